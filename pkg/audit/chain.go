@@ -0,0 +1,101 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// Chain is the tail of a hash chain. Every record carries the hash of the
+// previous record, and its own hash is the SHA-256 of its JSON without the
+// hash. The first record of a chain has the seq 1 and the empty prev hash.
+type Chain struct {
+	Seq  uint64
+	Hash string
+}
+
+// Link sets the seq and the hashes of the record and moves the tail of the
+// chain to it.
+func (c *Chain) Link(r *Record) error {
+	r.Seq = c.Seq + 1
+	r.PrevHash = c.Hash
+	hash, err := hashRecord(r)
+	if err != nil {
+		return err
+	}
+	r.Hash = hash
+	c.Seq, c.Hash = r.Seq, r.Hash
+	return nil
+}
+
+func hashRecord(r *Record) (string, error) {
+	hash := r.Hash
+	r.Hash = ""
+	data, err := json.Marshal(r)
+	r.Hash = hash
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Verify checks that the records continue the chain from c, and returns
+// the tail of the chain after the records. To verify the records in the
+// middle of a chain, c is the seq and the hash before the first record.
+func Verify(c Chain, records []Record) (Chain, error) {
+	for i := range records {
+		r := &records[i]
+		if r.Seq != c.Seq+1 {
+			return c, moerr.NewInternalErrorNoCtx("audit record %d follows record %d", r.Seq, c.Seq)
+		}
+		if r.PrevHash != c.Hash {
+			return c, moerr.NewInternalErrorNoCtx("audit record %d does not link to the previous record", r.Seq)
+		}
+		hash, err := hashRecord(r)
+		if err != nil {
+			return c, err
+		}
+		if hash != r.Hash {
+			return c, moerr.NewInternalErrorNoCtx("audit record %d has been modified", r.Seq)
+		}
+		c.Seq, c.Hash = r.Seq, r.Hash
+	}
+	return c, nil
+}
+
+// ReadRecords reads the JSON lines of the records.
+func ReadRecords(r io.Reader) ([]Record, error) {
+	var records []Record
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, moerr.NewInternalErrorNoCtx("invalid audit record at line %d: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRecords(t *testing.T, n int) []Record {
+	var chain Chain
+	records := make([]Record, n)
+	for i := range records {
+		records[i] = Record{
+			Event:     EventQuery,
+			Class:     ClassDDL,
+			Account:   "sys",
+			User:      "root",
+			Statement: fmt.Sprintf("create table t%d (a int)", i),
+		}
+		require.NoError(t, chain.Link(&records[i]))
+	}
+	return records
+}
+
+func TestChain(t *testing.T) {
+	records := newTestRecords(t, 5)
+	require.Equal(t, uint64(1), records[0].Seq)
+	require.Empty(t, records[0].PrevHash)
+	for i := 1; i < len(records); i++ {
+		require.Equal(t, records[i-1].Hash, records[i].PrevHash)
+	}
+
+	tail, err := Verify(Chain{}, records)
+	require.NoError(t, err)
+	require.Equal(t, Chain{Seq: 5, Hash: records[4].Hash}, tail)
+
+	// the records in the middle of the chain
+	_, err = Verify(Chain{Seq: 2, Hash: records[1].Hash}, records[2:])
+	require.NoError(t, err)
+}
+
+func TestVerifyTampered(t *testing.T) {
+	modified := newTestRecords(t, 5)
+	modified[2].User = "admin"
+	_, err := Verify(Chain{}, modified)
+	require.ErrorContains(t, err, "audit record 3 has been modified")
+
+	removed := newTestRecords(t, 5)
+	removed = append(removed[:2], removed[3:]...)
+	_, err = Verify(Chain{}, removed)
+	require.ErrorContains(t, err, "audit record 4 follows record 2")
+
+	// the removal is detected even if the seqs are rewritten
+	for i := range removed {
+		removed[i].Seq = uint64(i + 1)
+	}
+	_, err = Verify(Chain{}, removed)
+	require.Error(t, err)
+
+	inserted := newTestRecords(t, 3)
+	fake := inserted[1]
+	fake.Statement = "drop table t"
+	inserted = append(inserted[:2], append([]Record{fake}, inserted[2:]...)...)
+	_, err = Verify(Chain{}, inserted)
+	require.Error(t, err)
+}
+
+func TestReadRecords(t *testing.T) {
+	records := newTestRecords(t, 3)
+	records[1].Statement = "select '<\xff>'"
+	var chain Chain
+	for i := range records {
+		require.NoError(t, chain.Link(&records[i]))
+	}
+
+	var buf bytes.Buffer
+	for i := range records {
+		data, err := json.Marshal(&records[i])
+		require.NoError(t, err)
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	read, err := ReadRecords(&buf)
+	require.NoError(t, err)
+	require.Len(t, read, 3)
+	_, err = Verify(Chain{}, read)
+	require.NoError(t, err)
+
+	_, err = ReadRecords(bytes.NewBufferString("{\"seq\":1}\n{\"seq\""))
+	require.ErrorContains(t, err, "line 2")
+}
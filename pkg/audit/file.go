@@ -0,0 +1,235 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// FileName is the name of the current audit log file, the rotated files
+	// are named FileName.<rotate time>.
+	FileName = "audit.log"
+
+	rotatedTimeFormat = "20060102T150405.000000000"
+	maxLineSize       = 64 << 20
+)
+
+// FileSink writes the records as JSON lines to the rotating local files.
+// The chain continues across the rotated files.
+type FileSink struct {
+	mu       sync.Mutex
+	dir      string
+	maxSize  int64
+	maxFiles int
+	f        *os.File
+	size     int64
+	chain    Chain
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink opens the audit log in dir and continues the chain of the
+// last record. The file is rotated once it reaches maxSize bytes, and only
+// the latest maxFiles rotated files are kept if maxFiles is positive.
+func NewFileSink(dir string, maxSize int64, maxFiles int) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	s := &FileSink{dir: dir, maxSize: maxSize, maxFiles: maxFiles}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	last, err := lastRecord(s.f, s.size)
+	if err != nil {
+		s.f.Close()
+		return nil, err
+	}
+	if last == nil {
+		if last, err = s.lastRotatedRecord(); err != nil {
+			s.f.Close()
+			return nil, err
+		}
+	}
+	if last != nil {
+		s.chain = Chain{Seq: last.Seq, Hash: last.Hash}
+	}
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(filepath.Join(s.dir, FileName), os.O_CREATE|os.O_RDWR|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.f, s.size = f, info.Size()
+	if s.size == 0 {
+		return nil
+	}
+	// a record torn by a crash is left as it is, the next record starts
+	// from a new line.
+	last := make([]byte, 1)
+	if _, err = f.ReadAt(last, s.size-1); err != nil {
+		f.Close()
+		return err
+	}
+	if last[0] != '\n' {
+		n, err := f.Write([]byte{'\n'})
+		s.size += int64(n)
+		if err != nil {
+			f.Close()
+			return err
+		}
+	}
+	return nil
+}
+
+// Write implements Sink.
+func (s *FileSink) Write(_ context.Context, r *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// the chain moves only if the record is written
+	chain := s.chain
+	if err := chain.Link(r); err != nil {
+		return err
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if s.size > 0 && s.size+int64(len(data)) > s.maxSize {
+		if err = s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.f.Write(data)
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+	s.chain = chain
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return err
+	}
+	name := FileName + "." + time.Now().UTC().Format(rotatedTimeFormat)
+	if err := os.Rename(filepath.Join(s.dir, FileName), filepath.Join(s.dir, name)); err != nil {
+		return err
+	}
+	if s.maxFiles > 0 {
+		files, err := s.rotatedFiles()
+		if err != nil {
+			return err
+		}
+		for len(files) > s.maxFiles {
+			if err = os.Remove(filepath.Join(s.dir, files[0])); err != nil {
+				return err
+			}
+			files = files[1:]
+		}
+	}
+	return s.open()
+}
+
+// rotatedFiles returns the rotated files from the oldest to the latest.
+func (s *FileSink) rotatedFiles() ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasPrefix(entry.Name(), FileName+".") {
+			files = append(files, entry.Name())
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func (s *FileSink) lastRotatedRecord() (*Record, error) {
+	files, err := s.rotatedFiles()
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(s.dir, files[len(files)-1]))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return lastRecord(f, info.Size())
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
+
+// lastRecord reads the lines of the file backwards and returns the last
+// record, the lines torn by a crash are skipped. It returns nil if the file
+// has no record.
+func lastRecord(f *os.File, size int64) (*Record, error) {
+	const blockSize = 4096
+	var tail []byte
+	off := size
+	for {
+		// the lines in the tail are complete, except the first one if the
+		// beginning of the file has not been read.
+		for end := bytes.LastIndexByte(tail, '\n'); end >= 0; {
+			start := bytes.LastIndexByte(tail[:end], '\n')
+			if start < 0 && off > 0 {
+				break
+			}
+			var r Record
+			if err := json.Unmarshal(tail[start+1:end], &r); err == nil {
+				return &r, nil
+			}
+			tail, end = tail[:start+1], start
+		}
+		if off == 0 {
+			return nil, nil
+		}
+		n := min(int64(blockSize), off)
+		off -= n
+		block := make([]byte, n)
+		if _, err := f.ReadAt(block, off); err != nil {
+			return nil, err
+		}
+		tail = append(block, tail...)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestRecords(t *testing.T, s *FileSink, from, to int) {
+	for i := from; i < to; i++ {
+		r := &Record{Event: EventQuery, Class: ClassDDL, Statement: fmt.Sprintf("create table t%d (a int)", i)}
+		require.NoError(t, s.Write(context.Background(), r))
+	}
+}
+
+// readTestRecords reads the rotated files and the current file in order.
+func readTestRecords(t *testing.T, dir string) []Record {
+	s := &FileSink{dir: dir}
+	files, err := s.rotatedFiles()
+	require.NoError(t, err)
+	var records []Record
+	for _, name := range append(files, FileName) {
+		f, err := os.Open(filepath.Join(dir, name))
+		require.NoError(t, err)
+		read, err := ReadRecords(f)
+		require.NoError(t, f.Close())
+		require.NoError(t, err)
+		records = append(records, read...)
+	}
+	return records
+}
+
+func TestFileSink(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewFileSink(dir, 1<<20, 0)
+	require.NoError(t, err)
+	writeTestRecords(t, s, 0, 3)
+	require.NoError(t, s.Close())
+
+	// the chain continues after reopening
+	s, err = NewFileSink(dir, 1<<20, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), s.chain.Seq)
+	writeTestRecords(t, s, 3, 5)
+	require.NoError(t, s.Close())
+
+	records := readTestRecords(t, dir)
+	require.Len(t, records, 5)
+	_, err = Verify(Chain{}, records)
+	require.NoError(t, err)
+}
+
+func TestFileSinkRotate(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewFileSink(dir, 500, 0)
+	require.NoError(t, err)
+	writeTestRecords(t, s, 0, 10)
+	files, err := s.rotatedFiles()
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	require.NoError(t, s.Close())
+
+	records := readTestRecords(t, dir)
+	require.Len(t, records, 10)
+	_, err = Verify(Chain{}, records)
+	require.NoError(t, err)
+
+	// the chain continues from the rotated file if the current file is empty
+	require.NoError(t, os.Truncate(filepath.Join(dir, FileName), 0))
+	s, err = NewFileSink(dir, 500, 2)
+	require.NoError(t, err)
+	require.NotZero(t, s.chain.Seq)
+	writeTestRecords(t, s, 10, 20)
+	files, err = s.rotatedFiles()
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	require.NoError(t, s.Close())
+}
+
+func TestFileSinkTornRecord(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewFileSink(dir, 1<<20, 0)
+	require.NoError(t, err)
+	writeTestRecords(t, s, 0, 2)
+	require.NoError(t, s.Close())
+
+	f, err := os.OpenFile(filepath.Join(dir, FileName), os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"seq":3,"time":"2024-`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s, err = NewFileSink(dir, 1<<20, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), s.chain.Seq)
+	writeTestRecords(t, s, 2, 3)
+	require.NoError(t, s.Close())
+
+	data, err := os.ReadFile(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 4)
+	require.True(t, strings.HasPrefix(lines[3], `{"seq":3,`))
+	// the torn record is detected
+	_, err = ReadRecords(strings.NewReader(string(data)))
+	require.Error(t, err)
+}
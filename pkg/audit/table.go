@@ -0,0 +1,189 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"go.uber.org/zap"
+)
+
+const (
+	tableColumns = "node_uuid, seq, event_time, event, class, account_id, account, user_name, role_name, " +
+		"host, connection_id, session_id, db_name, statement_type, statement, code, error, prev_hash, hash"
+
+	getChainTailFmt = "select seq, hash from `%s`.`%s` where node_uuid = '%s' order by seq desc limit 1"
+
+	insertRecordsFmt = "insert into `%s`.`%s` (%s) values "
+
+	defaultFlushInterval = time.Second
+	defaultMaxPending    = 100000
+)
+
+// TableSink writes the records into mo_catalog.mo_audit_log of the sys
+// account. The records are inserted in batches by a background goroutine,
+// and every node has its own chain in the table.
+type TableSink struct {
+	exec     executor.SQLExecutor
+	node     string
+	interval time.Duration
+
+	mu      sync.Mutex
+	pending []Record
+	// the tail of the chain is read from the table before the first insert
+	chain *Chain
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ Sink = (*TableSink)(nil)
+
+// NewTableSink starts the goroutine which flushes the records of the node.
+func NewTableSink(ctx context.Context, exec executor.SQLExecutor, node string) *TableSink {
+	s := &TableSink{
+		exec:     exec,
+		node:     node,
+		interval: defaultFlushInterval,
+	}
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.wg.Add(1)
+	go s.run(ctx)
+	return s
+}
+
+// Write implements Sink. The record is linked when it is flushed.
+func (s *TableSink) Write(ctx context.Context, r *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.pending) >= defaultMaxPending {
+		return moerr.NewInternalError(ctx, "too many audit records are waiting to be flushed")
+	}
+	s.pending = append(s.pending, *r)
+	return nil
+}
+
+func (s *TableSink) run(ctx context.Context) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// flush the records of the closed sink
+			if err := s.flush(context.Background()); err != nil {
+				logutil.Error("failed to flush the audit records", zap.Error(err))
+			}
+			return
+		case <-ticker.C:
+			if err := s.flush(ctx); err != nil {
+				logutil.Error("failed to flush the audit records", zap.Error(err))
+			}
+		}
+	}
+}
+
+// flush inserts the pending records. The records are kept and the chain
+// does not move if the insert fails, they are retried in the next flush.
+func (s *TableSink) flush(ctx context.Context) error {
+	s.mu.Lock()
+	records := s.pending
+	s.mu.Unlock()
+	if len(records) == 0 {
+		return nil
+	}
+
+	if s.chain == nil {
+		chain, err := s.readChainTail(ctx)
+		if err != nil {
+			return err
+		}
+		s.chain = &chain
+	}
+	chain := *s.chain
+	var sql strings.Builder
+	sql.WriteString(fmt.Sprintf(insertRecordsFmt, catalog.MO_CATALOG, catalog.MO_AUDIT_LOG, tableColumns))
+	for i := range records {
+		r := &records[i]
+		r.Node = s.node
+		if err := chain.Link(r); err != nil {
+			return err
+		}
+		if i > 0 {
+			sql.WriteString(", ")
+		}
+		sql.WriteString(fmt.Sprintf("('%s', %d, '%s', '%s', '%s', %d, '%s', '%s', '%s', '%s', %d, '%s', '%s', '%s', '%s', %d, '%s', '%s', '%s')",
+			escape(r.Node), r.Seq, r.Time, r.Event, r.Class, r.AccountID, escape(r.Account),
+			escape(r.User), escape(r.Role), escape(r.Host), r.ConnectionID, escape(r.SessionID),
+			escape(r.Database), escape(r.StatementType), escape(r.Statement), r.Code,
+			escape(r.Error), r.PrevHash, r.Hash))
+	}
+	res, err := s.exec.Exec(ctx, sql.String(), s.opts())
+	if err != nil {
+		return err
+	}
+	res.Close()
+
+	s.chain = &chain
+	s.mu.Lock()
+	s.pending = s.pending[len(records):]
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *TableSink) readChainTail(ctx context.Context) (Chain, error) {
+	var chain Chain
+	res, err := s.exec.Exec(ctx, fmt.Sprintf(getChainTailFmt, catalog.MO_CATALOG, catalog.MO_AUDIT_LOG, escape(s.node)), s.opts())
+	if err != nil {
+		return chain, err
+	}
+	defer res.Close()
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		if rows == 0 {
+			return true
+		}
+		chain.Seq = vector.GetFixedAt[uint64](cols[0], 0)
+		chain.Hash = cols[1].GetStringAt(0)
+		return false
+	})
+	return chain, nil
+}
+
+func (s *TableSink) opts() executor.Options {
+	return executor.Options{}.
+		WithAccountID(catalog.System_Account).
+		WithDatabase(catalog.MO_CATALOG).
+		WithDisableTrace()
+}
+
+// Close implements Sink, the pending records are flushed.
+func (s *TableSink) Close() error {
+	s.cancel()
+	s.wg.Wait()
+	return nil
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s)
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+)
+
+type testExecutor struct {
+	sqls []string
+	err  error
+}
+
+func (e *testExecutor) Exec(ctx context.Context, sql string, opts executor.Options) (executor.Result, error) {
+	if strings.HasPrefix(sql, "insert") && e.err != nil {
+		return executor.Result{}, e.err
+	}
+	e.sqls = append(e.sqls, sql)
+	return executor.Result{}, nil
+}
+
+func (e *testExecutor) ExecTxn(ctx context.Context, execFunc func(txn executor.TxnExecutor) error, opts executor.Options) error {
+	return moerr.NewNotSupportedNoCtx("txn")
+}
+
+func TestTableSink(t *testing.T) {
+	ctx := context.Background()
+	exec := &testExecutor{err: moerr.NewInternalErrorNoCtx("insert failed")}
+	s := &TableSink{exec: exec, node: "cn1"}
+	require.NoError(t, s.Write(ctx, &Record{Event: EventConnect, Class: ClassConnect, User: "root"}))
+	require.NoError(t, s.Write(ctx, &Record{Event: EventQuery, Class: ClassDDL, Statement: "create table 't'"}))
+
+	// the records are kept and the chain does not move
+	require.Error(t, s.flush(ctx))
+	require.Len(t, s.pending, 2)
+	require.Equal(t, Chain{}, *s.chain)
+
+	exec.err = nil
+	require.NoError(t, s.flush(ctx))
+	require.Empty(t, s.pending)
+	require.Equal(t, uint64(2), s.chain.Seq)
+	require.Len(t, exec.sqls, 2)
+	require.Contains(t, exec.sqls[0], "where node_uuid = 'cn1'")
+	insert := exec.sqls[1]
+	require.True(t, strings.HasPrefix(insert, "insert into `mo_catalog`.`mo_audit_log`"))
+	require.Contains(t, insert, `'create table \'t\''`)
+	require.Contains(t, insert, "('cn1', 1, ")
+	require.Contains(t, insert, "('cn1', 2, ")
+	require.Contains(t, insert, s.chain.Hash)
+}
@@ -0,0 +1,161 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit records the audit trail of the accounts. The records of a
+// sink are linked into a hash chain, so the modification, the insertion and
+// the removal of the records in the middle of the trail can be detected by
+// Verify.
+package audit
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Event is what happened.
+type Event string
+
+const (
+	EventConnect       Event = "connect"
+	EventFailedConnect Event = "failed_connect"
+	EventDisconnect    Event = "disconnect"
+	EventQuery         Event = "query"
+)
+
+// Class is the class of the events used by the filter rules.
+type Class string
+
+const (
+	// ClassConnect includes the connect, failed_connect and disconnect
+	// events.
+	ClassConnect Class = "CONNECT"
+	ClassDDL     Class = "DDL"
+	ClassDCL     Class = "DCL"
+	ClassDML     Class = "DML"
+	ClassDQL     Class = "DQL"
+	ClassTCL     Class = "TCL"
+	ClassOther   Class = "OTHER"
+)
+
+var classes = []Class{ClassConnect, ClassDDL, ClassDCL, ClassDML, ClassDQL, ClassTCL, ClassOther}
+
+// TimeFormat is the format of Record.Time, it is the same as the string of
+// DATETIME(6) so the records in the table can be verified as well.
+const TimeFormat = "2006-01-02 15:04:05.000000"
+
+// Record is an audit record. The fields are serialized in the order of
+// the struct, which is the input of the hash of the record.
+type Record struct {
+	Seq           uint64 `json:"seq"`
+	Time          string `json:"time"`
+	Node          string `json:"node"`
+	Event         Event  `json:"event"`
+	Class         Class  `json:"class"`
+	AccountID     uint32 `json:"account_id"`
+	Account       string `json:"account"`
+	User          string `json:"user"`
+	Role          string `json:"role"`
+	Host          string `json:"host"`
+	ConnectionID  uint32 `json:"connection_id"`
+	SessionID     string `json:"session_id"`
+	Database      string `json:"database"`
+	StatementType string `json:"statement_type"`
+	Statement     string `json:"statement"`
+	// Code is the error code of the result, 0 means success.
+	Code     uint16 `json:"code"`
+	Error    string `json:"error"`
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+// FormatTime formats t in UTC as Record.Time.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(TimeFormat)
+}
+
+// Sink is where the records are written to.
+type Sink interface {
+	// Write links the record to the chain of the sink and saves it. The
+	// Seq, PrevHash and Hash of the record are set by the sink.
+	Write(ctx context.Context, r *Record) error
+	Close() error
+}
+
+// Filter decides which records are written. The empty include lists match
+// everything.
+type Filter struct {
+	Classes          map[Class]bool
+	IncludeUsers     []string
+	ExcludeUsers     []string
+	IncludeDatabases []string
+	ExcludeDatabases []string
+}
+
+// ParseClasses parses the comma separated classes, the unknown classes are
+// ignored.
+func ParseClasses(s string) map[Class]bool {
+	m := make(map[Class]bool)
+	for _, item := range ParseList(s) {
+		for _, c := range classes {
+			if strings.EqualFold(item, string(c)) {
+				m[c] = true
+			}
+		}
+	}
+	return m
+}
+
+// ParseList splits the comma separated list, the empty items are skipped.
+func ParseList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// Match returns true if the record should be written. The database rules
+// only apply to the queries, the database of a query is the current
+// database of the session.
+func (f *Filter) Match(r *Record) bool {
+	if !f.Classes[r.Class] {
+		return false
+	}
+	if !matchList(r.User, f.IncludeUsers, f.ExcludeUsers) {
+		return false
+	}
+	if r.Event == EventQuery && !matchList(r.Database, f.IncludeDatabases, f.ExcludeDatabases) {
+		return false
+	}
+	return true
+}
+
+func matchList(name string, include, exclude []string) bool {
+	if len(include) > 0 && !contains(include, name) {
+		return false
+	}
+	return !contains(exclude, name)
+}
+
+func contains(list []string, name string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClasses(t *testing.T) {
+	require.Equal(t,
+		map[Class]bool{ClassConnect: true, ClassDDL: true, ClassOther: true},
+		ParseClasses(" connect,DDL,, other ,unknown"))
+	require.Empty(t, ParseClasses(""))
+}
+
+func TestFilter(t *testing.T) {
+	f := &Filter{
+		Classes:          ParseClasses("CONNECT,DDL"),
+		ExcludeUsers:     ParseList("monitor"),
+		IncludeDatabases: ParseList("db1, db2"),
+	}
+	require.True(t, f.Match(&Record{Event: EventQuery, Class: ClassDDL, User: "root", Database: "DB1"}))
+	require.False(t, f.Match(&Record{Event: EventQuery, Class: ClassDML, User: "root", Database: "db1"}))
+	require.False(t, f.Match(&Record{Event: EventQuery, Class: ClassDDL, User: "monitor", Database: "db1"}))
+	require.False(t, f.Match(&Record{Event: EventQuery, Class: ClassDDL, User: "root", Database: "db3"}))
+	require.False(t, f.Match(&Record{Event: EventQuery, Class: ClassDDL, User: "root"}))
+	// the database rules do not apply to the connections
+	require.True(t, f.Match(&Record{Event: EventFailedConnect, Class: ClassConnect, User: "root"}))
+	require.False(t, f.Match(&Record{Event: EventConnect, Class: ClassConnect, User: "monitor"}))
+
+	f.IncludeUsers = ParseList("root")
+	require.False(t, f.Match(&Record{Event: EventConnect, Class: ClassConnect, User: "u1"}))
+}
+
+func TestFormatTime(t *testing.T) {
+	ts := time.Date(2024, 3, 15, 8, 1, 2, 3456789, time.FixedZone("", 3600))
+	require.Equal(t, "2024-03-15 07:01:02.003456", FormatTime(ts))
+}
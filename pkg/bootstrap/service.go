@@ -90,6 +90,9 @@ var (
 		frontend.MoCatalogMoUpgradeDDL,
 		frontend.MoCatalogMoUpgradeTenantDDL,
 		frontend.MoCatalogMoPitrDDL,
+		frontend.MoCatalogMoCdcWatermarkDDL,
+		frontend.MoCatalogMoAuditLogDDL,
+		frontend.MoCatalogMoColumnStatisticsDDL,
 	}
 
 	initMoVersionFormat = `insert into %s.%s values ('%s', %d, %d, current_timestamp(), current_timestamp())`
@@ -24,6 +24,9 @@ import (
 var clusterUpgEntries = []versions.UpgradeEntry{
 	upg_mo_pitr,
 	upg_mo_subs,
+	upg_mo_cdc_watermark,
+	upg_mo_audit_log,
+	upg_mo_column_statistics,
 }
 
 var needUpgradePubSub = false
@@ -64,3 +67,57 @@ var upg_mo_subs = versions.UpgradeEntry{
 		return false, nil
 	},
 }
+
+var upg_mo_cdc_watermark = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_CDC_WATERMARK,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoCdcWatermarkDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		isExist, err := versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK)
+		if err != nil {
+			return false, err
+		}
+
+		if isExist {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_audit_log = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_AUDIT_LOG,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoAuditLogDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		isExist, err := versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_AUDIT_LOG)
+		if err != nil {
+			return false, err
+		}
+
+		if isExist {
+			return true, nil
+		}
+		return false, nil
+	},
+}
+
+var upg_mo_column_statistics = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_COLUMN_STATISTICS,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoColumnStatisticsDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		isExist, err := versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_COLUMN_STATISTICS)
+		if err != nil {
+			return false, err
+		}
+
+		if isExist {
+			return true, nil
+		}
+		return false, nil
+	},
+}
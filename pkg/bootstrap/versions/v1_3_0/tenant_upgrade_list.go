@@ -19,12 +19,21 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 )
 
 var tenantUpgEntries = []versions.UpgradeEntry{
 	upg_systemMetrics_server_snapshot_usage,
 	upg_mo_snapshots,
+	upg_mo_user_pg_auth_string,
+	upg_mo_user_auth_plugin,
+	upg_mo_user_sha2_auth_string,
+	upg_mo_user_password_last_changed,
+	upg_mo_user_password_history,
+	upg_mo_user_failed_logins,
+	upg_mo_user_locked_time,
+	upg_mo_catalog_mo_query_memory,
 }
 
 const viewServerSnapshotUsage = "server_snapshot_usage"
@@ -58,3 +67,120 @@ var upg_mo_snapshots = versions.UpgradeEntry{
 		return false, nil
 	},
 }
+
+var upg_mo_user_pg_auth_string = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_USER,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf("alter table %s.%s add column pg_auth_string varchar(300) after default_role", catalog.MO_CATALOG, catalog.MO_USER),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_USER, "pg_auth_string")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_user_auth_plugin = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_USER,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf("alter table %s.%s add column auth_plugin varchar(64) after pg_auth_string", catalog.MO_CATALOG, catalog.MO_USER),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_USER, "auth_plugin")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_user_sha2_auth_string = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_USER,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf("alter table %s.%s add column sha2_auth_string varchar(128) after auth_plugin", catalog.MO_CATALOG, catalog.MO_USER),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_USER, "sha2_auth_string")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_user_password_last_changed = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_USER,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf("alter table %s.%s add column password_last_changed timestamp after sha2_auth_string", catalog.MO_CATALOG, catalog.MO_USER),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_USER, "password_last_changed")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_user_password_history = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_USER,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf("alter table %s.%s add column password_history text after password_last_changed", catalog.MO_CATALOG, catalog.MO_USER),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_USER, "password_history")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_user_failed_logins = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_USER,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf("alter table %s.%s add column failed_logins int unsigned after password_history", catalog.MO_CATALOG, catalog.MO_USER),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_USER, "failed_logins")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_user_locked_time = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_USER,
+	UpgType:   versions.ADD_COLUMN,
+	UpgSql:    fmt.Sprintf("alter table %s.%s add column locked_time timestamp after failed_logins", catalog.MO_CATALOG, catalog.MO_USER),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MO_USER, "locked_time")
+		if err != nil {
+			return false, err
+		}
+		return colInfo.IsExits, nil
+	},
+}
+
+var upg_mo_catalog_mo_query_memory = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: "mo_query_memory",
+	UpgType:   versions.MODIFY_VIEW,
+	UpgSql:    frontend.MoCatalogMoQueryMemoryDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, viewDef, err := versions.CheckViewDefinition(txn, accountId, catalog.MO_CATALOG, "mo_query_memory")
+		if err != nil {
+			return false, err
+		}
+
+		if exists && viewDef == frontend.MoCatalogMoQueryMemoryDDL {
+			return true, nil
+		}
+		return false, nil
+	},
+	PreSql: fmt.Sprintf("DROP VIEW IF EXISTS %s.%s;", catalog.MO_CATALOG, "mo_query_memory"),
+}
@@ -147,6 +147,18 @@ const (
 
 	//MO_Pitr
 	MO_PITR = "mo_pitr"
+
+	// MO_CDC_WATERMARK the watermarks of the cdc tasks
+	MO_CDC_WATERMARK = "mo_cdc_watermark"
+
+	// MO_AUDIT_LOG the audit records of the accounts whose audit log sink is the table
+	MO_AUDIT_LOG = "mo_audit_log"
+
+	// MO_COLUMN_STATISTICS the column histograms built by ANALYZE TABLE
+	MO_COLUMN_STATISTICS = "mo_column_statistics"
+
+	// MO_USER the users of the account
+	MO_USER = "mo_user"
 )
 
 const (
@@ -0,0 +1,478 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+)
+
+// sinkChunkRows is the number of rows sent to the sink in one call.
+const sinkChunkRows = 1000
+
+// change is the last committed change of a primary key in a window.
+type change struct {
+	ts      types.TS
+	deleted bool
+	row     []any
+	key     []any
+}
+
+// tableReader replicates one table to the sink. It first copies a full
+// snapshot of the table, then polls the committed changes after the
+// watermark and applies them to the sink.
+type tableReader struct {
+	task    *Task
+	mapping TableMapping
+	sinker  Sinker
+	def     *TableDef
+
+	// columns of the source table, in the order of def.Columns
+	columns []string
+	// the primary key column of the source table, it is a hidden column if
+	// the primary key is composite.
+	pkName  string
+	cpkey   bool
+	pkTypes []types.Type
+
+	watermark types.TS
+}
+
+func (r *tableReader) logger() *zap.Logger {
+	return r.task.logger.With(
+		zap.String("table", r.mapping.SourceDatabase+"."+r.mapping.SourceTable))
+}
+
+func (r *tableReader) accountCtx(ctx context.Context) context.Context {
+	return defines.AttachAccountId(ctx, r.task.accountID)
+}
+
+func (r *tableReader) execOpts() executor.Options {
+	return executor.Options{}.
+		WithAccountID(r.task.accountID).
+		WithDatabase(r.mapping.SourceDatabase)
+}
+
+// init loads the definition of the source table.
+func (r *tableReader) init(ctx context.Context) error {
+	return r.task.exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		ctx := r.accountCtx(ctx)
+		db, err := r.task.eng.Database(ctx, r.mapping.SourceDatabase, txn.Txn())
+		if err != nil {
+			return err
+		}
+		rel, err := db.Relation(ctx, r.mapping.SourceTable, nil)
+		if err != nil {
+			return err
+		}
+		tableDef := rel.GetTableDef(ctx)
+		if tableDef.Pkey == nil || tableDef.Pkey.PkeyColName == catalog.FakePrimaryKeyColName {
+			return moerr.NewInternalError(ctx, "cdc table %s.%s has no primary key",
+				r.mapping.SourceDatabase, r.mapping.SourceTable)
+		}
+
+		def := &TableDef{
+			Database: r.mapping.SinkDatabase,
+			Table:    r.mapping.SinkTable,
+			PkNames:  tableDef.Pkey.Names,
+		}
+		colTypes := make(map[string]types.Type)
+		for _, col := range tableDef.Cols {
+			typ := types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale)
+			colTypes[col.Name] = typ
+			if col.Hidden || col.Name == catalog.Row_ID {
+				continue
+			}
+			def.Columns = append(def.Columns, Column{Name: col.Name, Type: typ})
+			r.columns = append(r.columns, col.Name)
+		}
+		r.pkName = tableDef.Pkey.PkeyColName
+		r.cpkey = len(tableDef.Pkey.Names) > 1
+		for _, name := range tableDef.Pkey.Names {
+			r.pkTypes = append(r.pkTypes, colTypes[name])
+		}
+		r.def = def
+		r.sinker = r.task.downstream.Sinker(def)
+		return nil
+	}, r.execOpts())
+}
+
+func (r *tableReader) run(ctx context.Context) error {
+	if err := r.init(ctx); err != nil {
+		return err
+	}
+	if err := retry(ctx, r.task.ddlRetry, func() error {
+		return r.sinker.Prepare(ctx)
+	}); err != nil {
+		return err
+	}
+
+	watermark, ok, err := r.task.watermarks.Get(ctx, r.mapping.SourceDatabase, r.mapping.SourceTable)
+	if err != nil {
+		return err
+	}
+	if ok {
+		r.watermark = watermark
+	} else if r.task.noFull {
+		if r.watermark, err = r.now(ctx); err != nil {
+			return err
+		}
+		if !r.task.startTS.IsEmpty() {
+			r.watermark = r.task.startTS
+		}
+		if err = r.saveWatermark(ctx); err != nil {
+			return err
+		}
+	} else if err = r.fullSync(ctx, false); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		if r.finished() {
+			r.logger().Info("cdc table finished", zap.String("watermark", r.watermark.ToString()))
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+		}
+		err = r.task.incrementalLimit.run(ctx, func() error {
+			return r.incremental(ctx)
+		})
+		if moerr.IsMoErrCode(err, moerr.ErrTxnStale) {
+			// the changes after the watermark are gone, start over
+			r.logger().Warn("cdc watermark is stale, resync the table",
+				zap.String("watermark", r.watermark.ToString()), zap.Error(err))
+			err = r.fullSync(ctx, true)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		timer.Reset(r.task.pollInterval)
+	}
+}
+
+func (r *tableReader) finished() bool {
+	return !r.task.endTS.IsEmpty() && r.task.endTS.LessEq(&r.watermark)
+}
+
+// now returns the latest timestamp which can be read.
+func (r *tableReader) now(ctx context.Context) (ts types.TS, err error) {
+	err = r.task.exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		ts = types.TimestampToTS(txn.Txn().SnapshotTS())
+		return nil
+	}, r.execOpts())
+	return
+}
+
+func (r *tableReader) saveWatermark(ctx context.Context) error {
+	return r.task.watermarks.Update(ctx, r.mapping.SourceDatabase, r.mapping.SourceTable, r.watermark)
+}
+
+// fullSync copies the snapshot of the table to the sink.
+func (r *tableReader) fullSync(ctx context.Context, truncate bool) error {
+	return r.task.fullLimit.run(ctx, func() error {
+		snapshot, err := r.now(ctx)
+		if err != nil {
+			return err
+		}
+		if !r.task.startTS.IsEmpty() && !truncate {
+			snapshot = r.task.startTS
+		}
+		if !r.task.endTS.IsEmpty() && r.task.endTS.Less(&snapshot) {
+			snapshot = r.task.endTS
+		}
+		// snapshot reads only take the physical part
+		snapshot = types.BuildTS(snapshot.Physical(), 0)
+		r.logger().Info("cdc full sync start", zap.String("snapshot", snapshot.ToString()))
+
+		if truncate {
+			if err = retry(ctx, r.task.fullDMLRetry, func() error {
+				return r.sinker.Truncate(ctx)
+			}); err != nil {
+				return err
+			}
+		}
+
+		cols := make([]string, len(r.columns))
+		for i, name := range r.columns {
+			cols[i] = quoteName(name)
+		}
+		sql := fmt.Sprintf("select %s from %s.%s {MO_TS = %d}",
+			strings.Join(cols, ", "),
+			quoteName(r.mapping.SourceDatabase),
+			quoteName(r.mapping.SourceTable),
+			snapshot.Physical())
+		res, err := r.task.exec.Exec(ctx, sql, r.execOpts())
+		if err != nil {
+			return err
+		}
+		defer res.Close()
+
+		rows := make([][]any, 0, sinkChunkRows)
+		for _, bat := range res.Batches {
+			for i := 0; i < bat.RowCount(); i++ {
+				row := make([]any, len(bat.Vecs))
+				for j, vec := range bat.Vecs {
+					if row[j], err = extractValue(vec, i); err != nil {
+						return err
+					}
+				}
+				rows = append(rows, row)
+				if len(rows) == sinkChunkRows {
+					if err = r.upsert(ctx, rows, r.task.fullDMLRetry); err != nil {
+						return err
+					}
+					rows = rows[:0]
+				}
+			}
+		}
+		if err = r.upsert(ctx, rows, r.task.fullDMLRetry); err != nil {
+			return err
+		}
+
+		r.watermark = snapshot
+		r.logger().Info("cdc full sync done", zap.String("watermark", r.watermark.ToString()))
+		return r.saveWatermark(ctx)
+	})
+}
+
+func (r *tableReader) upsert(ctx context.Context, rows [][]any, retryTimes int) error {
+	for len(rows) > 0 {
+		n := min(len(rows), sinkChunkRows)
+		if err := retry(ctx, retryTimes, func() error {
+			return r.sinker.Upsert(ctx, rows[:n])
+		}); err != nil {
+			return err
+		}
+		rows = rows[n:]
+	}
+	return nil
+}
+
+func (r *tableReader) delete(ctx context.Context, keys [][]any, retryTimes int) error {
+	for len(keys) > 0 {
+		n := min(len(keys), sinkChunkRows)
+		if err := retry(ctx, retryTimes, func() error {
+			return r.sinker.Delete(ctx, keys[:n])
+		}); err != nil {
+			return err
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
+// incremental applies the changes committed in (watermark, now] to the sink.
+func (r *tableReader) incremental(ctx context.Context) error {
+	var to types.TS
+	err := r.task.exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		to = types.TimestampToTS(txn.Txn().SnapshotTS())
+		if !r.task.endTS.IsEmpty() && r.task.endTS.Less(&to) {
+			to = r.task.endTS
+		}
+		if to.LessEq(&r.watermark) {
+			return nil
+		}
+
+		ctx := r.accountCtx(ctx)
+		db, err := r.task.eng.Database(ctx, r.mapping.SourceDatabase, txn.Txn())
+		if err != nil {
+			return err
+		}
+		rel, err := db.Relation(ctx, r.mapping.SourceTable, nil)
+		if err != nil {
+			return err
+		}
+		handle, err := rel.CollectChanges(ctx, r.watermark, to, r.task.mp)
+		if err != nil {
+			return err
+		}
+		defer handle.Close()
+
+		changes := make(map[string]*change)
+		for {
+			data, tombstone, err := handle.Next(ctx, r.task.mp)
+			if err != nil {
+				return err
+			}
+			if data == nil && tombstone == nil {
+				break
+			}
+			if data != nil {
+				err = r.collectInserts(data, changes)
+				data.Clean(r.task.mp)
+			}
+			if err == nil && tombstone != nil {
+				err = r.collectDeletes(tombstone, changes)
+			}
+			if tombstone != nil {
+				tombstone.Clean(r.task.mp)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return r.apply(ctx, changes)
+	}, r.execOpts())
+	if err != nil || to.LessEq(&r.watermark) {
+		return err
+	}
+	r.watermark = to
+	return r.saveWatermark(ctx)
+}
+
+// collectInserts keeps the last change of every primary key. An update
+// is a delete and an insert at the same timestamp, so the insert wins
+// over the delete at the same timestamp.
+func (r *tableReader) collectInserts(bat *batch.Batch, changes map[string]*change) error {
+	idx := make([]int, len(r.columns))
+	pkIdx := -1
+	for i, attr := range bat.Attrs {
+		if attr == r.pkName {
+			pkIdx = i
+		}
+		for j, name := range r.columns {
+			if attr == name {
+				idx[j] = i
+			}
+		}
+	}
+	if pkIdx == -1 {
+		return moerr.NewInternalErrorNoCtx("primary key %s not found in the changes", r.pkName)
+	}
+	tss := vector.MustFixedCol[types.TS](bat.Vecs[len(bat.Vecs)-1])
+	for i := 0; i < bat.RowCount(); i++ {
+		key := string(bat.Vecs[pkIdx].GetRawBytesAt(i))
+		if c, ok := changes[key]; ok && tss[i].Less(&c.ts) {
+			continue
+		}
+		row := make([]any, len(idx))
+		for j, k := range idx {
+			v, err := extractValue(bat.Vecs[k], i)
+			if err != nil {
+				return err
+			}
+			row[j] = v
+		}
+		changes[key] = &change{ts: tss[i], row: row}
+	}
+	return nil
+}
+
+func (r *tableReader) collectDeletes(bat *batch.Batch, changes map[string]*change) error {
+	tss := vector.MustFixedCol[types.TS](bat.Vecs[1])
+	for i := 0; i < bat.RowCount(); i++ {
+		key := string(bat.Vecs[0].GetRawBytesAt(i))
+		if c, ok := changes[key]; ok && tss[i].LessEq(&c.ts) {
+			continue
+		}
+		pk, err := r.primaryKey(bat.Vecs[0], i)
+		if err != nil {
+			return err
+		}
+		changes[key] = &change{ts: tss[i], deleted: true, key: pk}
+	}
+	return nil
+}
+
+func (r *tableReader) primaryKey(vec *vector.Vector, i int) ([]any, error) {
+	if !r.cpkey {
+		v, err := extractValue(vec, i)
+		if err != nil {
+			return nil, err
+		}
+		return []any{v}, nil
+	}
+	tuple, err := types.Unpack(vec.GetBytesAt(i))
+	if err != nil {
+		return nil, err
+	}
+	if len(tuple) != len(r.pkTypes) {
+		return nil, moerr.NewInternalErrorNoCtx("invalid composite primary key of %d values", len(tuple))
+	}
+	key := make([]any, len(tuple))
+	for j, v := range tuple {
+		key[j] = extractTupleValue(v, r.pkTypes[j])
+	}
+	return key, nil
+}
+
+func (r *tableReader) apply(ctx context.Context, changes map[string]*change) error {
+	var (
+		rows [][]any
+		keys [][]any
+	)
+	for _, c := range changes {
+		if c.deleted {
+			keys = append(keys, c.key)
+		} else {
+			rows = append(rows, c.row)
+		}
+	}
+	if err := r.delete(ctx, keys, r.task.incrementalDMLRetry); err != nil {
+		return err
+	}
+	return r.upsert(ctx, rows, r.task.incrementalDMLRetry)
+}
+
+// retry calls fn until it succeeds or fails more than times.
+func retry(ctx context.Context, times int, fn func() error) (err error) {
+	for i := 0; ; i++ {
+		if err = fn(); err == nil || i >= times || ctx.Err() != nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second * time.Duration(i+1)):
+		}
+	}
+}
+
+// limiter bounds the number of tables doing the same kind of work.
+type limiter chan struct{}
+
+func newLimiter(n int) limiter {
+	return make(limiter, n)
+}
+
+func (l limiter) run(ctx context.Context, fn func() error) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case l <- struct{}{}:
+	}
+	defer func() { <-l }()
+	return fn()
+}
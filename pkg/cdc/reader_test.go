@@ -0,0 +1,201 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// recordSinker keeps the rows and keys sent to the sink.
+type recordSinker struct {
+	rows [][]any
+	keys [][]any
+}
+
+func (s *recordSinker) Prepare(ctx context.Context) error  { return nil }
+func (s *recordSinker) Truncate(ctx context.Context) error { return nil }
+
+func (s *recordSinker) Upsert(ctx context.Context, rows [][]any) error {
+	s.rows = append(s.rows, rows...)
+	return nil
+}
+
+func (s *recordSinker) Delete(ctx context.Context, keys [][]any) error {
+	s.keys = append(s.keys, keys...)
+	return nil
+}
+
+func newTestReader(sinker Sinker) *tableReader {
+	return &tableReader{
+		task:    &Task{},
+		sinker:  sinker,
+		columns: []string{"a", "b"},
+		pkName:  "a",
+		pkTypes: []types.Type{types.T_int64.ToType()},
+	}
+}
+
+func TestTableReaderApplyChanges(t *testing.T) {
+	mp := mpool.MustNewZero()
+	ts := func(i int64) types.TS { return types.BuildTS(i, 0) }
+
+	// the changes of the data, the commit timestamp is the last column
+	data := batch.New(false, []string{"b", "a", "ts"})
+	data.Vecs[0] = vector.NewVec(types.T_varchar.ToType())
+	data.Vecs[1] = vector.NewVec(types.T_int64.ToType())
+	data.Vecs[2] = vector.NewVec(types.T_TS.ToType())
+	for _, r := range []struct {
+		a  int64
+		b  string
+		ts types.TS
+	}{
+		{1, "x", ts(1)},
+		{1, "y", ts(2)},
+		{2, "z", ts(1)},
+		{3, "w", ts(3)},
+	} {
+		require.NoError(t, vector.AppendBytes(data.Vecs[0], []byte(r.b), false, mp))
+		require.NoError(t, vector.AppendFixed(data.Vecs[1], r.a, false, mp))
+		require.NoError(t, vector.AppendFixed(data.Vecs[2], r.ts, false, mp))
+	}
+	data.SetRowCount(4)
+	defer data.Clean(mp)
+
+	// 1 is updated at 2, 2 is deleted at 2, 3 is deleted before it is
+	// inserted again and 4 is deleted only.
+	tombstone := batch.New(false, []string{"pk", "ts"})
+	tombstone.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	tombstone.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	for _, r := range []struct {
+		a  int64
+		ts types.TS
+	}{
+		{1, ts(2)},
+		{2, ts(2)},
+		{3, ts(2)},
+		{4, ts(3)},
+	} {
+		require.NoError(t, vector.AppendFixed(tombstone.Vecs[0], r.a, false, mp))
+		require.NoError(t, vector.AppendFixed(tombstone.Vecs[1], r.ts, false, mp))
+	}
+	tombstone.SetRowCount(4)
+	defer tombstone.Clean(mp)
+
+	sinker := &recordSinker{}
+	r := newTestReader(sinker)
+	changes := make(map[string]*change)
+	require.NoError(t, r.collectInserts(data, changes))
+	require.NoError(t, r.collectDeletes(tombstone, changes))
+	require.NoError(t, r.apply(context.Background(), changes))
+
+	sortRows := func(rows [][]any) {
+		sort.Slice(rows, func(i, j int) bool { return rows[i][0].(int64) < rows[j][0].(int64) })
+	}
+	sortRows(sinker.rows)
+	sortRows(sinker.keys)
+	assert.Equal(t, [][]any{{int64(1), "y"}, {int64(3), "w"}}, sinker.rows)
+	assert.Equal(t, [][]any{{int64(2)}, {int64(4)}}, sinker.keys)
+}
+
+func TestTableReaderMissingPrimaryKey(t *testing.T) {
+	mp := mpool.MustNewZero()
+	data := batch.New(false, []string{"b", "ts"})
+	data.Vecs[0] = vector.NewVec(types.T_varchar.ToType())
+	data.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	defer data.Clean(mp)
+
+	r := newTestReader(&recordSinker{})
+	require.Error(t, r.collectInserts(data, make(map[string]*change)))
+}
+
+func TestTableReaderCompositePrimaryKey(t *testing.T) {
+	mp := mpool.MustNewZero()
+	r := newTestReader(&recordSinker{})
+	r.cpkey = true
+	r.pkTypes = []types.Type{types.T_int64.ToType(), types.T_varchar.ToType()}
+
+	packer := types.NewPacker()
+	defer packer.Close()
+	packer.EncodeInt64(7)
+	packer.EncodeStringType([]byte("k"))
+
+	vec := vector.NewVec(types.T_varchar.ToType())
+	defer vec.Free(mp)
+	require.NoError(t, vector.AppendBytes(vec, packer.GetBuf(), false, mp))
+	key, err := r.primaryKey(vec, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []any{int64(7), "k"}, key)
+
+	// a key of another number of columns is rejected
+	r.pkTypes = r.pkTypes[:1]
+	_, err = r.primaryKey(vec, 0)
+	require.Error(t, err)
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	calls := 0
+	require.NoError(t, retry(ctx, 3, func() error {
+		calls++
+		return nil
+	}))
+	assert.Equal(t, 1, calls)
+
+	calls = 0
+	err := retry(ctx, 0, func() error {
+		calls++
+		return moerr.NewInternalErrorNoCtx("failed")
+	})
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+
+	// a canceled context stops the retries
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	calls = 0
+	err = retry(canceled, 3, func() error {
+		calls++
+		return moerr.NewInternalErrorNoCtx("failed")
+	})
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestLimiter(t *testing.T) {
+	l := newLimiter(1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := l.run(ctx, func() error {
+		// the limiter is full, a canceled caller gives up waiting
+		cancel()
+		return l.run(ctx, func() error {
+			t.Fatal("should not run")
+			return nil
+		})
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
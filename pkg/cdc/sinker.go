@@ -0,0 +1,359 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// maxSQLSize is the soft limit of the statements sent to the mysql sink.
+const maxSQLSize = 4 << 20
+
+// Column is a column replicated to the sink.
+type Column struct {
+	Name string
+	Type types.Type
+}
+
+// TableDef is the definition of a sink table. The values of the rows and
+// keys passed to a Sinker follow the order of Columns and PkNames.
+type TableDef struct {
+	Database string
+	Table    string
+	Columns  []Column
+	PkNames  []string
+}
+
+// Sinker applies the changes of a table to the downstream.
+type Sinker interface {
+	// Prepare creates the sink table if it does not exist.
+	Prepare(ctx context.Context) error
+	// Truncate removes all the rows of the sink table.
+	Truncate(ctx context.Context) error
+	// Upsert inserts the rows, replacing the existing ones with the same
+	// primary key.
+	Upsert(ctx context.Context, rows [][]any) error
+	// Delete removes the rows with the given primary keys.
+	Delete(ctx context.Context, keys [][]any) error
+}
+
+// Downstream is the connection to the sink, shared by all the tables of a
+// cdc task.
+type Downstream interface {
+	Sinker(def *TableDef) Sinker
+	Close() error
+}
+
+// NewDownstream connects to the sink described by the sink type and uri.
+func NewDownstream(ctx context.Context, sinkType string, uri string) (Downstream, error) {
+	info, err := ParseUri(ctx, sinkType, uri)
+	if err != nil {
+		return nil, err
+	}
+	switch sinkType {
+	case SinkTypeFile:
+		if err = os.MkdirAll(info.Path, 0755); err != nil {
+			return nil, err
+		}
+		return &fileDownstream{dir: info.Path}, nil
+	default:
+		cfg := mysql.NewConfig()
+		cfg.User = info.User
+		cfg.Passwd = info.Password
+		cfg.Net = "tcp"
+		cfg.Addr = net.JoinHostPort(info.Host, strconv.Itoa(info.Port))
+		cfg.Timeout = 10 * time.Second
+		cfg.Params = map[string]string{"time_zone": "'+00:00'"}
+		db, err := sql.Open("mysql", cfg.FormatDSN())
+		if err != nil {
+			return nil, err
+		}
+		if err = db.PingContext(ctx); err != nil {
+			_ = db.Close()
+			return nil, moerr.NewInternalError(ctx, "connect to sink %s: %v", MaskUri(uri), err)
+		}
+		return &mysqlDownstream{db: db, sinkType: sinkType}, nil
+	}
+}
+
+type mysqlDownstream struct {
+	db       *sql.DB
+	sinkType string
+}
+
+func (d *mysqlDownstream) Sinker(def *TableDef) Sinker {
+	return &mysqlSinker{db: d.db, def: def, sinkType: d.sinkType}
+}
+
+func (d *mysqlDownstream) Close() error {
+	return d.db.Close()
+}
+
+type mysqlSinker struct {
+	db       *sql.DB
+	def      *TableDef
+	sinkType string
+}
+
+func (s *mysqlSinker) tableName() string {
+	return quoteName(s.def.Database) + "." + quoteName(s.def.Table)
+}
+
+func (s *mysqlSinker) Prepare(ctx context.Context) error {
+	for _, stmt := range s.createTableSQLs() {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *mysqlSinker) createTableSQLs() []string {
+	var buf strings.Builder
+	buf.WriteString("CREATE TABLE IF NOT EXISTS ")
+	buf.WriteString(s.tableName())
+	buf.WriteString(" (")
+	for i, col := range s.def.Columns {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(quoteName(col.Name))
+		buf.WriteString(" ")
+		buf.WriteString(sinkTypeString(col.Type, s.sinkType))
+	}
+	buf.WriteString(", PRIMARY KEY (")
+	for i, name := range s.def.PkNames {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(quoteName(name))
+	}
+	buf.WriteString("))")
+	return []string{
+		"CREATE DATABASE IF NOT EXISTS " + quoteName(s.def.Database),
+		buf.String(),
+	}
+}
+
+func (s *mysqlSinker) Truncate(ctx context.Context) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM "+s.tableName())
+	return err
+}
+
+func (s *mysqlSinker) Upsert(ctx context.Context, rows [][]any) error {
+	for _, stmt := range s.upsertSQLs(rows) {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *mysqlSinker) upsertSQLs(rows [][]any) []string {
+	var prefix strings.Builder
+	prefix.WriteString("REPLACE INTO ")
+	prefix.WriteString(s.tableName())
+	prefix.WriteString(" (")
+	for i, col := range s.def.Columns {
+		if i > 0 {
+			prefix.WriteString(", ")
+		}
+		prefix.WriteString(quoteName(col.Name))
+	}
+	prefix.WriteString(") VALUES ")
+	return buildSQLs(prefix.String(), rows)
+}
+
+func (s *mysqlSinker) Delete(ctx context.Context, keys [][]any) error {
+	for _, stmt := range s.deleteSQLs(keys) {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *mysqlSinker) deleteSQLs(keys [][]any) []string {
+	var prefix strings.Builder
+	prefix.WriteString("DELETE FROM ")
+	prefix.WriteString(s.tableName())
+	prefix.WriteString(" WHERE (")
+	for i, name := range s.def.PkNames {
+		if i > 0 {
+			prefix.WriteString(", ")
+		}
+		prefix.WriteString(quoteName(name))
+	}
+	prefix.WriteString(") IN (")
+	stmts := buildSQLs(prefix.String(), keys)
+	for i := range stmts {
+		stmts[i] += ")"
+	}
+	return stmts
+}
+
+// buildSQLs appends the tuples to the prefix, splitting them into several
+// statements when one grows beyond maxSQLSize.
+func buildSQLs(prefix string, tuples [][]any) []string {
+	var (
+		stmts []string
+		buf   strings.Builder
+	)
+	for _, tuple := range tuples {
+		if buf.Len() == 0 {
+			buf.WriteString(prefix)
+		} else {
+			buf.WriteString(", ")
+		}
+		buf.WriteByte('(')
+		for i, v := range tuple {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			appendSQLValue(&buf, v)
+		}
+		buf.WriteByte(')')
+		if buf.Len() >= maxSQLSize {
+			stmts = append(stmts, buf.String())
+			buf.Reset()
+		}
+	}
+	if buf.Len() > 0 {
+		stmts = append(stmts, buf.String())
+	}
+	return stmts
+}
+
+// sinkTypeString returns the column type used to create the sink table.
+func sinkTypeString(typ types.Type, sinkType string) string {
+	switch typ.Oid {
+	case types.T_time, types.T_datetime, types.T_timestamp:
+		if typ.Scale > 0 {
+			return fmt.Sprintf("%s(%d)", typ.Oid.String(), typ.Scale)
+		}
+		return typ.Oid.String()
+	case types.T_enum:
+		return "SMALLINT UNSIGNED"
+	case types.T_datalink:
+		return "TEXT"
+	case types.T_uuid:
+		if sinkType == SinkTypeMySQL {
+			return "CHAR(36)"
+		}
+	case types.T_array_float32, types.T_array_float64:
+		if sinkType == SinkTypeMySQL {
+			return "TEXT"
+		}
+		if typ.Oid == types.T_array_float32 {
+			return fmt.Sprintf("VECF32(%d)", typ.Width)
+		}
+		return fmt.Sprintf("VECF64(%d)", typ.Width)
+	}
+	return typ.DescString()
+}
+
+type fileDownstream struct {
+	dir string
+	mu  sync.Mutex
+}
+
+func (d *fileDownstream) Sinker(def *TableDef) Sinker {
+	return &fileSinker{
+		downstream: d,
+		def:        def,
+		path:       filepath.Join(d.dir, def.Database+"."+def.Table+".jsonl"),
+	}
+}
+
+func (d *fileDownstream) Close() error {
+	return nil
+}
+
+// fileSinker appends the changes of a table to a local file as JSON lines,
+// it is mainly used for testing.
+type fileSinker struct {
+	downstream *fileDownstream
+	def        *TableDef
+	path       string
+}
+
+type fileRecord struct {
+	Op   string         `json:"op"`
+	Data map[string]any `json:"data,omitempty"`
+}
+
+func (s *fileSinker) write(records []fileRecord) error {
+	s.downstream.mu.Lock()
+	defer s.downstream.mu.Unlock()
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(f)
+	for _, record := range records {
+		if err = enc.Encode(record); err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+	return f.Close()
+}
+
+func (s *fileSinker) Prepare(ctx context.Context) error {
+	return nil
+}
+
+func (s *fileSinker) Truncate(ctx context.Context) error {
+	return s.write([]fileRecord{{Op: "truncate"}})
+}
+
+func (s *fileSinker) Upsert(ctx context.Context, rows [][]any) error {
+	records := make([]fileRecord, 0, len(rows))
+	for _, row := range rows {
+		data := make(map[string]any, len(row))
+		for i, v := range row {
+			data[s.def.Columns[i].Name] = v
+		}
+		records = append(records, fileRecord{Op: "upsert", Data: data})
+	}
+	return s.write(records)
+}
+
+func (s *fileSinker) Delete(ctx context.Context, keys [][]any) error {
+	records := make([]fileRecord, 0, len(keys))
+	for _, key := range keys {
+		data := make(map[string]any, len(key))
+		for i, v := range key {
+			data[s.def.PkNames[i]] = v
+		}
+		records = append(records, fileRecord{Op: "delete", Data: data})
+	}
+	return s.write(records)
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+func newTestTableDef() *TableDef {
+	return &TableDef{
+		Database: "db",
+		Table:    "t",
+		Columns: []Column{
+			{Name: "a", Type: types.T_int64.ToType()},
+			{Name: "b", Type: types.T_varchar.ToType()},
+		},
+		PkNames: []string{"a"},
+	}
+}
+
+func TestMySQLSinker(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+
+	ctx := context.Background()
+	s := (&mysqlDownstream{db: db, sinkType: SinkTypeMySQL}).Sinker(newTestTableDef())
+
+	mock.ExpectExec("CREATE DATABASE IF NOT EXISTS `db`").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS `db`.`t` (`a` BIGINT, `b` VARCHAR(65535), PRIMARY KEY (`a`))").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	require.NoError(t, s.Prepare(ctx))
+
+	mock.ExpectExec("REPLACE INTO `db`.`t` (`a`, `b`) VALUES (1, 'x'), (2, NULL), (3, 'it\\'s')").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	require.NoError(t, s.Upsert(ctx, [][]any{{int64(1), "x"}, {int64(2), nil}, {int64(3), "it's"}}))
+
+	mock.ExpectExec("DELETE FROM `db`.`t` WHERE (`a`) IN ((1), (2))").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	require.NoError(t, s.Delete(ctx, [][]any{{int64(1)}, {int64(2)}}))
+
+	mock.ExpectExec("DELETE FROM `db`.`t`").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, s.Truncate(ctx))
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBuildSQLs(t *testing.T) {
+	rows := make([][]any, 0, 100)
+	value := strings.Repeat("x", maxSQLSize/50)
+	for i := 0; i < 100; i++ {
+		rows = append(rows, []any{int64(i), value})
+	}
+	stmts := buildSQLs("REPLACE INTO t VALUES ", rows)
+	require.Equal(t, 2, len(stmts))
+	for _, stmt := range stmts {
+		assert.True(t, strings.HasPrefix(stmt, "REPLACE INTO t VALUES ("))
+	}
+}
+
+func TestFileSinker(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	d, err := NewDownstream(ctx, SinkTypeFile, "file://"+dir)
+	require.NoError(t, err)
+	defer d.Close()
+
+	s := d.Sinker(newTestTableDef())
+	require.NoError(t, s.Prepare(ctx))
+	require.NoError(t, s.Upsert(ctx, [][]any{{int64(1), "x"}}))
+	require.NoError(t, s.Delete(ctx, [][]any{{int64(1)}}))
+	require.NoError(t, s.Truncate(ctx))
+
+	data, err := os.ReadFile(filepath.Join(dir, "db.t.jsonl"))
+	require.NoError(t, err)
+	assert.Equal(t,
+		`{"op":"upsert","data":{"a":1,"b":"x"}}`+"\n"+
+			`{"op":"delete","data":{"a":1}}`+"\n"+
+			`{"op":"truncate"}`+"\n",
+		string(data))
+}
+
+func TestExtractValue(t *testing.T) {
+	mp := mpool.MustNewZero()
+
+	vec := vector.NewVec(types.New(types.T_decimal64, 10, 2))
+	require.NoError(t, vector.AppendFixed(vec, types.Decimal64(12345), false, mp))
+	require.NoError(t, vector.AppendFixed(vec, types.Decimal64(0), true, mp))
+	v, err := extractValue(vec, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "123.45", v)
+	v, err = extractValue(vec, 1)
+	require.NoError(t, err)
+	assert.Nil(t, v)
+	vec.Free(mp)
+
+	vec = vector.NewVec(types.T_varbinary.ToType())
+	require.NoError(t, vector.AppendBytes(vec, []byte{0x1, 0x2}, false, mp))
+	v, err = extractValue(vec, 0)
+	require.NoError(t, err)
+	var buf strings.Builder
+	appendSQLValue(&buf, v)
+	assert.Equal(t, "x'0102'", buf.String())
+	vec.Free(mp)
+
+	assert.Equal(t, int64(3), extractTupleValue(int32(3), types.T_int32.ToType()))
+	assert.Equal(t, "abc", extractTupleValue([]byte("abc"), types.T_varchar.ToType()))
+}
@@ -0,0 +1,314 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const defaultPollInterval = time.Second
+
+const getTablesFmt = "select relname from `%s`.`%s` where account_id = %d and reldatabase = '%s' and relkind = '%s'"
+
+// CdcTaskExecutorFactory returns the executor of the cdc daemon tasks.
+func CdcTaskExecutorFactory(
+	logger *zap.Logger,
+	ts taskservice.TaskService,
+	exec executor.SQLExecutor,
+	eng engine.Engine,
+	attachToTask func(context.Context, uint64, taskservice.ActiveRoutine) error,
+) func(context.Context, task.Task) error {
+	return func(ctx context.Context, t task.Task) error {
+		ctx1, cancel := context.WithTimeout(context.Background(), time.Second*3)
+		defer cancel()
+		tasks, err := ts.QueryDaemonTask(ctx1,
+			taskservice.WithTaskIDCond(taskservice.EQ, t.GetID()),
+		)
+		if err != nil {
+			return err
+		}
+		if len(tasks) != 1 {
+			return moerr.NewInternalError(ctx, "invalid tasks count %d", len(tasks))
+		}
+		details, ok := tasks[0].Details.Details.(*task.Details_CreateCdc)
+		if !ok {
+			return moerr.NewInternalError(ctx, "invalid details type")
+		}
+
+		cdc, err := NewTask(ctx, logger, exec, eng, tasks[0].Details.AccountID, details.CreateCdc)
+		if err != nil {
+			return err
+		}
+		defer cdc.Close()
+		if err := attachToTask(ctx, t.GetID(), cdc); err != nil {
+			return err
+		}
+		// Start the cdc task and hangs here.
+		return cdc.Start(ctx)
+	}
+}
+
+// Task replicates the tables of a CREATE CDC statement to the sink. Every
+// table is replicated by its own reader, pausing the task stops all the
+// readers and resuming it starts them again from the saved watermarks.
+type Task struct {
+	logger     *zap.Logger
+	exec       executor.SQLExecutor
+	eng        engine.Engine
+	mp         *mpool.MPool
+	accountID  uint32
+	details    *task.CreateCdcDetails
+	mappings   []TableMapping
+	downstream Downstream
+	watermarks *watermarkStore
+
+	startTS             types.TS
+	endTS               types.TS
+	noFull              bool
+	fullLimit           limiter
+	incrementalLimit    limiter
+	ddlRetry            int
+	fullDMLRetry        int
+	incrementalDMLRetry int
+	pollInterval        time.Duration
+
+	resumeC chan struct{}
+	pauseC  chan struct{}
+	cancelC chan struct{}
+
+	cancelOnce sync.Once
+}
+
+var _ taskservice.ActiveRoutine = new(Task)
+
+// NewTask creates a cdc task from its definition.
+func NewTask(
+	ctx context.Context,
+	logger *zap.Logger,
+	exec executor.SQLExecutor,
+	eng engine.Engine,
+	accountID uint32,
+	details *task.CreateCdcDetails,
+) (*Task, error) {
+	mappings, err := ParseTables(ctx, details.Tables)
+	if err != nil {
+		return nil, err
+	}
+	opts := details.Options
+	mp, err := mpool.NewMPool("cdc_task", 0, mpool.NoFixed)
+	if err != nil {
+		return nil, err
+	}
+	noFull, _ := strconv.ParseBool(opts[OptNoFull])
+	t := &Task{
+		logger: logger.With(
+			zap.String("cdc-task-id", details.TaskId),
+			zap.String("cdc-task-name", details.TaskName)),
+		exec:      exec,
+		eng:       eng,
+		mp:        mp,
+		accountID: accountID,
+		details:   details,
+		mappings:  mappings,
+		watermarks: &watermarkStore{
+			exec:      exec,
+			accountID: accountID,
+			taskID:    details.TaskId,
+		},
+		startTS:             getTS(opts, OptStartTS),
+		endTS:               getTS(opts, OptEndTS),
+		noFull:              noFull,
+		fullLimit:           newLimiter(getInt(opts, OptFullConcurrency, defaultConcurrency)),
+		incrementalLimit:    newLimiter(getInt(opts, OptIncrementalConcurrency, defaultConcurrency)),
+		ddlRetry:            getInt(opts, OptFullDDLRetry, defaultRetryTimes),
+		fullDMLRetry:        getInt(opts, OptFullDMLRetry, defaultRetryTimes),
+		incrementalDMLRetry: getInt(opts, OptIncrementalDMLRetry, defaultRetryTimes),
+		pollInterval:        defaultPollInterval,
+		resumeC:             make(chan struct{}, 1),
+		pauseC:              make(chan struct{}, 1),
+		cancelC:             make(chan struct{}),
+	}
+	return t, nil
+}
+
+// Start runs the task until it is canceled, all the tables reach the end
+// timestamp, or a table fails.
+func (t *Task) Start(ctx context.Context) (err error) {
+	t.logger.Info("cdc task start")
+	defer func() {
+		t.logger.Info("cdc task stop", zap.Error(err))
+	}()
+
+	if t.downstream, err = NewDownstream(ctx, t.details.SinkType, t.details.SinkUri); err != nil {
+		return err
+	}
+
+	for {
+		stop, done := t.startReaders(ctx)
+		select {
+		case <-ctx.Done():
+			stop()
+			return nil
+		case <-t.cancelC:
+			stop()
+			return nil
+		case <-t.resumeC:
+			// resume a running task restarts the readers from the
+			// saved watermarks.
+			stop()
+		case err = <-done:
+			stop()
+			return err
+		case <-t.pauseC:
+			stop()
+			t.logger.Info("cdc task paused")
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.cancelC:
+				return nil
+			case <-t.resumeC:
+				t.logger.Info("cdc task resumed")
+			}
+		}
+	}
+}
+
+// startReaders starts the readers of all the tables. The returned channel
+// receives nil when all of them finish, or the first error.
+func (t *Task) startReaders(ctx context.Context) (stop func(), done <-chan error) {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	errC := make(chan error, 1)
+	stop = func() {
+		cancel()
+		wg.Wait()
+	}
+
+	mappings, err := t.resolveTables(ctx)
+	if err != nil {
+		errC <- err
+		return stop, errC
+	}
+
+	for _, m := range mappings {
+		r := &tableReader{task: t, mapping: m}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := r.run(ctx); err != nil {
+				r.logger().Error("cdc table failed", zap.Error(err))
+				select {
+				case errC <- err:
+				default:
+				}
+				// stop the other tables
+				cancel()
+			}
+		}()
+	}
+
+	resC := make(chan error, 1)
+	go func() {
+		wg.Wait()
+		select {
+		case err := <-errC:
+			resC <- err
+		default:
+			resC <- nil
+		}
+	}()
+	return stop, resC
+}
+
+// resolveTables expands the "db.*" mappings to the tables of the database.
+func (t *Task) resolveTables(ctx context.Context) ([]TableMapping, error) {
+	var res []TableMapping
+	for _, m := range t.mappings {
+		if m.SourceTable != "*" {
+			res = append(res, m)
+			continue
+		}
+		sql := fmt.Sprintf(getTablesFmt,
+			catalog.MO_CATALOG, catalog.MO_TABLES, t.accountID, escape(m.SourceDatabase), catalog.SystemOrdinaryRel)
+		ret, err := t.exec.Exec(ctx, sql, executor.Options{}.WithAccountID(t.accountID))
+		if err != nil {
+			return nil, err
+		}
+		ret.ReadRows(func(rows int, cols []*vector.Vector) bool {
+			for i := 0; i < rows; i++ {
+				table := cols[0].GetStringAt(i)
+				res = append(res, TableMapping{
+					SourceDatabase: m.SourceDatabase,
+					SourceTable:    table,
+					SinkDatabase:   m.SinkDatabase,
+					SinkTable:      table,
+				})
+			}
+			return true
+		})
+		ret.Close()
+	}
+	return res, nil
+}
+
+// Resume implements the taskservice.ActiveRoutine interface.
+func (t *Task) Resume() error {
+	select {
+	case t.resumeC <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Pause implements the taskservice.ActiveRoutine interface.
+func (t *Task) Pause() error {
+	select {
+	case t.pauseC <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Cancel implements the taskservice.ActiveRoutine interface.
+func (t *Task) Cancel() error {
+	t.cancelOnce.Do(func() {
+		close(t.cancelC)
+	})
+	return nil
+}
+
+func (t *Task) Close() {
+	if t.downstream != nil {
+		_ = t.downstream.Close()
+	}
+	mpool.DeleteMPool(t.mp)
+}
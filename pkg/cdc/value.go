@@ -0,0 +1,178 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// extractValue converts the i-th value of the vector to a value the sinks
+// understand: nil, bool, int64, uint64, float64, string or []byte. Temporal
+// and decimal values are converted to their string forms, timestamps are
+// in UTC.
+func extractValue(vec *vector.Vector, i int) (any, error) {
+	if vec.IsNull(uint64(i)) {
+		return nil, nil
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		return vector.GetFixedAt[bool](vec, i), nil
+	case types.T_bit:
+		return vector.GetFixedAt[uint64](vec, i), nil
+	case types.T_int8:
+		return int64(vector.GetFixedAt[int8](vec, i)), nil
+	case types.T_int16:
+		return int64(vector.GetFixedAt[int16](vec, i)), nil
+	case types.T_int32:
+		return int64(vector.GetFixedAt[int32](vec, i)), nil
+	case types.T_int64:
+		return vector.GetFixedAt[int64](vec, i), nil
+	case types.T_uint8:
+		return uint64(vector.GetFixedAt[uint8](vec, i)), nil
+	case types.T_uint16:
+		return uint64(vector.GetFixedAt[uint16](vec, i)), nil
+	case types.T_uint32:
+		return uint64(vector.GetFixedAt[uint32](vec, i)), nil
+	case types.T_uint64:
+		return vector.GetFixedAt[uint64](vec, i), nil
+	case types.T_float32:
+		return float64(vector.GetFixedAt[float32](vec, i)), nil
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, i), nil
+	case types.T_decimal64:
+		return vector.GetFixedAt[types.Decimal64](vec, i).Format(typ.Scale), nil
+	case types.T_decimal128:
+		return vector.GetFixedAt[types.Decimal128](vec, i).Format(typ.Scale), nil
+	case types.T_date:
+		return vector.GetFixedAt[types.Date](vec, i).String(), nil
+	case types.T_time:
+		return vector.GetFixedAt[types.Time](vec, i).String2(typ.Scale), nil
+	case types.T_datetime:
+		return vector.GetFixedAt[types.Datetime](vec, i).String2(typ.Scale), nil
+	case types.T_timestamp:
+		return vector.GetFixedAt[types.Timestamp](vec, i).String2(time.UTC, typ.Scale), nil
+	case types.T_enum:
+		return uint64(vector.GetFixedAt[types.Enum](vec, i)), nil
+	case types.T_uuid:
+		return vector.GetFixedAt[types.Uuid](vec, i).String(), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink:
+		return vec.GetStringAt(i), nil
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return append([]byte(nil), vec.GetBytesAt(i)...), nil
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytesAt(i)).String(), nil
+	case types.T_array_float32:
+		return types.BytesToArrayToString[float32](vec.GetBytesAt(i)), nil
+	case types.T_array_float64:
+		return types.BytesToArrayToString[float64](vec.GetBytesAt(i)), nil
+	}
+	return nil, moerr.NewNotSupportedNoCtx("cdc of type %s", typ.String())
+}
+
+// extractTupleValue is the counterpart of extractValue for the values
+// unpacked from a composite primary key.
+func extractTupleValue(v any, typ types.Type) any {
+	switch x := v.(type) {
+	case int8:
+		return int64(x)
+	case int16:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case uint8:
+		return uint64(x)
+	case uint16:
+		return uint64(x)
+	case uint32:
+		return uint64(x)
+	case float32:
+		return float64(x)
+	case types.Decimal64:
+		return x.Format(typ.Scale)
+	case types.Decimal128:
+		return x.Format(typ.Scale)
+	case types.Date:
+		return x.String()
+	case types.Time:
+		return x.String2(typ.Scale)
+	case types.Datetime:
+		return x.String2(typ.Scale)
+	case types.Timestamp:
+		return x.String2(time.UTC, typ.Scale)
+	case types.Enum:
+		return uint64(x)
+	case types.Uuid:
+		return x.String()
+	case []byte:
+		switch typ.Oid {
+		case types.T_binary, types.T_varbinary, types.T_blob:
+			return x
+		}
+		return string(x)
+	}
+	return v
+}
+
+// appendSQLValue appends the value as a SQL literal.
+func appendSQLValue(buf *strings.Builder, v any) {
+	switch x := v.(type) {
+	case nil:
+		buf.WriteString("NULL")
+	case bool:
+		buf.WriteString(strconv.FormatBool(x))
+	case int64:
+		buf.WriteString(strconv.FormatInt(x, 10))
+	case uint64:
+		buf.WriteString(strconv.FormatUint(x, 10))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(x, 'g', -1, 64))
+	case []byte:
+		buf.WriteString("x'")
+		buf.WriteString(hex.EncodeToString(x))
+		buf.WriteString("'")
+	case string:
+		buf.WriteByte('\'')
+		for i := 0; i < len(x); i++ {
+			switch c := x[i]; c {
+			case '\'', '\\':
+				buf.WriteByte('\\')
+				buf.WriteByte(c)
+			case 0:
+				buf.WriteString(`\0`)
+			case '\n':
+				buf.WriteString(`\n`)
+			case '\r':
+				buf.WriteString(`\r`)
+			case 0x1a:
+				buf.WriteString(`\Z`)
+			default:
+				buf.WriteByte(c)
+			}
+		}
+		buf.WriteByte('\'')
+	}
+}
+
+func quoteName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+)
+
+const (
+	getWatermarkFmt = "select watermark from `%s`.`%s` where account_id = %d and task_id = '%s' and db_name = '%s' and table_name = '%s'"
+
+	updateWatermarkFmt = "insert into `%s`.`%s` (account_id, task_id, db_name, table_name, watermark) values (%d, '%s', '%s', '%s', '%s') " +
+		"on duplicate key update watermark = '%s'"
+
+	deleteWatermarkFmt = "delete from `%s`.`%s` where account_id = %d and task_id = '%s'"
+)
+
+// DeleteWatermarkSQL returns the sql to delete all the watermarks of a cdc
+// task.
+func DeleteWatermarkSQL(accountID uint32, taskID string) string {
+	return fmt.Sprintf(deleteWatermarkFmt,
+		catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK, accountID, escape(taskID))
+}
+
+// watermarkStore persists the watermark of every table of a cdc task. All
+// the changes committed at or before the watermark of a table have been
+// applied to the sink.
+type watermarkStore struct {
+	exec      executor.SQLExecutor
+	accountID uint32
+	taskID    string
+}
+
+func (w *watermarkStore) opts() executor.Options {
+	return executor.Options{}.
+		WithAccountID(catalog.System_Account).
+		WithDatabase(catalog.MO_CATALOG)
+}
+
+// Get returns the watermark of the table, the bool result is false if the
+// table has no watermark yet.
+func (w *watermarkStore) Get(ctx context.Context, db, table string) (types.TS, bool, error) {
+	sql := fmt.Sprintf(getWatermarkFmt,
+		catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK,
+		w.accountID, escape(w.taskID), escape(db), escape(table))
+	res, err := w.exec.Exec(ctx, sql, w.opts())
+	if err != nil {
+		return types.TS{}, false, err
+	}
+	defer res.Close()
+
+	var value string
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		value = cols[0].GetStringAt(0)
+		return false
+	})
+	if value == "" {
+		return types.TS{}, false, nil
+	}
+	ts, err := decodeWatermark(value)
+	if err != nil {
+		return types.TS{}, false, err
+	}
+	return ts, true, nil
+}
+
+// Update saves the watermark of the table.
+func (w *watermarkStore) Update(ctx context.Context, db, table string, ts types.TS) error {
+	value := ts.ToString()
+	sql := fmt.Sprintf(updateWatermarkFmt,
+		catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK,
+		w.accountID, escape(w.taskID), escape(db), escape(table), value, value)
+	res, err := w.exec.Exec(ctx, sql, w.opts())
+	if err != nil {
+		return err
+	}
+	res.Close()
+	return nil
+}
+
+func decodeWatermark(s string) (types.TS, error) {
+	physical, logical, ok := strings.Cut(s, "-")
+	if !ok {
+		return types.TS{}, moerr.NewInternalErrorNoCtx("invalid watermark %s", s)
+	}
+	p, err := strconv.ParseInt(physical, 10, 64)
+	if err != nil {
+		return types.TS{}, moerr.NewInternalErrorNoCtx("invalid watermark %s", s)
+	}
+	l, err := strconv.ParseUint(logical, 10, 32)
+	if err != nil {
+		return types.TS{}, moerr.NewInternalErrorNoCtx("invalid watermark %s", s)
+	}
+	return types.BuildTS(p, uint32(l)), nil
+}
+
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s)
+}
@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/cdc"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
@@ -272,6 +273,9 @@ func (s *service) registerExecutorsLocked() {
 	// streaming connector task
 	s.task.runner.RegisterExecutor(task.TaskCode_ConnectorKafkaSink,
 		moconnector.KafkaSinkConnectorExecutor(s.logger, ts, ieFactory, s.task.runner.Attach))
+	// cdc task
+	s.task.runner.RegisterExecutor(task.TaskCode_InitCdc,
+		cdc.CdcTaskExecutorFactory(s.logger, ts, s.sqlExecutor, s.storeEngine, s.task.runner.Attach))
 	s.task.runner.RegisterExecutor(task.TaskCode_MergeObject,
 		func(ctx context.Context, task task.Task) error {
 			metadata := task.GetMetadata()
@@ -64,6 +64,8 @@ const (
 	ErrOOM              uint16 = 20103
 	ErrQueryInterrupted uint16 = 20104
 	ErrNotSupported     uint16 = 20105
+	ErrQueryTimeout     uint16 = 20106
+	ErrQueryMemoryLimit uint16 = 20107
 
 	// Group 2: numeric and functions
 	ErrDivByZero                   uint16 = 20200
@@ -163,6 +165,7 @@ const (
 	ErrBlobCantHaveDefault                      uint16 = 20472
 	ErrCantCompileForPrepare                    uint16 = 20473
 	ErrTableMustHaveAVisibleColumn              uint16 = 20474
+	ErrKeyDoesNotExist                          uint16 = 20475
 
 	// Group 5: rpc timeout
 	// ErrRPCTimeout rpc timeout
@@ -313,6 +316,8 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrOOM:              {ER_ENGINE_OUT_OF_MEMORY, []string{MySQLDefaultSqlState}, "error: out of memory"},
 	ErrQueryInterrupted: {ER_QUERY_INTERRUPTED, []string{MySQLDefaultSqlState}, "query interrupted"},
 	ErrNotSupported:     {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "not supported: %s"},
+	ErrQueryTimeout:     {ER_QUERY_TIMEOUT, []string{MySQLDefaultSqlState}, "Query execution was interrupted, maximum statement execution time exceeded"},
+	ErrQueryMemoryLimit: {ER_CAPACITY_EXCEEDED, []string{MySQLDefaultSqlState}, "Query execution was interrupted, memory quota of %d bytes exceeded"},
 
 	// Group 2: numeric
 	ErrDivByZero:                   {ER_DIVISION_BY_ZERO, []string{MySQLDefaultSqlState}, "division by zero"},
@@ -410,6 +415,7 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrFKNoReferencedRow2:                       {ER_NO_REFERENCED_ROW_2, []string{"23000"}, "Cannot add or update a child row: a foreign key constraint fails"},
 	ErrBlobCantHaveDefault:                      {ER_BLOB_CANT_HAVE_DEFAULT, []string{MySQLDefaultSqlState}, "BLOB, TEXT, GEOMETRY or JSON column '%-.192s' can't have a default value"},
 	ErrTableMustHaveAVisibleColumn:              {ER_TABLE_MUST_HAVE_A_VISIBLE_COLUMN, []string{MySQLDefaultSqlState}, "A table must have at least one visible column."},
+	ErrKeyDoesNotExist:                          {ER_KEY_DOES_NOT_EXIST, []string{"42000"}, "Key '%-.192s' doesn't exist in table '%-.192s'"},
 
 	// Group 5: rpc timeout
 	ErrRPCTimeout:   {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "rpc timeout"},
@@ -767,6 +773,14 @@ func NewQueryInterrupted(ctx context.Context) *Error {
 	return newError(ctx, ErrQueryInterrupted)
 }
 
+func NewQueryTimeout(ctx context.Context) *Error {
+	return newError(ctx, ErrQueryTimeout)
+}
+
+func NewQueryMemoryLimit(ctx context.Context, quota int64) *Error {
+	return newError(ctx, ErrQueryMemoryLimit, quota)
+}
+
 func NewDivByZero(ctx context.Context) *Error {
 	return newError(ctx, ErrDivByZero)
 }
@@ -1295,6 +1309,10 @@ func NewErrKeyColumnDoesNotExist(ctx context.Context, k any) *Error {
 	return newError(ctx, ErrKeyColumnDoesNotExist, k)
 }
 
+func NewErrKeyDoesNotExist(ctx context.Context, k, t any) *Error {
+	return newError(ctx, ErrKeyDoesNotExist, k, t)
+}
+
 func NewErrCantDropFieldOrKey(ctx context.Context, k any) *Error {
 	return newError(ctx, ErrCantDropFieldOrKey, k)
 }
@@ -46,6 +46,14 @@ func NewOOMNoCtx() *Error {
 	return newError(Context(), ErrOOM)
 }
 
+func NewQueryTimeoutNoCtx() *Error {
+	return newError(Context(), ErrQueryTimeout)
+}
+
+func NewQueryMemoryLimitNoCtx(quota int64) *Error {
+	return newError(Context(), ErrQueryMemoryLimit, quota)
+}
+
 func NewDivByZeroNoCtx() *Error {
 	return newError(Context(), ErrDivByZero)
 }
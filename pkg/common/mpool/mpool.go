@@ -330,11 +330,22 @@ type MPool struct {
 	inUseCount int32 // number of in use call
 	pools      [NumFixedPool]fixedPool
 	details    *mpoolDetails
+	quota      mpoolQuota
 
 	// To remove: this thing is highly unlikely to be of any good use.
 	sels *sync.Pool
 }
 
+// mpoolQuota limits the bytes held by the running query of the pool.
+// The bytes held before the quota was set are not counted.
+type mpoolQuota struct {
+	limit atomic.Int64 // 0 means no limit
+	base  atomic.Int64 // current bytes of the pool when the quota was set
+	peak  atomic.Int64 // max bytes held since the quota was set
+	// exceeded is set once an allocation was refused by the limit.
+	exceeded atomic.Bool
+}
+
 const (
 	NoFixed = 1
 	NoLock  = 2
@@ -374,6 +385,60 @@ func (mp *MPool) Cap() int64 {
 	return mp.cap
 }
 
+// QuotaState is the quota of a pool replaced by SetQuota.
+type QuotaState struct {
+	limit    int64
+	base     int64
+	peak     int64
+	exceeded bool
+}
+
+// SetQuota starts counting the memory of a new query, and limits it to
+// quota bytes. Zero means no limit. It returns the quota it replaces, which
+// is put back by RestoreQuota once the query is done.
+func (mp *MPool) SetQuota(quota int64) QuotaState {
+	st := QuotaState{
+		limit:    mp.quota.limit.Load(),
+		base:     mp.quota.base.Load(),
+		peak:     mp.quota.peak.Load(),
+		exceeded: mp.quota.exceeded.Load(),
+	}
+	mp.quota.limit.Store(quota)
+	mp.quota.base.Store(mp.stats.NumCurrBytes.Load())
+	mp.quota.peak.Store(0)
+	mp.quota.exceeded.Store(false)
+	return st
+}
+
+// RestoreQuota puts back the quota replaced by SetQuota. The memory held by
+// the query of the replaced quota counts in the peak of the restored one.
+func (mp *MPool) RestoreQuota(st QuotaState) {
+	_, peak := mp.QuotaUsage()
+	peak += mp.quota.base.Load() - st.base
+	mp.quota.limit.Store(st.limit)
+	mp.quota.base.Store(st.base)
+	mp.quota.peak.Store(max(st.peak, peak))
+	mp.quota.exceeded.Store(st.exceeded)
+}
+
+// Quota returns the limit set by SetQuota.
+func (mp *MPool) Quota() int64 {
+	return mp.quota.limit.Load()
+}
+
+// QuotaExceeded returns true if an allocation failed because of the quota
+// since the last SetQuota call.
+func (mp *MPool) QuotaExceeded() bool {
+	return mp.quota.exceeded.Load()
+}
+
+// QuotaUsage returns the bytes currently held and the max bytes held since
+// the last SetQuota call.
+func (mp *MPool) QuotaUsage() (curr int64, peak int64) {
+	curr = max(mp.stats.NumCurrBytes.Load()-mp.quota.base.Load(), 0)
+	return curr, max(mp.quota.peak.Load(), curr)
+}
+
 func (mp *MPool) destroy() (succeed bool) {
 	if !atomic.CompareAndSwapInt32(&mp.available, Available, Unavailable) {
 		logutil.Errorf("Mpool %s double destroy", mp.tag)
@@ -585,6 +650,16 @@ func (mp *MPool) Alloc(sz int) ([]byte, error) {
 		globalStats.RecordFree("global", tempSize)
 		return nil, moerr.NewInternalErrorNoCtx("mpool out of space, alloc %d bytes, cap %d", sz, mp.cap)
 	}
+	used := mycurr - mp.quota.base.Load()
+	if limit := mp.quota.limit.Load(); limit > 0 && used > limit {
+		mp.stats.RecordFree(mp.tag, tempSize)
+		globalStats.RecordFree("global", tempSize)
+		mp.quota.exceeded.Store(true)
+		return nil, moerr.NewQueryMemoryLimitNoCtx(limit)
+	}
+	if peak := mp.quota.peak.Load(); used > peak {
+		mp.quota.peak.CompareAndSwap(peak, used)
+	}
 
 	// from fixed pool
 	if idx < NumFixedPool {
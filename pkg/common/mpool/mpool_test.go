@@ -18,6 +18,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/stretchr/testify/require"
 )
 
@@ -150,3 +151,53 @@ func TestMpoolReAllocate(t *testing.T) {
 	m.Free(d4)
 	require.Equal(t, int64(0), m.CurrNB())
 }
+
+func TestMPoolQuota(t *testing.T) {
+	m := MustNewZeroNoFixed()
+	held, err := m.Alloc(1024)
+	require.NoError(t, err)
+
+	// the bytes held before the quota are not counted.
+	m.SetQuota(4096)
+	require.Equal(t, int64(4096), m.Quota())
+	curr, peak := m.QuotaUsage()
+	require.Equal(t, int64(0), curr)
+	require.Equal(t, int64(0), peak)
+
+	d1, err := m.Alloc(2048)
+	require.NoError(t, err)
+	_, err = m.Alloc(2048)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryLimit))
+	require.True(t, m.QuotaExceeded())
+	curr, peak = m.QuotaUsage()
+	require.Equal(t, int64(2048+kMemHdrSz), curr)
+	require.Equal(t, curr, peak)
+
+	m.Free(d1)
+	curr, peak = m.QuotaUsage()
+	require.Equal(t, int64(0), curr)
+	require.Equal(t, int64(2048+kMemHdrSz), peak)
+
+	// no limit.
+	outer := m.SetQuota(0)
+	require.False(t, m.QuotaExceeded())
+	d1, err = m.Alloc(8192)
+	require.NoError(t, err)
+
+	// the replaced quota is put back, and the memory held meanwhile counts
+	// in its peak.
+	m.RestoreQuota(outer)
+	require.Equal(t, int64(4096), m.Quota())
+	require.True(t, m.QuotaExceeded())
+	curr, peak = m.QuotaUsage()
+	require.Equal(t, int64(8192+kMemHdrSz), curr)
+	require.Equal(t, curr, peak)
+	m.Free(d1)
+	_, err = m.Alloc(8192)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryLimit))
+
+	m.RestoreQuota(QuotaState{})
+	require.Equal(t, int64(0), m.Quota())
+	m.Free(held)
+	require.Equal(t, int64(0), m.CurrNB())
+}
@@ -120,6 +120,15 @@ var (
 	// defaultMergeCycle default: 5 minute
 	defaultMergeCycle = 5 * time.Minute
 
+	// defaultPgAuthMethod default: scram-sha-256
+	defaultPgAuthMethod = "scram-sha-256"
+
+	// defaultAuditLogDir default: ./mo-data/audit
+	defaultAuditLogDir = "./mo-data/audit"
+
+	// defaultAuditLogMaxSize default: 100 MB
+	defaultAuditLogMaxSize = 100 << 20
+
 	// defaultSessionTimeout default: 24 hour
 	defaultSessionTimeout = 24 * time.Hour
 
@@ -183,6 +192,36 @@ type FrontendParameters struct {
 	// UnixSocketAddress listening unix domain socket
 	UnixSocketAddress string `toml:"unix-socket" user_setting:"advanced"`
 
+	// PgPort defines which port the PostgreSQL protocol listener uses. 0 disables it.
+	PgPort int64 `toml:"pg-port" user_setting:"advanced"`
+
+	// PgAuthMethod is the authentication method offered to PostgreSQL clients.
+	// "scram-sha-256" uses the SCRAM verifier stored in mo_user and falls back to
+	// "password" (cleartext) for the users without one. md5 is not supported, because
+	// its verifier is salted with the user name and can not be derived from the
+	// stored password hashes.
+	PgAuthMethod string `toml:"pg-auth-method" user_setting:"advanced"`
+
+	// PgAllowCleartextPassword allows the "password" method on the connections without
+	// TLS, which sends the password unencrypted. default: false
+	PgAllowCleartextPassword bool `toml:"pg-allow-cleartext-password" user_setting:"advanced"`
+
+	// CachingSha2PrivateKeyPath is the path of the RSA private key in PEM format. It is used by
+	// caching_sha2_password to exchange the password over the non-TLS connections. A key pair
+	// is generated when it is needed if the path is empty.
+	CachingSha2PrivateKeyPath string `toml:"caching-sha2-private-key-path" user_setting:"advanced"`
+
+	// AuditLogDir is the directory of the audit log files of the accounts whose
+	// audit_log_sink is FILE.
+	AuditLogDir string `toml:"audit-log-dir" user_setting:"advanced"`
+
+	// AuditLogMaxSize is the size in bytes at which the audit log file is rotated.
+	AuditLogMaxSize int64 `toml:"audit-log-max-size" user_setting:"advanced"`
+
+	// AuditLogMaxFiles is the number of the rotated audit log files to keep. 0 keeps
+	// all of them.
+	AuditLogMaxFiles int64 `toml:"audit-log-max-files" user_setting:"advanced"`
+
 	//guest mmu limitation. default: 1 << 40 = 1099511627776
 	GuestMmuLimitation int64 `toml:"guestMmuLimitation"`
 
@@ -309,6 +348,18 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.UnixSocketAddress = defaultUnixAddr
 	}
 
+	if fp.PgAuthMethod == "" {
+		fp.PgAuthMethod = defaultPgAuthMethod
+	}
+
+	if fp.AuditLogDir == "" {
+		fp.AuditLogDir = defaultAuditLogDir
+	}
+
+	if fp.AuditLogMaxSize == 0 {
+		fp.AuditLogMaxSize = int64(defaultAuditLogMaxSize)
+	}
+
 	if fp.GuestMmuLimitation == 0 {
 		fp.GuestMmuLimitation = int64(toml.ByteSize(defaultGuestMmuLimitation))
 	}
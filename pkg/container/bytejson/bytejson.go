@@ -241,6 +241,21 @@ func (bj ByteJson) toString(buf []byte) ([]byte, error) {
 	return toString(buf, data)
 }
 
+// GetArrayElem returns the i-th element of the json array.
+func (bj ByteJson) GetArrayElem(i int) ByteJson {
+	return bj.getArrayElem(i)
+}
+
+// GetObjectKey returns the i-th key of the json object in the sorted order.
+func (bj ByteJson) GetObjectKey(i int) []byte {
+	return bj.getObjectKey(i)
+}
+
+// GetObjectVal returns the value of the i-th key of the json object.
+func (bj ByteJson) GetObjectVal(i int) ByteJson {
+	return bj.getObjectVal(i)
+}
+
 func (bj ByteJson) getObjectKey(i int) []byte {
 	keyOff := int(endian.Uint32(bj.Data[headerSize+i*keyEntrySize:]))
 	keyLen := int(endian.Uint16(bj.Data[headerSize+i*keyEntrySize+keyOriginOff:]))
@@ -273,12 +288,19 @@ func (bj ByteJson) getValEntry(off int) ByteJson {
 	return ByteJson{Type: TpCode(tpCode), Data: bj.Data[valOff : valOff+dataBytes]}
 }
 
-func (bj ByteJson) queryValByKey(key []byte) ByteJson {
+// lookupKey returns the index of the key in the json object, and false if
+// the key does not exist.
+func (bj ByteJson) lookupKey(key []byte) (int, bool) {
 	cnt := bj.GetElemCnt()
 	idx := sort.Search(cnt, func(i int) bool {
 		return bytes.Compare(bj.getObjectKey(i), key) >= 0
 	})
-	if idx >= cnt || !bytes.Equal(bj.getObjectKey(idx), key) {
+	return idx, idx < cnt && bytes.Equal(bj.getObjectKey(idx), key)
+}
+
+func (bj ByteJson) queryValByKey(key []byte) ByteJson {
+	idx, ok := bj.lookupKey(key)
+	if !ok {
 		return Null
 	}
 	return bj.getObjectVal(idx)
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"math"
 	"strconv"
 	"testing"
 
@@ -135,6 +136,44 @@ func TestArray(t *testing.T) {
 	}
 }
 
+func TestBuild(t *testing.T) {
+	nested, err := ParseFromString(`{"b": [1, "x"], "a": null}`)
+	require.NoError(t, err)
+	f, err := FromFloat64(1.5)
+	require.NoError(t, err)
+	_, err = FromFloat64(math.NaN())
+	require.Error(t, err)
+
+	elems := []ByteJson{FromInt64(-1), FromUint64(2), f, FromString("s\"q"), FromBool(true), Null, nested}
+	arr := BuildArray(elems)
+	require.Equal(t, `[-1, 2, 1.5, "s\"q", true, null, {"a": null, "b": [1, "x"]}]`, arr.String())
+	require.Equal(t, len(elems), arr.GetElemCnt())
+	for i := range elems {
+		require.Equal(t, elems[i].String(), arr.GetArrayElem(i).String())
+	}
+	require.Equal(t, `[]`, BuildArray(nil).String())
+
+	obj, err := BuildObject([]string{"c", "a", "c", "b"}, []ByteJson{FromInt64(1), arr, FromBool(false), Null})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": `+arr.String()+`, "b": null, "c": false}`, obj.String())
+	require.Equal(t, 3, obj.GetElemCnt())
+	require.Equal(t, "b", string(obj.GetObjectKey(1)))
+	require.Equal(t, "false", obj.GetObjectVal(2).String())
+	v, err := obj.Query([]*Path{mustPath(t, "$.a[6].b[1]")}).MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, `"x"`, string(v))
+
+	obj, err = BuildObject(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, `{}`, obj.String())
+}
+
+func mustPath(t *testing.T, s string) *Path {
+	p, err := ParseJsonPath(s)
+	require.NoError(t, err)
+	return &p
+}
+
 func TestQuery(t *testing.T) {
 	kases := []struct {
 		jsonStr string
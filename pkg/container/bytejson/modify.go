@@ -0,0 +1,614 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// ModifyType is the way JSON_SET, JSON_INSERT and JSON_REPLACE modify a
+// json document at a path.
+type ModifyType int
+
+const (
+	// ModifySet replaces the existing value and adds the missing one.
+	ModifySet ModifyType = iota
+	// ModifyInsert adds the missing value only.
+	ModifyInsert
+	// ModifyReplace replaces the existing value only.
+	ModifyReplace
+)
+
+func newPathWildcardError(path *Path) error {
+	return moerr.NewInvalidInputNoCtx("path expression '%s' may not contain the * and ** tokens or an array range", path.String())
+}
+
+// arrayElems returns the elements of the json array, a json value that is not
+// an array is wrapped as an array of itself.
+func (bj ByteJson) arrayElems() []ByteJson {
+	if bj.Type != TpCodeArray {
+		return []ByteJson{bj}
+	}
+	elems := make([]ByteJson, bj.GetElemCnt())
+	for i := range elems {
+		elems[i] = bj.getArrayElem(i)
+	}
+	return elems
+}
+
+// objectEntries returns the keys and values of the json object.
+func (bj ByteJson) objectEntries() ([]string, []ByteJson) {
+	cnt := bj.GetElemCnt()
+	keys, vals := make([]string, cnt), make([]ByteJson, cnt)
+	for i := 0; i < cnt; i++ {
+		keys[i] = string(bj.getObjectKey(i))
+		vals[i] = bj.getObjectVal(i)
+	}
+	return keys, vals
+}
+
+// resolveIndex returns the position of the index in an array of cnt elements,
+// which may be out of the range.
+func (pi subPathIndices) resolveIndex(cnt int) int {
+	if pi.tp == lastIndices {
+		return cnt - 1 - pi.num
+	}
+	return pi.num
+}
+
+// seek calls fn with each value matched by the legs and the concrete legs
+// to the value. Unlike query, a leg that matches nothing yields nothing
+// rather than a json null. A value that is not an array matches the index
+// 0 of itself, as MySQL does.
+func (bj ByteJson) seek(legs []subPath, at []subPath, fn func(v ByteJson, at []subPath) bool) bool {
+	if len(legs) == 0 {
+		return fn(bj, at)
+	}
+	leg, rest := legs[0], legs[1:]
+
+	switch leg.tp {
+	case subPathDoubleStar:
+		if !bj.seek(rest, at, fn) {
+			return false
+		}
+		return bj.seekChildren(at, func(child ByteJson, at []subPath) bool {
+			return child.seek(legs, at, fn)
+		})
+
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return true
+		}
+		if leg.key == "*" {
+			return bj.seekChildren(at, func(child ByteJson, at []subPath) bool {
+				return child.seek(rest, at, fn)
+			})
+		}
+		if i, ok := bj.lookupKey([]byte(leg.key)); ok {
+			return bj.getObjectVal(i).seek(rest, append(at, leg), fn)
+		}
+		return true
+
+	case subPathIdx:
+		if bj.Type != TpCodeArray {
+			if leg.idx.num == subPathIdxALL || leg.idx.resolveIndex(1) == 0 {
+				return bj.seek(rest, at, fn)
+			}
+			return true
+		}
+		if leg.idx.num == subPathIdxALL {
+			return bj.seekChildren(at, func(child ByteJson, at []subPath) bool {
+				return child.seek(rest, at, fn)
+			})
+		}
+		cnt := bj.GetElemCnt()
+		if i := leg.idx.resolveIndex(cnt); i >= 0 && i < cnt {
+			return bj.getArrayElem(i).seek(rest, append(at, indexLeg(i)), fn)
+		}
+		return true
+
+	case subPathRange:
+		if bj.Type != TpCodeArray {
+			if leg.iRange.start.resolveIndex(1) == 0 {
+				return bj.seek(rest, at, fn)
+			}
+			return true
+		}
+		cnt := bj.GetElemCnt()
+		start := max(leg.iRange.start.resolveIndex(cnt), 0)
+		end := min(leg.iRange.end.resolveIndex(cnt), cnt-1)
+		for i := start; i <= end; i++ {
+			if !bj.getArrayElem(i).seek(rest, append(at, indexLeg(i)), fn) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// seekChildren calls fn with each member of the json object or each element
+// of the json array.
+func (bj ByteJson) seekChildren(at []subPath, fn func(child ByteJson, at []subPath) bool) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+			leg := subPath{tp: subPathKey, key: string(bj.getObjectKey(i))}
+			if !fn(bj.getObjectVal(i), append(at, leg)) {
+				return false
+			}
+		}
+	case TpCodeArray:
+		for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+			if !fn(bj.getArrayElem(i), append(at, indexLeg(i))) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func indexLeg(i int) subPath {
+	return subPath{tp: subPathIdx, idx: &subPathIndices{tp: numberIndices, num: i}}
+}
+
+// legsString formats the concrete legs as MySQL does, a key is quoted only
+// if it is not an identifier.
+func legsString(legs []subPath) string {
+	var s strings.Builder
+	s.WriteByte('$')
+	for _, leg := range legs {
+		if leg.tp == subPathIdx {
+			s.WriteByte('[')
+			s.WriteString(strconv.Itoa(leg.idx.num))
+			s.WriteByte(']')
+			continue
+		}
+		s.WriteByte('.')
+		if isIdentifier(leg.key) {
+			s.WriteString(leg.key)
+		} else {
+			quoted, _ := appendString(nil, leg.key)
+			s.Write(quoted)
+		}
+	}
+	return s.String()
+}
+
+// Lookup returns the value at the path, and false if there is no value at the
+// path. The path must not contain the wildcards or ranges.
+func (bj ByteJson) Lookup(path *Path) (ByteJson, bool, error) {
+	if !path.IsSimple() {
+		return ByteJson{}, false, newPathWildcardError(path)
+	}
+	var ret ByteJson
+	found := false
+	bj.seek(path.paths, nil, func(v ByteJson, _ []subPath) bool {
+		ret, found = v, true
+		return false
+	})
+	return ret, found, nil
+}
+
+// Exists returns true if there is any value matched by the path.
+func (bj ByteJson) Exists(path *Path) bool {
+	found := false
+	bj.seek(path.paths, nil, func(ByteJson, []subPath) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// Match returns all the values matched by the path in document order.
+func (bj ByteJson) Match(path *Path) []ByteJson {
+	var ret []ByteJson
+	bj.seek(path.paths, nil, func(v ByteJson, _ []subPath) bool {
+		ret = append(ret, v)
+		return true
+	})
+	return ret
+}
+
+// Modify sets the values at the paths one by one in the way of the modify
+// type, a path whose parent does not exist is ignored.
+func (bj ByteJson) Modify(paths []*Path, values []ByteJson, tp ModifyType) (ByteJson, error) {
+	var err error
+	for i, path := range paths {
+		if !path.IsSimple() {
+			return ByteJson{}, newPathWildcardError(path)
+		}
+		if bj, err = bj.modify(path.paths, values[i], tp); err != nil {
+			return ByteJson{}, err
+		}
+	}
+	return bj, nil
+}
+
+func (bj ByteJson) modify(legs []subPath, value ByteJson, tp ModifyType) (ByteJson, error) {
+	if len(legs) == 0 {
+		// the document itself always exists.
+		if tp == ModifyInsert {
+			return bj, nil
+		}
+		return value, nil
+	}
+	leg := legs[0]
+
+	if len(legs) > 1 {
+		return bj.updateChild(leg, func(child ByteJson) (ByteJson, error) {
+			return child.modify(legs[1:], value, tp)
+		})
+	}
+
+	if leg.tp == subPathKey {
+		if bj.Type != TpCodeObject {
+			return bj, nil
+		}
+		if _, ok := bj.lookupKey([]byte(leg.key)); ok && tp == ModifyInsert || !ok && tp == ModifyReplace {
+			return bj, nil
+		}
+		keys, vals := bj.objectEntries()
+		return BuildObject(append(keys, leg.key), append(vals, value))
+	}
+
+	cnt := 1
+	if bj.Type == TpCodeArray {
+		cnt = bj.GetElemCnt()
+	}
+	i := leg.idx.resolveIndex(cnt)
+	switch {
+	case i < 0:
+		return bj, nil
+	case i < cnt:
+		if tp == ModifyInsert {
+			return bj, nil
+		}
+		if bj.Type != TpCodeArray {
+			return value, nil
+		}
+		elems := bj.arrayElems()
+		elems[i] = value
+		return mergeToArray(elems), nil
+	default:
+		// a value out of the array is appended, and a value that is not an
+		// array is wrapped as an array.
+		if tp == ModifyReplace {
+			return bj, nil
+		}
+		return mergeToArray(append(bj.arrayElems(), value)), nil
+	}
+}
+
+// updateChild replaces the child at the leg with the result of fn, the json
+// is unchanged if the child does not exist.
+func (bj ByteJson) updateChild(leg subPath, fn func(child ByteJson) (ByteJson, error)) (ByteJson, error) {
+	switch {
+	case leg.tp == subPathKey && bj.Type == TpCodeObject:
+		i, ok := bj.lookupKey([]byte(leg.key))
+		if !ok {
+			return bj, nil
+		}
+		child, err := fn(bj.getObjectVal(i))
+		if err != nil {
+			return ByteJson{}, err
+		}
+		keys, vals := bj.objectEntries()
+		vals[i] = child
+		return BuildObject(keys, vals)
+
+	case leg.tp == subPathIdx && bj.Type == TpCodeArray:
+		cnt := bj.GetElemCnt()
+		i := leg.idx.resolveIndex(cnt)
+		if i < 0 || i >= cnt {
+			return bj, nil
+		}
+		child, err := fn(bj.getArrayElem(i))
+		if err != nil {
+			return ByteJson{}, err
+		}
+		elems := bj.arrayElems()
+		elems[i] = child
+		return mergeToArray(elems), nil
+
+	case leg.tp == subPathIdx && leg.idx.resolveIndex(1) == 0:
+		// the index 0 of a value that is not an array is itself.
+		return fn(bj)
+	}
+	return bj, nil
+}
+
+// Remove removes the values at the paths one by one, a path that does not
+// exist is ignored.
+func (bj ByteJson) Remove(paths []*Path) (ByteJson, error) {
+	var err error
+	for _, path := range paths {
+		if !path.IsSimple() {
+			return ByteJson{}, newPathWildcardError(path)
+		}
+		if path.empty() {
+			return ByteJson{}, moerr.NewInvalidInputNoCtx("the path expression '$' is not allowed in json_remove")
+		}
+		if bj, err = bj.remove(path.paths); err != nil {
+			return ByteJson{}, err
+		}
+	}
+	return bj, nil
+}
+
+func (bj ByteJson) remove(legs []subPath) (ByteJson, error) {
+	leg := legs[0]
+	if len(legs) > 1 {
+		return bj.updateChild(leg, func(child ByteJson) (ByteJson, error) {
+			return child.remove(legs[1:])
+		})
+	}
+
+	switch {
+	case leg.tp == subPathKey && bj.Type == TpCodeObject:
+		i, ok := bj.lookupKey([]byte(leg.key))
+		if !ok {
+			return bj, nil
+		}
+		keys, vals := bj.objectEntries()
+		return BuildObject(append(keys[:i], keys[i+1:]...), append(vals[:i], vals[i+1:]...))
+
+	case leg.tp == subPathIdx && bj.Type == TpCodeArray:
+		cnt := bj.GetElemCnt()
+		i := leg.idx.resolveIndex(cnt)
+		if i < 0 || i >= cnt {
+			return bj, nil
+		}
+		elems := bj.arrayElems()
+		return mergeToArray(append(elems[:i], elems[i+1:]...)), nil
+	}
+	return bj, nil
+}
+
+// MergePreserve merges the json documents as JSON_MERGE_PRESERVE does. Two
+// objects are merged into an object whose duplicate keys have the merged
+// values, otherwise the values are wrapped as arrays and concatenated.
+func MergePreserve(docs []ByteJson) (ByteJson, error) {
+	var err error
+	ret := docs[0]
+	for _, doc := range docs[1:] {
+		if ret, err = mergePreserve(ret, doc); err != nil {
+			return ByteJson{}, err
+		}
+	}
+	return ret, nil
+}
+
+func mergePreserve(a, b ByteJson) (ByteJson, error) {
+	if a.Type != TpCodeObject || b.Type != TpCodeObject {
+		return mergeToArray(append(a.arrayElems(), b.arrayElems()...)), nil
+	}
+
+	keys, vals := a.objectEntries()
+	for i, cnt := 0, b.GetElemCnt(); i < cnt; i++ {
+		key, val := b.getObjectKey(i), b.getObjectVal(i)
+		if j, ok := a.lookupKey(key); ok {
+			merged, err := mergePreserve(vals[j], val)
+			if err != nil {
+				return ByteJson{}, err
+			}
+			vals[j] = merged
+			continue
+		}
+		keys = append(keys, string(key))
+		vals = append(vals, val)
+	}
+	return BuildObject(keys, vals)
+}
+
+// MergePatch merges the json documents as JSON_MERGE_PATCH does, which is the
+// JSON Merge Patch of the RFC 7396.
+func MergePatch(docs []ByteJson) (ByteJson, error) {
+	var err error
+	ret := docs[0]
+	for _, doc := range docs[1:] {
+		if ret, err = mergePatch(ret, doc); err != nil {
+			return ByteJson{}, err
+		}
+	}
+	return ret, nil
+}
+
+func mergePatch(target, patch ByteJson) (ByteJson, error) {
+	if patch.Type != TpCodeObject {
+		return patch, nil
+	}
+
+	var keys []string
+	var vals []ByteJson
+	if target.Type == TpCodeObject {
+		keys, vals = target.objectEntries()
+	}
+	removed := make([]bool, len(keys))
+	for i, cnt := 0, patch.GetElemCnt(); i < cnt; i++ {
+		key, val := patch.getObjectKey(i), patch.getObjectVal(i)
+		j, ok := -1, false
+		if target.Type == TpCodeObject {
+			j, ok = target.lookupKey(key)
+		}
+		if val.IsNull() {
+			if ok {
+				removed[j] = true
+			}
+			continue
+		}
+
+		old := Null
+		if ok {
+			old = vals[j]
+		}
+		merged, err := mergePatch(old, val)
+		if err != nil {
+			return ByteJson{}, err
+		}
+		if ok {
+			vals[j] = merged
+			continue
+		}
+		keys = append(keys, string(key))
+		vals = append(vals, merged)
+		removed = append(removed, false)
+	}
+
+	n := 0
+	for i := range keys {
+		if !removed[i] {
+			keys[n], vals[n] = keys[i], vals[i]
+			n++
+		}
+	}
+	return BuildObject(keys[:n], vals[:n])
+}
+
+// Contains returns true if the candidate is contained in the json as the
+// JSON_CONTAINS does.
+//   - a scalar contains the candidate if they are equal.
+//   - an object contains an object candidate if each key of the candidate is in
+//     the object, and the value of the key contains the value of the candidate.
+//   - an array contains an array candidate if each element of the candidate is
+//     contained in an element of the array, and contains another candidate if
+//     any element contains it.
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		for i, cnt := 0, candidate.GetElemCnt(); i < cnt; i++ {
+			j, ok := bj.lookupKey(candidate.getObjectKey(i))
+			if !ok || !bj.getObjectVal(j).Contains(candidate.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+
+	case TpCodeArray:
+		if candidate.Type == TpCodeArray {
+			for i, cnt := 0, candidate.GetElemCnt(); i < cnt; i++ {
+				if !bj.containsElem(candidate.getArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+			if bj.getArrayElem(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if candidate.Type == TpCodeObject || candidate.Type == TpCodeArray {
+		return false
+	}
+	return CompareByteJson(bj, candidate) == 0
+}
+
+// containsElem returns true if an element of the json array contains the
+// element of an array candidate, a scalar is only contained in an equal scalar
+// element.
+func (bj ByteJson) containsElem(elem ByteJson) bool {
+	scalar := elem.Type != TpCodeObject && elem.Type != TpCodeArray
+	for i, cnt := 0, bj.GetElemCnt(); i < cnt; i++ {
+		v := bj.getArrayElem(i)
+		if scalar && (v.Type == TpCodeObject || v.Type == TpCodeArray) {
+			continue
+		}
+		if v.Contains(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns the json array of the keys of the json object, and false if the
+// json is not an object.
+func (bj ByteJson) Keys() (ByteJson, bool) {
+	if bj.Type != TpCodeObject {
+		return Null, false
+	}
+	cnt := bj.GetElemCnt()
+	keys := make([]ByteJson, cnt)
+	for i := 0; i < cnt; i++ {
+		keys[i] = FromString(string(bj.getObjectKey(i)))
+	}
+	return mergeToArray(keys), true
+}
+
+// Length returns the number of the elements of an array, the number of the
+// members of an object, or 1 for a scalar.
+func (bj ByteJson) Length() int {
+	if bj.Type == TpCodeObject || bj.Type == TpCodeArray {
+		return bj.GetElemCnt()
+	}
+	return 1
+}
+
+// Search returns the paths of the strings under the paths which are matched by
+// the match function, as the JSON_SEARCH does. It returns a json string if
+// there is only one path or one is true, an array of the paths otherwise,
+// and false if nothing is matched.
+func (bj ByteJson) Search(paths []*Path, one bool, match func(s []byte) (bool, error)) (ByteJson, bool, error) {
+	if len(paths) == 0 {
+		paths = []*Path{{}}
+	}
+
+	var err error
+	var found []ByteJson
+	seen := make(map[string]struct{})
+	var walk func(v ByteJson, at []subPath) bool
+	walk = func(v ByteJson, at []subPath) bool {
+		if v.Type == TpCodeString {
+			var ok bool
+			if ok, err = match(v.GetString()); err != nil {
+				return false
+			}
+			if ok {
+				s := legsString(at)
+				if _, dup := seen[s]; !dup {
+					seen[s] = struct{}{}
+					found = append(found, FromString(s))
+				}
+				return !one
+			}
+			return true
+		}
+		return v.seekChildren(at, walk)
+	}
+	for _, path := range paths {
+		if !bj.seek(path.paths, nil, walk) {
+			break
+		}
+	}
+
+	if err != nil {
+		return ByteJson{}, false, err
+	}
+	switch len(found) {
+	case 0:
+		return Null, false, nil
+	case 1:
+		return found[0], true, nil
+	}
+	return mergeToArray(found), true, nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustJson(t *testing.T, s string) ByteJson {
+	bj, err := ParseFromString(s)
+	require.NoError(t, err)
+	return bj
+}
+
+func TestLookup(t *testing.T) {
+	bj := mustJson(t, `{"a": [1, {"b": null}], "c": 2}`)
+	kases := []struct {
+		path  string
+		found bool
+		out   string
+	}{
+		{"$", true, `{"a": [1, {"b": null}], "c": 2}`},
+		{"$.a[1].b", true, "null"},
+		{"$.a[last]", true, `{"b": null}`},
+		{"$.c[0]", true, "2"},
+		{"$.c[1]", false, ""},
+		{"$.d", false, ""},
+		{"$.a.b", false, ""},
+	}
+	for _, k := range kases {
+		v, found, err := bj.Lookup(mustPath(t, k.path))
+		require.NoError(t, err)
+		require.Equal(t, k.found, found, k.path)
+		if found {
+			require.Equal(t, k.out, v.String(), k.path)
+		}
+	}
+
+	_, _, err := bj.Lookup(mustPath(t, "$.a[*]"))
+	require.Error(t, err)
+	require.True(t, bj.Exists(mustPath(t, "$**.b")))
+	require.False(t, bj.Exists(mustPath(t, "$.*.d")))
+
+	vals := bj.Match(mustPath(t, "$.a[*]"))
+	require.Len(t, vals, 2)
+	require.Equal(t, "1", vals[0].String())
+	require.Equal(t, `{"b": null}`, vals[1].String())
+	require.Empty(t, bj.Match(mustPath(t, "$.d[*]")))
+}
+
+func TestModify(t *testing.T) {
+	doc := `{"a": 1, "b": [2, 3, {"c": 4}]}`
+	kases := []struct {
+		path string
+		tp   ModifyType
+		out  string
+	}{
+		{"$.a", ModifySet, `{"a": 10, "b": [2, 3, {"c": 4}]}`},
+		{"$.a", ModifyInsert, doc},
+		{"$.a", ModifyReplace, `{"a": 10, "b": [2, 3, {"c": 4}]}`},
+		{"$.d", ModifySet, `{"a": 1, "b": [2, 3, {"c": 4}], "d": 10}`},
+		{"$.d", ModifyInsert, `{"a": 1, "b": [2, 3, {"c": 4}], "d": 10}`},
+		{"$.d", ModifyReplace, doc},
+		{"$.b[1]", ModifySet, `{"a": 1, "b": [2, 10, {"c": 4}]}`},
+		{"$.b[5]", ModifySet, `{"a": 1, "b": [2, 3, {"c": 4}, 10]}`},
+		{"$.b[5]", ModifyReplace, doc},
+		{"$.b[last].c", ModifyReplace, `{"a": 1, "b": [2, 3, {"c": 10}]}`},
+		{"$.a[0]", ModifyReplace, `{"a": 10, "b": [2, 3, {"c": 4}]}`},
+		{"$.a[1]", ModifyInsert, `{"a": [1, 10], "b": [2, 3, {"c": 4}]}`},
+		{"$.x.y", ModifySet, doc},
+		{"$", ModifySet, "10"},
+		{"$", ModifyInsert, doc},
+	}
+	for _, k := range kases {
+		bj := mustJson(t, doc)
+		out, err := bj.Modify([]*Path{mustPath(t, k.path)}, []ByteJson{FromInt64(10)}, k.tp)
+		require.NoError(t, err)
+		require.Equal(t, k.out, out.String(), k.path)
+	}
+
+	// the paths are applied one by one.
+	out, err := mustJson(t, `{}`).Modify(
+		[]*Path{mustPath(t, "$.a"), mustPath(t, "$.a.b")},
+		[]ByteJson{mustJson(t, `{}`), FromString("x")}, ModifySet)
+	require.NoError(t, err)
+	require.Equal(t, `{"a": {"b": "x"}}`, out.String())
+
+	_, err = mustJson(t, doc).Modify([]*Path{mustPath(t, "$.b[*]")}, []ByteJson{Null}, ModifySet)
+	require.Error(t, err)
+}
+
+func TestRemove(t *testing.T) {
+	bj := mustJson(t, `{"a": 1, "b": [2, 3, {"c": 4}]}`)
+	out, err := bj.Remove([]*Path{mustPath(t, "$.b[0]"), mustPath(t, "$.b[last].c"), mustPath(t, "$.x")})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 1, "b": [3, {}]}`, out.String())
+
+	out, err = bj.Remove([]*Path{mustPath(t, "$.a")})
+	require.NoError(t, err)
+	require.Equal(t, `{"b": [2, 3, {"c": 4}]}`, out.String())
+
+	_, err = bj.Remove([]*Path{mustPath(t, "$")})
+	require.Error(t, err)
+	_, err = bj.Remove([]*Path{mustPath(t, "$.*")})
+	require.Error(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	kases := []struct {
+		docs     []string
+		preserve string
+		patch    string
+	}{
+		{[]string{`[1, 2]`, `[true, false]`}, `[1, 2, true, false]`, `[true, false]`},
+		{[]string{`{"a": 1}`, `{"b": 2}`}, `{"a": 1, "b": 2}`, `{"a": 1, "b": 2}`},
+		{[]string{`1`, `true`}, `[1, true]`, `true`},
+		{[]string{`[1, 2]`, `{"id": 47}`}, `[1, 2, {"id": 47}]`, `{"id": 47}`},
+		{[]string{`{"a": 1, "b": 2}`, `{"a": 3, "c": 4}`}, `{"a": [1, 3], "b": 2, "c": 4}`, `{"a": 3, "b": 2, "c": 4}`},
+		{[]string{`{"a": 1, "b": 2}`, `{"a": 3, "c": 4}`, `{"a": 5, "d": 6}`}, `{"a": [1, 3, 5], "b": 2, "c": 4, "d": 6}`, `{"a": 5, "b": 2, "c": 4, "d": 6}`},
+		{[]string{`{"a": 1, "b": 2}`, `{"b": null}`}, `{"a": 1, "b": [2, null]}`, `{"a": 1}`},
+		{[]string{`{"a": {"x": 1}}`, `{"a": {"y": 2}}`}, `{"a": {"x": 1, "y": 2}}`, `{"a": {"x": 1, "y": 2}}`},
+		{[]string{`1`, `{"a": {"b": null, "c": 1}}`}, `[1, {"a": {"b": null, "c": 1}}]`, `{"a": {"c": 1}}`},
+	}
+	for _, k := range kases {
+		docs := make([]ByteJson, len(k.docs))
+		for i, s := range k.docs {
+			docs[i] = mustJson(t, s)
+		}
+		out, err := MergePreserve(docs)
+		require.NoError(t, err)
+		require.Equal(t, k.preserve, out.String(), k.docs)
+		out, err = MergePatch(docs)
+		require.NoError(t, err)
+		require.Equal(t, k.patch, out.String(), k.docs)
+	}
+}
+
+func TestContains(t *testing.T) {
+	kases := []struct {
+		target    string
+		candidate string
+		out       bool
+	}{
+		{`{"a": 1, "b": 2, "c": {"d": 4}}`, `1`, false},
+		{`{"a": 1, "b": 2, "c": {"d": 4}}`, `{"a": 1}`, true},
+		{`{"a": 1, "b": 2, "c": {"d": 4}}`, `{"a": 1, "c": {}}`, true},
+		{`{"a": 1, "b": 2, "c": {"d": 4}}`, `{"a": 2}`, false},
+		{`[1, 2, [3, 4]]`, `2`, true},
+		{`[1, 2, [3, 4]]`, `[1, 2]`, true},
+		{`[1, 2, [3, 4]]`, `[1, 3]`, false},
+		{`[1, 2, [3, 4]]`, `[1, 5]`, false},
+		{`[1, 2, [3, 4]]`, `[[1, 2]]`, false},
+		{`[1, 2, [3, 4]]`, `[[3]]`, true},
+		{`[[1, 2]]`, `[1]`, false},
+		{`[[1, 2]]`, `1`, true},
+		{`[{"a": 1, "b": 2}]`, `{"a": 1}`, true},
+		{`1`, `1.0`, true},
+		{`"1"`, `1`, false},
+		{`1`, `[1]`, false},
+		{`true`, `true`, true},
+		{`null`, `false`, false},
+	}
+	for _, k := range kases {
+		require.Equal(t, k.out, mustJson(t, k.target).Contains(mustJson(t, k.candidate)), k.target+" "+k.candidate)
+	}
+}
+
+func TestKeysAndLength(t *testing.T) {
+	bj := mustJson(t, `{"b": 1, "a": [1, 2, 3]}`)
+	keys, ok := bj.Keys()
+	require.True(t, ok)
+	require.Equal(t, `["a", "b"]`, keys.String())
+	require.Equal(t, 2, bj.Length())
+
+	_, ok = mustJson(t, `[1]`).Keys()
+	require.False(t, ok)
+	require.Equal(t, 3, mustJson(t, `[1, 2, 3]`).Length())
+	require.Equal(t, 1, mustJson(t, `"abc"`).Length())
+}
+
+func TestSearch(t *testing.T) {
+	bj := mustJson(t, `["abc", [{"k": "10"}, "def"], {"x": "abc"}, {"y": "bcd"}, {"a b": "abc"}]`)
+	equal := func(s string) func([]byte) (bool, error) {
+		return func(b []byte) (bool, error) {
+			return bytes.Equal(b, []byte(s)), nil
+		}
+	}
+
+	kases := []struct {
+		str   string
+		one   bool
+		paths []string
+		out   string
+	}{
+		{"abc", true, nil, `"$[0]"`},
+		{"abc", false, nil, `["$[0]", "$[2].x", "$[4].\"a b\""]`},
+		{"ghi", false, nil, ""},
+		{"10", false, nil, `"$[1][0].k"`},
+		{"10", false, []string{"$**.k"}, `"$[1][0].k"`},
+		{"10", false, []string{"$[1][0].k", "$[1]"}, `"$[1][0].k"`},
+		{"abc", false, []string{"$[2]"}, `"$[2].x"`},
+		{"abc", false, []string{"$[*].x", "$[0]"}, `["$[2].x", "$[0]"]`},
+	}
+	for _, k := range kases {
+		paths := make([]*Path, len(k.paths))
+		for i, p := range k.paths {
+			paths[i] = mustPath(t, p)
+		}
+		out, found, err := bj.Search(paths, k.one, equal(k.str))
+		require.NoError(t, err)
+		require.Equal(t, k.out != "", found, k.str)
+		if found {
+			require.Equal(t, k.out, out.String(), k.str)
+		}
+	}
+}
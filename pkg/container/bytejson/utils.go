@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"math"
 	"math/bits"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -165,6 +166,74 @@ func mergeToArray(origin []ByteJson) ByteJson {
 	return ByteJson{Type: TpCodeArray, Data: buf}
 }
 
+// BuildArray returns the json array of the elements.
+func BuildArray(elems []ByteJson) ByteJson {
+	return mergeToArray(elems)
+}
+
+// BuildObject returns the json object of the keys and values. The keys are
+// sorted and the last value of a duplicate key wins, the same as parsing an
+// object.
+func BuildObject(keys []string, values []ByteJson) (ByteJson, error) {
+	last := make(map[string]int, len(keys))
+	for i, k := range keys {
+		if len(k) > math.MaxUint16 {
+			return ByteJson{}, moerr.NewInvalidInputNoCtx("json key %s", k)
+		}
+		last[k] = i
+	}
+	idx := make([]int, 0, len(last))
+	for i, k := range keys {
+		if last[k] == i {
+			idx = append(idx, i)
+		}
+	}
+	sort.Slice(idx, func(a, b int) bool {
+		return keys[idx[a]] < keys[idx[b]]
+	})
+
+	n := len(idx)
+	vals := make([]ByteJson, n)
+	buf := make([]byte, headerSize+n*(keyEntrySize+valEntrySize))
+	endian.PutUint32(buf, uint32(n))
+	for i, j := range idx {
+		o := headerSize + i*keyEntrySize
+		endian.PutUint32(buf[o:], uint32(len(buf)))
+		endian.PutUint16(buf[o+keyOriginOff:], uint16(len(keys[j])))
+		buf = append(buf, keys[j]...)
+		vals[i] = values[j]
+	}
+	buf = addByteElem(buf, headerSize+n*keyEntrySize, vals)
+	endian.PutUint32(buf[docSizeOff:], uint32(len(buf)))
+	return ByteJson{Type: TpCodeObject, Data: buf}, nil
+}
+
+func FromBool(v bool) ByteJson {
+	if v {
+		return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralTrue}}
+	}
+	return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralFalse}}
+}
+
+func FromInt64(v int64) ByteJson {
+	return ByteJson{Type: TpCodeInt64, Data: endian.AppendUint64(nil, uint64(v))}
+}
+
+func FromUint64(v uint64) ByteJson {
+	return ByteJson{Type: TpCodeUint64, Data: endian.AppendUint64(nil, v)}
+}
+
+func FromFloat64(v float64) (ByteJson, error) {
+	if err := checkFloat64(v); err != nil {
+		return ByteJson{}, err
+	}
+	return ByteJson{Type: TpCodeFloat64, Data: endian.AppendUint64(nil, math.Float64bits(v))}, nil
+}
+
+func FromString(s string) ByteJson {
+	return ByteJson{Type: TpCodeString, Data: addString(nil, s)}
+}
+
 // check unnest mode
 func checkMode(mode string) bool {
 	if mode == "both" || mode == "array" || mode == "object" {
@@ -0,0 +1,283 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/audit"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+)
+
+// The audit log of an account is configured by its global system variables:
+//
+//   - audit_log enables the audit log.
+//   - audit_log_sink is FILE or TABLE. FILE writes the records into the
+//     rotating JSON lines files in audit-log-dir of the CN, TABLE writes them
+//     into mo_catalog.mo_audit_log of the sys account.
+//   - audit_log_classes are the classes of the recorded events, they are
+//     CONNECT (login, logout and failed authentication), DDL, DCL, DML, DQL,
+//     TCL and OTHER.
+//   - audit_log_include_users, audit_log_exclude_users,
+//     audit_log_include_databases and audit_log_exclude_databases filter the
+//     records by the user and the current database.
+//
+// The failed authentications of the unknown accounts are recorded by the
+// settings of the sys account. The SET GLOBAL of the audit settings are
+// always recorded, so the audit log can not be turned off silently.
+
+const (
+	auditLogVar        = "audit_log"
+	auditLogSinkVar    = "audit_log_sink"
+	auditLogClassesVar = "audit_log_classes"
+	auditLogVarPrefix  = "audit_log"
+
+	auditLogSinkTable = "TABLE"
+)
+
+// auditLogger holds the sinks of the CN, they are opened when they are used
+// for the first time.
+type auditLogger struct {
+	ctx context.Context
+
+	mu    sync.Mutex
+	node  string
+	file  audit.Sink
+	table audit.Sink
+}
+
+func newAuditLogger(ctx context.Context) *auditLogger {
+	return &auditLogger{ctx: ctx}
+}
+
+func (l *auditLogger) setNode(node string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.node = node
+}
+
+func (l *auditLogger) sink(ctx context.Context, ses *Session, name string) (audit.Sink, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if strings.EqualFold(name, auditLogSinkTable) {
+		if l.table == nil {
+			v, ok := runtime.ServiceRuntime(ses.GetService()).GetGlobalVariables(runtime.InternalSQLExecutor)
+			if !ok {
+				return nil, moerr.NewNotSupported(ctx, "audit log table without the sql executor")
+			}
+			l.table = audit.NewTableSink(l.ctx, v.(executor.SQLExecutor), l.node)
+		}
+		return l.table, nil
+	}
+	if l.file == nil {
+		sv := getGlobalPu().SV
+		file, err := audit.NewFileSink(sv.AuditLogDir, sv.AuditLogMaxSize, int(sv.AuditLogMaxFiles))
+		if err != nil {
+			return nil, err
+		}
+		l.file = file
+	}
+	return l.file, nil
+}
+
+// Close flushes and closes the opened sinks.
+func (l *auditLogger) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var err error
+	for _, sink := range []audit.Sink{l.file, l.table} {
+		if sink == nil {
+			continue
+		}
+		if err2 := sink.Close(); err2 != nil && err == nil {
+			err = err2
+		}
+	}
+	l.file, l.table = nil, nil
+	return err
+}
+
+// write writes the record if the account enables the audit log and the
+// record matches its filter. force ignores the settings except the sink.
+func (l *auditLogger) write(ctx context.Context, ses *Session, vars *SystemVariables, r *audit.Record, force bool) {
+	if vars == nil {
+		return
+	}
+	if !force {
+		enabled, err := valueIsBoolTrue(vars.Get(auditLogVar))
+		if err != nil || !enabled {
+			return
+		}
+		if !auditFilter(vars).Match(r) {
+			return
+		}
+	}
+	l.mu.Lock()
+	r.Node = l.node
+	l.mu.Unlock()
+	sink, err := l.sink(ctx, ses, auditVarString(vars, auditLogSinkVar))
+	if err == nil {
+		err = sink.Write(ctx, r)
+	}
+	if err != nil {
+		ses.Error(ctx, "failed to write the audit record", zap.Error(err))
+	}
+}
+
+func auditFilter(vars *SystemVariables) *audit.Filter {
+	return &audit.Filter{
+		Classes:          audit.ParseClasses(auditVarString(vars, auditLogClassesVar)),
+		IncludeUsers:     audit.ParseList(auditVarString(vars, "audit_log_include_users")),
+		ExcludeUsers:     audit.ParseList(auditVarString(vars, "audit_log_exclude_users")),
+		IncludeDatabases: audit.ParseList(auditVarString(vars, "audit_log_include_databases")),
+		ExcludeDatabases: audit.ParseList(auditVarString(vars, "audit_log_exclude_databases")),
+	}
+}
+
+func auditVarString(vars *SystemVariables, name string) string {
+	s, _ := vars.Get(name).(string)
+	return s
+}
+
+func getAuditLogger(ses *Session) *auditLogger {
+	if rm := ses.getRoutineManager(); rm != nil {
+		return rm.auditor
+	}
+	return nil
+}
+
+func newAuditRecord(ses *Session, event audit.Event, class audit.Class) *audit.Record {
+	r := &audit.Record{
+		Time:         audit.FormatTime(time.Now()),
+		Event:        event,
+		Class:        class,
+		Host:         ses.clientAddr,
+		ConnectionID: ses.GetConnectionID(),
+		SessionID:    uuid.UUID(ses.GetUUID()).String(),
+		Database:     ses.GetDatabaseName(),
+	}
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		r.AccountID = tenant.GetTenantID()
+		r.Account = tenant.GetTenant()
+		r.User = tenant.GetUser()
+		r.Role = tenant.GetDefaultRole()
+	}
+	return r
+}
+
+func setAuditResult(r *audit.Record, err error) {
+	if err == nil {
+		return
+	}
+	code, _, msg := RewriteError(err, r.User)
+	r.Code, r.Error = code, msg
+}
+
+// auditConnect records the result of the authentication of the session.
+func auditConnect(ctx context.Context, ses *Session, authErr error) {
+	l := getAuditLogger(ses)
+	if l == nil {
+		return
+	}
+	if authErr == nil {
+		ses.auditConnected = true
+		l.write(ctx, ses, ses.GetGlobalSysVars(), newAuditRecord(ses, audit.EventConnect, audit.ClassConnect), false)
+		return
+	}
+	r := newAuditRecord(ses, audit.EventFailedConnect, audit.ClassConnect)
+	setAuditResult(r, authErr)
+	l.write(ctx, ses, auditSysVarsOfFailedConnect(ctx, ses), r, false)
+}
+
+// auditSysVarsOfFailedConnect returns the global system variables of the
+// account which the session failed to log in. The account is sys if it
+// does not exist.
+func auditSysVarsOfFailedConnect(ctx context.Context, ses *Session) *SystemVariables {
+	tenant := ses.GetTenantInfo()
+	if tenant == nil {
+		return GSysVarsMgr.cached(sysAccountID)
+	}
+	if vars := GSysVarsMgr.cached(tenant.GetTenantID()); vars != nil {
+		return vars
+	}
+	mp, err := ses.getGlobalSysVars(ctx)
+	if err != nil {
+		ses.Error(ctx, "failed to get the audit settings", zap.Error(err))
+		return nil
+	}
+	return &SystemVariables{mp: mp}
+}
+
+// auditDisconnect records the logout of the session.
+func auditDisconnect(ctx context.Context, ses *Session) {
+	l := getAuditLogger(ses)
+	if l == nil || !ses.auditConnected {
+		return
+	}
+	ses.auditConnected = false
+	l.write(ctx, ses, ses.GetGlobalSysVars(), newAuditRecord(ses, audit.EventDisconnect, audit.ClassConnect), false)
+}
+
+// auditStatement records the statement executed by the client.
+func auditStatement(ctx context.Context, ses *Session, stmt tree.Statement, sql string, err error) {
+	l := getAuditLogger(ses)
+	if l == nil || !ses.auditConnected {
+		return
+	}
+	stmtType := getStatementType(stmt)
+	r := newAuditRecord(ses, audit.EventQuery, auditClassOf(stmtType.GetQueryType()))
+	r.StatementType = stmtType.GetStatementType()
+	r.Statement = sql
+	setAuditResult(r, err)
+	l.write(ctx, ses, ses.GetGlobalSysVars(), r, setsAuditSettings(stmt))
+}
+
+func auditClassOf(queryType string) audit.Class {
+	switch queryType {
+	case tree.QueryTypeDDL:
+		return audit.ClassDDL
+	case tree.QueryTypeDCL:
+		return audit.ClassDCL
+	case tree.QueryTypeDML:
+		return audit.ClassDML
+	case tree.QueryTypeDQL:
+		return audit.ClassDQL
+	case tree.QueryTypeTCL:
+		return audit.ClassTCL
+	}
+	return audit.ClassOther
+}
+
+func setsAuditSettings(stmt tree.Statement) bool {
+	sv, ok := stmt.(*tree.SetVar)
+	if !ok {
+		return false
+	}
+	for _, assign := range sv.Assignments {
+		if assign.System && assign.Global && strings.HasPrefix(strings.ToLower(assign.Name), auditLogVarPrefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/audit"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_auditClassOf(t *testing.T) {
+	ctx := context.TODO()
+	for sql, class := range map[string]audit.Class{
+		"create table t (a int)":       audit.ClassDDL,
+		"grant select on table t to r": audit.ClassDCL,
+		"insert into t values (1)":     audit.ClassDML,
+		"select 1":                     audit.ClassDQL,
+		"commit":                       audit.ClassTCL,
+		"set @a = 1":                   audit.ClassOther,
+	} {
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, sql, 1)
+		require.NoError(t, err)
+		require.Equal(t, class, auditClassOf(getStatementType(stmt).GetQueryType()), sql)
+	}
+}
+
+func Test_setsAuditSettings(t *testing.T) {
+	ctx := context.TODO()
+	for sql, expected := range map[string]bool{
+		"set global audit_log = 0":                       true,
+		"set @@global.audit_log_classes = 'DDL'":         true,
+		"set audit_log_include_users = 'u1'":             false,
+		"set global autocommit = 1":                      false,
+		"set global sql_mode = '', global audit_log = 1": true,
+		"set global sql_mode = '', audit_log = 1":        false,
+	} {
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, sql, 1)
+		require.NoError(t, err)
+		require.Equal(t, expected, setsAuditSettings(stmt), sql)
+	}
+	require.False(t, setsAuditSettings(&tree.Select{}))
+}
+
+func TestAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	dir := t.TempDir()
+	getGlobalPu().SV.AuditLogDir = dir
+	l := newAuditLogger(ctx)
+	l.setNode("cn1")
+	ses.rm = &RoutineManager{auditor: l}
+	ses.clientAddr = "127.0.0.1:12345"
+
+	vars := ses.GetGlobalSysVars()
+	vars.Set(auditLogVar, int64(1))
+	vars.Set(auditLogClassesVar, "CONNECT,DDL")
+	vars.Set("audit_log_exclude_databases", "tmp")
+
+	auditConnect(ctx, ses, nil)
+	require.True(t, ses.auditConnected)
+	run := func(db, sql string, err error) {
+		stmt, err2 := parsers.ParseOne(ctx, dialect.MYSQL, sql, 1)
+		require.NoError(t, err2)
+		ses.SetDatabaseName(db)
+		auditStatement(ctx, ses, stmt, sql, err)
+	}
+	run("db1", "create table t (a int)", nil)
+	run("db1", "insert into t values (1)", nil)
+	run("tmp", "create table t (a int)", nil)
+	run("db1", "drop table t2", moerr.NewNoSuchTable(ctx, "db1", "t2"))
+	vars.Set(auditLogVar, int64(0))
+	run("db1", "set global audit_log = 0", nil)
+	run("db1", "create table t3 (a int)", nil)
+	auditDisconnect(ctx, ses)
+	require.False(t, ses.auditConnected)
+	require.NoError(t, l.Close())
+
+	f, err := os.Open(filepath.Join(dir, audit.FileName))
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := audit.ReadRecords(f)
+	require.NoError(t, err)
+	_, err = audit.Verify(audit.Chain{}, records)
+	require.NoError(t, err)
+
+	require.Len(t, records, 4)
+	require.Equal(t, audit.EventConnect, records[0].Event)
+	require.Equal(t, "cn1", records[0].Node)
+	require.Equal(t, sysAccountName, records[0].Account)
+	require.Equal(t, rootName, records[0].User)
+	require.Equal(t, moAdminRoleName, records[0].Role)
+	require.Equal(t, "127.0.0.1:12345", records[0].Host)
+
+	require.Equal(t, audit.EventQuery, records[1].Event)
+	require.Equal(t, audit.ClassDDL, records[1].Class)
+	require.Equal(t, "db1", records[1].Database)
+	require.Equal(t, "Create Table", records[1].StatementType)
+	require.Equal(t, "create table t (a int)", records[1].Statement)
+	require.Zero(t, records[1].Code)
+
+	require.Equal(t, "drop table t2", records[2].Statement)
+	require.Equal(t, uint16(moerr.ER_NO_SUCH_TABLE), records[2].Code)
+	require.NotEmpty(t, records[2].Error)
+
+	// the audit log is turned off
+	require.Equal(t, "set global audit_log = 0", records[3].Statement)
+}
+
+func TestAuditFailedConnect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.TODO()
+
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	dir := t.TempDir()
+	getGlobalPu().SV.AuditLogDir = dir
+	l := newAuditLogger(ctx)
+	ses.rm = &RoutineManager{auditor: l}
+
+	vars := &SystemVariables{mp: map[string]interface{}{
+		auditLogVar:        int64(1),
+		auditLogSinkVar:    "FILE",
+		auditLogClassesVar: "CONNECT",
+	}}
+	GSysVarsMgr.Put(sysAccountID, vars)
+	defer GSysVarsMgr.Put(sysAccountID, ses.GetGlobalSysVars())
+
+	auditConnect(ctx, ses, moerr.NewInternalError(ctx, "check password failed"))
+	require.False(t, ses.auditConnected)
+	// the statements of the session are not recorded
+	auditStatement(ctx, ses, &tree.CreateTable{}, "create table t (a int)", nil)
+	require.NoError(t, l.Close())
+
+	f, err := os.Open(filepath.Join(dir, audit.FileName))
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := audit.ReadRecords(f)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, audit.EventFailedConnect, records[0].Event)
+	require.NotZero(t, records[0].Code)
+}
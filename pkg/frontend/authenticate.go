@@ -918,6 +918,7 @@ var (
 		"mo_variables":                0,
 		"mo_transactions":             0,
 		"mo_cache":                    0,
+		"mo_query_memory":             0,
 		"mo_snapshots":                0,
 	}
 	sysAccountTables = map[string]struct{}{
@@ -925,6 +926,9 @@ var (
 		catalog.MOUpgradeTable:       {},
 		catalog.MOUpgradeTenantTable: {},
 		catalog.MO_PITR:              {},
+		catalog.MO_CDC_WATERMARK:     {},
+		catalog.MO_AUDIT_LOG:         {},
+		catalog.MO_COLUMN_STATISTICS: {},
 	}
 	//predefined tables of the database mo_catalog in every account
 	predefinedTables = map[string]int8{
@@ -951,6 +955,7 @@ var (
 		"mo_variables":                0,
 		"mo_transactions":             0,
 		"mo_cache":                    0,
+		"mo_query_memory":             0,
 		"mo_foreign_keys":             0,
 		"mo_snapshots":                0,
 		"mo_subs":                     0,
@@ -987,6 +992,7 @@ var (
 		MoCatalogMoVariablesDDL,
 		MoCatalogMoTransactionsDDL,
 		MoCatalogMoCacheDDL,
+		MoCatalogMoQueryMemoryDDL,
 	}
 
 	//drop tables for the tenant
@@ -1005,6 +1011,7 @@ var (
 		`drop view if exists mo_catalog.mo_variables;`,
 		`drop view if exists mo_catalog.mo_transactions;`,
 		`drop view if exists mo_catalog.mo_cache;`,
+		`drop view if exists mo_catalog.mo_query_memory;`,
 		`drop table if exists mo_catalog.mo_snapshots;`,
 	}
 	dropMoMysqlCompatibilityModeSql = `drop table if exists mo_catalog.mo_mysql_compatibility_mode;`
@@ -1119,8 +1126,11 @@ var (
 				login_type,
 				creator,
 				owner,
-				default_role
-    		) values(%d,"%s","%s","%s","%s","%s",%s,"%s",%d,%d,%d);`
+				default_role,
+				pg_auth_string,
+				auth_plugin,
+				sha2_auth_string
+    		) values(%d,"%s","%s","%s","%s","%s",%s,"%s",%d,%d,%d,"%s","%s","%s");`
 	initMoUserWithoutIDFormat = `insert into mo_catalog.mo_user(
 				user_host,
 				user_name,
@@ -1131,8 +1141,11 @@ var (
 				login_type,
 				creator,
 				owner,
-				default_role
-    		) values("%s","%s","%s","%s","%s",%s,"%s",%d,%d,%d);`
+				default_role,
+				pg_auth_string,
+				auth_plugin,
+				sha2_auth_string
+    		) values("%s","%s","%s","%s","%s",%s,"%s",%d,%d,%d,"%s","%s","%s");`
 	initMoRolePrivFormat = `insert into mo_catalog.mo_role_privs(
 				role_id,
 				role_name,
@@ -1169,7 +1182,29 @@ const (
 
 	getPasswordOfUserFormat = `select user_id,authentication_string,default_role from mo_catalog.mo_user where user_name = "%s" order by user_id;`
 
-	updatePasswordOfUserFormat = `update mo_catalog.mo_user set authentication_string = "%s" where user_name = "%s" order by user_id;`
+	updatePasswordOfUserFormat = `update mo_catalog.mo_user set authentication_string = "%s", pg_auth_string = "%s", sha2_auth_string = "%s" where user_name = "%s" order by user_id;`
+
+	updateAuthPluginOfUserFormat = `update mo_catalog.mo_user set auth_plugin = "%s" where user_name = "%s" order by user_id;`
+
+	getPgAuthStringOfUserFormat = `select pg_auth_string from mo_catalog.mo_user where user_name = "%s" order by user_id;`
+
+	getAuthPluginOfUserFormat = `select auth_plugin, sha2_auth_string from mo_catalog.mo_user where user_name = "%s" order by user_id;`
+
+	getLoginStateOfUserFormat = `select status, coalesce(failed_logins, 0), unix_timestamp(locked_time), unix_timestamp(coalesce(password_last_changed, created_time)) from mo_catalog.mo_user where user_id = %d;`
+
+	increaseFailedLoginsOfUserFormat = `update mo_catalog.mo_user set failed_logins = coalesce(failed_logins, 0) + 1 where user_id = %d;`
+
+	lockUserOfFailedLoginsFormat = `update mo_catalog.mo_user set locked_time = from_unixtime(%d) where user_id = %d and locked_time is null;`
+
+	resetFailedLoginsOfUserFormat = `update mo_catalog.mo_user set failed_logins = 0, locked_time = null where user_id = %d;`
+
+	updateStatusOfUserFormat = `update mo_catalog.mo_user set status = "%s", failed_logins = 0, locked_time = null where user_name = "%s" order by user_id;`
+
+	getPasswordHistoryOfUserFormat = `select authentication_string, unix_timestamp(coalesce(password_last_changed, created_time)), coalesce(password_history, "") from mo_catalog.mo_user where user_name = "%s" order by user_id;`
+
+	updatePasswordHistoryOfUserFormat = `update mo_catalog.mo_user set password_last_changed = from_unixtime(%d), password_history = '%s' where user_name = "%s" order by user_id;`
+
+	resetPasswordStateOfUserFormat = `update mo_catalog.mo_user set password_last_changed = from_unixtime(%d), failed_logins = 0, locked_time = null where user_name = "%s" order by user_id;`
 
 	checkRoleExistsFormat = `select role_id from mo_catalog.mo_role where role_id = %d and role_name = "%s";`
 
@@ -1625,12 +1660,85 @@ func getSqlForPasswordOfUser(ctx context.Context, user string) (string, error) {
 	return fmt.Sprintf(getPasswordOfUserFormat, user), nil
 }
 
-func getSqlForUpdatePasswordOfUser(ctx context.Context, password, user string) (string, error) {
+func getSqlForUpdatePasswordOfUser(ctx context.Context, password, pgAuthString, sha2AuthString, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(updatePasswordOfUserFormat, password, pgAuthString, sha2AuthString, user), nil
+}
+
+func getSqlForUpdateAuthPluginOfUser(ctx context.Context, authPlugin, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(updateAuthPluginOfUserFormat, authPlugin, user), nil
+}
+
+func getSqlForPgAuthStringOfUser(ctx context.Context, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(getPgAuthStringOfUserFormat, user), nil
+}
+
+func getSqlForAuthPluginOfUser(ctx context.Context, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(getAuthPluginOfUserFormat, user), nil
+}
+
+func getSqlForLoginStateOfUser(userID int64) string {
+	return fmt.Sprintf(getLoginStateOfUserFormat, userID)
+}
+
+func getSqlForIncreaseFailedLoginsOfUser(userID int64) string {
+	return fmt.Sprintf(increaseFailedLoginsOfUserFormat, userID)
+}
+
+func getSqlForLockUserOfFailedLogins(lockedTime, userID int64) string {
+	return fmt.Sprintf(lockUserOfFailedLoginsFormat, lockedTime, userID)
+}
+
+func getSqlForResetFailedLoginsOfUser(userID int64) string {
+	return fmt.Sprintf(resetFailedLoginsOfUserFormat, userID)
+}
+
+func getSqlForUpdateStatusOfUser(ctx context.Context, status, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(updateStatusOfUserFormat, status, user), nil
+}
+
+func getSqlForPasswordHistoryOfUser(ctx context.Context, user string) (string, error) {
 	err := inputNameIsInvalid(ctx, user)
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf(updatePasswordOfUserFormat, password, user), nil
+	return fmt.Sprintf(getPasswordHistoryOfUserFormat, user), nil
+}
+
+func getSqlForUpdatePasswordHistoryOfUser(ctx context.Context, changedTime int64, history, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	history = strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(history)
+	return fmt.Sprintf(updatePasswordHistoryOfUserFormat, changedTime, history, user), nil
+}
+
+func getSqlForResetPasswordStateOfUser(ctx context.Context, changedTime int64, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(resetPasswordStateOfUserFormat, changedTime, user), nil
 }
 
 func getSqlForCheckRoleExists(ctx context.Context, roleID int, roleName string) (string, error) {
@@ -2627,6 +2735,299 @@ func finishTxn(ctx context.Context, bh BackgroundExec, err error) error {
 	return rollbackTxn()
 }
 
+const secondsPerDay = 24 * 60 * 60
+
+// loginPolicy is the failed-login and the password policy of an account. It
+// is set by the global system variables of the account:
+//
+//   - failed_login_attempts is the number of the consecutive failed logins
+//     which lock the user, 0 disables the lock.
+//   - password_lock_time is the days the user is locked for, -1 means the
+//     user is locked until ALTER USER ... ACCOUNT UNLOCK.
+//   - default_password_lifetime is the days the password expires in after
+//     it is changed, 0 means the password never expires.
+//   - password_history is the number of the latest passwords which can not
+//     be reused.
+//   - password_reuse_interval is the days the password can not be reused in
+//     after it is changed.
+type loginPolicy struct {
+	failedLoginAttempts   int64
+	passwordLockTime      int64
+	passwordLifetime      int64
+	passwordHistory       int64
+	passwordReuseInterval int64
+}
+
+func getLoginPolicy(vars *SystemVariables) loginPolicy {
+	if vars == nil {
+		return loginPolicy{}
+	}
+	get := func(name string) int64 {
+		v, _ := vars.Get(name).(int64)
+		return v
+	}
+	return loginPolicy{
+		failedLoginAttempts:   get("failed_login_attempts"),
+		passwordLockTime:      get("password_lock_time"),
+		passwordLifetime:      get("default_password_lifetime"),
+		passwordHistory:       get("password_history"),
+		passwordReuseInterval: get("password_reuse_interval"),
+	}
+}
+
+func (p loginPolicy) locksFailedLogins() bool {
+	return p.failedLoginAttempts > 0 && p.passwordLockTime != 0
+}
+
+func (p loginPolicy) checksPasswordReuse() bool {
+	return p.passwordHistory > 0 || p.passwordReuseInterval > 0
+}
+
+// loginState is the lock state and the password state of the user in
+// mo_user. The times are unix seconds.
+type loginState struct {
+	status       string
+	failedLogins int64
+	// lockedTime is the time the user is locked by the failed logins, it is
+	// 0 if the user is not locked by the failed logins.
+	lockedTime int64
+	// passwordChanged is the time the password is changed, it is the time
+	// the user is created if the password has never been changed.
+	passwordChanged int64
+}
+
+func getLoginStateOfUser(ctx context.Context, ses *Session, userID int64) (*loginState, error) {
+	rsset, err := executeSQLInBackgroundSession(ctx, ses, getSqlForLoginStateOfUser(userID))
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(rsset) {
+		return nil, moerr.NewInternalError(ctx, "there is no user %d", userID)
+	}
+	st := &loginState{}
+	if st.status, err = rsset[0].GetString(ctx, 0, 0); err != nil {
+		return nil, err
+	}
+	if st.failedLogins, err = rsset[0].GetInt64(ctx, 0, 1); err != nil {
+		return nil, err
+	}
+	if st.lockedTime, err = getNullableInt64(ctx, rsset[0], 0, 2); err != nil {
+		return nil, err
+	}
+	if st.passwordChanged, err = getNullableInt64(ctx, rsset[0], 0, 3); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// getNullableInt64 returns 0 for NULL.
+func getNullableInt64(ctx context.Context, rs ExecResult, rindex, cindex uint64) (int64, error) {
+	isNull, err := rs.ColumnIsNull(ctx, rindex, cindex)
+	if err != nil || isNull {
+		return 0, err
+	}
+	return rs.GetInt64(ctx, rindex, cindex)
+}
+
+// lockError returns the error if the user is locked at now.
+func (p loginPolicy) lockError(ctx context.Context, user string, st *loginState, now int64) error {
+	if st.status == userStatusLock {
+		return moerr.NewInternalError(ctx, "user %s is locked", user)
+	}
+	if st.lockedTime == 0 || !p.locksFailedLogins() {
+		return nil
+	}
+	if p.passwordLockTime < 0 {
+		return moerr.NewInternalError(ctx, "user %s is locked due to %d consecutive failed logins", user, st.failedLogins)
+	}
+	remaining := st.lockedTime + p.passwordLockTime*secondsPerDay - now
+	if remaining <= 0 {
+		return nil
+	}
+	return moerr.NewInternalError(ctx, "user %s is locked for %d day(s) (%d day(s) remaining) due to %d consecutive failed logins",
+		user, p.passwordLockTime, (remaining+secondsPerDay-1)/secondsPerDay, st.failedLogins)
+}
+
+// passwordExpired returns true if the password has reached its lifetime.
+func (p loginPolicy) passwordExpired(st *loginState, now int64) bool {
+	return p.passwordLifetime > 0 && st.passwordChanged > 0 &&
+		now >= st.passwordChanged+p.passwordLifetime*secondsPerDay
+}
+
+// checkLoginState returns the error if the user is locked. The failed logins
+// are cleared if the lock has expired or been disabled by the policy.
+func checkLoginState(ctx context.Context, ses *Session, user string, userID int64, p loginPolicy, st *loginState, now int64) error {
+	if err := p.lockError(ctx, user, st, now); err != nil {
+		return err
+	}
+	if st.lockedTime != 0 {
+		if _, err := executeSQLInBackgroundSession(ctx, ses, getSqlForResetFailedLoginsOfUser(userID)); err != nil {
+			return err
+		}
+		st.failedLogins, st.lockedTime = 0, 0
+	}
+	return nil
+}
+
+// recordFailedLogin counts the failed login of the user, and locks the user
+// once the failed logins reach failed_login_attempts. It returns the error
+// for the client.
+func recordFailedLogin(ctx context.Context, ses *Session, user string, userID int64, p loginPolicy, now int64) error {
+	checkErr := moerr.NewInternalError(ctx, "check password failed")
+	if !p.locksFailedLogins() {
+		return checkErr
+	}
+	// the failed logins are counted in mo_user, so they are shared by the CNs
+	if _, err := executeSQLInBackgroundSession(ctx, ses, getSqlForIncreaseFailedLoginsOfUser(userID)); err != nil {
+		ses.Errorf(ctx, "failed to record the failed login: %s", err.Error())
+		return checkErr
+	}
+	st, err := getLoginStateOfUser(ctx, ses, userID)
+	if err != nil {
+		ses.Errorf(ctx, "failed to record the failed login: %s", err.Error())
+		return checkErr
+	}
+	if st.failedLogins < p.failedLoginAttempts {
+		return checkErr
+	}
+	if st.lockedTime == 0 {
+		if _, err = executeSQLInBackgroundSession(ctx, ses, getSqlForLockUserOfFailedLogins(now, userID)); err != nil {
+			ses.Errorf(ctx, "failed to lock the user: %s", err.Error())
+			return checkErr
+		}
+		st.lockedTime = now
+	}
+	if err = p.lockError(ctx, user, st, now); err != nil {
+		return err
+	}
+	return checkErr
+}
+
+// checkPasswordExpired rejects the statement if the password of the user has
+// expired, except ALTER USER and SET.
+func checkPasswordExpired(ctx context.Context, ses *Session, stmt tree.Statement) error {
+	if !ses.passwordExpired {
+		return nil
+	}
+	switch stmt.(type) {
+	case *tree.AlterUser, *tree.SetVar:
+		return nil
+	}
+	return moerr.NewInternalError(ctx, "you must reset your password using ALTER USER statement before executing this statement")
+}
+
+// passwordHistoryEntry is a password used by the user in mo_user.password_history.
+type passwordHistoryEntry struct {
+	// Password is the authentication_string of the password.
+	Password string `json:"password"`
+	// Time is the unix seconds the password is set.
+	Time int64 `json:"time"`
+}
+
+// getPasswordHistoryOfUser returns the passwords of the user from the oldest
+// to the current one.
+func getPasswordHistoryOfUser(ctx context.Context, bh BackgroundExec, user string) ([]passwordHistoryEntry, error) {
+	sql, err := getSqlForPasswordHistoryOfUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	current, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	changed, err := getNullableInt64(ctx, erArray[0], 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	data, err := erArray[0].GetString(ctx, 0, 2)
+	if err != nil {
+		return nil, err
+	}
+	var history []passwordHistoryEntry
+	if len(data) != 0 {
+		if err = json.Unmarshal([]byte(data), &history); err != nil {
+			return nil, err
+		}
+	}
+	return append(history, passwordHistoryEntry{Password: current, Time: changed}), nil
+}
+
+// checkPasswordReuse returns the error if the password is one of the latest
+// password_history passwords or it was set in password_reuse_interval days.
+func (p loginPolicy) checkPasswordReuse(ctx context.Context, user, host, password string, history []passwordHistoryEntry, now int64) error {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Password == password && p.keepsPassword(len(history)-1-i, history[i].Time, now) {
+			return moerr.NewInternalError(ctx, "cannot use these credentials for '%s'@'%s' because they contradict the password history policy", user, host)
+		}
+	}
+	return nil
+}
+
+// prunePasswordHistory removes the passwords which are not checked by the
+// policy any more.
+func (p loginPolicy) prunePasswordHistory(history []passwordHistoryEntry, now int64) []passwordHistoryEntry {
+	var kept []passwordHistoryEntry
+	for i := range history {
+		if p.keepsPassword(len(history)-1-i, history[i].Time, now) {
+			kept = append(kept, history[i])
+		}
+	}
+	return kept
+}
+
+// keepsPassword returns true if the password which is the nth latest one and
+// set at the time can not be reused.
+func (p loginPolicy) keepsPassword(nth int, time, now int64) bool {
+	return int64(nth) < p.passwordHistory ||
+		p.passwordReuseInterval > 0 && now < time+p.passwordReuseInterval*secondsPerDay
+}
+
+// updatePasswordOfUser changes the password of the user after it is checked
+// by the password reuse policy.
+func updatePasswordOfUser(ctx context.Context, bh BackgroundExec, p loginPolicy, user, host, password string, now int64) error {
+	var history []passwordHistoryEntry
+	var err error
+	encryption := HashPassWord(password)
+	if p.checksPasswordReuse() {
+		if history, err = getPasswordHistoryOfUser(ctx, bh, user); err != nil {
+			return err
+		}
+		if err = p.checkPasswordReuse(ctx, user, host, encryption, history, now); err != nil {
+			return err
+		}
+	}
+
+	sql, err := getSqlForUpdatePasswordOfUser(ctx, encryption, HashPgPassWord(password), HashSha2PassWord(password), user)
+	if err != nil {
+		return err
+	}
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+
+	var data []byte
+	if history = p.prunePasswordHistory(history, now); len(history) != 0 {
+		if data, err = json.Marshal(history); err != nil {
+			return err
+		}
+	}
+	if sql, err = getSqlForUpdatePasswordHistoryOfUser(ctx, now, string(data), user); err != nil {
+		return err
+	}
+	return bh.Exec(ctx, sql)
+}
+
 type alterUser struct {
 	IfExists bool
 	Users    []*user
@@ -2637,18 +3038,19 @@ type alterUser struct {
 }
 
 type user struct {
-	Username  string
-	Hostname  string
-	AuthExist bool
-	IdentTyp  tree.AccountIdentifiedOption
-	IdentStr  string
+	Username   string
+	Hostname   string
+	AuthExist  bool
+	IdentTyp   tree.AccountIdentifiedOption
+	IdentStr   string
+	AuthPlugin string
 }
 
 func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 	var sql string
 	var vr *verifiedRole
 	var erArray []ExecResult
-	var encryption string
+	var status string
 	account := ses.GetTenantInfo()
 	currentUser := account.GetUser()
 
@@ -2657,7 +3059,14 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 		return moerr.NewInternalError(ctx, "not support alter role")
 	}
 	if au.MiscOpt != nil {
-		return moerr.NewInternalError(ctx, "not support password or lock operation")
+		switch au.MiscOpt.(type) {
+		case *tree.UserMiscOptionAccountLock:
+			status = userStatusLock
+		case *tree.UserMiscOptionAccountUnlock:
+			status = userStatusUnlock
+		default:
+			return moerr.NewInternalError(ctx, "not support password or lock operation")
+		}
 	}
 	if au.CommentOrAttribute.Exist {
 		return moerr.NewInternalError(ctx, "not support alter comment or attribute")
@@ -2676,24 +3085,34 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 	}
 	hostName := user.Hostname
 	password := user.IdentStr
-	if len(password) == 0 {
+	if (user.AuthExist || len(status) == 0) && len(password) == 0 {
 		return moerr.NewInternalError(ctx, "password is empty string")
 	}
 	//put it into the single transaction
 	err = bh.Exec(ctx, "begin")
 	defer func() {
 		err = finishTxn(ctx, bh, err)
+		// the user can run the statements after changing the expired password
+		if err == nil && user.AuthExist && currentUser == userName {
+			ses.passwordExpired = false
+		}
 	}()
 	if err != nil {
 		return err
 	}
 
-	if !user.AuthExist {
+	if !user.AuthExist && len(status) == 0 {
 		return moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', alter Auth is nil", userName, hostName)
 	}
 
-	if user.IdentTyp != tree.AccountIdentifiedByPassword {
-		return moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', only support alter Auth by identified by", userName, hostName)
+	if user.AuthExist {
+		if user.IdentTyp != tree.AccountIdentifiedByPassword {
+			return moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', only support alter Auth by identified by", userName, hostName)
+		}
+
+		if err = checkAuthPlugin(ctx, user.AuthPlugin); err != nil {
+			return err
+		}
 	}
 
 	//check the user exists or not
@@ -2719,7 +3138,7 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 
 	//if the user is admin user with the role moadmin or accountadmin,
 	//the user can be altered
-	//otherwise only general user can alter itself
+	//otherwise only general user can alter its own password
 	if account.IsSysTenant() {
 		sql, err = getSqlForCheckUserHasRole(ctx, currentUser, moAdminRoleID)
 	} else {
@@ -2740,23 +3159,34 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 		return err
 	}
 
-	//encryption the password
-	encryption = HashPassWord(password)
-
-	if execResultArrayHasData(erArray) || getGlobalPu().SV.SkipCheckPrivilege {
-		sql, err = getSqlForUpdatePasswordOfUser(ctx, encryption, userName)
-		if err != nil {
-			return err
+	if !execResultArrayHasData(erArray) && !getGlobalPu().SV.SkipCheckPrivilege {
+		if currentUser != userName || len(status) != 0 {
+			return moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', don't have the privilege to alter", userName, hostName)
 		}
-		err = bh.Exec(ctx, sql)
+	}
+
+	if user.AuthExist {
+		err = updatePasswordOfUser(ctx, bh, getLoginPolicy(ses.GetGlobalSysVars()), userName, hostName, password, time.Now().Unix())
 		if err != nil {
 			return err
 		}
-	} else {
-		if currentUser != userName {
-			return moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', don't have the privilege to alter", userName, hostName)
+
+		//the authentication plugin is changed by IDENTIFIED WITH
+		if len(user.AuthPlugin) != 0 {
+			sql, err = getSqlForUpdateAuthPluginOfUser(ctx, strings.ToLower(user.AuthPlugin), userName)
+			if err != nil {
+				return err
+			}
+			err = bh.Exec(ctx, sql)
+			if err != nil {
+				return err
+			}
 		}
-		sql, err = getSqlForUpdatePasswordOfUser(ctx, encryption, userName)
+	}
+
+	//ACCOUNT LOCK or ACCOUNT UNLOCK, the failed logins are cleared as well
+	if len(status) != 0 {
+		sql, err = getSqlForUpdateStatusOfUser(ctx, status, userName)
 		if err != nil {
 			return err
 		}
@@ -2928,7 +3358,18 @@ func doAlterAccount(ctx context.Context, ses *Session, aa *alterAccount) (err er
 				//2, update the password
 				//encryption the password
 				encryption := HashPassWord(aa.IdentStr)
-				sql, rtnErr = getSqlForUpdatePasswordOfUser(ctx, encryption, aa.AdminName)
+				sql, rtnErr = getSqlForUpdatePasswordOfUser(ctx, encryption, HashPgPassWord(aa.IdentStr), HashSha2PassWord(aa.IdentStr), aa.AdminName)
+				if rtnErr != nil {
+					return rtnErr
+				}
+				bh.ClearExecResultSet()
+				rtnErr = bh.Exec(accountCtx, sql)
+				if rtnErr != nil {
+					return rtnErr
+				}
+
+				//the admin locked by the failed logins can log in with the new password
+				sql, rtnErr = getSqlForResetPasswordStateOfUser(ctx, time.Now().Unix(), aa.AdminName)
 				if rtnErr != nil {
 					return rtnErr
 				}
@@ -5347,7 +5788,7 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		*tree.ShowTableNumber, *tree.ShowColumnNumber,
 		*tree.ShowTableValues, *tree.ShowNodeList, *tree.ShowRolesStmt,
 		*tree.ShowLocks, *tree.ShowFunctionOrProcedureStatus, *tree.ShowPublications, *tree.ShowSubscriptions,
-		*tree.ShowBackendServers, *tree.ShowStages, *tree.ShowConnectors, *tree.DropConnector,
+		*tree.ShowBackendServers, *tree.ShowMasterStatus, *tree.ShowStages, *tree.ShowConnectors, *tree.DropConnector,
 		*tree.PauseDaemonTask, *tree.CancelDaemonTask, *tree.ResumeDaemonTask:
 		objType = objectTypeNone
 		kind = privilegeKindNone
@@ -7408,7 +7849,8 @@ func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *createAccoun
 	//the first user id in the general tenant
 	initMoUser1 := fmt.Sprintf(initMoUserFormat, newTenant.GetUserID(), rootHost, name, encryption, status,
 		types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType,
-		newTenant.GetUserID(), newTenant.GetDefaultRoleID(), accountAdminRoleID)
+		newTenant.GetUserID(), newTenant.GetDefaultRoleID(), accountAdminRoleID, HashPgPassWord(password),
+		AuthNativePassword, HashSha2PassWord(password))
 	addSqlIntoSet(initMoUser1)
 
 	//step4: add new entries to the mo_role_privs
@@ -7735,6 +8177,14 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *createU
 			return moerr.NewInternalError(ctx, "only support password verification now")
 		}
 
+		if err = checkAuthPlugin(ctx, user.AuthPlugin); err != nil {
+			return err
+		}
+		authPlugin := AuthNativePassword
+		if len(user.AuthPlugin) != 0 {
+			authPlugin = strings.ToLower(user.AuthPlugin)
+		}
+
 		password := user.IdentStr
 		if len(password) == 0 {
 			return moerr.NewInternalError(ctx, "password is empty string")
@@ -7750,7 +8200,8 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *createU
 		}
 		initMoUser1 := fmt.Sprintf(initMoUserWithoutIDFormat, host, user.Username, encryption, status,
 			types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType,
-			tenant.GetUserID(), tenant.GetDefaultRoleID(), newRoleId)
+			tenant.GetUserID(), tenant.GetDefaultRoleID(), newRoleId, HashPgPassWord(password),
+			authPlugin, HashSha2PassWord(password))
 
 		bh.ClearExecResultSet()
 		err = bh.Exec(ctx, initMoUser1)
@@ -6733,7 +6733,7 @@ func Test_doAlterUser(t *testing.T) {
 		}
 
 		for _, user := range stmt.Users {
-			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), user.Username)
+			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), "", "", user.Username)
 			bh.sql2result[sql] = nil
 		}
 
@@ -6786,7 +6786,7 @@ func Test_doAlterUser(t *testing.T) {
 		}
 
 		for _, user := range stmt.Users {
-			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), user.Username)
+			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), "", "", user.Username)
 			bh.sql2result[sql] = nil
 		}
 
@@ -6836,7 +6836,7 @@ func Test_doAlterUser(t *testing.T) {
 		}
 
 		for _, user := range stmt.Users {
-			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), user.Username)
+			sql, _ := getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(user.AuthOption.Str), "", "", user.Username)
 			bh.sql2result[sql] = nil
 		}
 
@@ -6909,7 +6909,7 @@ func Test_doAlterAccount(t *testing.T) {
 			{10, "111", 0},
 		})
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), "", "", mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
 		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
@@ -6963,7 +6963,7 @@ func Test_doAlterAccount(t *testing.T) {
 			{10, "111", 0},
 		})
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), "", "", mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
 		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
@@ -7012,7 +7012,7 @@ func Test_doAlterAccount(t *testing.T) {
 		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), "", "", mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
 		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
@@ -7063,7 +7063,7 @@ func Test_doAlterAccount(t *testing.T) {
 		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), "", "", mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
 		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
@@ -7116,7 +7116,7 @@ func Test_doAlterAccount(t *testing.T) {
 		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{})
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), "", "", mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = newMrsForCheckTenant([][]interface{}{
 			{0, 0, 0, 0},
 		})
@@ -7160,7 +7160,7 @@ func Test_doAlterAccount(t *testing.T) {
 		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), "", "", mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
 		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
@@ -7213,7 +7213,7 @@ func Test_doAlterAccount(t *testing.T) {
 		sql, _ = getSqlForPasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
-		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), mustUnboxExprStr(stmt.AuthOption.AdminName))
+		sql, _ = getSqlForUpdatePasswordOfUser(context.TODO(), mustUnboxExprStr(stmt.AuthOption.IdentifiedType.Str), "", "", mustUnboxExprStr(stmt.AuthOption.AdminName))
 		bh.sql2result[sql] = nil
 
 		err := doAlterAccount(ses.GetTxnHandler().GetTxnCtx(), ses, alterAcountFromStmt(stmt))
@@ -11564,3 +11564,300 @@ func TestCheckTimeStampValid(t *testing.T) {
 		convey.So(valid, convey.ShouldBeTrue)
 	})
 }
+
+func Test_loginPolicy(t *testing.T) {
+	vars := &SystemVariables{mp: map[string]interface{}{
+		"failed_login_attempts":     int64(3),
+		"password_lock_time":        int64(2),
+		"default_password_lifetime": int64(30),
+		"password_history":          int64(2),
+		"password_reuse_interval":   int64(10),
+	}}
+	p := getLoginPolicy(vars)
+	require.Equal(t, loginPolicy{
+		failedLoginAttempts:   3,
+		passwordLockTime:      2,
+		passwordLifetime:      30,
+		passwordHistory:       2,
+		passwordReuseInterval: 10,
+	}, p)
+	require.Equal(t, loginPolicy{}, getLoginPolicy(nil))
+	require.True(t, p.locksFailedLogins())
+	require.False(t, loginPolicy{failedLoginAttempts: 3}.locksFailedLogins())
+	require.True(t, p.checksPasswordReuse())
+	require.False(t, loginPolicy{}.checksPasswordReuse())
+
+	ctx := context.TODO()
+	now := int64(100 * secondsPerDay)
+
+	// locked by ALTER USER ... ACCOUNT LOCK
+	err := p.lockError(ctx, "u1", &loginState{status: userStatusLock}, now)
+	require.ErrorContains(t, err, "user u1 is locked")
+	require.True(t, needConvertedToAccessDeniedError(err.Error()))
+
+	// locked by the failed logins
+	st := &loginState{status: userStatusUnlock, failedLogins: 3, lockedTime: now - secondsPerDay/2}
+	require.ErrorContains(t, p.lockError(ctx, "u1", st, now), "locked for 2 day(s) (2 day(s) remaining) due to 3 consecutive failed logins")
+	require.ErrorContains(t, p.lockError(ctx, "u1", st, now+secondsPerDay), "(1 day(s) remaining)")
+	require.NoError(t, p.lockError(ctx, "u1", st, now+2*secondsPerDay))
+	unbounded := p
+	unbounded.passwordLockTime = -1
+	require.ErrorContains(t, unbounded.lockError(ctx, "u1", st, now+100*secondsPerDay), "user u1 is locked due to 3 consecutive failed logins")
+	// the lock is disabled
+	require.NoError(t, loginPolicy{}.lockError(ctx, "u1", st, now))
+	require.NoError(t, p.lockError(ctx, "u1", &loginState{status: userStatusUnlock}, now))
+
+	// the password lifetime
+	require.False(t, p.passwordExpired(&loginState{passwordChanged: now - 29*secondsPerDay}, now))
+	require.True(t, p.passwordExpired(&loginState{passwordChanged: now - 30*secondsPerDay}, now))
+	require.False(t, loginPolicy{}.passwordExpired(&loginState{passwordChanged: 1}, now))
+}
+
+func Test_passwordHistory(t *testing.T) {
+	ctx := context.TODO()
+	now := int64(100 * secondsPerDay)
+	history := []passwordHistoryEntry{
+		{Password: "p1", Time: now - 50*secondsPerDay},
+		{Password: "p2", Time: now - 20*secondsPerDay},
+		{Password: "p3", Time: now - 5*secondsPerDay},
+		{Password: "p4", Time: now - secondsPerDay},
+	}
+
+	// the latest two passwords
+	p := loginPolicy{passwordHistory: 2}
+	require.Error(t, p.checkPasswordReuse(ctx, "u1", "%", "p4", history, now))
+	require.ErrorContains(t, p.checkPasswordReuse(ctx, "u1", "%", "p3", history, now), "contradict the password history policy")
+	require.NoError(t, p.checkPasswordReuse(ctx, "u1", "%", "p2", history, now))
+	require.Equal(t, history[2:], p.prunePasswordHistory(history, now))
+
+	// the passwords set in 30 days
+	p = loginPolicy{passwordReuseInterval: 30}
+	require.Error(t, p.checkPasswordReuse(ctx, "u1", "%", "p2", history, now))
+	require.NoError(t, p.checkPasswordReuse(ctx, "u1", "%", "p1", history, now))
+	require.NoError(t, p.checkPasswordReuse(ctx, "u1", "%", "p5", history, now))
+	require.Equal(t, history[1:], p.prunePasswordHistory(history, now))
+
+	require.Empty(t, loginPolicy{}.prunePasswordHistory(history, now))
+}
+
+func newMrsForLoginStateOfUser(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"status", "failed_logins", "locked_time", "password_last_changed"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_failedLogins(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	ses := newSes(nil, ctrl)
+	ctx := context.TODO()
+	now := int64(100 * secondsPerDay)
+	p := loginPolicy{failedLoginAttempts: 3, passwordLockTime: 1}
+
+	st, err := getLoginStateOfUser(ctx, ses, 1)
+	require.Error(t, err)
+	require.Nil(t, st)
+
+	// the updates have no result set
+	bh.sql2result[getSqlForIncreaseFailedLoginsOfUser(1)] = &MysqlResultSet{}
+	bh.sql2result[getSqlForLockUserOfFailedLogins(now, 1)] = &MysqlResultSet{}
+	bh.sql2result[getSqlForResetFailedLoginsOfUser(1)] = &MysqlResultSet{}
+
+	bh.sql2result[getSqlForLoginStateOfUser(1)] = newMrsForLoginStateOfUser([][]interface{}{
+		{userStatusUnlock, int64(1), nil, now - secondsPerDay},
+	})
+	st, err = getLoginStateOfUser(ctx, ses, 1)
+	require.NoError(t, err)
+	require.Equal(t, &loginState{status: userStatusUnlock, failedLogins: 1, passwordChanged: now - secondsPerDay}, st)
+	require.NoError(t, checkLoginState(ctx, ses, "u1", 1, p, st, now))
+
+	// the failed logins have not reached the threshold
+	bh.sql2result[getSqlForLoginStateOfUser(1)] = newMrsForLoginStateOfUser([][]interface{}{
+		{userStatusUnlock, int64(2), nil, now - secondsPerDay},
+	})
+	err = recordFailedLogin(ctx, ses, "u1", 1, p, now)
+	require.ErrorContains(t, err, "check password failed")
+
+	// the user is locked by the third failed login
+	bh.sql2result[getSqlForLoginStateOfUser(1)] = newMrsForLoginStateOfUser([][]interface{}{
+		{userStatusUnlock, int64(3), nil, now - secondsPerDay},
+	})
+	err = recordFailedLogin(ctx, ses, "u1", 1, p, now)
+	require.ErrorContains(t, err, "user u1 is locked for 1 day(s)")
+
+	// the lock has expired
+	st = &loginState{status: userStatusUnlock, failedLogins: 3, lockedTime: now - secondsPerDay}
+	require.NoError(t, checkLoginState(ctx, ses, "u1", 1, p, st, now))
+	require.Equal(t, int64(0), st.failedLogins)
+	require.Equal(t, getSqlForResetFailedLoginsOfUser(1), bh.currentSql)
+
+	// the lock is disabled
+	err = recordFailedLogin(ctx, ses, "u1", 1, loginPolicy{}, now)
+	require.ErrorContains(t, err, "check password failed")
+}
+
+func Test_checkPasswordExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newSes(nil, ctrl)
+	ctx := context.TODO()
+	require.NoError(t, checkPasswordExpired(ctx, ses, &tree.Select{}))
+
+	ses.passwordExpired = true
+	require.ErrorContains(t, checkPasswordExpired(ctx, ses, &tree.Select{}), "you must reset your password")
+	require.NoError(t, checkPasswordExpired(ctx, ses, &tree.AlterUser{}))
+	require.NoError(t, checkPasswordExpired(ctx, ses, &tree.SetVar{}))
+}
+
+func Test_doAlterUserAccountLock(t *testing.T) {
+	convey.Convey("alter user account unlock", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterUser{
+			Users:   []*tree.User{{Username: "u1", Hostname: "%"}},
+			MiscOpt: tree.NewUserMiscOptionAccountUnlock(),
+		}
+		ses := newSes(determinePrivilegeSetOfStatement(stmt), ctrl)
+
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{{1, "111", 0}})
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		bh.sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{{0, 0}})
+
+		au := &alterUser{
+			Users:   []*user{{Username: "u1", Hostname: "%"}},
+			MiscOpt: stmt.MiscOpt,
+		}
+		err := doAlterUser(context.TODO(), ses, au)
+		convey.So(err, convey.ShouldBeNil)
+	})
+
+	convey.Convey("alter user account lock fail for privilege", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bh := &backgroundExecTest{}
+		bh.init()
+
+		bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+		defer bhStub.Reset()
+
+		stmt := &tree.AlterUser{
+			Users:   []*tree.User{{Username: "root", Hostname: "%"}},
+			MiscOpt: tree.NewUserMiscOptionAccountLock(),
+		}
+		ses := newSes(determinePrivilegeSetOfStatement(stmt), ctrl)
+
+		sql, _ := getSqlForPasswordOfUser(context.TODO(), "root")
+		bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{{0, "111", 0}})
+		sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+		bh.sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{})
+
+		au := &alterUser{
+			Users:   []*user{{Username: "root", Hostname: "%"}},
+			MiscOpt: stmt.MiscOpt,
+		}
+		err := doAlterUser(context.TODO(), ses, au)
+		convey.So(err, convey.ShouldBeError)
+	})
+
+	convey.Convey("alter user fail for unsupported option", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ses := newSes(nil, ctrl)
+		au := &alterUser{
+			Users:   []*user{{Username: "u1", Hostname: "%"}},
+			MiscOpt: tree.NewUserMiscOptionPasswordExpireNever(),
+		}
+		err := doAlterUser(context.TODO(), ses, au)
+		convey.So(err, convey.ShouldBeError)
+	})
+}
+
+func newMrsForPasswordHistoryOfUser(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"authentication_string", "password_last_changed", "password_history"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_doAlterUserPasswordHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecTest{}
+	bh.init()
+
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	ses := newSes(nil, ctrl)
+	ses.gSysVars = &SystemVariables{mp: map[string]interface{}{"password_history": int64(2)}}
+
+	sql, _ := getSqlForPasswordOfUser(context.TODO(), "u1")
+	bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{{1, "111", 0}})
+	sql, _ = getSqlForCheckUserHasRole(context.TODO(), "root", moAdminRoleID)
+	bh.sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{{0, 0}})
+	sql, _ = getSqlForPasswordHistoryOfUser(context.TODO(), "u1")
+	bh.sql2result[sql] = newMrsForPasswordHistoryOfUser([][]interface{}{
+		{HashPassWord("p2"), int64(200), fmt.Sprintf(`[{"password":"%s","time":100}]`, HashPassWord("p1"))},
+	})
+
+	alter := func(password string) error {
+		return doAlterUser(context.TODO(), ses, &alterUser{
+			Users: []*user{{
+				Username:  "u1",
+				Hostname:  "%",
+				AuthExist: true,
+				IdentTyp:  tree.AccountIdentifiedByPassword,
+				IdentStr:  password,
+			}},
+		})
+	}
+	require.ErrorContains(t, alter("p1"), "contradict the password history policy")
+	require.ErrorContains(t, alter("p2"), "contradict the password history policy")
+	require.NoError(t, alter("p3"))
+
+	history, err := getPasswordHistoryOfUser(context.TODO(), bh, "u1")
+	require.NoError(t, err)
+	require.Equal(t, []passwordHistoryEntry{
+		{Password: HashPassWord("p1"), Time: 100},
+		{Password: HashPassWord("p2"), Time: 200},
+	}, history)
+}
+
+func Test_getSqlForUpdatePasswordHistoryOfUser(t *testing.T) {
+	sql, err := getSqlForUpdatePasswordHistoryOfUser(context.TODO(), 100, `[{"password":"a'b\\c","time":1}]`, "u1")
+	require.NoError(t, err)
+	require.Equal(t, `update mo_catalog.mo_user set password_last_changed = from_unixtime(100), password_history = '[{"password":"a\'b\\\\c","time":1}]' where user_name = "u1" order by user_id;`, sql)
+}
@@ -0,0 +1,720 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// The binlog of MatrixOne is synthesized from the committed changes of the
+// tables, there are no binlog files. A binlog file holds the transactions
+// committed at the same physical time, in the order of the logical time,
+// and is named after the physical time. So a position of the binlog maps
+// to a commit timestamp, and a client resumes from the transactions after
+// the position it has read.
+//
+// The rows events only have the primary key columns in the before image,
+// the same as binlog_row_image=MINIMAL, and the tables without a primary
+// key are not in the binlog. The DDL of databases and tables are sent as
+// QUERY events. Gtid is not supported.
+
+const (
+	// BINLOG_DUMP_NON_BLOCK, send EOF instead of waiting for new events
+	binlogDumpNonBlock uint16 = 0x01
+	// BINLOG_THROUGH_GTID of COM_BINLOG_DUMP_GTID
+	binlogThroughGTID uint16 = 0x04
+
+	binlogPollInterval     = time.Second
+	binlogHeartbeatPeriod  = 30 * time.Second
+	binlogHeartbeatUserVar = "master_heartbeat_period"
+)
+
+// binlogDumpRequest is the request of COM_BINLOG_DUMP and
+// COM_BINLOG_DUMP_GTID.
+type binlogDumpRequest struct {
+	flags    uint16
+	serverID uint32
+	file     string
+	pos      uint64
+}
+
+// parseBinlogDump parses the payload of COM_BINLOG_DUMP, see
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_binlog_dump.html
+func parseBinlogDump(ctx context.Context, data []byte) (binlogDumpRequest, error) {
+	var req binlogDumpRequest
+	if len(data) < 10 {
+		return req, moerr.NewInvalidInput(ctx, "binlog dump contains malformed packet")
+	}
+	req.pos = uint64(binary.LittleEndian.Uint32(data))
+	req.flags = binary.LittleEndian.Uint16(data[4:])
+	req.serverID = binary.LittleEndian.Uint32(data[6:])
+	req.file = string(data[10:])
+	return req, nil
+}
+
+// parseBinlogDumpGTID parses the payload of COM_BINLOG_DUMP_GTID, see
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_binlog_dump_gtid.html
+// The gtid set is ignored since gtid is not supported.
+func parseBinlogDumpGTID(ctx context.Context, data []byte) (binlogDumpRequest, error) {
+	var req binlogDumpRequest
+	if len(data) < 10 {
+		return req, moerr.NewInvalidInput(ctx, "binlog dump gtid contains malformed packet")
+	}
+	req.flags = binary.LittleEndian.Uint16(data)
+	req.serverID = binary.LittleEndian.Uint32(data[2:])
+	n := int(binary.LittleEndian.Uint32(data[6:]))
+	pos := 10
+	if len(data) < pos+n+8 {
+		return req, moerr.NewInvalidInput(ctx, "binlog dump gtid contains malformed packet")
+	}
+	req.file = string(data[pos : pos+n])
+	req.pos = binary.LittleEndian.Uint64(data[pos+n:])
+	if req.flags&binlogThroughGTID != 0 {
+		pos += n + 8
+		if len(data) < pos+4 || len(data) < pos+4+int(binary.LittleEndian.Uint32(data[pos:])) {
+			return req, moerr.NewInvalidInput(ctx, "binlog dump gtid contains malformed packet")
+		}
+	}
+	return req, nil
+}
+
+func checkReplicationPrivilege(ctx context.Context, ses *Session) error {
+	if !ses.GetTenantInfo().IsAdminRole() {
+		return moerr.NewInternalError(ctx, "do not have privilege to execute the statement")
+	}
+	return nil
+}
+
+// handleRegisterSlave handles COM_REGISTER_SLAVE, see
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_register_slave.html
+// The replica is only logged, it is not listed anywhere.
+func handleRegisterSlave(ses *Session, execCtx *ExecCtx, data []byte) error {
+	ctx := execCtx.reqCtx
+	if err := checkReplicationPrivilege(ctx, ses); err != nil {
+		return err
+	}
+	malformed := moerr.NewInvalidInput(ctx, "register slave contains malformed packet")
+	if len(data) < 4 {
+		return malformed
+	}
+	serverID := binary.LittleEndian.Uint32(data)
+	pos := 4
+	var fields [3]string
+	for i := range fields {
+		if pos >= len(data) || pos+1+int(data[pos]) > len(data) {
+			return malformed
+		}
+		fields[i] = string(data[pos+1 : pos+1+int(data[pos])])
+		pos += 1 + int(data[pos])
+	}
+	if len(data) < pos+10 {
+		return malformed
+	}
+	port := binary.LittleEndian.Uint16(data[pos:])
+	ses.Info(ctx, "register binlog replica",
+		zap.Uint32("server id", serverID),
+		zap.String("host", fields[0]),
+		zap.Uint16("port", port))
+	return nil
+}
+
+// handleBinlogDump streams the binlog to the client until the connection
+// is closed, or until the events before the request are sent if the
+// request is non-blocking.
+func handleBinlogDump(ses *Session, execCtx *ExecCtx, req binlogDumpRequest) error {
+	ctx := execCtx.reqCtx
+	if err := checkReplicationPrivilege(ctx, ses); err != nil {
+		return err
+	}
+	s, err := newBinlogStreamer(ctx, ses)
+	if err != nil {
+		return err
+	}
+	ses.Info(ctx, "start binlog dump",
+		zap.Uint32("server id", req.serverID),
+		zap.String("file", req.file),
+		zap.Uint64("pos", req.pos))
+	return s.run(ctx, req)
+}
+
+// handleShowMasterStatus returns the position of the latest commit
+// timestamp. The transactions committed at the same physical time and
+// before the timestamp are sent again if a client dumps from there.
+func handleShowMasterStatus(ctx context.Context, ses *Session) error {
+	exec, err := binlogExecutor(ctx, ses)
+	if err != nil {
+		return err
+	}
+	now, err := binlogNow(ctx, exec, ses.GetTenantInfo().GetTenantID())
+	if err != nil {
+		return err
+	}
+
+	mrs := ses.GetMysqlResultSet()
+	for _, name := range []string{"File", "Position", "Binlog_Do_DB", "Binlog_Ignore_DB", "Executed_Gtid_Set"} {
+		col := new(MysqlColumn)
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		if name == "Position" {
+			col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+			col.SetSigned(false)
+		}
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{binlogFileName(now.Physical()), uint64(binlogFileHeaderLen), "", "", ""})
+	return trySaveQueryResult(ctx, ses, mrs)
+}
+
+func binlogExecutor(ctx context.Context, ses *Session) (executor.SQLExecutor, error) {
+	v, ok := runtime.ServiceRuntime(ses.GetService()).GetGlobalVariables(runtime.InternalSQLExecutor)
+	if !ok {
+		return nil, moerr.NewNotSupported(ctx, "binlog without the sql executor")
+	}
+	return v.(executor.SQLExecutor), nil
+}
+
+// binlogNow returns the latest timestamp which can be read.
+func binlogNow(ctx context.Context, exec executor.SQLExecutor, accountID uint32) (ts types.TS, err error) {
+	err = exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		ts = types.TimestampToTS(txn.Txn().SnapshotTS())
+		return nil
+	}, executor.Options{}.WithAccountID(accountID))
+	return
+}
+
+// binlogStreamer sends the binlog events of an account.
+type binlogStreamer struct {
+	ses       *Session
+	w         MysqlWriter
+	exec      executor.SQLExecutor
+	eng       engine.Engine
+	mp        *mpool.MPool
+	accountID uint32
+
+	enc binlogEncoder
+	// the changes until the watermark are sent
+	watermark types.TS
+	// the physical time of the current binlog file
+	physical int64
+	// the events before the position of the first binlog file have been
+	// read by the client
+	skip     uint32
+	lastSent time.Time
+}
+
+func newBinlogStreamer(ctx context.Context, ses *Session) (*binlogStreamer, error) {
+	exec, err := binlogExecutor(ctx, ses)
+	if err != nil {
+		return nil, err
+	}
+	serverID, err := ses.GetGlobalSysVar("server_id")
+	if err != nil {
+		return nil, err
+	}
+	return &binlogStreamer{
+		ses:       ses,
+		w:         ses.GetResponser().MysqlRrWr(),
+		exec:      exec,
+		eng:       getGlobalPu().StorageEngine,
+		mp:        ses.GetMemPool(),
+		accountID: ses.GetTenantInfo().GetTenantID(),
+		enc: binlogEncoder{
+			serverID:      uint32(serverID.(int64)),
+			serverVersion: getGlobalPu().SV.ServerVersionPrefix + serverVersion.Load().(string),
+		},
+	}, nil
+}
+
+func (s *binlogStreamer) run(ctx context.Context, req binlogDumpRequest) error {
+	if req.file == "" {
+		now, err := binlogNow(ctx, s.exec, s.accountID)
+		if err != nil {
+			return err
+		}
+		s.physical = now.Physical()
+	} else {
+		physical, ok := parseBinlogFileName(req.file)
+		if !ok {
+			return moerr.NewInvalidInput(ctx, "could not find binlog file '%s'", req.file)
+		}
+		s.physical = physical
+	}
+	s.skip = uint32(min(max(req.pos, binlogFileHeaderLen), math.MaxUint32))
+	s.watermark = types.BuildTS(s.physical, 0).Prev()
+	s.enc.file = binlogFileName(s.physical)
+	s.enc.pos = binlogFileHeaderLen
+
+	fde := s.enc.formatDescription(uint32(s.physical / 1e9))
+	if s.skip > binlogFileHeaderLen {
+		// like MySQL, the client does not update its position by the
+		// format description event when it resumes in the middle of a file
+		binary.LittleEndian.PutUint32(fde[13:], 0)
+	}
+	if err := s.write(s.enc.fakeRotate(s.skip), fde); err != nil {
+		return err
+	}
+
+	for {
+		to, txns, err := s.collect(ctx)
+		if moerr.IsMoErrCode(err, moerr.ErrTxnStale) {
+			return moerr.NewInternalError(ctx, "binlog file '%s' is no longer available", s.enc.file)
+		}
+		if err != nil {
+			return err
+		}
+		for _, txn := range txns {
+			if err = s.send(txn); err != nil {
+				return err
+			}
+		}
+		if s.watermark.Less(&to) {
+			s.watermark = to
+		}
+
+		if req.flags&binlogDumpNonBlock != 0 {
+			return s.w.WriteEOF(0, 0)
+		}
+		if time.Since(s.lastSent) >= s.heartbeatPeriod() {
+			if err = s.write(s.enc.heartbeat()); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(binlogPollInterval):
+		}
+	}
+}
+
+// heartbeatPeriod returns the period set by the client in nanoseconds.
+func (s *binlogStreamer) heartbeatPeriod() time.Duration {
+	v, err := s.ses.GetUserDefinedVar(binlogHeartbeatUserVar)
+	if err != nil || v == nil {
+		return binlogHeartbeatPeriod
+	}
+	period, err := strconv.ParseFloat(fmt.Sprint(v.Value), 64)
+	if err != nil || period <= 0 {
+		return binlogHeartbeatPeriod
+	}
+	return time.Duration(period)
+}
+
+func (s *binlogStreamer) write(events ...[]byte) error {
+	s.lastSent = time.Now()
+	return s.w.WriteBinlogEvents(events)
+}
+
+// send sends the events of a transaction, it starts a new binlog file if
+// the physical time of the transaction is not the same as the current one.
+func (s *binlogStreamer) send(txn *binlogTxn) error {
+	var events [][]byte
+	ts := uint32(txn.ts.Physical() / 1e9)
+	if txn.ts.Physical() != s.physical {
+		s.physical = txn.ts.Physical()
+		s.skip = 0
+		events = append(events,
+			s.enc.rotate(ts, binlogFileName(s.physical)),
+			s.enc.formatDescription(ts))
+	}
+	txnEvents, err := s.enc.events(txn)
+	if err != nil {
+		return err
+	}
+	if s.enc.pos <= s.skip {
+		return nil
+	}
+	return s.write(append(events, txnEvents...)...)
+}
+
+// collect returns the transactions committed in (watermark, to], in the
+// order of the commit timestamps. The changes of the tables dropped before
+// `to` are not visible.
+func (s *binlogStreamer) collect(ctx context.Context) (to types.TS, txns []*binlogTxn, err error) {
+	byTS := make(map[types.TS]*binlogTxn)
+	get := func(ts types.TS) *binlogTxn {
+		txn, ok := byTS[ts]
+		if !ok {
+			txn = &binlogTxn{ts: ts}
+			byTS[ts] = txn
+		}
+		return txn
+	}
+
+	err = s.exec.ExecTxn(ctx, func(txn executor.TxnExecutor) error {
+		to = types.TimestampToTS(txn.Txn().SnapshotTS())
+		if to.LessEq(&s.watermark) {
+			return nil
+		}
+		ctx := defines.AttachAccountId(ctx, s.accountID)
+		if err := s.collectDDL(ctx, txn.Txn(), to, get); err != nil {
+			return err
+		}
+
+		dbs, err := s.eng.Databases(ctx, txn.Txn())
+		if err != nil {
+			return err
+		}
+		sort.Strings(dbs)
+		for _, dbName := range dbs {
+			if isBannedDatabase(dbName) {
+				continue
+			}
+			db, err := s.eng.Database(ctx, dbName, txn.Txn())
+			if err != nil {
+				return err
+			}
+			if db.IsSubscription(ctx) {
+				continue
+			}
+			names, err := db.Relations(ctx)
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				if strings.HasPrefix(name, catalog.PrefixIndexTableName) {
+					continue
+				}
+				rel, err := db.Relation(ctx, name, nil)
+				if err != nil {
+					return err
+				}
+				def := rel.GetTableDef(ctx)
+				if def.TableType != catalog.SystemOrdinaryRel {
+					continue
+				}
+				table := newBinlogTable(rel.GetTableID(ctx), dbName, def)
+				if table == nil {
+					continue
+				}
+				if err = s.collectTable(ctx, rel, table, to, get); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}, executor.Options{}.WithAccountID(s.accountID))
+	if err != nil {
+		return
+	}
+
+	for _, txn := range byTS {
+		txns = append(txns, txn)
+	}
+	sort.Slice(txns, func(i, j int) bool {
+		return txns[i].ts.Less(&txns[j].ts)
+	})
+	return
+}
+
+// readChanges calls fn with the inserts and deletes committed in
+// (watermark, to] of the relation.
+func (s *binlogStreamer) readChanges(
+	ctx context.Context,
+	rel engine.Relation,
+	to types.TS,
+	fn func(data, tombstone *batch.Batch) error,
+) error {
+	handle, err := rel.CollectChanges(ctx, s.watermark, to, s.mp)
+	if err != nil {
+		return err
+	}
+	defer handle.Close()
+	for {
+		data, tombstone, err := handle.Next(ctx, s.mp)
+		if err != nil {
+			return err
+		}
+		if data == nil && tombstone == nil {
+			return nil
+		}
+		err = fn(data, tombstone)
+		if data != nil {
+			data.Clean(s.mp)
+		}
+		if tombstone != nil {
+			tombstone.Clean(s.mp)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *binlogStreamer) collectTable(
+	ctx context.Context,
+	rel engine.Relation,
+	table *binlogTable,
+	to types.TS,
+	get func(types.TS) *binlogTxn,
+) error {
+	return s.readChanges(ctx, rel, to, func(data, tombstone *batch.Batch) error {
+		if data != nil {
+			idx := make([]int, len(table.columns))
+			pkIdx := -1
+			for i, attr := range data.Attrs {
+				if attr == table.pkName {
+					pkIdx = i
+				}
+				for j, col := range table.columns {
+					if attr == col.name {
+						idx[j] = i
+					}
+				}
+			}
+			if pkIdx == -1 {
+				return moerr.NewInternalErrorNoCtx("primary key %s not found in the changes", table.pkName)
+			}
+			tss := vector.MustFixedCol[types.TS](data.Vecs[len(data.Vecs)-1])
+			for i := 0; i < data.RowCount(); i++ {
+				row := make([]any, len(idx))
+				for j, k := range idx {
+					row[j] = binlogValue(data.Vecs[k], i)
+				}
+				key := string(data.Vecs[pkIdx].GetRawBytesAt(i))
+				get(tss[i]).changes(table).inserts[key] = row
+			}
+		}
+		if tombstone != nil {
+			tss := vector.MustFixedCol[types.TS](tombstone.Vecs[1])
+			for i := 0; i < tombstone.RowCount(); i++ {
+				row, err := table.primaryKey(tombstone.Vecs[0], i)
+				if err != nil {
+					return err
+				}
+				key := string(tombstone.Vecs[0].GetRawBytesAt(i))
+				get(tss[i]).changes(table).deletes[key] = row
+			}
+		}
+		return nil
+	})
+}
+
+// catalogChanges are the changes of a catalog table of the account in a
+// transaction. The inserts are the values of the wanted columns, the
+// deletes are the values of the primary key.
+type catalogChanges struct {
+	inserts map[string][]any
+	deletes map[string]types.Tuple
+}
+
+// readCatalogChanges reads the changes of the catalog table, keyed by the
+// commit timestamp.
+func (s *binlogStreamer) readCatalogChanges(
+	ctx context.Context,
+	db engine.Database,
+	name string,
+	to types.TS,
+	attrs []string,
+) (map[types.TS]*catalogChanges, error) {
+	rel, err := db.Relation(ctx, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	changes := make(map[types.TS]*catalogChanges)
+	get := func(ts types.TS) *catalogChanges {
+		c, ok := changes[ts]
+		if !ok {
+			c = &catalogChanges{
+				inserts: make(map[string][]any),
+				deletes: make(map[string]types.Tuple),
+			}
+			changes[ts] = c
+		}
+		return c
+	}
+	err = s.readChanges(ctx, rel, to, func(data, tombstone *batch.Batch) error {
+		if data != nil {
+			idx := make([]int, len(attrs))
+			accIdx, pkIdx := -1, -1
+			for i, attr := range data.Attrs {
+				switch attr {
+				case catalog.SystemDBAttr_AccID:
+					accIdx = i
+				case catalog.CPrimaryKeyColName:
+					pkIdx = i
+				}
+				for j, name := range attrs {
+					if attr == name {
+						idx[j] = i
+					}
+				}
+			}
+			if accIdx == -1 || pkIdx == -1 {
+				return moerr.NewInternalErrorNoCtx("invalid changes of %s", name)
+			}
+			tss := vector.MustFixedCol[types.TS](data.Vecs[len(data.Vecs)-1])
+			accounts := vector.MustFixedCol[uint32](data.Vecs[accIdx])
+			for i := 0; i < data.RowCount(); i++ {
+				if accounts[i] != s.accountID {
+					continue
+				}
+				row := make([]any, len(idx))
+				for j, k := range idx {
+					row[j] = binlogValue(data.Vecs[k], i)
+				}
+				get(tss[i]).inserts[string(data.Vecs[pkIdx].GetRawBytesAt(i))] = row
+			}
+		}
+		if tombstone != nil {
+			tss := vector.MustFixedCol[types.TS](tombstone.Vecs[1])
+			for i := 0; i < tombstone.RowCount(); i++ {
+				tuple, err := types.Unpack(tombstone.Vecs[0].GetBytesAt(i))
+				if err != nil {
+					return err
+				}
+				// the primary key starts with the account id
+				if account, ok := tuple[0].(uint32); !ok || account != s.accountID {
+					continue
+				}
+				get(tss[i]).deletes[string(tombstone.Vecs[0].GetRawBytesAt(i))] = tuple
+			}
+		}
+		return nil
+	})
+	return changes, err
+}
+
+// collectDDL synthesizes the DDL statements from the changes of mo_database
+// and mo_tables. A table which is altered or truncated is dropped and
+// created again.
+func (s *binlogStreamer) collectDDL(
+	ctx context.Context,
+	txnOp client.TxnOperator,
+	to types.TS,
+	get func(types.TS) *binlogTxn,
+) error {
+	db, err := s.eng.Database(ctx, catalog.MO_CATALOG, txnOp)
+	if err != nil {
+		return err
+	}
+	dbChanges, err := s.readCatalogChanges(ctx, db, catalog.MO_DATABASE, to,
+		[]string{catalog.SystemDBAttr_Name, catalog.SystemDBAttr_CreateSQL})
+	if err != nil {
+		return err
+	}
+	tableChanges, err := s.readCatalogChanges(ctx, db, catalog.MO_TABLES, to,
+		[]string{catalog.SystemRelAttr_DBName, catalog.SystemRelAttr_Name,
+			catalog.SystemRelAttr_Kind, catalog.SystemRelAttr_CreateSQL})
+	if err != nil {
+		return err
+	}
+
+	tss := make(map[types.TS]struct{})
+	for ts := range dbChanges {
+		tss[ts] = struct{}{}
+	}
+	for ts := range tableChanges {
+		tss[ts] = struct{}{}
+	}
+	for ts := range tss {
+		var ddl []binlogDDL
+		databases := dbChanges[ts]
+		tables := tableChanges[ts]
+		if databases != nil {
+			for _, key := range sortedKeys(databases.inserts) {
+				row := databases.inserts[key]
+				name, query := string(row[0].([]byte)), string(row[1].([]byte))
+				if isBannedDatabase(name) {
+					continue
+				}
+				if _, ok := databases.deletes[key]; ok {
+					continue
+				}
+				if query == "" {
+					query = "CREATE DATABASE " + quoteBinlogName(name)
+				}
+				ddl = append(ddl, binlogDDL{schema: name, query: query})
+			}
+		}
+		if tables != nil {
+			for _, key := range sortedTupleKeys(tables.deletes) {
+				tuple := tables.deletes[key]
+				if len(tuple) != 3 {
+					continue
+				}
+				dbName, name := string(tuple[1].([]byte)), string(tuple[2].([]byte))
+				if isBannedDatabase(dbName) || strings.HasPrefix(name, catalog.PrefixIndexTableName) {
+					continue
+				}
+				ddl = append(ddl, binlogDDL{
+					schema: dbName,
+					query:  "DROP TABLE IF EXISTS " + quoteBinlogName(dbName) + "." + quoteBinlogName(name),
+				})
+			}
+			for _, key := range sortedKeys(tables.inserts) {
+				row := tables.inserts[key]
+				dbName, name := string(row[0].([]byte)), string(row[1].([]byte))
+				kind, query := string(row[2].([]byte)), string(row[3].([]byte))
+				if isBannedDatabase(dbName) || kind != catalog.SystemOrdinaryRel ||
+					strings.HasPrefix(name, catalog.PrefixIndexTableName) {
+					continue
+				}
+				ddl = append(ddl, binlogDDL{schema: dbName, query: query})
+			}
+		}
+		if databases != nil {
+			for _, key := range sortedTupleKeys(databases.deletes) {
+				tuple := databases.deletes[key]
+				if len(tuple) != 2 {
+					continue
+				}
+				name := string(tuple[1].([]byte))
+				if _, ok := databases.inserts[key]; ok || isBannedDatabase(name) {
+					continue
+				}
+				ddl = append(ddl, binlogDDL{query: "DROP DATABASE IF EXISTS " + quoteBinlogName(name)})
+			}
+		}
+		if len(ddl) > 0 {
+			get(ts).ddl = ddl
+		}
+	}
+	return nil
+}
+
+func sortedTupleKeys(m map[string]types.Tuple) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func quoteBinlogName(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
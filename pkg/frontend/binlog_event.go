@@ -0,0 +1,866 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// binlog event types, see
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_replication_binlog_event.html
+const (
+	binlogQueryEvent             byte = 2
+	binlogRotateEvent            byte = 4
+	binlogFormatDescriptionEvent byte = 15
+	binlogXIDEvent               byte = 16
+	binlogTableMapEvent          byte = 19
+	binlogHeartbeatEvent         byte = 27
+	binlogWriteRowsEvent         byte = 30
+	binlogUpdateRowsEvent        byte = 31
+	binlogDeleteRowsEvent        byte = 32
+)
+
+const (
+	binlogVersion        = 4
+	binlogEventHeaderLen = 19
+	// binlogFileHeaderLen is the length of the magic number at the beginning
+	// of a binlog file, the first event of a file is at this position.
+	binlogFileHeaderLen = 4
+	// binlogFilePrefix is the prefix of the binlog file names, the suffix is
+	// the physical part of the commit timestamps in the file.
+	binlogFilePrefix = "binlog."
+
+	// LOG_EVENT_ARTIFICIAL_F, the event is generated by the dump thread and
+	// is not in the binlog file.
+	binlogArtificialFlag uint16 = 0x20
+	// STMT_END_F of the rows events
+	binlogStmtEndFlag uint16 = 0x01
+	// the checksum of the events is always off
+	binlogChecksumOff byte = 0
+
+	// binlogRowsEventMaxSize is the size at which the rows of a table are
+	// split into another rows event, the same as binlog_row_event_max_size.
+	binlogRowsEventMaxSize = 8192
+	binlogServerVersionLen = 50
+)
+
+// binlogPostHeaderLens is the length of the post header of every event type,
+// starting from START_EVENT_V3, the same as MySQL 5.7.
+var binlogPostHeaderLens = []byte{
+	56, 13, 0, 8, 0, 18, 0, 4, 4, 4, 4, 18, 0, 0, 95, 0, 4, 26, 8, 0,
+	0, 0, 8, 8, 8, 2, 0, 0, 0, 10, 10, 10, 42, 42, 0, 18, 52, 0,
+}
+
+// binlogFileName returns the binlog file which holds the transactions
+// committed at the physical time.
+func binlogFileName(physical int64) string {
+	return fmt.Sprintf("%s%019d", binlogFilePrefix, physical)
+}
+
+// parseBinlogFileName is the reverse of binlogFileName.
+func parseBinlogFileName(name string) (int64, bool) {
+	suffix, ok := strings.CutPrefix(name, binlogFilePrefix)
+	if !ok {
+		return 0, false
+	}
+	physical, err := strconv.ParseInt(suffix, 10, 64)
+	if err != nil || physical < 0 {
+		return 0, false
+	}
+	return physical, true
+}
+
+// binlogEncoder encodes the binlog events and keeps the position of the
+// current binlog file.
+type binlogEncoder struct {
+	serverID      uint32
+	serverVersion string
+	file          string
+	pos           uint32
+}
+
+// event encodes an event at the current position, and moves the position
+// to the end of the event.
+func (e *binlogEncoder) event(typ byte, ts uint32, flags uint16, body []byte) []byte {
+	e.pos += uint32(binlogEventHeaderLen + len(body))
+	return e.encode(typ, ts, flags, e.pos, body)
+}
+
+// artificial encodes an event which is not in the binlog file.
+func (e *binlogEncoder) artificial(typ byte, logPos uint32, body []byte) []byte {
+	return e.encode(typ, 0, binlogArtificialFlag, logPos, body)
+}
+
+func (e *binlogEncoder) encode(typ byte, ts uint32, flags uint16, logPos uint32, body []byte) []byte {
+	data := make([]byte, 0, binlogEventHeaderLen+len(body))
+	data = binary.LittleEndian.AppendUint32(data, ts)
+	data = append(data, typ)
+	data = binary.LittleEndian.AppendUint32(data, e.serverID)
+	data = binary.LittleEndian.AppendUint32(data, uint32(binlogEventHeaderLen+len(body)))
+	data = binary.LittleEndian.AppendUint32(data, logPos)
+	data = binary.LittleEndian.AppendUint16(data, flags)
+	return append(data, body...)
+}
+
+// rotate encodes the ROTATE_EVENT at the end of the current file, and
+// starts the next file.
+func (e *binlogEncoder) rotate(ts uint32, next string) []byte {
+	event := e.event(binlogRotateEvent, ts, 0, rotateBody(next, binlogFileHeaderLen))
+	e.file, e.pos = next, binlogFileHeaderLen
+	return event
+}
+
+// fakeRotate tells the client the binlog file and position where the dump
+// starts.
+func (e *binlogEncoder) fakeRotate(pos uint32) []byte {
+	return e.artificial(binlogRotateEvent, 0, rotateBody(e.file, uint64(pos)))
+}
+
+func rotateBody(file string, pos uint64) []byte {
+	body := binary.LittleEndian.AppendUint64(nil, pos)
+	return append(body, file...)
+}
+
+// formatDescription encodes the FORMAT_DESCRIPTION_EVENT at the beginning of
+// the current file. Like MySQL, the event always carries the checksum
+// algorithm and its checksum, even if the checksum is off.
+func (e *binlogEncoder) formatDescription(ts uint32) []byte {
+	body := binary.LittleEndian.AppendUint16(nil, binlogVersion)
+	version := make([]byte, binlogServerVersionLen)
+	copy(version, e.serverVersion)
+	body = append(body, version...)
+	body = binary.LittleEndian.AppendUint32(body, ts)
+	body = append(body, binlogEventHeaderLen)
+	body = append(body, binlogPostHeaderLens...)
+	body = append(body, binlogChecksumOff)
+	// reserve the checksum
+	body = append(body, 0, 0, 0, 0)
+	event := e.event(binlogFormatDescriptionEvent, ts, 0, body)
+	binary.LittleEndian.PutUint32(event[len(event)-4:], crc32.ChecksumIEEE(event[:len(event)-4]))
+	return event
+}
+
+func (e *binlogEncoder) heartbeat() []byte {
+	return e.artificial(binlogHeartbeatEvent, e.pos, []byte(e.file))
+}
+
+// query encodes a QUERY_EVENT without status variables.
+func (e *binlogEncoder) query(ts uint32, schema, query string) []byte {
+	body := make([]byte, 0, 13+len(schema)+1+len(query))
+	// thread id and execution time
+	body = binary.LittleEndian.AppendUint32(body, 0)
+	body = binary.LittleEndian.AppendUint32(body, 0)
+	body = append(body, byte(len(schema)))
+	// error code and the length of status variables
+	body = binary.LittleEndian.AppendUint16(body, 0)
+	body = binary.LittleEndian.AppendUint16(body, 0)
+	body = append(body, schema...)
+	body = append(body, 0)
+	body = append(body, query...)
+	return e.event(binlogQueryEvent, ts, 0, body)
+}
+
+func (e *binlogEncoder) xid(ts uint32, xid uint64) []byte {
+	return e.event(binlogXIDEvent, ts, 0, binary.LittleEndian.AppendUint64(nil, xid))
+}
+
+// tableMap encodes the TABLE_MAP_EVENT of the table.
+func (e *binlogEncoder) tableMap(ts uint32, table *binlogTable) []byte {
+	body := appendBinlogTableID(nil, table.id)
+	// flags
+	body = binary.LittleEndian.AppendUint16(body, 0)
+	body = append(body, byte(len(table.schema)))
+	body = append(body, table.schema...)
+	body = append(body, 0)
+	body = append(body, byte(len(table.name)))
+	body = append(body, table.name...)
+	body = append(body, 0)
+	body = appendLenEncInt(body, uint64(len(table.columns)))
+	var meta []byte
+	for _, col := range table.columns {
+		body = append(body, byte(col.mysqlType))
+		meta = append(meta, col.meta...)
+	}
+	body = appendLenEncInt(body, uint64(len(meta)))
+	body = append(body, meta...)
+	nullable := newBinlogBitmap(len(table.columns))
+	for i, col := range table.columns {
+		if col.nullable {
+			nullable.set(i)
+		}
+	}
+	return e.event(binlogTableMapEvent, ts, 0, append(body, nullable...))
+}
+
+// rowsEvents encodes the rows of a table into one or more rows events of the
+// type. The before image of the rows only has the primary key columns,
+// which is binlog_row_image=MINIMAL. The after image has all the columns.
+func (e *binlogEncoder) rowsEvents(
+	typ byte,
+	ts uint32,
+	table *binlogTable,
+	before [][]any,
+	after [][]any,
+) ([][]byte, error) {
+	var (
+		events  [][]byte
+		present []binlogBitmap
+		images  int
+	)
+	all := newBinlogBitmap(len(table.columns))
+	for i := range table.columns {
+		all.set(i)
+	}
+	keys := newBinlogBitmap(len(table.columns))
+	for _, i := range table.pkIdx {
+		keys.set(i)
+	}
+	switch typ {
+	case binlogWriteRowsEvent:
+		present, images = []binlogBitmap{all}, len(after)
+	case binlogDeleteRowsEvent:
+		present, images = []binlogBitmap{keys}, len(before)
+	case binlogUpdateRowsEvent:
+		present, images = []binlogBitmap{keys, all}, len(after)
+	}
+
+	header := appendBinlogTableID(nil, table.id)
+	header = binary.LittleEndian.AppendUint16(header, 0)
+	// the length of the extra data, which includes itself
+	header = binary.LittleEndian.AppendUint16(header, 2)
+	header = appendLenEncInt(header, uint64(len(table.columns)))
+	for _, bitmap := range present {
+		header = append(header, bitmap...)
+	}
+
+	var err error
+	body := append([]byte(nil), header...)
+	for i := 0; i < images; i++ {
+		switch typ {
+		case binlogWriteRowsEvent:
+			body, err = table.appendRow(body, all, after[i])
+		case binlogDeleteRowsEvent:
+			body, err = table.appendRow(body, keys, before[i])
+		case binlogUpdateRowsEvent:
+			if body, err = table.appendRow(body, keys, before[i]); err == nil {
+				body, err = table.appendRow(body, all, after[i])
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(body) >= binlogRowsEventMaxSize && i < images-1 {
+			events = append(events, e.event(typ, ts, 0, body))
+			body = append([]byte(nil), header...)
+		}
+	}
+	// the flags are right after the table id
+	binary.LittleEndian.PutUint16(body[6:], binlogStmtEndFlag)
+	return append(events, e.event(typ, ts, 0, body)), nil
+}
+
+func appendBinlogTableID(data []byte, id uint64) []byte {
+	for i := 0; i < 6; i++ {
+		data = append(data, byte(id>>(8*i)))
+	}
+	return data
+}
+
+func appendLenEncInt(data []byte, v uint64) []byte {
+	switch {
+	case v < 251:
+		return append(data, byte(v))
+	case v < 1<<16:
+		return binary.LittleEndian.AppendUint16(append(data, 0xfc), uint16(v))
+	case v < 1<<24:
+		return append(data, 0xfd, byte(v), byte(v>>8), byte(v>>16))
+	}
+	return binary.LittleEndian.AppendUint64(append(data, 0xfe), v)
+}
+
+type binlogBitmap []byte
+
+func newBinlogBitmap(n int) binlogBitmap {
+	return make(binlogBitmap, (n+7)/8)
+}
+
+func (b binlogBitmap) set(i int) {
+	b[i/8] |= 1 << (i % 8)
+}
+
+func (b binlogBitmap) contains(i int) bool {
+	return b[i/8]&(1<<(i%8)) != 0
+}
+
+// binlogColumn is a column in the TABLE_MAP_EVENT.
+type binlogColumn struct {
+	name      string
+	typ       types.Type
+	nullable  bool
+	mysqlType defines.MysqlType
+	meta      []byte
+}
+
+// newBinlogColumn maps the type of a column to the binlog type. The strings
+// which do not fit in a VARCHAR, JSON, vectors and other types without a
+// binlog counterpart are sent as BLOB in their string forms.
+func newBinlogColumn(name string, typ types.Type, nullable bool) binlogColumn {
+	col := binlogColumn{name: name, typ: typ, nullable: nullable}
+	switch typ.Oid {
+	case types.T_bool, types.T_int8, types.T_uint8:
+		col.mysqlType = defines.MYSQL_TYPE_TINY
+	case types.T_int16, types.T_uint16, types.T_enum:
+		col.mysqlType = defines.MYSQL_TYPE_SHORT
+	case types.T_int32, types.T_uint32:
+		col.mysqlType = defines.MYSQL_TYPE_LONG
+	case types.T_int64, types.T_uint64, types.T_bit:
+		col.mysqlType = defines.MYSQL_TYPE_LONGLONG
+	case types.T_float32:
+		col.mysqlType, col.meta = defines.MYSQL_TYPE_FLOAT, []byte{4}
+	case types.T_float64:
+		col.mysqlType, col.meta = defines.MYSQL_TYPE_DOUBLE, []byte{8}
+	case types.T_decimal64, types.T_decimal128:
+		col.mysqlType, col.meta = defines.MYSQL_TYPE_NEWDECIMAL, []byte{byte(typ.Width), byte(typ.Scale)}
+	case types.T_date:
+		col.mysqlType = defines.MYSQL_TYPE_DATE
+	case types.T_datetime:
+		col.mysqlType, col.meta = defines.MYSQL_TYPE_DATETIME2, []byte{byte(typ.Scale)}
+	case types.T_timestamp:
+		col.mysqlType, col.meta = defines.MYSQL_TYPE_TIMESTAMP2, []byte{byte(typ.Scale)}
+	case types.T_time:
+		col.mysqlType, col.meta = defines.MYSQL_TYPE_TIME2, []byte{byte(typ.Scale)}
+	case types.T_uuid:
+		col.varchar(36)
+	case types.T_char, types.T_varchar:
+		col.varchar(int(typ.Width) * 4)
+	case types.T_binary, types.T_varbinary:
+		col.varchar(int(typ.Width))
+	default:
+		col.blob()
+	}
+	return col
+}
+
+func (col *binlogColumn) varchar(maxLen int) {
+	if maxLen <= 0 || maxLen > math.MaxUint16 {
+		col.blob()
+		return
+	}
+	col.mysqlType = defines.MYSQL_TYPE_VARCHAR
+	col.meta = binary.LittleEndian.AppendUint16(nil, uint16(maxLen))
+}
+
+func (col *binlogColumn) blob() {
+	// LONGBLOB, the length of the values takes 4 bytes
+	col.mysqlType, col.meta = defines.MYSQL_TYPE_BLOB, []byte{4}
+}
+
+// binlogTable is a table whose changes are in the binlog.
+type binlogTable struct {
+	id     uint64
+	schema string
+	name   string
+	// the visible columns of the table
+	columns []binlogColumn
+	// the columns of the primary key
+	pkIdx []int
+	// the name of the primary key in the changes, it is a hidden column if
+	// the primary key is composite.
+	pkName string
+	cpkey  bool
+}
+
+// newBinlogTable returns nil if the table has no primary key, the deletes
+// of such tables can not be mapped to rows.
+func newBinlogTable(id uint64, schema string, def *plan.TableDef) *binlogTable {
+	if def.Pkey == nil || def.Pkey.PkeyColName == "" ||
+		def.Pkey.PkeyColName == catalog.FakePrimaryKeyColName {
+		return nil
+	}
+	table := &binlogTable{
+		id:     id,
+		schema: schema,
+		name:   def.Name,
+		pkName: def.Pkey.PkeyColName,
+		cpkey:  len(def.Pkey.Names) > 1,
+	}
+	for _, col := range def.Cols {
+		if col.Hidden || col.Name == catalog.Row_ID {
+			continue
+		}
+		typ := types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale)
+		nullable := col.Default == nil || col.Default.NullAbility
+		table.columns = append(table.columns, newBinlogColumn(col.Name, typ, nullable))
+	}
+	for _, name := range def.Pkey.Names {
+		for i, col := range table.columns {
+			if col.name == name {
+				table.pkIdx = append(table.pkIdx, i)
+			}
+		}
+	}
+	if len(table.pkIdx) != len(def.Pkey.Names) {
+		return nil
+	}
+	return table
+}
+
+// primaryKey returns the values of the primary key columns of the i-th
+// row of the primary key vector, in the order of the columns of the table.
+func (t *binlogTable) primaryKey(vec *vector.Vector, i int) ([]any, error) {
+	row := make([]any, len(t.columns))
+	if !t.cpkey {
+		row[t.pkIdx[0]] = binlogValue(vec, i)
+		return row, nil
+	}
+	tuple, err := types.Unpack(vec.GetBytesAt(i))
+	if err != nil {
+		return nil, err
+	}
+	if len(tuple) != len(t.pkIdx) {
+		return nil, moerr.NewInternalErrorNoCtx("invalid composite primary key of %d values", len(tuple))
+	}
+	for j, v := range tuple {
+		row[t.pkIdx[j]] = v
+	}
+	return row, nil
+}
+
+// appendRow appends the null bitmap and the values of the present columns.
+func (t *binlogTable) appendRow(data []byte, present binlogBitmap, row []any) ([]byte, error) {
+	n := 0
+	for i := range t.columns {
+		if present.contains(i) {
+			n++
+		}
+	}
+	nulls := newBinlogBitmap(n)
+	j := 0
+	for i := range t.columns {
+		if !present.contains(i) {
+			continue
+		}
+		if row[i] == nil {
+			nulls.set(j)
+		}
+		j++
+	}
+	data = append(data, nulls...)
+	var err error
+	for i := range t.columns {
+		if !present.contains(i) || row[i] == nil {
+			continue
+		}
+		if data, err = appendBinlogValue(data, &t.columns[i], row[i]); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// binlogValue returns the i-th value of the vector, the values of the
+// columns sent as strings are converted to []byte.
+func binlogValue(vec *vector.Vector, i int) any {
+	if vec.IsNull(uint64(i)) {
+		return nil
+	}
+	switch vec.GetType().Oid {
+	case types.T_bool:
+		return vector.GetFixedAt[bool](vec, i)
+	case types.T_bit:
+		return vector.GetFixedAt[uint64](vec, i)
+	case types.T_int8:
+		return vector.GetFixedAt[int8](vec, i)
+	case types.T_int16:
+		return vector.GetFixedAt[int16](vec, i)
+	case types.T_int32:
+		return vector.GetFixedAt[int32](vec, i)
+	case types.T_int64:
+		return vector.GetFixedAt[int64](vec, i)
+	case types.T_uint8:
+		return vector.GetFixedAt[uint8](vec, i)
+	case types.T_uint16:
+		return vector.GetFixedAt[uint16](vec, i)
+	case types.T_uint32:
+		return vector.GetFixedAt[uint32](vec, i)
+	case types.T_uint64:
+		return vector.GetFixedAt[uint64](vec, i)
+	case types.T_float32:
+		return vector.GetFixedAt[float32](vec, i)
+	case types.T_float64:
+		return vector.GetFixedAt[float64](vec, i)
+	case types.T_decimal64:
+		return vector.GetFixedAt[types.Decimal64](vec, i)
+	case types.T_decimal128:
+		return vector.GetFixedAt[types.Decimal128](vec, i)
+	case types.T_date:
+		return vector.GetFixedAt[types.Date](vec, i)
+	case types.T_time:
+		return vector.GetFixedAt[types.Time](vec, i)
+	case types.T_datetime:
+		return vector.GetFixedAt[types.Datetime](vec, i)
+	case types.T_timestamp:
+		return vector.GetFixedAt[types.Timestamp](vec, i)
+	case types.T_enum:
+		return vector.GetFixedAt[types.Enum](vec, i)
+	case types.T_uuid:
+		return vector.GetFixedAt[types.Uuid](vec, i)
+	case types.T_json:
+		return []byte(types.DecodeJson(vec.GetBytesAt(i)).String())
+	case types.T_array_float32:
+		return []byte(types.BytesToArrayToString[float32](vec.GetBytesAt(i)))
+	case types.T_array_float64:
+		return []byte(types.BytesToArrayToString[float64](vec.GetBytesAt(i)))
+	}
+	return append([]byte(nil), vec.GetBytesAt(i)...)
+}
+
+// appendBinlogValue appends the value in the binary format of the binlog
+// type of the column.
+func appendBinlogValue(data []byte, col *binlogColumn, v any) ([]byte, error) {
+	switch col.mysqlType {
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT,
+		defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_LONGLONG:
+		var u uint64
+		switch x := v.(type) {
+		case bool:
+			if x {
+				u = 1
+			}
+		case int8:
+			u = uint64(x)
+		case int16:
+			u = uint64(x)
+		case int32:
+			u = uint64(x)
+		case int64:
+			u = uint64(x)
+		case uint8:
+			u = uint64(x)
+		case uint16:
+			u = uint64(x)
+		case uint32:
+			u = uint64(x)
+		case uint64:
+			u = x
+		case types.Enum:
+			u = uint64(x)
+		default:
+			return nil, binlogValueError(col, v)
+		}
+		switch col.mysqlType {
+		case defines.MYSQL_TYPE_TINY:
+			return append(data, byte(u)), nil
+		case defines.MYSQL_TYPE_SHORT:
+			return binary.LittleEndian.AppendUint16(data, uint16(u)), nil
+		case defines.MYSQL_TYPE_LONG:
+			return binary.LittleEndian.AppendUint32(data, uint32(u)), nil
+		}
+		return binary.LittleEndian.AppendUint64(data, u), nil
+
+	case defines.MYSQL_TYPE_FLOAT:
+		if x, ok := v.(float32); ok {
+			return binary.LittleEndian.AppendUint32(data, math.Float32bits(x)), nil
+		}
+	case defines.MYSQL_TYPE_DOUBLE:
+		if x, ok := v.(float64); ok {
+			return binary.LittleEndian.AppendUint64(data, math.Float64bits(x)), nil
+		}
+	case defines.MYSQL_TYPE_NEWDECIMAL:
+		switch x := v.(type) {
+		case types.Decimal64:
+			return appendBinlogDecimal(data, x.Format(col.typ.Scale), int(col.typ.Width), int(col.typ.Scale)), nil
+		case types.Decimal128:
+			return appendBinlogDecimal(data, x.Format(col.typ.Scale), int(col.typ.Width), int(col.typ.Scale)), nil
+		}
+	case defines.MYSQL_TYPE_DATE:
+		if x, ok := v.(types.Date); ok {
+			year, month, day, _ := x.Calendar(true)
+			u := uint32(day) | uint32(month)<<5 | uint32(year)<<9
+			return append(data, byte(u), byte(u>>8), byte(u>>16)), nil
+		}
+	case defines.MYSQL_TYPE_DATETIME2:
+		if x, ok := v.(types.Datetime); ok {
+			ym := int64(x.Year())*13 + int64(x.Month())
+			ymd := ym<<5 | int64(x.Day())
+			hms := int64(x.Hour())<<12 | int64(x.Minute())<<6 | int64(x.Sec())
+			// DATETIMEF_INT_OFS
+			u := uint64(ymd<<17|hms) + 0x8000000000
+			data = append(data, byte(u>>32), byte(u>>24), byte(u>>16), byte(u>>8), byte(u))
+			return appendBinlogFrac(data, x.MicroSec(), col.typ.Scale), nil
+		}
+	case defines.MYSQL_TYPE_TIMESTAMP2:
+		if x, ok := v.(types.Timestamp); ok {
+			data = binary.BigEndian.AppendUint32(data, uint32(x.Unix()))
+			usec := x.ToDatetime(time.UTC).MicroSec()
+			return appendBinlogFrac(data, usec, col.typ.Scale), nil
+		}
+	case defines.MYSQL_TYPE_TIME2:
+		if x, ok := v.(types.Time); ok {
+			return appendBinlogTime(data, x, col.typ.Scale), nil
+		}
+	case defines.MYSQL_TYPE_VARCHAR:
+		b, ok := binlogBytes(v)
+		if !ok {
+			break
+		}
+		if binary.LittleEndian.Uint16(col.meta) < 256 {
+			data = append(data, byte(len(b)))
+		} else {
+			data = binary.LittleEndian.AppendUint16(data, uint16(len(b)))
+		}
+		return append(data, b...), nil
+	case defines.MYSQL_TYPE_BLOB:
+		if b, ok := binlogBytes(v); ok {
+			data = binary.LittleEndian.AppendUint32(data, uint32(len(b)))
+			return append(data, b...), nil
+		}
+	}
+	return nil, binlogValueError(col, v)
+}
+
+func binlogBytes(v any) ([]byte, bool) {
+	switch x := v.(type) {
+	case []byte:
+		return x, true
+	case string:
+		return []byte(x), true
+	case types.Uuid:
+		return []byte(x.String()), true
+	}
+	return nil, false
+}
+
+func binlogValueError(col *binlogColumn, v any) error {
+	return moerr.NewInternalErrorNoCtx("invalid binlog value %T of column %s %s", v, col.name, col.typ.String())
+}
+
+// appendBinlogFrac appends the fractional seconds of the temporal types in
+// the big endian bytes of the precision.
+func appendBinlogFrac(data []byte, usec int64, scale int32) []byte {
+	switch scale {
+	case 1, 2:
+		return append(data, byte(usec/10000))
+	case 3, 4:
+		v := usec / 100
+		return append(data, byte(v>>8), byte(v))
+	case 5, 6:
+		return append(data, byte(usec>>16), byte(usec>>8), byte(usec))
+	}
+	return data
+}
+
+// appendBinlogTime appends a TIME2 value, the same as
+// my_time_packed_to_binary of MySQL.
+func appendBinlogTime(data []byte, t types.Time, scale int32) []byte {
+	hour, minute, sec, usec, neg := t.ClockFormat()
+	hms := int64(hour)<<12 | int64(minute)<<6 | int64(sec)
+	packed := hms<<24 + int64(usec)
+	if neg {
+		packed = -packed
+	}
+	intPart := packed>>24 + 0x800000
+	fracPart := packed % (1 << 24)
+	switch scale {
+	case 1, 2:
+		return append(data, byte(intPart>>16), byte(intPart>>8), byte(intPart), byte(int8(fracPart/10000)))
+	case 3, 4:
+		v := fracPart / 100
+		return append(data, byte(intPart>>16), byte(intPart>>8), byte(intPart), byte(v>>8), byte(v))
+	case 5, 6:
+		v := packed + 0x800000000000
+		return append(data, byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
+	return append(data, byte(intPart>>16), byte(intPart>>8), byte(intPart))
+}
+
+// the bytes taken by the digits less than 9 in the decimal binary format
+var binlogDecimalDigitBytes = [10]int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+// appendBinlogDecimal appends the decimal in the binary format of MySQL,
+// the same as decimal2bin. Every 9 digits take 4 bytes, the leftover digits
+// take the bytes of binlogDecimalDigitBytes.
+func appendBinlogDecimal(data []byte, s string, precision, scale int) []byte {
+	neg := strings.HasPrefix(s, "-")
+	intg, frac, _ := strings.Cut(strings.TrimPrefix(s, "-"), ".")
+	intDigits := precision - scale
+	if len(intg) < intDigits {
+		intg = strings.Repeat("0", intDigits-len(intg)) + intg
+	} else {
+		intg = intg[len(intg)-intDigits:]
+	}
+	if len(frac) < scale {
+		frac += strings.Repeat("0", scale-len(frac))
+	} else {
+		frac = frac[:scale]
+	}
+
+	start := len(data)
+	lead := intDigits % 9
+	if lead > 0 {
+		data = appendDecimalDigits(data, intg[:lead], binlogDecimalDigitBytes[lead])
+	}
+	for i := lead; i < intDigits; i += 9 {
+		data = appendDecimalDigits(data, intg[i:i+9], 4)
+	}
+	for i := 0; i+9 <= scale; i += 9 {
+		data = appendDecimalDigits(data, frac[i:i+9], 4)
+	}
+	if trail := scale % 9; trail > 0 {
+		data = appendDecimalDigits(data, frac[scale-trail:], binlogDecimalDigitBytes[trail])
+	}
+	if len(data) == start {
+		return data
+	}
+	if neg {
+		for i := start; i < len(data); i++ {
+			data[i] = ^data[i]
+		}
+	}
+	data[start] ^= 0x80
+	return data
+}
+
+func appendDecimalDigits(data []byte, digits string, size int) []byte {
+	v, _ := strconv.ParseUint(digits, 10, 32)
+	for i := size - 1; i >= 0; i-- {
+		data = append(data, byte(v>>(8*i)))
+	}
+	return data
+}
+
+// binlogDDL is a DDL statement in the binlog.
+type binlogDDL struct {
+	schema string
+	query  string
+}
+
+// binlogTableChanges are the changes of a table in a transaction, keyed by
+// the primary key.
+type binlogTableChanges struct {
+	table   *binlogTable
+	inserts map[string][]any
+	deletes map[string][]any
+}
+
+// binlogTxn is the changes committed at the same timestamp.
+type binlogTxn struct {
+	ts     types.TS
+	ddl    []binlogDDL
+	tables map[uint64]*binlogTableChanges
+}
+
+func (txn *binlogTxn) changes(table *binlogTable) *binlogTableChanges {
+	if txn.tables == nil {
+		txn.tables = make(map[uint64]*binlogTableChanges)
+	}
+	c, ok := txn.tables[table.id]
+	if !ok {
+		c = &binlogTableChanges{
+			table:   table,
+			inserts: make(map[string][]any),
+			deletes: make(map[string][]any),
+		}
+		txn.tables[table.id] = c
+	}
+	return c
+}
+
+// events encodes the transaction. The DDL statements come first, followed
+// by the row changes in a BEGIN ... XID group. The events of the same
+// changes always have the same size regardless of the order the changes are
+// collected, so a position in a binlog file is stable across dumps.
+func (e *binlogEncoder) events(txn *binlogTxn) ([][]byte, error) {
+	var events [][]byte
+	ts := uint32(txn.ts.Physical() / 1e9)
+	for _, ddl := range txn.ddl {
+		events = append(events, e.query(ts, ddl.schema, ddl.query))
+	}
+	if len(txn.tables) == 0 {
+		return events, nil
+	}
+
+	ids := make([]uint64, 0, len(txn.tables))
+	for id := range txn.tables {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	events = append(events, e.query(ts, "", "BEGIN"))
+	for _, id := range ids {
+		c := txn.tables[id]
+		var deletes, before, after, inserts [][]any
+		for _, key := range sortedKeys(c.deletes) {
+			if row, ok := c.inserts[key]; ok {
+				before = append(before, c.deletes[key])
+				after = append(after, row)
+			} else {
+				deletes = append(deletes, c.deletes[key])
+			}
+		}
+		for _, key := range sortedKeys(c.inserts) {
+			if _, ok := c.deletes[key]; !ok {
+				inserts = append(inserts, c.inserts[key])
+			}
+		}
+
+		events = append(events, e.tableMap(ts, c.table))
+		if len(deletes) > 0 {
+			rows, err := e.rowsEvents(binlogDeleteRowsEvent, ts, c.table, deletes, nil)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, rows...)
+		}
+		if len(after) > 0 {
+			rows, err := e.rowsEvents(binlogUpdateRowsEvent, ts, c.table, before, after)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, rows...)
+		}
+		if len(inserts) > 0 {
+			rows, err := e.rowsEvents(binlogWriteRowsEvent, ts, c.table, nil, inserts)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, rows...)
+		}
+	}
+	xid := uint64(txn.ts.Physical()) + uint64(txn.ts.Logical())
+	return append(events, e.xid(ts, xid)), nil
+}
+
+func sortedKeys(m map[string][]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
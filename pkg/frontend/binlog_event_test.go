@@ -0,0 +1,257 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func TestBinlogFileName(t *testing.T) {
+	name := binlogFileName(1700000000123456789)
+	require.Equal(t, "binlog.1700000000123456789", name)
+	physical, ok := parseBinlogFileName(name)
+	require.True(t, ok)
+	require.Equal(t, int64(1700000000123456789), physical)
+
+	for _, name := range []string{"", "mysql-bin.000001", "binlog.", "binlog.-1", "binlog.x"} {
+		_, ok = parseBinlogFileName(name)
+		require.False(t, ok, name)
+	}
+}
+
+func TestParseBinlogDump(t *testing.T) {
+	ctx := context.TODO()
+	data := binary.LittleEndian.AppendUint32(nil, 1234)
+	data = binary.LittleEndian.AppendUint16(data, binlogDumpNonBlock)
+	data = binary.LittleEndian.AppendUint32(data, 42)
+	data = append(data, "binlog.0000000000000000001"...)
+	req, err := parseBinlogDump(ctx, data)
+	require.NoError(t, err)
+	require.Equal(t, binlogDumpRequest{
+		flags:    binlogDumpNonBlock,
+		serverID: 42,
+		file:     "binlog.0000000000000000001",
+		pos:      1234,
+	}, req)
+	_, err = parseBinlogDump(ctx, data[:9])
+	require.Error(t, err)
+
+	file := "binlog.0000000000000000002"
+	data = binary.LittleEndian.AppendUint16(nil, binlogThroughGTID)
+	data = binary.LittleEndian.AppendUint32(data, 7)
+	data = binary.LittleEndian.AppendUint32(data, uint32(len(file)))
+	data = append(data, file...)
+	data = binary.LittleEndian.AppendUint64(data, 4)
+	data = binary.LittleEndian.AppendUint32(data, 8)
+	data = binary.LittleEndian.AppendUint64(data, 0)
+	req, err = parseBinlogDumpGTID(ctx, data)
+	require.NoError(t, err)
+	require.Equal(t, binlogDumpRequest{
+		flags:    binlogThroughGTID,
+		serverID: 7,
+		file:     file,
+		pos:      4,
+	}, req)
+	_, err = parseBinlogDumpGTID(ctx, data[:len(data)-1])
+	require.Error(t, err)
+}
+
+func TestAppendBinlogDecimal(t *testing.T) {
+	// the examples of decimal2bin in MySQL
+	require.Equal(t,
+		[]byte{0x81, 0x0d, 0xfb, 0x38, 0xd2, 0x04, 0xd2},
+		appendBinlogDecimal(nil, "1234567890.1234", 14, 4))
+	require.Equal(t,
+		[]byte{0x7e, 0xf2, 0x04, 0xc7, 0x2d, 0xfb, 0x2d},
+		appendBinlogDecimal(nil, "-1234567890.1234", 14, 4))
+	require.Equal(t, []byte{0x80, 0x01, 0x32}, appendBinlogDecimal(nil, "1.5", 5, 2))
+	require.Equal(t, []byte{0x80, 0x00}, appendBinlogDecimal(nil, "0", 3, 0))
+}
+
+func TestAppendBinlogValue(t *testing.T) {
+	value := func(typ types.Type, v any) []byte {
+		col := newBinlogColumn("c", typ, true)
+		data, err := appendBinlogValue(nil, &col, v)
+		require.NoError(t, err)
+		return data
+	}
+
+	require.Equal(t, []byte{0xff, 0xff}, value(types.T_int16.ToType(), int16(-1)))
+	require.Equal(t, []byte{0x01}, value(types.T_bool.ToType(), true))
+	require.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0}, value(types.T_uint64.ToType(), uint64(1)))
+
+	date := types.DateFromCalendar(2024, 3, 15)
+	u := uint32(15) | 3<<5 | 2024<<9
+	require.Equal(t, []byte{byte(u), byte(u >> 8), byte(u >> 16)}, value(types.T_date.ToType(), date))
+
+	dt := types.DatetimeFromClock(2019, 1, 1, 0, 0, 0, 0)
+	require.Equal(t, []byte{0x99, 0xa2, 0x02, 0x00, 0x00}, value(types.New(types.T_datetime, 0, 0), dt))
+	dt = types.DatetimeFromClock(2019, 1, 1, 0, 0, 0, 123456)
+	require.Equal(t,
+		[]byte{0x99, 0xa2, 0x02, 0x00, 0x00, 0x01, 0xe2, 0x40},
+		value(types.New(types.T_datetime, 0, 6), dt))
+
+	tm := types.TimeFromClock(true, 0, 0, 1, 0)
+	require.Equal(t, []byte{0x7f, 0xff, 0xff}, value(types.New(types.T_time, 0, 0), tm))
+	tm = types.TimeFromClock(false, 1, 2, 3, 0)
+	hms := 1<<12 | 2<<6 | 3 + 0x800000
+	require.Equal(t, []byte{byte(hms >> 16), byte(hms >> 8), byte(hms)}, value(types.New(types.T_time, 0, 0), tm))
+
+	ts := types.FromClockUTC(1970, 1, 1, 0, 1, 0, 500000)
+	require.Equal(t, []byte{0, 0, 0, 60, 50}, value(types.New(types.T_timestamp, 0, 2), ts))
+
+	require.Equal(t, []byte{3, 'a', 'b', 'c'}, value(types.New(types.T_varchar, 10, 0), []byte("abc")))
+	require.Equal(t, []byte{3, 0, 'a', 'b', 'c'}, value(types.New(types.T_varchar, 100, 0), []byte("abc")))
+	require.Equal(t, []byte{3, 0, 0, 0, 'a', 'b', 'c'}, value(types.T_text.ToType(), []byte("abc")))
+
+	col := newBinlogColumn("c", types.T_int32.ToType(), true)
+	_, err := appendBinlogValue(nil, &col, "x")
+	require.Error(t, err)
+}
+
+func newTestBinlogTable() *binlogTable {
+	def := &plan.TableDef{
+		Name: "t",
+		Cols: []*plan.ColDef{
+			{Name: "a", Typ: plan.Type{Id: int32(types.T_int64)}, Default: &plan.Default{}},
+			{Name: "b", Typ: plan.Type{Id: int32(types.T_varchar), Width: 20}, Default: &plan.Default{NullAbility: true}},
+		},
+		Pkey: &plan.PrimaryKeyDef{PkeyColName: "a", Names: []string{"a"}},
+	}
+	return newBinlogTable(272515, "db", def)
+}
+
+func TestNewBinlogTable(t *testing.T) {
+	table := newTestBinlogTable()
+	require.NotNil(t, table)
+	require.Equal(t, []int{0}, table.pkIdx)
+	require.False(t, table.columns[0].nullable)
+	require.True(t, table.columns[1].nullable)
+	require.Equal(t, defines.MYSQL_TYPE_VARCHAR, table.columns[1].mysqlType)
+
+	require.Nil(t, newBinlogTable(1, "db", &plan.TableDef{Name: "t"}))
+}
+
+func TestBinlogEncoderEvents(t *testing.T) {
+	table := newTestBinlogTable()
+	newTxn := func(reverse bool) *binlogTxn {
+		txn := &binlogTxn{
+			ts:  types.BuildTS(1700000000000000000, 1),
+			ddl: []binlogDDL{{schema: "db", query: "create table t (a bigint primary key, b varchar(20))"}},
+		}
+		c := txn.changes(table)
+		keys := []string{"k1", "k2", "k3"}
+		if reverse {
+			keys = []string{"k3", "k2", "k1"}
+		}
+		for _, key := range keys {
+			n := int64(key[1] - '0')
+			c.inserts[key] = []any{n, []byte(strings.Repeat("x", int(n)))}
+		}
+		c.deletes["k1"] = []any{int64(1), nil}
+		c.deletes["k9"] = []any{int64(9), nil}
+		return txn
+	}
+
+	enc := &binlogEncoder{serverID: 1, file: binlogFileName(1700000000000000000), pos: binlogFileHeaderLen}
+	events, err := enc.events(newTxn(false))
+	require.NoError(t, err)
+
+	var typs []byte
+	pos := uint32(binlogFileHeaderLen)
+	for _, event := range events {
+		typs = append(typs, event[4])
+		size := binary.LittleEndian.Uint32(event[9:])
+		require.Equal(t, uint32(len(event)), size)
+		pos += size
+		require.Equal(t, pos, binary.LittleEndian.Uint32(event[13:]))
+	}
+	require.Equal(t, []byte{
+		binlogQueryEvent,
+		binlogQueryEvent,
+		binlogTableMapEvent,
+		binlogDeleteRowsEvent,
+		binlogUpdateRowsEvent,
+		binlogWriteRowsEvent,
+		binlogXIDEvent,
+	}, typs)
+	require.Equal(t, pos, enc.pos)
+
+	// the same changes are encoded at the same positions
+	enc2 := &binlogEncoder{serverID: 1, file: enc.file, pos: binlogFileHeaderLen}
+	events2, err := enc2.events(newTxn(true))
+	require.NoError(t, err)
+	require.Equal(t, events, events2)
+
+	// update rows: the before image is the primary key, the after image is
+	// the whole row
+	update := events[4][binlogEventHeaderLen:]
+	require.Equal(t, binlogStmtEndFlag, binary.LittleEndian.Uint16(update[6:]))
+	require.Equal(t, []byte{2, 0x01, 0x03}, update[10:13])
+	require.Equal(t, append([]byte{0}, 1, 0, 0, 0, 0, 0, 0, 0), update[13:22])
+	require.Equal(t, append([]byte{0, 1, 0, 0, 0, 0, 0, 0, 0}, 1, 'x'), update[22:])
+}
+
+func TestBinlogRowsEventSplit(t *testing.T) {
+	table := newTestBinlogTable()
+	var rows [][]any
+	for i := 0; i < 1000; i++ {
+		rows = append(rows, []any{int64(i), []byte(strings.Repeat("y", 20))})
+	}
+	enc := &binlogEncoder{pos: binlogFileHeaderLen}
+	events, err := enc.rowsEvents(binlogWriteRowsEvent, 0, table, nil, rows)
+	require.NoError(t, err)
+	require.Greater(t, len(events), 1)
+	for i, event := range events {
+		flags := binary.LittleEndian.Uint16(event[binlogEventHeaderLen+6:])
+		if i == len(events)-1 {
+			require.Equal(t, binlogStmtEndFlag, flags)
+		} else {
+			require.Zero(t, flags)
+			require.Less(t, len(event), binlogRowsEventMaxSize+binlogEventHeaderLen+64)
+		}
+	}
+}
+
+func TestBinlogFormatDescription(t *testing.T) {
+	enc := &binlogEncoder{serverID: 3, serverVersion: "8.0.30-MatrixOne-v1.2.0", pos: binlogFileHeaderLen}
+	event := enc.formatDescription(100)
+	// the same size as MySQL 5.7 with the checksum
+	require.Equal(t, 119, len(event))
+	require.Equal(t, uint32(binlogFileHeaderLen+119), enc.pos)
+	require.Equal(t, binlogChecksumOff, event[len(event)-5])
+	require.Equal(t, crc32.ChecksumIEEE(event[:len(event)-4]), binary.LittleEndian.Uint32(event[len(event)-4:]))
+
+	rotate := enc.rotate(100, "binlog.0000000000000000002")
+	require.Equal(t, uint32(binlogFileHeaderLen+119+len(rotate)), binary.LittleEndian.Uint32(rotate[13:]))
+	require.Equal(t, "binlog.0000000000000000002", enc.file)
+	require.Equal(t, uint32(binlogFileHeaderLen), enc.pos)
+
+	fake := enc.fakeRotate(1000)
+	require.Zero(t, binary.LittleEndian.Uint32(fake[13:]))
+	require.Equal(t, binlogArtificialFlag, binary.LittleEndian.Uint16(fake[17:]))
+	require.Equal(t, uint64(1000), binary.LittleEndian.Uint64(fake[binlogEventHeaderLen:]))
+	require.Equal(t, uint32(binlogFileHeaderLen), enc.pos)
+}
@@ -0,0 +1,427 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+)
+
+const (
+	defaultCdcTaskMaxRetryTimes = 10
+	defaultCdcTaskRetryInterval = int64(time.Second * 10)
+)
+
+const getCdcWatermarksFmt = "select db_name, table_name, watermark from `%s`.`%s` where account_id = %d and task_id = '%s' order by db_name, table_name"
+
+func cdcTaskMetadata(taskID string) pb.TaskMetadata {
+	return pb.TaskMetadata{
+		ID:       taskID,
+		Executor: pb.TaskCode_InitCdc,
+		Options: pb.TaskOptions{
+			MaxRetryTimes: defaultCdcTaskMaxRetryTimes,
+			RetryInterval: defaultCdcTaskRetryInterval,
+			DelayDuration: 0,
+			Concurrency:   0,
+		},
+	}
+}
+
+func getTaskService(ctx context.Context) (taskservice.TaskService, error) {
+	if getGlobalPu() == nil || getGlobalPu().TaskService == nil {
+		return nil, moerr.NewInternalError(ctx,
+			"task service not ready yet, please try again later.")
+	}
+	return getGlobalPu().TaskService, nil
+}
+
+// queryCdcTasks returns the cdc tasks of the account which are not dropped.
+// If taskName is not empty, only the task with the name is returned.
+func queryCdcTasks(ctx context.Context, ts taskservice.TaskService, accountID uint32, taskName string) ([]pb.DaemonTask, error) {
+	tasks, err := ts.QueryDaemonTask(ctx,
+		taskservice.WithTaskType(taskservice.EQ, pb.TaskType_CreateCdc.String()),
+		taskservice.WithAccountID(taskservice.EQ, accountID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	res := tasks[:0]
+	for _, t := range tasks {
+		if t.TaskStatus == pb.TaskStatus_Canceled || t.TaskStatus == pb.TaskStatus_CancelRequested {
+			continue
+		}
+		details, ok := t.Details.Details.(*pb.Details_CreateCdc)
+		if !ok {
+			return nil, moerr.NewInternalError(ctx, "invalid task type %s", t.TaskType.String())
+		}
+		if taskName != "" && details.CreateCdc.TaskName != taskName {
+			continue
+		}
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+func handleCreateCdc(ctx context.Context, ses *Session, st *tree.CreateCDC) error {
+	ts, err := getTaskService(ctx)
+	if err != nil {
+		return err
+	}
+	if st.TaskName == "" {
+		return moerr.NewInternalError(ctx, "cdc task name is empty")
+	}
+	sinkType := strings.ToLower(st.SinkType)
+	if _, err = cdc.ParseUri(ctx, cdc.SinkTypeMySQL, st.SourceUri); err != nil {
+		return err
+	}
+	if _, err = cdc.ParseUri(ctx, sinkType, st.SinkUri); err != nil {
+		return err
+	}
+	mappings, err := cdc.ParseTables(ctx, st.Tables)
+	if err != nil {
+		return err
+	}
+	for _, m := range mappings {
+		if m.SourceTable == "*" {
+			continue
+		}
+		if _, tableDef := ses.GetTxnCompileCtx().Resolve(m.SourceDatabase, m.SourceTable, nil); tableDef == nil {
+			return moerr.NewNoSuchTable(ctx, m.SourceDatabase, m.SourceTable)
+		}
+	}
+	options, err := cdc.MakeOptions(ctx, st.Option)
+	if err != nil {
+		return err
+	}
+
+	accountID := ses.GetTenantInfo().GetTenantID()
+	tasks, err := queryCdcTasks(ctx, ts, accountID, st.TaskName)
+	if err != nil {
+		return err
+	}
+	if len(tasks) > 0 {
+		if st.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "cdc task %s already exists", st.TaskName)
+	}
+
+	taskID := uuid.New().String()
+	details := &pb.Details{
+		AccountID: accountID,
+		Account:   ses.GetTenantName(),
+		Username:  ses.GetUserName(),
+		Details: &pb.Details_CreateCdc{
+			CreateCdc: &pb.CreateCdcDetails{
+				TaskId:    taskID,
+				TaskName:  st.TaskName,
+				SourceUri: st.SourceUri,
+				SinkType:  sinkType,
+				SinkUri:   st.SinkUri,
+				Tables:    st.Tables,
+				Options:   options,
+			},
+		},
+	}
+	return ts.CreateDaemonTask(ctx, cdcTaskMetadata(taskID), details)
+}
+
+// updateCdcTasks moves the cdc tasks selected by option to the status.
+// Tasks already in the status or the requested one are skipped, and tasks
+// which are not in one of the from statuses are rejected.
+func updateCdcTasks(
+	ctx context.Context,
+	ses *Session,
+	option *tree.AllOrNotCDC,
+	requested pb.TaskStatus,
+	done pb.TaskStatus,
+	from ...pb.TaskStatus,
+) ([]pb.DaemonTask, error) {
+	ts, err := getTaskService(ctx)
+	if err != nil {
+		return nil, err
+	}
+	taskName := ""
+	if !option.All {
+		if option.TaskName == "" {
+			return nil, moerr.NewInternalError(ctx, "cdc task name is empty")
+		}
+		taskName = option.TaskName
+	}
+	tasks, err := queryCdcTasks(ctx, ts, ses.GetTenantInfo().GetTenantID(), taskName)
+	if err != nil {
+		return nil, err
+	}
+	if taskName != "" && len(tasks) == 0 {
+		return nil, moerr.NewInternalError(ctx, "cdc task %s does not exist", taskName)
+	}
+
+	toUpdate := make([]pb.DaemonTask, 0, len(tasks))
+	for _, t := range tasks {
+		if t.TaskStatus == requested || t.TaskStatus == done {
+			continue
+		}
+		allowed := len(from) == 0
+		for _, status := range from {
+			allowed = allowed || t.TaskStatus == status
+		}
+		if !allowed {
+			return nil, moerr.NewInternalError(ctx, "cdc task %s can not be changed to %s in %s state",
+				t.Details.GetCreateCdc().TaskName, done, t.TaskStatus)
+		}
+		t.TaskStatus = requested
+		toUpdate = append(toUpdate, t)
+	}
+	if len(toUpdate) == 0 {
+		return tasks, nil
+	}
+	c, err := ts.UpdateDaemonTask(ctx, toUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if c != len(toUpdate) {
+		return nil, moerr.NewInternalError(ctx, "cdc tasks changed concurrently, please try again")
+	}
+	return tasks, nil
+}
+
+func handlePauseCdc(ctx context.Context, ses *Session, st *tree.PauseCDC) error {
+	_, err := updateCdcTasks(ctx, ses, st.Option,
+		pb.TaskStatus_PauseRequested, pb.TaskStatus_Paused,
+		pb.TaskStatus_Running, pb.TaskStatus_Created, pb.TaskStatus_ResumeRequested)
+	return err
+}
+
+func handleResumeCdc(ctx context.Context, ses *Session, st *tree.ResumeCDC) error {
+	_, err := updateCdcTasks(ctx, ses, &tree.AllOrNotCDC{TaskName: st.TaskName},
+		pb.TaskStatus_ResumeRequested, pb.TaskStatus_Running,
+		pb.TaskStatus_Paused)
+	return err
+}
+
+// handleRestartCdc restarts the task from its saved watermarks. Unlike
+// RESUME, it also works for running tasks and for tasks stopped by an error.
+func handleRestartCdc(ctx context.Context, ses *Session, st *tree.RestartCDC) error {
+	ts, err := getTaskService(ctx)
+	if err != nil {
+		return err
+	}
+	// an empty name would select all the tasks of the account
+	if st.TaskName == "" {
+		return moerr.NewInternalError(ctx, "cdc task name is empty")
+	}
+	tasks, err := queryCdcTasks(ctx, ts, ses.GetTenantInfo().GetTenantID(), st.TaskName)
+	if err != nil {
+		return err
+	}
+	if len(tasks) == 0 {
+		return moerr.NewInternalError(ctx, "cdc task %s does not exist", st.TaskName)
+	}
+	tasks[0].TaskStatus = pb.TaskStatus_ResumeRequested
+	tasks[0].Details.Error = ""
+	c, err := ts.UpdateDaemonTask(ctx, tasks[:1])
+	if err != nil {
+		return err
+	}
+	if c != 1 {
+		return moerr.NewErrTaskNotFound(ctx, tasks[0].ID)
+	}
+	return nil
+}
+
+func handleDropCdc(ctx context.Context, ses *Session, st *tree.DropCDC) error {
+	tasks, err := updateCdcTasks(ctx, ses, st.Option,
+		pb.TaskStatus_CancelRequested, pb.TaskStatus_Canceled)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	sysCtx := defines.AttachAccountId(ctx, sysAccountID)
+	for _, t := range tasks {
+		sql := cdc.DeleteWatermarkSQL(ses.GetTenantInfo().GetTenantID(), t.Details.GetCreateCdc().TaskId)
+		if err = bh.Exec(sysCtx, sql); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var cdcCols = []Column{
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "task_id",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "task_name",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "source_uri",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "sink_type",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "sink_uri",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "tables",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "options",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "state",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "checkpoint",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "created_at",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+	&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "error",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	},
+}
+
+func handleShowCdc(ctx context.Context, ses *Session, st *tree.ShowCDC) error {
+	ts, err := getTaskService(ctx)
+	if err != nil {
+		return err
+	}
+	taskName := ""
+	if !st.Option.All {
+		taskName = st.Option.TaskName
+	}
+	accountID := ses.GetTenantInfo().GetTenantID()
+	tasks, err := queryCdcTasks(ctx, ts, accountID, taskName)
+	if err != nil {
+		return err
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].ID < tasks[j].ID
+	})
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	mrs := ses.GetMysqlResultSet()
+	for _, col := range cdcCols {
+		mrs.AddColumn(col)
+	}
+	for _, t := range tasks {
+		details := t.Details.GetCreateCdc()
+		checkpoint, err := getCdcCheckpoint(ctx, bh, accountID, details.TaskId)
+		if err != nil {
+			return err
+		}
+		row := make([]interface{}, len(cdcCols))
+		row[0] = details.TaskId
+		row[1] = details.TaskName
+		row[2] = cdc.MaskUri(details.SourceUri)
+		row[3] = details.SinkType
+		row[4] = cdc.MaskUri(details.SinkUri)
+		row[5] = details.Tables
+		row[6] = optionString(details.Options)
+		row[7] = t.TaskStatus.String()
+		row[8] = checkpoint
+		row[9] = t.CreateAt.String()
+		row[10] = t.Details.Error
+		mrs.AddRow(row)
+	}
+	return trySaveQueryResult(ctx, ses, mrs)
+}
+
+// getCdcCheckpoint returns the watermarks of the tables of a cdc task,
+// formatted as db.table=watermark.
+func getCdcCheckpoint(ctx context.Context, bh BackgroundExec, accountID uint32, taskID string) (string, error) {
+	sql := fmt.Sprintf(getCdcWatermarksFmt,
+		catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK, accountID, taskID)
+	sysCtx := defines.AttachAccountId(ctx, sysAccountID)
+	bh.ClearExecResultSet()
+	if err := bh.Exec(sysCtx, sql); err != nil {
+		return "", err
+	}
+	erArray, err := getResultSet(sysCtx, bh)
+	if err != nil {
+		return "", err
+	}
+	if !execResultArrayHasData(erArray) {
+		return "", nil
+	}
+	items := make([]string, 0, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		db, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return "", err
+		}
+		table, err := erArray[0].GetString(ctx, i, 1)
+		if err != nil {
+			return "", err
+		}
+		watermark, err := erArray[0].GetString(ctx, i, 2)
+		if err != nil {
+			return "", err
+		}
+		items = append(items, fmt.Sprintf("%s.%s=%s", db, table, watermark))
+	}
+	return strings.Join(items, ","), nil
+}
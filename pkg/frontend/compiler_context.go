@@ -87,6 +87,24 @@ func (tcc *TxnCompilerContext) SetViews(views []string) {
 	tcc.views = views
 }
 
+func (tcc *TxnCompilerContext) AddWarning(warn error) {
+	tcc.mu.Lock()
+	execCtx := tcc.execCtx
+	tcc.mu.Unlock()
+	if execCtx == nil {
+		return
+	}
+	ses, ok := execCtx.ses.(*Session)
+	if !ok || ses.GetErrInfo() == nil {
+		return
+	}
+	code := moerr.ER_UNKNOWN_ERROR
+	if me, ok := warn.(*moerr.Error); ok {
+		code = me.MySQLCode()
+	}
+	ses.GetErrInfo().pushWarning(code, warn.Error())
+}
+
 func (tcc *TxnCompilerContext) GetSnapshot() *plan2.Snapshot {
 	tcc.mu.Lock()
 	defer tcc.mu.Unlock()
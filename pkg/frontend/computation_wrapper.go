@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -239,6 +240,8 @@ func (cwft *TxnComputationWrapper) Compile(any any, fill func(*batch.Batch) erro
 			// retComp
 			cwft.proc.ReplaceTopCtx(execCtx.reqCtx)
 			retComp.Reset(cwft.proc, getStatementStartAt(execCtx.reqCtx), fill, cwft.ses.GetSql())
+			retComp.SetMaxExecutionTime(maxExecutionTimeOf(cwft.ses, cwft.stmt))
+			cwft.proc.Base.Lim.MemoryQuota = memoryQuotaOf(cwft.ses)
 			cwft.compile = retComp
 		}
 
@@ -422,6 +425,9 @@ func createCompile(
 		getStatementStartAt(execCtx.reqCtx),
 	)
 	retCompile.SetIsPrepare(isPrepare)
+	retCompile.SetParallelOutput(exportInParallel(ses, stmt))
+	retCompile.SetMaxExecutionTime(maxExecutionTimeOf(ses, stmt))
+	proc.Base.Lim.MemoryQuota = memoryQuotaOf(ses)
 	retCompile.SetBuildPlanFunc(func(ctx context.Context) (*plan2.Plan, error) {
 		plan, err := buildPlan(ctx, ses, ses.GetTxnCompileCtx(), stmt)
 		if err != nil {
@@ -468,3 +474,45 @@ func createCompile(
 	retCompile.SetOriginSQL(originSQL)
 	return
 }
+
+// maxExecutionTimeOf returns the time limit of the statement. Like MySQL, it
+// applies to the read-only SELECT statements, and the MAX_EXECUTION_TIME(N)
+// hint overrides the max_execution_time variable, whose global value is the
+// default of the account. Both are in milliseconds, 0 means no limit.
+func maxExecutionTimeOf(ses FeSession, stmt tree.Statement) time.Duration {
+	if ses.IsBackgroundSession() || ses.GetIsInternal() {
+		return 0
+	}
+	sel, ok := stmt.(*tree.Select)
+	if !ok || sel.SelectLockInfo != nil {
+		return 0
+	}
+	if clause, ok := sel.Select.(*tree.SelectClause); ok {
+		if hint := clause.Hints.Find("MAX_EXECUTION_TIME"); hint != nil && len(hint.Args) == 1 {
+			if ms, err := strconv.ParseUint(hint.Args[0], 10, 32); err == nil {
+				return time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+	val, err := ses.GetSessionSysVar("max_execution_time")
+	if err != nil {
+		return 0
+	}
+	ms, _ := val.(int64)
+	return time.Duration(ms) * time.Millisecond
+}
+
+// memoryQuotaOf returns the max bytes of memory the statement can hold in each
+// CN. It is the query_memory_limit variable, whose global value is the default
+// of the account. 0 means no limit.
+func memoryQuotaOf(ses FeSession) int64 {
+	if ses.IsBackgroundSession() || ses.GetIsInternal() {
+		return 0
+	}
+	val, err := ses.GetSessionSysVar("query_memory_limit")
+	if err != nil {
+		return 0
+	}
+	quota, _ := val.(int64)
+	return quota
+}
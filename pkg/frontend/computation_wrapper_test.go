@@ -0,0 +1,67 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+)
+
+func Test_maxExecutionTimeOf(t *testing.T) {
+	ctx := context.TODO()
+	ses := &Session{feSessionImpl: feSessionImpl{
+		sesSysVars: &SystemVariables{mp: map[string]interface{}{"max_execution_time": int64(2000)}},
+	}}
+
+	for _, kase := range []struct {
+		sql      string
+		expected time.Duration
+	}{
+		{"select a from t", 2 * time.Second},
+		{"select /*+ MAX_EXECUTION_TIME(100) */ a from t", 100 * time.Millisecond},
+		{"select /*+ MAX_EXECUTION_TIME(0) */ a from t", 0},
+		{"select /*+ MAX_EXECUTION_TIME(-1) */ a from t", 2 * time.Second},
+		{"select a from t for update", 0},
+		{"insert into t select /*+ MAX_EXECUTION_TIME(100) */ a from t", 0},
+		{"update t set a = 1", 0},
+	} {
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, kase.sql, 1)
+		require.NoError(t, err, kase.sql)
+		require.Equal(t, kase.expected, maxExecutionTimeOf(ses, stmt), kase.sql)
+	}
+
+	// the internal statements have no limit.
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "select a from t", 1)
+	require.NoError(t, err)
+	ses.isInternal = true
+	require.Equal(t, time.Duration(0), maxExecutionTimeOf(ses, stmt))
+}
+
+func Test_memoryQuotaOf(t *testing.T) {
+	ses := &Session{feSessionImpl: feSessionImpl{
+		sesSysVars: &SystemVariables{mp: map[string]interface{}{"query_memory_limit": int64(1 << 30)}},
+	}}
+	require.Equal(t, int64(1<<30), memoryQuotaOf(ses))
+
+	// the internal statements have no limit.
+	ses.isInternal = true
+	require.Equal(t, int64(0), memoryQuotaOf(ses))
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// cursorBufferedRows is the max count of the rows buffered by a cursor.
+const cursorBufferedRows = 1024
+
+// stmtCursor is the read-only cursor opened by the COM_STMT_EXECUTE with
+// CURSOR_TYPE_READ_ONLY. The statement is executed in its own goroutine.
+// The column definitions are sent by the COM_STMT_EXECUTE, and the rows are
+// buffered in rows until they are sent by the COM_STMT_FETCH. The pipeline is
+// blocked while the buffer is full, so the rows are produced as fast as the
+// client fetches them.
+//
+// The execution shares the session, its txn and process with the other
+// commands of the connection. So only COM_STMT_FETCH and COM_PING can be
+// interleaved with the open cursor, the other commands close the cursor
+// before they are executed. A session has one open cursor at most.
+type stmtCursor struct {
+	*MysqlResp
+	stmtID uint32
+	// columns are set before the cursor is opened
+	columns []Column
+	// rows is closed after the execution ends
+	rows chan []any
+	// opened is closed after the column definitions have been sent
+	opened     chan struct{}
+	isOpened   bool
+	done       chan struct{}
+	err        error
+	cancelFunc context.CancelFunc
+}
+
+func newStmtCursor(resper *MysqlResp, stmtID uint32, cancel context.CancelFunc) *stmtCursor {
+	return &stmtCursor{
+		MysqlResp:  resper,
+		stmtID:     stmtID,
+		rows:       make(chan []any, cursorBufferedRows),
+		opened:     make(chan struct{}),
+		done:       make(chan struct{}),
+		cancelFunc: cancel,
+	}
+}
+
+// RespPreMeta sends the column definitions and the EOF packet with
+// SERVER_STATUS_CURSOR_EXISTS, then the cursor is opened.
+func (c *stmtCursor) RespPreMeta(execCtx *ExecCtx, meta any) (err error) {
+	if c.isOpened {
+		return moerr.NewInternalError(execCtx.reqCtx, "the cursor has been opened")
+	}
+	ses := execCtx.ses.(*Session)
+	if err = c.respColumnDefs(ses, execCtx, meta.([]any)); err != nil {
+		return err
+	}
+	c.columns = ses.GetMysqlResultSet().Columns
+	status := ses.GetTxnHandler().GetServerStatus() | SERVER_STATUS_CURSOR_EXISTS
+	if err = c.mysqlRrWr.WriteEOFOrOK(0, status); err != nil {
+		return err
+	}
+	c.isOpened = true
+	close(c.opened)
+	return nil
+}
+
+// RespResult buffers the rows of the batch. It waits for the COM_STMT_FETCH
+// if the buffer is full.
+func (c *stmtCursor) RespResult(execCtx *ExecCtx, bat *batch.Batch) (err error) {
+	if !c.isOpened {
+		return c.MysqlResp.RespResult(execCtx, bat)
+	}
+	if c.binWr != nil {
+		if err = c.binWr.Write(execCtx, bat); err != nil {
+			return err
+		}
+	}
+	if bat == nil {
+		return nil
+	}
+	for j := 0; j < bat.RowCount(); j++ {
+		row := make([]any, len(bat.Vecs))
+		if err = extractRowFromEveryVector(execCtx.reqCtx, execCtx.ses, bat, j, row); err != nil {
+			return err
+		}
+		// the arrays share the memory of the vectors
+		for i, v := range row {
+			switch arr := v.(type) {
+			case []float32:
+				row[i] = slices.Clone(arr)
+			case []float64:
+				row[i] = slices.Clone(arr)
+			}
+		}
+		select {
+		case c.rows <- row:
+		case <-execCtx.reqCtx.Done():
+			return execCtx.reqCtx.Err()
+		}
+	}
+	return nil
+}
+
+// RespPostMeta does not send the end of the rows, it is sent by the
+// COM_STMT_FETCH which fetches the last row.
+func (c *stmtCursor) RespPostMeta(execCtx *ExecCtx, meta any) (err error) {
+	if !c.isOpened {
+		return c.MysqlResp.RespPostMeta(execCtx, meta)
+	}
+	ses := execCtx.ses.(*Session)
+	if len(execCtx.proc.GetSessionInfo().SeqAddValues) != 0 {
+		ses.AddSeqValues(execCtx.proc)
+	}
+	ses.SetSeqLastValue(execCtx.proc)
+	return nil
+}
+
+// close cancels the execution and waits for its end.
+func (c *stmtCursor) close() {
+	c.cancelFunc()
+	<-c.done
+}
+
+// useCursor returns true if the prepared statement is executed with a
+// read-only cursor. The flag is ignored by the statements which do not
+// return rows to the client, as mysql does.
+func useCursor(ses *Session, prepareStmt *PrepareStmt) bool {
+	if prepareStmt.cursorType&CURSOR_TYPE_READ_ONLY == 0 {
+		return false
+	}
+	if _, ok := ses.GetResponser().(*MysqlResp); !ok {
+		return false
+	}
+	sel, ok := prepareStmt.PrepareStmt.(*tree.Select)
+	return ok && sel.Ep == nil
+}
+
+// executeStmtWithCursor executes the prepared statement in a new goroutine,
+// and returns after the cursor is opened or the execution ends.
+func executeStmtWithCursor(ses *Session, execCtx *ExecCtx, stmtID uint32, sql string, prepareStmt *PrepareStmt) error {
+	// the cursor outlives the request
+	ctx, cancel := context.WithCancel(context.WithoutCancel(execCtx.reqCtx))
+	c := newStmtCursor(ses.GetResponser().(*MysqlResp), stmtID, cancel)
+	cursorExecCtx := &ExecCtx{
+		reqCtx:        ctx,
+		ses:           ses,
+		prepareColDef: prepareStmt.ColDefData,
+		cursor:        c,
+	}
+	go func() {
+		defer func() {
+			if e := recover(); e != nil {
+				c.err = moerr.ConvertPanicError(ctx, e)
+			}
+			prepareStmt.resetParams()
+			cursorExecCtx.Close()
+			close(c.rows)
+			close(c.done)
+		}()
+		c.err = doComQuery(ses, cursorExecCtx, &UserInput{sql: sql})
+	}()
+
+	select {
+	case <-c.opened:
+		ses.mu.Lock()
+		ses.cursor = c
+		ses.mu.Unlock()
+		return nil
+	case <-c.done:
+		cancel()
+		return c.err
+	case <-execCtx.reqCtx.Done():
+		c.close()
+		return execCtx.reqCtx.Err()
+	}
+}
+
+// closeCursor closes the open cursor of the session.
+func (ses *Session) closeCursor() {
+	ses.mu.Lock()
+	c := ses.cursor
+	ses.cursor = nil
+	ses.mu.Unlock()
+	if c != nil {
+		c.close()
+	}
+}
+
+func (ses *Session) getCursor() *stmtCursor {
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.cursor
+}
+
+// handleStmtFetch sends the next rows of the open cursor. The cursor is
+// closed after the last row is sent.
+func handleStmtFetch(ses *Session, execCtx *ExecCtx, data []byte) error {
+	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_fetch.html
+	if len(data) < 8 {
+		return moerr.NewInvalidInput(execCtx.reqCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	numRows := binary.LittleEndian.Uint32(data[4:8])
+	c := ses.getCursor()
+	if c == nil || c.stmtID != stmtID {
+		return moerr.NewInternalError(execCtx.reqCtx, "the statement (%d) has no open cursor", stmtID)
+	}
+
+	mrs := &MysqlResultSet{Columns: c.columns}
+	last := false
+	for !last && uint32(len(mrs.Data)) < numRows {
+		select {
+		case row, ok := <-c.rows:
+			if ok {
+				mrs.Data = append(mrs.Data, row)
+			} else {
+				last = true
+			}
+		case <-execCtx.reqCtx.Done():
+			ses.closeCursor()
+			return execCtx.reqCtx.Err()
+		}
+	}
+
+	mysqlRrWr := c.mysqlRrWr
+	if err := mysqlRrWr.WriteResultSetRow(mrs, uint64(len(mrs.Data))); err != nil {
+		ses.closeCursor()
+		return err
+	}
+	if !last {
+		return mysqlRrWr.WriteEOFOrOK(0, ses.GetTxnHandler().GetServerStatus()|SERVER_STATUS_CURSOR_EXISTS)
+	}
+	// the status is read after the execution ends
+	ses.closeCursor()
+	if c.err != nil {
+		return c.err
+	}
+	return mysqlRrWr.WriteEOFOrOK(0, ses.GetTxnHandler().GetServerStatus()|SERVER_STATUS_LAST_ROW_SENT)
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+)
+
+// cursorTestWriter records the rows and the status of the EOF packets.
+type cursorTestWriter struct {
+	testMysqlWriter
+	rows   [][]any
+	status []uint16
+}
+
+func (w *cursorTestWriter) WriteResultSetRow(mrs *MysqlResultSet, cnt uint64) error {
+	w.rows = append(w.rows, mrs.Data[:cnt]...)
+	return nil
+}
+
+func (w *cursorTestWriter) WriteEOFOrOK(warnings uint16, status uint16) error {
+	w.status = append(w.status, status)
+	return nil
+}
+
+func newCursorTestSession(w MysqlRrWr) *Session {
+	return &Session{
+		feSessionImpl: feSessionImpl{
+			respr:      NewMysqlResp(w),
+			txnHandler: &TxnHandler{},
+		},
+	}
+}
+
+// newFinishedCursor returns the cursor whose execution has produced the rows.
+func newFinishedCursor(ses *Session, stmtID uint32, rows [][]any, err error) *stmtCursor {
+	c := newStmtCursor(ses.GetResponser().(*MysqlResp), stmtID, func() {})
+	c.rows = make(chan []any, len(rows))
+	for _, row := range rows {
+		c.rows <- row
+	}
+	c.err = err
+	close(c.rows)
+	close(c.done)
+	return c
+}
+
+func fetchPayload(stmtID, numRows uint32) []byte {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint32(data[0:4], stmtID)
+	binary.LittleEndian.PutUint32(data[4:8], numRows)
+	return data
+}
+
+func Test_useCursor(t *testing.T) {
+	ctx := context.TODO()
+	ses := newCursorTestSession(&cursorTestWriter{})
+	parse := func(sql string) *PrepareStmt {
+		stmts, err := mysql.Parse(ctx, sql, 1)
+		require.NoError(t, err)
+		return &PrepareStmt{PrepareStmt: stmts[0], cursorType: CURSOR_TYPE_READ_ONLY}
+	}
+
+	require.True(t, useCursor(ses, parse("select a from t")))
+	require.False(t, useCursor(ses, parse("select a from t into outfile '/tmp/t.csv'")))
+	require.False(t, useCursor(ses, parse("insert into t values (1)")))
+
+	stmt := parse("select a from t")
+	stmt.cursorType = CURSOR_TYPE_NO_CURSOR
+	require.False(t, useCursor(ses, stmt))
+}
+
+func Test_handleStmtFetch(t *testing.T) {
+	ctx := context.TODO()
+	w := &cursorTestWriter{}
+	ses := newCursorTestSession(w)
+	execCtx := &ExecCtx{reqCtx: ctx, ses: ses}
+
+	err := handleStmtFetch(ses, execCtx, fetchPayload(1, 2))
+	require.ErrorContains(t, err, "has no open cursor")
+	err = handleStmtFetch(ses, execCtx, []byte{1, 0, 0, 0})
+	require.ErrorContains(t, err, "malformed packet")
+
+	ses.cursor = newFinishedCursor(ses, 1, [][]any{{int64(1)}, {int64(2)}, {int64(3)}}, nil)
+	err = handleStmtFetch(ses, execCtx, fetchPayload(2, 2))
+	require.ErrorContains(t, err, "has no open cursor")
+
+	require.NoError(t, handleStmtFetch(ses, execCtx, fetchPayload(1, 2)))
+	require.Equal(t, [][]any{{int64(1)}, {int64(2)}}, w.rows)
+	require.Equal(t, SERVER_STATUS_CURSOR_EXISTS, w.status[0])
+	require.NotNil(t, ses.getCursor())
+
+	require.NoError(t, handleStmtFetch(ses, execCtx, fetchPayload(1, 2)))
+	require.Equal(t, [][]any{{int64(1)}, {int64(2)}, {int64(3)}}, w.rows)
+	require.Equal(t, SERVER_STATUS_LAST_ROW_SENT, w.status[1])
+	require.Nil(t, ses.getCursor())
+
+	// the error of the execution is returned after the buffered rows
+	w.rows, w.status = nil, nil
+	ses.cursor = newFinishedCursor(ses, 1, [][]any{{int64(1)}}, context.Canceled)
+	err = handleStmtFetch(ses, execCtx, fetchPayload(1, 2))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, [][]any{{int64(1)}}, w.rows)
+	require.Empty(t, w.status)
+	require.Nil(t, ses.getCursor())
+}
+
+func Test_stmtCursorRespResult(t *testing.T) {
+	mp := mpool.MustNewZero()
+	ses := newCursorTestSession(&cursorTestWriter{})
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	execCtx := &ExecCtx{reqCtx: ctx, ses: ses}
+
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	for i := int64(0); i < 3; i++ {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], i, false, mp))
+	}
+	bat.SetRowCount(3)
+	defer bat.Clean(mp)
+
+	c := newStmtCursor(ses.GetResponser().(*MysqlResp), 1, cancel)
+	c.binWr = nil
+	c.isOpened = true
+	c.rows = make(chan []any, 2)
+
+	// the pipeline is blocked once the buffer is full, until the cursor is closed
+	done := make(chan error)
+	go func() {
+		done <- c.RespResult(execCtx, bat)
+	}()
+	require.Eventually(t, func() bool { return len(c.rows) == 2 }, time.Second*10, time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("the pipeline should be blocked")
+	default:
+	}
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+	require.Equal(t, []any{int64(0)}, <-c.rows)
+	require.Equal(t, []any{int64(1)}, <-c.rows)
+}
@@ -71,6 +71,9 @@ type ExportConfig struct {
 	mrs         *MysqlResultSet
 	lineStr     []byte
 	ctx         context.Context
+	// writer writes the files of the jsonline and parquet formats, and the
+	// compressed or partitioned files. It is nil for the plain csv.
+	writer *exportWriter
 }
 
 type writeParam struct {
@@ -408,127 +411,16 @@ func constructByte(ctx context.Context, obj FeSession, bat *batch.Batch, index i
 	closeby := ep.userConfig.Fields.EnclosedBy.Value
 	terminated := ep.userConfig.Fields.Terminated.Value
 	flag := ep.ColumnFlag
-	writeByte := make([]byte, 0)
-	for i := 0; i < bat.RowCount(); i++ {
-		for j, vec := range bat.Vecs {
-			if vec.GetNulls().Contains(uint64(i)) {
-				writeByte = appendBytes(writeByte, []byte("\\N"), symbol[j], closeby, flag[j])
-				continue
-			}
-			switch vec.GetType().Oid { //get col
-			case types.T_json:
-				val := types.DecodeJson(vec.GetBytesAt(i))
-				writeByte = appendBytes(writeByte, []byte(formatJsonString(val.String(), flag[j], terminated)), symbol[j], closeby, flag[j])
-			case types.T_bool:
-				val := vector.GetFixedAt[bool](vec, i)
-				if val {
-					writeByte = appendBytes(writeByte, []byte("true"), symbol[j], closeby, flag[j])
-				} else {
-					writeByte = appendBytes(writeByte, []byte("false"), symbol[j], closeby, flag[j])
-				}
-			case types.T_bit:
-				val := vector.GetFixedAt[uint64](vec, i)
-				bitLength := vec.GetType().Width
-				byteLength := (bitLength + 7) / 8
-				b := types.EncodeUint64(&val)[:byteLength]
-				slices.Reverse(b)
-				writeByte = appendBytes(writeByte, b, symbol[j], closeby, flag[j])
-			case types.T_int8:
-				val := vector.GetFixedAt[int8](vec, i)
-				writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_int16:
-				val := vector.GetFixedAt[int16](vec, i)
-				writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_int32:
-				val := vector.GetFixedAt[int32](vec, i)
-				writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_int64:
-				val := vector.GetFixedAt[int64](vec, i)
-				writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_uint8:
-				val := vector.GetFixedAt[uint8](vec, i)
-				writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_uint16:
-				val := vector.GetFixedAt[uint16](vec, i)
-				writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_uint32:
-				val := vector.GetFixedAt[uint32](vec, i)
-				writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_uint64:
-				val := vector.GetFixedAt[uint64](vec, i)
-				writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
-			case types.T_float32:
-				val := vector.GetFixedAt[float32](vec, i)
-				if vec.GetType().Scale < 0 || vec.GetType().Width == 0 {
-					writeByte = appendBytes(writeByte, []byte(strconv.FormatFloat(float64(val), 'f', -1, 32)), symbol[j], closeby, flag[j])
-				} else {
-					writeByte = appendBytes(writeByte, []byte(strconv.FormatFloat(float64(val), 'f', int(vec.GetType().Scale), 64)), symbol[j], closeby, flag[j])
-				}
-			case types.T_float64:
-				val := vector.GetFixedAt[float64](vec, i)
-				if vec.GetType().Scale < 0 || vec.GetType().Width == 0 {
-					writeByte = appendBytes(writeByte, []byte(strconv.FormatFloat(float64(val), 'f', -1, 32)), symbol[j], closeby, flag[j])
-				} else {
-					writeByte = appendBytes(writeByte, []byte(strconv.FormatFloat(float64(val), 'f', int(vec.GetType().Scale), 64)), symbol[j], closeby, flag[j])
-				}
-			case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary, types.T_datalink:
-				value := addEscapeToString(vec.GetBytesAt(i))
-				writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
-			case types.T_array_float32:
-				arrStr := types.BytesToArrayToString[float32](vec.GetBytesAt(i))
-				value := addEscapeToString(util2.UnsafeStringToBytes(arrStr))
-				writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
-			case types.T_array_float64:
-				arrStr := types.BytesToArrayToString[float64](vec.GetBytesAt(i))
-				value := addEscapeToString(util2.UnsafeStringToBytes(arrStr))
-				writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
-			case types.T_date:
-				val := vector.GetFixedAt[types.Date](vec, i)
-				writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
-			case types.T_datetime:
-				scale := vec.GetType().Scale
-				val := vector.GetFixedAt[types.Datetime](vec, i).String2(scale)
-				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
-			case types.T_time:
-				scale := vec.GetType().Scale
-				val := vector.GetFixedAt[types.Time](vec, i).String2(scale)
-				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
-			case types.T_timestamp:
-				scale := vec.GetType().Scale
-				timeZone := ses.GetTimeZone()
-				val := vector.GetFixedAt[types.Timestamp](vec, i).String2(timeZone, scale)
-				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
-			case types.T_decimal64:
-				scale := vec.GetType().Scale
-				val := vector.GetFixedAt[types.Decimal64](vec, i).Format(scale)
-				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
-			case types.T_decimal128:
-				scale := vec.GetType().Scale
-				val := vector.GetFixedAt[types.Decimal128](vec, i).Format(scale)
-				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
-			case types.T_uuid:
-				val := vector.GetFixedAt[types.Uuid](vec, i).String()
-				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
-			case types.T_Rowid:
-				val := vector.GetFixedAt[types.Rowid](vec, i)
-				writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
-			case types.T_Blockid:
-				val := vector.GetFixedAt[types.Blockid](vec, i)
-				writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
-			case types.T_enum:
-				val := vector.GetFixedAt[types.Enum](vec, i).String()
-				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
-			default:
-				ses.Error(ctx,
-					"Failed to construct byte due to unsupported type",
-					zap.Int("typeOid", int(vec.GetType().Oid)))
-				ByteChan <- &BatchByte{
-					err: moerr.NewInternalError(ctx, "constructByte : unsupported type %d", vec.GetType().Oid),
-				}
-				bat.Clean(ses.GetMemPool())
-				return
-			}
+	writeByte, err := formatCSVRows(ctx, ses, bat, symbol, closeby, terminated, flag)
+	if err != nil {
+		ses.Error(ctx,
+			"Failed to construct byte due to unsupported type",
+			zap.Error(err))
+		ByteChan <- &BatchByte{
+			err: err,
 		}
+		bat.Clean(ses.GetMemPool())
+		return
 	}
 
 	ByteChan <- &BatchByte{
@@ -540,6 +432,136 @@ func constructByte(ctx context.Context, obj FeSession, bat *batch.Batch, index i
 	bat.Clean(ses.GetMemPool())
 }
 
+// formatCSVRows formats the rows of the batch as the csv lines, the symbol
+// is the separator after each column.
+func formatCSVRows(ctx context.Context, ses *Session, bat *batch.Batch, symbol [][]byte, closeby byte, terminated string, flag []bool) ([]byte, error) {
+	var err error
+	writeByte := make([]byte, 0)
+	for i := 0; i < bat.RowCount(); i++ {
+		if writeByte, err = appendCSVRow(ctx, ses, writeByte, bat, i, symbol, closeby, terminated, flag); err != nil {
+			return nil, err
+		}
+	}
+	return writeByte, nil
+}
+
+// appendCSVRow appends the csv line of the row i of the batch.
+func appendCSVRow(ctx context.Context, ses *Session, writeByte []byte, bat *batch.Batch, i int, symbol [][]byte, closeby byte, terminated string, flag []bool) ([]byte, error) {
+	for j, vec := range bat.Vecs {
+		if vec.GetNulls().Contains(uint64(i)) {
+			writeByte = appendBytes(writeByte, []byte("\\N"), symbol[j], closeby, flag[j])
+			continue
+		}
+		switch vec.GetType().Oid { //get col
+		case types.T_json:
+			val := types.DecodeJson(vec.GetBytesAt(i))
+			writeByte = appendBytes(writeByte, []byte(formatJsonString(val.String(), flag[j], terminated)), symbol[j], closeby, flag[j])
+		case types.T_bool:
+			val := vector.GetFixedAt[bool](vec, i)
+			if val {
+				writeByte = appendBytes(writeByte, []byte("true"), symbol[j], closeby, flag[j])
+			} else {
+				writeByte = appendBytes(writeByte, []byte("false"), symbol[j], closeby, flag[j])
+			}
+		case types.T_bit:
+			val := vector.GetFixedAt[uint64](vec, i)
+			bitLength := vec.GetType().Width
+			byteLength := (bitLength + 7) / 8
+			b := types.EncodeUint64(&val)[:byteLength]
+			slices.Reverse(b)
+			writeByte = appendBytes(writeByte, b, symbol[j], closeby, flag[j])
+		case types.T_int8:
+			val := vector.GetFixedAt[int8](vec, i)
+			writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
+		case types.T_int16:
+			val := vector.GetFixedAt[int16](vec, i)
+			writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
+		case types.T_int32:
+			val := vector.GetFixedAt[int32](vec, i)
+			writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
+		case types.T_int64:
+			val := vector.GetFixedAt[int64](vec, i)
+			writeByte = appendBytes(writeByte, []byte(strconv.FormatInt(int64(val), 10)), symbol[j], closeby, flag[j])
+		case types.T_uint8:
+			val := vector.GetFixedAt[uint8](vec, i)
+			writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
+		case types.T_uint16:
+			val := vector.GetFixedAt[uint16](vec, i)
+			writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
+		case types.T_uint32:
+			val := vector.GetFixedAt[uint32](vec, i)
+			writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
+		case types.T_uint64:
+			val := vector.GetFixedAt[uint64](vec, i)
+			writeByte = appendBytes(writeByte, []byte(strconv.FormatUint(uint64(val), 10)), symbol[j], closeby, flag[j])
+		case types.T_float32:
+			val := vector.GetFixedAt[float32](vec, i)
+			if vec.GetType().Scale < 0 || vec.GetType().Width == 0 {
+				writeByte = appendBytes(writeByte, []byte(strconv.FormatFloat(float64(val), 'f', -1, 32)), symbol[j], closeby, flag[j])
+			} else {
+				writeByte = appendBytes(writeByte, []byte(strconv.FormatFloat(float64(val), 'f', int(vec.GetType().Scale), 64)), symbol[j], closeby, flag[j])
+			}
+		case types.T_float64:
+			val := vector.GetFixedAt[float64](vec, i)
+			if vec.GetType().Scale < 0 || vec.GetType().Width == 0 {
+				writeByte = appendBytes(writeByte, []byte(strconv.FormatFloat(float64(val), 'f', -1, 32)), symbol[j], closeby, flag[j])
+			} else {
+				writeByte = appendBytes(writeByte, []byte(strconv.FormatFloat(float64(val), 'f', int(vec.GetType().Scale), 64)), symbol[j], closeby, flag[j])
+			}
+		case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary, types.T_datalink:
+			value := addEscapeToString(vec.GetBytesAt(i))
+			writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
+		case types.T_array_float32:
+			arrStr := types.BytesToArrayToString[float32](vec.GetBytesAt(i))
+			value := addEscapeToString(util2.UnsafeStringToBytes(arrStr))
+			writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
+		case types.T_array_float64:
+			arrStr := types.BytesToArrayToString[float64](vec.GetBytesAt(i))
+			value := addEscapeToString(util2.UnsafeStringToBytes(arrStr))
+			writeByte = appendBytes(writeByte, value, symbol[j], closeby, true)
+		case types.T_date:
+			val := vector.GetFixedAt[types.Date](vec, i)
+			writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
+		case types.T_datetime:
+			scale := vec.GetType().Scale
+			val := vector.GetFixedAt[types.Datetime](vec, i).String2(scale)
+			writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
+		case types.T_time:
+			scale := vec.GetType().Scale
+			val := vector.GetFixedAt[types.Time](vec, i).String2(scale)
+			writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
+		case types.T_timestamp:
+			scale := vec.GetType().Scale
+			timeZone := ses.GetTimeZone()
+			val := vector.GetFixedAt[types.Timestamp](vec, i).String2(timeZone, scale)
+			writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
+		case types.T_decimal64:
+			scale := vec.GetType().Scale
+			val := vector.GetFixedAt[types.Decimal64](vec, i).Format(scale)
+			writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
+		case types.T_decimal128:
+			scale := vec.GetType().Scale
+			val := vector.GetFixedAt[types.Decimal128](vec, i).Format(scale)
+			writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
+		case types.T_uuid:
+			val := vector.GetFixedAt[types.Uuid](vec, i).String()
+			writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
+		case types.T_Rowid:
+			val := vector.GetFixedAt[types.Rowid](vec, i)
+			writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
+		case types.T_Blockid:
+			val := vector.GetFixedAt[types.Blockid](vec, i)
+			writeByte = appendBytes(writeByte, []byte(val.String()), symbol[j], closeby, flag[j])
+		case types.T_enum:
+			val := vector.GetFixedAt[types.Enum](vec, i).String()
+			writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
+		default:
+			return nil, moerr.NewInternalError(ctx, "constructByte : unsupported type %d", vec.GetType().Oid)
+		}
+	}
+	return writeByte, nil
+}
+
 func addEscapeToString(s []byte) []byte {
 	pos := make([]int, 0)
 	for i := 0; i < len(s); i++ {
@@ -822,6 +844,9 @@ func (ec *ExportConfig) resetLineStr() {
 }
 
 func (ec *ExportConfig) Write(execCtx *ExecCtx, bat *batch.Batch) error {
+	if ec.writer != nil {
+		return ec.writer.write(execCtx.reqCtx, bat)
+	}
 	ec.Index.Add(1)
 	copied, err := bat.Dup(execCtx.ses.GetMemPool())
 	if err != nil {
@@ -839,6 +864,11 @@ func (ec *ExportConfig) Write(execCtx *ExecCtx, bat *batch.Batch) error {
 
 func (ec *ExportConfig) Close() {
 	if ec != nil {
+		if ec.writer != nil {
+			// the files are left unfinished if the export failed
+			_ = ec.writer.close()
+			ec.writer = nil
+		}
 		ec.mrs = nil
 		ec.lineStr = nil
 		ec.ctx = nil
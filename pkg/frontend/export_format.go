@@ -0,0 +1,604 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/parquet-go/parquet-go/compress"
+	"github.com/parquet-go/parquet-go/deprecated"
+	"github.com/parquet-go/parquet-go/format"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// The options of FORMAT 'parquet' {'compression'='zstd', 'row_group_size'='100000'}.
+const (
+	exportOptionCompression  = "compression"
+	exportOptionRowGroupSize = "row_group_size"
+
+	defaultParquetRowGroupSize = 128 * 1024
+)
+
+var parquetCodecs = map[string]compress.Codec{
+	"none":         &parquet.Uncompressed,
+	"uncompressed": &parquet.Uncompressed,
+	"snappy":       &parquet.Snappy,
+	"gzip":         &parquet.Gzip,
+	"zstd":         &parquet.Zstd,
+	"lz4":          &parquet.Lz4Raw,
+	"brotli":       &parquet.Brotli,
+}
+
+// newCSVWriterFunc returns the function to create the csv writers, the
+// FIELDS, LINES, HEADER and FORCE_QUOTE clauses work as the plain csv.
+func newCSVWriterFunc(ctx context.Context, ses *Session, ep *ExportConfig, cols []*plan.ColDef) (func(seq *exportFileSeq) (exportFileWriter, error), error) {
+	userConfig := ep.userConfig
+	n := len(cols)
+	symbol := make([][]byte, n)
+	flag := make([]bool, n)
+	var header []byte
+	for i, col := range cols {
+		if i < n-1 {
+			symbol[i] = []byte(userConfig.Fields.Terminated.Value)
+		} else {
+			symbol[i] = []byte(userConfig.Lines.TerminatedBy.Value)
+		}
+		for _, name := range userConfig.ForceQuote {
+			if name == col.Name {
+				flag[i] = true
+			}
+		}
+		if userConfig.Header {
+			header = append(header, col.Name...)
+			header = append(header, symbol[i]...)
+		}
+	}
+	if userConfig.MaxFileSize != 0 && uint64(len(header)) >= userConfig.MaxFileSize {
+		return nil, moerr.NewInternalError(ctx, "the header line size is over the maxFileSize")
+	}
+	return func(seq *exportFileSeq) (exportFileWriter, error) {
+		w := &csvWriter{
+			exportFile: exportFile{ses: ses, ep: ep, seq: seq, compression: userConfig.Compression},
+			symbol:     symbol,
+			flag:       flag,
+			header:     header,
+		}
+		if err := w.open(); err != nil {
+			return nil, err
+		}
+		return w, nil
+	}, nil
+}
+
+// csvWriter writes the csv files which are compressed or partitioned, every
+// file has the header line if it is required.
+type csvWriter struct {
+	exportFile
+	symbol [][]byte
+	flag   []bool
+	header []byte
+	line   []byte
+}
+
+func (w *csvWriter) open() error {
+	if err := w.exportFile.open(); err != nil {
+		return err
+	}
+	if len(w.header) > 0 {
+		if _, err := w.Write(w.header); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *csvWriter) writeBatch(ctx context.Context, bat *batch.Batch) (err error) {
+	closeby := w.ep.userConfig.Fields.EnclosedBy.Value
+	terminated := w.ep.userConfig.Fields.Terminated.Value
+	for i := 0; i < bat.RowCount(); i++ {
+		if w.line, err = appendCSVRow(ctx, w.ses, w.line[:0], bat, i, w.symbol, closeby, terminated, w.flag); err != nil {
+			return err
+		}
+		if w.overflow(uint64(len(w.line))) {
+			if err = w.closeFile(); err != nil {
+				return err
+			}
+			if err = w.open(); err != nil {
+				return err
+			}
+		}
+		if _, err = w.Write(w.line); err != nil {
+			return err
+		}
+		w.rows++
+	}
+	return nil
+}
+
+func (w *csvWriter) close() error {
+	return w.closeFile()
+}
+
+// newJsonLineWriterFunc returns the function to create the jsonline writers.
+func newJsonLineWriterFunc(ctx context.Context, ses *Session, ep *ExportConfig, cols []*plan.ColDef) (func(seq *exportFileSeq) (exportFileWriter, error), error) {
+	if len(ep.userConfig.FormatOption) > 0 {
+		return nil, moerr.NewBadConfig(ctx, "the jsonline format has no option")
+	}
+	keys := make([][]byte, 0, len(cols))
+	for _, col := range cols {
+		key, err := json.Marshal(col.Name)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return func(seq *exportFileSeq) (exportFileWriter, error) {
+		w := &jsonLineWriter{
+			exportFile: exportFile{ses: ses, ep: ep, seq: seq, compression: ep.userConfig.Compression},
+			keys:       keys,
+		}
+		if err := w.open(); err != nil {
+			return nil, err
+		}
+		return w, nil
+	}, nil
+}
+
+// jsonLineWriter writes every row as a json object in a line, the keys are
+// the column names.
+type jsonLineWriter struct {
+	exportFile
+	keys [][]byte
+	line []byte
+}
+
+func (w *jsonLineWriter) writeBatch(ctx context.Context, bat *batch.Batch) (err error) {
+	for i := 0; i < bat.RowCount(); i++ {
+		w.line = append(w.line[:0], '{')
+		for j, vec := range bat.Vecs {
+			if j > 0 {
+				w.line = append(w.line, ',')
+			}
+			w.line = append(w.line, w.keys[j]...)
+			w.line = append(w.line, ':')
+			if w.line, err = appendJsonValue(ctx, w.ses, w.line, vec, i); err != nil {
+				return err
+			}
+		}
+		w.line = append(w.line, '}', '\n')
+		if w.overflow(uint64(len(w.line))) {
+			if err = w.rotate(); err != nil {
+				return err
+			}
+		}
+		if _, err = w.Write(w.line); err != nil {
+			return err
+		}
+		w.rows++
+	}
+	return nil
+}
+
+func (w *jsonLineWriter) close() error {
+	return w.closeFile()
+}
+
+// appendJsonValue appends the json value of the row i of the vector. The
+// numbers are json numbers, the json values are kept as they are, and the
+// others are json strings.
+func appendJsonValue(ctx context.Context, ses *Session, buf []byte, vec *vector.Vector, i int) ([]byte, error) {
+	if vec.GetNulls().Contains(uint64(i)) {
+		return append(buf, "null"...), nil
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_json:
+		return append(buf, types.DecodeJson(vec.GetBytesAt(i)).String()...), nil
+	case types.T_bool:
+		return strconv.AppendBool(buf, vector.GetFixedAt[bool](vec, i)), nil
+	case types.T_bit:
+		return strconv.AppendUint(buf, vector.GetFixedAt[uint64](vec, i), 10), nil
+	case types.T_int8:
+		return strconv.AppendInt(buf, int64(vector.GetFixedAt[int8](vec, i)), 10), nil
+	case types.T_int16:
+		return strconv.AppendInt(buf, int64(vector.GetFixedAt[int16](vec, i)), 10), nil
+	case types.T_int32:
+		return strconv.AppendInt(buf, int64(vector.GetFixedAt[int32](vec, i)), 10), nil
+	case types.T_int64:
+		return strconv.AppendInt(buf, vector.GetFixedAt[int64](vec, i), 10), nil
+	case types.T_uint8:
+		return strconv.AppendUint(buf, uint64(vector.GetFixedAt[uint8](vec, i)), 10), nil
+	case types.T_uint16:
+		return strconv.AppendUint(buf, uint64(vector.GetFixedAt[uint16](vec, i)), 10), nil
+	case types.T_uint32:
+		return strconv.AppendUint(buf, uint64(vector.GetFixedAt[uint32](vec, i)), 10), nil
+	case types.T_uint64:
+		return strconv.AppendUint(buf, vector.GetFixedAt[uint64](vec, i), 10), nil
+	case types.T_float32:
+		return strconv.AppendFloat(buf, float64(vector.GetFixedAt[float32](vec, i)), 'g', -1, 32), nil
+	case types.T_float64:
+		return strconv.AppendFloat(buf, vector.GetFixedAt[float64](vec, i), 'g', -1, 64), nil
+	case types.T_decimal64:
+		return append(buf, vector.GetFixedAt[types.Decimal64](vec, i).Format(typ.Scale)...), nil
+	case types.T_decimal128:
+		return append(buf, vector.GetFixedAt[types.Decimal128](vec, i).Format(typ.Scale)...), nil
+	case types.T_array_float32:
+		return append(buf, types.BytesToArrayToString[float32](vec.GetBytesAt(i))...), nil
+	case types.T_array_float64:
+		return append(buf, types.BytesToArrayToString[float64](vec.GetBytesAt(i))...), nil
+	}
+
+	str, ok, err := exportStringOf(ctx, ses, vec, i)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, moerr.NewNotSupported(ctx, "export type %s in jsonline format", typ.String())
+	}
+	data, err := json.Marshal(str)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, data...), nil
+}
+
+// exportStringOf returns the string of the row i if the value is exported
+// as a string, such as the varchar and the date.
+func exportStringOf(ctx context.Context, ses *Session, vec *vector.Vector, i int) (string, bool, error) {
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary, types.T_datalink:
+		return string(vec.GetBytesAt(i)), true, nil
+	case types.T_date:
+		return vector.GetFixedAt[types.Date](vec, i).String(), true, nil
+	case types.T_datetime:
+		return vector.GetFixedAt[types.Datetime](vec, i).String2(typ.Scale), true, nil
+	case types.T_time:
+		return vector.GetFixedAt[types.Time](vec, i).String2(typ.Scale), true, nil
+	case types.T_timestamp:
+		return vector.GetFixedAt[types.Timestamp](vec, i).String2(ses.GetTimeZone(), typ.Scale), true, nil
+	case types.T_uuid:
+		return vector.GetFixedAt[types.Uuid](vec, i).String(), true, nil
+	case types.T_Rowid:
+		val := vector.GetFixedAt[types.Rowid](vec, i)
+		return val.String(), true, nil
+	case types.T_Blockid:
+		val := vector.GetFixedAt[types.Blockid](vec, i)
+		return val.String(), true, nil
+	case types.T_enum:
+		return vector.GetFixedAt[types.Enum](vec, i).String(), true, nil
+	}
+	return "", false, nil
+}
+
+// parquetWriter writes the rows into the parquet files. The files are split
+// at the row groups, so a file may be larger than the MaxFileSize by a row
+// group.
+type parquetWriter struct {
+	exportFile
+	options []parquet.WriterOption
+	pw      *parquet.Writer
+	buf     []parquet.Row
+}
+
+// newParquetWriterFunc returns the function to create the parquet writers,
+// the COMPRESSION clause is the codec of the pages, and it is overridden by
+// the compression option.
+func newParquetWriterFunc(ctx context.Context, ses *Session, ep *ExportConfig, cols []*plan.ColDef) (func(seq *exportFileSeq) (exportFileWriter, error), error) {
+	codec := compress.Codec(&parquet.Snappy)
+	if ep.userConfig.Compression != "" {
+		codec = parquetCodecs[ep.userConfig.Compression]
+	}
+	rowGroupSize := int64(defaultParquetRowGroupSize)
+	opts := ep.userConfig.FormatOption
+	for i := 0; i+1 < len(opts); i += 2 {
+		switch strings.ToLower(opts[i]) {
+		case exportOptionCompression:
+			c, ok := parquetCodecs[strings.ToLower(opts[i+1])]
+			if !ok {
+				return nil, moerr.NewBadConfig(ctx, "the compression '%s' of the parquet format", opts[i+1])
+			}
+			codec = c
+		case exportOptionRowGroupSize:
+			n, err := strconv.ParseInt(opts[i+1], 10, 64)
+			if err != nil || n <= 0 {
+				return nil, moerr.NewBadConfig(ctx, "the row group size '%s' of the parquet format", opts[i+1])
+			}
+			rowGroupSize = n
+		default:
+			return nil, moerr.NewBadConfig(ctx, "the option '%s' of the parquet format", opts[i])
+		}
+	}
+
+	group := parquetGroup{Group: parquet.Group{}}
+	for _, col := range cols {
+		if _, ok := group.Group[col.Name]; ok {
+			return nil, moerr.NewBadConfig(ctx, "the duplicate column name '%s' in parquet format", col.Name)
+		}
+		node, err := parquetNodeOf(ctx, types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale))
+		if err != nil {
+			return nil, err
+		}
+		node = parquet.Optional(node)
+		group.Group[col.Name] = node
+		group.fields = append(group.fields, parquetField{Node: node, name: col.Name})
+	}
+
+	options := []parquet.WriterOption{
+		parquet.NewSchema("matrixone", group),
+		parquet.Compression(codec),
+		parquet.MaxRowsPerRowGroup(rowGroupSize),
+		parquet.CreatedBy("matrixone", "", ""),
+		// the file is buffered already, and the size of the file is
+		// up to date after every row group
+		parquet.WriteBufferSize(0),
+	}
+	return func(seq *exportFileSeq) (exportFileWriter, error) {
+		w := &parquetWriter{
+			exportFile: exportFile{ses: ses, ep: ep, seq: seq},
+			options:    options,
+		}
+		if err := w.open(); err != nil {
+			return nil, err
+		}
+		return w, nil
+	}, nil
+}
+
+func (w *parquetWriter) open() error {
+	if err := w.exportFile.open(); err != nil {
+		return err
+	}
+	w.pw = parquet.NewWriter(&w.exportFile, w.options...)
+	return nil
+}
+
+func (w *parquetWriter) writeBatch(ctx context.Context, bat *batch.Batch) error {
+	n := bat.RowCount()
+	if cap(w.buf) < n {
+		w.buf = make([]parquet.Row, n)
+	}
+	w.buf = w.buf[:n]
+	for i := range w.buf {
+		w.buf[i] = w.buf[i][:0]
+	}
+	for j, vec := range bat.Vecs {
+		for i := 0; i < n; i++ {
+			v, err := parquetValueOf(ctx, vec, i)
+			if err != nil {
+				return err
+			}
+			if v.IsNull() {
+				v = v.Level(0, 0, j)
+			} else {
+				v = v.Level(0, 1, j)
+			}
+			w.buf[i] = append(w.buf[i], v)
+		}
+	}
+	// the file is full by the rows of the last batches
+	if maxSize := w.ep.userConfig.MaxFileSize; maxSize != 0 && w.rows != 0 && w.size >= maxSize {
+		if err := w.close(); err != nil {
+			return err
+		}
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+	if _, err := w.pw.WriteRows(w.buf); err != nil {
+		return err
+	}
+	w.rows += uint64(n)
+	return nil
+}
+
+func (w *parquetWriter) close() error {
+	if w.pw == nil {
+		return nil
+	}
+	pw := w.pw
+	w.pw = nil
+	if err := pw.Close(); err != nil {
+		w.file.Close()
+		w.file = nil
+		return err
+	}
+	return w.closeFile()
+}
+
+// parquetGroup keeps the columns in the order of the result, the fields of
+// parquet.Group are sorted by the names.
+type parquetGroup struct {
+	parquet.Group
+	fields []parquet.Field
+}
+
+func (g parquetGroup) Fields() []parquet.Field {
+	return g.fields
+}
+
+type parquetField struct {
+	parquet.Node
+	name string
+}
+
+func (f parquetField) Name() string { return f.name }
+
+func (f parquetField) Value(base reflect.Value) reflect.Value {
+	return base.MapIndex(reflect.ValueOf(f.name))
+}
+
+// localTimeType is the TIME or TIMESTAMP which is not adjusted to UTC, such
+// as the DATETIME.
+type localTimeType struct {
+	parquet.Type
+}
+
+func (t localTimeType) LogicalType() *format.LogicalType {
+	lt := *t.Type.LogicalType()
+	if lt.Timestamp != nil {
+		ts := *lt.Timestamp
+		ts.IsAdjustedToUTC = false
+		lt.Timestamp = &ts
+	}
+	if lt.Time != nil {
+		tm := *lt.Time
+		tm.IsAdjustedToUTC = false
+		lt.Time = &tm
+	}
+	return &lt
+}
+
+// ConvertedType returns nil, the converted types of the time are adjusted
+// to UTC.
+func (t localTimeType) ConvertedType() *deprecated.ConvertedType {
+	return nil
+}
+
+func parquetNodeOf(ctx context.Context, typ types.Type) (parquet.Node, error) {
+	switch typ.Oid {
+	case types.T_bool:
+		return parquet.Leaf(parquet.BooleanType), nil
+	case types.T_int8:
+		return parquet.Int(8), nil
+	case types.T_int16:
+		return parquet.Int(16), nil
+	case types.T_int32:
+		return parquet.Int(32), nil
+	case types.T_int64:
+		return parquet.Int(64), nil
+	case types.T_uint8:
+		return parquet.Uint(8), nil
+	case types.T_uint16:
+		return parquet.Uint(16), nil
+	case types.T_uint32:
+		return parquet.Uint(32), nil
+	case types.T_uint64, types.T_bit:
+		return parquet.Uint(64), nil
+	case types.T_float32:
+		return parquet.Leaf(parquet.FloatType), nil
+	case types.T_float64:
+		return parquet.Leaf(parquet.DoubleType), nil
+	case types.T_decimal64:
+		return parquet.Decimal(int(typ.Scale), decimalPrecision(typ.Width, 18), parquet.Int64Type), nil
+	case types.T_decimal128:
+		return parquet.Decimal(int(typ.Scale), decimalPrecision(typ.Width, 38), parquet.FixedLenByteArrayType(16)), nil
+	case types.T_date:
+		return parquet.Date(), nil
+	case types.T_time:
+		return parquet.Leaf(localTimeType{parquet.Time(parquet.Microsecond).Type()}), nil
+	case types.T_datetime:
+		return parquet.Leaf(localTimeType{parquet.Timestamp(parquet.Microsecond).Type()}), nil
+	case types.T_timestamp:
+		return parquet.Timestamp(parquet.Microsecond), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink, types.T_enum,
+		types.T_array_float32, types.T_array_float64:
+		return parquet.String(), nil
+	case types.T_json:
+		return parquet.JSON(), nil
+	case types.T_uuid:
+		return parquet.UUID(), nil
+	case types.T_binary, types.T_varbinary, types.T_blob, types.T_Rowid, types.T_Blockid:
+		return parquet.Leaf(parquet.ByteArrayType), nil
+	}
+	return nil, moerr.NewNotSupported(ctx, "export type %s in parquet format", typ.String())
+}
+
+func decimalPrecision(width int32, max int) int {
+	if width <= 0 || int(width) > max {
+		return max
+	}
+	return int(width)
+}
+
+// parquetValueOf returns the value of the row i in the physical type of the
+// node returned by parquetNodeOf.
+func parquetValueOf(ctx context.Context, vec *vector.Vector, i int) (parquet.Value, error) {
+	if vec.GetNulls().Contains(uint64(i)) {
+		return parquet.NullValue(), nil
+	}
+	typ := vec.GetType()
+	switch typ.Oid {
+	case types.T_bool:
+		return parquet.BooleanValue(vector.GetFixedAt[bool](vec, i)), nil
+	case types.T_int8:
+		return parquet.Int32Value(int32(vector.GetFixedAt[int8](vec, i))), nil
+	case types.T_int16:
+		return parquet.Int32Value(int32(vector.GetFixedAt[int16](vec, i))), nil
+	case types.T_int32:
+		return parquet.Int32Value(vector.GetFixedAt[int32](vec, i)), nil
+	case types.T_int64:
+		return parquet.Int64Value(vector.GetFixedAt[int64](vec, i)), nil
+	case types.T_uint8:
+		return parquet.Int32Value(int32(vector.GetFixedAt[uint8](vec, i))), nil
+	case types.T_uint16:
+		return parquet.Int32Value(int32(vector.GetFixedAt[uint16](vec, i))), nil
+	case types.T_uint32:
+		return parquet.Int32Value(int32(vector.GetFixedAt[uint32](vec, i))), nil
+	case types.T_uint64, types.T_bit:
+		return parquet.Int64Value(int64(vector.GetFixedAt[uint64](vec, i))), nil
+	case types.T_float32:
+		return parquet.FloatValue(vector.GetFixedAt[float32](vec, i)), nil
+	case types.T_float64:
+		return parquet.DoubleValue(vector.GetFixedAt[float64](vec, i)), nil
+	case types.T_decimal64:
+		return parquet.Int64Value(int64(vector.GetFixedAt[types.Decimal64](vec, i))), nil
+	case types.T_decimal128:
+		// the big-endian two's complement
+		val := vector.GetFixedAt[types.Decimal128](vec, i)
+		b := make([]byte, 16)
+		binary.BigEndian.PutUint64(b[:8], val.B64_127)
+		binary.BigEndian.PutUint64(b[8:], val.B0_63)
+		return parquet.FixedLenByteArrayValue(b), nil
+	case types.T_date:
+		return parquet.Int32Value(vector.GetFixedAt[types.Date](vec, i).DaysSinceUnixEpoch()), nil
+	case types.T_time:
+		return parquet.Int64Value(int64(vector.GetFixedAt[types.Time](vec, i))), nil
+	case types.T_datetime:
+		return parquet.Int64Value(int64(vector.GetFixedAt[types.Datetime](vec, i)) - types.GetUnixEpochSecs()), nil
+	case types.T_timestamp:
+		return parquet.Int64Value(int64(vector.GetFixedAt[types.Timestamp](vec, i)) - types.GetUnixEpochSecs()), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_datalink, types.T_json,
+		types.T_binary, types.T_varbinary, types.T_blob:
+		return parquet.ByteArrayValue(vec.GetBytesAt(i)), nil
+	case types.T_enum:
+		return parquet.ByteArrayValue([]byte(vector.GetFixedAt[types.Enum](vec, i).String())), nil
+	case types.T_array_float32:
+		return parquet.ByteArrayValue([]byte(types.BytesToArrayToString[float32](vec.GetBytesAt(i)))), nil
+	case types.T_array_float64:
+		return parquet.ByteArrayValue([]byte(types.BytesToArrayToString[float64](vec.GetBytesAt(i)))), nil
+	case types.T_uuid:
+		val := vector.GetFixedAt[types.Uuid](vec, i)
+		return parquet.FixedLenByteArrayValue(val[:]), nil
+	case types.T_Rowid:
+		val := vector.GetFixedAt[types.Rowid](vec, i)
+		return parquet.ByteArrayValue(val[:]), nil
+	case types.T_Blockid:
+		val := vector.GetFixedAt[types.Blockid](vec, i)
+		return parquet.ByteArrayValue(val[:]), nil
+	}
+	return parquet.Value{}, moerr.NewNotSupported(ctx, "export type %s in parquet format", typ.String())
+}
@@ -0,0 +1,343 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func newExportFormatTestConfig(filePath, fileFormat string, options ...string) *ExportConfig {
+	return &ExportConfig{
+		userConfig: &tree.ExportParam{
+			Outfile:      true,
+			FilePath:     filePath,
+			FileFormat:   fileFormat,
+			FormatOption: options,
+			Fields: &tree.Fields{
+				Terminated: &tree.Terminated{Value: ","},
+				EnclosedBy: &tree.EnclosedBy{Value: '"'},
+			},
+			Lines: &tree.Lines{
+				TerminatedBy: &tree.Terminated{Value: "\n"},
+			},
+		},
+		DefaultBufSize: 1024,
+	}
+}
+
+// requireExportFileCount checks the files are named as path, path.1 ... and
+// there are n files.
+func requireExportFileCount(t *testing.T, filePath string, n int) {
+	for i := 0; i < n; i++ {
+		_, err := os.Stat(getExportFilePath(filePath, uint(i)))
+		require.NoError(t, err)
+	}
+	_, err := os.Stat(getExportFilePath(filePath, uint(n)))
+	require.True(t, os.IsNotExist(err))
+}
+
+// newExportFormatTestBatch returns a batch of (id int64, price decimal(10,2),
+// d date, ts timestamp, name varchar), the second row is all null except id.
+func newExportFormatTestBatch(t *testing.T, mp *mpool.MPool) ([]*plan.ColDef, *batch.Batch) {
+	cols := []*plan.ColDef{
+		{Name: "id", Typ: plan.Type{Id: int32(types.T_int64)}},
+		{Name: "price", Typ: plan.Type{Id: int32(types.T_decimal64), Width: 10, Scale: 2}},
+		{Name: "d", Typ: plan.Type{Id: int32(types.T_date)}},
+		{Name: "ts", Typ: plan.Type{Id: int32(types.T_timestamp)}},
+		{Name: "name", Typ: plan.Type{Id: int32(types.T_varchar)}},
+	}
+	bat := batch.NewWithSize(len(cols))
+	for i, col := range cols {
+		bat.Vecs[i] = vector.NewVec(types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale))
+	}
+	price, err := types.ParseDecimal64("12.34", 10, 2)
+	require.NoError(t, err)
+	d, err := types.ParseDateCast("2024-01-02")
+	require.NoError(t, err)
+	ts, err := types.ParseTimestamp(time.UTC, "2024-01-02 03:04:05", 0)
+	require.NoError(t, err)
+
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(1), false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[1], price, false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[2], d, false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[3], ts, false, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[4], []byte(`a"b`), false, mp))
+
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(2), false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[1], types.Decimal64(0), true, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[2], types.Date(0), true, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[3], types.Timestamp(0), true, mp))
+	require.NoError(t, vector.AppendBytes(bat.Vecs[4], nil, true, mp))
+	bat.SetRowCount(2)
+	return cols, bat
+}
+
+func newExportFormatTestSession() *Session {
+	ses := &Session{}
+	ses.SetTimeZone(time.UTC)
+	ses.SetMemPool(mpool.MustNewZero())
+	return ses
+}
+
+func Test_exportJsonLine(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	cols, bat := newExportFormatTestBatch(t, mp)
+	defer bat.Clean(mp)
+	ses := newExportFormatTestSession()
+
+	filePath := filepath.Join(t.TempDir(), "export.jsonl")
+	ep := newExportFormatTestConfig(filePath, tree.JSONLINE)
+	w, err := newExportWriter(ctx, ses, ep, cols)
+	require.NoError(t, err)
+	require.NoError(t, w.write(ctx, bat))
+	require.NoError(t, w.close())
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	require.Equal(t,
+		`{"id":1,"price":12.34,"d":"2024-01-02","ts":"2024-01-02 03:04:05","name":"a\"b"}`+"\n"+
+			`{"id":2,"price":null,"d":null,"ts":null,"name":null}`+"\n",
+		string(data))
+	require.Equal(t, int64(len(data)), ses.writeCsvBytes.Load())
+	requireExportFileCount(t, filePath, 1)
+
+	// the options belong to the parquet format
+	ep = newExportFormatTestConfig(filepath.Join(t.TempDir(), "export.jsonl"), tree.JSONLINE, "compression", "zstd")
+	_, err = newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.Error(t, err)
+}
+
+func Test_exportJsonLineMaxFileSize(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	cols, bat := newExportFormatTestBatch(t, mp)
+	defer bat.Clean(mp)
+
+	filePath := filepath.Join(t.TempDir(), "export.jsonl")
+	ep := newExportFormatTestConfig(filePath, tree.JSONLINE)
+	ep.userConfig.MaxFileSize = 10
+	w, err := newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.NoError(t, err)
+	require.NoError(t, w.write(ctx, bat))
+	require.NoError(t, w.close())
+
+	// every file holds one line at least
+	requireExportFileCount(t, filePath, 2)
+	for i, prefix := range []string{`{"id":1,`, `{"id":2,`} {
+		data, err := os.ReadFile(getExportFilePath(filePath, uint(i)))
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(data), prefix))
+		require.Equal(t, 1, strings.Count(string(data), "\n"))
+	}
+}
+
+func Test_exportParquet(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	cols, bat := newExportFormatTestBatch(t, mp)
+	defer bat.Clean(mp)
+	ses := newExportFormatTestSession()
+
+	filePath := filepath.Join(t.TempDir(), "export.parquet")
+	ep := newExportFormatTestConfig(filePath, tree.PARQUET, "Compression", "ZSTD", "row_group_size", "1")
+	w, err := newExportWriter(ctx, ses, ep, cols)
+	require.NoError(t, err)
+	require.NoError(t, w.write(ctx, bat))
+	require.NoError(t, w.close())
+	requireExportFileCount(t, filePath, 1)
+
+	f, err := os.Open(filePath)
+	require.NoError(t, err)
+	defer f.Close()
+	stat, err := f.Stat()
+	require.NoError(t, err)
+	require.Equal(t, stat.Size(), ses.writeCsvBytes.Load())
+	pf, err := parquet.OpenFile(f, stat.Size())
+	require.NoError(t, err)
+
+	// the columns are in the order of the result
+	fields := pf.Schema().Fields()
+	require.Equal(t, len(cols), len(fields))
+	for i, col := range cols {
+		require.Equal(t, col.Name, fields[i].Name())
+		require.True(t, fields[i].Optional())
+	}
+	decimal := fields[1].Type().LogicalType().Decimal
+	require.NotNil(t, decimal)
+	require.Equal(t, int32(10), decimal.Precision)
+	require.Equal(t, int32(2), decimal.Scale)
+	require.NotNil(t, fields[2].Type().LogicalType().Date)
+	timestamp := fields[3].Type().LogicalType().Timestamp
+	require.NotNil(t, timestamp)
+	require.True(t, timestamp.IsAdjustedToUTC)
+	require.NotNil(t, fields[4].Type().LogicalType().UTF8)
+
+	// one row in a row group
+	require.Equal(t, 2, len(pf.RowGroups()))
+	require.Equal(t, "ZSTD", pf.Metadata().RowGroups[0].Columns[0].MetaData.Codec.String())
+
+	rows := make([]parquet.Row, 1)
+	n, _ := pf.RowGroups()[0].Rows().ReadRows(rows)
+	require.Equal(t, 1, n)
+	row := rows[0]
+	require.Equal(t, int64(1), row[0].Int64())
+	require.Equal(t, int64(1234), row[1].Int64())
+	require.Equal(t, int32(19724), row[2].Int32())
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).UnixMicro(), row[3].Int64())
+	require.Equal(t, `a"b`, string(row[4].ByteArray()))
+
+	n, _ = pf.RowGroups()[1].Rows().ReadRows(rows)
+	require.Equal(t, 1, n)
+	row = rows[0]
+	require.Equal(t, int64(2), row[0].Int64())
+	for _, v := range row[1:] {
+		require.True(t, v.IsNull())
+	}
+}
+
+func Test_exportParquetTypes(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+
+	cols := []*plan.ColDef{
+		{Name: "dt", Typ: plan.Type{Id: int32(types.T_datetime)}},
+		{Name: "d128", Typ: plan.Type{Id: int32(types.T_decimal128), Width: 20, Scale: 3}},
+	}
+	bat := batch.NewWithSize(len(cols))
+	defer bat.Clean(mp)
+	for i, col := range cols {
+		bat.Vecs[i] = vector.NewVec(types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale))
+	}
+	dt, err := types.ParseDatetime("2024-01-02 03:04:05", 0)
+	require.NoError(t, err)
+	d128, err := types.ParseDecimal128("-1.5", 20, 3)
+	require.NoError(t, err)
+	require.NoError(t, vector.AppendFixed(bat.Vecs[0], dt, false, mp))
+	require.NoError(t, vector.AppendFixed(bat.Vecs[1], d128, false, mp))
+	bat.SetRowCount(1)
+
+	filePath := filepath.Join(t.TempDir(), "export.parquet")
+	ep := newExportFormatTestConfig(filePath, tree.PARQUET)
+	w, err := newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.NoError(t, err)
+	require.NoError(t, w.write(ctx, bat))
+	require.NoError(t, w.close())
+
+	f, err := os.Open(filePath)
+	require.NoError(t, err)
+	defer f.Close()
+	stat, err := f.Stat()
+	require.NoError(t, err)
+	pf, err := parquet.OpenFile(f, stat.Size())
+	require.NoError(t, err)
+
+	// the datetime is a local timestamp
+	fields := pf.Schema().Fields()
+	timestamp := fields[0].Type().LogicalType().Timestamp
+	require.NotNil(t, timestamp)
+	require.False(t, timestamp.IsAdjustedToUTC)
+	decimal := fields[1].Type().LogicalType().Decimal
+	require.NotNil(t, decimal)
+	require.Equal(t, int32(20), decimal.Precision)
+	require.Equal(t, int32(3), decimal.Scale)
+
+	rows := make([]parquet.Row, 1)
+	n, _ := pf.RowGroups()[0].Rows().ReadRows(rows)
+	require.Equal(t, 1, n)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).UnixMicro(), rows[0][0].Int64())
+	b := rows[0][1].ByteArray()
+	require.Equal(t, 16, len(b))
+	require.Equal(t, uint64(1<<64-1), binary.BigEndian.Uint64(b[:8]))
+	require.Equal(t, uint64(1<<64-1500), binary.BigEndian.Uint64(b[8:]))
+}
+
+func Test_exportParquetMaxFileSize(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	cols, bat := newExportFormatTestBatch(t, mp)
+	defer bat.Clean(mp)
+	ses := newExportFormatTestSession()
+
+	filePath := filepath.Join(t.TempDir(), "export.parquet")
+	ep := newExportFormatTestConfig(filePath, tree.PARQUET, "row_group_size", "1")
+	ep.userConfig.MaxFileSize = 1
+	w, err := newExportWriter(ctx, ses, ep, cols)
+	require.NoError(t, err)
+	require.NoError(t, w.write(ctx, bat))
+	require.NoError(t, w.write(ctx, bat))
+	require.NoError(t, w.close())
+
+	// the file is full after the first batch
+	requireExportFileCount(t, filePath, 2)
+	for i, numRows := range []int64{2, 2} {
+		f, err := os.Open(getExportFilePath(filePath, uint(i)))
+		require.NoError(t, err)
+		stat, err := f.Stat()
+		require.NoError(t, err)
+		pf, err := parquet.OpenFile(f, stat.Size())
+		require.NoError(t, err)
+		require.Equal(t, numRows, pf.NumRows())
+		f.Close()
+	}
+}
+
+func Test_exportFormatOptions(t *testing.T) {
+	ctx := context.TODO()
+	cols := []*plan.ColDef{{Name: "a", Typ: plan.Type{Id: int32(types.T_int64)}}}
+	for _, c := range []struct {
+		format  string
+		options []string
+	}{
+		{tree.PARQUET, []string{"compression", "lzo"}},
+		{tree.PARQUET, []string{"row_group_size", "0"}},
+		{tree.PARQUET, []string{"row_group_size", "a"}},
+		{tree.PARQUET, []string{"page_size", "1"}},
+		{tree.CSV, []string{"compression", "zstd"}},
+		{"orc", nil},
+	} {
+		ep := newExportFormatTestConfig(filepath.Join(t.TempDir(), "export"), c.format, c.options...)
+		_, err := newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+		require.Error(t, err, c)
+	}
+
+	// the csv is written by the csv writer
+	ep := newExportFormatTestConfig(filepath.Join(t.TempDir(), "export"), "")
+	w, err := newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.NoError(t, err)
+	require.Nil(t, w)
+
+	// the column names are the keys of the parquet group
+	cols = append(cols, &plan.ColDef{Name: "a", Typ: plan.Type{Id: int32(types.T_int64)}})
+	ep = newExportFormatTestConfig(filepath.Join(t.TempDir(), "export"), tree.PARQUET)
+	_, err = newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.Error(t, err)
+}
@@ -0,0 +1,518 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// The compressions of the exported files.
+const (
+	exportCompressionGzip = "gzip"
+	exportCompressionZstd = "zstd"
+)
+
+// hiveDefaultPartition is the directory name of the null partition value.
+const hiveDefaultPartition = "__HIVE_DEFAULT_PARTITION__"
+
+var exportCompressionExts = map[string]string{
+	exportCompressionGzip: ".gz",
+	exportCompressionZstd: ".zst",
+}
+
+// MkdirAll creates the directories of the partitions
+var MkdirAll = os.MkdirAll
+
+// exportFileWriter writes the result rows into the files of a directory. The
+// rows are written in the order of the batches, and the files are split by
+// the MaxFileSize like the csv files.
+type exportFileWriter interface {
+	writeBatch(ctx context.Context, bat *batch.Batch) error
+	// close finishes the current file
+	close() error
+}
+
+// exportFormatOf returns the format of the export, it is csv by default.
+func exportFormatOf(ep *tree.ExportParam) string {
+	if ep == nil || ep.FileFormat == "" {
+		return tree.CSV
+	}
+	return ep.FileFormat
+}
+
+// useExportWriter returns true if the result is written by the exportWriter,
+// the plain csv files are written by the ExportConfig in order.
+func useExportWriter(ep *tree.ExportParam) bool {
+	return exportFormatOf(ep) != tree.CSV || ep.Compression != "" || len(ep.PartitionBy) > 0
+}
+
+// exportInParallel returns true if the parallel pipelines of the query can
+// write the result into the files by themselves. The rows are not in the
+// order of the result.
+func exportInParallel(ses FeSession, stmt tree.Statement) bool {
+	sel, ok := stmt.(*tree.Select)
+	if !ok || sel.Ep == nil || !sel.Ep.Outfile || !useExportWriter(sel.Ep) {
+		return false
+	}
+	if sel.OrderBy != nil {
+		return false
+	}
+	if _, ok = sel.Select.(*tree.ParenSelect); ok {
+		return false
+	}
+	// the query result is saved in the order of the batches
+	if val, err := ses.GetSessionSysVar("save_query_result"); err == nil {
+		if v, _ := val.(int8); v > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// exportWriter writes the result into the files of the jsonline and parquet
+// formats, and the compressed or partitioned files. It can be called by the
+// parallel pipelines at the same time, every call takes an idle worker which
+// has its own files, so the pipelines never write the same file.
+type exportWriter struct {
+	ses *Session
+	ep  *ExportConfig
+	// partitionBy are the indexes of the partition columns in the result,
+	// and dataCols are the indexes of the other columns.
+	partitionBy []int
+	partNames   []string
+	dataCols    []int
+	// dir and name are the directory and the name of the outfile
+	dir  string
+	name string
+	ext  string
+	// newFile returns the writer of the files in the sequence
+	newFile func(seq *exportFileSeq) (exportFileWriter, error)
+	// seqs are the file sequences of the partitions
+	seqs sync.Map
+
+	mu      sync.Mutex
+	idle    []*exportWorker
+	workers []*exportWorker
+	closed  bool
+}
+
+// exportWorker holds the files of a pipeline, one file for a partition.
+type exportWorker struct {
+	files map[string]exportFileWriter
+}
+
+// exportFileSeq names the files of a directory, they are named as path,
+// path.1, path.2 and so on, like the csv files split by the MaxFileSize.
+// The workers of the directory share the sequence.
+type exportFileSeq struct {
+	dir  string
+	path string
+	ext  string
+	cnt  atomic.Uint32
+
+	once sync.Once
+	err  error
+}
+
+func (seq *exportFileSeq) next() (string, error) {
+	if seq.dir != "" {
+		seq.once.Do(func() {
+			seq.err = MkdirAll(seq.dir, 0o755)
+		})
+		if seq.err != nil {
+			return "", seq.err
+		}
+	}
+	n := seq.cnt.Add(1) - 1
+	return getExportFilePath(seq.path, uint(n)) + seq.ext, nil
+}
+
+// newExportWriter returns nil if the result is written as the plain csv.
+func newExportWriter(ctx context.Context, obj FeSession, ep *ExportConfig, cols []*plan.ColDef) (*exportWriter, error) {
+	ses := obj.(*Session)
+	userConfig := ep.userConfig
+	fileFormat := exportFormatOf(userConfig)
+	if fileFormat == tree.CSV && len(userConfig.FormatOption) > 0 {
+		return nil, moerr.NewBadConfig(ctx, "the csv format has no option, use the FIELDS and LINES clauses")
+	}
+	if !useExportWriter(userConfig) {
+		return nil, nil
+	}
+
+	w := &exportWriter{
+		ses: ses,
+		ep:  ep,
+	}
+	filePath := userConfig.FilePath
+	if len(userConfig.StageFilePath) != 0 {
+		filePath = userConfig.StageFilePath
+	}
+	w.dir, w.name = filepath.Split(filePath)
+
+	compression := userConfig.Compression
+	if compression != "" {
+		ext, ok := exportCompressionExts[compression]
+		if !ok {
+			return nil, moerr.NewBadConfig(ctx, "the compression '%s' of the export, it should be gzip or zstd", compression)
+		}
+		// the parquet files are compressed by the pages
+		if fileFormat != tree.PARQUET && !strings.HasSuffix(w.name, ext) {
+			w.ext = ext
+		}
+	}
+
+	if err := w.initPartition(ctx, cols); err != nil {
+		return nil, err
+	}
+	dataCols := make([]*plan.ColDef, len(w.dataCols))
+	for i, idx := range w.dataCols {
+		dataCols[i] = cols[idx]
+	}
+
+	var err error
+	switch fileFormat {
+	case tree.CSV:
+		w.newFile, err = newCSVWriterFunc(ctx, ses, ep, dataCols)
+	case tree.JSONLINE:
+		w.newFile, err = newJsonLineWriterFunc(ctx, ses, ep, dataCols)
+	case tree.PARQUET:
+		w.newFile, err = newParquetWriterFunc(ctx, ses, ep, dataCols)
+	default:
+		err = moerr.NewNotSupported(ctx, "export format '%s'", fileFormat)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// the outfile is created even if the result is empty
+	if len(w.partitionBy) == 0 {
+		worker := w.acquire()
+		defer w.release(worker)
+		if _, err = worker.fileOf(w, ""); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+func (w *exportWriter) initPartition(ctx context.Context, cols []*plan.ColDef) error {
+	isPartition := make([]bool, len(cols))
+	for _, name := range w.ep.userConfig.PartitionBy {
+		idx := -1
+		for i, col := range cols {
+			if strings.EqualFold(col.Name, name) {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return moerr.NewBadConfig(ctx, "the partition column '%s' is not in the result", name)
+		}
+		if isPartition[idx] {
+			return moerr.NewBadConfig(ctx, "the duplicate partition column '%s'", name)
+		}
+		isPartition[idx] = true
+		w.partitionBy = append(w.partitionBy, idx)
+		w.partNames = append(w.partNames, escapePartitionPath(cols[idx].Name))
+	}
+	for i := range cols {
+		if !isPartition[i] {
+			w.dataCols = append(w.dataCols, i)
+		}
+	}
+	if len(w.dataCols) == 0 {
+		return moerr.NewBadConfig(ctx, "all the columns of the result are the partition columns")
+	}
+	return nil
+}
+
+func (w *exportWriter) acquire() *exportWorker {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if n := len(w.idle); n > 0 {
+		worker := w.idle[n-1]
+		w.idle = w.idle[:n-1]
+		return worker
+	}
+	worker := &exportWorker{files: make(map[string]exportFileWriter)}
+	w.workers = append(w.workers, worker)
+	return worker
+}
+
+func (w *exportWriter) release(worker *exportWorker) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.idle = append(w.idle, worker)
+}
+
+// fileOf returns the writer of the partition, the partition is the relative
+// directory such as "a=1/b=2/".
+func (worker *exportWorker) fileOf(w *exportWriter, partition string) (exportFileWriter, error) {
+	if f, ok := worker.files[partition]; ok {
+		return f, nil
+	}
+	v, ok := w.seqs.Load(partition)
+	if !ok {
+		seq := &exportFileSeq{
+			path: filepath.Join(w.dir, partition, w.name),
+			ext:  w.ext,
+		}
+		if partition != "" {
+			seq.dir = filepath.Join(w.dir, partition)
+		}
+		v, _ = w.seqs.LoadOrStore(partition, seq)
+	}
+	f, err := w.newFile(v.(*exportFileSeq))
+	if err != nil {
+		return nil, err
+	}
+	worker.files[partition] = f
+	return f, nil
+}
+
+func (w *exportWriter) write(ctx context.Context, bat *batch.Batch) error {
+	worker := w.acquire()
+	defer w.release(worker)
+
+	if len(w.partitionBy) == 0 {
+		f, err := worker.fileOf(w, "")
+		if err != nil {
+			return err
+		}
+		return f.writeBatch(ctx, bat)
+	}
+
+	// split the rows by the partitions in the order of their first rows
+	var partitions []string
+	sels := make(map[string][]int64)
+	var buf []byte
+	for i := 0; i < bat.RowCount(); i++ {
+		buf = buf[:0]
+		for j, idx := range w.partitionBy {
+			value, err := partitionValueOf(ctx, w.ses, bat.Vecs[idx], i)
+			if err != nil {
+				return err
+			}
+			buf = append(buf, w.partNames[j]...)
+			buf = append(buf, '=')
+			buf = append(buf, escapePartitionPath(value)...)
+			buf = append(buf, '/')
+		}
+		partition := string(buf)
+		if _, ok := sels[partition]; !ok {
+			partitions = append(partitions, partition)
+		}
+		sels[partition] = append(sels[partition], int64(i))
+	}
+
+	mp := w.ses.GetMemPool()
+	for _, partition := range partitions {
+		f, err := worker.fileOf(w, partition)
+		if err != nil {
+			return err
+		}
+		part := batch.NewWithSize(len(w.dataCols))
+		for j, idx := range w.dataCols {
+			if len(partitions) == 1 {
+				part.Vecs[j] = bat.Vecs[idx]
+				continue
+			}
+			part.Vecs[j] = vector.NewVec(*bat.Vecs[idx].GetType())
+			if err = part.Vecs[j].Union(bat.Vecs[idx], sels[partition], mp); err != nil {
+				part.Clean(mp)
+				return err
+			}
+		}
+		part.SetRowCount(len(sels[partition]))
+		err = f.writeBatch(ctx, part)
+		if len(partitions) > 1 {
+			part.Clean(mp)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close finishes all the files, it can be called more than once.
+func (w *exportWriter) close() (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	for _, worker := range w.workers {
+		for _, f := range worker.files {
+			if err2 := f.close(); err2 != nil && err == nil {
+				err = err2
+			}
+		}
+	}
+	return err
+}
+
+// partitionValueOf returns the value of the row i as the string.
+func partitionValueOf(ctx context.Context, ses *Session, vec *vector.Vector, i int) (string, error) {
+	if vec.GetNulls().Contains(uint64(i)) {
+		return hiveDefaultPartition, nil
+	}
+	if str, ok, err := exportStringOf(ctx, ses, vec, i); err != nil || ok {
+		return str, err
+	}
+	value, err := appendJsonValue(ctx, ses, nil, vec, i)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// escapePartitionPath escapes the characters of the partition directory
+// like the hive.
+func escapePartitionPath(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c == 0x7f || strings.IndexByte("\"#%'*/:=?\\{[]^", c) >= 0 {
+			fmt.Fprintf(&sb, "%%%02X", c)
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// exportFile is the local file of the export, it is in the stage if the
+// path of the outfile is a stage.
+type exportFile struct {
+	ses *Session
+	ep  *ExportConfig
+	seq *exportFileSeq
+	// compression is gzip, zstd or empty
+	compression string
+
+	file *os.File
+	w    *bufio.Writer
+	zw   io.WriteCloser
+	// size is the bytes written into the file
+	size uint64
+	// rows is the number of rows written into the file
+	rows uint64
+}
+
+func (f *exportFile) open() error {
+	filePath, err := f.seq.next()
+	if err != nil {
+		return err
+	}
+	file, err := OpenFile(filePath, os.O_RDWR|os.O_EXCL|os.O_CREATE, 0o666)
+	if err != nil {
+		return err
+	}
+	f.file = file
+	f.w = bufio.NewWriterSize(file, int(f.ep.DefaultBufSize))
+	f.size = 0
+	f.rows = 0
+	switch f.compression {
+	case exportCompressionGzip:
+		f.zw = gzip.NewWriter(fileWriter{f})
+	case exportCompressionZstd:
+		if f.zw, err = zstd.NewWriter(fileWriter{f}); err != nil {
+			file.Close()
+			f.file = nil
+			return err
+		}
+	}
+	return nil
+}
+
+// Write writes the bytes into the file, it compresses the bytes if needed.
+func (f *exportFile) Write(p []byte) (int, error) {
+	if f.zw != nil {
+		return f.zw.Write(p)
+	}
+	return f.write(p)
+}
+
+func (f *exportFile) write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	f.size += uint64(n)
+	f.ses.writeCsvBytes.Add(int64(n)) // statistic out traffic, CASE 2: select into
+	return n, err
+}
+
+func (f *exportFile) closeFile() error {
+	if f.file == nil {
+		return nil
+	}
+	file := f.file
+	f.file = nil
+	if f.zw != nil {
+		zw := f.zw
+		f.zw = nil
+		if err := zw.Close(); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	if err := f.w.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// overflow returns true if the file can not hold n more bytes. A file holds
+// one row at least. The size of the compressed file is behind the rows, so
+// it may be larger than the MaxFileSize.
+func (f *exportFile) overflow(n uint64) bool {
+	maxSize := f.ep.userConfig.MaxFileSize
+	return maxSize != 0 && f.rows != 0 && f.size+n > maxSize
+}
+
+// rotate finishes the current file and opens the next one.
+func (f *exportFile) rotate() error {
+	if err := f.closeFile(); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+// fileWriter writes the compressed bytes into the file.
+type fileWriter struct {
+	f *exportFile
+}
+
+func (w fileWriter) Write(p []byte) (int, error) {
+	return w.f.write(p)
+}
@@ -0,0 +1,230 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func Test_exportCompressed(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	cols, bat := newExportFormatTestBatch(t, mp)
+	defer bat.Clean(mp)
+
+	// gzip csv with the header
+	filePath := filepath.Join(t.TempDir(), "export.csv")
+	ep := newExportFormatTestConfig(filePath, "")
+	ep.userConfig.Header = true
+	ep.userConfig.Compression = exportCompressionGzip
+	w, err := newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.NoError(t, err)
+	require.NoError(t, w.write(ctx, bat))
+	require.NoError(t, w.close())
+
+	f, err := os.Open(filePath + ".gz")
+	require.NoError(t, err)
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	data, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	require.Equal(t,
+		"id,price,d,ts,name\n"+
+			"1,12.34,2024-01-02,2024-01-02 03:04:05,\"a\"\"b\"\n"+
+			"2,\\N,\\N,\\N,\\N\n",
+		string(data))
+
+	// zstd jsonline, the extension is not appended again
+	filePath = filepath.Join(t.TempDir(), "export.jsonl.zst")
+	ep = newExportFormatTestConfig(filePath, tree.JSONLINE)
+	ep.userConfig.Compression = exportCompressionZstd
+	w, err = newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.NoError(t, err)
+	require.NoError(t, w.write(ctx, bat))
+	require.NoError(t, w.close())
+
+	compressed, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	zd, err := zstd.NewReader(nil)
+	require.NoError(t, err)
+	defer zd.Close()
+	data, err = zd.DecodeAll(compressed, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, strings.Count(string(data), "\n"))
+	require.True(t, strings.HasPrefix(string(data), `{"id":1,`))
+
+	ep = newExportFormatTestConfig(filepath.Join(t.TempDir(), "export.csv"), "")
+	ep.userConfig.Compression = "lzo"
+	_, err = newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.Error(t, err)
+}
+
+func Test_exportPartition(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	cols, bat := newExportFormatTestBatch(t, mp)
+	defer bat.Clean(mp)
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "export.jsonl")
+	ep := newExportFormatTestConfig(filePath, tree.JSONLINE)
+	ep.userConfig.PartitionBy = []string{"name", "ID"}
+	w, err := newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.NoError(t, err)
+	require.NoError(t, w.write(ctx, bat))
+	require.NoError(t, w.close())
+
+	// the outfile is in the partition directories only
+	_, err = os.Stat(filePath)
+	require.True(t, os.IsNotExist(err))
+
+	data, err := os.ReadFile(filepath.Join(dir, "name=a%22b", "id=1", "export.jsonl"))
+	require.NoError(t, err)
+	require.Equal(t, `{"price":12.34,"d":"2024-01-02","ts":"2024-01-02 03:04:05"}`+"\n", string(data))
+	data, err = os.ReadFile(filepath.Join(dir, "name="+hiveDefaultPartition, "id=2", "export.jsonl"))
+	require.NoError(t, err)
+	require.Equal(t, `{"price":null,"d":null,"ts":null}`+"\n", string(data))
+
+	for _, partitionBy := range [][]string{
+		{"a"},
+		{"id", "id"},
+		{"id", "price", "d", "ts", "name"},
+	} {
+		ep = newExportFormatTestConfig(filepath.Join(t.TempDir(), "export.jsonl"), tree.JSONLINE)
+		ep.userConfig.PartitionBy = partitionBy
+		_, err = newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+		require.Error(t, err, partitionBy)
+	}
+}
+
+func Test_exportWriterParallel(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	cols, bat := newExportFormatTestBatch(t, mp)
+	defer bat.Clean(mp)
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "export.csv")
+	ep := newExportFormatTestConfig(filePath, "")
+	ep.userConfig.Compression = exportCompressionGzip
+	ep.userConfig.PartitionBy = []string{"id"}
+	w, err := newExportWriter(ctx, newExportFormatTestSession(), ep, cols)
+	require.NoError(t, err)
+
+	// the pipelines write at the same time
+	const parallel, batches = 4, 10
+	var wg sync.WaitGroup
+	errs := make([]error, parallel)
+	for i := 0; i < parallel; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < batches; j++ {
+				if errs[i] = w.write(ctx, bat); errs[i] != nil {
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	for _, err = range errs {
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.close())
+
+	// every worker has its own file in a partition
+	for _, partition := range []string{"id=1", "id=2"} {
+		entries, err := os.ReadDir(filepath.Join(dir, partition))
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(entries), parallel)
+		var names []string
+		rows := 0
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+			f, err := os.Open(filepath.Join(dir, partition, entry.Name()))
+			require.NoError(t, err)
+			zr, err := gzip.NewReader(f)
+			require.NoError(t, err)
+			data, err := io.ReadAll(zr)
+			require.NoError(t, err)
+			f.Close()
+			rows += strings.Count(string(data), "\n")
+		}
+		sort.Strings(names)
+		require.Equal(t, "export.csv.gz", names[0])
+		require.Equal(t, batches*parallel, rows)
+	}
+}
+
+func Test_escapePartitionPath(t *testing.T) {
+	require.Equal(t, "2024-01-02 03%3A04%3A05", escapePartitionPath("2024-01-02 03:04:05"))
+	require.Equal(t, "a%2Fb%3Dc%25", escapePartitionPath("a/b=c%"))
+	require.Equal(t, "abc", escapePartitionPath("abc"))
+}
+
+func Test_exportInParallel(t *testing.T) {
+	ses := newExportFormatTestSession()
+	ses.sesSysVars = &SystemVariables{mp: map[string]interface{}{"save_query_result": int8(0)}}
+
+	sel := &tree.Select{Ep: &tree.ExportParam{Outfile: true, FileFormat: tree.PARQUET}}
+	require.True(t, exportInParallel(ses, sel))
+
+	// the plain csv is written in order
+	sel.Ep = &tree.ExportParam{Outfile: true}
+	require.False(t, exportInParallel(ses, sel))
+	sel.Ep.PartitionBy = []string{"a"}
+	require.True(t, exportInParallel(ses, sel))
+
+	sel.OrderBy = tree.OrderBy{&tree.Order{}}
+	require.False(t, exportInParallel(ses, sel))
+	sel.OrderBy = nil
+
+	ses.sesSysVars.mp["save_query_result"] = int8(1)
+	require.False(t, exportInParallel(ses, sel))
+	require.False(t, exportInParallel(ses, &tree.Select{}))
+}
+
+func Test_partitionValueOf(t *testing.T) {
+	ctx := context.TODO()
+	mp := mpool.MustNewZero()
+	_, bat := newExportFormatTestBatch(t, mp)
+	defer bat.Clean(mp)
+	ses := newExportFormatTestSession()
+	for i, expected := range []string{"1", "12.34", "2024-01-02", "2024-01-02 03:04:05", `a"b`} {
+		value, err := partitionValueOf(ctx, ses, bat.Vecs[i], 0)
+		require.NoError(t, err)
+		require.Equal(t, expected, value)
+		value, err = partitionValueOf(ctx, ses, bat.Vecs[i], 1)
+		require.NoError(t, err)
+		if i > 0 {
+			require.Equal(t, hiveDefaultPartition, value)
+		}
+	}
+}
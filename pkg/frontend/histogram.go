@@ -0,0 +1,258 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+)
+
+const (
+	sampleHistogramFormat = "select sample(`%s`, %d rows, 'row') from `%s`.`%s` where `%s` is not null"
+
+	deleteHistogramFormat = `delete from %s.%s where account_id = %d and table_id = %d and column_name = '%s'`
+
+	insertHistogramFormat = `insert into %s.%s values (%d, %d, %d, '%s', '%s', '%s', '%s', current_timestamp())`
+
+	getHistogramsFormat = `select column_name, histogram from %s.%s where account_id = %d and table_id = %d`
+)
+
+// handleAnalyzeHistogram builds the histograms of the columns from a sample of
+// the table, or drops them. The histograms are persisted in
+// mo_catalog.mo_column_statistics and installed in the stats of the table.
+func handleAnalyzeHistogram(ses *Session, execCtx *ExecCtx, stmt *tree.AnalyzeStmt) (err error) {
+	ctx := execCtx.reqCtx
+	dbName := string(stmt.Table.SchemaName)
+	if dbName == "" {
+		dbName = ses.GetDatabaseName()
+	}
+	if dbName == "" {
+		return moerr.NewNoDB(ctx)
+	}
+	tblName := string(stmt.Table.ObjectName)
+
+	tcc := ses.GetTxnCompileCtx()
+	relCtx, rel, err := tcc.getRelation(dbName, tblName, nil, nil)
+	if err != nil {
+		return err
+	}
+	tableDef := rel.CopyTableDef(relCtx)
+	if tableDef.Partition != nil {
+		return moerr.NewNotSupported(ctx, "histograms of partitioned tables")
+	}
+	cols, err := histogramColumns(ctx, tableDef, stmt)
+	if err != nil {
+		return err
+	}
+	if err = inputNameIsInvalid(ctx, dbName, tblName); err != nil {
+		return err
+	}
+	key := pb.StatsInfoKey{
+		AccId:      ses.GetTenantInfo().GetTenantID(),
+		DatabaseID: rel.GetDBID(relCtx),
+		TableID:    rel.GetTableID(relCtx),
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	var histograms []string
+	if stmt.Histogram == tree.HistogramUpdate {
+		buckets := int(stmt.Buckets)
+		if buckets == 0 {
+			buckets = plan2.DefaultHistogramBuckets
+		}
+		if buckets < 0 || buckets > plan2.MaxHistogramBuckets {
+			return moerr.NewInvalidInput(ctx, "the number of buckets must be between 1 and %d", plan2.MaxHistogramBuckets)
+		}
+		for _, col := range cols {
+			values, err := sampleHistogramValues(ctx, bh, dbName, tblName, col)
+			if err != nil {
+				return err
+			}
+			data, err := plan2.HistogramToJSON(plan2.BuildHistogram(values, buckets))
+			if err != nil {
+				return err
+			}
+			histograms = append(histograms, data)
+		}
+	}
+
+	sysCtx := defines.AttachAccountId(ctx, sysAccountID)
+	if err = bh.Exec(sysCtx, "begin;"); err != nil {
+		return err
+	}
+	err = func() error {
+		for i, col := range cols {
+			sql := fmt.Sprintf(deleteHistogramFormat, catalog.MO_CATALOG, catalog.MO_COLUMN_STATISTICS,
+				key.AccId, key.TableID, col.Name)
+			if err := bh.Exec(sysCtx, sql); err != nil {
+				return err
+			}
+			if histograms == nil {
+				continue
+			}
+			sql = fmt.Sprintf(insertHistogramFormat, catalog.MO_CATALOG, catalog.MO_COLUMN_STATISTICS,
+				key.AccId, key.DatabaseID, key.TableID, dbName, tblName, col.Name, histograms[i])
+			if err := bh.Exec(sysCtx, sql); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+	if err = finishTxn(sysCtx, bh, err); err != nil {
+		return err
+	}
+
+	return installHistograms(sysCtx, ses, bh, key)
+}
+
+// histogramColumns returns the columns named by the statement, or all the
+// columns having a histogram type if none is named.
+func histogramColumns(ctx context.Context, tableDef *plan.TableDef, stmt *tree.AnalyzeStmt) ([]*plan.ColDef, error) {
+	var cols []*plan.ColDef
+	if len(stmt.Cols) == 0 {
+		for _, col := range tableDef.Cols {
+			if !col.Hidden && plan2.IsHistogramType(types.T(col.Typ.Id)) {
+				cols = append(cols, col)
+			}
+		}
+		return cols, nil
+	}
+
+	for _, ident := range stmt.Cols {
+		name := strings.ToLower(string(ident))
+		idx, ok := tableDef.Name2ColIndex[name]
+		if !ok || tableDef.Cols[idx].Hidden {
+			return nil, moerr.NewBadFieldError(ctx, string(ident), tableDef.Name)
+		}
+		col := tableDef.Cols[idx]
+		if err := inputNameIsInvalid(ctx, col.Name); err != nil {
+			return nil, err
+		}
+		typ := types.T(col.Typ.Id)
+		if stmt.Histogram == tree.HistogramUpdate && !plan2.IsHistogramType(typ) {
+			return nil, moerr.NewNotSupported(ctx, "histogram on column '%s' of type %s", col.Name, typ.String())
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+// sampleHistogramValues returns the float64 form of the non-null values of a
+// sample of the column, the form is the one of the column stats.
+func sampleHistogramValues(ctx context.Context, bh BackgroundExec, dbName, tblName string, col *plan.ColDef) ([]float64, error) {
+	bh.ClearExecResultSet()
+	sql := fmt.Sprintf(sampleHistogramFormat, col.Name, plan2.HistogramSampleRows, dbName, tblName, col.Name)
+	if err := bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if len(erArray) == 0 {
+		return nil, nil
+	}
+
+	typ := types.T(col.Typ.Id)
+	values := make([]float64, 0, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		if isNull, err := erArray[0].ColumnIsNull(ctx, i, 0); err != nil {
+			return nil, err
+		} else if isNull {
+			continue
+		}
+		s, err := erArray[0].GetString(ctx, i, 0)
+		if err != nil {
+			return nil, err
+		}
+		v, err := histogramValue(typ, s)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func histogramValue(typ types.T, s string) (float64, error) {
+	switch {
+	case typ.IsSignedInt():
+		v, err := strconv.ParseInt(s, 10, 64)
+		return float64(v), err
+	case typ.IsUnsignedInt():
+		v, err := strconv.ParseUint(s, 10, 64)
+		return float64(v), err
+	case typ == types.T_date:
+		v, err := types.ParseDateCast(s)
+		return float64(v), err
+	case typ == types.T_datetime:
+		v, err := types.ParseDatetime(s, 6)
+		return float64(v), err
+	default:
+		return strconv.ParseFloat(s, 64)
+	}
+}
+
+// installHistograms reads all the histograms of the table and installs them
+// in the stats of the engine and the session.
+func installHistograms(sysCtx context.Context, ses *Session, bh BackgroundExec, key pb.StatsInfoKey) error {
+	bh.ClearExecResultSet()
+	sql := fmt.Sprintf(getHistogramsFormat, catalog.MO_CATALOG, catalog.MO_COLUMN_STATISTICS, key.AccId, key.TableID)
+	if err := bh.Exec(sysCtx, sql); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(sysCtx, bh)
+	if err != nil {
+		return err
+	}
+
+	var histograms map[string]*pb.Histogram
+	if execResultArrayHasData(erArray) {
+		histograms = make(map[string]*pb.Histogram)
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			name, err := erArray[0].GetString(sysCtx, i, 0)
+			if err != nil {
+				return err
+			}
+			data, err := erArray[0].GetString(sysCtx, i, 1)
+			if err != nil {
+				return err
+			}
+			if histograms[name], err = plan2.HistogramFromJSON(data); err != nil {
+				return err
+			}
+		}
+	}
+
+	eng := getGlobalPu().StorageEngine
+	eng.SetHistograms(key, histograms)
+	if info := eng.Stats(sysCtx, key, false); info != nil {
+		ses.GetTxnCompileCtx().UpdateStatsInCache(key.TableID, info)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_histogramColumns(t *testing.T) {
+	ctx := context.TODO()
+	tableDef := &plan.TableDef{
+		Name: "t",
+		Cols: []*plan.ColDef{
+			{Name: "a", Typ: plan.Type{Id: int32(types.T_int64)}},
+			{Name: "b", Typ: plan.Type{Id: int32(types.T_varchar)}},
+			{Name: "c", Typ: plan.Type{Id: int32(types.T_date)}},
+			{Name: "__mo_rowid", Typ: plan.Type{Id: int32(types.T_Rowid)}, Hidden: true},
+		},
+		Name2ColIndex: map[string]int32{"a": 0, "b": 1, "c": 2, "__mo_rowid": 3},
+	}
+	names := func(cols []*plan.ColDef) []string {
+		var ret []string
+		for _, col := range cols {
+			ret = append(ret, col.Name)
+		}
+		return ret
+	}
+
+	stmt := &tree.AnalyzeStmt{Histogram: tree.HistogramUpdate}
+	cols, err := histogramColumns(ctx, tableDef, stmt)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c"}, names(cols))
+
+	stmt.Cols = tree.IdentifierList{"C"}
+	cols, err = histogramColumns(ctx, tableDef, stmt)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c"}, names(cols))
+
+	stmt.Cols = tree.IdentifierList{"b"}
+	_, err = histogramColumns(ctx, tableDef, stmt)
+	require.Error(t, err)
+
+	stmt.Cols = tree.IdentifierList{"x"}
+	_, err = histogramColumns(ctx, tableDef, stmt)
+	require.Error(t, err)
+
+	stmt = &tree.AnalyzeStmt{Histogram: tree.HistogramDrop, Cols: tree.IdentifierList{"b"}}
+	cols, err = histogramColumns(ctx, tableDef, stmt)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b"}, names(cols))
+}
+
+func Test_histogramValue(t *testing.T) {
+	v, err := histogramValue(types.T_int32, "-12")
+	require.NoError(t, err)
+	require.Equal(t, -12.0, v)
+
+	v, err = histogramValue(types.T_uint64, "12")
+	require.NoError(t, err)
+	require.Equal(t, 12.0, v)
+
+	v, err = histogramValue(types.T_float64, "1.5")
+	require.NoError(t, err)
+	require.Equal(t, 1.5, v)
+
+	d, _ := types.ParseDateCast("2024-01-02")
+	v, err = histogramValue(types.T_date, "2024-01-02")
+	require.NoError(t, err)
+	require.Equal(t, float64(d), v)
+
+	dt, _ := types.ParseDatetime("2024-01-02 03:04:05", 6)
+	v, err = histogramValue(types.T_datetime, "2024-01-02 03:04:05")
+	require.NoError(t, err)
+	require.Equal(t, float64(dt), v)
+
+	_, err = histogramValue(types.T_int64, "x")
+	require.Error(t, err)
+}
@@ -401,6 +401,10 @@ func (ip *internalProtocol) Authenticate(ctx context.Context) error {
 	return nil
 }
 
+func (ip *internalProtocol) HandleChangeUser(ctx context.Context, payload []byte) error {
+	return nil
+}
+
 func (ip *internalProtocol) GetSequenceId() uint8 {
 	return 0
 }
@@ -517,6 +521,10 @@ func (ip *internalProtocol) WriteLocalInfileRequest(filename string) error {
 	return nil
 }
 
+func (ip *internalProtocol) WriteBinlogEvents(events [][]byte) error {
+	return nil
+}
+
 func (ip *internalProtocol) MakeColumnDefData(ctx context.Context, columns []*planPb.ColDef) ([][]byte, error) {
 	return nil, nil
 }
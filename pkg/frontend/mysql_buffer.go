@@ -93,6 +93,12 @@ type Conn struct {
 	timeout           time.Duration
 	allocator         *BufferAllocator
 	ses               *Session
+	// compressor is not nil if the compressed protocol is used
+	compressor *compressor
+	// the sequence id of the compressed packets. It is independent of the sequenceId.
+	compressedSequenceId uint8
+	// decompressed keeps the data of the compressed packet which has not been read
+	decompressed []byte
 }
 
 // NewIOSession create a new io session
@@ -153,6 +159,10 @@ func (c *Conn) Close() error {
 			c.allocator.Free(e.Value.([]byte))
 		}
 		c.dynamicBuf.Init()
+		if c.compressor != nil {
+			c.compressor.close()
+			c.compressor = nil
+		}
 	}()
 
 	err := c.closeConn()
@@ -318,7 +328,16 @@ func (c *Conn) ReadOnePayload(packetLength int) ([]byte, error) {
 
 // ReadBytes reads specified bytes from the network
 func (c *Conn) ReadBytes(buf []byte, Length int) error {
+	if c.compressor != nil {
+		return c.readDecompressed(buf[:Length])
+	}
+	return c.readRawBytes(buf[:Length])
+}
+
+// readRawBytes reads len(buf) bytes from the network
+func (c *Conn) readRawBytes(buf []byte) error {
 	var err error
+	Length := len(buf)
 	var n int
 	var readLength int
 	for readLength < Length {
@@ -539,6 +558,14 @@ func (c *Conn) Write(payload []byte) error {
 
 // WriteToConn is the base method for write data to network, calling net.Conn.Write().
 func (c *Conn) WriteToConn(buf []byte) error {
+	if c.compressor != nil {
+		return c.writeCompressed(buf)
+	}
+	return c.writeRawBytes(buf)
+}
+
+// writeRawBytes writes the buf to the network
+func (c *Conn) writeRawBytes(buf []byte) error {
 	sendLength := 0
 	for sendLength < len(buf) {
 		n, err := c.conn.Write(buf[sendLength:])
@@ -427,7 +427,12 @@ func getDataFromPipeline(obj FeSession, execCtx *ExecCtx, bat *batch.Batch) erro
 	ses := obj.(*Session)
 
 	begin := time.Now()
-	err := ses.GetResponser().RespResult(execCtx, bat)
+	var err error
+	if execCtx.cursor != nil {
+		err = execCtx.cursor.RespResult(execCtx, bat)
+	} else {
+		err = ses.GetResponser().RespResult(execCtx, bat)
+	}
 	if err != nil {
 		return err
 	}
@@ -761,7 +766,7 @@ func doShowErrors(ses *Session, execCtx *ExecCtx) error {
 
 	for i := info.length() - 1; i >= 0; i-- {
 		row := make([]interface{}, 3)
-		row[0] = "Error"
+		row[0] = info.levels[i]
 		row[1] = int16(info.codes[i])
 		row[2] = info.msgs[i]
 		mrs.AddRow(row)
@@ -915,6 +920,9 @@ func handleShowVariables(ses FeSession, execCtx *ExecCtx, sv *tree.ShowVariables
 func handleAnalyzeStmt(ses *Session, execCtx *ExecCtx, stmt *tree.AnalyzeStmt) error {
 	ses.EnterFPrint(FPHandleAnalyzeStmt)
 	defer ses.ExitFPrint(FPHandleAnalyzeStmt)
+	if stmt.Histogram != tree.HistogramNone {
+		return handleAnalyzeHistogram(ses, execCtx, stmt)
+	}
 	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
 	// IMO, this approach is simple and future-proof
 	// Although this rewriting processing could have been handled in rewrite module,
@@ -1292,6 +1300,7 @@ func handleCreateUser(ses FeSession, execCtx *ExecCtx, st *tree.CreateUser) erro
 		if u.AuthOption != nil {
 			v.AuthExist = true
 			v.IdentTyp = u.AuthOption.Typ
+			v.AuthPlugin = u.AuthOption.AuthPlugin
 			switch v.IdentTyp {
 			case tree.AccountIdentifiedByPassword,
 				tree.AccountIdentifiedWithSSL:
@@ -1332,6 +1341,7 @@ func handleAlterUser(ses FeSession, execCtx *ExecCtx, st *tree.AlterUser) error
 		if su.AuthOption != nil {
 			u.AuthExist = true
 			u.IdentTyp = su.AuthOption.Typ
+			u.AuthPlugin = su.AuthOption.AuthPlugin
 			switch u.IdentTyp {
 			case tree.AccountIdentifiedByPassword,
 				tree.AccountIdentifiedWithSSL:
@@ -1771,9 +1781,9 @@ func getExplainOption(reqCtx context.Context, options []tree.OptionElem) (*expla
 				if strings.EqualFold(v.Value, "TEXT") {
 					es.Format = explain.EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
-					return nil, moerr.NewNotSupported(reqCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_JSON
 				} else if strings.EqualFold(v.Value, "DOT") {
-					return nil, moerr.NewNotSupported(reqCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_DOT
 				} else {
 					return nil, moerr.NewInvalidInput(reqCtx, "invalid explain option '%s', valud '%s'", v.Name, v.Value)
 				}
@@ -2038,6 +2048,9 @@ func incStatementErrorsCounter(tenant string, stmt tree.Statement) {
 func authenticateUserCanExecuteStatement(reqCtx context.Context, ses *Session, stmt tree.Statement) error {
 	reqCtx, span := trace.Debug(reqCtx, "authenticateUserCanExecuteStatement")
 	defer span.End()
+	if err := checkPasswordExpired(reqCtx, ses, stmt); err != nil {
+		return err
+	}
 	if getGlobalPu().SV.SkipCheckPrivilege {
 		return nil
 	}
@@ -2673,6 +2686,9 @@ func doComQuery(ses *Session, execCtx *ExecCtx, input *UserInput) (retErr error)
 	input.genSqlSourceType(ses)
 	ses.SetShowStmtType(NotShowStatement)
 	resper := ses.GetResponser()
+	if execCtx.cursor != nil {
+		resper = execCtx.cursor
+	}
 	ses.SetSql(input.getSql())
 	input.genHash()
 
@@ -2951,6 +2967,10 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 
 	var sql string
 	ses.Debugf(execCtx.reqCtx, "cmd %v", req.GetCmd())
+	// the open cursor shares the session with the other commands
+	if req.GetCmd() != COM_STMT_FETCH && req.GetCmd() != COM_PING {
+		ses.closeCursor()
+	}
 	ses.SetCmd(req.GetCmd())
 	switch req.GetCmd() {
 	case COM_QUIT:
@@ -3015,19 +3035,26 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 		ses.SetCmd(COM_STMT_EXECUTE)
 		var prepareStmt *PrepareStmt
 		sql, prepareStmt, err = parseStmtExecute(execCtx.reqCtx, ses, req.GetData().([]byte))
-		execCtx.prepareColDef = prepareStmt.ColDefData
 		if err != nil {
 			return NewGeneralErrorResponse(COM_STMT_EXECUTE, ses.GetTxnHandler().GetServerStatus(), err), nil
 		}
-		err = doComQuery(ses, execCtx, &UserInput{sql: sql})
+		execCtx.prepareColDef = prepareStmt.ColDefData
+		if useCursor(ses, prepareStmt) {
+			stmtID := binary.LittleEndian.Uint32(req.GetData().([]byte)[0:4])
+			err = executeStmtWithCursor(ses, execCtx, stmtID, sql, prepareStmt)
+		} else {
+			err = doComQuery(ses, execCtx, &UserInput{sql: sql})
+			prepareStmt.resetParams()
+		}
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, ses.GetTxnHandler().GetServerStatus(), err)
 		}
-		if prepareStmt.params != nil {
-			prepareStmt.params.GetNulls().Reset()
-			for k := range prepareStmt.getFromSendLongData {
-				delete(prepareStmt.getFromSendLongData, k)
-			}
+		return resp, nil
+
+	case COM_STMT_FETCH:
+		err = handleStmtFetch(ses, execCtx, req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_FETCH, ses.GetTxnHandler().GetServerStatus(), err)
 		}
 		return resp, nil
 
@@ -3090,6 +3117,45 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 		}
 		return NewGeneralOkResponse(COM_SET_OPTION, ses.GetTxnHandler().GetServerStatus()), nil
 
+	case COM_RESET_CONNECTION:
+		err = ses.ResetConnection(execCtx)
+		if err != nil {
+			return NewGeneralErrorResponse(COM_RESET_CONNECTION, ses.GetTxnHandler().GetServerStatus(), err), nil
+		}
+		return NewGeneralOkResponse(COM_RESET_CONNECTION, ses.GetTxnHandler().GetServerStatus()), nil
+
+	case COM_CHANGE_USER:
+		err = handleChangeUser(ses, execCtx, req.GetData().([]byte))
+		if err != nil {
+			// the connection is closed after the failed authentication
+			return NewGeneralErrorResponse(COM_CHANGE_USER, ses.GetTxnHandler().GetServerStatus(), err), err
+		}
+		return NewGeneralOkResponse(COM_CHANGE_USER, ses.GetTxnHandler().GetServerStatus()), nil
+
+	case COM_REGISTER_SLAVE:
+		err = handleRegisterSlave(ses, execCtx, req.GetData().([]byte))
+		if err != nil {
+			return NewGeneralErrorResponse(COM_REGISTER_SLAVE, ses.GetTxnHandler().GetServerStatus(), err), nil
+		}
+		return NewGeneralOkResponse(COM_REGISTER_SLAVE, ses.GetTxnHandler().GetServerStatus()), nil
+
+	case COM_BINLOG_DUMP, COM_BINLOG_DUMP_GTID:
+		var dump binlogDumpRequest
+		if req.GetCmd() == COM_BINLOG_DUMP {
+			dump, err = parseBinlogDump(execCtx.reqCtx, req.GetData().([]byte))
+		} else {
+			dump, err = parseBinlogDumpGTID(execCtx.reqCtx, req.GetData().([]byte))
+		}
+		if err == nil {
+			// the events are sent by the dump, there is no response if it
+			// succeeds
+			err = handleBinlogDump(ses, execCtx, dump)
+		}
+		if err != nil {
+			return NewGeneralErrorResponse(req.GetCmd(), ses.GetTxnHandler().GetServerStatus(), err), nil
+		}
+		return nil, nil
+
 	default:
 		resp = NewGeneralErrorResponse(req.GetCmd(), ses.GetTxnHandler().GetServerStatus(), moerr.NewInternalError(execCtx.reqCtx, "unsupported command. 0x%x", req.GetCmd()))
 	}
@@ -3495,6 +3561,28 @@ func handleSetOption(ses *Session, execCtx *ExecCtx, data []byte) (err error) {
 	return nil
 }
 
+// handleChangeUser resets the session and authenticates the user in the
+// COM_CHANGE_USER on the same connection.
+func handleChangeUser(ses *Session, execCtx *ExecCtx, data []byte) error {
+	auditDisconnect(execCtx.reqCtx, ses)
+	if err := ses.ResetConnection(execCtx); err != nil {
+		return err
+	}
+	// the routine is recorded again for the account of the new user
+	if rm := ses.getRoutineManager(); rm != nil && rm.accountRoutine != nil && ses.GetTenantInfo() != nil {
+		rm.accountRoutine.deleteRoutine(int64(ses.GetTenantInfo().GetTenantID()), ses.getRoutine())
+	}
+	mysqlRrWr := ses.GetResponser().MysqlRrWr()
+	err := mysqlRrWr.HandleChangeUser(execCtx.reqCtx, data)
+	auditConnect(execCtx.reqCtx, ses, err)
+	if err != nil {
+		return err
+	}
+	ses.InvalidatePrivilegeCache()
+	ses.SetDatabaseName(mysqlRrWr.GetStr(DBNAME))
+	return nil
+}
+
 func handleExecUpgrade(ses *Session, execCtx *ExecCtx, st *tree.UpgradeStatement) error {
 	retryCount := st.Retry
 	if st.Retry <= 0 {
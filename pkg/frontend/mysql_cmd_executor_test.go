@@ -1372,11 +1372,13 @@ func Test_getExplainOption(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, option.Format, explain.EXPLAIN_FORMAT_TEXT)
 
-	_, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "json"}})
-	require.NotNil(t, err)
+	option, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "json"}})
+	require.Nil(t, err)
+	require.Equal(t, option.Format, explain.EXPLAIN_FORMAT_JSON)
 
-	_, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "dot"}})
-	require.NotNil(t, err)
+	option, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "dot"}})
+	require.Nil(t, err)
+	require.Equal(t, option.Format, explain.EXPLAIN_FORMAT_DOT)
 
 	_, err = getExplainOption(ctx, []tree.OptionElem{{Name: "format", Value: "???"}})
 	require.NotNil(t, err)
@@ -0,0 +1,232 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// the compression algorithms of the compressed protocol
+const (
+	compressNone = iota
+	compressZlib
+	compressZstd
+)
+
+const (
+	// the header of the compressed packet: int<3> compressed length,
+	// int<1> compressed sequence id and int<3> uncompressed length.
+	compressedHeaderLength = 7
+	// the payload shorter than it is sent without compression
+	minCompressLength = 50
+	// defaultZstdCompressionLevel is used when the client does not set the level
+	defaultZstdCompressionLevel = 3
+)
+
+// compressor compresses and decompresses the payload of the compressed packets.
+type compressor struct {
+	algorithm int
+
+	zlibBuf    bytes.Buffer
+	zlibWriter *zlib.Writer
+
+	zstdEncoder *zstd.Encoder
+	zstdDecoder *zstd.Decoder
+}
+
+func newCompressor(algorithm int, level int) (*compressor, error) {
+	var err error
+	c := &compressor{algorithm: algorithm}
+	switch algorithm {
+	case compressZlib:
+		c.zlibWriter = zlib.NewWriter(&c.zlibBuf)
+	case compressZstd:
+		if level <= 0 {
+			level = defaultZstdCompressionLevel
+		}
+		c.zstdEncoder, err = zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+			zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		// a compressed packet never holds more than MaxPayloadSize bytes, the
+		// limit keeps a malicious frame from decoding into a huge buffer.
+		c.zstdDecoder, err = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(uint64(MaxPayloadSize)))
+		if err != nil {
+			c.zstdEncoder.Close()
+			return nil, err
+		}
+	default:
+		return nil, moerr.NewInternalErrorNoCtx("unsupported compression algorithm %d", algorithm)
+	}
+	return c, nil
+}
+
+// compress appends the compressed data to dst.
+func (c *compressor) compress(dst, data []byte) ([]byte, error) {
+	if c.algorithm == compressZstd {
+		return c.zstdEncoder.EncodeAll(data, dst), nil
+	}
+	c.zlibBuf.Reset()
+	c.zlibWriter.Reset(&c.zlibBuf)
+	if _, err := c.zlibWriter.Write(data); err != nil {
+		return nil, err
+	}
+	if err := c.zlibWriter.Close(); err != nil {
+		return nil, err
+	}
+	return append(dst, c.zlibBuf.Bytes()...), nil
+}
+
+// decompress decompresses the data whose length is uncompressedLength after decompression.
+func (c *compressor) decompress(data []byte, uncompressedLength int) ([]byte, error) {
+	var err error
+	var out []byte
+	if c.algorithm == compressZstd {
+		out, err = c.zstdDecoder.DecodeAll(data, make([]byte, 0, uncompressedLength))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var r io.ReadCloser
+		if r, err = zlib.NewReader(bytes.NewReader(data)); err != nil {
+			return nil, err
+		}
+		out = make([]byte, uncompressedLength)
+		if _, err = io.ReadFull(r, out); err != nil {
+			return nil, err
+		}
+		if err = r.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if len(out) != uncompressedLength {
+		return nil, moerr.NewInternalErrorNoCtx("the length of the decompressed packet is %d, expected %d", len(out), uncompressedLength)
+	}
+	return out, nil
+}
+
+func (c *compressor) close() {
+	if c.zstdEncoder != nil {
+		_ = c.zstdEncoder.Close()
+	}
+	if c.zstdDecoder != nil {
+		c.zstdDecoder.Close()
+	}
+}
+
+// EnableCompression makes the subsequent packets be sent and received in
+// the compressed protocol. It is called after the server sends the OK packet
+// of the authentication.
+func (c *Conn) EnableCompression(algorithm int, level int) error {
+	cp, err := newCompressor(algorithm, level)
+	if err != nil {
+		return err
+	}
+	c.compressor = cp
+	c.compressedSequenceId = 0
+	c.decompressed = nil
+	return nil
+}
+
+// readDecompressed fills the buf with the data of the compressed packets.
+func (c *Conn) readDecompressed(buf []byte) error {
+	for len(buf) > 0 {
+		if len(c.decompressed) == 0 {
+			if err := c.readCompressedPacket(); err != nil {
+				return err
+			}
+			continue
+		}
+		n := copy(buf, c.decompressed)
+		c.decompressed = c.decompressed[n:]
+		buf = buf[n:]
+	}
+	return nil
+}
+
+// readCompressedPacket reads a compressed packet and keeps the decompressed payload.
+func (c *Conn) readCompressedPacket() error {
+	var header [compressedHeaderLength]byte
+	if err := c.readRawBytes(header[:]); err != nil {
+		return err
+	}
+	compressedLength := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	c.compressedSequenceId = header[3] + 1
+	uncompressedLength := int(uint32(header[4]) | uint32(header[5])<<8 | uint32(header[6])<<16)
+
+	payload := make([]byte, compressedLength)
+	if err := c.readRawBytes(payload); err != nil {
+		return err
+	}
+	// the uncompressed length 0 means that the payload is not compressed
+	if uncompressedLength == 0 {
+		c.decompressed = payload
+		return nil
+	}
+	data, err := c.compressor.decompress(payload, uncompressedLength)
+	if err != nil {
+		return err
+	}
+	c.decompressed = data
+	return nil
+}
+
+// writeCompressed sends the data in the compressed packets.
+func (c *Conn) writeCompressed(data []byte) error {
+	for len(data) > 0 {
+		chunk := data[:Min(len(data), int(MaxPayloadSize))]
+		data = data[len(chunk):]
+
+		packet := make([]byte, compressedHeaderLength, compressedHeaderLength+len(chunk))
+		uncompressedLength := 0
+		if len(chunk) >= minCompressLength {
+			compressed, err := c.compressor.compress(packet, chunk)
+			if err != nil {
+				return err
+			}
+			if len(compressed)-compressedHeaderLength < len(chunk) {
+				packet = compressed
+				uncompressedLength = len(chunk)
+			}
+		}
+		if uncompressedLength == 0 {
+			packet = append(packet[:compressedHeaderLength], chunk...)
+		}
+
+		compressedLength := len(packet) - compressedHeaderLength
+		packet[0] = byte(compressedLength)
+		packet[1] = byte(compressedLength >> 8)
+		packet[2] = byte(compressedLength >> 16)
+		packet[3] = c.compressedSequenceId
+		packet[4] = byte(uncompressedLength)
+		packet[5] = byte(uncompressedLength >> 8)
+		packet[6] = byte(uncompressedLength >> 16)
+		c.compressedSequenceId++
+		if err := c.writeRawBytes(packet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
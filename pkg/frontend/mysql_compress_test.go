@@ -0,0 +1,172 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+)
+
+func TestCompressor(t *testing.T) {
+	data := bytes.Repeat([]byte("matrixone"), 100)
+	for _, algorithm := range []int{compressZlib, compressZstd} {
+		c, err := newCompressor(algorithm, 0)
+		require.NoError(t, err)
+		compressed, err := c.compress(nil, data)
+		require.NoError(t, err)
+		require.Less(t, len(compressed), len(data))
+
+		decompressed, err := c.decompress(compressed, len(data))
+		require.NoError(t, err)
+		require.Equal(t, data, decompressed)
+
+		_, err = c.decompress(compressed, len(data)+1)
+		require.Error(t, err)
+		c.close()
+	}
+
+	_, err := newCompressor(compressNone, 0)
+	require.Error(t, err)
+}
+
+func TestCompressorDecompressionBomb(t *testing.T) {
+	c, err := newCompressor(compressZstd, 0)
+	require.NoError(t, err)
+	defer c.close()
+
+	// the frame decodes into more than a packet can hold
+	bomb, err := c.compress(nil, make([]byte, int(MaxPayloadSize)+1))
+	require.NoError(t, err)
+	_, err = c.decompress(bomb, 100)
+	require.ErrorIs(t, err, zstd.ErrDecoderSizeExceeded)
+}
+
+func TestMySQLCompressedProtocol(t *testing.T) {
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	sv.SessionTimeout.Duration = 5 * time.Minute
+	pu := config.NewParameterUnit(sv, nil, nil, nil)
+
+	payloads := [][]byte{
+		[]byte("select 1"),
+		bytes.Repeat([]byte("compressed"), 1000),
+		bytes.Repeat([]byte{'a'}, int(MaxPayloadSize)+100),
+	}
+
+	for _, algorithm := range []int{compressZlib, compressZstd} {
+		server, client := net.Pipe()
+		cWriter, err := NewIOSession(client, pu)
+		require.NoError(t, err)
+		cReader, err := NewIOSession(server, pu)
+		require.NoError(t, err)
+		cReader.allowedPacketSize = int(MaxPayloadSize) * 16
+		require.NoError(t, cWriter.EnableCompression(algorithm, 0))
+		require.NoError(t, cReader.EnableCompression(algorithm, 0))
+
+		errC := make(chan error, 1)
+		go func() {
+			for _, payload := range payloads {
+				if err := cWriter.BeginPacket(); err != nil {
+					errC <- err
+					return
+				}
+				if err := cWriter.Append(payload...); err != nil {
+					errC <- err
+					return
+				}
+				if err := cWriter.FinishedPacket(); err != nil {
+					errC <- err
+					return
+				}
+			}
+			errC <- cWriter.Flush()
+		}()
+
+		for _, payload := range payloads {
+			data, err := cReader.Read()
+			require.NoError(t, err)
+			require.Equal(t, payload, data)
+		}
+		require.NoError(t, <-errC)
+		require.Equal(t, cWriter.compressedSequenceId, cReader.compressedSequenceId)
+		cWriter.Close()
+		cReader.Close()
+	}
+}
+
+func TestMySQLCompressedShortPacket(t *testing.T) {
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	pu := config.NewParameterUnit(sv, nil, nil, nil)
+
+	server, client := net.Pipe()
+	defer server.Close()
+	cWriter, err := NewIOSession(client, pu)
+	require.NoError(t, err)
+	defer cWriter.Close()
+	require.NoError(t, cWriter.EnableCompression(compressZlib, 0))
+
+	go func() {
+		_ = cWriter.WriteToConn([]byte("short"))
+	}()
+
+	// the short payload is sent without compression.
+	var res [compressedHeaderLength + 5]byte
+	n := 0
+	for n < len(res) {
+		m, err := server.Read(res[n:])
+		require.NoError(t, err)
+		n += m
+	}
+	require.Equal(t, []byte{5, 0, 0, 0, 0, 0, 0}, res[:compressedHeaderLength])
+	require.Equal(t, "short", string(res[compressedHeaderLength:]))
+}
+
+func TestAnalyseHandshakeResponseZstdLevel(t *testing.T) {
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	pu := config.NewParameterUnit(sv, nil, nil, nil)
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+	ioses, err := NewIOSession(server, pu)
+	require.NoError(t, err)
+	mp := NewMysqlClientProtocol("", 0, ioses, 1024, sv)
+
+	capability := CLIENT_PROTOCOL_41 | CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	data := make([]byte, 0, 64)
+	data = append(data, byte(capability), byte(capability>>8), byte(capability>>16), byte(capability>>24))
+	// max packet size, charset and the filler
+	data = append(data, make([]byte, 4+1+23)...)
+	data = append(data, []byte("root")...)
+	data = append(data, 0)
+	// empty auth response
+	data = append(data, 0)
+	// zstd compression level
+	data = append(data, 7)
+
+	ok, resp, err := mp.analyseHandshakeResponse41(context.TODO(), data)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint8(7), resp.zstdCompressionLevel)
+}
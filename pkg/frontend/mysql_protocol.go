@@ -64,7 +64,9 @@ var DefaultCapability = CLIENT_LONG_PASSWORD |
 	CLIENT_PLUGIN_AUTH |
 	CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA |
 	CLIENT_DEPRECATE_EOF |
-	CLIENT_CONNECT_ATTRS
+	CLIENT_CONNECT_ATTRS |
+	CLIENT_COMPRESS |
+	CLIENT_ZSTD_COMPRESSION_ALGORITHM
 
 // DefaultClientConnStatus default server status
 var DefaultClientConnStatus = SERVER_STATUS_AUTOCOMMIT
@@ -154,6 +156,8 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -243,6 +247,9 @@ type MysqlProtocolImpl struct {
 	//max packet size of the client
 	maxClientPacketSize uint32
 
+	//the compression level of zstd if the client uses the zstd compressed protocol
+	zstdCompressionLevel uint8
+
 	//the user of the client
 	username string
 
@@ -250,6 +257,9 @@ type MysqlProtocolImpl struct {
 	// indicated by the plugin name field.
 	authResponse []byte
 
+	// the authentication plugin of the authResponse
+	authPlugin string
+
 	//the default database for the client
 	database string
 
@@ -621,6 +631,8 @@ type response41 struct {
 	clientPluginName  string
 	isAskForTlsHeader bool
 	connectAttrs      map[string]string
+	// zstdCompressionLevel is set if CLIENT_ZSTD_COMPRESSION_ALGORITHM is set
+	zstdCompressionLevel uint8
 }
 
 // handshake response 320
@@ -775,10 +787,11 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(ctx context.Context, proc *process
 		return moerr.NewInternalError(ctx, "malform packet")
 
 	}
-	if flag != 0 {
-		// TODO only support CURSOR_TYPE_NO_CURSOR flag now
+	if flag&^CURSOR_TYPE_READ_ONLY != 0 {
+		// only support CURSOR_TYPE_NO_CURSOR and CURSOR_TYPE_READ_ONLY flag now
 		return moerr.NewInvalidInput(ctx, "unsupported Prepare flag '%v'", flag)
 	}
+	stmt.cursorType = flag
 
 	// skip iteration-count, always 1
 	pos += 4
@@ -1402,8 +1415,29 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 
 	ses := mp.GetSession()
 	if !mp.SV.SkipCheckUser {
+		checkPassword := CheckPassword
+		plugin, sha2AuthString := getAuthPluginOfUser(ctx, ses, mp.GetUserName())
+		if plugin == AuthCachingSha2Password {
+			// the wrong password is reported by AuthenticateUser, which
+			// counts the failed logins.
+			if err = mp.authenticateSha2(ctx, sha2AuthString); err != nil && !isCheckPasswordFailed(err) {
+				return err
+			}
+			// the password has been checked by caching_sha2_password
+			passed := err == nil
+			checkPassword = func(pwd, salt, auth []byte) bool {
+				return passed
+			}
+		} else if mp.authPlugin == AuthCachingSha2Password {
+			if mp.authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthNativePassword); err != nil {
+				return err
+			}
+			mp.authPlugin = AuthNativePassword
+		}
+		authResponse = mp.authResponse
+
 		ses.Debugf(ctx, "authenticate user 1")
-		psw, err = ses.AuthenticateUser(ctx, mp.GetUserName(), mp.GetDatabaseName(), mp.authResponse, mp.GetSalt(), CheckPassword)
+		psw, err = ses.AuthenticateUser(ctx, mp.GetUserName(), mp.GetDatabaseName(), mp.authResponse, mp.GetSalt(), checkPassword)
 		if err != nil {
 			return err
 		}
@@ -1414,7 +1448,7 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 		ses.Debugf(ctx, "authenticate user 2")
 
 		//TO Check password
-		if CheckPassword(psw, mp.GetSalt(), authResponse) {
+		if checkPassword(psw, mp.GetSalt(), authResponse) {
 			ses.Debugf(ctx, "check password succeeded")
 			if err = ses.InitSystemVariables(ctx); err != nil {
 				return err
@@ -1466,6 +1500,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp41.authResponse
+		mp.authPlugin = resp41.clientPluginName
 		mp.capability = mp.capability & resp41.capabilities
 
 		if nameAndCharset, ok3 := collationID2CharsetAndName[int(resp41.collationID)]; !ok3 {
@@ -1477,6 +1512,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.maxClientPacketSize = resp41.maxPacketSize
+		mp.zstdCompressionLevel = resp41.zstdCompressionLevel
 		mp.username = resp41.username
 		mp.database = resp41.database
 		mp.connectAttrs = resp41.connectAttrs
@@ -1514,7 +1550,9 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 	}()
 
 	ses.Debugf(ctx, "authenticate user")
-	if err := mp.authenticateUser(ctx, mp.authResponse); err != nil {
+	err := mp.authenticateUser(ctx, mp.authResponse)
+	auditConnect(ctx, ses, err)
+	if err != nil {
 		ses.Errorf(ctx, "authenticate user failed.error:%v", err)
 		errorCode, sqlState, msg := RewriteError(err, mp.username)
 		ses.timestampMap[TSSendErrPacketStart] = time.Now()
@@ -1530,13 +1568,17 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 
 	ses.Debugf(ctx, "handle handshake end")
 	ses.timestampMap[TSSendOKPacketStart] = time.Now()
-	err := mp.sendOKPacket(0, 0, 0, 0, "")
+	err = mp.sendOKPacket(0, 0, 0, 0, "")
 	ses.timestampMap[TSSendOKPacketEnd] = time.Now()
 	v2.SendOKPacketDurationHistogram.Observe(ses.timestampMap[TSSendOKPacketEnd].Sub(ses.timestampMap[TSSendOKPacketStart]).Seconds())
 	ses.Debugf(ctx, "handle handshake response ok")
 	if err != nil {
 		return err
 	}
+	// the packets after the OK packet are compressed
+	if err = mp.enableCompression(); err != nil {
+		return err
+	}
 	allowedPacketSize, err := ses.GetSessionSysVar("max_allowed_packet")
 	if err != nil {
 		return err
@@ -1545,6 +1587,223 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 	return nil
 }
 
+// HandleChangeUser authenticates the user in the COM_CHANGE_USER with the
+// salt of the handshake. The OK or ERR packet is sent by the caller.
+func (mp *MysqlProtocolImpl) HandleChangeUser(ctx context.Context, payload []byte) error {
+	info, err := mp.analyseChangeUser(ctx, payload)
+	if err != nil {
+		return err
+	}
+	//to switch authenticate method. caching_sha2_password is switched after the
+	//plugin of the user is known.
+	if info.clientPluginName != AuthNativePassword && info.clientPluginName != AuthCachingSha2Password {
+		if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthNativePassword); err != nil {
+			return moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+		}
+		info.clientPluginName = AuthNativePassword
+	}
+
+	mp.SetUserName(info.username)
+	mp.SetDatabaseName(info.database)
+	mp.authResponse = info.authResponse
+	mp.authPlugin = info.clientPluginName
+	if info.collationID != 0 {
+		if nameAndCharset, ok := collationID2CharsetAndName[int(info.collationID)]; ok {
+			mp.collationID = int(info.collationID)
+			mp.collationName = nameAndCharset.collationName
+			mp.charset = nameAndCharset.charset
+		}
+	}
+	if info.connectAttrs != nil {
+		mp.connectAttrs = info.connectAttrs
+	}
+
+	if err = mp.authenticateUser(ctx, mp.authResponse); err != nil {
+		return err
+	}
+	allowedPacketSize, err := mp.GetSession().GetSessionSysVar("max_allowed_packet")
+	if err != nil {
+		return err
+	}
+	mp.tcpConn.allowedPacketSize = int(allowedPacketSize.(int64))
+	return nil
+}
+
+// the server analyses the payload of the COM_CHANGE_USER from the client.
+// the response41 is used to hold the user, auth response, database,
+// collation, auth plugin and connect attributes.
+func (mp *MysqlProtocolImpl) analyseChangeUser(ctx context.Context, data []byte) (response41, error) {
+	var pos = 0
+	var ok bool
+	var info response41
+
+	//string[NUL]        user
+	info.username, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get username failed")
+	}
+
+	/*
+		if capabilities & CLIENT_SECURE_CONNECTION {
+			int<1>             length of auth-response
+			string[n]          auth-response
+		} else {
+			string[NUL]        auth-response
+		}
+	*/
+	if mp.capability&CLIENT_SECURE_CONNECTION != 0 {
+		var l uint8
+		l, pos, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get length of auth-response failed")
+		}
+		info.authResponse, pos, ok = mp.readCountOfBytes(data, pos, int(l))
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+	} else {
+		var auth string
+		auth, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+		info.authResponse = []byte(auth)
+	}
+
+	//string[NUL]        database
+	info.database, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get database failed")
+	}
+
+	info.clientPluginName = AuthNativePassword
+	if pos >= len(data) {
+		return info, nil
+	}
+
+	//int<2>             character set
+	var collationID uint16
+	collationID, pos, ok = mp.io.ReadUint16(data, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get character set failed")
+	}
+	info.collationID = uint8(collationID)
+
+	if mp.capability&CLIENT_PLUGIN_AUTH != 0 && pos < len(data) {
+		info.clientPluginName, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth plugin name failed")
+		}
+	}
+
+	// client connection attributes
+	if mp.capability&CLIENT_CONNECT_ATTRS != 0 && pos < len(data) {
+		var l uint64
+		l, pos, ok = mp.readIntLenEnc(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get length of client-connect-attrs failed")
+		}
+		info.connectAttrs = make(map[string]string)
+		endPos := pos + int(l)
+		var key, value string
+		for pos < endPos {
+			key, pos, ok = mp.readStringLenEnc(data, pos)
+			if !ok {
+				return info, moerr.NewInternalError(ctx, "get connect-attrs key failed")
+			}
+			value, pos, ok = mp.readStringLenEnc(data, pos)
+			if !ok {
+				return info, moerr.NewInternalError(ctx, "get connect-attrs value failed")
+			}
+			info.connectAttrs[key] = value
+		}
+	}
+
+	return info, nil
+}
+
+// MakeChangeUserHandshakeResp makes a handshake response41 payload with the user,
+// auth response and database in the COM_CHANGE_USER. The capabilities and the
+// max packet size are kept from the original handshake response. It is used by
+// the proxy to login as the new user when the connection is transferred.
+func (mp *MysqlProtocolImpl) MakeChangeUserHandshakeResp(ctx context.Context, handshakeResp, changeUser []byte) (payload []byte, username, database string, err error) {
+	// int<4> capabilities, int<4> max packet size, int<1> character set
+	// and string[23] reserved
+	const headerLen = 32
+	if len(handshakeResp) < headerLen {
+		return nil, "", "", moerr.NewInternalError(ctx, "received a broken response packet")
+	}
+	info, err := mp.analyseChangeUser(ctx, changeUser)
+	if err != nil {
+		return nil, "", "", err
+	}
+	capabilities, _, _ := mp.io.ReadUint32(handshakeResp, 0)
+	if info.database != "" {
+		capabilities |= CLIENT_CONNECT_WITH_DB
+	}
+	capabilities &^= CLIENT_ZSTD_COMPRESSION_ALGORITHM
+
+	// the attributes of the handshake are kept if the COM_CHANGE_USER has none
+	if info.connectAttrs == nil {
+		info.connectAttrs = mp.GetConnectAttrs()
+	}
+	var attrs []byte
+	if capabilities&CLIENT_CONNECT_ATTRS != 0 {
+		attrsLen := 0
+		for k, v := range info.connectAttrs {
+			attrsLen += len(k) + len(v) + 18
+		}
+		attrs = make([]byte, attrsLen)
+		attrsLen = 0
+		for k, v := range info.connectAttrs {
+			attrsLen = mp.writeStringLenEnc(attrs, attrsLen, k)
+			attrsLen = mp.writeStringLenEnc(attrs, attrsLen, v)
+		}
+		attrs = attrs[:attrsLen]
+	}
+
+	data := make([]byte, headerLen+len(info.username)+len(info.authResponse)+len(info.database)+len(info.clientPluginName)+len(attrs)+32)
+	pos := copy(data, handshakeResp[:headerLen])
+	mp.io.WriteUint32(data, 0, capabilities)
+	if info.collationID != 0 {
+		mp.io.WriteUint8(data, 8, info.collationID)
+	}
+
+	pos = mp.writeStringNUL(data, pos, info.username)
+	if capabilities&CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA != 0 {
+		pos = mp.writeIntLenEnc(data, pos, uint64(len(info.authResponse)))
+		pos = mp.writeCountOfBytes(data, pos, info.authResponse)
+	} else if capabilities&CLIENT_SECURE_CONNECTION != 0 {
+		pos = mp.io.WriteUint8(data, pos, uint8(len(info.authResponse)))
+		pos = mp.writeCountOfBytes(data, pos, info.authResponse)
+	} else {
+		pos = mp.writeCountOfBytes(data, pos, info.authResponse)
+		pos = mp.io.WriteUint8(data, pos, 0)
+	}
+	if capabilities&CLIENT_CONNECT_WITH_DB != 0 {
+		pos = mp.writeStringNUL(data, pos, info.database)
+	}
+	if capabilities&CLIENT_PLUGIN_AUTH != 0 {
+		pos = mp.writeStringNUL(data, pos, info.clientPluginName)
+	}
+	if capabilities&CLIENT_CONNECT_ATTRS != 0 {
+		pos = mp.writeIntLenEnc(data, pos, uint64(len(attrs)))
+		pos = mp.writeCountOfBytes(data, pos, attrs)
+	}
+	return data[:pos], info.username, info.database, nil
+}
+
+// enableCompression switches the connection to the compressed protocol
+// if the client asks for it. zstd is preferred to zlib.
+func (mp *MysqlProtocolImpl) enableCompression() error {
+	if mp.capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		return mp.tcpConn.EnableCompression(compressZstd, int(mp.zstdCompressionLevel))
+	} else if mp.capability&CLIENT_COMPRESS != 0 {
+		return mp.tcpConn.EnableCompression(compressZlib, 0)
+	}
+	return nil
+}
+
 // the server makes a handshake v10 packet
 // return handshake packet
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
@@ -1706,10 +1965,11 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 			return false, info, moerr.NewInternalError(ctx, "get auth plugin name failed")
 		}
 
-		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
+		//to switch authenticate method. caching_sha2_password is switched after the
+		//plugin of the user is known.
+		if info.clientPluginName != AuthNativePassword && info.clientPluginName != AuthCachingSha2Password {
 			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
+			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx, AuthNativePassword); err != nil {
 				return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
 			}
 			info.clientPluginName = AuthNativePassword
@@ -1740,6 +2000,14 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		}
 	}
 
+	//int<1>             zstd compression level
+	if info.capabilities&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 && pos < len(data) {
+		info.zstdCompressionLevel, _, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get zstd compression level failed")
+		}
+	}
+
 	return true, info, nil
 }
 
@@ -1880,9 +2148,9 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context) ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context, authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
@@ -1968,6 +2236,25 @@ func (mp *MysqlProtocolImpl) WriteLocalInfileRequest(filename string) error {
 	return mp.writePackets(req)
 }
 
+// WriteBinlogEvents sends every event in a packet led by the OK header.
+func (mp *MysqlProtocolImpl) WriteBinlogEvents(events [][]byte) error {
+	for _, event := range events {
+		if err := mp.beginPacket(); err != nil {
+			return err
+		}
+		if err := mp.append(defines.OKHeader); err != nil {
+			return err
+		}
+		if err := mp.append(event...); err != nil {
+			return err
+		}
+		if err := mp.finishedPacket(); err != nil {
+			return err
+		}
+	}
+	return mp.flush()
+}
+
 func (mp *MysqlProtocolImpl) sendOKPacketWithEof(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
 	okPkt := mp.makeOKPayloadWithEof(affectedRows, lastInsertId, status, warnings, message)
 	return mp.writePackets(okPkt)
@@ -2697,8 +2984,8 @@ func (mp *MysqlProtocolImpl) WriteResultSetRow(mrs *MysqlResultSet, cnt uint64)
 	defer mp.m.Unlock()
 	var err error = nil
 
-	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE use binaryRow
-	useBinaryRow := cmd == COM_STMT_EXECUTE
+	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE and COM_STMT_FETCH use binaryRow
+	useBinaryRow := cmd == COM_STMT_EXECUTE || cmd == COM_STMT_FETCH
 
 	//make rows into the batch
 	for i := uint64(0); i < cnt; i++ {
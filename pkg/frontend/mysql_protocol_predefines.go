@@ -324,6 +324,15 @@ const (
 	CLIENT_CAN_HANDLE_EXPIRED_PASSWORDS   uint32 = 0x00400000
 	CLIENT_SESSION_TRACK                  uint32 = 0x00800000
 	CLIENT_DEPRECATE_EOF                  uint32 = 0x01000000
+	CLIENT_ZSTD_COMPRESSION_ALGORITHM     uint32 = 0x04000000
+)
+
+// the flags of COM_STMT_EXECUTE
+const (
+	CURSOR_TYPE_NO_CURSOR  uint8 = 0x00
+	CURSOR_TYPE_READ_ONLY  uint8 = 0x01
+	CURSOR_TYPE_FOR_UPDATE uint8 = 0x02
+	CURSOR_TYPE_SCROLLABLE uint8 = 0x04
 )
 
 // server status
@@ -367,6 +376,8 @@ const (
 	COM_TIME                CommandType = 0x0f
 	COM_DELAYED_INSERT      CommandType = 0x10
 	COM_CHANGE_USER         CommandType = 0x11
+	COM_BINLOG_DUMP         CommandType = 0x12
+	COM_REGISTER_SLAVE      CommandType = 0x15
 	COM_STMT_PREPARE        CommandType = 0x16
 	COM_STMT_EXECUTE        CommandType = 0x17
 	COM_STMT_SEND_LONG_DATA CommandType = 0x18
@@ -375,6 +386,7 @@ const (
 	COM_SET_OPTION          CommandType = 0x1b
 	COM_STMT_FETCH          CommandType = 0x1c
 	COM_DAEMON              CommandType = 0x1d
+	COM_BINLOG_DUMP_GTID    CommandType = 0x1e
 	COM_RESET_CONNECTION    CommandType = 0x1f
 )
 
@@ -416,6 +428,10 @@ func (ct CommandType) String() string {
 		return "COM_DELAYED_INSERT"
 	case COM_CHANGE_USER:
 		return "COM_CHANGE_USER"
+	case COM_BINLOG_DUMP:
+		return "COM_BINLOG_DUMP"
+	case COM_REGISTER_SLAVE:
+		return "COM_REGISTER_SLAVE"
 	case COM_STMT_PREPARE:
 		return "COM_STMT_PREPARE"
 	case COM_STMT_EXECUTE:
@@ -432,6 +448,8 @@ func (ct CommandType) String() string {
 		return "COM_STMT_FETCH"
 	case COM_DAEMON:
 		return "COM_DAEMON"
+	case COM_BINLOG_DUMP_GTID:
+		return "COM_BINLOG_DUMP_GTID"
 	case COM_RESET_CONNECTION:
 		return "COM_RESET_CONNECTION"
 	default:
@@ -2988,6 +2988,10 @@ func (fp *testMysqlWriter) Authenticate(ctx context.Context) error {
 	return nil
 }
 
+func (fp *testMysqlWriter) HandleChangeUser(ctx context.Context, payload []byte) error {
+	return nil
+}
+
 func (fp *testMysqlWriter) GetSequenceId() uint8 {
 	return 0
 }
@@ -3048,6 +3052,10 @@ func (fp *testMysqlWriter) WriteLocalInfileRequest(filename string) error {
 	return nil
 }
 
+func (fp *testMysqlWriter) WriteBinlogEvents(events [][]byte) error {
+	return nil
+}
+
 func (fp *testMysqlWriter) Flush() error {
 	return nil
 }
@@ -3055,3 +3063,75 @@ func (fp *testMysqlWriter) Flush() error {
 func (fp *testMysqlWriter) MakeColumnDefData(ctx context.Context, columns []*planPb.ColDef) ([][]byte, error) {
 	return nil, nil
 }
+
+func TestHandleChangeUserPayload(t *testing.T) {
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	pu := config.NewParameterUnit(sv, nil, nil, nil)
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+	ioses, err := NewIOSession(server, pu)
+	require.NoError(t, err)
+	mp := NewMysqlClientProtocol("", 0, ioses, 1024, sv)
+	ctx := context.TODO()
+
+	capability := CLIENT_PROTOCOL_41 | CLIENT_SECURE_CONNECTION | CLIENT_PLUGIN_AUTH |
+		CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA | CLIENT_CONNECT_ATTRS
+	handshakeResp := make([]byte, 0, 128)
+	handshakeResp = append(handshakeResp, byte(capability), byte(capability>>8), byte(capability>>16), byte(capability>>24))
+	// max packet size, charset and the filler
+	handshakeResp = append(handshakeResp, make([]byte, 4+1+23)...)
+	handshakeResp = append(handshakeResp, "sys:root\x00"...)
+	handshakeResp = append(handshakeResp, 0)
+	handshakeResp = append(handshakeResp, AuthNativePassword+"\x00"...)
+	handshakeResp = append(handshakeResp, 0)
+	ok, resp41, err := mp.analyseHandshakeResponse41(ctx, handshakeResp)
+	require.NoError(t, err)
+	require.True(t, ok)
+	mp.capability &= resp41.capabilities
+
+	auth := bytes.Repeat([]byte{'a'}, 20)
+	changeUser := make([]byte, 0, 128)
+	changeUser = append(changeUser, "acc1:user1\x00"...)
+	changeUser = append(changeUser, byte(len(auth)))
+	changeUser = append(changeUser, auth...)
+	changeUser = append(changeUser, "db1\x00"...)
+	// the old client sends no character set and auth plugin
+	info, err := mp.analyseChangeUser(ctx, changeUser)
+	require.NoError(t, err)
+	require.Equal(t, "acc1:user1", info.username)
+	require.Equal(t, auth, info.authResponse)
+	require.Equal(t, "db1", info.database)
+	require.Equal(t, AuthNativePassword, info.clientPluginName)
+	require.Nil(t, info.connectAttrs)
+
+	changeUser = append(changeUser, utf8mb4BinCollationID, 0)
+	changeUser = append(changeUser, AuthNativePassword+"\x00"...)
+	changeUser = append(changeUser, 8, 3, 'k', 'e', 'y', 3, 'v', 'a', 'l')
+	info, err = mp.analyseChangeUser(ctx, changeUser)
+	require.NoError(t, err)
+	require.Equal(t, utf8mb4BinCollationID, info.collationID)
+	require.Equal(t, map[string]string{"key": "val"}, info.connectAttrs)
+
+	_, err = mp.analyseChangeUser(ctx, changeUser[:5])
+	require.Error(t, err)
+
+	// the handshake response rebuilt for the new user
+	payload, username, db, err := mp.MakeChangeUserHandshakeResp(ctx, handshakeResp, changeUser)
+	require.NoError(t, err)
+	require.Equal(t, "acc1:user1", username)
+	require.Equal(t, "db1", db)
+	ok, resp41, err = mp.analyseHandshakeResponse41(ctx, payload)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "acc1:user1", resp41.username)
+	require.Equal(t, auth, resp41.authResponse)
+	require.Equal(t, "db1", resp41.database)
+	require.Equal(t, AuthNativePassword, resp41.clientPluginName)
+	require.Equal(t, utf8mb4BinCollationID, resp41.collationID)
+	require.Equal(t, map[string]string{"key": "val"}, resp41.connectAttrs)
+
+	_, _, _, err = mp.MakeChangeUserHandshakeResp(ctx, handshakeResp[:10], changeUser)
+	require.Error(t, err)
+}
@@ -0,0 +1,424 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+const (
+	// sha2AuthStringPrefix is the prefix of the caching_sha2_password auth string:
+	// $A$<rounds/1000 in 3 digits>$<salt><sha256crypt hash>, the same as MySQL.
+	sha2AuthStringPrefix = "$A$005$"
+	sha2SaltLength       = 20
+	sha2HashLength       = 43
+	sha2HashRounds       = 5000
+	sha2RSAKeyBits       = 2048
+
+	// the header of the AuthMoreData packet
+	authMoreDataHeader = 0x01
+
+	// the status in the AuthMoreData packet of caching_sha2_password
+	cachingSha2RequestPublicKey = 0x02
+	cachingSha2FastAuthSuccess  = 0x03
+	cachingSha2PerformFullAuth  = 0x04
+)
+
+// sha256CryptOrder is the order of the bytes when the hash is encoded.
+var sha256CryptOrder = [10][3]int{
+	{0, 10, 20}, {21, 1, 11}, {12, 22, 2}, {3, 13, 23}, {24, 4, 14},
+	{15, 25, 5}, {6, 16, 26}, {27, 7, 17}, {18, 28, 8}, {9, 19, 29},
+}
+
+const (
+	sha2SaltChars  = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	sha2CryptChars = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// sha2AuthCache keeps SHA256(SHA256(password)) of the users who have passed the full
+// authentication, so that the next login can use the fast authentication. The entry
+// is keyed by account:user and is valid only if the auth string of the user is the
+// same as the one when it is cached, so the changed password invalidates it.
+var sha2AuthCache sync.Map
+
+type sha2AuthCacheEntry struct {
+	authString string
+	digest     []byte
+}
+
+// sha2RSAKey is the RSA key pair used to exchange the password over non-TLS connections.
+var sha2RSAKey struct {
+	sync.Once
+	key       *rsa.PrivateKey
+	publicKey []byte
+	err       error
+}
+
+// HashSha2PassWord makes the caching_sha2_password auth string of the password.
+func HashSha2PassWord(pwd string) string {
+	if len(pwd) == 0 {
+		return ""
+	}
+	salt := make([]byte, sha2SaltLength)
+	charsLen := big.NewInt(int64(len(sha2SaltChars)))
+	for i := range salt {
+		n, err := rand.Int(rand.Reader, charsLen)
+		if err != nil {
+			return ""
+		}
+		salt[i] = sha2SaltChars[n.Int64()]
+	}
+	return sha2AuthStringPrefix + string(salt) + sha256Crypt([]byte(pwd), salt, sha2HashRounds)
+}
+
+// checkSha2PassWord checks the cleartext password against the caching_sha2_password auth string.
+func checkSha2PassWord(authString string, pwd []byte) bool {
+	if len(authString) == 0 {
+		return len(pwd) == 0
+	}
+	if len(authString) != len(sha2AuthStringPrefix)+sha2SaltLength+sha2HashLength ||
+		!strings.HasPrefix(authString, sha2AuthStringPrefix) {
+		return false
+	}
+	salt := authString[len(sha2AuthStringPrefix) : len(sha2AuthStringPrefix)+sha2SaltLength]
+	hash := sha256Crypt(pwd, []byte(salt), sha2HashRounds)
+	return subtle.ConstantTimeCompare([]byte(hash), []byte(authString[len(authString)-sha2HashLength:])) == 1
+}
+
+// checkSha2Scramble checks the scramble of the fast authentication.
+// scramble = XOR(SHA256(password), SHA256(SHA256(SHA256(password)), nonce))
+// and digest = SHA256(SHA256(password)).
+func checkSha2Scramble(digest, nonce, scramble []byte) bool {
+	if len(scramble) != sha256.Size {
+		return false
+	}
+	h := sha256.New()
+	h.Write(digest)
+	h.Write(nonce)
+	stage1 := h.Sum(nil)
+	for i := range stage1 {
+		stage1[i] ^= scramble[i]
+	}
+	stage2 := sha256.Sum256(stage1)
+	return subtle.ConstantTimeCompare(stage2[:], digest) == 1
+}
+
+// sha256Crypt is the SHA-256 based crypt(3) of Ulrich Drepper without the
+// length limit of the salt, which is used by MySQL. It returns the 43 bytes hash.
+func sha256Crypt(key, salt []byte, rounds int) string {
+	b := sha256.New()
+	b.Write(key)
+	b.Write(salt)
+	b.Write(key)
+	sumB := b.Sum(nil)
+
+	a := sha256.New()
+	a.Write(key)
+	a.Write(salt)
+	for i := len(key); i > 0; i -= sha256.Size {
+		a.Write(sumB[:min(i, sha256.Size)])
+	}
+	for i := len(key); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			a.Write(sumB)
+		} else {
+			a.Write(key)
+		}
+	}
+	sumA := a.Sum(nil)
+
+	dp := sha256.New()
+	for range key {
+		dp.Write(key)
+	}
+	p := repeatBytes(dp.Sum(nil), len(key))
+
+	ds := sha256.New()
+	for i := 0; i < 16+int(sumA[0]); i++ {
+		ds.Write(salt)
+	}
+	s := repeatBytes(ds.Sum(nil), len(salt))
+
+	c := sumA
+	for i := 0; i < rounds; i++ {
+		h := sha256.New()
+		if i&1 != 0 {
+			h.Write(p)
+		} else {
+			h.Write(c)
+		}
+		if i%3 != 0 {
+			h.Write(s)
+		}
+		if i%7 != 0 {
+			h.Write(p)
+		}
+		if i&1 != 0 {
+			h.Write(c)
+		} else {
+			h.Write(p)
+		}
+		c = h.Sum(nil)
+	}
+
+	out := make([]byte, 0, sha2HashLength)
+	encode := func(b2, b1, b0 byte, n int) {
+		w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+		for ; n > 0; n-- {
+			out = append(out, sha2CryptChars[w&0x3f])
+			w >>= 6
+		}
+	}
+	for _, idx := range sha256CryptOrder {
+		encode(c[idx[0]], c[idx[1]], c[idx[2]], 4)
+	}
+	encode(0, c[31], c[30], 3)
+	return string(out)
+}
+
+func repeatBytes(src []byte, n int) []byte {
+	out := make([]byte, 0, n)
+	for len(out) < n {
+		out = append(out, src[:min(len(src), n-len(out))]...)
+	}
+	return out
+}
+
+// getSha2RSAKey returns the RSA private key and the public key in PEM format. The key
+// is loaded from the path, or generated if the path is empty.
+func getSha2RSAKey(path string) (*rsa.PrivateKey, []byte, error) {
+	sha2RSAKey.Do(func() {
+		var key *rsa.PrivateKey
+		if len(path) == 0 {
+			key, sha2RSAKey.err = rsa.GenerateKey(rand.Reader, sha2RSAKeyBits)
+		} else {
+			key, sha2RSAKey.err = loadRSAPrivateKey(path)
+		}
+		if sha2RSAKey.err != nil {
+			return
+		}
+		var der []byte
+		if der, sha2RSAKey.err = x509.MarshalPKIXPublicKey(&key.PublicKey); sha2RSAKey.err != nil {
+			return
+		}
+		sha2RSAKey.key = key
+		sha2RSAKey.publicKey = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	})
+	return sha2RSAKey.key, sha2RSAKey.publicKey, sha2RSAKey.err
+}
+
+func loadRSAPrivateKey(path string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, moerr.NewInternalErrorNoCtx("invalid RSA private key file %s", path)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("the private key in %s is not a RSA key", path)
+	}
+	return rsaKey, nil
+}
+
+// queryAuthInfoOfUser runs the query made by getSql in the account of the user and returns
+// the result. It returns nil when the tenant or the user does not exist, or the user is the
+// special user, so that the caller can report the error during the authentication.
+func queryAuthInfoOfUser(ctx context.Context, ses *Session, userInput string, getSql func(context.Context, string) (string, error)) (context.Context, ExecResult) {
+	tenant, err := GetTenantInfo(ctx, userInput)
+	if err != nil {
+		return ctx, nil
+	}
+	if isSpecial, _, _ := isSpecialUser(tenant.GetUser()); isSpecial {
+		return ctx, nil
+	}
+
+	sysTenantCtx := defines.AttachAccount(ctx, uint32(sysAccountID), uint32(rootID), uint32(moAdminRoleID))
+	sql, err := getSqlForCheckTenant(sysTenantCtx, tenant.GetTenant())
+	if err != nil {
+		return ctx, nil
+	}
+	rsset, err := executeSQLInBackgroundSession(sysTenantCtx, ses, sql)
+	if err != nil || !execResultArrayHasData(rsset) {
+		return ctx, nil
+	}
+	tenantID, err := rsset[0].GetInt64(sysTenantCtx, 0, 0)
+	if err != nil {
+		return ctx, nil
+	}
+
+	tenantCtx := defines.AttachAccountId(ctx, uint32(tenantID))
+	sql, err = getSql(tenantCtx, tenant.GetUser())
+	if err != nil {
+		return ctx, nil
+	}
+	rsset, err = executeSQLInBackgroundSession(tenantCtx, ses, sql)
+	if err != nil || !execResultArrayHasData(rsset) {
+		return ctx, nil
+	}
+	return tenantCtx, rsset[0]
+}
+
+// getAuthPluginOfUser returns the authentication plugin and the caching_sha2_password
+// auth string of the user. The plugin is empty if the user can not be found.
+func getAuthPluginOfUser(ctx context.Context, ses *Session, userInput string) (string, string) {
+	tenantCtx, rs := queryAuthInfoOfUser(ctx, ses, userInput, getSqlForAuthPluginOfUser)
+	if rs == nil {
+		return "", ""
+	}
+	plugin, err := rs.GetString(tenantCtx, 0, 0)
+	if err != nil {
+		return "", ""
+	}
+	authString, err := rs.GetString(tenantCtx, 0, 1)
+	if err != nil {
+		return "", ""
+	}
+	return strings.ToLower(plugin), authString
+}
+
+// checkAuthPlugin checks the plugin in IDENTIFIED WITH is supported.
+func checkAuthPlugin(ctx context.Context, plugin string) error {
+	switch strings.ToLower(plugin) {
+	case "", AuthNativePassword, AuthCachingSha2Password:
+		return nil
+	}
+	return moerr.NewInternalError(ctx, "unsupported authentication plugin %s", plugin)
+}
+
+// makeAuthMoreDataPayload makes the AuthMoreData packet of caching_sha2_password.
+func (mp *MysqlProtocolImpl) makeAuthMoreDataPayload(data []byte) []byte {
+	payload := make([]byte, HeaderOffset+1+len(data))
+	pos := mp.io.WriteUint8(payload, HeaderOffset, authMoreDataHeader)
+	copy(payload[pos:], data)
+	return payload
+}
+
+// authenticateSha2 runs the fast authentication or the full authentication of
+// caching_sha2_password. The client is asked to switch to caching_sha2_password
+// if it uses another plugin in the handshake response.
+func (mp *MysqlProtocolImpl) authenticateSha2(ctx context.Context, authString string) error {
+	if mp.authPlugin != AuthCachingSha2Password {
+		if mp.capability&CLIENT_PLUGIN_AUTH == 0 {
+			return moerr.NewInternalError(ctx, "the client does not support the authentication plugin %s", AuthCachingSha2Password)
+		}
+		authResponse, err := mp.negotiateAuthenticationMethod(ctx, AuthCachingSha2Password)
+		if err != nil {
+			return err
+		}
+		mp.authResponse = authResponse
+		mp.authPlugin = AuthCachingSha2Password
+	}
+
+	// the empty password
+	scramble := bytes.TrimSuffix(mp.authResponse, []byte{0})
+	if len(scramble) == 0 {
+		if len(authString) != 0 {
+			return moerr.NewInternalError(ctx, "check password failed")
+		}
+		return nil
+	}
+
+	tenant, err := GetTenantInfo(ctx, mp.GetUserName())
+	if err != nil {
+		return err
+	}
+	cacheKey := fmt.Sprintf("%s:%s", tenant.GetTenant(), tenant.GetUser())
+	if v, ok := sha2AuthCache.Load(cacheKey); ok && v.(*sha2AuthCacheEntry).authString == authString {
+		if !checkSha2Scramble(v.(*sha2AuthCacheEntry).digest, mp.GetSalt(), scramble) {
+			return moerr.NewInternalError(ctx, "check password failed")
+		}
+		return mp.writePackets(mp.makeAuthMoreDataPayload([]byte{cachingSha2FastAuthSuccess}))
+	}
+
+	// the digest is not cached, ask the client to send the password
+	if err = mp.writePackets(mp.makeAuthMoreDataPayload([]byte{cachingSha2PerformFullAuth})); err != nil {
+		return err
+	}
+	pwd, err := mp.readSha2Password(ctx)
+	if err != nil {
+		return err
+	}
+	if !checkSha2PassWord(authString, pwd) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	stage1 := sha256.Sum256(pwd)
+	digest := sha256.Sum256(stage1[:])
+	sha2AuthCache.Store(cacheKey, &sha2AuthCacheEntry{authString: authString, digest: digest[:]})
+	return nil
+}
+
+// readSha2Password reads the password of the full authentication. The password is in
+// cleartext over TLS connections, otherwise it is encrypted by the RSA public key
+// which is sent to the client on request.
+func (mp *MysqlProtocolImpl) readSha2Password(ctx context.Context) ([]byte, error) {
+	data, err := mp.tcpConn.Read()
+	if err != nil {
+		return nil, err
+	}
+	if mp.IsTlsEstablished() {
+		return bytes.TrimSuffix(data, []byte{0}), nil
+	}
+	if len(data) != 1 || data[0] != cachingSha2RequestPublicKey {
+		return nil, moerr.NewInternalError(ctx, "%s requires a secure connection or the RSA public key", AuthCachingSha2Password)
+	}
+
+	key, publicKey, err := getSha2RSAKey(mp.SV.CachingSha2PrivateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	if err = mp.writePackets(mp.makeAuthMoreDataPayload(publicKey)); err != nil {
+		return nil, err
+	}
+	data, err = mp.tcpConn.Read()
+	if err != nil {
+		return nil, err
+	}
+	pwd, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, key, data, nil)
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "decrypt the password failed: %v", err)
+	}
+	// the password is XORed with the nonce before the encryption
+	salt := mp.GetSalt()
+	for i := range pwd {
+		pwd[i] ^= salt[i%len(salt)]
+	}
+	return bytes.TrimSuffix(pwd, []byte{0}), nil
+}
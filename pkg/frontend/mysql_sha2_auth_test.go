@@ -0,0 +1,151 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+)
+
+// sha2Scramble makes the scramble of caching_sha2_password as the client does.
+func sha2Scramble(pwd, nonce []byte) []byte {
+	stage1 := sha256.Sum256(pwd)
+	stage2 := sha256.Sum256(stage1[:])
+	h := sha256.New()
+	h.Write(stage2[:])
+	h.Write(nonce)
+	scramble := h.Sum(nil)
+	for i := range scramble {
+		scramble[i] ^= stage1[i]
+	}
+	return scramble
+}
+
+func TestSha256Crypt(t *testing.T) {
+	// the test vector of the SHA-256 crypt
+	require.Equal(t, "5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5",
+		sha256Crypt([]byte("Hello world!"), []byte("saltstring"), 5000))
+}
+
+func TestHashSha2PassWord(t *testing.T) {
+	require.Equal(t, "", HashSha2PassWord(""))
+	require.True(t, checkSha2PassWord("", nil))
+
+	authString := HashSha2PassWord("111")
+	require.Len(t, authString, len(sha2AuthStringPrefix)+sha2SaltLength+sha2HashLength)
+	require.True(t, checkSha2PassWord(authString, []byte("111")))
+	require.False(t, checkSha2PassWord(authString, []byte("112")))
+	require.False(t, checkSha2PassWord(authString[1:], []byte("111")))
+	// the salt is random
+	require.NotEqual(t, authString, HashSha2PassWord("111"))
+}
+
+func TestCheckSha2Scramble(t *testing.T) {
+	nonce := []byte("01234567890123456789")
+	stage1 := sha256.Sum256([]byte("111"))
+	digest := sha256.Sum256(stage1[:])
+	require.True(t, checkSha2Scramble(digest[:], nonce, sha2Scramble([]byte("111"), nonce)))
+	require.False(t, checkSha2Scramble(digest[:], nonce, sha2Scramble([]byte("112"), nonce)))
+	require.False(t, checkSha2Scramble(digest[:], nonce, nil))
+}
+
+func TestCheckAuthPlugin(t *testing.T) {
+	require.NoError(t, checkAuthPlugin(context.TODO(), ""))
+	require.NoError(t, checkAuthPlugin(context.TODO(), "CACHING_SHA2_PASSWORD"))
+	require.NoError(t, checkAuthPlugin(context.TODO(), AuthNativePassword))
+	require.Error(t, checkAuthPlugin(context.TODO(), "sha256_password"))
+}
+
+func TestAuthenticateSha2(t *testing.T) {
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	pu := config.NewParameterUnit(sv, nil, nil, nil)
+
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+	ioses, err := NewIOSession(server, pu)
+	require.NoError(t, err)
+	mp := NewMysqlClientProtocol("", 0, ioses, 1024, sv)
+	mp.username = "sha2_acc:sha2_user"
+	clientIO, err := NewIOSession(client, pu)
+	require.NoError(t, err)
+
+	pwd := []byte("111")
+	authString := HashSha2PassWord(string(pwd))
+	nonce := mp.GetSalt()
+
+	// the full authentication with the RSA public key
+	mp.authPlugin = AuthCachingSha2Password
+	mp.authResponse = sha2Scramble(pwd, nonce)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- mp.authenticateSha2(context.TODO(), authString)
+	}()
+	data, err := clientIO.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte{authMoreDataHeader, cachingSha2PerformFullAuth}, data)
+	require.NoError(t, clientIO.Write([]byte{cachingSha2RequestPublicKey}))
+	data, err = clientIO.Read()
+	require.NoError(t, err)
+	require.Equal(t, byte(authMoreDataHeader), data[0])
+	block, _ := pem.Decode(data[1:])
+	require.NotNil(t, block)
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+	plain := append(append([]byte{}, pwd...), 0)
+	for i := range plain {
+		plain[i] ^= nonce[i%len(nonce)]
+	}
+	encrypted, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, key.(*rsa.PublicKey), plain, nil)
+	require.NoError(t, err)
+	require.NoError(t, clientIO.Write(encrypted))
+	require.NoError(t, <-errC)
+
+	// the fast authentication with the cached digest
+	go func() {
+		errC <- mp.authenticateSha2(context.TODO(), authString)
+	}()
+	data, err = clientIO.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte{authMoreDataHeader, cachingSha2FastAuthSuccess}, data)
+	require.NoError(t, <-errC)
+
+	// the wrong password fails in the fast authentication
+	mp.authResponse = sha2Scramble([]byte("112"), nonce)
+	require.Error(t, mp.authenticateSha2(context.TODO(), authString))
+
+	// the changed password invalidates the cache
+	mp.authResponse = sha2Scramble(pwd, nonce)
+	go func() {
+		errC <- mp.authenticateSha2(context.TODO(), HashSha2PassWord(string(pwd)))
+	}()
+	data, err = clientIO.Read()
+	require.NoError(t, err)
+	require.Equal(t, []byte{authMoreDataHeader, cachingSha2PerformFullAuth}, data)
+	_ = client.Close()
+	require.Error(t, <-errC)
+}
@@ -0,0 +1,270 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	pgAuthMethodScram    = "scram-sha-256"
+	pgAuthMethodPassword = "password"
+
+	scramSha256Mechanism  = "SCRAM-SHA-256"
+	scramSaltLength       = 16
+	scramNonceLength      = 18
+	scramIterationCounter = 4096
+)
+
+// scramVerifier is the server side secret of the SCRAM-SHA-256 authentication.
+// It is stored in mo_user.pg_auth_string with the same format of PostgreSQL:
+// SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey>
+type scramVerifier struct {
+	iterations int
+	salt       []byte
+	storedKey  []byte
+	serverKey  []byte
+}
+
+func (sv *scramVerifier) String() string {
+	return fmt.Sprintf("%s$%d:%s$%s:%s",
+		scramSha256Mechanism,
+		sv.iterations,
+		base64.StdEncoding.EncodeToString(sv.salt),
+		base64.StdEncoding.EncodeToString(sv.storedKey),
+		base64.StdEncoding.EncodeToString(sv.serverKey))
+}
+
+// HashPgPassWord makes the SCRAM-SHA-256 verifier of the password
+// for the PostgreSQL protocol.
+func HashPgPassWord(pwd string) string {
+	if len(pwd) == 0 {
+		return ""
+	}
+	salt := make([]byte, scramSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return ""
+	}
+	return makeScramVerifier(pwd, salt, scramIterationCounter).String()
+}
+
+func makeScramVerifier(pwd string, salt []byte, iterations int) *scramVerifier {
+	saltedPassword := pbkdf2Sha256([]byte(pwd), salt, iterations)
+	clientKey := hmacSha256(saltedPassword, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	return &scramVerifier{
+		iterations: iterations,
+		salt:       salt,
+		storedKey:  storedKey[:],
+		serverKey:  hmacSha256(saltedPassword, []byte("Server Key")),
+	}
+}
+
+func parseScramVerifier(ctx context.Context, s string) (*scramVerifier, error) {
+	invalid := moerr.NewInternalError(ctx, "invalid SCRAM-SHA-256 verifier")
+	mechanism, rest, ok := strings.Cut(s, "$")
+	if !ok || mechanism != scramSha256Mechanism {
+		return nil, invalid
+	}
+	params, keys, ok := strings.Cut(rest, "$")
+	if !ok {
+		return nil, invalid
+	}
+	iter, salt, ok := strings.Cut(params, ":")
+	if !ok {
+		return nil, invalid
+	}
+	stored, server, ok := strings.Cut(keys, ":")
+	if !ok {
+		return nil, invalid
+	}
+	sv := &scramVerifier{}
+	var err error
+	if sv.iterations, err = strconv.Atoi(iter); err != nil || sv.iterations <= 0 {
+		return nil, invalid
+	}
+	if sv.salt, err = base64.StdEncoding.DecodeString(salt); err != nil {
+		return nil, invalid
+	}
+	if sv.storedKey, err = base64.StdEncoding.DecodeString(stored); err != nil || len(sv.storedKey) != sha256.Size {
+		return nil, invalid
+	}
+	if sv.serverKey, err = base64.StdEncoding.DecodeString(server); err != nil || len(sv.serverKey) != sha256.Size {
+		return nil, invalid
+	}
+	return sv, nil
+}
+
+func hmacSha256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// pbkdf2Sha256 is the PBKDF2 with HMAC-SHA-256. SCRAM only needs one block
+// of the derived key.
+func pbkdf2Sha256(pwd, salt []byte, iterations int) []byte {
+	prf := hmac.New(sha256.New, pwd)
+	prf.Write(salt)
+	prf.Write(binary.BigEndian.AppendUint32(nil, 1))
+	u := prf.Sum(nil)
+	result := make([]byte, len(u))
+	copy(result, u)
+	for i := 1; i < iterations; i++ {
+		prf.Reset()
+		prf.Write(u)
+		u = prf.Sum(u[:0])
+		for j := range result {
+			result[j] ^= u[j]
+		}
+	}
+	return result
+}
+
+// scramServer runs the server side of the SCRAM-SHA-256 exchange (RFC 5802, RFC 7677).
+// Channel binding is not supported.
+type scramServer struct {
+	verifier        *scramVerifier
+	gs2Header       string
+	clientFirstBare string
+	serverFirst     string
+	nonce           string
+}
+
+func newScramServer(verifier *scramVerifier) *scramServer {
+	return &scramServer{verifier: verifier}
+}
+
+// handleClientFirst parses the client-first-message and returns the server-first-message.
+func (ss *scramServer) handleClientFirst(ctx context.Context, msg []byte) ([]byte, error) {
+	s := string(msg)
+	// gs2-header: gs2-cbind-flag "," [authzid] ","
+	if len(s) < 3 {
+		return nil, moerr.NewInvalidInput(ctx, "malformed SCRAM message")
+	}
+	switch s[0] {
+	case 'n', 'y':
+	case 'p':
+		return nil, moerr.NewNotSupported(ctx, "SCRAM channel binding")
+	default:
+		return nil, moerr.NewInvalidInput(ctx, "malformed SCRAM message")
+	}
+	if s[1] != ',' {
+		return nil, moerr.NewInvalidInput(ctx, "malformed SCRAM message")
+	}
+	idx := strings.IndexByte(s[2:], ',')
+	if idx < 0 {
+		return nil, moerr.NewInvalidInput(ctx, "malformed SCRAM message")
+	}
+	ss.gs2Header = s[:2+idx+1]
+	ss.clientFirstBare = s[2+idx+1:]
+
+	var clientNonce string
+	for _, attr := range strings.Split(ss.clientFirstBare, ",") {
+		if strings.HasPrefix(attr, "r=") {
+			clientNonce = attr[2:]
+		} else if strings.HasPrefix(attr, "m=") {
+			return nil, moerr.NewNotSupported(ctx, "SCRAM mandatory extension")
+		}
+	}
+	if clientNonce == "" {
+		return nil, moerr.NewInvalidInput(ctx, "SCRAM message without nonce")
+	}
+
+	serverNonce := make([]byte, scramNonceLength)
+	if _, err := rand.Read(serverNonce); err != nil {
+		return nil, err
+	}
+	ss.nonce = clientNonce + base64.StdEncoding.EncodeToString(serverNonce)
+	ss.serverFirst = fmt.Sprintf("r=%s,s=%s,i=%d",
+		ss.nonce,
+		base64.StdEncoding.EncodeToString(ss.verifier.salt),
+		ss.verifier.iterations)
+	return []byte(ss.serverFirst), nil
+}
+
+// handleClientFinal verifies the client proof and returns the server-final-message.
+func (ss *scramServer) handleClientFinal(ctx context.Context, msg []byte) ([]byte, error) {
+	s := string(msg)
+	idx := strings.LastIndex(s, ",p=")
+	if idx < 0 {
+		return nil, moerr.NewInvalidInput(ctx, "SCRAM message without proof")
+	}
+	withoutProof := s[:idx]
+	proof, err := base64.StdEncoding.DecodeString(s[idx+3:])
+	if err != nil || len(proof) != sha256.Size {
+		return nil, moerr.NewInvalidInput(ctx, "malformed SCRAM proof")
+	}
+
+	var binding, nonce string
+	for _, attr := range strings.Split(withoutProof, ",") {
+		if strings.HasPrefix(attr, "c=") {
+			binding = attr[2:]
+		} else if strings.HasPrefix(attr, "r=") {
+			nonce = attr[2:]
+		}
+	}
+	if binding != base64.StdEncoding.EncodeToString([]byte(ss.gs2Header)) {
+		return nil, moerr.NewInvalidInput(ctx, "SCRAM channel binding mismatch")
+	}
+	if nonce != ss.nonce {
+		return nil, moerr.NewInvalidInput(ctx, "SCRAM nonce mismatch")
+	}
+
+	authMessage := []byte(ss.clientFirstBare + "," + ss.serverFirst + "," + withoutProof)
+	clientSignature := hmacSha256(ss.verifier.storedKey, authMessage)
+	clientKey := make([]byte, len(proof))
+	for i := range proof {
+		clientKey[i] = proof[i] ^ clientSignature[i]
+	}
+	storedKey := sha256.Sum256(clientKey)
+	if !hmac.Equal(storedKey[:], ss.verifier.storedKey) {
+		return nil, moerr.NewInternalError(ctx, "check password failed")
+	}
+
+	serverSignature := hmacSha256(ss.verifier.serverKey, authMessage)
+	return []byte("v=" + base64.StdEncoding.EncodeToString(serverSignature)), nil
+}
+
+// checkPgPassword checks the cleartext password against SHA1(SHA1(password)) in mo_user.
+func checkPgPassword(pwd []byte, cleartext []byte) bool {
+	return len(pwd) != 0 && bytes.Equal(pwd, HashSha1(HashSha1(cleartext)))
+}
+
+// getPgAuthStringOfUser returns the SCRAM verifier of the user. It returns an empty
+// string when the tenant, the user or the verifier does not exist, so that the caller
+// can fall back to the cleartext password and report the error during authentication.
+func getPgAuthStringOfUser(ctx context.Context, ses *Session, userInput string) string {
+	tenantCtx, rs := queryAuthInfoOfUser(ctx, ses, userInput, getSqlForPgAuthStringOfUser)
+	if rs == nil {
+		return ""
+	}
+	authString, err := rs.GetString(tenantCtx, 0, 0)
+	if err != nil {
+		return ""
+	}
+	return authString
+}
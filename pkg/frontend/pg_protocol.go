@@ -0,0 +1,1710 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// the codes of the startup packets
+const (
+	pgProtocolVersion3 uint32 = 196608
+	pgCancelRequest    uint32 = 80877102
+	pgSSLRequest       uint32 = 80877103
+	pgGSSENCRequest    uint32 = 80877104
+)
+
+// the messages from the frontend
+const (
+	pgMsgQuery     byte = 'Q'
+	pgMsgParse     byte = 'P'
+	pgMsgBind      byte = 'B'
+	pgMsgDescribe  byte = 'D'
+	pgMsgExecute   byte = 'E'
+	pgMsgSync      byte = 'S'
+	pgMsgFlush     byte = 'H'
+	pgMsgClose     byte = 'C'
+	pgMsgTerminate byte = 'X'
+	pgMsgPassword  byte = 'p'
+)
+
+// the messages from the backend
+const (
+	pgMsgAuthentication       byte = 'R'
+	pgMsgParameterStatus      byte = 'S'
+	pgMsgBackendKeyData       byte = 'K'
+	pgMsgReadyForQuery        byte = 'Z'
+	pgMsgRowDescription       byte = 'T'
+	pgMsgDataRow              byte = 'D'
+	pgMsgCommandComplete      byte = 'C'
+	pgMsgErrorResponse        byte = 'E'
+	pgMsgEmptyQueryResponse   byte = 'I'
+	pgMsgParseComplete        byte = '1'
+	pgMsgBindComplete         byte = '2'
+	pgMsgCloseComplete        byte = '3'
+	pgMsgParameterDescription byte = 't'
+	pgMsgNoData               byte = 'n'
+	pgMsgPortalSuspended      byte = 's'
+)
+
+// the authentication requests
+const (
+	pgAuthOk                uint32 = 0
+	pgAuthCleartextPassword uint32 = 3
+	pgAuthSASL              uint32 = 10
+	pgAuthSASLContinue      uint32 = 11
+	pgAuthSASLFinal         uint32 = 12
+)
+
+const (
+	pgServerVersion                   = "13.0"
+	pgMaxMessageLength                = 1 << 30
+	pgMaxStartupMessageLength         = 10000
+	pgFlushThreshold                  = 1 << 20
+	pgSqlStateInvalidPassword         = "28P01"
+	pgSqlStateProtocolViolation       = "08P01"
+	pgSqlStateInternalError           = "XX000"
+	pgSqlStateDuplicatePreparedStmt   = "42P05"
+	pgSqlStateInvalidCursorName       = "34000"
+	pgSqlStateInvalidPreparedStmtName = "26000"
+)
+
+// pgStatement is the prepared statement created by the Parse message.
+type pgStatement struct {
+	name string
+	// query is the original query from the client
+	query string
+	// prepare is the prepared statement in the session. It is nil for the empty query.
+	prepare *PrepareStmt
+	stmtID  uint32
+	// paramOrder is the parameter index ($n - 1) of every placeholder in the rewritten query
+	paramOrder []int
+	// paramOIDs is the types of the parameters $1...$n
+	paramOIDs []uint32
+	// columns is the result columns of the statement
+	columns []*MysqlColumn
+}
+
+// pgPortal is the bound statement created by the Bind message.
+type pgPortal struct {
+	name          string
+	stmt          *pgStatement
+	values        []any
+	resultFormats []int16
+
+	// the rows are buffered when the Execute message limits the count of the rows
+	buffering bool
+	suspended bool
+	tag       string
+	pending   [][]byte
+}
+
+func (portal *pgPortal) resultFormat(i int) int16 {
+	if len(portal.resultFormats) == 0 {
+		return pgFormatText
+	} else if len(portal.resultFormats) == 1 {
+		return portal.resultFormats[0]
+	} else if i < len(portal.resultFormats) {
+		return portal.resultFormats[i]
+	}
+	return pgFormatText
+}
+
+// PgProtocolImpl implements the PostgreSQL frontend/backend protocol v3.
+// It serves the statements with the same session machinery as the MySQL protocol.
+// The responses of the session are translated into the PostgreSQL messages.
+type PgProtocolImpl struct {
+	m sync.Mutex
+
+	sid          string
+	tcpConn      *Conn
+	ses          *Session
+	ctx          context.Context
+	SV           *config.FrontendParameters
+	connectionID uint32
+	secretKey    uint32
+
+	username      string
+	database      string
+	startupParams map[string]string
+	authString    []byte
+
+	established    atomic.Bool
+	tlsEstablished atomic.Bool
+	quit           atomic.Bool
+
+	// out holds the messages that have not been flushed
+	out        []byte
+	writeBytes uint64
+
+	// the result set in sending
+	columns     []*MysqlColumn
+	columnTypes []uint32
+	rowCount    uint64
+
+	// the extended query
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+	// curPortal is the portal in executing
+	curPortal *pgPortal
+	// prepared is the prepared statement from the response of the Parse message
+	prepared *PrepareStmt
+	// failed denotes an ErrorResponse has been sent for the current message
+	failed bool
+	// skipUntilSync discards the messages until the Sync message after an error
+	skipUntilSync bool
+}
+
+var _ MysqlRrWr = &PgProtocolImpl{}
+
+func NewPgProtocol(sid string, connectionID uint32, tcp *Conn, SV *config.FrontendParameters) *PgProtocolImpl {
+	var key [4]byte
+	_, _ = rand.Read(key[:])
+	return &PgProtocolImpl{
+		sid:           sid,
+		tcpConn:       tcp,
+		connectionID:  connectionID,
+		secretKey:     binary.BigEndian.Uint32(key[:]),
+		SV:            SV,
+		startupParams: make(map[string]string),
+		statements:    make(map[string]*pgStatement),
+		portals:       make(map[string]*pgPortal),
+	}
+}
+
+func (pp *PgProtocolImpl) GetSession() *Session {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.ses
+}
+
+func (pp *PgProtocolImpl) SetSession(ses *Session) {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.ses = ses
+}
+
+func (pp *PgProtocolImpl) Peer() string {
+	if pp.tcpConn == nil {
+		return ""
+	}
+	return pp.tcpConn.RemoteAddress()
+}
+
+func (pp *PgProtocolImpl) GetStr(id PropertyID) string {
+	switch id {
+	case USERNAME:
+		return pp.username
+	case DBNAME:
+		return pp.database
+	case PEER:
+		return pp.Peer()
+	case AuthString:
+		return string(pp.authString)
+	}
+	return ""
+}
+
+func (pp *PgProtocolImpl) SetStr(id PropertyID, val string) {
+	switch id {
+	case USERNAME:
+		pp.username = val
+	case DBNAME:
+		pp.database = val
+	}
+}
+
+func (pp *PgProtocolImpl) SetU32(id PropertyID, v uint32) {
+	switch id {
+	case CONNID:
+		pp.connectionID = v
+	}
+}
+
+func (pp *PgProtocolImpl) GetU32(id PropertyID) uint32 {
+	switch id {
+	case CONNID:
+		return pp.connectionID
+	}
+	return 0
+}
+
+func (pp *PgProtocolImpl) SetU8(PropertyID, uint8) {}
+
+func (pp *PgProtocolImpl) GetU8(PropertyID) uint8 {
+	return 0
+}
+
+func (pp *PgProtocolImpl) SetBool(id PropertyID, val bool) {
+	switch id {
+	case ESTABLISHED:
+		pp.established.Store(val)
+	case TLS_ESTABLISHED:
+		pp.tlsEstablished.Store(val)
+	}
+}
+
+func (pp *PgProtocolImpl) GetBool(id PropertyID) bool {
+	switch id {
+	case ESTABLISHED:
+		return pp.established.Load()
+	case TLS_ESTABLISHED:
+		return pp.tlsEstablished.Load()
+	}
+	return false
+}
+
+func (pp *PgProtocolImpl) UpdateCtx(ctx context.Context) {
+	pp.ctx = ctx
+}
+
+func (pp *PgProtocolImpl) Close() {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	if !pp.quit.Swap(true) && pp.tcpConn != nil {
+		_ = pp.tcpConn.Disconnect()
+	}
+	pp.out = nil
+	pp.ses = nil
+	if pp.tcpConn != nil {
+		pp.tcpConn.ses = nil
+	}
+}
+
+func (pp *PgProtocolImpl) Reset(ses *Session) {
+	pp.SetSession(ses)
+	pp.ResetStatistics()
+}
+
+func (pp *PgProtocolImpl) ResetStatistics() {
+	pp.writeBytes = 0
+}
+
+func (pp *PgProtocolImpl) CalculateOutTrafficBytes(reset bool) (bytes int64, packets int64) {
+	ses := pp.GetSession()
+	if ses == nil {
+		if pp.quit.Load() {
+			return 0, 0
+		}
+		return -1, -1
+	}
+	resultSetPart := int64(pp.writeBytes)
+	csvPart := ses.writeCsvBytes.Load()
+	bytes = resultSetPart + csvPart
+	packets = ses.GetPacketCnt() +
+		int64(len(ses.sql)>>14) + int64(ses.payloadCounter>>14) +
+		resultSetPart>>bit4TcpWriteCopy + int64((csvPart>>20)/getGlobalPu().SV.ExportDataDefaultFlushSize)
+	if reset {
+		ses.ResetPacketCounter()
+	}
+	return
+}
+
+// ---------------------------------------------------------------------------
+// reading and writing the messages
+// ---------------------------------------------------------------------------
+
+// readMessage reads a regular message: type, int32 length and the body.
+func (pp *PgProtocolImpl) readMessage() (byte, []byte, error) {
+	var header [5]byte
+	if err := pp.tcpConn.ReadBytes(header[:], len(header)); err != nil {
+		return 0, nil, err
+	}
+	length := int(binary.BigEndian.Uint32(header[1:]))
+	if length < 4 || length > pgMaxMessageLength {
+		return 0, nil, moerr.NewInvalidInputNoCtx("invalid message length %d", length)
+	}
+	body := make([]byte, length-4)
+	if err := pp.tcpConn.ReadBytes(body, len(body)); err != nil {
+		return 0, nil, err
+	}
+	return header[0], body, nil
+}
+
+// readStartupMessage reads the untyped startup message: int32 length and the body.
+func (pp *PgProtocolImpl) readStartupMessage() ([]byte, error) {
+	var header [4]byte
+	if err := pp.tcpConn.ReadBytes(header[:], len(header)); err != nil {
+		return nil, err
+	}
+	length := int(binary.BigEndian.Uint32(header[:]))
+	if length < 8 || length > pgMaxStartupMessageLength {
+		return nil, moerr.NewInvalidInputNoCtx("invalid startup packet length %d", length)
+	}
+	body := make([]byte, length-4)
+	if err := pp.tcpConn.ReadBytes(body, len(body)); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// Read reads a message and returns the type followed by the body.
+func (pp *PgProtocolImpl) Read() ([]byte, error) {
+	typ, body, err := pp.readMessage()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{typ}, body...), nil
+}
+
+func (pp *PgProtocolImpl) ReadLoadLocalPacket() ([]byte, error) {
+	return nil, moerr.NewNotSupportedNoCtx("LOAD DATA LOCAL over the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) Free(buf []byte) {}
+
+func (pp *PgProtocolImpl) beginMessage(typ byte) int {
+	pp.out = append(pp.out, typ, 0, 0, 0, 0)
+	return len(pp.out) - 4
+}
+
+func (pp *PgProtocolImpl) endMessage(start int) {
+	binary.BigEndian.PutUint32(pp.out[start:], uint32(len(pp.out)-start))
+}
+
+func (pp *PgProtocolImpl) writeMessage(typ byte, body ...[]byte) {
+	start := pp.beginMessage(typ)
+	for _, b := range body {
+		pp.out = append(pp.out, b...)
+	}
+	pp.endMessage(start)
+}
+
+func (pp *PgProtocolImpl) flush() error {
+	if len(pp.out) == 0 {
+		return nil
+	}
+	out := pp.out
+	pp.out = pp.out[:0]
+	pp.writeBytes += uint64(len(out))
+	if pp.quit.Load() {
+		return moerr.NewInternalErrorNoCtx("the connection has been closed")
+	}
+	return pp.tcpConn.WriteToConn(out)
+}
+
+func (pp *PgProtocolImpl) flushIfFull() error {
+	if len(pp.out) >= pgFlushThreshold {
+		return pp.flush()
+	}
+	return nil
+}
+
+func appendCString(buf []byte, s string) []byte {
+	buf = append(buf, s...)
+	return append(buf, 0)
+}
+
+func appendInt16(buf []byte, v int16) []byte {
+	return binary.BigEndian.AppendUint16(buf, uint16(v))
+}
+
+func appendInt32(buf []byte, v int32) []byte {
+	return binary.BigEndian.AppendUint32(buf, uint32(v))
+}
+
+// pgMessageReader reads the fields of a message body.
+type pgMessageReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *pgMessageReader) malformed() {
+	if r.err == nil {
+		r.err = moerr.NewInvalidInputNoCtx("malformed message")
+	}
+}
+
+func (r *pgMessageReader) cstring() string {
+	if r.err != nil {
+		return ""
+	}
+	idx := bytes.IndexByte(r.data[r.pos:], 0)
+	if idx < 0 {
+		r.malformed()
+		return ""
+	}
+	s := string(r.data[r.pos : r.pos+idx])
+	r.pos += idx + 1
+	return s
+}
+
+func (r *pgMessageReader) byte1() byte {
+	if r.err != nil || r.pos+1 > len(r.data) {
+		r.malformed()
+		return 0
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *pgMessageReader) int16() int16 {
+	if r.err != nil || r.pos+2 > len(r.data) {
+		r.malformed()
+		return 0
+	}
+	v := int16(binary.BigEndian.Uint16(r.data[r.pos:]))
+	r.pos += 2
+	return v
+}
+
+func (r *pgMessageReader) int32() int32 {
+	if r.err != nil || r.pos+4 > len(r.data) {
+		r.malformed()
+		return 0
+	}
+	v := int32(binary.BigEndian.Uint32(r.data[r.pos:]))
+	r.pos += 4
+	return v
+}
+
+func (r *pgMessageReader) bytes(n int) []byte {
+	if r.err != nil || n < 0 || r.pos+n > len(r.data) {
+		r.malformed()
+		return nil
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+// ---------------------------------------------------------------------------
+// startup and authentication
+// ---------------------------------------------------------------------------
+
+// handshake processes the startup messages until the StartupMessage arrives.
+// It returns false when the connection is a CancelRequest which needs no more processing.
+func (pp *PgProtocolImpl) handshake(ctx context.Context, tlsConfig *tls.Config) (bool, error) {
+	for {
+		body, err := pp.readStartupMessage()
+		if err != nil {
+			return false, err
+		}
+		r := &pgMessageReader{data: body}
+		code := uint32(r.int32())
+		switch code {
+		case pgSSLRequest:
+			if pp.SV.EnableTls && tlsConfig != nil && !pp.GetBool(TLS_ESTABLISHED) {
+				if err = pp.tcpConn.WriteToConn([]byte{'S'}); err != nil {
+					return false, err
+				}
+				tlsConn := tls.Server(pp.tcpConn.RawConn(), tlsConfig)
+				tlsCtx, cancel := context.WithTimeout(ctx, 20*time.Second)
+				err = tlsConn.HandshakeContext(tlsCtx)
+				cancel()
+				if err != nil {
+					return false, err
+				}
+				pp.tcpConn.UseConn(tlsConn)
+				pp.SetBool(TLS_ESTABLISHED, true)
+			} else if err = pp.tcpConn.WriteToConn([]byte{'N'}); err != nil {
+				return false, err
+			}
+		case pgGSSENCRequest:
+			if err = pp.tcpConn.WriteToConn([]byte{'N'}); err != nil {
+				return false, err
+			}
+		case pgCancelRequest:
+			processID := uint32(r.int32())
+			secretKey := uint32(r.int32())
+			if r.err != nil {
+				return false, r.err
+			}
+			cancelPgRequest(processID, secretKey)
+			return false, nil
+		case pgProtocolVersion3:
+			for {
+				key := r.cstring()
+				if r.err != nil {
+					return false, r.err
+				}
+				if key == "" {
+					break
+				}
+				pp.startupParams[key] = r.cstring()
+			}
+			pp.username = pp.startupParams["user"]
+			pp.database = pp.startupParams["database"]
+			if pp.username == "" {
+				err = moerr.NewInvalidInput(ctx, "no user name specified in the startup packet")
+				pp.writeError("FATAL", pgSqlStateInvalidPassword, err.Error())
+				_ = pp.flush()
+				return false, err
+			}
+			return true, nil
+		default:
+			err = moerr.NewNotSupported(ctx, "frontend protocol %d.%d", code>>16, code&0xffff)
+			pp.writeError("FATAL", pgSqlStateProtocolViolation, err.Error())
+			_ = pp.flush()
+			return false, err
+		}
+	}
+}
+
+// cancelPgRequest cancels the running query of the connection with the same backend key.
+func cancelPgRequest(processID, secretKey uint32) {
+	rm := getGlobalRtMgr()
+	if rm == nil {
+		return
+	}
+	rt := rm.getRoutineByConnID(processID)
+	if rt == nil {
+		return
+	}
+	if pro, ok := rt.getProtocol().(*PgProtocolImpl); ok && pro.secretKey == secretKey {
+		rt.killQuery(false, "")
+	}
+}
+
+func (pp *PgProtocolImpl) WriteHandshake() error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
+	return false, nil
+}
+
+func (pp *PgProtocolImpl) HandleChangeUser(ctx context.Context, payload []byte) error {
+	return moerr.NewNotSupported(ctx, "change user over the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) Authenticate(ctx context.Context) error {
+	ses := pp.GetSession()
+	ses.timestampMap[TSAuthenticateStart] = time.Now()
+	defer func() {
+		ses.timestampMap[TSAuthenticateEnd] = time.Now()
+		v2.AuthenticateDurationHistogram.Observe(ses.timestampMap[TSAuthenticateEnd].Sub(ses.timestampMap[TSAuthenticateStart]).Seconds())
+	}()
+
+	ses.Debugf(ctx, "authenticate user")
+	err := pp.authenticateUser(ctx)
+	auditConnect(ctx, ses, err)
+	if err != nil {
+		ses.Errorf(ctx, "authenticate user failed.error:%v", err)
+		_, sqlState, msg := RewriteError(err, pp.username)
+		if sqlState == "28000" {
+			sqlState = pgSqlStateInvalidPassword
+		}
+		pp.writeError("FATAL", sqlState, msg)
+		if err2 := pp.flush(); err2 != nil {
+			ses.Errorf(ctx, "send error response failed.error:%v", err2)
+			return err2
+		}
+		return err
+	}
+
+	pp.writeMessage(pgMsgAuthentication, appendInt32(nil, int32(pgAuthOk)))
+	params := [][2]string{
+		{"server_version", pgServerVersion},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"IntervalStyle", "postgres"},
+		{"TimeZone", "UTC"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"is_superuser", "off"},
+		{"session_authorization", pp.username},
+		{"application_name", pp.startupParams["application_name"]},
+	}
+	for _, p := range params {
+		pp.writeMessage(pgMsgParameterStatus, appendCString(appendCString(nil, p[0]), p[1]))
+	}
+	pp.writeMessage(pgMsgBackendKeyData,
+		appendInt32(appendInt32(nil, int32(pp.connectionID)), int32(pp.secretKey)))
+	pp.writeReadyForQuery()
+	ses.Debugf(ctx, "handle startup message ok")
+	return pp.flush()
+}
+
+func (pp *PgProtocolImpl) authenticateUser(ctx context.Context) error {
+	ses := pp.GetSession()
+	if pp.SV.SkipCheckUser {
+		ses.Debugf(ctx, "skip authenticate user")
+		tenant, err := GetTenantInfo(ctx, pp.username)
+		if err != nil {
+			return err
+		}
+		ses.SetTenantInfo(tenant)
+		return nil
+	}
+
+	var verifier *scramVerifier
+	var err error
+	if pp.SV.PgAuthMethod == pgAuthMethodScram {
+		if authString := getPgAuthStringOfUser(ctx, ses, pp.username); authString != "" {
+			if verifier, err = parseScramVerifier(ctx, authString); err != nil {
+				return err
+			}
+		}
+	}
+
+	var checkPassword func(pwd, salt, auth []byte) bool
+	if verifier != nil {
+		// the wrong password is reported by AuthenticateUser, which counts
+		// the failed logins.
+		if err = pp.scramAuthenticate(ctx, verifier); err != nil && !isCheckPasswordFailed(err) {
+			return err
+		}
+		// the proof of the client has been verified by the SCRAM exchange
+		passed := err == nil
+		checkPassword = func(pwd, salt, auth []byte) bool {
+			return passed
+		}
+	} else {
+		if !pp.cleartextPasswordAllowed() {
+			return moerr.NewInternalError(ctx,
+				"the password of user %s can only be sent in cleartext over TLS", pp.username)
+		}
+		cleartext, err := pp.readCleartextPassword(ctx)
+		if err != nil {
+			return err
+		}
+		checkPassword = func(pwd, salt, auth []byte) bool {
+			return checkPgPassword(pwd, cleartext)
+		}
+	}
+
+	psw, err := ses.AuthenticateUser(ctx, pp.username, pp.database, nil, nil, checkPassword)
+	if err != nil {
+		return err
+	}
+	pp.authString = psw
+
+	// the special users skip the password checking in AuthenticateUser
+	if !checkPassword(psw, nil, nil) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	ses.Debugf(ctx, "check password succeeded")
+	return ses.InitSystemVariables(ctx)
+}
+
+// readPasswordMessage requests the authentication and reads the PasswordMessage/SASLResponse.
+func (pp *PgProtocolImpl) readPasswordMessage(ctx context.Context) ([]byte, error) {
+	if err := pp.flush(); err != nil {
+		return nil, err
+	}
+	typ, body, err := pp.readMessage()
+	if err != nil {
+		return nil, err
+	}
+	if typ != pgMsgPassword {
+		return nil, moerr.NewInvalidInput(ctx, "expected password response, got message type %c", typ)
+	}
+	return body, nil
+}
+
+// cleartextPasswordAllowed tells whether the client may send the password in
+// cleartext, which is only done over TLS unless the config allows it.
+func (pp *PgProtocolImpl) cleartextPasswordAllowed() bool {
+	return pp.tlsEstablished.Load() || pp.SV.PgAllowCleartextPassword
+}
+
+func (pp *PgProtocolImpl) readCleartextPassword(ctx context.Context) ([]byte, error) {
+	pp.writeMessage(pgMsgAuthentication, appendInt32(nil, int32(pgAuthCleartextPassword)))
+	body, err := pp.readPasswordMessage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := &pgMessageReader{data: body}
+	password := r.cstring()
+	if r.err != nil {
+		return nil, r.err
+	}
+	return []byte(password), nil
+}
+
+func (pp *PgProtocolImpl) scramAuthenticate(ctx context.Context, verifier *scramVerifier) error {
+	mechanisms := appendCString(nil, scramSha256Mechanism)
+	mechanisms = append(mechanisms, 0)
+	pp.writeMessage(pgMsgAuthentication, appendInt32(nil, int32(pgAuthSASL)), mechanisms)
+	body, err := pp.readPasswordMessage(ctx)
+	if err != nil {
+		return err
+	}
+	r := &pgMessageReader{data: body}
+	mechanism := r.cstring()
+	length := r.int32()
+	clientFirst := r.bytes(int(length))
+	if r.err != nil {
+		return r.err
+	}
+	if mechanism != scramSha256Mechanism {
+		return moerr.NewNotSupported(ctx, "SASL mechanism %s", mechanism)
+	}
+
+	ss := newScramServer(verifier)
+	serverFirst, err := ss.handleClientFirst(ctx, clientFirst)
+	if err != nil {
+		return err
+	}
+	pp.writeMessage(pgMsgAuthentication, appendInt32(nil, int32(pgAuthSASLContinue)), serverFirst)
+	clientFinal, err := pp.readPasswordMessage(ctx)
+	if err != nil {
+		return err
+	}
+	serverFinal, err := ss.handleClientFinal(ctx, clientFinal)
+	if err != nil {
+		return err
+	}
+	pp.writeMessage(pgMsgAuthentication, appendInt32(nil, int32(pgAuthSASLFinal)), serverFinal)
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// the message loop
+// ---------------------------------------------------------------------------
+
+// serve reads and processes the messages until the client terminates the connection.
+func (pp *PgProtocolImpl) serve(rt *Routine) error {
+	for {
+		typ, body, err := pp.readMessage()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err = pp.handleMessage(rt, typ, body); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func (pp *PgProtocolImpl) handleMessage(rt *Routine, typ byte, body []byte) error {
+	ctx := rt.getCancelRoutineCtx()
+	if pp.skipUntilSync && typ != pgMsgSync && typ != pgMsgTerminate {
+		return nil
+	}
+	var err error
+	switch typ {
+	case pgMsgQuery:
+		err = pp.handleQuery(rt, body)
+	case pgMsgParse:
+		err = pp.handleParse(ctx, rt, body)
+	case pgMsgBind:
+		err = pp.handleBind(ctx, body)
+	case pgMsgDescribe:
+		err = pp.handleDescribe(ctx, body)
+	case pgMsgExecute:
+		err = pp.handleExecute(ctx, rt, body)
+	case pgMsgClose:
+		err = pp.handleClose(ctx, rt, body)
+	case pgMsgSync:
+		pp.skipUntilSync = false
+		pp.writeReadyForQuery()
+		return pp.flush()
+	case pgMsgFlush:
+		return pp.flush()
+	case pgMsgTerminate:
+		return io.EOF
+	default:
+		pp.writeError("ERROR", pgSqlStateProtocolViolation, fmt.Sprintf("unsupported frontend message type %c", typ))
+		if typ != pgMsgPassword {
+			pp.skipUntilSync = true
+		}
+		return pp.flush()
+	}
+	if err != nil {
+		return err
+	}
+	if pp.failed && typ != pgMsgQuery {
+		pp.skipUntilSync = true
+	}
+	return pp.flushIfFull()
+}
+
+// execRequest runs the request on the routine like RoutineManager.Handler does for the MySQL packets.
+func (pp *PgProtocolImpl) execRequest(rt *Routine, cmd CommandType, data []byte) error {
+	pp.columns = nil
+	pp.columnTypes = pp.columnTypes[:0]
+	pp.rowCount = 0
+	rt.updateGoroutineId()
+	rt.setInProcessRequest(true)
+	defer rt.setInProcessRequest(false)
+	return rt.handleRequest(&Request{cmd: cmd, data: data})
+}
+
+// isEmptyPgQuery checks the query contains nothing but the spaces and semicolons.
+func isEmptyPgQuery(sql string) bool {
+	return strings.TrimSpace(strings.ReplaceAll(sql, ";", "")) == ""
+}
+
+func (pp *PgProtocolImpl) handleQuery(rt *Routine, body []byte) error {
+	r := &pgMessageReader{data: body}
+	sql := r.cstring()
+	if r.err != nil {
+		return r.err
+	}
+	pp.failed = false
+	pp.curPortal = nil
+	if isEmptyPgQuery(sql) {
+		pp.writeMessage(pgMsgEmptyQueryResponse)
+	} else {
+		query, _ := convertPgQuery(sql)
+		if err := pp.execRequest(rt, COM_QUERY, []byte(query)); err != nil {
+			return err
+		}
+	}
+	pp.writeReadyForQuery()
+	return pp.flush()
+}
+
+func (pp *PgProtocolImpl) handleParse(ctx context.Context, rt *Routine, body []byte) error {
+	r := &pgMessageReader{data: body}
+	name := r.cstring()
+	query := r.cstring()
+	n := int(r.int16())
+	declared := make([]uint32, 0, n)
+	for i := 0; i < n; i++ {
+		declared = append(declared, uint32(r.int32()))
+	}
+	if r.err != nil {
+		return r.err
+	}
+
+	pp.failed = false
+	if old, ok := pp.statements[name]; ok {
+		if name != "" {
+			pp.writeError("ERROR", pgSqlStateDuplicatePreparedStmt, fmt.Sprintf("prepared statement \"%s\" already exists", name))
+			return nil
+		}
+		if err := pp.closeStatement(rt, old); err != nil {
+			return err
+		}
+	}
+
+	stmt := &pgStatement{name: name, query: query}
+	if !isEmptyPgQuery(query) {
+		converted, order := convertPgQuery(query)
+		pp.prepared = nil
+		pp.curPortal = nil
+		if err := pp.execRequest(rt, COM_STMT_PREPARE, []byte(converted)); err != nil {
+			return err
+		}
+		if pp.failed {
+			return nil
+		}
+		if pp.prepared == nil {
+			pp.writeError("ERROR", pgSqlStateInternalError, "the statement can not be prepared")
+			return nil
+		}
+		stmt.prepare = pp.prepared
+		stmt.paramOrder = order
+		pp.prepared = nil
+		if err := pp.describePrepared(ctx, stmt, declared); err != nil {
+			pp.writeErr(err)
+			return pp.closeStatement(rt, stmt)
+		}
+	}
+	pp.statements[name] = stmt
+	pp.writeMessage(pgMsgParseComplete)
+	return nil
+}
+
+// describePrepared fills the statement id, the parameter types and the result columns.
+func (pp *PgProtocolImpl) describePrepared(ctx context.Context, stmt *pgStatement, declared []uint32) error {
+	id, err := GetPrepareStmtID(ctx, stmt.prepare.Name)
+	if err != nil {
+		return err
+	}
+	stmt.stmtID = uint32(id)
+
+	dcPrepare, ok := stmt.prepare.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	paramTypes := dcPrepare.Prepare.ParamTypes
+	if len(paramTypes) != len(stmt.paramOrder) {
+		return moerr.NewInvalidInput(ctx, "the statement has %d parameters, but got %d placeholders", len(paramTypes), len(stmt.paramOrder))
+	}
+
+	numParams := len(declared)
+	for _, idx := range stmt.paramOrder {
+		if idx+1 > numParams {
+			numParams = idx + 1
+		}
+	}
+	stmt.paramOIDs = make([]uint32, numParams)
+	copy(stmt.paramOIDs, declared)
+	for i, idx := range stmt.paramOrder {
+		if stmt.paramOIDs[idx] == 0 {
+			stmt.paramOIDs[idx] = pgTypeOfEngineType(types.T(paramTypes[i]))
+		}
+	}
+	for i := range stmt.paramOIDs {
+		if stmt.paramOIDs[i] == 0 {
+			stmt.paramOIDs[i] = pgTypeUnknown
+		}
+	}
+
+	for _, col := range plan2.GetResultColumnsFromPlan(dcPrepare.Prepare.Plan) {
+		c, err := colDef2MysqlColumn(ctx, col)
+		if err != nil {
+			return err
+		}
+		stmt.columns = append(stmt.columns, c)
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) closeStatement(rt *Routine, stmt *pgStatement) error {
+	delete(pp.statements, stmt.name)
+	for name, portal := range pp.portals {
+		if portal.stmt == stmt {
+			delete(pp.portals, name)
+		}
+	}
+	if stmt.prepare == nil {
+		return nil
+	}
+	pp.curPortal = nil
+	return pp.execRequest(rt, COM_STMT_CLOSE, binary.LittleEndian.AppendUint32(nil, stmt.stmtID))
+}
+
+func (pp *PgProtocolImpl) handleBind(ctx context.Context, body []byte) error {
+	r := &pgMessageReader{data: body}
+	portalName := r.cstring()
+	stmtName := r.cstring()
+	formats := make([]int16, r.int16())
+	for i := range formats {
+		formats[i] = r.int16()
+	}
+	numParams := int(r.int16())
+	params := make([][]byte, numParams)
+	for i := 0; i < numParams; i++ {
+		length := r.int32()
+		if length >= 0 {
+			params[i] = r.bytes(int(length))
+		}
+	}
+	resultFormats := make([]int16, r.int16())
+	for i := range resultFormats {
+		resultFormats[i] = r.int16()
+	}
+	if r.err != nil {
+		return r.err
+	}
+
+	pp.failed = false
+	stmt, ok := pp.statements[stmtName]
+	if !ok {
+		pp.writeError("ERROR", pgSqlStateInvalidPreparedStmtName, fmt.Sprintf("prepared statement \"%s\" does not exist", stmtName))
+		return nil
+	}
+	if _, ok = pp.portals[portalName]; ok && portalName != "" {
+		pp.writeError("ERROR", pgSqlStateInvalidCursorName, fmt.Sprintf("portal \"%s\" already exists", portalName))
+		return nil
+	}
+	if numParams != len(stmt.paramOIDs) {
+		pp.writeError("ERROR", pgSqlStateProtocolViolation,
+			fmt.Sprintf("bind message supplies %d parameters, but prepared statement \"%s\" requires %d", numParams, stmtName, len(stmt.paramOIDs)))
+		return nil
+	}
+
+	portal := &pgPortal{
+		name:          portalName,
+		stmt:          stmt,
+		values:        make([]any, numParams),
+		resultFormats: resultFormats,
+	}
+	for i := 0; i < numParams; i++ {
+		format := pgFormatText
+		if len(formats) == 1 {
+			format = formats[0]
+		} else if i < len(formats) {
+			format = formats[i]
+		}
+		val, err := decodePgParam(ctx, stmt.paramOIDs[i], format, params[i])
+		if err != nil {
+			pp.writeErr(err)
+			return nil
+		}
+		portal.values[i] = val
+	}
+	pp.portals[portalName] = portal
+	pp.writeMessage(pgMsgBindComplete)
+	return nil
+}
+
+func (pp *PgProtocolImpl) handleDescribe(ctx context.Context, body []byte) error {
+	r := &pgMessageReader{data: body}
+	kind := r.byte1()
+	name := r.cstring()
+	if r.err != nil {
+		return r.err
+	}
+
+	pp.failed = false
+	switch kind {
+	case 'S':
+		stmt, ok := pp.statements[name]
+		if !ok {
+			pp.writeError("ERROR", pgSqlStateInvalidPreparedStmtName, fmt.Sprintf("prepared statement \"%s\" does not exist", name))
+			return nil
+		}
+		buf := appendInt16(nil, int16(len(stmt.paramOIDs)))
+		for _, oid := range stmt.paramOIDs {
+			buf = appendInt32(buf, int32(oid))
+		}
+		pp.writeMessage(pgMsgParameterDescription, buf)
+		pp.writeStatementColumns(stmt, nil)
+	case 'P':
+		portal, ok := pp.portals[name]
+		if !ok {
+			pp.writeError("ERROR", pgSqlStateInvalidCursorName, fmt.Sprintf("portal \"%s\" does not exist", name))
+			return nil
+		}
+		pp.writeStatementColumns(portal.stmt, portal)
+	default:
+		pp.writeError("ERROR", pgSqlStateProtocolViolation, fmt.Sprintf("invalid DESCRIBE message subtype %d", kind))
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) writeStatementColumns(stmt *pgStatement, portal *pgPortal) {
+	if len(stmt.columns) == 0 {
+		pp.writeMessage(pgMsgNoData)
+		return
+	}
+	pp.setColumns(stmt.columns)
+	pp.writeRowDescription(portal)
+}
+
+func (pp *PgProtocolImpl) handleExecute(ctx context.Context, rt *Routine, body []byte) error {
+	r := &pgMessageReader{data: body}
+	name := r.cstring()
+	maxRows := int(r.int32())
+	if r.err != nil {
+		return r.err
+	}
+
+	pp.failed = false
+	portal, ok := pp.portals[name]
+	if !ok {
+		pp.writeError("ERROR", pgSqlStateInvalidCursorName, fmt.Sprintf("portal \"%s\" does not exist", name))
+		return nil
+	}
+	if portal.stmt.prepare == nil {
+		pp.writeMessage(pgMsgEmptyQueryResponse)
+		return nil
+	}
+	if portal.suspended {
+		pp.drainPortal(portal, maxRows)
+		return nil
+	}
+
+	pp.curPortal = portal
+	portal.buffering = maxRows > 0
+	portal.tag = ""
+	portal.pending = nil
+	defer func() {
+		pp.curPortal = nil
+		portal.buffering = false
+	}()
+	if err := pp.execRequest(rt, COM_STMT_EXECUTE, binary.LittleEndian.AppendUint32(nil, portal.stmt.stmtID)); err != nil {
+		return err
+	}
+	if portal.buffering && !pp.failed && portal.tag != "" {
+		pp.drainPortal(portal, maxRows)
+	}
+	return nil
+}
+
+// drainPortal sends at most maxRows buffered rows of the portal.
+func (pp *PgProtocolImpl) drainPortal(portal *pgPortal, maxRows int) {
+	n := len(portal.pending)
+	if maxRows > 0 && maxRows < n {
+		n = maxRows
+	}
+	for _, row := range portal.pending[:n] {
+		pp.out = append(pp.out, row...)
+	}
+	portal.pending = portal.pending[n:]
+	if len(portal.pending) > 0 {
+		portal.suspended = true
+		pp.writeMessage(pgMsgPortalSuspended)
+		return
+	}
+	portal.suspended = false
+	pp.writeMessage(pgMsgCommandComplete, appendCString(nil, portal.tag))
+}
+
+func (pp *PgProtocolImpl) handleClose(ctx context.Context, rt *Routine, body []byte) error {
+	r := &pgMessageReader{data: body}
+	kind := r.byte1()
+	name := r.cstring()
+	if r.err != nil {
+		return r.err
+	}
+
+	pp.failed = false
+	switch kind {
+	case 'S':
+		if stmt, ok := pp.statements[name]; ok {
+			if err := pp.closeStatement(rt, stmt); err != nil {
+				return err
+			}
+		}
+	case 'P':
+		delete(pp.portals, name)
+	default:
+		pp.writeError("ERROR", pgSqlStateProtocolViolation, fmt.Sprintf("invalid CLOSE message subtype %d", kind))
+		return nil
+	}
+	if !pp.failed {
+		pp.writeMessage(pgMsgCloseComplete)
+	}
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// the responses of the session
+// ---------------------------------------------------------------------------
+
+func (pp *PgProtocolImpl) writeReadyForQuery() {
+	status := byte('I')
+	if ses := pp.GetSession(); ses != nil && ses.GetTxnHandler().GetServerStatus()&SERVER_STATUS_IN_TRANS != 0 {
+		status = 'T'
+	}
+	pp.writeMessage(pgMsgReadyForQuery, []byte{status})
+}
+
+func (pp *PgProtocolImpl) writeError(severity, sqlState, msg string) {
+	if len(sqlState) != 5 {
+		sqlState = pgSqlStateInternalError
+	}
+	buf := appendCString([]byte{'S'}, severity)
+	buf = appendCString(append(buf, 'V'), severity)
+	buf = appendCString(append(buf, 'C'), sqlState)
+	buf = appendCString(append(buf, 'M'), msg)
+	buf = append(buf, 0)
+	pp.writeMessage(pgMsgErrorResponse, buf)
+	pp.failed = true
+}
+
+func (pp *PgProtocolImpl) writeErr(err error) {
+	if me, ok := err.(*moerr.Error); ok {
+		pp.writeError("ERROR", me.SqlState(), me.Error())
+		return
+	}
+	pp.writeError("ERROR", pgSqlStateInternalError, err.Error())
+}
+
+// commandTag makes the tag of the CommandComplete message.
+func (pp *PgProtocolImpl) commandTag(rows uint64) string {
+	var stmt tree.Statement
+	if pp.curPortal != nil && pp.curPortal.stmt.prepare != nil {
+		stmt = pp.curPortal.stmt.prepare.PrepareStmt
+	} else if ses := pp.GetSession(); ses != nil {
+		stmt = ses.ast
+	}
+	return pgCommandTag(stmt, rows)
+}
+
+func pgCommandTag(stmt tree.Statement, rows uint64) string {
+	switch stmt.(type) {
+	case nil:
+		return "OK"
+	case *tree.Insert, *tree.Replace:
+		return "INSERT 0 " + strconv.FormatUint(rows, 10)
+	case *tree.Update:
+		return "UPDATE " + strconv.FormatUint(rows, 10)
+	case *tree.Delete:
+		return "DELETE " + strconv.FormatUint(rows, 10)
+	case *tree.Load:
+		return "COPY " + strconv.FormatUint(rows, 10)
+	case *tree.Select, *tree.ParenSelect:
+		return "SELECT " + strconv.FormatUint(rows, 10)
+	case *tree.BeginTransaction:
+		return "BEGIN"
+	case *tree.CommitTransaction:
+		return "COMMIT"
+	case *tree.RollbackTransaction:
+		return "ROLLBACK"
+	default:
+		return strings.ToUpper(stmt.GetStatementType())
+	}
+}
+
+func (pp *PgProtocolImpl) writeCommandComplete(tag string) {
+	if pp.curPortal != nil && pp.curPortal.buffering && len(pp.columnTypes) != 0 {
+		pp.curPortal.tag = tag
+		return
+	}
+	pp.writeMessage(pgMsgCommandComplete, appendCString(nil, tag))
+}
+
+func (pp *PgProtocolImpl) setColumns(columns []*MysqlColumn) {
+	pp.columns = columns
+	pp.columnTypes = pp.columnTypes[:0]
+	for _, col := range columns {
+		pp.columnTypes = append(pp.columnTypes, pgTypeOfMysqlColumn(col))
+	}
+	pp.rowCount = 0
+}
+
+func (pp *PgProtocolImpl) resultFormat(i int) int16 {
+	if pp.curPortal != nil {
+		return pp.curPortal.resultFormat(i)
+	}
+	return pgFormatText
+}
+
+func (pp *PgProtocolImpl) writeRowDescription(portal *pgPortal) {
+	buf := appendInt16(nil, int16(len(pp.columns)))
+	for i, col := range pp.columns {
+		oid := pp.columnTypes[i]
+		format := pgFormatText
+		if portal != nil {
+			format = portal.resultFormat(i)
+		}
+		buf = appendCString(buf, col.Name())
+		// table oid and attribute number
+		buf = appendInt32(buf, 0)
+		buf = appendInt16(buf, 0)
+		buf = appendInt32(buf, int32(oid))
+		buf = appendInt16(buf, pgTypeLength(oid))
+		// type modifier
+		buf = appendInt32(buf, -1)
+		buf = appendInt16(buf, format)
+	}
+	pp.writeMessage(pgMsgRowDescription, buf)
+}
+
+// writeDataRow sends the row r of the result set. The row is buffered
+// in the portal when the Execute message limits the count of the rows.
+func (pp *PgProtocolImpl) writeDataRow(ctx context.Context, mrs *MysqlResultSet, r uint64) error {
+	start := pp.beginMessage(pgMsgDataRow)
+	pp.out = appendInt16(pp.out, int16(len(pp.columnTypes)))
+	for i, oid := range pp.columnTypes {
+		val, err := encodePgValue(ctx, mrs, r, uint64(i), oid, pp.resultFormat(i))
+		if err != nil {
+			pp.out = pp.out[:start-1]
+			return err
+		}
+		if val == nil {
+			pp.out = appendInt32(pp.out, -1)
+		} else {
+			pp.out = appendInt32(pp.out, int32(len(val)))
+			pp.out = append(pp.out, val...)
+		}
+	}
+	pp.endMessage(start)
+	pp.rowCount++
+
+	if portal := pp.curPortal; portal != nil && portal.buffering {
+		row := make([]byte, len(pp.out)-start+1)
+		copy(row, pp.out[start-1:])
+		pp.out = pp.out[:start-1]
+		portal.pending = append(portal.pending, row)
+		return nil
+	}
+	return pp.flushIfFull()
+}
+
+func (pp *PgProtocolImpl) writeResultSet(ctx context.Context, mrs *MysqlResultSet) error {
+	columns := make([]*MysqlColumn, 0, len(mrs.Columns))
+	for _, col := range mrs.Columns {
+		mc, ok := col.(*MysqlColumn)
+		if !ok {
+			mc = &MysqlColumn{}
+			mc.SetName(col.Name())
+			mc.SetColumnType(col.ColumnType())
+		}
+		columns = append(columns, mc)
+	}
+	pp.setColumns(columns)
+	if pp.curPortal == nil {
+		pp.writeRowDescription(nil)
+	}
+	for r := uint64(0); r < mrs.GetRowCount(); r++ {
+		if err := pp.writeDataRow(ctx, mrs, r); err != nil {
+			return err
+		}
+	}
+	pp.writeCommandComplete("SELECT " + strconv.FormatUint(pp.rowCount, 10))
+	return nil
+}
+
+func (pp *PgProtocolImpl) Write(execCtx *ExecCtx, bat *batch.Batch) error {
+	n := bat.Vecs[0].Length()
+	mrs := MysqlResultSet{}
+	mrs.Columns = execCtx.ses.GetMysqlResultSet().Columns
+	mrs.Data = [][]interface{}{make([]interface{}, len(bat.Vecs))}
+	ses := execCtx.ses.(*Session)
+	isShowTableStatus := ses.GetShowStmtType() == ShowTableStatus
+
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	for j := 0; j < n; j++ {
+		err := extractRowFromEveryVector(execCtx.reqCtx, execCtx.ses, bat, j, mrs.Data[0])
+		if err != nil {
+			return err
+		}
+		if isShowTableStatus {
+			row2 := make([]interface{}, len(mrs.Data[0]))
+			copy(row2, mrs.Data[0])
+			ses.AppendData(row2)
+		} else if err = pp.writeDataRow(execCtx.reqCtx, &mrs, 0); err != nil {
+			execCtx.ses.Error(execCtx.reqCtx,
+				"Flush error",
+				zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteOK(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
+	pp.writeCommandComplete(pp.commandTag(affectedRows))
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteOKtWithEOF(affectedRows, lastInsertId uint64, status, warnings uint16, message string) error {
+	return pp.WriteOK(affectedRows, lastInsertId, status, warnings, message)
+}
+
+func (pp *PgProtocolImpl) WriteEOF(warnings, status uint16) error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteEOFIF(warnings uint16, status uint16) error {
+	return nil
+}
+
+// WriteEOFIFAndNoFlush ends the column definitions. The Execute message does not
+// send the RowDescription which is sent by the Describe message.
+func (pp *PgProtocolImpl) WriteEOFIFAndNoFlush(warnings uint16, status uint16) error {
+	pp.setColumns(pp.columns)
+	if pp.curPortal == nil {
+		pp.writeRowDescription(nil)
+	}
+	return nil
+}
+
+// WriteEOFOrOK ends the result set.
+func (pp *PgProtocolImpl) WriteEOFOrOK(warnings uint16, status uint16) error {
+	pp.writeCommandComplete("SELECT " + strconv.FormatUint(pp.rowCount, 10))
+	return pp.flushIfFull()
+}
+
+func (pp *PgProtocolImpl) WriteERR(errorCode uint16, sqlState, errorMessage string) error {
+	pp.writeError("ERROR", sqlState, errorMessage)
+	return nil
+}
+
+// WriteLengthEncodedNumber begins the column definitions of the result set.
+func (pp *PgProtocolImpl) WriteLengthEncodedNumber(n uint64) error {
+	pp.columns = make([]*MysqlColumn, 0, n)
+	pp.columnTypes = pp.columnTypes[:0]
+	pp.rowCount = 0
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteColumnDef(ctx context.Context, column Column, i int) error {
+	mc, ok := column.(*MysqlColumn)
+	if !ok {
+		return moerr.NewInternalError(ctx, "unsupported column type %T", column)
+	}
+	pp.columns = append(pp.columns, mc)
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteColumnDefBytes(payload []byte) error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteRow() error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteTextRow() error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteBinaryRow() error {
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteResultSetRow(mrs *MysqlResultSet, count uint64) error {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	for r := uint64(0); r < count; r++ {
+		if err := pp.writeDataRow(pp.ctx, mrs, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteResponse(ctx context.Context, resp *Response) error {
+	switch resp.category {
+	case OkResponse:
+		pp.writeCommandComplete(pp.commandTag(resp.affectedRows))
+	case EoFResponse:
+	case ErrorResponse:
+		err, _ := resp.data.(error)
+		if err == nil {
+			pp.writeCommandComplete(pp.commandTag(0))
+			return nil
+		}
+		pp.writeErr(err)
+	case ResultResponse:
+		mer, _ := resp.data.(*MysqlExecutionResult)
+		if mer == nil {
+			pp.writeCommandComplete(pp.commandTag(0))
+		} else if mer.Mrs() == nil {
+			pp.writeCommandComplete(pp.commandTag(mer.AffectedRows()))
+		} else {
+			return pp.writeResultSet(ctx, mer.Mrs())
+		}
+	case LocalInfileRequest:
+		return pp.WriteLocalInfileRequest("")
+	default:
+		return moerr.NewInternalError(ctx, "unsupported response:%d ", resp.category)
+	}
+	return nil
+}
+
+// WritePrepareResponse keeps the prepared statement for the Parse message.
+func (pp *PgProtocolImpl) WritePrepareResponse(ctx context.Context, stmt *PrepareStmt) error {
+	pp.prepared = stmt
+	return nil
+}
+
+func (pp *PgProtocolImpl) WriteLocalInfileRequest(filepath string) error {
+	return moerr.NewNotSupportedNoCtx("LOAD DATA LOCAL over the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) WriteBinlogEvents(events [][]byte) error {
+	return moerr.NewNotSupportedNoCtx("binlog dump over the PostgreSQL protocol")
+}
+
+func (pp *PgProtocolImpl) ParseSendLongData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	return moerr.NewNotSupported(ctx, "send long data over the PostgreSQL protocol")
+}
+
+// ParseExecuteData fills the parameters of the prepared statement with the values of the portal in executing.
+func (pp *PgProtocolImpl) ParseExecuteData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	var err error
+	portal := pp.curPortal
+	if portal == nil {
+		return moerr.NewInternalError(ctx, "no portal in executing")
+	}
+	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	numParams := len(dcPrepare.Prepare.ParamTypes)
+	if numParams != len(portal.stmt.paramOrder) {
+		return moerr.NewInvalidInput(ctx, "the statement has %d parameters, but got %d", numParams, len(portal.stmt.paramOrder))
+	}
+
+	if stmt.params == nil {
+		stmt.params = proc.GetVector(types.T_text.ToType())
+		for i := 0; i < numParams; i++ {
+			err = vector.AppendBytes(stmt.params, []byte{}, false, proc.GetMPool())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for i, idx := range portal.stmt.paramOrder {
+		if err = util.SetAnyToStringVector(proc, portal.values[idx], stmt.params, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MakeColumnDefData returns nothing. The columns are always sent by WriteColumnDef.
+func (pp *PgProtocolImpl) MakeColumnDefData(ctx context.Context, columns []*planPb.ColDef) ([][]byte, error) {
+	return nil, nil
+}
+
+// ---------------------------------------------------------------------------
+// the query conversion
+// ---------------------------------------------------------------------------
+
+// convertPgQuery converts the query in the PostgreSQL lexical conventions into the
+// one accepted by the MySQL dialect parser:
+//
+//   - the positional parameters $n are replaced by '?'. The parameter index (n - 1)
+//     of every '?' is returned, so that a parameter can be used multiple times.
+//   - the double-quoted identifiers are quoted by the backticks.
+//   - the backslashes in the standard conforming strings are escaped.
+//   - the dollar-quoted strings are converted into the single-quoted strings.
+//
+// The comments, the E” strings and the other tokens are kept.
+func convertPgQuery(sql string) (string, []int) {
+	var sb strings.Builder
+	var order []int
+	sb.Grow(len(sql) + 8)
+
+	isIdentChar := func(c byte) bool {
+		return c == '_' || c == '$' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+	}
+	prevIsIdent := func(i int) bool {
+		return i > 0 && isIdentChar(sql[i-1])
+	}
+
+	for i := 0; i < len(sql); {
+		c := sql[i]
+		switch {
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			end := strings.IndexByte(sql[i:], '\n')
+			if end < 0 {
+				end = len(sql) - i
+			}
+			sb.WriteString(sql[i : i+end])
+			i += end
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			end := strings.Index(sql[i+2:], "*/")
+			if end < 0 {
+				end = len(sql) - i
+			} else {
+				end += 4
+			}
+			sb.WriteString(sql[i : i+end])
+			i += end
+		case c == '\'':
+			// E'...' keeps the backslash escapes which are the same as MySQL
+			escape := i > 0 && (sql[i-1] == 'E' || sql[i-1] == 'e') && !prevIsIdent(i-1)
+			sb.WriteByte('\'')
+			i++
+			for i < len(sql) {
+				if sql[i] == '\'' {
+					if i+1 < len(sql) && sql[i+1] == '\'' {
+						sb.WriteString("''")
+						i += 2
+						continue
+					}
+					break
+				}
+				if sql[i] == '\\' {
+					if escape {
+						sb.WriteByte('\\')
+						i++
+						if i < len(sql) {
+							sb.WriteByte(sql[i])
+							i++
+						}
+						continue
+					}
+					sb.WriteString(`\\`)
+					i++
+					continue
+				}
+				sb.WriteByte(sql[i])
+				i++
+			}
+			if i < len(sql) {
+				sb.WriteByte('\'')
+				i++
+			}
+		case c == '"':
+			sb.WriteByte('`')
+			i++
+			for i < len(sql) {
+				if sql[i] == '"' {
+					if i+1 < len(sql) && sql[i+1] == '"' {
+						sb.WriteByte('"')
+						i += 2
+						continue
+					}
+					break
+				}
+				if sql[i] == '`' {
+					sb.WriteString("``")
+				} else {
+					sb.WriteByte(sql[i])
+				}
+				i++
+			}
+			if i < len(sql) {
+				sb.WriteByte('`')
+				i++
+			}
+		case c == '$' && !prevIsIdent(i):
+			j := i + 1
+			for j < len(sql) && sql[j] >= '0' && sql[j] <= '9' {
+				j++
+			}
+			if j > i+1 {
+				n, err := strconv.Atoi(sql[i+1 : j])
+				if err == nil && n > 0 {
+					order = append(order, n-1)
+					sb.WriteByte('?')
+					i = j
+					continue
+				}
+			}
+			// dollar-quoted string: $tag$...$tag$
+			j = i + 1
+			for j < len(sql) && sql[j] != '$' && isIdentChar(sql[j]) && !(j == i+1 && sql[j] >= '0' && sql[j] <= '9') {
+				j++
+			}
+			if j < len(sql) && sql[j] == '$' {
+				tag := sql[i : j+1]
+				end := strings.Index(sql[j+1:], tag)
+				if end >= 0 {
+					body := sql[j+1 : j+1+end]
+					sb.WriteByte('\'')
+					sb.WriteString(strings.NewReplacer(`'`, `''`, `\`, `\\`).Replace(body))
+					sb.WriteByte('\'')
+					i = j + 1 + end + len(tag)
+					continue
+				}
+			}
+			sb.WriteByte(c)
+			i++
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String(), order
+}
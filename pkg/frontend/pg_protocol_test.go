@@ -0,0 +1,387 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// scramClientProof computes the client-final-message of the SCRAM exchange like a PostgreSQL client.
+func scramClientProof(t *testing.T, password, clientFirstBare, serverFirst string) (string, []byte) {
+	var nonce, salt string
+	var iterations int
+	for _, attr := range strings.Split(serverFirst, ",") {
+		switch {
+		case strings.HasPrefix(attr, "r="):
+			nonce = attr[2:]
+		case strings.HasPrefix(attr, "s="):
+			salt = attr[2:]
+		case strings.HasPrefix(attr, "i="):
+			var err error
+			iterations, err = strconv.Atoi(attr[2:])
+			require.NoError(t, err)
+		}
+	}
+	rawSalt, err := base64.StdEncoding.DecodeString(salt)
+	require.NoError(t, err)
+
+	saltedPassword := pbkdf2Sha256([]byte(password), rawSalt, iterations)
+	clientKey := hmacSha256(saltedPassword, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	withoutProof := "c=" + base64.StdEncoding.EncodeToString([]byte("n,,")) + ",r=" + nonce
+	authMessage := clientFirstBare + "," + serverFirst + "," + withoutProof
+	clientSignature := hmacSha256(storedKey[:], []byte(authMessage))
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+	serverSignature := hmacSha256(hmacSha256(saltedPassword, []byte("Server Key")), []byte(authMessage))
+	return withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof), serverSignature
+}
+
+func Test_scramAuthentication(t *testing.T) {
+	ctx := context.TODO()
+	verifier, err := parseScramVerifier(ctx, HashPgPassWord("111"))
+	require.NoError(t, err)
+	require.Equal(t, scramIterationCounter, verifier.iterations)
+
+	run := func(password string) error {
+		ss := newScramServer(verifier)
+		clientFirstBare := "n=,r=rOprNGfwEbeRWgbNEkqO"
+		serverFirst, err := ss.handleClientFirst(ctx, []byte("n,,"+clientFirstBare))
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(serverFirst), "r=rOprNGfwEbeRWgbNEkqO"))
+
+		clientFinal, serverSignature := scramClientProof(t, password, clientFirstBare, string(serverFirst))
+		serverFinal, err := ss.handleClientFinal(ctx, []byte(clientFinal))
+		if err != nil {
+			return err
+		}
+		require.Equal(t, "v="+base64.StdEncoding.EncodeToString(serverSignature), string(serverFinal))
+		return nil
+	}
+	require.NoError(t, run("111"))
+	require.Error(t, run("112"))
+
+	ss := newScramServer(verifier)
+	_, err = ss.handleClientFirst(ctx, []byte("p=tls-server-end-point,,n=,r=abc"))
+	require.Error(t, err)
+	_, err = ss.handleClientFirst(ctx, []byte("n,,n="))
+	require.Error(t, err)
+}
+
+func Test_parseScramVerifier(t *testing.T) {
+	ctx := context.TODO()
+	salt := []byte("0123456789abcdef")
+	sv := makeScramVerifier("pwd", salt, 10)
+	parsed, err := parseScramVerifier(ctx, sv.String())
+	require.NoError(t, err)
+	require.Equal(t, sv, parsed)
+
+	for _, s := range []string{
+		"",
+		"md5abc",
+		"SCRAM-SHA-256$4096:salt",
+		"SCRAM-SHA-256$x:c2FsdA==$a:b",
+		"SCRAM-SHA-256$4096:c2FsdA==$YQ==:YQ==",
+	} {
+		_, err = parseScramVerifier(ctx, s)
+		require.Error(t, err, s)
+	}
+	require.Equal(t, "", HashPgPassWord(""))
+}
+
+func Test_checkPgPassword(t *testing.T) {
+	pwd := HashSha1(HashSha1([]byte("111")))
+	require.True(t, checkPgPassword(pwd, []byte("111")))
+	require.False(t, checkPgPassword(pwd, []byte("112")))
+	require.False(t, checkPgPassword(nil, nil))
+}
+
+func Test_convertPgQuery(t *testing.T) {
+	cases := []struct {
+		sql   string
+		want  string
+		order []int
+	}{
+		{"select 1", "select 1", nil},
+		{"select * from t where a = $1 and b = $2", "select * from t where a = ? and b = ?", []int{0, 1}},
+		{"select $2, $1, $2", "select ?, ?, ?", []int{1, 0, 1}},
+		{`select "Col" from "my""tab"`, "select `Col` from `my\"tab`", nil},
+		{`select 'a\b', 'it''s $1'`, `select 'a\\b', 'it''s $1'`, nil},
+		{`select E'a\'b'`, `select E'a\'b'`, nil},
+		{"select $$it's$$, $tag$x$y$tag$", `select 'it''s', 'x$y'`, nil},
+		{"select a$1 from t -- $1\n where b = $1 /* $2 */", "select a$1 from t -- $1\n where b = ? /* $2 */", []int{0}},
+	}
+	for _, c := range cases {
+		got, order := convertPgQuery(c.sql)
+		require.Equal(t, c.want, got, c.sql)
+		require.Equal(t, c.order, order, c.sql)
+	}
+	require.True(t, isEmptyPgQuery(" ; ;\n"))
+	require.False(t, isEmptyPgQuery("select 1;"))
+}
+
+func Test_pgTypeOfEngineType(t *testing.T) {
+	cases := map[types.T]uint32{
+		types.T_bool:       pgTypeBool,
+		types.T_int8:       pgTypeInt2,
+		types.T_uint16:     pgTypeInt4,
+		types.T_int64:      pgTypeInt8,
+		types.T_uint64:     pgTypeNumeric,
+		types.T_float32:    pgTypeFloat4,
+		types.T_float64:    pgTypeFloat8,
+		types.T_decimal128: pgTypeNumeric,
+		types.T_char:       pgTypeBpchar,
+		types.T_varchar:    pgTypeVarchar,
+		types.T_text:       pgTypeText,
+		types.T_blob:       pgTypeBytea,
+		types.T_varbinary:  pgTypeBytea,
+		types.T_json:       pgTypeJson,
+		types.T_date:       pgTypeDate,
+		types.T_time:       pgTypeTime,
+		types.T_datetime:   pgTypeTimestamp,
+		types.T_uuid:       pgTypeUuid,
+		types.T_any:        pgTypeUnknown,
+	}
+	for typ, oid := range cases {
+		require.Equal(t, oid, pgTypeOfEngineType(typ), typ.String())
+	}
+
+	col := &MysqlColumn{}
+	col.SetColumnType(defines.MYSQL_TYPE_BLOB)
+	col.SetCharset(charsetBinary)
+	require.Equal(t, pgTypeBytea, pgTypeOfMysqlColumn(col))
+	col.SetCharset(charsetVarchar)
+	require.Equal(t, pgTypeText, pgTypeOfMysqlColumn(col))
+	require.Equal(t, int16(-1), pgTypeLength(pgTypeText))
+	require.Equal(t, int16(8), pgTypeLength(pgTypeInt8))
+}
+
+func Test_pgNumeric(t *testing.T) {
+	ctx := context.TODO()
+	for _, s := range []string{"0", "1", "-1", "12345.678", "0.0001", "-0.5000", "100000000", "9999.9999"} {
+		data, err := encodePgNumeric(ctx, s)
+		require.NoError(t, err)
+		got, err := decodePgNumeric(ctx, data)
+		require.NoError(t, err)
+		require.Equal(t, s, got)
+	}
+	_, err := encodePgNumeric(ctx, "1e5")
+	require.Error(t, err)
+	_, err = decodePgNumeric(ctx, []byte{0, 1})
+	require.Error(t, err)
+}
+
+func Test_decodePgParam(t *testing.T) {
+	ctx := context.TODO()
+	cases := []struct {
+		oid    uint32
+		format int16
+		data   []byte
+		want   any
+	}{
+		{pgTypeInt4, pgFormatText, nil, nil},
+		{pgTypeInt4, pgFormatText, []byte("42"), "42"},
+		{pgTypeBool, pgFormatText, []byte("true"), "1"},
+		{pgTypeBool, pgFormatText, []byte("f"), "0"},
+		{pgTypeBytea, pgFormatText, []byte(`\x6162`), "ab"},
+		{pgTypeInt2, pgFormatBinary, []byte{0xff, 0xfe}, "-2"},
+		{pgTypeInt4, pgFormatBinary, binary.BigEndian.AppendUint32(nil, 7), "7"},
+		{pgTypeInt8, pgFormatBinary, binary.BigEndian.AppendUint64(nil, 1<<40), "1099511627776"},
+		{pgTypeFloat8, pgFormatBinary, binary.BigEndian.AppendUint64(nil, 0x3ff8000000000000), "1.5"},
+		{pgTypeDate, pgFormatBinary, binary.BigEndian.AppendUint32(nil, 1), "2000-01-02"},
+		{pgTypeTimestamp, pgFormatBinary, binary.BigEndian.AppendUint64(nil, 1000000), "2000-01-01 00:00:01.000000"},
+		{pgTypeText, pgFormatBinary, []byte("abc"), "abc"},
+	}
+	for _, c := range cases {
+		got, err := decodePgParam(ctx, c.oid, c.format, c.data)
+		require.NoError(t, err)
+		require.Equal(t, c.want, got)
+	}
+	_, err := decodePgParam(ctx, pgTypeInt4, pgFormatBinary, []byte{1})
+	require.Error(t, err)
+	_, err = decodePgParam(ctx, pgTypeBytea, pgFormatText, []byte(`\xzz`))
+	require.Error(t, err)
+}
+
+func Test_pgCommandTag(t *testing.T) {
+	require.Equal(t, "INSERT 0 3", pgCommandTag(&tree.Insert{}, 3))
+	require.Equal(t, "UPDATE 2", pgCommandTag(&tree.Update{}, 2))
+	require.Equal(t, "DELETE 1", pgCommandTag(&tree.Delete{}, 1))
+	require.Equal(t, "SELECT 5", pgCommandTag(&tree.Select{}, 5))
+	require.Equal(t, "BEGIN", pgCommandTag(&tree.BeginTransaction{}, 0))
+	require.Equal(t, "COMMIT", pgCommandTag(&tree.CommitTransaction{}, 0))
+	require.Equal(t, "OK", pgCommandTag(nil, 0))
+}
+
+// readPgMessage reads a message sent by the backend.
+func readPgMessage(t *testing.T, conn net.Conn) (byte, []byte) {
+	header := make([]byte, 5)
+	_, err := io.ReadFull(conn, header)
+	require.NoError(t, err)
+	body := make([]byte, binary.BigEndian.Uint32(header[1:])-4)
+	_, err = io.ReadFull(conn, body)
+	require.NoError(t, err)
+	return header[0], body
+}
+
+func newTestPgProtocol(t *testing.T) (*PgProtocolImpl, net.Conn) {
+	server, client := net.Pipe()
+	sv := &config.FrontendParameters{}
+	sv.SetDefaultValues()
+	sv.SessionTimeout.Duration = time.Minute
+	pu := config.NewParameterUnit(sv, nil, nil, nil)
+	rs, err := NewIOSession(server, pu)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = server.Close()
+		_ = client.Close()
+	})
+	return NewPgProtocol("", 1, rs, sv), client
+}
+
+func Test_PgProtocolHandshake(t *testing.T) {
+	pp, client := newTestPgProtocol(t)
+
+	go func() {
+		// SSLRequest is refused without the tls config
+		msg := binary.BigEndian.AppendUint32(nil, 8)
+		msg = binary.BigEndian.AppendUint32(msg, pgSSLRequest)
+		_, _ = client.Write(msg)
+		reply := make([]byte, 1)
+		_, _ = io.ReadFull(client, reply)
+		if reply[0] != 'N' {
+			return
+		}
+
+		body := binary.BigEndian.AppendUint32(nil, pgProtocolVersion3)
+		body = appendCString(appendCString(body, "user"), "sys:root")
+		body = appendCString(appendCString(body, "database"), "db1")
+		body = appendCString(appendCString(body, "application_name"), "psql")
+		body = append(body, 0)
+		_, _ = client.Write(append(binary.BigEndian.AppendUint32(nil, uint32(len(body)+4)), body...))
+	}()
+
+	ok, err := pp.handshake(context.TODO(), nil)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "sys:root", pp.GetStr(USERNAME))
+	require.Equal(t, "db1", pp.GetStr(DBNAME))
+	require.Equal(t, "psql", pp.startupParams["application_name"])
+}
+
+func Test_PgCleartextPassword(t *testing.T) {
+	pp, _ := newTestPgProtocol(t)
+	pp.SV.PgAuthMethod = pgAuthMethodPassword
+	pp.username = "sys:root"
+
+	// the password is not asked in cleartext without TLS
+	err := pp.authenticateUser(context.TODO())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "only be sent in cleartext over TLS")
+
+	pp.SetBool(TLS_ESTABLISHED, true)
+	require.True(t, pp.cleartextPasswordAllowed())
+	pp.SetBool(TLS_ESTABLISHED, false)
+	pp.SV.PgAllowCleartextPassword = true
+	require.True(t, pp.cleartextPasswordAllowed())
+}
+
+func Test_PgProtocolMessages(t *testing.T) {
+	pp, client := newTestPgProtocol(t)
+
+	pp.setColumns(nil)
+	col := &MysqlColumn{}
+	col.SetName("a")
+	col.SetColumnType(defines.MYSQL_TYPE_LONG)
+	col.SetSigned(true)
+	pp.setColumns([]*MysqlColumn{col})
+	pp.writeRowDescription(nil)
+	mrs := &MysqlResultSet{}
+	mrs.AddColumn(col)
+	mrs.AddRow([]interface{}{int32(10)})
+	mrs.AddRow([]interface{}{nil})
+	require.NoError(t, pp.WriteResultSetRow(mrs, 2))
+	require.NoError(t, pp.WriteEOFOrOK(0, 0))
+	require.NoError(t, pp.WriteERR(0, "42000", "bad"))
+
+	done := make(chan error)
+	go func() {
+		done <- pp.flush()
+	}()
+
+	typ, body := readPgMessage(t, client)
+	require.Equal(t, pgMsgRowDescription, typ)
+	r := &pgMessageReader{data: body}
+	require.Equal(t, int16(1), r.int16())
+	require.Equal(t, "a", r.cstring())
+	r.int32()
+	r.int16()
+	require.Equal(t, int32(pgTypeInt4), r.int32())
+	require.NoError(t, r.err)
+
+	typ, body = readPgMessage(t, client)
+	require.Equal(t, pgMsgDataRow, typ)
+	require.Equal(t, []byte{0, 1, 0, 0, 0, 2, '1', '0'}, body)
+	typ, body = readPgMessage(t, client)
+	require.Equal(t, pgMsgDataRow, typ)
+	require.Equal(t, []byte{0, 1, 0xff, 0xff, 0xff, 0xff}, body)
+
+	typ, body = readPgMessage(t, client)
+	require.Equal(t, pgMsgCommandComplete, typ)
+	require.Equal(t, "SELECT 2\x00", string(body))
+
+	typ, body = readPgMessage(t, client)
+	require.Equal(t, pgMsgErrorResponse, typ)
+	require.Equal(t, "SERROR\x00VERROR\x00C42000\x00Mbad\x00\x00", string(body))
+	require.True(t, pp.failed)
+	require.NoError(t, <-done)
+}
+
+func Test_pgPortalDrain(t *testing.T) {
+	pp, client := newTestPgProtocol(t)
+	portal := &pgPortal{tag: "SELECT 3", pending: [][]byte{{'D', 0, 0, 0, 4}, {'D', 0, 0, 0, 4}, {'D', 0, 0, 0, 4}}}
+	pp.drainPortal(portal, 2)
+	require.True(t, portal.suspended)
+	pp.drainPortal(portal, 2)
+	require.False(t, portal.suspended)
+
+	done := make(chan error)
+	go func() {
+		done <- pp.flush()
+	}()
+	for _, want := range []byte{pgMsgDataRow, pgMsgDataRow, pgMsgPortalSuspended, pgMsgDataRow, pgMsgCommandComplete} {
+		typ, _ := readPgMessage(t, client)
+		require.Equal(t, want, typ)
+	}
+	require.NoError(t, <-done)
+}
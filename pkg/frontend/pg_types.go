@@ -0,0 +1,548 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+// the object identifiers of the PostgreSQL types in pg_type
+const (
+	pgTypeBool      uint32 = 16
+	pgTypeBytea     uint32 = 17
+	pgTypeInt8      uint32 = 20
+	pgTypeInt2      uint32 = 21
+	pgTypeInt4      uint32 = 23
+	pgTypeText      uint32 = 25
+	pgTypeJson      uint32 = 114
+	pgTypeFloat4    uint32 = 700
+	pgTypeFloat8    uint32 = 701
+	pgTypeUnknown   uint32 = 705
+	pgTypeBpchar    uint32 = 1042
+	pgTypeVarchar   uint32 = 1043
+	pgTypeDate      uint32 = 1082
+	pgTypeTime      uint32 = 1083
+	pgTypeTimestamp uint32 = 1114
+	pgTypeNumeric   uint32 = 1700
+	pgTypeUuid      uint32 = 2950
+)
+
+// the format codes of the values
+const (
+	pgFormatText   int16 = 0
+	pgFormatBinary int16 = 1
+)
+
+const (
+	pgNumericPos   = 0x0000
+	pgNumericNeg   = 0x4000
+	pgNumericNaN   = 0xC000
+	pgNumericNBase = 10000
+)
+
+var (
+	// the epoch of the binary date and timestamp is 2000-01-01
+	pgEpochDate     = types.DateFromCalendar(2000, 1, 1)
+	pgEpochDatetime = types.DatetimeFromClock(2000, 1, 1, 0, 0, 0, 0)
+)
+
+// pgTypeOfEngineType maps the engine type to the PostgreSQL type.
+func pgTypeOfEngineType(t types.T) uint32 {
+	switch t {
+	case types.T_bool:
+		return pgTypeBool
+	case types.T_int8, types.T_uint8, types.T_int16:
+		return pgTypeInt2
+	case types.T_uint16, types.T_int32:
+		return pgTypeInt4
+	case types.T_uint32, types.T_int64, types.T_bit:
+		return pgTypeInt8
+	case types.T_uint64, types.T_decimal64, types.T_decimal128, types.T_decimal256:
+		return pgTypeNumeric
+	case types.T_float32:
+		return pgTypeFloat4
+	case types.T_float64:
+		return pgTypeFloat8
+	case types.T_char:
+		return pgTypeBpchar
+	case types.T_varchar, types.T_enum, types.T_array_float32, types.T_array_float64,
+		types.T_TS, types.T_Rowid, types.T_Blockid:
+		return pgTypeVarchar
+	case types.T_text, types.T_datalink:
+		return pgTypeText
+	case types.T_binary, types.T_varbinary, types.T_blob:
+		return pgTypeBytea
+	case types.T_json:
+		return pgTypeJson
+	case types.T_date:
+		return pgTypeDate
+	case types.T_time:
+		return pgTypeTime
+	case types.T_datetime, types.T_timestamp:
+		return pgTypeTimestamp
+	case types.T_uuid:
+		return pgTypeUuid
+	default:
+		return pgTypeUnknown
+	}
+}
+
+// engineTypeOfMysqlColumn recovers the engine type from the column of the result set.
+// It is the reverse of convertEngineTypeToMysqlType.
+func engineTypeOfMysqlColumn(col *MysqlColumn) types.T {
+	switch col.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		return types.T_bool
+	case defines.MYSQL_TYPE_BIT:
+		return types.T_bit
+	case defines.MYSQL_TYPE_TINY:
+		if col.IsSigned() {
+			return types.T_int8
+		}
+		return types.T_uint8
+	case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+		if col.IsSigned() {
+			return types.T_int16
+		}
+		return types.T_uint16
+	case defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_INT24:
+		if col.IsSigned() {
+			return types.T_int32
+		}
+		return types.T_uint32
+	case defines.MYSQL_TYPE_LONGLONG:
+		if col.IsSigned() {
+			return types.T_int64
+		}
+		return types.T_uint64
+	case defines.MYSQL_TYPE_FLOAT:
+		return types.T_float32
+	case defines.MYSQL_TYPE_DOUBLE:
+		return types.T_float64
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
+		return types.T_decimal128
+	case defines.MYSQL_TYPE_STRING:
+		return types.T_char
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
+		// binary/varbinary are sent as varchar with the binary charset
+		if col.Charset() == charsetBinary {
+			return types.T_varbinary
+		}
+		return types.T_varchar
+	case defines.MYSQL_TYPE_TEXT:
+		return types.T_text
+	case defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TINY_BLOB,
+		defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB:
+		// text is sent as blob with the varchar charset
+		if col.Charset() == charsetBinary {
+			return types.T_blob
+		}
+		return types.T_text
+	case defines.MYSQL_TYPE_JSON:
+		return types.T_json
+	case defines.MYSQL_TYPE_DATE:
+		return types.T_date
+	case defines.MYSQL_TYPE_TIME:
+		return types.T_time
+	case defines.MYSQL_TYPE_DATETIME:
+		return types.T_datetime
+	case defines.MYSQL_TYPE_TIMESTAMP:
+		return types.T_timestamp
+	case defines.MYSQL_TYPE_UUID:
+		return types.T_uuid
+	default:
+		return types.T_any
+	}
+}
+
+func pgTypeOfMysqlColumn(col *MysqlColumn) uint32 {
+	return pgTypeOfEngineType(engineTypeOfMysqlColumn(col))
+}
+
+// pgTypeLength returns the typlen of the type. -1 denotes the variable length.
+func pgTypeLength(oid uint32) int16 {
+	switch oid {
+	case pgTypeBool:
+		return 1
+	case pgTypeInt2:
+		return 2
+	case pgTypeInt4, pgTypeFloat4, pgTypeDate:
+		return 4
+	case pgTypeInt8, pgTypeFloat8, pgTypeTime, pgTypeTimestamp:
+		return 8
+	case pgTypeUuid:
+		return 16
+	default:
+		return -1
+	}
+}
+
+// encodePgValue encodes the value at (r, c) of the result set in the text or binary format.
+// It returns nil for NULL.
+func encodePgValue(ctx context.Context, mrs *MysqlResultSet, r, c uint64, oid uint32, format int16) ([]byte, error) {
+	value, err := mrs.GetValue(ctx, r, c)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+	if format == pgFormatBinary {
+		return encodePgBinaryValue(ctx, mrs, r, c, value, oid)
+	}
+
+	switch oid {
+	case pgTypeBool:
+		v, err := mrs.GetInt64(ctx, r, c)
+		if err != nil {
+			return nil, err
+		}
+		if v != 0 {
+			return []byte("t"), nil
+		}
+		return []byte("f"), nil
+	case pgTypeFloat4, pgTypeFloat8:
+		v, err := mrs.GetFloat64(ctx, r, c)
+		if err != nil {
+			return nil, err
+		}
+		if math.IsNaN(v) {
+			return []byte("NaN"), nil
+		} else if math.IsInf(v, 1) {
+			return []byte("Infinity"), nil
+		} else if math.IsInf(v, -1) {
+			return []byte("-Infinity"), nil
+		}
+	case pgTypeBytea:
+		raw, err := pgValueBytes(ctx, mrs, r, c, value)
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, 2+hex.EncodedLen(len(raw)))
+		buf[0], buf[1] = '\\', 'x'
+		hex.Encode(buf[2:], raw)
+		return buf, nil
+	}
+	return pgValueBytes(ctx, mrs, r, c, value)
+}
+
+// pgValueBytes returns the text form of the value.
+func pgValueBytes(ctx context.Context, mrs *MysqlResultSet, r, c uint64, value any) ([]byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return v, nil
+	case types.Date:
+		return []byte(v.String()), nil
+	default:
+		s, err := mrs.GetString(ctx, r, c)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(s), nil
+	}
+}
+
+func encodePgBinaryValue(ctx context.Context, mrs *MysqlResultSet, r, c uint64, value any, oid uint32) ([]byte, error) {
+	switch oid {
+	case pgTypeBool:
+		v, err := mrs.GetInt64(ctx, r, c)
+		if err != nil {
+			return nil, err
+		}
+		if v != 0 {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case pgTypeInt2, pgTypeInt4, pgTypeInt8:
+		v, err := mrs.GetInt64(ctx, r, c)
+		if err != nil {
+			return nil, err
+		}
+		switch oid {
+		case pgTypeInt2:
+			return binary.BigEndian.AppendUint16(nil, uint16(v)), nil
+		case pgTypeInt4:
+			return binary.BigEndian.AppendUint32(nil, uint32(v)), nil
+		default:
+			return binary.BigEndian.AppendUint64(nil, uint64(v)), nil
+		}
+	case pgTypeFloat4:
+		v, err := mrs.GetFloat64(ctx, r, c)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint32(nil, math.Float32bits(float32(v))), nil
+	case pgTypeFloat8:
+		v, err := mrs.GetFloat64(ctx, r, c)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(nil, math.Float64bits(v)), nil
+	case pgTypeBytea, pgTypeText, pgTypeVarchar, pgTypeBpchar, pgTypeJson, pgTypeUnknown:
+		return pgValueBytes(ctx, mrs, r, c, value)
+	}
+
+	s, err := pgValueBytes(ctx, mrs, r, c, value)
+	if err != nil {
+		return nil, err
+	}
+	switch oid {
+	case pgTypeNumeric:
+		return encodePgNumeric(ctx, string(s))
+	case pgTypeDate:
+		d, ok := value.(types.Date)
+		if !ok {
+			if d, err = types.ParseDateCast(string(s)); err != nil {
+				return nil, err
+			}
+		}
+		return binary.BigEndian.AppendUint32(nil, uint32(int32(d-pgEpochDate))), nil
+	case pgTypeTime:
+		t, err := types.ParseTime(string(s), 6)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(t)), nil
+	case pgTypeTimestamp:
+		dt, err := types.ParseDatetime(string(s), 6)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(nil, uint64(int64(dt-pgEpochDatetime))), nil
+	case pgTypeUuid:
+		u, err := types.ParseUuid(string(s))
+		if err != nil {
+			return nil, err
+		}
+		return u[:], nil
+	}
+	return nil, moerr.NewNotSupported(ctx, "binary format of the type %d", oid)
+}
+
+// encodePgNumeric encodes the decimal string into the binary format of numeric:
+// ndigits, weight, sign, dscale and the base 10000 digits.
+func encodePgNumeric(ctx context.Context, s string) ([]byte, error) {
+	sign := uint16(pgNumericPos)
+	if strings.HasPrefix(s, "-") {
+		sign = pgNumericNeg
+		s = s[1:]
+	} else {
+		s = strings.TrimPrefix(s, "+")
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i++ {
+			if part[i] < '0' || part[i] > '9' {
+				return nil, moerr.NewInvalidInput(ctx, "invalid numeric value '%s'", s)
+			}
+		}
+	}
+	dscale := len(fracPart)
+
+	// align the integer part and the fraction part to the base 10000 digits
+	if pad := len(intPart) % 4; pad != 0 {
+		intPart = strings.Repeat("0", 4-pad) + intPart
+	}
+	if pad := len(fracPart) % 4; pad != 0 {
+		fracPart += strings.Repeat("0", 4-pad)
+	}
+	all := intPart + fracPart
+	digits := make([]int16, 0, len(all)/4)
+	for i := 0; i < len(all); i += 4 {
+		d, _ := strconv.Atoi(all[i : i+4])
+		digits = append(digits, int16(d))
+	}
+	weight := len(intPart)/4 - 1
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight = 0
+		sign = pgNumericPos
+	}
+
+	buf := make([]byte, 0, 8+2*len(digits))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(digits)))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(int16(weight)))
+	buf = binary.BigEndian.AppendUint16(buf, sign)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(dscale))
+	for _, d := range digits {
+		buf = binary.BigEndian.AppendUint16(buf, uint16(d))
+	}
+	return buf, nil
+}
+
+// decodePgNumeric decodes the binary format of numeric into the decimal string.
+func decodePgNumeric(ctx context.Context, data []byte) (string, error) {
+	if len(data) < 8 {
+		return "", moerr.NewInvalidInput(ctx, "invalid binary numeric")
+	}
+	ndigits := int(binary.BigEndian.Uint16(data))
+	weight := int(int16(binary.BigEndian.Uint16(data[2:])))
+	sign := binary.BigEndian.Uint16(data[4:])
+	dscale := int(binary.BigEndian.Uint16(data[6:]))
+	if len(data) != 8+2*ndigits {
+		return "", moerr.NewInvalidInput(ctx, "invalid binary numeric")
+	}
+	if sign == pgNumericNaN {
+		return "", moerr.NewNotSupported(ctx, "numeric NaN")
+	}
+
+	digit := func(i int) int {
+		if i < 0 || i >= ndigits {
+			return 0
+		}
+		return int(binary.BigEndian.Uint16(data[8+2*i:]))
+	}
+
+	var sb strings.Builder
+	if sign == pgNumericNeg {
+		sb.WriteByte('-')
+	}
+	if weight < 0 {
+		sb.WriteByte('0')
+	} else {
+		for i := 0; i <= weight; i++ {
+			if i == 0 {
+				sb.WriteString(strconv.Itoa(digit(i)))
+			} else {
+				sb.WriteString(leftPad4(digit(i)))
+			}
+		}
+	}
+	if dscale > 0 {
+		var frac strings.Builder
+		for i := weight + 1; frac.Len() < dscale; i++ {
+			frac.WriteString(leftPad4(digit(i)))
+		}
+		sb.WriteByte('.')
+		sb.WriteString(frac.String()[:dscale])
+	}
+	return sb.String(), nil
+}
+
+func leftPad4(d int) string {
+	s := strconv.Itoa(d)
+	return strings.Repeat("0", 4-len(s)) + s
+}
+
+// decodePgParam decodes the value of the parameter into the string which is
+// filled into the parameters of the prepared statement. It returns nil for NULL.
+func decodePgParam(ctx context.Context, oid uint32, format int16, data []byte) (any, error) {
+	if data == nil {
+		return nil, nil
+	}
+	if format == pgFormatText {
+		switch oid {
+		case pgTypeBool:
+			switch strings.ToLower(strings.TrimSpace(string(data))) {
+			case "t", "true", "y", "yes", "on", "1":
+				return "1", nil
+			default:
+				return "0", nil
+			}
+		case pgTypeBytea:
+			if len(data) >= 2 && data[0] == '\\' && data[1] == 'x' {
+				raw := make([]byte, hex.DecodedLen(len(data)-2))
+				if _, err := hex.Decode(raw, data[2:]); err != nil {
+					return nil, moerr.NewInvalidInput(ctx, "invalid bytea value")
+				}
+				return string(raw), nil
+			}
+		}
+		return string(data), nil
+	}
+
+	invalid := func() error {
+		return moerr.NewInvalidInput(ctx, "invalid binary value of the type %d", oid)
+	}
+	switch oid {
+	case pgTypeBool:
+		if len(data) != 1 {
+			return nil, invalid()
+		}
+		if data[0] != 0 {
+			return "1", nil
+		}
+		return "0", nil
+	case pgTypeInt2:
+		if len(data) != 2 {
+			return nil, invalid()
+		}
+		return strconv.FormatInt(int64(int16(binary.BigEndian.Uint16(data))), 10), nil
+	case pgTypeInt4:
+		if len(data) != 4 {
+			return nil, invalid()
+		}
+		return strconv.FormatInt(int64(int32(binary.BigEndian.Uint32(data))), 10), nil
+	case pgTypeInt8:
+		if len(data) != 8 {
+			return nil, invalid()
+		}
+		return strconv.FormatInt(int64(binary.BigEndian.Uint64(data)), 10), nil
+	case pgTypeFloat4:
+		if len(data) != 4 {
+			return nil, invalid()
+		}
+		return strconv.FormatFloat(float64(math.Float32frombits(binary.BigEndian.Uint32(data))), 'g', -1, 32), nil
+	case pgTypeFloat8:
+		if len(data) != 8 {
+			return nil, invalid()
+		}
+		return strconv.FormatFloat(math.Float64frombits(binary.BigEndian.Uint64(data)), 'g', -1, 64), nil
+	case pgTypeBytea, pgTypeText, pgTypeVarchar, pgTypeBpchar, pgTypeJson, pgTypeUnknown:
+		return string(data), nil
+	case pgTypeNumeric:
+		return decodePgNumeric(ctx, data)
+	case pgTypeDate:
+		if len(data) != 4 {
+			return nil, invalid()
+		}
+		d := pgEpochDate + types.Date(int32(binary.BigEndian.Uint32(data)))
+		return d.String(), nil
+	case pgTypeTime:
+		if len(data) != 8 {
+			return nil, invalid()
+		}
+		return types.Time(int64(binary.BigEndian.Uint64(data))).String2(6), nil
+	case pgTypeTimestamp:
+		if len(data) != 8 {
+			return nil, invalid()
+		}
+		dt := pgEpochDatetime + types.Datetime(int64(binary.BigEndian.Uint64(data)))
+		return dt.String2(6), nil
+	case pgTypeUuid:
+		if len(data) != 16 {
+			return nil, invalid()
+		}
+		var u types.Uuid
+		copy(u[:], data)
+		return u.String(), nil
+	}
+	return nil, moerr.NewNotSupported(ctx, "binary format of the type %d", oid)
+}
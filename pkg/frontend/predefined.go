@@ -34,7 +34,14 @@ var (
 				login_type  varchar(16),
 				creator int signed,
 				owner int signed,
-				default_role int signed
+				default_role int signed,
+				pg_auth_string varchar(300),
+				auth_plugin varchar(64),
+				sha2_auth_string varchar(128),
+				password_last_changed timestamp,
+				password_history text,
+				failed_logins int unsigned,
+				locked_time timestamp
     		)`
 
 	MoCatalogMoAccountDDL = `create table mo_catalog.mo_account (
@@ -150,6 +157,50 @@ var (
 			primary key(pitr_name, create_account)
 			)`, catalog.MO_CATALOG, catalog.MO_PITR)
 
+	MoCatalogMoCdcWatermarkDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			account_id bigint unsigned,
+			task_id varchar(64),
+			db_name varchar(256),
+			table_name varchar(256),
+			watermark varchar(128),
+			primary key(account_id, task_id, db_name, table_name)
+			)`, catalog.MO_CATALOG, catalog.MO_CDC_WATERMARK)
+
+	MoCatalogMoAuditLogDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			node_uuid varchar(64),
+			seq bigint unsigned,
+			event_time datetime(6),
+			event varchar(32),
+			class varchar(16),
+			account_id int unsigned,
+			account varchar(300),
+			user_name varchar(300),
+			role_name varchar(300),
+			host varchar(128),
+			connection_id int unsigned,
+			session_id varchar(64),
+			db_name varchar(5000),
+			statement_type varchar(64),
+			statement text,
+			code smallint unsigned,
+			error text,
+			prev_hash varchar(64),
+			hash varchar(64),
+			primary key(node_uuid, seq)
+			)`, catalog.MO_CATALOG, catalog.MO_AUDIT_LOG)
+
+	MoCatalogMoColumnStatisticsDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			account_id int unsigned,
+			database_id bigint unsigned,
+			table_id bigint unsigned,
+			database_name varchar(5000),
+			table_name varchar(5000),
+			column_name varchar(256),
+			histogram text,
+			update_time timestamp,
+			primary key(account_id, table_id, column_name)
+			)`, catalog.MO_CATALOG, catalog.MO_COLUMN_STATISTICS)
+
 	MoCatalogMoPubsDDL = `create table mo_catalog.mo_pubs (
     		pub_name varchar(64) primary key,
     		database_name varchar(5000),
@@ -215,6 +266,7 @@ var (
 	MoCatalogMoVariablesDDL      = `CREATE VIEW mo_catalog.mo_variables AS SELECT configuration_id, account_id, account_name, dat_name, variable_name, variable_value, system_variables FROM mo_catalog.mo_mysql_compatibility_mode`
 	MoCatalogMoTransactionsDDL   = `CREATE VIEW mo_catalog.mo_transactions AS SELECT cn_id, txn_id, create_ts, snapshot_ts, prepared_ts, commit_ts, txn_mode, isolation, user_txn, txn_status, table_id, lock_key, lock_content, lock_mode FROM mo_transactions() AS mo_transactions_tmp`
 	MoCatalogMoCacheDDL          = `CREATE VIEW mo_catalog.mo_cache AS SELECT node_type, node_id, type, used, free, hit_ratio FROM mo_cache() AS mo_cache_tmp`
+	MoCatalogMoQueryMemoryDDL    = `CREATE VIEW mo_catalog.mo_query_memory AS SELECT node_id, conn_id, session_id, account, user, statement_id, query_start, info, memory_used, memory_peak, memory_quota FROM mo_query_memory() AS mo_query_memory_tmp`
 )
 
 // `mo_catalog` database system tables
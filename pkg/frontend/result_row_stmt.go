@@ -168,6 +168,24 @@ func (resper *MysqlResp) respColumnDefsWithoutFlush(ses *Session, execCtx *ExecC
 	//execCtx.proto.DisableAutoFlush()
 	//defer execCtx.proto.EnableAutoFlush()
 
+	err = resper.respColumnDefs(ses, execCtx, columns)
+	if err != nil {
+		return
+	}
+	/*
+		mysql COM_QUERY response: End after the column has been sent.
+		send EOF packet
+	*/
+	err = resper.mysqlRrWr.WriteEOFIFAndNoFlush(0, ses.GetTxnHandler().GetServerStatus())
+	if err != nil {
+		return
+	}
+	return
+}
+
+// respColumnDefs sends the column count and the column definitions without
+// the EOF packet.
+func (resper *MysqlResp) respColumnDefs(ses *Session, execCtx *ExecCtx, columns []any) (err error) {
 	mrs := ses.GetMysqlResultSet()
 	/*
 		Step 1 : send column count and column definition.
@@ -204,14 +222,6 @@ func (resper *MysqlResp) respColumnDefsWithoutFlush(ses *Session, execCtx *ExecC
 			}
 		}
 	}
-	/*
-		mysql COM_QUERY response: End after the column has been sent.
-		send EOF packet
-	*/
-	err = resper.mysqlRrWr.WriteEOFIFAndNoFlush(0, ses.GetTxnHandler().GetServerStatus())
-	if err != nil {
-		return
-	}
 	return
 }
 
@@ -333,7 +333,8 @@ func (rt *Routine) handleRequest(req *Request) error {
 			metric.ConnectionCounter(ses.GetTenantInfo().GetTenant()).Dec()
 		})
 
-		//ensure cleaning the transaction
+		//ensure cleaning the cursor and the transaction
+		ses.closeCursor()
 		ses.Error(tenantCtx, "rollback the txn.")
 		tempExecCtx := ExecCtx{
 			ses:    ses,
@@ -417,8 +418,12 @@ func (rt *Routine) cleanup() {
 		rt.mc.waitAndClose()
 
 		ses := rt.getSession()
-		//step A: rollback the txn
 		if ses != nil {
+			auditDisconnect(context.Background(), ses)
+		}
+		//step A: close the cursor and rollback the txn
+		if ses != nil {
+			ses.closeCursor()
 			ses.EnterFPrint(FPCleanup)
 			defer ses.ExitFPrint(FPCleanup)
 			tempExecCtx := ExecCtx{
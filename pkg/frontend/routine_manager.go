@@ -47,6 +47,8 @@ type RoutineManager struct {
 	accountRoutine   *AccountRoutineManager
 	baseService      BaseService
 	sessionManager   *queryservice.SessionManager
+	// auditor writes the audit records of the sessions
+	auditor *auditLogger
 	// reportSystemStatusTime is the time when report system status last time.
 	reportSystemStatusTime atomic.Pointer[time.Time]
 }
@@ -223,6 +225,9 @@ func (rm *RoutineManager) setBaseService(baseService BaseService) {
 	rm.mu.Lock()
 	defer rm.mu.Unlock()
 	rm.baseService = baseService
+	if baseService != nil {
+		rm.auditor.setNode(baseService.ID())
+	}
 }
 
 func (rm *RoutineManager) setSessionMgr(sessionMgr *queryservice.SessionManager) {
@@ -236,6 +241,19 @@ func (rm *RoutineManager) GetAccountRoutineManager() *AccountRoutineManager {
 }
 
 func (rm *RoutineManager) Created(rs *Conn) error {
+	return rm.created(rs, func(sid string, connID uint32) MysqlRrWr {
+		return NewMysqlClientProtocol(sid, connID, rs, int(getGlobalPu().SV.MaxBytesInOutbufToFlush), getGlobalPu().SV)
+	})
+}
+
+// CreatedPg is the same as Created but serves the connection with the PostgreSQL protocol.
+func (rm *RoutineManager) CreatedPg(rs *Conn) error {
+	return rm.created(rs, func(sid string, connID uint32) MysqlRrWr {
+		return NewPgProtocol(sid, connID, rs, getGlobalPu().SV)
+	})
+}
+
+func (rm *RoutineManager) created(rs *Conn, newProtocol func(sid string, connID uint32) MysqlRrWr) error {
 	logutil.Debugf("get the connection from %s", rs.RemoteAddress())
 	createdStart := time.Now()
 	connID, err := rm.getConnID()
@@ -247,7 +265,7 @@ func (rm *RoutineManager) Created(rs *Conn) error {
 	if rm.baseService != nil {
 		sid = rm.baseService.ID()
 	}
-	pro := NewMysqlClientProtocol(sid, connID, rs, int(getGlobalPu().SV.MaxBytesInOutbufToFlush), getGlobalPu().SV)
+	pro := newProtocol(sid, connID)
 	routine := NewRoutine(rm.getCtx(), pro, getGlobalPu().SV)
 	v2.CreatedRoutineCounter.Inc()
 
@@ -263,7 +281,7 @@ func (rm *RoutineManager) Created(rs *Conn) error {
 	ses.SetFromRealUser(true)
 	ses.setRoutineManager(rm)
 	ses.setRoutine(routine)
-	ses.clientAddr = pro.Peer()
+	ses.clientAddr = rs.RemoteAddress()
 
 	ses.timestampMap[TSCreatedStart] = createdStart
 	defer func() {
@@ -272,15 +290,15 @@ func (rm *RoutineManager) Created(rs *Conn) error {
 	}()
 
 	routine.setSession(ses)
-	pro.SetSession(ses)
+	pro.Reset(ses)
 
 	ses.Debugf(cancelCtx, "have done some preparation for the connection %s", rs.RemoteAddress())
 
 	// With proxy module enabled, we try to update salt value and label info from proxy.
-	if getGlobalPu().SV.ProxyEnabled {
-		pro.receiveExtraInfo(rs)
+	if mp, ok := pro.(*MysqlProtocolImpl); ok && getGlobalPu().SV.ProxyEnabled {
+		mp.receiveExtraInfo(rs)
 	}
-	rm.setRoutine(rs, pro.connectionID, routine)
+	rm.setRoutine(rs, pro.GetU32(CONNID), routine)
 	ses.UpdateDebugString()
 	return nil
 }
@@ -467,6 +485,7 @@ func NewRoutineManager(ctx context.Context) (*RoutineManager, error) {
 		clients:          make(map[*Conn]*Routine),
 		routinesByConnID: make(map[uint32]*Routine),
 		accountRoutine:   accountRoutine,
+		auditor:          newAuditLogger(ctx),
 	}
 	if getGlobalPu().SV.EnableTls {
 		err := initTlsConfig(rm, getGlobalPu().SV)
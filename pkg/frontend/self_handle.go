@@ -385,6 +385,12 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		if err = handleShowBackendServers(ses, execCtx); err != nil {
 			return
 		}
+	case *tree.ShowMasterStatus:
+		ses.EnterFPrint(FPShowMasterStatus)
+		defer ses.ExitFPrint(FPShowMasterStatus)
+		if err = handleShowMasterStatus(execCtx.reqCtx, ses); err != nil {
+			return
+		}
 	case *tree.SetTransaction:
 		ses.EnterFPrint(FPSetTransaction)
 		defer ses.ExitFPrint(FPSetTransaction)
@@ -465,11 +471,41 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		defer ses.ExitFPrint(FPSetConnectionID)
 		ses.SetConnectionID(st.ConnectionID)
 	case *tree.CreateCDC:
+		ses.EnterFPrint(FPCreateCdc)
+		defer ses.ExitFPrint(FPCreateCdc)
+		if err = handleCreateCdc(execCtx.reqCtx, ses, st); err != nil {
+			return
+		}
 	case *tree.PauseCDC:
+		ses.EnterFPrint(FPPauseCdc)
+		defer ses.ExitFPrint(FPPauseCdc)
+		if err = handlePauseCdc(execCtx.reqCtx, ses, st); err != nil {
+			return
+		}
 	case *tree.DropCDC:
+		ses.EnterFPrint(FPDropCdc)
+		defer ses.ExitFPrint(FPDropCdc)
+		if err = handleDropCdc(execCtx.reqCtx, ses, st); err != nil {
+			return
+		}
 	case *tree.RestartCDC:
+		ses.EnterFPrint(FPRestartCdc)
+		defer ses.ExitFPrint(FPRestartCdc)
+		if err = handleRestartCdc(execCtx.reqCtx, ses, st); err != nil {
+			return
+		}
 	case *tree.ResumeCDC:
+		ses.EnterFPrint(FPResumeCdc)
+		defer ses.ExitFPrint(FPResumeCdc)
+		if err = handleResumeCdc(execCtx.reqCtx, ses, st); err != nil {
+			return
+		}
 	case *tree.ShowCDC:
+		ses.EnterFPrint(FPShowCdc)
+		defer ses.ExitFPrint(FPShowCdc)
+		if err = handleShowCdc(execCtx.reqCtx, ses, st); err != nil {
+			return
+		}
 	}
 	return
 }
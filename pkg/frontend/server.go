@@ -53,6 +53,8 @@ type MOServer struct {
 
 	pu        *config.ParameterUnit
 	listeners []net.Listener
+	// pgListener serves the PostgreSQL protocol. It is nil if the pg-port is not set.
+	pgListener net.Listener
 }
 
 // BaseService is an interface which indicates that the instance is
@@ -78,6 +80,9 @@ func (mo *MOServer) GetRoutineManager() *RoutineManager {
 
 func (mo *MOServer) Start() error {
 	logutil.Infof("Server Listening on : %s ", mo.addr)
+	if mo.pgListener != nil {
+		logutil.Infof("Server Listening on : %s for PostgreSQL protocol", mo.pgListener.Addr())
+	}
 	mo.startListener()
 	setMoServerStarted(true)
 	return nil
@@ -98,6 +103,11 @@ func (mo *MOServer) Stop() error {
 			errors = append(errors, err)
 		}
 	}
+	if mo.pgListener != nil {
+		if err := mo.pgListener.Close(); err != nil {
+			errors = append(errors, err)
+		}
+	}
 	if len(errors) > 0 {
 		return errors[0]
 	}
@@ -110,6 +120,9 @@ func (mo *MOServer) Stop() error {
 			return err
 		}
 	}
+	if err := mo.rm.auditor.Close(); err != nil {
+		return err
+	}
 	logutil.Debug("application stopped")
 	return nil
 }
@@ -122,11 +135,15 @@ func (mo *MOServer) startListener() {
 
 	for _, listener := range mo.listeners {
 		mo.wg.Add(1)
-		go mo.startAccept(listener)
+		go mo.startAccept(listener, mo.handleConn)
+	}
+	if mo.pgListener != nil {
+		mo.wg.Add(1)
+		go mo.startAccept(mo.pgListener, mo.handlePgConn)
 	}
 }
 
-func (mo *MOServer) startAccept(listener net.Listener) {
+func (mo *MOServer) startAccept(listener net.Listener, handleConn func(net.Conn)) {
 	defer mo.wg.Done()
 
 	var tempDelay time.Duration
@@ -150,7 +167,7 @@ func (mo *MOServer) startAccept(listener net.Listener) {
 		}
 		tempDelay = 0
 
-		go mo.handleConn(conn)
+		go handleConn(conn)
 
 	}
 }
@@ -185,6 +202,88 @@ func (mo *MOServer) handleConn(conn net.Conn) {
 	mo.handleLoop(rs)
 }
 
+// handlePgConn serves the connection from the PostgreSQL listener.
+func (mo *MOServer) handlePgConn(conn net.Conn) {
+	var rs *Conn
+	var err error
+	defer func() {
+		if rs != nil {
+			err = rs.Close()
+			if err != nil {
+				logutil.Error("Handle conn error", zap.Error(err))
+				return
+			}
+		}
+	}()
+
+	rs, err = NewIOSession(conn, mo.pu)
+	if err != nil {
+		logutil.Error("NewIOSession error", zap.Error(err))
+		return
+	}
+	err = mo.rm.CreatedPg(rs)
+	if err != nil {
+		logutil.Error("Create routine error", zap.Error(err))
+		return
+	}
+	routine := mo.rm.getRoutine(rs)
+	if routine == nil {
+		logutil.Error("Failed to handshake with server, routine does not exist...")
+		return
+	}
+	ok, err := mo.pgHandshake(routine)
+	if err != nil {
+		logutil.Error("HandShake error", zap.Error(err))
+		return
+	}
+	if !ok {
+		return
+	}
+	if err = routine.getProtocol().(*PgProtocolImpl).serve(routine); err != nil && !skipClientQuit(err.Error()) {
+		logutil.Error("handle session failed", zap.Error(err))
+	}
+}
+
+// pgHandshake processes the startup messages and authenticates the user of the
+// PostgreSQL connection. It returns false if the connection needs no more serving.
+func (mo *MOServer) pgHandshake(routine *Routine) (bool, error) {
+	rm := mo.rm
+	ctx, span := trace.Start(rm.getCtx(), "RoutineManager.Handler",
+		trace.WithKind(trace.SpanKindStatement))
+	defer span.End()
+
+	tempCtx, tempCancel := context.WithTimeout(ctx, getGlobalPu().SV.SessionTimeout.Duration)
+	defer tempCancel()
+
+	protocol := routine.getProtocol().(*PgProtocolImpl)
+	ses := routine.getSession()
+	ts := ses.timestampMap
+
+	ts[TSEstablishStart] = time.Now()
+	ses.Debugf(tempCtx, "HANDLE PG STARTUP")
+	ok, err := protocol.handshake(tempCtx, rm.getTlsConfig())
+	if err != nil || !ok {
+		return false, err
+	}
+	if err = protocol.Authenticate(tempCtx); err != nil {
+		return false, err
+	}
+	protocol.SetBool(ESTABLISHED, true)
+	ts[TSEstablishEnd] = time.Now()
+	v2.EstablishDurationHistogram.Observe(ts[TSEstablishEnd].Sub(ts[TSEstablishStart]).Seconds())
+	ses.Info(ctx, fmt.Sprintf("mo accept PostgreSQL connection, time cost of Created: %s, Establish: %s, Authenticate: %s",
+		ts[TSCreatedEnd].Sub(ts[TSCreatedStart]).String(),
+		ts[TSEstablishEnd].Sub(ts[TSEstablishStart]).String(),
+		ts[TSAuthenticateEnd].Sub(ts[TSAuthenticateStart]).String()))
+
+	dbName := protocol.GetStr(DBNAME)
+	if dbName != "" {
+		ses.SetDatabaseName(dbName)
+	}
+	rm.sessionManager.AddSession(ses)
+	return true, nil
+}
+
 func (mo *MOServer) handleLoop(rs *Conn) {
 	if err := mo.handleMessage(rs); err != nil {
 		logutil.Error("handle session failed", zap.Error(err))
@@ -417,6 +516,13 @@ func NewMOServer(
 		}
 		mo.listeners = append(mo.listeners, listenerUnix)
 	}
+	if pu.SV.PgPort > 0 {
+		listenerPg, err := net.Listen("tcp", fmt.Sprintf("%s:%d", pu.SV.Host, pu.SV.PgPort))
+		if err != nil {
+			logutil.Panicf("start server failed with %+v", err)
+		}
+		mo.pgListener = listenerPg
+	}
 	return mo
 }
 
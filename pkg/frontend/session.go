@@ -246,6 +246,18 @@ type Session struct {
 	clientAddr string
 	proxyAddr  string
 
+	// auditConnected is true after the login of the client is recorded, the
+	// logout and the statements of the client are recorded as well.
+	auditConnected bool
+
+	// passwordExpired is true if the password of the user has expired. The
+	// statements except changing the password are rejected until it is changed.
+	passwordExpired bool
+
+	// cursor is the open cursor of the prepared statements, it is protected
+	// by mu.
+	cursor *stmtCursor
+
 	disableTrace bool
 
 	// disableAgg co-operate with RecordStatement
@@ -477,16 +489,27 @@ func (ses *Session) getQueryId(internalSql bool) []string {
 }
 
 type errInfo struct {
+	levels []string
 	codes  []uint16
 	msgs   []string
 	maxCnt int
 }
 
 func (e *errInfo) push(code uint16, msg string) {
+	e.pushWithLevel("Error", code, msg)
+}
+
+func (e *errInfo) pushWarning(code uint16, msg string) {
+	e.pushWithLevel("Warning", code, msg)
+}
+
+func (e *errInfo) pushWithLevel(level string, code uint16, msg string) {
 	if e.maxCnt > 0 && len(e.codes) > e.maxCnt {
+		e.levels = e.levels[1:]
 		e.codes = e.codes[1:]
 		e.msgs = e.msgs[1:]
 	}
+	e.levels = append(e.levels, level)
 	e.codes = append(e.codes, code)
 	e.msgs = append(e.msgs, msg)
 }
@@ -519,6 +542,7 @@ func NewSession(
 			respr:          NewMysqlResp(proto),
 		},
 		errInfo: &errInfo{
+			levels: make([]string, 0, MoDefaultErrorCount),
 			codes:  make([]uint16, 0, MoDefaultErrorCount),
 			msgs:   make([]string, 0, MoDefaultErrorCount),
 			maxCnt: MoDefaultErrorCount,
@@ -1108,6 +1132,8 @@ func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbNa
 	var pwd, accountStatus string
 	var accountVersion uint64
 
+	ses.passwordExpired = false
+
 	//Get tenant info
 	tenant, err = GetTenantInfo(ctx, userInput)
 	if err != nil {
@@ -1289,6 +1315,28 @@ func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbNa
 		v2.CheckRoleDurationHistogram.Observe(ses.timestampMap[TSCheckRoleEnd].Sub(ses.timestampMap[TSCheckRoleStart]).Seconds())
 	}
 	//------------------------------------------------------------------------------------------------------------------
+	// the login policy is in the global system variables of the account
+	if err = ses.InitSystemVariables(ctx); err != nil {
+		return nil, err
+	}
+	policy := getLoginPolicy(ses.GetGlobalSysVars())
+	now := time.Now().Unix()
+	state, err := getLoginStateOfUser(tenantCtx, ses, userID)
+	if err != nil {
+		// the columns of the login state do not exist before the tenant is
+		// upgraded, any other error must not skip the lock check.
+		if !isMissingColumn(err) {
+			return nil, err
+		}
+		ses.Warnf(tenantCtx, "failed to get the login state of user %s: %s", tenant.GetUser(), err.Error())
+		state = nil
+	}
+	if state != nil {
+		if err = checkLoginState(tenantCtx, ses, tenant.GetUser(), userID, policy, state, now); err != nil {
+			return nil, err
+		}
+	}
+
 	psw, err := GetPassWord(pwd)
 	if err != nil {
 		return nil, err
@@ -1297,11 +1345,19 @@ func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbNa
 	// TO Check password
 	if checkPassword(psw, salt, authResponse) {
 		ses.Debug(tenantCtx, "check password succeeded")
-		if err = ses.InitSystemVariables(ctx); err != nil {
-			return nil, err
+		if state != nil {
+			if state.failedLogins != 0 {
+				if _, err = executeSQLInBackgroundSession(tenantCtx, ses, getSqlForResetFailedLoginsOfUser(userID)); err != nil {
+					return nil, err
+				}
+			}
+			ses.passwordExpired = policy.passwordExpired(state, now)
 		}
 	} else {
-		return nil, moerr.NewInternalError(tenantCtx, "check password failed")
+		if state == nil {
+			return nil, moerr.NewInternalError(tenantCtx, "check password failed")
+		}
+		return nil, recordFailedLogin(tenantCtx, ses, tenant.GetUser(), userID, policy, now)
 	}
 
 	// If the login information contains the database name, verify if the database exists
@@ -1473,6 +1529,12 @@ func (ses *Session) StatusSession() *status.Session {
 			}
 		}
 	}
+	// the memory of the running query in this CN.
+	var memUsed, memPeak, memQuota int64
+	if ses.GetQueryInProgress() && ses.GetMemPool() != nil {
+		memUsed, memPeak = ses.GetMemPool().QuotaUsage()
+		memQuota = ses.GetMemPool().Quota()
+	}
 	return &status.Session{
 		NodeID:        ses.getRoutineManager().baseService.ID(),
 		ConnID:        ses.GetConnectionID(),
@@ -1494,6 +1556,9 @@ func (ses *Session) StatusSession() *status.Session {
 		Role:          roleName,
 		FromProxy:     ses.fromProxy,
 		ProxyHost:     ses.proxyAddr,
+		MemoryUsed:    memUsed,
+		MemoryPeak:    memPeak,
+		MemoryQuota:   memQuota,
 	}
 }
 
@@ -1572,6 +1637,39 @@ func (ses *Session) reset(prev *Session) error {
 	return nil
 }
 
+// ResetConnection resets the session state for the COM_RESET_CONNECTION and
+// the COM_CHANGE_USER. The active txn is rolled back, the temporary tables,
+// user variables and prepared statements are dropped, and the session system
+// variables are restored to the defaults.
+func (ses *Session) ResetConnection(execCtx *ExecCtx) error {
+	tempExecCtx := ExecCtx{
+		reqCtx: execCtx.reqCtx,
+		ses:    ses,
+		txnOpt: FeTxnOption{byRollback: true},
+	}
+	defer tempExecCtx.Close()
+	txnHandler := ses.GetTxnHandler()
+	if err := txnHandler.Rollback(&tempExecCtx); err != nil {
+		return err
+	}
+	txnHandler.setAutocommitOn()
+	txnHandler.dropTempEngine()
+
+	ses.mu.Lock()
+	ses.userDefinedVars = make(map[string]*UserDefinedVar)
+	for _, stmt := range ses.prepareStmts {
+		stmt.Close()
+	}
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	ses.lastInsertID = 0
+	ses.seqCurValues = make(map[uint64]string)
+	*ses.seqLastValue = ""
+	ses.mu.Unlock()
+	ses.cleanCache()
+
+	return ses.InitSystemVariables(execCtx.reqCtx)
+}
+
 func checkPlanIsInsertValues(proc *process.Process,
 	p *plan.Plan) (bool, [][]colexec.ExpressionExecutor) {
 	qry := p.GetQuery()
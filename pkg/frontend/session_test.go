@@ -603,3 +603,37 @@ func TestSession_Migrate(t *testing.T) {
 	assert.Equal(t, "d1", s.GetDatabaseName())
 	assert.Equal(t, 2, len(s.prepareStmts))
 }
+
+func TestSession_ResetConnection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ctx := context.TODO()
+
+	assert.NoError(t, ses.SetUserDefinedVar("a", int64(1), "set @a = 1"))
+	assert.NoError(t, ses.SetPrepareStmt(ctx, "stmt1", &PrepareStmt{Name: "stmt1"}))
+	assert.NoError(t, ses.SetSessionSysVar(ctx, "autocommit", "off"))
+	autocommit, err := ses.GetSessionSysVar("autocommit")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, autocommit)
+	ses.SetLastInsertID(10)
+	ses.GetTxnHandler().CreateTempEngine()
+	assert.True(t, ses.GetTxnHandler().HasTempEngine())
+
+	stubs := gostub.StubFunc(&ExeSqlInBgSes, nil, nil)
+	defer stubs.Reset()
+	ec := &ExecCtx{reqCtx: ctx, ses: ses}
+	assert.NoError(t, ses.ResetConnection(ec))
+
+	v, err := ses.GetUserDefinedVar("a")
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	assert.Empty(t, ses.GetPrepareStmts())
+	autocommit, err = ses.GetSessionSysVar("autocommit")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, autocommit)
+	assert.Equal(t, uint64(0), ses.GetLastInsertID())
+	assert.False(t, ses.GetTxnHandler().HasTempEngine())
+	assert.False(t, ses.GetTxnHandler().InActiveTxn())
+}
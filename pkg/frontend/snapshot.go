@@ -116,9 +116,15 @@ var (
 		"mo_variables":      1,
 		"mo_transactions":   1,
 		"mo_cache":          1,
+		"mo_query_memory":   1,
 
 		catalog.MO_SNAPSHOTS: 1,
 		catalog.MO_PITR:      1,
+
+		catalog.MO_CDC_WATERMARK: 1,
+		catalog.MO_AUDIT_LOG:     1,
+
+		catalog.MO_COLUMN_STATISTICS: 1,
 	}
 )
 
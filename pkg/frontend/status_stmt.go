@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -51,9 +52,14 @@ func executeStatusStmt(ses *Session, execCtx *ExecCtx) (err error) {
 			// open new file
 			ep.DefaultBufSize = getGlobalPu().SV.ExportDataDefaultFlushSize
 			initExportFileParam(ep, mrs)
-			if err = openNewFile(execCtx.reqCtx, ep, mrs); err != nil {
+			if ep.writer, err = newExportWriter(execCtx.reqCtx, ses, ep, plan2.GetResultColumnsFromPlan(execCtx.cw.Plan())); err != nil {
 				return
 			}
+			if ep.writer == nil {
+				if err = openNewFile(execCtx.reqCtx, ep, mrs); err != nil {
+					return
+				}
+			}
 
 			ep.init()
 			fPrintTxnOp := execCtx.ses.GetTxnHandler().GetTxn()
@@ -73,6 +79,12 @@ func executeStatusStmt(ses *Session, execCtx *ExecCtx) (err error) {
 				ses.Infof(execCtx.reqCtx, "time of Exec.Run : %s", time.Since(runBegin).String())
 			}
 
+			if ep.writer != nil {
+				if err = ep.writer.close(); err != nil {
+					return
+				}
+				break
+			}
 			if err = exportAllData(ep); err != nil {
 				return
 			}
@@ -176,8 +176,10 @@ func statementCanBeExecutedInUncommittedTransaction(ctx context.Context, ses FeS
 		*tree.ShowSubscriptions,
 		*tree.ShowCreatePublications,
 		*tree.ShowBackendServers,
+		*tree.ShowMasterStatus,
 		*tree.ShowAccountUpgrade,
-		*tree.ShowConnectors:
+		*tree.ShowConnectors,
+		*tree.ShowCDC:
 		return true, nil
 		//others
 	case *tree.ExplainStmt, *tree.ExplainAnalyze, *tree.ExplainFor, *InternalCmdFieldList:
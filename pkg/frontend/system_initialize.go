@@ -85,9 +85,11 @@ func createTablesInMoCatalog(ctx context.Context, txn executor.TxnExecutor, fina
 
 	//encryption the password
 	encryption := HashPassWord(defaultPassword)
+	pgEncryption := HashPgPassWord(defaultPassword)
+	sha2Encryption := HashSha2PassWord(defaultPassword)
 
-	initMoUser1 := fmt.Sprintf(initMoUserFormat, rootID, rootHost, rootName, encryption, rootStatus, types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType, rootCreatorID, rootOwnerRoleID, rootDefaultRoleID)
-	initMoUser2 := fmt.Sprintf(initMoUserFormat, dumpID, dumpHost, dumpName, encryption, dumpStatus, types.CurrentTimestamp().String2(time.UTC, 0), dumpExpiredTime, dumpLoginType, dumpCreatorID, dumpOwnerRoleID, dumpDefaultRoleID)
+	initMoUser1 := fmt.Sprintf(initMoUserFormat, rootID, rootHost, rootName, encryption, rootStatus, types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType, rootCreatorID, rootOwnerRoleID, rootDefaultRoleID, pgEncryption, AuthNativePassword, sha2Encryption)
+	initMoUser2 := fmt.Sprintf(initMoUserFormat, dumpID, dumpHost, dumpName, encryption, dumpStatus, types.CurrentTimestamp().String2(time.UTC, 0), dumpExpiredTime, dumpLoginType, dumpCreatorID, dumpOwnerRoleID, dumpDefaultRoleID, pgEncryption, AuthNativePassword, sha2Encryption)
 	addSqlIntoSet(initMoUser1)
 	addSqlIntoSet(initMoUser2)
 
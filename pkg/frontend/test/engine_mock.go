@@ -1020,6 +1020,21 @@ func (mr *MockRelationMockRecorder) CollectTombstones(ctx, txnOffset interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectTombstones", reflect.TypeOf((*MockRelation)(nil).CollectTombstones), ctx, txnOffset)
 }
 
+// CollectChanges mocks base method.
+func (m *MockRelation) CollectChanges(ctx context.Context, from, to types.TS, mp *mpool.MPool) (engine.ChangesHandle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CollectChanges", ctx, from, to, mp)
+	ret0, _ := ret[0].(engine.ChangesHandle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CollectChanges indicates an expected call of CollectChanges.
+func (mr *MockRelationMockRecorder) CollectChanges(ctx, from, to, mp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectChanges", reflect.TypeOf((*MockRelation)(nil).CollectChanges), ctx, from, to, mp)
+}
+
 // CopyTableDef mocks base method.
 func (m *MockRelation) CopyTableDef(arg0 context.Context) *plan.TableDef {
 	m.ctrl.T.Helper()
@@ -1384,6 +1399,59 @@ func (mr *MockRelationMockRecorder) Write(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockRelation)(nil).Write), arg0, arg1)
 }
 
+// MockChangesHandle is a mock of ChangesHandle interface.
+type MockChangesHandle struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangesHandleMockRecorder
+}
+
+// MockChangesHandleMockRecorder is the mock recorder for MockChangesHandle.
+type MockChangesHandleMockRecorder struct {
+	mock *MockChangesHandle
+}
+
+// NewMockChangesHandle creates a new mock instance.
+func NewMockChangesHandle(ctrl *gomock.Controller) *MockChangesHandle {
+	mock := &MockChangesHandle{ctrl: ctrl}
+	mock.recorder = &MockChangesHandleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangesHandle) EXPECT() *MockChangesHandleMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockChangesHandle) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockChangesHandleMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockChangesHandle)(nil).Close))
+}
+
+// Next mocks base method.
+func (m *MockChangesHandle) Next(ctx context.Context, mp *mpool.MPool) (*batch.Batch, *batch.Batch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Next", ctx, mp)
+	ret0, _ := ret[0].(*batch.Batch)
+	ret1, _ := ret[1].(*batch.Batch)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Next indicates an expected call of Next.
+func (mr *MockChangesHandleMockRecorder) Next(ctx, mp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Next", reflect.TypeOf((*MockChangesHandle)(nil).Next), ctx, mp)
+}
+
 // MockReader is a mock of Reader interface.
 type MockReader struct {
 	ctrl     *gomock.Controller
@@ -1892,6 +1960,18 @@ func (mr *MockEngineMockRecorder) Stats(ctx, key, sync interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockEngine)(nil).Stats), ctx, key, sync)
 }
 
+// SetHistograms mocks base method.
+func (m *MockEngine) SetHistograms(key statsinfo.StatsInfoKey, histograms map[string]*statsinfo.Histogram) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetHistograms", key, histograms)
+}
+
+// SetHistograms indicates an expected call of SetHistograms.
+func (mr *MockEngineMockRecorder) SetHistograms(key, histograms interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHistograms", reflect.TypeOf((*MockEngine)(nil).SetHistograms), key, histograms)
+}
+
 // TryToSubscribeTable mocks base method.
 func (m *MockEngine) TryToSubscribeTable(arg0 context.Context, arg1, arg2 uint64) error {
 	m.ctrl.T.Helper()
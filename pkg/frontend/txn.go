@@ -822,3 +822,16 @@ func (th *TxnHandler) GetTempEngine() *memoryengine.Engine {
 	defer th.mu.Unlock()
 	return th.tempEngine
 }
+
+// dropTempEngine drops the temporary storage and engine. All the temporary
+// tables created in the session are discarded.
+func (th *TxnHandler) dropTempEngine() {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	th.tempStorage = nil
+	th.tempTnService = nil
+	th.tempEngine = nil
+	if ee, ok := th.storage.(*engine.EntireEngine); ok && ee != nil {
+		ee.TempEngine = nil
+	}
+}
@@ -136,6 +136,13 @@ const (
 	FPAlterPitr
 	FPRestorePitr
 	FPSetConnectionID
+	FPCreateCdc
+	FPPauseCdc
+	FPDropCdc
+	FPRestartCdc
+	FPResumeCdc
+	FPShowCdc
+	FPShowMasterStatus
 	FPRollbackTxn
 	FPCommitTxn
 	FPFinishTxn
@@ -258,6 +265,8 @@ type PrepareStmt struct {
 
 	params              *vector.Vector
 	getFromSendLongData map[int]struct{}
+	// cursorType is the flag of the COM_STMT_EXECUTE in executing
+	cursorType uint8
 
 	compile *compile.Compile
 }
@@ -354,6 +363,16 @@ func getStatementType(stmt tree.Statement) tree.StatementType {
 //	tableInfos map[string][]ColumnInfo
 //}
 
+// resetParams clears the parameters of the last execution.
+func (prepareStmt *PrepareStmt) resetParams() {
+	if prepareStmt.params != nil {
+		prepareStmt.params.GetNulls().Reset()
+		for k := range prepareStmt.getFromSendLongData {
+			delete(prepareStmt.getFromSendLongData, k)
+		}
+	}
+}
+
 func (prepareStmt *PrepareStmt) Close() {
 	if prepareStmt.params != nil {
 		prepareStmt.params.Free(prepareStmt.proc.Mp())
@@ -559,6 +578,8 @@ type ExecCtx struct {
 	results           []ExecResult
 	prepareColDef     [][]byte
 	isIssue3482       bool
+	// cursor receives the result of the COM_STMT_EXECUTE with a read-only cursor
+	cursor *stmtCursor
 }
 
 func (execCtx *ExecCtx) Close() {
@@ -580,6 +601,7 @@ func (execCtx *ExecCtx) Close() {
 	execCtx.resper = nil
 	execCtx.results = nil
 	execCtx.prepareColDef = nil
+	execCtx.cursor = nil
 }
 
 // outputCallBackFunc is the callback function to send the result to the client.
@@ -1140,6 +1162,8 @@ type MysqlReader interface {
 	Free(buf []byte)
 	HandleHandshake(ctx context.Context, payload []byte) (bool, error)
 	Authenticate(ctx context.Context) error
+	// HandleChangeUser authenticates the user in the COM_CHANGE_USER
+	HandleChangeUser(ctx context.Context, payload []byte) error
 	ParseSendLongData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error
 	ParseExecuteData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error
 }
@@ -1166,6 +1190,8 @@ type MysqlWriter interface {
 	WriteResponse(context.Context, *Response) error
 	WritePrepareResponse(ctx context.Context, stmt *PrepareStmt) error
 	WriteLocalInfileRequest(filepath string) error
+	// WriteBinlogEvents sends the events of the binlog dump
+	WriteBinlogEvents(events [][]byte) error
 
 	CalculateOutTrafficBytes(b bool) (int64, int64)
 	ResetStatistics()
@@ -491,6 +491,13 @@ func logStatementStatus(ctx context.Context, ses FeSession, stmt tree.Statement,
 	} else {
 		stmtStr = stm.Statement
 	}
+	if s, ok := ses.(*Session); ok && stmt != nil {
+		if status == success {
+			auditStatement(ctx, s, stmt, stmtStr, nil)
+		} else {
+			auditStatement(ctx, s, stmt, stmtStr, err)
+		}
+	}
 	logStatementStringStatus(ctx, ses, stmtStr, status, err)
 }
 
@@ -681,8 +688,22 @@ func RewriteError(err error, username string) (uint16, string, string) {
 	return errorCode, sqlState, msg
 }
 
+// isCheckPasswordFailed returns true if the error is the wrong password.
+func isCheckPasswordFailed(err error) bool {
+	return strings.Contains(err.Error(), "check password failed")
+}
+
+// isMissingColumn returns true if the error is a column which does not exist,
+// like the columns of mo_catalog added by an upgrade which has not run yet.
+func isMissingColumn(err error) bool {
+	return moerr.IsMoErrCode(err, moerr.ErrInvalidInput) &&
+		strings.Contains(err.Error(), "column") &&
+		strings.Contains(err.Error(), "does not exist")
+}
+
 func needConvertedToAccessDeniedError(errMsg string) bool {
 	if strings.Contains(errMsg, "check password failed") ||
+		strings.Contains(errMsg, "is locked") ||
 		/*
 			following two cases are suggested by the peers from the mo cloud team.
 			we keep the consensus with them.
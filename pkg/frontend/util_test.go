@@ -1299,3 +1299,10 @@ func Test_issue3482(t *testing.T) {
 	ui := UserInput{sql: s}
 	assert.True(t, ui.isIssue3482Sql())
 }
+
+func Test_isMissingColumn(t *testing.T) {
+	require.True(t, isMissingColumn(moerr.NewInvalidInput(context.TODO(), "column %s does not exist", "failed_logins")))
+	require.False(t, isMissingColumn(moerr.NewInvalidInput(context.TODO(), "there is no user %d", 1)))
+	require.False(t, isMissingColumn(moerr.NewInternalError(context.TODO(), "column failed_logins does not exist")))
+	require.False(t, isMissingColumn(context.DeadlineExceeded))
+}
@@ -975,6 +975,13 @@ func (m *GlobalSysVarsMgr) Get(accountId uint32, ses *Session, ctx context.Conte
 	return m.accountsGlobalSysVarsMap[accountId], nil
 }
 
+// cached returns the sys vars of accountId if they have been loaded, or nil.
+func (m *GlobalSysVarsMgr) cached(accountId uint32) *SystemVariables {
+	m.Lock()
+	defer m.Unlock()
+	return m.accountsGlobalSysVarsMap[accountId]
+}
+
 func (m *GlobalSysVarsMgr) Put(accountId uint32, vars *SystemVariables) {
 	m.Lock()
 	defer m.Unlock()
@@ -1348,6 +1355,15 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableUintType("query_result_maxsize", 0, 18446744073709551615),
 		Default:           uint64(100),
 	},
+	// the max bytes of memory a query can use in a CN, 0 means no limit.
+	"query_memory_limit": {
+		Name:              "query_memory_limit",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("query_memory_limit", 0, math.MaxInt64, false),
+		Default:           int64(0),
+	},
 	//whether TN does primary key uniqueness check against transaction's workspace or not.
 	"mo_pk_check_by_dn": {
 		Name:              "mo_pk_check_by_dn",
@@ -1797,6 +1813,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableStringType("external_user"),
 		Default:           "",
 	},
+	"failed_login_attempts": {
+		Name:              "failed_login_attempts",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("failed_login_attempts", 0, 32767, false),
+		Default:           int64(0),
+	},
 	"flush": {
 		Name:              "flush",
 		Scope:             ScopeGlobal,
@@ -2653,6 +2677,14 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableIntType("password_history", 0, 4294967295, false),
 		Default:           int64(0),
 	},
+	"password_lock_time": {
+		Name:              "password_lock_time",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("password_lock_time", 0, 32767, true),
+		Default:           int64(1),
+	},
 	"password_require_current": {
 		Name:              "password_require_current",
 		Scope:             ScopeGlobal,
@@ -3109,6 +3141,110 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("sql_log_bin"),
 		Default:           int64(0),
 	},
+	"log_bin": {
+		Name:              "log_bin",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("log_bin"),
+		Default:           int64(1),
+	},
+	"binlog_format": {
+		Name:              "binlog_format",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("binlog_format"),
+		Default:           "ROW",
+	},
+	"binlog_row_image": {
+		Name:              "binlog_row_image",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("binlog_row_image"),
+		Default:           "MINIMAL",
+	},
+	"binlog_checksum": {
+		Name:              "binlog_checksum",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("binlog_checksum"),
+		Default:           "NONE",
+	},
+	"gtid_mode": {
+		Name:              "gtid_mode",
+		Scope:             ScopeGlobal,
+		Dynamic:           false,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("gtid_mode"),
+		Default:           "OFF",
+	},
+	"server_id": {
+		Name:              "server_id",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("server_id", 0, math.MaxUint32, false),
+		Default:           int64(1),
+	},
+	"audit_log": {
+		Name:              "audit_log",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("audit_log"),
+		Default:           int64(0),
+	},
+	"audit_log_sink": {
+		Name:              "audit_log_sink",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemSystemEnumType("audit_log_sink", "FILE", "TABLE"),
+		Default:           "FILE",
+	},
+	"audit_log_classes": {
+		Name:              "audit_log_classes",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("audit_log_classes"),
+		Default:           "CONNECT,DDL,DCL",
+	},
+	"audit_log_include_users": {
+		Name:              "audit_log_include_users",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("audit_log_include_users"),
+		Default:           "",
+	},
+	"audit_log_exclude_users": {
+		Name:              "audit_log_exclude_users",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("audit_log_exclude_users"),
+		Default:           "",
+	},
+	"audit_log_include_databases": {
+		Name:              "audit_log_include_databases",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("audit_log_include_databases"),
+		Default:           "",
+	},
+	"audit_log_exclude_databases": {
+		Name:              "audit_log_exclude_databases",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("audit_log_exclude_databases"),
+		Default:           "",
+	},
 	"sql_notes": {
 		Name:              "sql_notes",
 		Scope:             ScopeBoth,
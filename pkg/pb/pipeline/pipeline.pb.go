@@ -793,6 +793,7 @@ type Group struct {
 	PreAllocSize         uint64           `protobuf:"varint,7,opt,name=preAllocSize,proto3" json:"preAllocSize,omitempty"`
 	PartialResults       []byte           `protobuf:"bytes,8,opt,name=PartialResults,proto3" json:"PartialResults,omitempty"`
 	PartialResultTypes   []uint32         `protobuf:"varint,9,rep,packed,name=PartialResultTypes,proto3" json:"PartialResultTypes,omitempty"`
+	GroupingIds          []uint64         `protobuf:"varint,10,rep,packed,name=grouping_ids,json=groupingIds,proto3" json:"grouping_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -894,6 +895,13 @@ func (m *Group) GetPartialResultTypes() []uint32 {
 	return nil
 }
 
+func (m *Group) GetGroupingIds() []uint64 {
+	if m != nil {
+		return m.GroupingIds
+	}
+	return nil
+}
+
 type Insert struct {
 	Affected        uint64          `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"`
 	ToWriteS3       bool            `protobuf:"varint,2,opt,name=ToWriteS3,proto3" json:"ToWriteS3,omitempty"`
@@ -4322,11 +4330,13 @@ func (m *NodeInfo) GetPayload() string {
 }
 
 type ProcessLimitation struct {
-	Size                 int64    `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
-	BatchRows            int64    `protobuf:"varint,2,opt,name=batch_rows,json=batchRows,proto3" json:"batch_rows,omitempty"`
-	BatchSize            int64    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	PartitionRows        int64    `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
-	ReaderSize           int64    `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	Size          int64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	BatchRows     int64 `protobuf:"varint,2,opt,name=batch_rows,json=batchRows,proto3" json:"batch_rows,omitempty"`
+	BatchSize     int64 `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	PartitionRows int64 `protobuf:"varint,4,opt,name=partition_rows,json=partitionRows,proto3" json:"partition_rows,omitempty"`
+	ReaderSize    int64 `protobuf:"varint,5,opt,name=reader_size,json=readerSize,proto3" json:"reader_size,omitempty"`
+	// memory_quota is the max bytes the query can hold in the mpool, 0 means no limit.
+	MemoryQuota          int64    `protobuf:"varint,6,opt,name=memory_quota,json=memoryQuota,proto3" json:"memory_quota,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4400,6 +4410,13 @@ func (m *ProcessLimitation) GetReaderSize() int64 {
 	return 0
 }
 
+func (m *ProcessLimitation) GetMemoryQuota() int64 {
+	if m != nil {
+		return m.MemoryQuota
+	}
+	return 0
+}
+
 type PrepareParamInfo struct {
 	Length               int64    `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
 	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -4472,19 +4489,21 @@ func (m *PrepareParamInfo) GetNulls() []bool {
 }
 
 type ProcessInfo struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Sql                  string            `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
-	Lim                  ProcessLimitation `protobuf:"bytes,3,opt,name=lim,proto3" json:"lim"`
-	UnixTime             int64             `protobuf:"varint,4,opt,name=unix_time,json=unixTime,proto3" json:"unix_time,omitempty"`
-	AccountId            uint32            `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Snapshot             txn.CNTxnSnapshot `protobuf:"bytes,6,opt,name=snapshot,proto3" json:"snapshot"`
-	SessionInfo          SessionInfo       `protobuf:"bytes,7,opt,name=session_info,json=sessionInfo,proto3" json:"session_info"`
-	AnalysisNodeList     []int32           `protobuf:"varint,8,rep,packed,name=analysis_node_list,json=analysisNodeList,proto3" json:"analysis_node_list,omitempty"`
-	SessionLogger        SessionLoggerInfo `protobuf:"bytes,9,opt,name=session_logger,json=sessionLogger,proto3" json:"session_logger"`
-	PrepareParams        PrepareParamInfo  `protobuf:"bytes,10,opt,name=prepare_params,json=prepareParams,proto3" json:"prepare_params"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Id               string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sql              string            `protobuf:"bytes,2,opt,name=sql,proto3" json:"sql,omitempty"`
+	Lim              ProcessLimitation `protobuf:"bytes,3,opt,name=lim,proto3" json:"lim"`
+	UnixTime         int64             `protobuf:"varint,4,opt,name=unix_time,json=unixTime,proto3" json:"unix_time,omitempty"`
+	AccountId        uint32            `protobuf:"varint,5,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Snapshot         txn.CNTxnSnapshot `protobuf:"bytes,6,opt,name=snapshot,proto3" json:"snapshot"`
+	SessionInfo      SessionInfo       `protobuf:"bytes,7,opt,name=session_info,json=sessionInfo,proto3" json:"session_info"`
+	AnalysisNodeList []int32           `protobuf:"varint,8,rep,packed,name=analysis_node_list,json=analysisNodeList,proto3" json:"analysis_node_list,omitempty"`
+	SessionLogger    SessionLoggerInfo `protobuf:"bytes,9,opt,name=session_logger,json=sessionLogger,proto3" json:"session_logger"`
+	PrepareParams    PrepareParamInfo  `protobuf:"bytes,10,opt,name=prepare_params,json=prepareParams,proto3" json:"prepare_params"`
+	// timeout is the remaining time of the query in nanoseconds, 0 means no limit.
+	Timeout              int64    `protobuf:"varint,11,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProcessInfo) Reset()         { *m = ProcessInfo{} }
@@ -4590,6 +4609,13 @@ func (m *ProcessInfo) GetPrepareParams() PrepareParamInfo {
 	return PrepareParamInfo{}
 }
 
+func (m *ProcessInfo) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
 type SessionInfo struct {
 	User                 string   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	Host                 string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
@@ -5132,319 +5158,320 @@ func init() {
 func init() { proto.RegisterFile("pipeline.proto", fileDescriptor_7ac67a7adf3df9c7) }
 
 var fileDescriptor_7ac67a7adf3df9c7 = []byte{
-	// 4992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7b, 0x5b, 0x93, 0x1c, 0x47,
-	0x56, 0xb0, 0xfa, 0x5e, 0x7d, 0xfa, 0x32, 0x3d, 0xa9, 0x5b, 0x5b, 0x96, 0xa5, 0x51, 0xaf, 0x25,
-	0xcf, 0xca, 0xd6, 0x68, 0x3d, 0x5e, 0x7f, 0xdf, 0x06, 0x8b, 0xd7, 0x3b, 0x9a, 0x91, 0x4c, 0xaf,
-	0x35, 0xa3, 0x21, 0x67, 0x84, 0x03, 0x07, 0x41, 0x45, 0x4d, 0x55, 0x76, 0x4f, 0x79, 0xaa, 0x33,
-	0x4b, 0x55, 0xd5, 0xd2, 0x8c, 0x7e, 0x00, 0x3f, 0x00, 0x7e, 0x00, 0xc4, 0xbe, 0x40, 0x04, 0x97,
-	0x20, 0xe0, 0x91, 0xe0, 0x7d, 0x1f, 0x79, 0x23, 0x82, 0x07, 0x20, 0xbc, 0x2f, 0x44, 0x00, 0x11,
-	0x3c, 0x00, 0x6f, 0x04, 0xc4, 0x39, 0x99, 0x75, 0xe9, 0x9e, 0xd6, 0xc8, 0xb2, 0xbd, 0x80, 0x09,
-	0xbf, 0x65, 0x9e, 0x73, 0xf2, 0x7a, 0xae, 0x99, 0x79, 0x12, 0xba, 0xa1, 0x1f, 0x8a, 0xc0, 0x97,
-	0x62, 0x2d, 0x8c, 0x54, 0xa2, 0x98, 0x95, 0xd6, 0xaf, 0xdc, 0x19, 0xfb, 0xc9, 0xe1, 0xf4, 0x60,
-	0xcd, 0x55, 0x93, 0xbb, 0x63, 0x35, 0x56, 0x77, 0x89, 0xe0, 0x60, 0x3a, 0xa2, 0x1a, 0x55, 0xa8,
-	0xa4, 0x1b, 0x5e, 0x81, 0x30, 0x70, 0xa4, 0x29, 0x2f, 0x25, 0xfe, 0x44, 0xc4, 0x89, 0x33, 0x09,
-	0x53, 0x64, 0xa0, 0xdc, 0x23, 0x53, 0x6e, 0x26, 0xc7, 0x86, 0x6e, 0xf0, 0x9f, 0x25, 0x68, 0x6c,
-	0x8b, 0x38, 0x76, 0xc6, 0x82, 0x0d, 0xa0, 0x12, 0xfb, 0x5e, 0xbf, 0xb4, 0x52, 0x5a, 0xed, 0xae,
-	0xf7, 0xd6, 0xb2, 0x69, 0xed, 0x25, 0x4e, 0x32, 0x8d, 0x39, 0x22, 0x91, 0xc6, 0x9d, 0x78, 0xfd,
-	0xf2, 0x3c, 0xcd, 0xb6, 0x48, 0x0e, 0x95, 0xc7, 0x11, 0xc9, 0x7a, 0x50, 0x11, 0x51, 0xd4, 0xaf,
-	0xac, 0x94, 0x56, 0xdb, 0x1c, 0x8b, 0x8c, 0x41, 0xd5, 0x73, 0x12, 0xa7, 0x5f, 0x25, 0x10, 0x95,
-	0xd9, 0x9b, 0xd0, 0x0d, 0x23, 0xe5, 0xda, 0xbe, 0x1c, 0x29, 0x9b, 0xb0, 0x35, 0xc2, 0xb6, 0x11,
-	0x3a, 0x94, 0x23, 0xb5, 0x85, 0x54, 0x7d, 0x68, 0x38, 0xd2, 0x09, 0x4e, 0x62, 0xd1, 0xaf, 0x13,
-	0x3a, 0xad, 0xb2, 0x2e, 0x94, 0x7d, 0xaf, 0xdf, 0x58, 0x29, 0xad, 0x56, 0x79, 0xd9, 0xf7, 0x70,
-	0x8c, 0xe9, 0xd4, 0xf7, 0xfa, 0x96, 0x1e, 0x03, 0xcb, 0x6c, 0x00, 0x6d, 0x29, 0x84, 0xb7, 0xa3,
-	0x12, 0x2e, 0xc2, 0xe0, 0xa4, 0xdf, 0x5c, 0x29, 0xad, 0x5a, 0x7c, 0x06, 0x36, 0x78, 0x0c, 0xcd,
-	0x4d, 0x25, 0xa5, 0x70, 0x13, 0x15, 0xb1, 0xeb, 0xd0, 0x4a, 0x97, 0x64, 0x9b, 0xad, 0xa8, 0x71,
-	0x48, 0x41, 0x43, 0x8f, 0xbd, 0x05, 0x4b, 0x6e, 0x4a, 0x6d, 0xfb, 0xd2, 0x13, 0xc7, 0xb4, 0x17,
-	0x35, 0xde, 0xcd, 0xc0, 0x43, 0x84, 0x0e, 0xfe, 0xa9, 0x0c, 0x8d, 0xbd, 0xc3, 0xe9, 0x68, 0x14,
-	0x08, 0xf6, 0x26, 0x74, 0x4c, 0x71, 0x53, 0x05, 0x43, 0xef, 0xd8, 0xf4, 0x3b, 0x0b, 0x64, 0x2b,
-	0xd0, 0x32, 0x80, 0xfd, 0x93, 0x50, 0x98, 0x6e, 0x8b, 0xa0, 0xd9, 0x7e, 0xb6, 0x7d, 0x49, 0x5b,
-	0x5c, 0xe1, 0xb3, 0xc0, 0x39, 0x2a, 0xe7, 0x98, 0x76, 0x7d, 0x96, 0xca, 0xa1, 0xd1, 0x36, 0x02,
-	0xff, 0xa9, 0xe0, 0x62, 0xbc, 0x29, 0x13, 0xda, 0xfb, 0x1a, 0x2f, 0x82, 0xd8, 0x3a, 0x5c, 0x8c,
-	0x75, 0x13, 0x3b, 0x72, 0xe4, 0x58, 0xc4, 0xf6, 0xd4, 0x97, 0xc9, 0xff, 0xfb, 0x7e, 0xbf, 0xbe,
-	0x52, 0x59, 0xad, 0xf2, 0xf3, 0x06, 0xc9, 0x09, 0xf7, 0x98, 0x50, 0xec, 0x7b, 0x70, 0x61, 0xae,
-	0x8d, 0x6e, 0xd2, 0x58, 0xa9, 0xac, 0x56, 0x38, 0x9b, 0x69, 0x32, 0xa4, 0x16, 0xf7, 0x61, 0x39,
-	0x9a, 0x4a, 0x94, 0xd6, 0x07, 0x7e, 0x90, 0x88, 0x68, 0x2f, 0x14, 0x2e, 0xf1, 0xb0, 0xb5, 0x7e,
-	0x79, 0x8d, 0x04, 0x9a, 0xcf, 0xa3, 0xf9, 0xe9, 0x16, 0x83, 0xbf, 0x2b, 0x83, 0xb5, 0xe5, 0xc7,
-	0xa1, 0x93, 0xb8, 0x87, 0xec, 0x32, 0x34, 0x46, 0x53, 0xe9, 0xe6, 0x1c, 0xac, 0x63, 0x75, 0xe8,
-	0xb1, 0x5f, 0x86, 0xa5, 0x40, 0xb9, 0x4e, 0x60, 0x67, 0xcc, 0xea, 0x97, 0x57, 0x2a, 0xab, 0xad,
-	0xf5, 0xf3, 0xb9, 0x24, 0x67, 0xc2, 0xc0, 0xbb, 0x44, 0x9b, 0x0b, 0xc7, 0x07, 0xd0, 0x8b, 0xc4,
-	0x44, 0x25, 0xa2, 0xd0, 0xbc, 0x42, 0xcd, 0x59, 0xde, 0xfc, 0x93, 0xc8, 0x09, 0x77, 0x94, 0x27,
-	0xf8, 0x92, 0xa6, 0xcd, 0x9b, 0xbf, 0x5b, 0xd8, 0x4f, 0x31, 0xb6, 0x7d, 0xef, 0xd8, 0xa6, 0x01,
-	0xfa, 0xd5, 0x95, 0xca, 0x6a, 0x2d, 0xdf, 0x1c, 0x31, 0x1e, 0x7a, 0xc7, 0x0f, 0x11, 0xc3, 0xde,
-	0x83, 0x4b, 0xf3, 0x4d, 0x74, 0xaf, 0xfd, 0x1a, 0xb5, 0x39, 0x3f, 0xd3, 0x86, 0x13, 0x8a, 0xdd,
-	0x80, 0x76, 0xda, 0x28, 0x41, 0x41, 0xaa, 0x6b, 0xd6, 0xc6, 0x05, 0x41, 0xba, 0x0c, 0x0d, 0x3f,
-	0xb6, 0x63, 0x5f, 0x1e, 0x91, 0x02, 0x59, 0xbc, 0xee, 0xc7, 0x7b, 0xbe, 0x3c, 0x62, 0xaf, 0x81,
-	0x15, 0x09, 0x57, 0x63, 0x2c, 0xc2, 0x34, 0x22, 0xe1, 0x22, 0x6a, 0x10, 0x43, 0x6d, 0x5b, 0x44,
-	0x63, 0xc1, 0xae, 0x80, 0x85, 0xf8, 0x3d, 0xd7, 0x91, 0xb4, 0xbd, 0x16, 0xcf, 0xea, 0xa8, 0xae,
-	0xa1, 0x13, 0x25, 0xbe, 0x13, 0x90, 0xfc, 0x5a, 0x3c, 0xad, 0xb2, 0xd7, 0xa1, 0x19, 0x27, 0x4e,
-	0x94, 0xe0, 0x22, 0x48, 0x6e, 0x6b, 0xdc, 0x22, 0x00, 0x8a, 0xfe, 0x65, 0x68, 0x08, 0xe9, 0x11,
-	0xaa, 0xaa, 0x19, 0x26, 0xa4, 0x37, 0xf4, 0x8e, 0x07, 0x7f, 0x5e, 0x82, 0xce, 0xf6, 0x34, 0x48,
-	0xfc, 0x8d, 0x68, 0x3c, 0x15, 0x13, 0x99, 0xa0, 0x9a, 0x6f, 0xf9, 0x71, 0x62, 0x46, 0xa6, 0x32,
-	0x5b, 0x85, 0xe6, 0x47, 0x91, 0x9a, 0x86, 0xf7, 0x8f, 0xc3, 0x94, 0xa1, 0xa0, 0x65, 0x07, 0x21,
-	0x3c, 0x47, 0xb2, 0x77, 0xa0, 0xf5, 0x28, 0xf2, 0x44, 0x74, 0xef, 0x84, 0x68, 0x2b, 0xa7, 0x68,
-	0x8b, 0x68, 0x76, 0x15, 0x9a, 0x7b, 0x22, 0x74, 0x22, 0x07, 0x39, 0x8d, 0x13, 0x6b, 0xf2, 0x1c,
-	0x80, 0x6b, 0x25, 0xe2, 0xa1, 0x67, 0xb4, 0x27, 0xad, 0x0e, 0xc6, 0xd0, 0xdc, 0x18, 0x8f, 0x23,
-	0x31, 0x76, 0x12, 0xb2, 0x53, 0x2a, 0xa4, 0xe9, 0x56, 0x78, 0x59, 0x85, 0x64, 0x0b, 0x71, 0x01,
-	0x7a, 0x7f, 0xa8, 0xcc, 0xae, 0x41, 0x55, 0x2c, 0x9e, 0x0f, 0xc1, 0xd9, 0x25, 0xa8, 0xbb, 0x4a,
-	0x8e, 0xfc, 0xb1, 0xb1, 0xa0, 0xa6, 0x36, 0xf8, 0x87, 0x32, 0xd4, 0x68, 0x71, 0xb8, 0xbd, 0x68,
-	0xd5, 0x6c, 0xf1, 0xd4, 0x09, 0x52, 0xae, 0x20, 0xe0, 0xfe, 0x53, 0x27, 0x60, 0x2b, 0x50, 0xc3,
-	0x6e, 0xe2, 0x05, 0x7b, 0xa3, 0x11, 0xec, 0x16, 0xd4, 0x50, 0x56, 0xe2, 0xd9, 0x19, 0xa0, 0xac,
-	0xdc, 0xab, 0xfe, 0xec, 0x6f, 0xaf, 0x9f, 0xe3, 0x1a, 0xcd, 0xde, 0x82, 0xaa, 0x33, 0x1e, 0xc7,
-	0x24, 0xb2, 0x33, 0x5a, 0x93, 0xad, 0x97, 0x13, 0x01, 0x7b, 0x1f, 0x9a, 0x9a, 0x6f, 0x48, 0x5d,
-	0x23, 0xea, 0xcb, 0x05, 0x6f, 0x51, 0x64, 0x29, 0xcf, 0x29, 0x71, 0xc7, 0xfd, 0xd8, 0x18, 0x2a,
-	0x12, 0x5c, 0x8b, 0xe7, 0x00, 0x34, 0xe7, 0x61, 0x24, 0x36, 0x82, 0x40, 0xb9, 0x7b, 0xfe, 0x73,
-	0x61, 0x8c, 0xff, 0x0c, 0x8c, 0xdd, 0x82, 0xee, 0xae, 0x16, 0x39, 0x2e, 0xe2, 0x69, 0x90, 0xc4,
-	0xc6, 0x21, 0xcc, 0x41, 0xd9, 0x1a, 0xb0, 0x19, 0xc8, 0x3e, 0x2d, 0xbf, 0xb9, 0x52, 0x59, 0xed,
-	0xf0, 0x05, 0x98, 0xc1, 0xbf, 0x96, 0xa1, 0x3e, 0x94, 0xb1, 0x88, 0x12, 0x54, 0x00, 0x67, 0x34,
-	0x12, 0x6e, 0x22, 0xb4, 0x7d, 0xa9, 0xf2, 0xac, 0x8e, 0x0b, 0xd8, 0x57, 0x9f, 0x44, 0x7e, 0x22,
-	0xf6, 0xde, 0x33, 0x2c, 0xce, 0x01, 0xec, 0x36, 0x2c, 0x3b, 0x9e, 0x67, 0xa7, 0xd4, 0x76, 0xa4,
-	0x9e, 0xc5, 0xa4, 0x0c, 0x16, 0x5f, 0x72, 0x3c, 0x6f, 0xc3, 0xc0, 0xb9, 0x7a, 0x16, 0xb3, 0x1b,
-	0x50, 0x89, 0xc4, 0x88, 0x18, 0xde, 0x5a, 0x5f, 0xd2, 0x0c, 0x79, 0x74, 0xf0, 0x99, 0x70, 0x13,
-	0x2e, 0x46, 0x1c, 0x71, 0xec, 0x02, 0xd4, 0x9c, 0x24, 0x89, 0xf4, 0x06, 0x37, 0xb9, 0xae, 0xb0,
-	0x35, 0x38, 0x4f, 0x4a, 0x97, 0xf8, 0x4a, 0xda, 0x89, 0x73, 0x10, 0xa0, 0x2b, 0x8b, 0x8d, 0xd5,
-	0x5e, 0xce, 0x50, 0xfb, 0x88, 0x19, 0x7a, 0x31, 0xda, 0xf9, 0x79, 0x7a, 0xe9, 0x4c, 0x44, 0x4c,
-	0x46, 0xbb, 0xc9, 0xcf, 0xcf, 0xb6, 0xd8, 0x41, 0x14, 0xfb, 0x0e, 0x74, 0xf2, 0x36, 0xa8, 0xb6,
-	0x16, 0x69, 0x40, 0x3b, 0x03, 0xa2, 0x56, 0x5f, 0x84, 0xba, 0x1f, 0xdb, 0x42, 0x7a, 0xc6, 0xef,
-	0xd6, 0xfc, 0xf8, 0xbe, 0xf4, 0xd8, 0xdb, 0xd0, 0xd4, 0xa3, 0x78, 0x62, 0xd4, 0x07, 0x5a, 0x5e,
-	0xd7, 0xc8, 0x1b, 0x82, 0xb7, 0xc4, 0x88, 0x5b, 0x89, 0x29, 0x0d, 0xde, 0x80, 0xda, 0x46, 0x14,
-	0x39, 0x27, 0xb4, 0x56, 0x2c, 0xf4, 0x4b, 0x64, 0xf9, 0x74, 0x65, 0xe0, 0x42, 0x65, 0xdb, 0x09,
-	0xd9, 0x4d, 0x28, 0x4f, 0x42, 0xc2, 0xb4, 0xd6, 0x2f, 0x16, 0xc4, 0xcc, 0x09, 0xd7, 0xb6, 0xc3,
-	0xfb, 0x32, 0x89, 0x4e, 0x78, 0x79, 0x12, 0x5e, 0x79, 0x1f, 0x1a, 0xa6, 0x8a, 0x31, 0xca, 0x91,
-	0x38, 0x21, 0xf6, 0x35, 0x39, 0x16, 0x71, 0x80, 0xa7, 0x4e, 0x30, 0x4d, 0x1d, 0xaf, 0xae, 0xfc,
-	0x52, 0xf9, 0x07, 0xa5, 0xc1, 0xbf, 0x55, 0xc1, 0xda, 0x12, 0x81, 0xc0, 0x75, 0xa1, 0x0c, 0x16,
-	0xd9, 0x64, 0x04, 0x60, 0x06, 0x86, 0x34, 0xda, 0x16, 0x53, 0x2b, 0x61, 0xe4, 0x60, 0x06, 0x86,
-	0xd6, 0x63, 0x78, 0x6f, 0xea, 0x1e, 0x89, 0x84, 0x04, 0xa0, 0xc3, 0xd3, 0x2a, 0x62, 0x76, 0x0c,
-	0xa6, 0xaa, 0x31, 0xa6, 0xca, 0xae, 0x02, 0x44, 0xea, 0x99, 0xed, 0x6b, 0x4b, 0xa9, 0x8d, 0x8e,
-	0x15, 0xa9, 0x67, 0x43, 0xb4, 0x95, 0xff, 0x2d, 0x7c, 0xff, 0xff, 0xd0, 0x2f, 0xf0, 0x1d, 0x03,
-	0x1d, 0xdb, 0x97, 0xf6, 0x01, 0x7a, 0x5d, 0x23, 0x02, 0x79, 0x9f, 0x14, 0x07, 0x0d, 0xe5, 0x3d,
-	0x72, 0xc9, 0x46, 0x9a, 0x9b, 0x67, 0x48, 0xf3, 0x42, 0xe5, 0x80, 0xc5, 0xca, 0x71, 0x0f, 0x60,
-	0x4f, 0x8c, 0x27, 0x42, 0x26, 0xdb, 0x4e, 0xd8, 0x6f, 0x11, 0xe3, 0x07, 0x39, 0xe3, 0x53, 0x6e,
-	0xad, 0xe5, 0x44, 0x5a, 0x0a, 0x0a, 0xad, 0xd0, 0x4f, 0xba, 0x8e, 0xb4, 0x93, 0x68, 0x2a, 0x5d,
-	0x27, 0x11, 0xfd, 0x36, 0x0d, 0xd5, 0x72, 0x1d, 0xb9, 0x6f, 0x40, 0x05, 0x09, 0xee, 0x14, 0x25,
-	0xf8, 0x16, 0x2c, 0x85, 0x91, 0x3f, 0x71, 0xa2, 0x13, 0xfb, 0x48, 0x9c, 0x10, 0x33, 0xba, 0x3a,
-	0xa2, 0x33, 0xe0, 0x8f, 0xc5, 0xc9, 0xd0, 0x3b, 0xbe, 0xf2, 0x01, 0x2c, 0xcd, 0x4d, 0xe0, 0x95,
-	0xe4, 0xee, 0x5f, 0x4a, 0xd0, 0xdc, 0x8d, 0x84, 0xb1, 0x3a, 0xd7, 0xa1, 0x15, 0xbb, 0x87, 0x62,
-	0xe2, 0x10, 0x97, 0x4c, 0x0f, 0xa0, 0x41, 0xc8, 0x9c, 0x59, 0xbd, 0x2a, 0x9f, 0xad, 0x57, 0x38,
-	0x0f, 0xed, 0x88, 0x51, 0x99, 0xb0, 0x98, 0x1b, 0x93, 0x6a, 0xd1, 0x98, 0xac, 0x40, 0xfb, 0xd0,
-	0x89, 0x6d, 0x67, 0x9a, 0x28, 0xdb, 0x55, 0x01, 0x09, 0x9d, 0xc5, 0xe1, 0xd0, 0x89, 0x37, 0xa6,
-	0x89, 0xda, 0x54, 0xe4, 0xd8, 0xfd, 0xd8, 0x9e, 0x86, 0x1e, 0xee, 0xa1, 0x36, 0xd9, 0x96, 0x1f,
-	0x3f, 0xa6, 0x3a, 0xca, 0xa4, 0x88, 0x13, 0x7f, 0xe2, 0x18, 0x86, 0xda, 0xae, 0x9a, 0xca, 0x84,
-	0x0c, 0x77, 0x85, 0x2f, 0x67, 0x28, 0xae, 0x9e, 0x6d, 0x22, 0x62, 0xf0, 0x37, 0x65, 0x80, 0x87,
-	0xca, 0x3d, 0xda, 0x77, 0xa2, 0xb1, 0x48, 0x30, 0x1c, 0x49, 0x05, 0xd9, 0x28, 0x5a, 0x23, 0xd1,
-	0xe2, 0xcb, 0xd6, 0xe1, 0x52, 0xca, 0x03, 0x57, 0x05, 0x14, 0x1a, 0x69, 0x49, 0x34, 0xfb, 0xc8,
-	0x0c, 0x56, 0x07, 0xd7, 0x24, 0x86, 0xec, 0x07, 0x39, 0xdf, 0xb0, 0x4d, 0x72, 0x12, 0x92, 0xee,
-	0x2d, 0xf2, 0x77, 0x9d, 0xbc, 0xf9, 0xfe, 0x49, 0xc8, 0xbe, 0x07, 0x17, 0x23, 0x31, 0x8a, 0x44,
-	0x7c, 0x68, 0x27, 0x71, 0x71, 0x30, 0x1d, 0xae, 0x2c, 0x1b, 0xe4, 0x7e, 0x9c, 0x8d, 0xf5, 0x3d,
-	0xb8, 0x38, 0xa2, 0xf0, 0x74, 0x7e, 0x7a, 0x5a, 0x6d, 0x97, 0x35, 0xb2, 0x38, 0xbb, 0x37, 0x80,
-	0xce, 0x68, 0x5a, 0x15, 0x53, 0xe7, 0x17, 0xd0, 0x66, 0x1c, 0x04, 0x02, 0x3d, 0xcb, 0xe6, 0x21,
-	0x06, 0xce, 0x5b, 0x62, 0x64, 0xa2, 0xb6, 0x1c, 0xc0, 0x06, 0x50, 0xdd, 0x56, 0x9e, 0x20, 0x25,
-	0xec, 0xae, 0x77, 0xd7, 0xe8, 0xb4, 0x87, 0x3b, 0x89, 0x50, 0x4e, 0xb8, 0xc1, 0x0e, 0xd4, 0x11,
-	0xf2, 0x28, 0x64, 0x6b, 0xd0, 0x48, 0x68, 0x87, 0x63, 0x63, 0x34, 0x2f, 0xe4, 0xba, 0x93, 0x6f,
-	0x3f, 0x4f, 0x89, 0x50, 0x36, 0x0e, 0xb0, 0x47, 0x63, 0xc9, 0x74, 0x65, 0xc0, 0x61, 0x29, 0x13,
-	0xcf, 0xc7, 0xd2, 0x7f, 0x32, 0x15, 0xec, 0x43, 0x58, 0x0e, 0x23, 0x61, 0xfb, 0x04, 0xb3, 0xa7,
-	0x47, 0xb6, 0x9b, 0xe8, 0xd3, 0x0e, 0x0d, 0x81, 0x7b, 0x9c, 0xb7, 0x38, 0xda, 0x4c, 0x8e, 0x79,
-	0x37, 0x9c, 0xa9, 0x0f, 0x3e, 0x85, 0xcb, 0x19, 0xc5, 0x9e, 0x70, 0x95, 0xf4, 0x9c, 0xe8, 0x84,
-	0x2c, 0xc9, 0x5c, 0xdf, 0xf1, 0xab, 0xf4, 0xbd, 0x47, 0x7d, 0xff, 0xb4, 0x02, 0xdd, 0x47, 0x72,
-	0x6b, 0x1a, 0x06, 0x3e, 0x6a, 0xf7, 0xc7, 0x5a, 0xf9, 0xb4, 0xd0, 0x97, 0x8a, 0x42, 0xbf, 0x0a,
-	0x3d, 0x33, 0x0a, 0xf2, 0x4e, 0x8b, 0xac, 0x39, 0xe5, 0x69, 0xf8, 0xa6, 0x0a, 0x48, 0x5e, 0xd9,
-	0x07, 0x70, 0x71, 0x4a, 0x2b, 0xd7, 0x94, 0x87, 0xc2, 0x3d, 0xb2, 0x5f, 0x10, 0xc9, 0x31, 0x4d,
-	0x88, 0x4d, 0x91, 0x8c, 0x02, 0xcc, 0xeb, 0xd0, 0xca, 0x9b, 0xa7, 0x9a, 0x07, 0x19, 0x21, 0xcd,
-	0x44, 0x49, 0xdb, 0x4b, 0xa7, 0x6c, 0xec, 0x3e, 0xea, 0x6c, 0x57, 0xe5, 0x2b, 0x41, 0xeb, 0xff,
-	0xeb, 0xb0, 0x3c, 0x43, 0x49, 0xb3, 0xa8, 0xd3, 0x2c, 0xee, 0xe4, 0xcc, 0x9d, 0x5d, 0x7e, 0xb1,
-	0x8a, 0xf3, 0xd1, 0x36, 0x72, 0x49, 0xcd, 0x42, 0x8d, 0x86, 0xfb, 0x63, 0xa9, 0x22, 0x61, 0x24,
-	0xcf, 0xf2, 0xe3, 0x21, 0xd5, 0xaf, 0xec, 0xc0, 0x85, 0x45, 0xbd, 0x2c, 0x30, 0x74, 0x2b, 0x45,
-	0x43, 0x37, 0x17, 0x85, 0xe6, 0x46, 0xef, 0xf7, 0x4b, 0xd0, 0x7a, 0x30, 0x7d, 0xfe, 0xfc, 0x44,
-	0x1f, 0xee, 0x58, 0x1b, 0x4a, 0x3b, 0xd4, 0x4b, 0x99, 0x97, 0x76, 0x30, 0x10, 0xde, 0x3d, 0x42,
-	0x6b, 0x47, 0x9d, 0x34, 0xb9, 0xa9, 0x61, 0xfc, 0xba, 0x7b, 0xb4, 0x7f, 0x86, 0x3e, 0x6b, 0x34,
-	0x86, 0x6e, 0xf7, 0xa6, 0x7e, 0x80, 0xfe, 0xd2, 0xa8, 0x6e, 0x56, 0xc7, 0x88, 0x70, 0x38, 0xd2,
-	0xf2, 0xf2, 0x20, 0x52, 0x13, 0x2d, 0xd1, 0xc6, 0xe0, 0x2d, 0xc0, 0x0c, 0xfe, 0xa8, 0x02, 0xd5,
-	0x9f, 0x28, 0x5f, 0xea, 0x43, 0x53, 0x60, 0x07, 0xfa, 0x58, 0x82, 0xcc, 0x69, 0x44, 0x22, 0x78,
-	0x88, 0x81, 0xfd, 0x6b, 0x60, 0xa1, 0x60, 0x04, 0x3a, 0xe0, 0x27, 0x94, 0xab, 0x34, 0x2a, 0x8f,
-	0xf9, 0x4b, 0x0b, 0x63, 0xfe, 0x2c, 0x24, 0xaf, 0xbe, 0x2c, 0x24, 0x6f, 0x06, 0x62, 0x84, 0xa2,
-	0x2a, 0x3d, 0x13, 0x69, 0x17, 0x3b, 0xb3, 0x10, 0xb9, 0xa9, 0xa4, 0xc7, 0xbe, 0x0b, 0x10, 0xf9,
-	0xe3, 0x43, 0x43, 0x59, 0x3f, 0x7d, 0x4c, 0x22, 0x2c, 0x91, 0x72, 0x78, 0xcd, 0x1c, 0xb1, 0x6d,
-	0x63, 0xc4, 0x0e, 0x70, 0x97, 0xf4, 0x3a, 0x1a, 0x69, 0x34, 0xbf, 0xf8, 0x70, 0x7e, 0x69, 0xe6,
-	0x70, 0x4e, 0xbb, 0x4b, 0xeb, 0xbd, 0x0a, 0xe8, 0x35, 0x0e, 0x6d, 0x25, 0xed, 0x30, 0x3d, 0x5c,
-	0x5a, 0x08, 0x79, 0x24, 0x77, 0x8f, 0xd0, 0xf8, 0xe1, 0x89, 0xd4, 0x44, 0xfe, 0xcd, 0xf9, 0xc8,
-	0x7f, 0x05, 0xda, 0x9f, 0x29, 0x5f, 0xda, 0x13, 0x27, 0xb4, 0x13, 0x67, 0x4c, 0x61, 0x41, 0x8d,
-	0x03, 0xc2, 0xb6, 0x9d, 0x70, 0xdf, 0x19, 0x93, 0x7b, 0x34, 0xa7, 0x5e, 0x54, 0x92, 0x96, 0x26,
-	0x30, 0x20, 0x3c, 0x4a, 0xfe, 0x76, 0x05, 0xac, 0x0d, 0x99, 0xf8, 0xc4, 0xb2, 0x4b, 0x50, 0x8f,
-	0x28, 0xb8, 0x37, 0x0c, 0x33, 0xb5, 0x8c, 0x29, 0xe5, 0x97, 0x31, 0xa5, 0xf2, 0x0a, 0x4c, 0xa9,
-	0x7e, 0x61, 0xa6, 0xd4, 0xce, 0x62, 0xca, 0xec, 0x06, 0xd6, 0xcf, 0xdc, 0xc0, 0xc6, 0xfc, 0x06,
-	0x9e, 0xc9, 0x51, 0xeb, 0xcb, 0x71, 0x74, 0x9e, 0x29, 0xcd, 0x97, 0x31, 0x05, 0x4e, 0x31, 0xe5,
-	0x4f, 0x2b, 0x60, 0x3d, 0x14, 0xa3, 0xe4, 0x5b, 0x3d, 0xfa, 0xc6, 0xe8, 0xd1, 0x3f, 0x57, 0xa0,
-	0xc9, 0x71, 0x85, 0xbf, 0x40, 0x9e, 0xdd, 0x05, 0x20, 0x5e, 0x9c, 0xcd, 0x38, 0xe2, 0x17, 0x9d,
-	0xce, 0xd9, 0xbb, 0xd0, 0xd2, 0x3c, 0xd1, 0x2d, 0x6a, 0x2f, 0x68, 0xa1, 0x19, 0xb7, 0x7f, 0x9a,
-	0xdf, 0xf5, 0x2f, 0xcc, 0xef, 0xc6, 0x97, 0xe6, 0xb7, 0xf5, 0x75, 0xf0, 0xbb, 0x79, 0x26, 0xbf,
-	0xe1, 0x65, 0xfc, 0x6e, 0xbd, 0x8c, 0xdf, 0xed, 0x53, 0xfc, 0xfe, 0x69, 0x05, 0x3a, 0xc4, 0xef,
-	0x3d, 0x31, 0xf9, 0x6a, 0xc6, 0x73, 0x8e, 0x49, 0x95, 0x57, 0x65, 0xd2, 0xd7, 0x64, 0x47, 0xcf,
-	0x64, 0x52, 0xfd, 0xeb, 0x60, 0x52, 0xe3, 0x4c, 0x26, 0x59, 0x2f, 0x63, 0x52, 0xf3, 0xd5, 0x95,
-	0x32, 0x63, 0xd2, 0x57, 0xf6, 0x70, 0xdf, 0x32, 0xe9, 0x6b, 0x62, 0x12, 0x2c, 0x8c, 0x40, 0xbe,
-	0xb2, 0x12, 0xfd, 0x4f, 0x46, 0x20, 0xff, 0x17, 0x99, 0xf2, 0x67, 0x15, 0x80, 0x3d, 0x5f, 0x8e,
-	0x03, 0xf1, 0x6d, 0x0c, 0xf2, 0x8d, 0x89, 0x41, 0x7e, 0x5e, 0x06, 0x6b, 0xdb, 0x89, 0x8e, 0xbe,
-	0xb1, 0x9a, 0xf4, 0x1d, 0x68, 0x28, 0x59, 0xd4, 0x9b, 0x22, 0x5d, 0x5d, 0xc9, 0xff, 0x15, 0xaa,
-	0xf1, 0x07, 0x25, 0x68, 0xec, 0x46, 0xca, 0x9b, 0xba, 0xc9, 0x97, 0xd4, 0x8b, 0x2f, 0xba, 0xc5,
-	0xb3, 0x6b, 0xa9, 0xbe, 0x6c, 0x2d, 0xb5, 0xf9, 0xb5, 0x0c, 0xfe, 0x90, 0xae, 0x4a, 0x69, 0xaa,
-	0x0f, 0xd7, 0x7f, 0xc1, 0x93, 0x4d, 0xe5, 0xaa, 0xfa, 0x02, 0xb9, 0x7a, 0xf9, 0x6c, 0x7f, 0xb7,
-	0x04, 0x4d, 0xba, 0xd3, 0x3a, 0x53, 0x7e, 0xb3, 0xf9, 0x94, 0xcf, 0x9e, 0xcf, 0x99, 0x0a, 0x5e,
-	0xf9, 0x52, 0x0a, 0x3e, 0xf8, 0x9d, 0x12, 0x74, 0xe8, 0xda, 0xf1, 0xc1, 0x54, 0xba, 0xf4, 0xee,
-	0xb1, 0xf8, 0xa6, 0x6c, 0x05, 0xaa, 0x91, 0x48, 0xd2, 0x29, 0xb6, 0xf5, 0x30, 0x9b, 0x2a, 0xd8,
-	0x12, 0x23, 0x4e, 0x18, 0xdc, 0x2d, 0x27, 0x1a, 0xc7, 0x8b, 0x9e, 0x36, 0x11, 0x8e, 0xab, 0x0f,
-	0x9d, 0xc8, 0x99, 0xc4, 0xe9, 0xd3, 0xa6, 0xae, 0x31, 0x06, 0x55, 0xba, 0xe7, 0xae, 0xd1, 0x3d,
-	0x0f, 0x95, 0x07, 0x1b, 0x70, 0xf1, 0xfe, 0x71, 0x22, 0x22, 0xe9, 0x04, 0x3b, 0xce, 0x44, 0xac,
-	0x6f, 0xaa, 0x40, 0x5f, 0x0d, 0xa6, 0xc4, 0xa5, 0x9c, 0x18, 0x27, 0x5c, 0xcc, 0xcf, 0xd0, 0x95,
-	0xc1, 0x4d, 0x68, 0x8d, 0xfc, 0x40, 0xd8, 0x6a, 0x34, 0x8a, 0x45, 0x82, 0xa3, 0xeb, 0x12, 0x2d,
-	0xab, 0xc2, 0x4d, 0x6d, 0xf0, 0x97, 0x55, 0x68, 0xa7, 0x43, 0xd1, 0xc3, 0xf6, 0xe2, 0xe5, 0xbf,
-	0x0e, 0x4d, 0xea, 0x2d, 0xf6, 0x9f, 0x0b, 0xda, 0x83, 0x0a, 0xb7, 0x10, 0x40, 0x2f, 0x91, 0x1b,
-	0xb0, 0x5c, 0x18, 0xca, 0x4e, 0x54, 0xe2, 0x04, 0x66, 0x1b, 0x0a, 0x6f, 0x54, 0x05, 0x12, 0xbe,
-	0x84, 0x95, 0x47, 0x54, 0xde, 0x47, 0x6a, 0xdc, 0xde, 0xec, 0x62, 0xf0, 0xd4, 0xf6, 0x22, 0x86,
-	0x7d, 0x04, 0x4b, 0xb8, 0xda, 0x75, 0x7d, 0xcb, 0x4c, 0xeb, 0xd5, 0x86, 0xe7, 0x7a, 0x3e, 0xc4,
-	0xc2, 0x3d, 0xe3, 0x1d, 0x39, 0xb3, 0x85, 0x6f, 0x00, 0xb8, 0x91, 0x70, 0x12, 0x61, 0xc7, 0x4f,
-	0x02, 0xba, 0x5d, 0x68, 0xf2, 0xa6, 0x86, 0xec, 0x3d, 0x09, 0xb2, 0x95, 0x66, 0x5e, 0xa3, 0xa9,
-	0x57, 0x4a, 0x9a, 0x73, 0x07, 0x5a, 0x2a, 0xf2, 0xc7, 0xbe, 0xd4, 0xd7, 0x98, 0xd6, 0x82, 0xd9,
-	0x82, 0x26, 0xa0, 0x4b, 0xcd, 0x01, 0xd4, 0xb5, 0xa0, 0x9a, 0xe7, 0xa0, 0x19, 0xdb, 0xa7, 0x31,
-	0x8c, 0x43, 0x77, 0xff, 0x60, 0x53, 0x05, 0xfb, 0x94, 0x06, 0xb4, 0xa9, 0x82, 0x3e, 0x50, 0xaf,
-	0xb7, 0x4f, 0x2f, 0x0b, 0xf9, 0xb3, 0x36, 0x4b, 0xac, 0x2f, 0x32, 0xe7, 0x7a, 0x60, 0xb7, 0x60,
-	0x29, 0x4e, 0x22, 0xdf, 0x4d, 0x70, 0x89, 0xf6, 0x44, 0x79, 0x82, 0x5c, 0x8b, 0xc5, 0x3b, 0x1a,
-	0xbc, 0xf7, 0x24, 0xd8, 0x56, 0x9e, 0xb8, 0xb2, 0x01, 0xe7, 0x17, 0x74, 0xf7, 0x4a, 0x4f, 0x37,
-	0x2e, 0xc0, 0x5e, 0x12, 0x09, 0x67, 0x42, 0xc2, 0xf3, 0x16, 0x34, 0x92, 0x83, 0x80, 0xde, 0x65,
-	0x4a, 0x0b, 0xdf, 0x65, 0xea, 0xc9, 0x01, 0xee, 0x52, 0x41, 0x1c, 0xcb, 0xf4, 0x42, 0x62, 0x6a,
-	0x38, 0x50, 0xe0, 0x4f, 0xfc, 0xc4, 0x24, 0xfc, 0xe8, 0xca, 0xa0, 0x05, 0x4d, 0xea, 0x01, 0xc7,
-	0xc0, 0xca, 0xaf, 0xe1, 0xf0, 0x54, 0x01, 0xb0, 0x1e, 0x4b, 0x5f, 0xc9, 0x8d, 0x20, 0x18, 0xfc,
-	0x47, 0x09, 0x60, 0xcf, 0x99, 0x84, 0x5a, 0x97, 0xd9, 0x8f, 0xa1, 0x15, 0x53, 0x4d, 0x27, 0x87,
-	0xe8, 0x64, 0xaf, 0x82, 0xb0, 0xe4, 0xa4, 0xa6, 0x88, 0x06, 0x87, 0x43, 0x9c, 0x95, 0xc9, 0x6f,
-	0xe8, 0x1e, 0xe8, 0x85, 0xae, 0x6c, 0xfc, 0x06, 0x81, 0xe8, 0x71, 0xee, 0x26, 0x74, 0x0d, 0x41,
-	0x28, 0x22, 0x57, 0x48, 0x3d, 0xed, 0x12, 0xef, 0x68, 0xe8, 0xae, 0x06, 0xb2, 0x77, 0x33, 0x32,
-	0x57, 0x05, 0xd3, 0x89, 0x8c, 0x17, 0x38, 0x57, 0xd3, 0x64, 0x53, 0x13, 0x0c, 0xd6, 0xd3, 0xa5,
-	0xd0, 0x44, 0x2c, 0xa8, 0xe2, 0x78, 0xbd, 0x73, 0xac, 0x05, 0x0d, 0xd3, 0x6b, 0xaf, 0xc4, 0x3a,
-	0xd0, 0xa4, 0x44, 0x15, 0xc2, 0x95, 0x07, 0x7f, 0xd1, 0x83, 0xd6, 0x50, 0xc6, 0x49, 0x34, 0xd5,
-	0x86, 0x2c, 0xcf, 0xc7, 0xa8, 0x51, 0x3e, 0x86, 0x79, 0x09, 0xd3, 0xcb, 0xa0, 0x97, 0xb0, 0x5b,
-	0x50, 0x75, 0x64, 0xe2, 0x9b, 0x68, 0xae, 0x90, 0xdb, 0x93, 0x1e, 0xae, 0x38, 0xe1, 0xd9, 0x1d,
-	0x68, 0x98, 0x44, 0x20, 0xe3, 0x0b, 0x16, 0x66, 0x11, 0xa5, 0x34, 0x6c, 0x0d, 0x2c, 0xcf, 0x64,
-	0x28, 0x91, 0x55, 0x9b, 0xe9, 0x3a, 0xcd, 0x5d, 0xe2, 0x19, 0x0d, 0xbb, 0x01, 0x15, 0x67, 0x3c,
-	0x26, 0x55, 0xa4, 0x27, 0xd3, 0x94, 0x94, 0x12, 0x3e, 0x38, 0xe2, 0xd8, 0x5d, 0x13, 0x9a, 0xa0,
-	0x6f, 0x31, 0x49, 0x53, 0x85, 0x3e, 0xd3, 0x8b, 0x35, 0x1d, 0xa2, 0x90, 0xaf, 0xb9, 0x0b, 0xcd,
-	0x58, 0x4c, 0x7c, 0xdd, 0xa0, 0x39, 0xdf, 0x20, 0x3d, 0x9c, 0x70, 0x2b, 0x4e, 0x8f, 0x29, 0xef,
-	0x43, 0x2b, 0xa6, 0xe8, 0x58, 0x37, 0x81, 0xf4, 0xb9, 0x25, 0x6b, 0x92, 0x85, 0xce, 0x1c, 0xe2,
-	0x3c, 0x8c, 0xbe, 0x0b, 0xcd, 0x89, 0x13, 0x1d, 0xe9, 0x46, 0xad, 0xf9, 0x71, 0xd2, 0xd0, 0x8d,
-	0x5b, 0x93, 0x34, 0x88, 0x1b, 0x40, 0x95, 0x68, 0xdb, 0xa9, 0x7e, 0xa4, 0xb4, 0x7a, 0xbf, 0x11,
-	0xc7, 0xde, 0x86, 0x46, 0xa8, 0x7d, 0x3c, 0x3d, 0xc7, 0xb6, 0xd6, 0x97, 0x73, 0x32, 0xe3, 0xfc,
-	0x79, 0x4a, 0xc1, 0x7e, 0x04, 0x5d, 0xfd, 0x74, 0x38, 0x32, 0x1e, 0x8c, 0x9e, 0x68, 0x67, 0xb2,
-	0x50, 0x66, 0x1c, 0x1c, 0xef, 0x24, 0x33, 0xfe, 0xee, 0x87, 0xd0, 0x11, 0xc6, 0xc0, 0xd8, 0xb1,
-	0xeb, 0xc8, 0x7e, 0x8f, 0x9a, 0x5f, 0x5a, 0x6c, 0x7f, 0x78, 0x5b, 0x14, 0xbd, 0xc5, 0x2a, 0xd4,
-	0xf5, 0x43, 0x51, 0x7f, 0x99, 0x5a, 0x15, 0x12, 0x25, 0xf5, 0x33, 0x02, 0x37, 0x78, 0x76, 0x6f,
-	0xee, 0x81, 0x07, 0x2d, 0x0c, 0xa3, 0x36, 0xfd, 0x17, 0xbd, 0xda, 0xcc, 0x3c, 0xfd, 0x7c, 0x2c,
-	0x4e, 0xd8, 0x3a, 0x40, 0xfe, 0x30, 0xd6, 0x3f, 0x3f, 0x2f, 0x8a, 0xd9, 0xab, 0x18, 0x6f, 0x66,
-	0x0f, 0x62, 0xec, 0xfe, 0xec, 0x43, 0x9d, 0x7e, 0xeb, 0xb8, 0x40, 0x4d, 0x5f, 0x5b, 0xd0, 0x54,
-	0x3f, 0x79, 0xf0, 0xa5, 0x70, 0xee, 0xbd, 0xef, 0x1d, 0xb0, 0x54, 0xe4, 0x61, 0xc8, 0x71, 0xd2,
-	0xbf, 0x48, 0xda, 0xbb, 0x6c, 0xde, 0xf6, 0x75, 0x1a, 0x15, 0x05, 0x19, 0x0d, 0xa5, 0x2b, 0xec,
-	0x0e, 0xb4, 0xc3, 0x48, 0x7d, 0x26, 0xdc, 0x44, 0xfb, 0x91, 0x4b, 0xa7, 0xd3, 0xaf, 0x0c, 0x9e,
-	0xdc, 0x4a, 0xee, 0x27, 0x2e, 0xbf, 0xd0, 0x4f, 0xac, 0xa4, 0x96, 0xb1, 0x7f, 0xfa, 0x51, 0x89,
-	0x10, 0xd8, 0x8b, 0xb1, 0xa9, 0xaf, 0x9d, 0xee, 0xc5, 0xd8, 0xd7, 0x3e, 0x34, 0xfc, 0xf8, 0x81,
-	0x1f, 0xc5, 0x49, 0xff, 0x8a, 0x4e, 0x5b, 0x33, 0x55, 0xb4, 0xc8, 0x7e, 0xfc, 0xd0, 0x89, 0x93,
-	0xfe, 0xeb, 0x69, 0xa2, 0x1c, 0xd6, 0x70, 0xcf, 0x75, 0xac, 0x4f, 0x52, 0x7b, 0x75, 0x7e, 0xcf,
-	0xb3, 0x0b, 0x52, 0x13, 0xf4, 0x93, 0x8c, 0x7f, 0x08, 0x4b, 0xba, 0x4d, 0xae, 0x82, 0x6f, 0xcc,
-	0xcb, 0xe4, 0xcc, 0x4d, 0x1b, 0xef, 0x44, 0x33, 0x17, 0x6f, 0x59, 0x07, 0x68, 0x7e, 0x74, 0x07,
-	0xd7, 0x16, 0x76, 0x90, 0x19, 0x2a, 0xdd, 0x41, 0x76, 0x29, 0x74, 0x1b, 0xea, 0x9e, 0x4e, 0x49,
-	0xb9, 0x7e, 0xca, 0x00, 0x99, 0x94, 0x09, 0x6e, 0x28, 0xd8, 0x77, 0xa1, 0x41, 0xcf, 0xd1, 0x2a,
-	0xec, 0xaf, 0xcc, 0x0b, 0xb1, 0x7e, 0x46, 0xe6, 0xf5, 0x40, 0x3f, 0x27, 0xbf, 0x0d, 0x8d, 0x34,
-	0x76, 0xbf, 0x31, 0xaf, 0x98, 0x26, 0x86, 0xe7, 0x29, 0x05, 0xbb, 0x09, 0xb5, 0x09, 0x9a, 0xe7,
-	0xfe, 0x60, 0xde, 0xb0, 0x69, 0xab, 0xad, 0xb1, 0x64, 0x78, 0xc8, 0x83, 0x6a, 0xed, 0xfb, 0xce,
-	0x29, 0xc3, 0x93, 0xb9, 0x57, 0x0e, 0x71, 0xee, 0x6a, 0x7f, 0x13, 0xae, 0x14, 0x1f, 0x89, 0xd3,
-	0x17, 0x64, 0x13, 0x1a, 0xbd, 0x49, 0xbd, 0xdc, 0x58, 0x20, 0xe0, 0xb3, 0x6f, 0xcd, 0xfc, 0x72,
-	0xf8, 0x82, 0x47, 0xe8, 0xf7, 0x33, 0xe7, 0x87, 0x76, 0xa5, 0x7f, 0xf3, 0xd4, 0xb4, 0x32, 0xf7,
-	0x99, 0xba, 0x44, 0xf2, 0xba, 0x3f, 0x80, 0xf6, 0x68, 0xfa, 0xfc, 0xf9, 0x89, 0x89, 0xd0, 0xfb,
-	0xb7, 0xa8, 0x5d, 0x21, 0x0c, 0x2c, 0x3c, 0x79, 0xf2, 0xd6, 0xa8, 0xf0, 0xfe, 0x79, 0x19, 0x1a,
-	0xae, 0xb4, 0x1d, 0xcf, 0x8b, 0xfa, 0x6f, 0xe9, 0x27, 0x4f, 0x57, 0x6e, 0x78, 0x1e, 0xbd, 0x1d,
-	0xab, 0x50, 0x50, 0x2a, 0xa2, 0xed, 0x7b, 0xfd, 0x55, 0xed, 0x86, 0x53, 0xd0, 0xd0, 0xa3, 0x5c,
-	0x66, 0x27, 0x72, 0x82, 0x40, 0x04, 0x48, 0xf0, 0x5d, 0x93, 0xcb, 0x6c, 0x40, 0x43, 0x8f, 0xdd,
-	0x80, 0xf6, 0xc4, 0x39, 0xb6, 0x53, 0x48, 0xff, 0xb6, 0x4e, 0x14, 0x9d, 0x38, 0xc7, 0xbb, 0x06,
-	0x84, 0x62, 0xae, 0xb3, 0x7c, 0x48, 0xd8, 0xde, 0x9e, 0x17, 0xf3, 0xec, 0x10, 0xc3, 0x9b, 0x7e,
-	0x76, 0x9e, 0x21, 0x73, 0x44, 0x46, 0xd8, 0x0e, 0xd6, 0xfb, 0xef, 0x9c, 0x36, 0x47, 0xe6, 0x98,
-	0x86, 0xe6, 0x28, 0x3d, 0xb1, 0xad, 0x03, 0x68, 0x6b, 0x4d, 0xcc, 0xbe, 0x33, 0xdf, 0x26, 0x0b,
-	0x73, 0xb8, 0x4e, 0x71, 0x21, 0x56, 0xaf, 0x03, 0x50, 0xc0, 0xa5, 0xdb, 0xac, 0xcd, 0xb7, 0xc9,
-	0xa2, 0x21, 0xde, 0x7c, 0x9a, 0x16, 0xd1, 0x2f, 0x4d, 0x31, 0x30, 0xb2, 0x9d, 0x20, 0xe8, 0xdf,
-	0x9d, 0xd7, 0x81, 0x34, 0x66, 0xe2, 0xd6, 0x34, 0x8d, 0x9e, 0xde, 0x87, 0xf6, 0x06, 0x25, 0x9c,
-	0xfb, 0x31, 0xd9, 0xa4, 0x9b, 0x50, 0xcd, 0x8e, 0x95, 0x99, 0xb1, 0x23, 0x8a, 0xe7, 0x62, 0x28,
-	0x47, 0x8a, 0x13, 0x7a, 0xf0, 0x27, 0x15, 0xa8, 0xef, 0xa9, 0x69, 0xe4, 0x8a, 0x97, 0xe7, 0xed,
-	0xbc, 0x91, 0xae, 0x5d, 0xe6, 0xef, 0xda, 0x7a, 0x99, 0x84, 0x2e, 0x9e, 0x58, 0x2b, 0x14, 0x78,
-	0x67, 0x27, 0xd6, 0x2c, 0x2d, 0x43, 0xe7, 0xa6, 0xea, 0x0a, 0xf1, 0x7d, 0x1a, 0x1f, 0x7a, 0xea,
-	0x99, 0x44, 0xbe, 0xd7, 0x28, 0x6f, 0x06, 0x52, 0xd0, 0xd0, 0xa3, 0xe4, 0xbd, 0x94, 0x80, 0x04,
-	0x4b, 0x47, 0xfb, 0xed, 0x14, 0x48, 0xe2, 0x95, 0x9e, 0x72, 0x1b, 0x2f, 0x38, 0xe5, 0xde, 0x86,
-	0x2c, 0x99, 0xc8, 0x44, 0x1e, 0x2f, 0x4e, 0x36, 0x5a, 0x87, 0x66, 0xf6, 0x1d, 0xc1, 0x44, 0x1d,
-	0x17, 0xd6, 0xf2, 0x0f, 0x0a, 0xfb, 0x69, 0x89, 0xe7, 0x64, 0x0b, 0x4e, 0xb5, 0x61, 0xa4, 0x0e,
-	0xcc, 0x01, 0x04, 0x5e, 0xe5, 0x54, 0xbb, 0x8b, 0xed, 0xd2, 0xc3, 0xbf, 0x1f, 0xdb, 0xae, 0x92,
-	0x71, 0x62, 0x22, 0xff, 0x86, 0x1f, 0x6f, 0x62, 0x75, 0xf0, 0x1b, 0x60, 0xed, 0x28, 0x8f, 0x58,
-	0x88, 0xa7, 0xc9, 0x89, 0x1b, 0x4e, 0x4d, 0x8c, 0x48, 0x65, 0xf3, 0xdb, 0x40, 0x33, 0xc7, 0xfc,
-	0x36, 0xa0, 0xad, 0xab, 0xe8, 0x13, 0x27, 0x96, 0x75, 0xf2, 0xf3, 0x49, 0xa0, 0x1c, 0xcf, 0x30,
-	0x24, 0xad, 0x0e, 0xfe, 0xb8, 0x04, 0xcb, 0xbb, 0x91, 0x72, 0x45, 0x1c, 0x3f, 0x44, 0xa7, 0xe4,
-	0x50, 0x88, 0xc1, 0xa0, 0x4a, 0x07, 0x47, 0x9d, 0x1b, 0x4c, 0x65, 0x14, 0x06, 0xca, 0xa6, 0xcb,
-	0x63, 0xeb, 0x0a, 0x6f, 0x12, 0x84, 0x42, 0xeb, 0x0c, 0x4d, 0x0d, 0x2b, 0x05, 0x34, 0x1d, 0x39,
-	0x6f, 0x42, 0x37, 0x4f, 0xcf, 0xa3, 0x1e, 0x4c, 0xee, 0x7f, 0x06, 0xa5, 0x5e, 0xae, 0x43, 0x2b,
-	0x12, 0x0e, 0xba, 0x6d, 0xea, 0xa6, 0x46, 0x34, 0xa0, 0x41, 0xd8, 0xcf, 0xe0, 0x10, 0x7a, 0xbb,
-	0x91, 0x08, 0x9d, 0x48, 0xa0, 0x25, 0x98, 0xd0, 0xae, 0x5c, 0x82, 0x7a, 0x20, 0xe4, 0x38, 0x39,
-	0x34, 0xf3, 0x35, 0xb5, 0xec, 0x6f, 0x47, 0xb9, 0xf0, 0xb7, 0x03, 0x77, 0x27, 0x12, 0x8e, 0xf9,
-	0x02, 0x42, 0x65, 0x14, 0x56, 0x39, 0x0d, 0xcc, 0x61, 0xd6, 0xe2, 0xba, 0x32, 0xf8, 0xeb, 0x0a,
-	0xb4, 0xcc, 0xce, 0xd0, 0x28, 0x7a, 0x9f, 0x4b, 0xd9, 0x3e, 0xf7, 0xa0, 0x82, 0xe7, 0x51, 0xbd,
-	0xf1, 0x58, 0x64, 0xef, 0x41, 0x25, 0xf0, 0x27, 0x26, 0x38, 0x7f, 0x7d, 0xc6, 0xae, 0xcc, 0xee,
-	0xaf, 0xb9, 0x25, 0x41, 0x6a, 0x3c, 0xbe, 0x4e, 0xa5, 0x7f, 0x6c, 0xa3, 0x54, 0x98, 0x3d, 0x41,
-	0x1d, 0x3f, 0x46, 0xd1, 0xc3, 0x4d, 0x75, 0x5c, 0xca, 0xf2, 0x49, 0xf5, 0xa5, 0xc3, 0x9b, 0x06,
-	0x32, 0xf4, 0xd8, 0xf7, 0xc1, 0x8a, 0xa5, 0x13, 0xc6, 0x87, 0x2a, 0x31, 0xc1, 0x38, 0x5b, 0x4b,
-	0x8e, 0xe5, 0xda, 0xe6, 0xce, 0xfe, 0xb1, 0xdc, 0x33, 0x18, 0x33, 0x58, 0x46, 0xc9, 0x7e, 0x04,
-	0xed, 0x58, 0xc4, 0xb1, 0xce, 0x93, 0x1c, 0x29, 0xa3, 0x47, 0x17, 0x8b, 0xc1, 0x36, 0x61, 0x71,
-	0xd5, 0xa6, 0x71, 0x2b, 0xce, 0x41, 0xec, 0x1d, 0x60, 0x8e, 0x31, 0x3c, 0xb6, 0x54, 0x9e, 0xc8,
-	0xdf, 0x10, 0x6b, 0xbc, 0x97, 0x62, 0x50, 0x64, 0x49, 0xb2, 0x7f, 0x05, 0xba, 0xe9, 0x68, 0x81,
-	0x1a, 0x8f, 0xb3, 0xa3, 0xf5, 0xeb, 0xa7, 0xc6, 0x7b, 0x48, 0xe8, 0xc2, 0xa8, 0x9d, 0xb8, 0x88,
-	0x60, 0x1f, 0x41, 0x37, 0xd4, 0xac, 0xb7, 0xcd, 0xbd, 0x8c, 0x8e, 0xf9, 0xaf, 0xcc, 0x38, 0xcd,
-	0x19, 0xd1, 0xc8, 0x53, 0xe6, 0x72, 0x78, 0x3c, 0xf8, 0xf7, 0x12, 0xb4, 0x0a, 0x6b, 0xa4, 0xff,
-	0x39, 0xb1, 0x88, 0xd2, 0x3b, 0x1a, 0x2c, 0x23, 0xec, 0x50, 0x99, 0x5c, 0xf8, 0x26, 0xa7, 0x32,
-	0xc2, 0x22, 0x15, 0x88, 0x54, 0xb3, 0xb0, 0x8c, 0x16, 0xcb, 0x9c, 0xa2, 0x74, 0xbe, 0x31, 0xb1,
-	0xb0, 0xca, 0xdb, 0x39, 0x70, 0xe8, 0xb1, 0x2b, 0x60, 0xa1, 0xf0, 0x1d, 0x38, 0x71, 0x7a, 0x6b,
-	0x94, 0xd5, 0x51, 0x35, 0x9f, 0x8a, 0x08, 0xe7, 0x62, 0x8c, 0x5d, 0x5a, 0x45, 0xc9, 0x20, 0x23,
-	0xf3, 0x5c, 0x49, 0x9d, 0x36, 0xd1, 0xe6, 0x16, 0x02, 0x3e, 0x55, 0x92, 0x9a, 0x19, 0x39, 0x20,
-	0x1b, 0xd7, 0xe4, 0x69, 0x15, 0x4d, 0xc9, 0x93, 0xa9, 0xc0, 0xc0, 0xc2, 0xa3, 0xa4, 0xf1, 0x26,
-	0x6f, 0x50, 0x7d, 0xe8, 0x0d, 0xfe, 0xb1, 0x04, 0xcb, 0xa7, 0x36, 0x1b, 0xfd, 0x38, 0x6e, 0x74,
-	0x9a, 0xc9, 0xd8, 0xe6, 0x75, 0xac, 0x0e, 0x3d, 0x42, 0x24, 0x13, 0x12, 0xbd, 0xb2, 0x41, 0x24,
-	0x13, 0x94, 0xbb, 0x8b, 0x50, 0x4f, 0x8e, 0x69, 0xb5, 0x5a, 0x8d, 0x6a, 0xc9, 0x31, 0x2e, 0x73,
-	0x03, 0x9a, 0x81, 0x1a, 0xdb, 0x81, 0x78, 0x2a, 0x02, 0xda, 0x87, 0xee, 0xfa, 0x9b, 0x67, 0x70,
-	0x79, 0xed, 0xa1, 0x1a, 0x3f, 0x44, 0x5a, 0x6e, 0x05, 0xa6, 0x34, 0xf8, 0x09, 0x58, 0x29, 0x94,
-	0x35, 0xa1, 0xb6, 0x25, 0x0e, 0xa6, 0xe3, 0xde, 0x39, 0x3c, 0x4f, 0x63, 0x8b, 0x5e, 0x09, 0x4b,
-	0x9f, 0x38, 0x91, 0xec, 0x95, 0x11, 0x7d, 0x3f, 0x8a, 0x54, 0xd4, 0xab, 0x60, 0x71, 0xd7, 0x91,
-	0xbe, 0xdb, 0xab, 0x62, 0xf1, 0x81, 0x93, 0x38, 0x41, 0xaf, 0x36, 0xf8, 0xad, 0x3a, 0x58, 0xbb,
-	0x66, 0x74, 0xb6, 0x05, 0x9d, 0xec, 0xfb, 0xd4, 0xe2, 0xeb, 0x85, 0xdd, 0xf9, 0x02, 0x5d, 0x2f,
-	0xb4, 0xc3, 0x42, 0x6d, 0xfe, 0x13, 0x56, 0xf9, 0xd4, 0x27, 0xac, 0xab, 0x50, 0x79, 0x12, 0x9d,
-	0xcc, 0xbe, 0xb6, 0xec, 0x06, 0x8e, 0xe4, 0x08, 0x66, 0xef, 0x42, 0x0b, 0xf9, 0x6e, 0xc7, 0xe4,
-	0x7f, 0xcd, 0xd1, 0xbc, 0xf8, 0x9d, 0x8d, 0xe0, 0x1c, 0x90, 0xc8, 0xf8, 0xe8, 0x35, 0xb0, 0xdc,
-	0x43, 0x3f, 0xf0, 0x22, 0x21, 0xcd, 0xf5, 0x19, 0x3b, 0x3d, 0x65, 0x9e, 0xd1, 0xb0, 0x1f, 0x53,
-	0x82, 0x60, 0x7a, 0xa5, 0x50, 0xbc, 0xc7, 0xbf, 0x38, 0x73, 0xd2, 0x4b, 0x29, 0xf8, 0x52, 0x81,
-	0x9c, 0x14, 0x36, 0xcf, 0x2c, 0x6e, 0x14, 0x33, 0x8b, 0xf5, 0xc7, 0x9c, 0xec, 0x38, 0x4f, 0xe7,
-	0x0d, 0x0a, 0xaa, 0x34, 0x82, 0x7c, 0x4b, 0x33, 0x3b, 0x88, 0x28, 0xc7, 0x63, 0xb7, 0xa0, 0x8a,
-	0xe6, 0xc1, 0x68, 0x69, 0x61, 0xda, 0xa9, 0x3b, 0xe3, 0x84, 0xa7, 0xef, 0x76, 0xd3, 0xf8, 0xd0,
-	0xd6, 0x61, 0x01, 0x5a, 0xa4, 0x96, 0x49, 0xd9, 0x9f, 0xc6, 0x87, 0x5b, 0x18, 0x18, 0xa0, 0x94,
-	0xde, 0x84, 0x6e, 0xba, 0x48, 0x93, 0xf7, 0xa8, 0x13, 0x02, 0x3a, 0x29, 0x54, 0xa7, 0x3d, 0xae,
-	0xc1, 0x79, 0xf7, 0xd0, 0x91, 0x52, 0x04, 0xf6, 0xc1, 0x74, 0x34, 0x4a, 0x1d, 0x49, 0x87, 0xac,
-	0xd3, 0xb2, 0x41, 0xdd, 0x23, 0x0c, 0xf9, 0xa5, 0x01, 0x74, 0xa4, 0x1f, 0xe8, 0x3c, 0x71, 0xdb,
-	0x95, 0x49, 0xbf, 0x4b, 0x94, 0x2d, 0xe9, 0x07, 0x94, 0x1e, 0xbe, 0x29, 0x13, 0xf6, 0x21, 0xf4,
-	0xa6, 0x53, 0xdf, 0x8b, 0xed, 0x44, 0xa5, 0x9f, 0x9d, 0xfa, 0x4b, 0xb4, 0xa7, 0x85, 0x33, 0xf7,
-	0xe3, 0xa9, 0xef, 0xed, 0x2b, 0xf3, 0xdd, 0xa9, 0x43, 0xf4, 0x69, 0x15, 0x35, 0x59, 0x5f, 0x7c,
-	0x63, 0xcb, 0x9e, 0x4e, 0xee, 0x3b, 0x48, 0x93, 0xfb, 0xe6, 0x1e, 0x3b, 0x96, 0x4f, 0x3d, 0x76,
-	0x7c, 0x08, 0xed, 0xa2, 0x48, 0xa2, 0x88, 0xd3, 0x79, 0xa4, 0x77, 0x8e, 0x01, 0xd4, 0x77, 0x54,
-	0x34, 0x71, 0x82, 0x5e, 0x09, 0xcb, 0x3a, 0x8d, 0xbf, 0x57, 0x66, 0x6d, 0xb0, 0xd2, 0x40, 0xb9,
-	0x57, 0x19, 0xfc, 0x10, 0xac, 0xf4, 0xef, 0x17, 0xfd, 0xc6, 0x41, 0x9b, 0x4d, 0x21, 0x82, 0x36,
-	0x78, 0x16, 0x02, 0x28, 0xb2, 0x4a, 0x3f, 0x2a, 0x96, 0xf3, 0x8f, 0x8a, 0x83, 0x5f, 0x85, 0x76,
-	0x71, 0x69, 0xe9, 0xa5, 0x54, 0x29, 0xbf, 0x94, 0x5a, 0xd0, 0x8a, 0x2e, 0x65, 0x23, 0x35, 0xb1,
-	0x0b, 0x91, 0x88, 0x85, 0x00, 0x1c, 0xe6, 0xf6, 0x13, 0xa8, 0xeb, 0x4f, 0x99, 0x6c, 0x19, 0x3a,
-	0x8f, 0xe5, 0x91, 0x54, 0xcf, 0xa4, 0x06, 0xf4, 0xce, 0xb1, 0xf3, 0xb0, 0x94, 0xae, 0xd6, 0xfc,
-	0xfe, 0xec, 0x95, 0x58, 0x0f, 0xda, 0xc4, 0x8d, 0x14, 0x52, 0x66, 0x57, 0xa1, 0x6f, 0x8c, 0xfd,
-	0x96, 0x92, 0x62, 0x47, 0x25, 0xfe, 0xe8, 0x24, 0xc5, 0x56, 0xd8, 0x12, 0xb4, 0xf6, 0x12, 0x15,
-	0xee, 0x09, 0xe9, 0xf9, 0x72, 0xdc, 0xab, 0xde, 0x7e, 0x00, 0x75, 0xfd, 0x57, 0xb4, 0x30, 0xa4,
-	0x06, 0xf4, 0xce, 0x21, 0xf5, 0x27, 0x8e, 0x9f, 0xf8, 0x72, 0xbc, 0x23, 0x8e, 0x13, 0x6d, 0x64,
-	0xf0, 0x28, 0xdd, 0x2b, 0xb3, 0x2e, 0x80, 0xe9, 0xf5, 0xbe, 0xf4, 0x7a, 0x95, 0x7b, 0x9b, 0x3f,
-	0xfb, 0xfc, 0x5a, 0xe9, 0xaf, 0x3e, 0xbf, 0x56, 0xfa, 0xfb, 0xcf, 0xaf, 0x9d, 0xfb, 0xbd, 0x9f,
-	0x5f, 0x2b, 0x7d, 0xfa, 0x6e, 0xe1, 0x27, 0xec, 0xc4, 0x49, 0x22, 0xff, 0x58, 0xdf, 0x27, 0xa7,
-	0x15, 0x29, 0xee, 0x86, 0x47, 0xe3, 0xbb, 0xe1, 0xc1, 0xdd, 0x54, 0x54, 0x0e, 0xea, 0xf4, 0xc1,
-	0xf5, 0xbd, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x34, 0x5e, 0x24, 0x5f, 0x3b, 0x00, 0x00,
+	// 4996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4d, 0x93, 0x1c, 0x47,
+	0x56, 0xea, 0xef, 0xea, 0xd7, 0x1f, 0xd3, 0x93, 0xfa, 0x2a, 0xcb, 0xb2, 0x34, 0xdb, 0x6b, 0xd9,
+	0xb3, 0x5a, 0x6b, 0xb4, 0x1e, 0xaf, 0xc1, 0xc1, 0xe2, 0xf5, 0x8e, 0x46, 0x92, 0xe9, 0x5d, 0x69,
+	0x34, 0x9b, 0x33, 0xc2, 0x81, 0x83, 0xa0, 0xa2, 0xa6, 0x2a, 0xbb, 0xa7, 0x76, 0xaa, 0x2b, 0x4b,
+	0x55, 0xd5, 0xd2, 0x8c, 0x7f, 0x00, 0x17, 0x6e, 0xf0, 0x03, 0x20, 0xf6, 0x02, 0x11, 0x10, 0x04,
+	0x01, 0x47, 0x82, 0xfb, 0x1e, 0xf7, 0xcc, 0x01, 0x08, 0xef, 0x11, 0x88, 0x80, 0x08, 0xe0, 0x46,
+	0x40, 0xbc, 0x97, 0x99, 0x55, 0xd5, 0x3d, 0xad, 0x91, 0x65, 0x7b, 0x01, 0x13, 0x3e, 0x55, 0xbe,
+	0x8f, 0xfc, 0x7c, 0x1f, 0xf9, 0x32, 0xf3, 0x15, 0xf4, 0xe3, 0x20, 0x16, 0x61, 0x10, 0x89, 0x8d,
+	0x38, 0x91, 0x99, 0x64, 0x96, 0x81, 0xaf, 0xdc, 0x9a, 0x04, 0xd9, 0xe1, 0xec, 0x60, 0xc3, 0x93,
+	0xd3, 0xdb, 0x13, 0x39, 0x91, 0xb7, 0x89, 0xe1, 0x60, 0x36, 0x26, 0x88, 0x00, 0x2a, 0xa9, 0x8a,
+	0x57, 0x20, 0x0e, 0xdd, 0x48, 0x97, 0x57, 0xb2, 0x60, 0x2a, 0xd2, 0xcc, 0x9d, 0xc6, 0x86, 0x18,
+	0x4a, 0xef, 0x48, 0x97, 0xdb, 0xd9, 0xb1, 0xe6, 0x1b, 0xfe, 0x57, 0x05, 0x5a, 0x0f, 0x45, 0x9a,
+	0xba, 0x13, 0xc1, 0x86, 0x50, 0x4b, 0x03, 0xdf, 0xae, 0xac, 0x55, 0xd6, 0xfb, 0x9b, 0x83, 0x8d,
+	0x7c, 0x58, 0x7b, 0x99, 0x9b, 0xcd, 0x52, 0x8e, 0x44, 0xe4, 0xf1, 0xa6, 0xbe, 0x5d, 0x5d, 0xe4,
+	0x79, 0x28, 0xb2, 0x43, 0xe9, 0x73, 0x24, 0xb2, 0x01, 0xd4, 0x44, 0x92, 0xd8, 0xb5, 0xb5, 0xca,
+	0x7a, 0x97, 0x63, 0x91, 0x31, 0xa8, 0xfb, 0x6e, 0xe6, 0xda, 0x75, 0x42, 0x51, 0x99, 0xbd, 0x0e,
+	0xfd, 0x38, 0x91, 0x9e, 0x13, 0x44, 0x63, 0xe9, 0x10, 0xb5, 0x41, 0xd4, 0x2e, 0x62, 0x47, 0xd1,
+	0x58, 0xde, 0x45, 0x2e, 0x1b, 0x5a, 0x6e, 0xe4, 0x86, 0x27, 0xa9, 0xb0, 0x9b, 0x44, 0x36, 0x20,
+	0xeb, 0x43, 0x35, 0xf0, 0xed, 0xd6, 0x5a, 0x65, 0xbd, 0xce, 0xab, 0x81, 0x8f, 0x7d, 0xcc, 0x66,
+	0x81, 0x6f, 0x5b, 0xaa, 0x0f, 0x2c, 0xb3, 0x21, 0x74, 0x23, 0x21, 0xfc, 0x1d, 0x99, 0x71, 0x11,
+	0x87, 0x27, 0x76, 0x7b, 0xad, 0xb2, 0x6e, 0xf1, 0x39, 0xdc, 0xf0, 0x31, 0xb4, 0xb7, 0x65, 0x14,
+	0x09, 0x2f, 0x93, 0x09, 0xbb, 0x0e, 0x1d, 0x33, 0x25, 0x47, 0x2f, 0x45, 0x83, 0x83, 0x41, 0x8d,
+	0x7c, 0xf6, 0x26, 0xac, 0x78, 0x86, 0xdb, 0x09, 0x22, 0x5f, 0x1c, 0xd3, 0x5a, 0x34, 0x78, 0x3f,
+	0x47, 0x8f, 0x10, 0x3b, 0xfc, 0xa7, 0x2a, 0xb4, 0xf6, 0x0e, 0x67, 0xe3, 0x71, 0x28, 0xd8, 0xeb,
+	0xd0, 0xd3, 0xc5, 0x6d, 0x19, 0x8e, 0xfc, 0x63, 0xdd, 0xee, 0x3c, 0x92, 0xad, 0x41, 0x47, 0x23,
+	0xf6, 0x4f, 0x62, 0xa1, 0x9b, 0x2d, 0xa3, 0xe6, 0xdb, 0x79, 0x18, 0x44, 0xb4, 0xc4, 0x35, 0x3e,
+	0x8f, 0x5c, 0xe0, 0x72, 0x8f, 0xed, 0xfa, 0x29, 0x2e, 0x97, 0x7a, 0xdb, 0x0a, 0x83, 0xa7, 0x82,
+	0x8b, 0xc9, 0x76, 0x94, 0xd1, 0xda, 0x37, 0x78, 0x19, 0xc5, 0x36, 0xe1, 0x62, 0xaa, 0xaa, 0x38,
+	0x89, 0x1b, 0x4d, 0x44, 0xea, 0xcc, 0x82, 0x28, 0xfb, 0x95, 0xef, 0xda, 0xcd, 0xb5, 0xda, 0x7a,
+	0x9d, 0x9f, 0xd7, 0x44, 0x4e, 0xb4, 0xc7, 0x44, 0x62, 0xdf, 0x81, 0x0b, 0x0b, 0x75, 0x54, 0x95,
+	0xd6, 0x5a, 0x6d, 0xbd, 0xc6, 0xd9, 0x5c, 0x95, 0x11, 0xd5, 0xb8, 0x07, 0xab, 0xc9, 0x2c, 0x42,
+	0x6d, 0xbd, 0x1f, 0x84, 0x99, 0x48, 0xf6, 0x62, 0xe1, 0x91, 0x0c, 0x3b, 0x9b, 0x97, 0x37, 0x48,
+	0xa1, 0xf9, 0x22, 0x99, 0x9f, 0xae, 0x31, 0xfc, 0xfb, 0x2a, 0x58, 0x77, 0x83, 0x34, 0x76, 0x33,
+	0xef, 0x90, 0x5d, 0x86, 0xd6, 0x78, 0x16, 0x79, 0x85, 0x04, 0x9b, 0x08, 0x8e, 0x7c, 0xf6, 0xeb,
+	0xb0, 0x12, 0x4a, 0xcf, 0x0d, 0x9d, 0x5c, 0x58, 0x76, 0x75, 0xad, 0xb6, 0xde, 0xd9, 0x3c, 0x5f,
+	0x68, 0x72, 0xae, 0x0c, 0xbc, 0x4f, 0xbc, 0x39, 0xcc, 0xde, 0x87, 0x41, 0x22, 0xa6, 0x32, 0x13,
+	0xa5, 0xea, 0x35, 0xaa, 0xce, 0x8a, 0xea, 0x1f, 0x25, 0x6e, 0xbc, 0x23, 0x7d, 0xc1, 0x57, 0x14,
+	0x6f, 0x51, 0xfd, 0xed, 0xd2, 0x7a, 0x8a, 0x89, 0x13, 0xf8, 0xc7, 0x0e, 0x75, 0x60, 0xd7, 0xd7,
+	0x6a, 0xeb, 0x8d, 0x62, 0x71, 0xc4, 0x64, 0xe4, 0x1f, 0x3f, 0x40, 0x0a, 0x7b, 0x07, 0x2e, 0x2d,
+	0x56, 0x51, 0xad, 0xda, 0x0d, 0xaa, 0x73, 0x7e, 0xae, 0x0e, 0x27, 0x12, 0xfb, 0x06, 0x74, 0x4d,
+	0xa5, 0xec, 0x24, 0x56, 0x76, 0xd3, 0xe0, 0x9d, 0xb4, 0xa4, 0x48, 0x97, 0xa1, 0x15, 0xa4, 0x4e,
+	0x1a, 0x44, 0x47, 0x64, 0x40, 0x16, 0x6f, 0x06, 0xe9, 0x5e, 0x10, 0x1d, 0xb1, 0x57, 0xc0, 0x4a,
+	0x84, 0xa7, 0x28, 0x16, 0x51, 0x5a, 0x89, 0xf0, 0x90, 0x34, 0x4c, 0xa1, 0xf1, 0x50, 0x24, 0x13,
+	0xc1, 0xae, 0x80, 0x85, 0xf4, 0x3d, 0xcf, 0x8d, 0x68, 0x79, 0x2d, 0x9e, 0xc3, 0x68, 0xae, 0xb1,
+	0x9b, 0x64, 0x81, 0x1b, 0x92, 0xfe, 0x5a, 0xdc, 0x80, 0xec, 0x55, 0x68, 0xa7, 0x99, 0x9b, 0x64,
+	0x38, 0x09, 0xd2, 0xdb, 0x06, 0xb7, 0x08, 0x81, 0xaa, 0x7f, 0x19, 0x5a, 0x22, 0xf2, 0x89, 0x54,
+	0x57, 0x02, 0x13, 0x91, 0x3f, 0xf2, 0x8f, 0x87, 0x7f, 0x55, 0x81, 0xde, 0xc3, 0x59, 0x98, 0x05,
+	0x5b, 0xc9, 0x64, 0x26, 0xa6, 0x51, 0x86, 0x66, 0x7e, 0x37, 0x48, 0x33, 0xdd, 0x33, 0x95, 0xd9,
+	0x3a, 0xb4, 0x3f, 0x4c, 0xe4, 0x2c, 0xbe, 0x77, 0x1c, 0x1b, 0x81, 0x82, 0xd2, 0x1d, 0xc4, 0xf0,
+	0x82, 0xc8, 0xde, 0x82, 0xce, 0xa3, 0xc4, 0x17, 0xc9, 0x9d, 0x13, 0xe2, 0xad, 0x9d, 0xe2, 0x2d,
+	0x93, 0xd9, 0x55, 0x68, 0xef, 0x89, 0xd8, 0x4d, 0x5c, 0x94, 0x34, 0x0e, 0xac, 0xcd, 0x0b, 0x04,
+	0xce, 0x95, 0x98, 0x47, 0xbe, 0xb6, 0x1e, 0x03, 0x0e, 0x27, 0xd0, 0xde, 0x9a, 0x4c, 0x12, 0x31,
+	0x71, 0x33, 0xf2, 0x53, 0x32, 0xa6, 0xe1, 0xd6, 0x78, 0x55, 0xc6, 0xe4, 0x0b, 0x71, 0x02, 0x6a,
+	0x7d, 0xa8, 0xcc, 0xae, 0x41, 0x5d, 0x2c, 0x1f, 0x0f, 0xe1, 0xd9, 0x25, 0x68, 0x7a, 0x32, 0x1a,
+	0x07, 0x13, 0xed, 0x41, 0x35, 0x34, 0xfc, 0xbd, 0x1a, 0x34, 0x68, 0x72, 0xb8, 0xbc, 0xe8, 0xd5,
+	0x1c, 0xf1, 0xd4, 0x0d, 0x8d, 0x54, 0x10, 0x71, 0xef, 0xa9, 0x1b, 0xb2, 0x35, 0x68, 0x60, 0x33,
+	0xe9, 0x92, 0xb5, 0x51, 0x04, 0xf6, 0x06, 0x34, 0x50, 0x57, 0xd2, 0xf9, 0x11, 0xa0, 0xae, 0xdc,
+	0xa9, 0xff, 0xec, 0xef, 0xae, 0x9f, 0xe3, 0x8a, 0xcc, 0xde, 0x84, 0xba, 0x3b, 0x99, 0xa4, 0x76,
+	0x7d, 0xd1, 0x6a, 0xf2, 0xf9, 0x72, 0x62, 0x60, 0xef, 0x42, 0x5b, 0xc9, 0x0d, 0xb9, 0x1b, 0xc4,
+	0x7d, 0xb9, 0xb4, 0x5b, 0x94, 0x45, 0xca, 0x0b, 0x4e, 0x5c, 0xf1, 0x20, 0xd5, 0x8e, 0x8a, 0x14,
+	0xd7, 0xe2, 0x05, 0x02, 0xdd, 0x79, 0x9c, 0x88, 0xad, 0x30, 0x94, 0xde, 0x5e, 0xf0, 0x89, 0xd0,
+	0xce, 0x7f, 0x0e, 0xc7, 0xde, 0x80, 0xfe, 0xae, 0x52, 0x39, 0x2e, 0xd2, 0x59, 0x98, 0xa5, 0x7a,
+	0x43, 0x58, 0xc0, 0xb2, 0x0d, 0x60, 0x73, 0x98, 0x7d, 0x9a, 0x7e, 0x7b, 0xad, 0xb6, 0xde, 0xe3,
+	0x4b, 0x28, 0x68, 0x55, 0x13, 0x5c, 0xe9, 0x20, 0x42, 0x3b, 0x4c, 0x6d, 0x20, 0x27, 0xd8, 0x31,
+	0xb8, 0x91, 0x9f, 0x0e, 0xff, 0xad, 0x0a, 0xcd, 0x51, 0x94, 0x8a, 0x24, 0x43, 0x1b, 0x71, 0xc7,
+	0x63, 0xe1, 0x65, 0x42, 0xb9, 0xa0, 0x3a, 0xcf, 0x61, 0x9c, 0xe3, 0xbe, 0xfc, 0x28, 0x09, 0x32,
+	0xb1, 0xf7, 0x8e, 0xd6, 0x82, 0x02, 0xc1, 0x6e, 0xc2, 0xaa, 0xeb, 0xfb, 0x8e, 0xe1, 0x76, 0x12,
+	0xf9, 0x2c, 0x25, 0x7b, 0xb1, 0xf8, 0x8a, 0xeb, 0xfb, 0x5b, 0x1a, 0xcf, 0xe5, 0x33, 0x1c, 0x53,
+	0x2d, 0x11, 0x63, 0xd2, 0x89, 0xce, 0xe6, 0x8a, 0x92, 0xd9, 0xa3, 0x83, 0x9f, 0x08, 0x2f, 0xe3,
+	0x62, 0xcc, 0x91, 0xc6, 0x2e, 0x40, 0xc3, 0xcd, 0xb2, 0x44, 0xc9, 0xa0, 0xcd, 0x15, 0xc0, 0x36,
+	0xe0, 0x3c, 0xd9, 0x65, 0x16, 0xc8, 0xc8, 0xc9, 0xdc, 0x83, 0x50, 0xd0, 0x9c, 0x94, 0x63, 0x5f,
+	0xcd, 0x49, 0xfb, 0x48, 0x19, 0xf9, 0x29, 0x6e, 0x05, 0x8b, 0xfc, 0x91, 0x3b, 0x15, 0x29, 0xf9,
+	0xf5, 0x36, 0x3f, 0x3f, 0x5f, 0x63, 0x07, 0x49, 0xec, 0x9b, 0xd0, 0x2b, 0xea, 0xa0, 0x65, 0x5b,
+	0x64, 0x24, 0xdd, 0x1c, 0x89, 0x86, 0x7f, 0x11, 0x9a, 0x41, 0xea, 0x88, 0xc8, 0xd7, 0x5b, 0x73,
+	0x23, 0x48, 0xef, 0x45, 0x3e, 0xfb, 0x36, 0xb4, 0x55, 0x2f, 0xbe, 0x18, 0xdb, 0x40, 0xd3, 0xeb,
+	0x6b, 0x95, 0x44, 0xf4, 0x5d, 0x31, 0xe6, 0x56, 0xa6, 0x4b, 0xc3, 0xd7, 0xa0, 0xb1, 0x95, 0x24,
+	0xee, 0x09, 0xcd, 0x15, 0x0b, 0x76, 0x85, 0x9c, 0xa3, 0x02, 0x86, 0x1e, 0xd4, 0x1e, 0xba, 0x31,
+	0xbb, 0x01, 0xd5, 0x69, 0x4c, 0x94, 0xce, 0xe6, 0xc5, 0x92, 0x26, 0xba, 0xf1, 0xc6, 0xc3, 0xf8,
+	0x5e, 0x94, 0x25, 0x27, 0xbc, 0x3a, 0x8d, 0xaf, 0xbc, 0x0b, 0x2d, 0x0d, 0x62, 0x18, 0x73, 0x24,
+	0x4e, 0x48, 0x7c, 0x6d, 0x8e, 0x45, 0xec, 0xe0, 0xa9, 0x1b, 0xce, 0xcc, 0xde, 0xac, 0x80, 0x5f,
+	0xab, 0xbe, 0x57, 0x19, 0xfe, 0x7b, 0x1d, 0xac, 0xbb, 0x22, 0x14, 0x38, 0x2f, 0x54, 0xd3, 0xb2,
+	0x98, 0xb4, 0x02, 0xcc, 0xe1, 0x90, 0x47, 0xb9, 0x6b, 0xaa, 0x25, 0xb4, 0x1e, 0xcc, 0xe1, 0xd0,
+	0xc1, 0x8c, 0xee, 0xcc, 0xbc, 0x23, 0x91, 0x91, 0x02, 0xf4, 0xb8, 0x01, 0x91, 0xb2, 0xa3, 0x29,
+	0x75, 0x45, 0xd1, 0x20, 0xbb, 0x0a, 0x90, 0xc8, 0x67, 0x4e, 0xa0, 0x9c, 0xa9, 0xf2, 0x4b, 0x56,
+	0x22, 0x9f, 0x8d, 0xd0, 0x9d, 0xfe, 0x8f, 0xc8, 0xfd, 0x57, 0xc1, 0x2e, 0xea, 0x50, 0x84, 0xe4,
+	0x04, 0x91, 0x73, 0x80, 0x1b, 0xb3, 0x56, 0x81, 0xa2, 0x4d, 0x0a, 0x95, 0x46, 0xd1, 0x1d, 0x24,
+	0x1a, 0x6d, 0x6e, 0x9f, 0xa1, 0xcd, 0x4b, 0x8d, 0x03, 0x96, 0x1b, 0xc7, 0x1d, 0x80, 0x3d, 0x31,
+	0x99, 0x8a, 0x28, 0x7b, 0xe8, 0xc6, 0x76, 0x87, 0x04, 0x3f, 0x2c, 0x04, 0x6f, 0xa4, 0xb5, 0x51,
+	0x30, 0x29, 0x2d, 0x28, 0xd5, 0x42, 0xa3, 0xf7, 0xdc, 0xc8, 0xc9, 0x92, 0x59, 0xe4, 0xb9, 0x99,
+	0xb0, 0xbb, 0xd4, 0x55, 0xc7, 0x73, 0xa3, 0x7d, 0x8d, 0x2a, 0x69, 0x70, 0xaf, 0xac, 0xc1, 0x6f,
+	0xc0, 0x4a, 0x9c, 0x04, 0x53, 0x37, 0x39, 0x71, 0x8e, 0xc4, 0x09, 0x09, 0xa3, 0xaf, 0x82, 0x3e,
+	0x8d, 0xfe, 0x91, 0x38, 0x19, 0xf9, 0xc7, 0x57, 0xde, 0x87, 0x95, 0x85, 0x01, 0xbc, 0x94, 0xde,
+	0xfd, 0x4b, 0x05, 0xda, 0xbb, 0x89, 0xd0, 0x5e, 0xe7, 0x3a, 0x74, 0x52, 0xef, 0x50, 0x4c, 0x5d,
+	0x92, 0x92, 0x6e, 0x01, 0x14, 0x0a, 0x85, 0x33, 0x6f, 0x57, 0xd5, 0xb3, 0xed, 0x0a, 0xc7, 0xa1,
+	0xf6, 0x6a, 0x34, 0x26, 0x2c, 0x16, 0xce, 0xa4, 0x5e, 0x76, 0x26, 0x6b, 0xd0, 0x3d, 0x74, 0x53,
+	0xc7, 0x9d, 0x65, 0xd2, 0xf1, 0x64, 0x48, 0x4a, 0x67, 0x71, 0x38, 0x74, 0xd3, 0xad, 0x59, 0x26,
+	0xb7, 0x25, 0xed, 0xfd, 0x41, 0xea, 0xcc, 0x62, 0xdf, 0xcd, 0x8c, 0x57, 0xb7, 0x82, 0xf4, 0x31,
+	0xc1, 0xa8, 0x93, 0x22, 0xcd, 0x82, 0xa9, 0xab, 0x05, 0xea, 0x78, 0x72, 0x16, 0x65, 0xe4, 0xdb,
+	0x6b, 0x7c, 0x35, 0x27, 0x71, 0xf9, 0x6c, 0x1b, 0x09, 0xc3, 0xbf, 0xad, 0x02, 0x3c, 0x90, 0xde,
+	0xd1, 0xbe, 0x9b, 0x4c, 0x44, 0x86, 0x11, 0x8b, 0x51, 0x64, 0x6d, 0x68, 0xad, 0x4c, 0xa9, 0x2f,
+	0xdb, 0x84, 0x4b, 0x46, 0x06, 0x9e, 0x0c, 0x29, 0x7a, 0x52, 0x9a, 0xa8, 0xd7, 0x91, 0x69, 0xaa,
+	0x8a, 0xbf, 0x49, 0x0d, 0xd9, 0x7b, 0xb0, 0x52, 0xae, 0x93, 0x9d, 0xc4, 0x64, 0x7b, 0xcb, 0xb6,
+	0xc4, 0x5e, 0x51, 0x7d, 0xff, 0x24, 0x66, 0xdf, 0x81, 0x8b, 0x89, 0x18, 0x27, 0x22, 0x3d, 0x74,
+	0xb2, 0xb4, 0xdc, 0x99, 0x8a, 0x68, 0x56, 0x35, 0x71, 0x3f, 0xcd, 0xfb, 0xfa, 0x0e, 0x5c, 0x1c,
+	0x53, 0x04, 0xbb, 0x38, 0x3c, 0x65, 0xb6, 0xab, 0x8a, 0x58, 0x1e, 0xdd, 0x6b, 0x40, 0xc7, 0x38,
+	0x65, 0x8a, 0x66, 0x7f, 0x0c, 0x69, 0x31, 0x0e, 0x42, 0x81, 0x3b, 0xcb, 0xf6, 0x21, 0xc6, 0xd6,
+	0x77, 0xc5, 0x58, 0x07, 0x76, 0x05, 0x82, 0x0d, 0xa1, 0xfe, 0x50, 0xfa, 0x82, 0x8c, 0xb0, 0xbf,
+	0xd9, 0xdf, 0xc0, 0x7a, 0x1b, 0xb8, 0x92, 0x88, 0xe5, 0x44, 0x1b, 0xee, 0x40, 0x13, 0x31, 0x8f,
+	0x62, 0xb6, 0x01, 0xad, 0x8c, 0x56, 0x38, 0xd5, 0x4e, 0xf3, 0x42, 0x61, 0x3b, 0xc5, 0xf2, 0x73,
+	0xc3, 0x84, 0xba, 0x71, 0x80, 0x2d, 0x6a, 0x4f, 0xa6, 0x80, 0x21, 0x87, 0x95, 0x5c, 0x3d, 0x1f,
+	0x47, 0xc1, 0x93, 0x99, 0x60, 0x1f, 0xc0, 0x6a, 0x9c, 0x08, 0x27, 0x20, 0x9c, 0x33, 0x3b, 0x72,
+	0xbc, 0x4c, 0x1d, 0x88, 0xa8, 0x0b, 0x5c, 0xe3, 0xa2, 0xc6, 0xd1, 0x76, 0x76, 0xcc, 0xfb, 0xf1,
+	0x1c, 0x3c, 0xfc, 0x18, 0x2e, 0xe7, 0x1c, 0x7b, 0xc2, 0x93, 0x91, 0xef, 0x26, 0x27, 0xe4, 0x49,
+	0x16, 0xda, 0x4e, 0x5f, 0xa6, 0xed, 0x3d, 0x6a, 0xfb, 0xa7, 0x35, 0xe8, 0x3f, 0x8a, 0xee, 0xce,
+	0xe2, 0x30, 0x40, 0xeb, 0xfe, 0x91, 0x32, 0x3e, 0xa5, 0xf4, 0x95, 0xb2, 0xd2, 0xaf, 0xc3, 0x40,
+	0xf7, 0x82, 0xb2, 0x53, 0x2a, 0xab, 0x0f, 0x82, 0x0a, 0xbf, 0x2d, 0x43, 0xd2, 0x57, 0xf6, 0x3e,
+	0x5c, 0x9c, 0xd1, 0xcc, 0x15, 0xe7, 0xa1, 0xf0, 0x8e, 0x9c, 0xe7, 0x04, 0x7b, 0x4c, 0x31, 0x62,
+	0x55, 0x64, 0x43, 0x1c, 0xda, 0x74, 0x51, 0xdd, 0x58, 0x1e, 0xe4, 0x8c, 0x34, 0x12, 0x19, 0x39,
+	0xbe, 0x19, 0xb2, 0xf6, 0xfb, 0x68, 0xb3, 0x7d, 0x59, 0xcc, 0x04, 0xbd, 0xff, 0x6f, 0xc1, 0xea,
+	0x1c, 0x27, 0x8d, 0xa2, 0x49, 0xa3, 0xb8, 0x55, 0x08, 0x77, 0x7e, 0xfa, 0x65, 0x10, 0xc7, 0xa3,
+	0x7c, 0xe4, 0x8a, 0x9c, 0xc7, 0x6a, 0x0b, 0x0f, 0x26, 0x91, 0x4c, 0x84, 0xd6, 0x3c, 0x2b, 0x48,
+	0x47, 0x04, 0x5f, 0xd9, 0x81, 0x0b, 0xcb, 0x5a, 0x59, 0xe2, 0xe8, 0xd6, 0xca, 0x8e, 0x6e, 0x21,
+	0x50, 0x2d, 0x9c, 0xde, 0x1f, 0x57, 0xa0, 0x73, 0x7f, 0xf6, 0xc9, 0x27, 0x27, 0xea, 0xfc, 0xc7,
+	0xba, 0x50, 0xd9, 0xa1, 0x56, 0xaa, 0xbc, 0xb2, 0x83, 0xb1, 0xf2, 0xee, 0x11, 0x7a, 0x3b, 0x6a,
+	0xa4, 0xcd, 0x35, 0x84, 0x21, 0xee, 0xee, 0xd1, 0xfe, 0x19, 0xf6, 0xac, 0xc8, 0x18, 0xba, 0xdd,
+	0x99, 0x05, 0x21, 0xee, 0x97, 0xda, 0x74, 0x73, 0x18, 0x83, 0xc6, 0xd1, 0x58, 0xe9, 0xcb, 0xfd,
+	0x44, 0x4e, 0x95, 0x46, 0x6b, 0x87, 0xb7, 0x84, 0x32, 0xfc, 0xb3, 0x1a, 0xd4, 0x7f, 0x28, 0x83,
+	0x48, 0x9d, 0xab, 0x42, 0x27, 0x54, 0x27, 0x17, 0x14, 0x4e, 0x2b, 0x11, 0xe1, 0x03, 0x8c, 0xfd,
+	0x5f, 0x01, 0xcb, 0x93, 0x9a, 0x54, 0x55, 0x24, 0x4f, 0x86, 0x0f, 0xe6, 0x8f, 0x05, 0x95, 0xa5,
+	0xc7, 0x82, 0x3c, 0x6a, 0xaf, 0xbf, 0x28, 0x6a, 0x6f, 0x87, 0x62, 0x8c, 0xaa, 0x1a, 0xf9, 0x76,
+	0xa3, 0xcc, 0x4b, 0x8d, 0x59, 0x48, 0xdc, 0x96, 0x91, 0xcf, 0xbe, 0x05, 0x90, 0x04, 0x93, 0x43,
+	0xcd, 0xd9, 0x3c, 0x7d, 0x92, 0x22, 0x2a, 0xb1, 0x72, 0x78, 0x45, 0x9f, 0xc2, 0x1d, 0xed, 0xc4,
+	0x0e, 0x70, 0x95, 0xd4, 0x3c, 0x5a, 0x26, 0xe0, 0x5f, 0x7e, 0x7e, 0xbf, 0x34, 0x77, 0x7e, 0xa7,
+	0xd5, 0xa5, 0xf9, 0x5e, 0x05, 0xdc, 0x35, 0x0e, 0x1d, 0x19, 0x39, 0xb1, 0x39, 0x7f, 0x5a, 0x88,
+	0x79, 0x14, 0xed, 0x1e, 0xa1, 0xf3, 0xc3, 0x43, 0xab, 0x3e, 0x1c, 0xb4, 0x17, 0x0f, 0x07, 0x6b,
+	0xd0, 0xfd, 0x89, 0x0c, 0x22, 0x67, 0xea, 0xc6, 0x4e, 0xe6, 0x4e, 0x28, 0x2c, 0x68, 0x70, 0x40,
+	0xdc, 0x43, 0x37, 0xde, 0x77, 0x27, 0xb4, 0x3d, 0x2a, 0x66, 0x32, 0x92, 0x8e, 0x62, 0xd0, 0x28,
+	0x3c, 0x6d, 0xfe, 0x7e, 0x0d, 0xac, 0xad, 0x28, 0x0b, 0x48, 0x64, 0x97, 0xa0, 0x99, 0x50, 0xfc,
+	0xaf, 0x05, 0xa6, 0xa1, 0x5c, 0x28, 0xd5, 0x17, 0x09, 0xa5, 0xf6, 0x12, 0x42, 0xa9, 0x7f, 0x66,
+	0xa1, 0x34, 0xce, 0x12, 0xca, 0xfc, 0x02, 0x36, 0xcf, 0x5c, 0xc0, 0xd6, 0xe2, 0x02, 0x9e, 0x29,
+	0x51, 0xeb, 0xf3, 0x49, 0x74, 0x51, 0x28, 0xed, 0x17, 0x09, 0x05, 0x4e, 0x09, 0xe5, 0x2f, 0x6a,
+	0x60, 0x3d, 0x10, 0xe3, 0xec, 0x6b, 0x3b, 0xfa, 0xca, 0xd8, 0xd1, 0x3f, 0xd7, 0xa0, 0xcd, 0x71,
+	0x86, 0xbf, 0x44, 0x99, 0xdd, 0x06, 0x20, 0x59, 0x9c, 0x2d, 0x38, 0x92, 0x97, 0x3a, 0xc0, 0xbf,
+	0x0d, 0x1d, 0x25, 0x13, 0x55, 0xa3, 0xf1, 0x9c, 0x1a, 0x4a, 0x70, 0xfb, 0xa7, 0xe5, 0xdd, 0xfc,
+	0xcc, 0xf2, 0x6e, 0x7d, 0x6e, 0x79, 0x5b, 0x5f, 0x86, 0xbc, 0xdb, 0x67, 0xca, 0x1b, 0x5e, 0x24,
+	0xef, 0xce, 0x8b, 0xe4, 0xdd, 0x3d, 0x25, 0xef, 0x9f, 0xd6, 0xa0, 0x47, 0xf2, 0xde, 0x13, 0xd3,
+	0x2f, 0xe6, 0x3c, 0x17, 0x84, 0x54, 0x7b, 0x59, 0x21, 0x7d, 0x49, 0x7e, 0xf4, 0x4c, 0x21, 0x35,
+	0xbf, 0x0c, 0x21, 0xb5, 0xce, 0x14, 0x92, 0xf5, 0x22, 0x21, 0xb5, 0x5f, 0xde, 0x28, 0x73, 0x21,
+	0x7d, 0xe1, 0x1d, 0xee, 0x6b, 0x21, 0x7d, 0x49, 0x42, 0x82, 0xa5, 0x11, 0xc8, 0x17, 0x36, 0xa2,
+	0xff, 0xcd, 0x08, 0xe4, 0xff, 0xa3, 0x50, 0xfe, 0xb2, 0x06, 0xb0, 0x17, 0x44, 0x93, 0x50, 0x7c,
+	0x1d, 0x83, 0x7c, 0x65, 0x62, 0x90, 0x5f, 0x54, 0xc1, 0x7a, 0xe8, 0x26, 0x47, 0x5f, 0x59, 0x4b,
+	0xfa, 0x26, 0xb4, 0x64, 0x54, 0xb6, 0x9b, 0x32, 0x5f, 0x53, 0x46, 0xff, 0x27, 0x4c, 0xe3, 0x4f,
+	0x2a, 0xd0, 0xda, 0x4d, 0xa4, 0x3f, 0xf3, 0xb2, 0xcf, 0x69, 0x17, 0x9f, 0x75, 0x89, 0xe7, 0xe7,
+	0x52, 0x7f, 0xd1, 0x5c, 0x1a, 0x8b, 0x73, 0x19, 0xfe, 0x29, 0x5d, 0x95, 0xd2, 0x50, 0x1f, 0x6c,
+	0xfe, 0x92, 0x07, 0x6b, 0xf4, 0xaa, 0xfe, 0x1c, 0xbd, 0x7a, 0xf1, 0x68, 0xff, 0xb0, 0x02, 0x6d,
+	0xba, 0xd3, 0x3a, 0x53, 0x7f, 0xf3, 0xf1, 0x54, 0xcf, 0x1e, 0xcf, 0x99, 0x06, 0x5e, 0xfb, 0x5c,
+	0x06, 0x3e, 0xfc, 0x83, 0x0a, 0xf4, 0xe8, 0xda, 0xf1, 0xfe, 0x2c, 0xf2, 0xe8, 0xdd, 0x63, 0xf9,
+	0x4d, 0xd9, 0x1a, 0xd4, 0x13, 0x91, 0x99, 0x21, 0x76, 0x55, 0x37, 0xdb, 0x32, 0xc4, 0xcb, 0x66,
+	0xa2, 0xe0, 0x6a, 0xb9, 0xc9, 0x24, 0x5d, 0xf6, 0xfa, 0x89, 0x78, 0x9c, 0x3d, 0xbe, 0xb9, 0x4e,
+	0x53, 0xf3, 0xfa, 0xa9, 0x20, 0x7c, 0x49, 0xa5, 0x7b, 0xee, 0x06, 0xdd, 0xf3, 0x50, 0x79, 0xb8,
+	0x05, 0x17, 0xef, 0x1d, 0x67, 0x22, 0x89, 0xdc, 0x10, 0x6f, 0x7d, 0x36, 0xf1, 0xf6, 0x94, 0xae,
+	0x06, 0x0d, 0x73, 0xa5, 0x60, 0xc6, 0x01, 0x97, 0x53, 0x38, 0x14, 0x30, 0xbc, 0x01, 0x9d, 0x71,
+	0x10, 0x0a, 0x47, 0x8e, 0xc7, 0xa9, 0xc8, 0xb0, 0x77, 0x55, 0xa2, 0x69, 0xd5, 0xb8, 0x86, 0x86,
+	0x7f, 0x53, 0x87, 0xae, 0xe9, 0x8a, 0xde, 0xbe, 0x97, 0x4f, 0xff, 0x55, 0x68, 0x53, 0x6b, 0x29,
+	0x3e, 0x58, 0x56, 0xa9, 0x05, 0x0b, 0x11, 0xf4, 0x58, 0xb9, 0x05, 0xab, 0xa5, 0xae, 0x9c, 0x4c,
+	0x66, 0x6e, 0x68, 0xd7, 0x16, 0xdf, 0xa8, 0x4a, 0x2c, 0x7c, 0x05, 0x81, 0x47, 0x54, 0xde, 0x47,
+	0x6e, 0x5c, 0xde, 0xfc, 0x62, 0xf0, 0xd4, 0xf2, 0x22, 0x85, 0x7d, 0x08, 0x2b, 0x38, 0xdb, 0x4d,
+	0x75, 0xcb, 0x4c, 0xf3, 0x55, 0x8e, 0xe7, 0x7a, 0xd1, 0xc5, 0xd2, 0x35, 0xe3, 0xbd, 0xa8, 0x0c,
+	0xa2, 0x09, 0x7a, 0x89, 0xc0, 0x9b, 0xc3, 0xf4, 0x49, 0x48, 0xb7, 0x0b, 0x6d, 0xde, 0x56, 0x98,
+	0xbd, 0x27, 0x61, 0x3e, 0xd3, 0x7c, 0xd7, 0x68, 0xab, 0x99, 0x92, 0xe5, 0xdc, 0x82, 0x8e, 0x4c,
+	0x82, 0x49, 0x10, 0xa9, 0x6b, 0x4c, 0x6b, 0xc9, 0x68, 0x41, 0x31, 0xd0, 0xa5, 0xe6, 0x10, 0x9a,
+	0x4a, 0x51, 0xf5, 0x73, 0xd0, 0x9c, 0xef, 0x53, 0x14, 0xc6, 0xa1, 0xbf, 0x7f, 0x80, 0x97, 0xef,
+	0x94, 0x29, 0xb4, 0x2d, 0x43, 0x7a, 0x93, 0xed, 0x6c, 0xde, 0x3c, 0x3d, 0x2d, 0x94, 0xcf, 0xc6,
+	0x3c, 0xb3, 0xba, 0xc8, 0x5c, 0x68, 0x01, 0x9f, 0x6d, 0xd2, 0x2c, 0x09, 0xbc, 0x0c, 0xa7, 0xe8,
+	0x4c, 0xf1, 0xba, 0xbc, 0x43, 0xae, 0xa6, 0xa7, 0xd0, 0x7b, 0x4f, 0x42, 0xbc, 0x27, 0xbf, 0xb2,
+	0x05, 0xe7, 0x97, 0x34, 0xf7, 0x52, 0x4f, 0x37, 0x1e, 0xc0, 0x5e, 0x96, 0x08, 0x77, 0x4a, 0xca,
+	0xf3, 0x26, 0xb4, 0xb2, 0x83, 0x90, 0xde, 0x65, 0x2a, 0x4b, 0xdf, 0x65, 0x9a, 0xd9, 0x01, 0xae,
+	0x52, 0x49, 0x1d, 0xab, 0xf4, 0x42, 0xa2, 0x21, 0xec, 0x28, 0x0c, 0xa6, 0x41, 0xa6, 0x73, 0x82,
+	0x14, 0x30, 0xec, 0x40, 0x9b, 0x5a, 0xc0, 0x3e, 0x10, 0xf8, 0x4d, 0xec, 0x9e, 0x00, 0x00, 0xeb,
+	0x71, 0x14, 0xc8, 0x68, 0x2b, 0x0c, 0x87, 0xff, 0x59, 0x01, 0xd8, 0x73, 0xa7, 0xb1, 0xb2, 0x65,
+	0xf6, 0x03, 0xe8, 0xa4, 0x04, 0xa9, 0xfc, 0x11, 0x95, 0x0f, 0x56, 0x52, 0x96, 0x82, 0x55, 0x17,
+	0xd1, 0xe1, 0x70, 0x48, 0xf3, 0x32, 0xed, 0x1b, 0xaa, 0x05, 0x7a, 0xa1, 0xab, 0xea, 0x7d, 0x83,
+	0x50, 0xf4, 0x38, 0x77, 0x03, 0xfa, 0x9a, 0x21, 0x16, 0x89, 0x27, 0x22, 0x35, 0xec, 0x0a, 0xef,
+	0x29, 0xec, 0xae, 0x42, 0xb2, 0xb7, 0x73, 0x36, 0x4f, 0x86, 0xb3, 0x69, 0x94, 0x2e, 0xd9, 0x5c,
+	0x75, 0x95, 0x6d, 0xc5, 0x30, 0xdc, 0x34, 0x53, 0xa1, 0x81, 0x58, 0x50, 0xc7, 0xfe, 0x06, 0xe7,
+	0x58, 0x07, 0x5a, 0xba, 0xd5, 0x41, 0x85, 0xf5, 0xa0, 0x4d, 0xb9, 0x2c, 0x44, 0xab, 0x0e, 0xff,
+	0x7a, 0x00, 0x9d, 0x51, 0x94, 0x66, 0xc9, 0x4c, 0x39, 0xb2, 0x22, 0x65, 0xa3, 0x41, 0x29, 0x1b,
+	0xfa, 0x25, 0x4c, 0x4d, 0x03, 0x8b, 0xec, 0x0d, 0xa8, 0xbb, 0x51, 0x16, 0xe8, 0x68, 0xae, 0x94,
+	0xfe, 0x63, 0x0e, 0x57, 0x9c, 0xe8, 0xec, 0x16, 0xb4, 0x74, 0xae, 0x90, 0xde, 0x0b, 0x96, 0x26,
+	0x1a, 0x19, 0x1e, 0xb6, 0x01, 0x96, 0xaf, 0x93, 0x98, 0xec, 0xc6, 0x62, 0xd3, 0x26, 0xbd, 0x89,
+	0xe7, 0x3c, 0xf8, 0x64, 0xea, 0x4e, 0x26, 0x76, 0xd3, 0x3c, 0x99, 0x1a, 0x56, 0xca, 0x09, 0xe1,
+	0x48, 0x63, 0xb7, 0x75, 0x68, 0x82, 0x7b, 0x8b, 0x6d, 0x2d, 0xb6, 0x69, 0x2e, 0xd6, 0x54, 0x88,
+	0x82, 0x25, 0xac, 0x90, 0x8a, 0x69, 0xa0, 0x2a, 0xb4, 0x17, 0x2b, 0x98, 0xc3, 0x09, 0xb7, 0x52,
+	0x5d, 0x62, 0xef, 0x42, 0x27, 0xa5, 0xe8, 0x58, 0x55, 0x01, 0xf3, 0xdc, 0x92, 0x57, 0xc9, 0x43,
+	0x67, 0x0e, 0x69, 0x5e, 0xc6, 0x7e, 0xa6, 0x6e, 0x72, 0xa4, 0x2a, 0x75, 0x16, 0xfb, 0x31, 0xa1,
+	0x1b, 0xb7, 0xa6, 0xba, 0x84, 0xef, 0x57, 0xc4, 0xdb, 0x35, 0xf6, 0x61, 0x78, 0xd5, 0x7a, 0x23,
+	0x8d, 0x7d, 0x1b, 0x5a, 0xb1, 0xda, 0xe3, 0xe9, 0x39, 0xb6, 0xb3, 0xb9, 0x5a, 0xb0, 0xe9, 0xcd,
+	0x9f, 0x1b, 0x0e, 0xf6, 0x7d, 0xe8, 0xab, 0xa7, 0xc3, 0xb1, 0xde, 0xc1, 0xe8, 0x89, 0x76, 0x2e,
+	0x51, 0x65, 0x6e, 0x83, 0xe3, 0xbd, 0xac, 0x0c, 0xb2, 0xef, 0x41, 0x4f, 0x68, 0x07, 0xe3, 0xa4,
+	0x98, 0x0d, 0x35, 0xa0, 0xea, 0x97, 0x96, 0xfb, 0x1f, 0xde, 0x15, 0x25, 0x88, 0xad, 0x43, 0x53,
+	0x3d, 0x14, 0xd9, 0xab, 0x54, 0xab, 0x94, 0x4b, 0xa9, 0x9e, 0x11, 0xb8, 0xa6, 0xb3, 0x3b, 0x0b,
+	0x0f, 0x3c, 0xe8, 0x61, 0x18, 0xd5, 0xb1, 0x9f, 0xf7, 0x6a, 0x33, 0xf7, 0xf4, 0x83, 0x8f, 0x58,
+	0x9b, 0x00, 0xc5, 0xc3, 0x98, 0x7d, 0x7e, 0x51, 0x15, 0xf3, 0x57, 0x31, 0xde, 0xce, 0x1f, 0xc4,
+	0x30, 0x33, 0xaf, 0xfc, 0x50, 0xa7, 0xde, 0x3a, 0x2e, 0x50, 0xd5, 0x57, 0x96, 0x54, 0x55, 0x4f,
+	0x1e, 0x7c, 0x25, 0x9e, 0x47, 0xb0, 0xb7, 0xc0, 0x92, 0x98, 0x17, 0xe5, 0x1c, 0x9c, 0xd8, 0x17,
+	0xc9, 0x7a, 0x57, 0xf5, 0xdb, 0xbe, 0xca, 0xb4, 0xa2, 0x20, 0xa3, 0x25, 0x15, 0xc0, 0x6e, 0x61,
+	0x8a, 0x8f, 0xc4, 0x47, 0x7f, 0xb5, 0x8f, 0x5c, 0x3a, 0x9d, 0xa1, 0xa5, 0xe9, 0xb4, 0xad, 0x14,
+	0xfb, 0xc4, 0xe5, 0xe7, 0xee, 0x13, 0x6b, 0xc6, 0x33, 0xda, 0xa7, 0x58, 0x14, 0x01, 0x5b, 0xd1,
+	0x3e, 0xf5, 0x95, 0xd3, 0xad, 0x28, 0x0a, 0xa6, 0x5c, 0x04, 0xe9, 0xfd, 0x20, 0x49, 0x33, 0xfb,
+	0x8a, 0xca, 0x6c, 0xd3, 0x20, 0x7a, 0xe4, 0x20, 0x7d, 0xe0, 0xa6, 0x99, 0xfd, 0xaa, 0xc9, 0xa5,
+	0x43, 0x08, 0xd7, 0x5c, 0xc5, 0xfa, 0xa4, 0xb5, 0x57, 0x17, 0xd7, 0x3c, 0xbf, 0x20, 0xd5, 0x41,
+	0x3f, 0x16, 0xd9, 0x07, 0xb0, 0xa2, 0xea, 0x14, 0x26, 0xf8, 0xda, 0xa2, 0x4e, 0xce, 0xdd, 0xb4,
+	0xf1, 0x5e, 0x52, 0x06, 0x8b, 0x06, 0xd0, 0xfd, 0xa8, 0x06, 0xae, 0x2d, 0x6d, 0x20, 0x77, 0x54,
+	0xbd, 0xa4, 0x0c, 0xb2, 0x9b, 0xd0, 0xf4, 0x55, 0x4a, 0xca, 0xf5, 0x53, 0x0e, 0x48, 0xa7, 0x4c,
+	0x70, 0xcd, 0xc1, 0xbe, 0x05, 0x2d, 0x7a, 0x8e, 0x96, 0xb1, 0xbd, 0xb6, 0xa8, 0xc4, 0xea, 0x19,
+	0x99, 0x37, 0x43, 0xfa, 0xa2, 0x61, 0x9a, 0xd8, 0xfd, 0x1b, 0x8b, 0x86, 0xa9, 0x63, 0x78, 0x6e,
+	0x38, 0xd8, 0x0d, 0x68, 0x4c, 0xd1, 0x3d, 0xdb, 0xc3, 0x45, 0xc7, 0xa6, 0xbc, 0xb6, 0xa2, 0x92,
+	0xe3, 0xa1, 0x1d, 0x54, 0x59, 0xdf, 0x37, 0x4f, 0x39, 0x9e, 0x7c, 0x7b, 0xe5, 0x90, 0xe6, 0x65,
+	0xf6, 0x3b, 0x70, 0xa5, 0xfc, 0x48, 0x6c, 0x5e, 0x90, 0x75, 0x68, 0xf4, 0x3a, 0xb5, 0xf2, 0x8d,
+	0x25, 0x0a, 0x3e, 0xff, 0xd6, 0xcc, 0x2f, 0xc7, 0xcb, 0x09, 0x34, 0x2c, 0xb5, 0x69, 0xa1, 0x5f,
+	0xb1, 0x6f, 0x9c, 0x1a, 0x56, 0xbe, 0x7d, 0x9a, 0x2d, 0x11, 0xcb, 0xec, 0x3d, 0xe8, 0x8e, 0xf1,
+	0x51, 0x53, 0x47, 0xe8, 0xf6, 0x1b, 0x6b, 0x95, 0xf9, 0x30, 0xb0, 0xf4, 0xe4, 0xc9, 0x3b, 0xe3,
+	0x02, 0xc0, 0xec, 0x49, 0x2f, 0x72, 0x5c, 0xdf, 0x4f, 0xec, 0x37, 0xd5, 0x93, 0xa7, 0x17, 0x6d,
+	0xf9, 0x3e, 0xbd, 0x1d, 0xcb, 0x58, 0x50, 0xb6, 0x22, 0xa6, 0x47, 0xac, 0xab, 0x6d, 0xd8, 0xa0,
+	0x46, 0x3e, 0x32, 0x60, 0x2c, 0x1d, 0x86, 0x02, 0xf3, 0x0f, 0xec, 0x6f, 0x29, 0x06, 0x83, 0x1a,
+	0xf9, 0x98, 0x00, 0x33, 0x75, 0x8f, 0x1d, 0x83, 0xb1, 0x6f, 0x12, 0x47, 0x67, 0xea, 0x1e, 0xef,
+	0x6a, 0x14, 0xaa, 0xb9, 0xca, 0xf2, 0x21, 0x65, 0xfb, 0xf6, 0xa2, 0x9a, 0xe7, 0x87, 0x18, 0xde,
+	0x0e, 0x4c, 0x51, 0xb9, 0x23, 0x72, 0xc2, 0x4e, 0xb8, 0x69, 0xbf, 0x75, 0xda, 0x1d, 0xe9, 0x63,
+	0x1a, 0xba, 0x23, 0x5d, 0xc4, 0x3a, 0xca, 0x5b, 0x93, 0xb0, 0x6f, 0x2d, 0xd6, 0xc9, 0xc3, 0x1c,
+	0xde, 0xce, 0x4c, 0x11, 0xeb, 0x50, 0xc0, 0xa5, 0xea, 0x6c, 0x2c, 0xd6, 0xc9, 0xa3, 0x21, 0xde,
+	0x7e, 0x6a, 0x8a, 0xb8, 0x2f, 0xcd, 0xa2, 0x40, 0x46, 0x8e, 0x1b, 0x86, 0xf6, 0xed, 0x45, 0x1b,
+	0x30, 0x31, 0x13, 0xb7, 0x66, 0xba, 0x34, 0x7c, 0x17, 0xba, 0x5b, 0x94, 0x93, 0x1e, 0xa4, 0xe4,
+	0x93, 0x6e, 0x40, 0x3d, 0x3f, 0x56, 0xe6, 0xce, 0x8e, 0x38, 0x3e, 0x11, 0x98, 0xd7, 0xce, 0x89,
+	0x3c, 0xfc, 0xf3, 0x1a, 0x34, 0xf7, 0xe4, 0x2c, 0xf1, 0xc4, 0x8b, 0xf3, 0x76, 0x5e, 0x33, 0x73,
+	0x8f, 0x8a, 0x77, 0x6d, 0x35, 0x4d, 0x22, 0x97, 0x4f, 0xac, 0x35, 0x0a, 0xbc, 0xf3, 0x13, 0x6b,
+	0x9e, 0x96, 0xa1, 0xd2, 0x57, 0x15, 0x40, 0x72, 0x9f, 0xa5, 0x87, 0xbe, 0x7c, 0x86, 0xa9, 0x79,
+	0x14, 0x6a, 0xd4, 0x39, 0x18, 0xd4, 0xc8, 0xa7, 0xe4, 0x3d, 0xc3, 0x40, 0x8a, 0xa5, 0xa2, 0xfd,
+	0xae, 0x41, 0x92, 0x7a, 0x99, 0x53, 0x6e, 0xeb, 0x39, 0xa7, 0xdc, 0x9b, 0x90, 0x27, 0x13, 0xd9,
+	0xd6, 0xd2, 0xa0, 0x36, 0xa7, 0xb3, 0x4d, 0x68, 0xe7, 0x7f, 0x2c, 0xe8, 0xa8, 0xe3, 0xc2, 0x46,
+	0x8e, 0xd9, 0xd8, 0x37, 0x25, 0x5e, 0xb0, 0x2d, 0x39, 0xd5, 0xc6, 0x89, 0x3c, 0xd0, 0x07, 0x10,
+	0x78, 0x99, 0x53, 0xed, 0x2e, 0xd6, 0x33, 0x87, 0xff, 0x20, 0xc5, 0xdb, 0x99, 0x34, 0xd3, 0x91,
+	0x7f, 0x2b, 0x48, 0xb7, 0x11, 0x1c, 0xfe, 0x36, 0x58, 0x98, 0xd8, 0x8d, 0x22, 0xc4, 0xd3, 0xe4,
+	0xd4, 0x8b, 0x67, 0x3a, 0x46, 0xa4, 0xb2, 0xfe, 0x21, 0x41, 0x09, 0x47, 0xff, 0x90, 0x40, 0x4b,
+	0x57, 0x23, 0x0c, 0x95, 0x55, 0x7e, 0xf4, 0x49, 0x28, 0x5d, 0x5f, 0x0b, 0xc4, 0x80, 0xc3, 0x9f,
+	0x57, 0x60, 0x75, 0x37, 0x91, 0x9e, 0x48, 0xd3, 0x07, 0xb8, 0x29, 0xb9, 0x14, 0x62, 0x30, 0xa8,
+	0xd3, 0xc1, 0x51, 0xa5, 0x0f, 0x53, 0x19, 0x95, 0x81, 0xb2, 0xe9, 0x8a, 0xd8, 0xba, 0xc6, 0xdb,
+	0x84, 0xa1, 0xd0, 0x3a, 0x27, 0x53, 0xc5, 0x5a, 0x89, 0x4c, 0x47, 0xce, 0x1b, 0xd0, 0x2f, 0xd2,
+	0xf3, 0xa8, 0x05, 0xfd, 0x7b, 0x40, 0x8e, 0xa5, 0x56, 0xae, 0x43, 0x27, 0x11, 0x2e, 0x6e, 0xdb,
+	0xd4, 0x4c, 0x83, 0x78, 0x40, 0xa1, 0xa8, 0x1d, 0xf4, 0x0c, 0x62, 0x2a, 0x93, 0x13, 0xe7, 0xc9,
+	0x4c, 0x66, 0x2e, 0x29, 0x48, 0x8d, 0x77, 0x14, 0xee, 0xc7, 0x88, 0x1a, 0x1e, 0xc2, 0x60, 0x37,
+	0x11, 0xb1, 0x9b, 0x08, 0x74, 0x16, 0x53, 0x5a, 0xb8, 0x4b, 0xd0, 0x0c, 0x45, 0x34, 0xc9, 0x0e,
+	0xf5, 0x94, 0x34, 0x94, 0xff, 0x21, 0x52, 0x2d, 0xfd, 0x21, 0x82, 0x0b, 0x98, 0x08, 0x57, 0xff,
+	0x48, 0x42, 0x65, 0xd4, 0xe7, 0x68, 0x16, 0xea, 0xf3, 0xae, 0xc5, 0x15, 0x30, 0xfc, 0xd7, 0x1a,
+	0x74, 0xf4, 0xe2, 0x51, 0x2f, 0x4a, 0x14, 0x95, 0x5c, 0x14, 0x03, 0xa8, 0xe1, 0x91, 0x55, 0xc9,
+	0x06, 0x8b, 0xec, 0x1d, 0xa8, 0x85, 0xc1, 0x54, 0xc7, 0xef, 0xaf, 0xce, 0xb9, 0x9e, 0x79, 0x11,
+	0xe8, 0x8b, 0x14, 0xe4, 0xc6, 0x13, 0xee, 0x2c, 0x0a, 0x8e, 0x1d, 0x54, 0x1c, 0xbd, 0x6c, 0xe8,
+	0x06, 0x8e, 0x51, 0x3b, 0x71, 0xdd, 0x5d, 0x8f, 0x12, 0x81, 0x8c, 0x49, 0xf5, 0x78, 0x5b, 0x63,
+	0x46, 0x3e, 0xfb, 0x2e, 0x58, 0x69, 0xe4, 0xc6, 0xe9, 0xa1, 0xcc, 0x74, 0xbc, 0xce, 0x36, 0xf0,
+	0x37, 0x9c, 0xed, 0x9d, 0xfd, 0xe3, 0x68, 0x4f, 0x53, 0x74, 0x67, 0x39, 0x27, 0xfb, 0x3e, 0x74,
+	0x53, 0x91, 0xa6, 0x2a, 0x95, 0x72, 0x2c, 0xed, 0xd6, 0xe2, 0xa6, 0xb0, 0xa7, 0xa8, 0x38, 0x6b,
+	0x5d, 0xb9, 0x93, 0x16, 0x28, 0xf6, 0x16, 0x30, 0x57, 0xfb, 0x26, 0x27, 0x92, 0xbe, 0x28, 0x9e,
+	0x19, 0x1b, 0x7c, 0x60, 0x28, 0xa8, 0xd5, 0xa4, 0xfc, 0xbf, 0x01, 0x7d, 0xd3, 0x5b, 0x28, 0x27,
+	0x93, 0xfc, 0xf4, 0xfd, 0xea, 0xa9, 0xfe, 0x1e, 0x10, 0xb9, 0xd4, 0x6b, 0x2f, 0x2d, 0x13, 0xd8,
+	0x87, 0xf8, 0x73, 0x0f, 0x89, 0xde, 0xd1, 0x57, 0x37, 0xea, 0x58, 0x70, 0x65, 0x6e, 0x5f, 0x9d,
+	0x53, 0x8d, 0x22, 0xab, 0xae, 0xc0, 0xa7, 0x68, 0x30, 0xb8, 0xda, 0x72, 0xa6, 0xcc, 0xb1, 0xc6,
+	0x0d, 0x38, 0xfc, 0x8f, 0x0a, 0x74, 0x4a, 0xb3, 0xa7, 0xff, 0x7f, 0x52, 0x91, 0x98, 0x0b, 0x1e,
+	0x2c, 0x23, 0xee, 0x50, 0xea, 0x5c, 0xfb, 0x36, 0xa7, 0x32, 0xe2, 0x12, 0x19, 0x0a, 0x63, 0x96,
+	0x58, 0x46, 0x77, 0xa7, 0x8f, 0x60, 0x2a, 0x59, 0x99, 0x84, 0x5b, 0xe7, 0xdd, 0x02, 0x39, 0xf2,
+	0x31, 0x31, 0x08, 0xd5, 0xf2, 0xc0, 0x4d, 0xcd, 0x95, 0x53, 0x0e, 0xe3, 0x30, 0x9f, 0x8a, 0x04,
+	0xc7, 0xa2, 0x3d, 0xa5, 0x01, 0x51, 0x67, 0xc8, 0x43, 0x7d, 0x22, 0x23, 0x95, 0x73, 0xd1, 0xe5,
+	0x16, 0x22, 0x3e, 0x96, 0x11, 0x55, 0xd3, 0x1a, 0x42, 0x0e, 0xb2, 0xcd, 0x0d, 0x88, 0x7e, 0xe8,
+	0xc9, 0x4c, 0x60, 0x54, 0xe2, 0x53, 0x52, 0x7a, 0x9b, 0xb7, 0x08, 0x1e, 0xf9, 0xc3, 0x7f, 0xac,
+	0xc0, 0xea, 0x29, 0x31, 0x60, 0x10, 0x80, 0x22, 0x30, 0x69, 0x90, 0x5d, 0xde, 0x44, 0x70, 0xe4,
+	0x13, 0x21, 0x9b, 0x92, 0x52, 0x56, 0x35, 0x21, 0x9b, 0xa2, 0x46, 0x5e, 0x84, 0x66, 0x76, 0x4c,
+	0xb3, 0x55, 0x06, 0xd6, 0xc8, 0x8e, 0x71, 0x9a, 0x5b, 0xd0, 0x0e, 0xe5, 0xc4, 0x09, 0xc5, 0x53,
+	0x11, 0xd2, 0x3a, 0xf4, 0x37, 0x5f, 0x3f, 0x43, 0xfe, 0x1b, 0x0f, 0xe4, 0xe4, 0x01, 0xf2, 0x72,
+	0x2b, 0xd4, 0xa5, 0xe1, 0x0f, 0xc1, 0x32, 0x58, 0xd6, 0x86, 0xc6, 0x5d, 0x71, 0x30, 0x9b, 0x0c,
+	0xce, 0xe1, 0x61, 0x1c, 0x6b, 0x0c, 0x2a, 0x58, 0xfa, 0xc8, 0x4d, 0xa2, 0x41, 0x15, 0xc9, 0xf7,
+	0x92, 0x44, 0x26, 0x83, 0x1a, 0x16, 0x77, 0xdd, 0x28, 0xf0, 0x06, 0x75, 0x2c, 0xde, 0x77, 0x33,
+	0x37, 0x1c, 0x34, 0x86, 0xbf, 0xdb, 0x04, 0x6b, 0x57, 0xf7, 0xce, 0xee, 0x42, 0xcf, 0x8c, 0xe4,
+	0x39, 0x77, 0x13, 0xbb, 0x8b, 0x05, 0xba, 0x9b, 0xe8, 0xc6, 0x25, 0x68, 0xf1, 0x27, 0xaf, 0xea,
+	0xa9, 0x9f, 0xbc, 0xae, 0x42, 0xed, 0x49, 0x72, 0x32, 0xff, 0x54, 0xb3, 0x1b, 0xba, 0x11, 0x47,
+	0x34, 0x3e, 0x7f, 0xa2, 0xdc, 0x9d, 0x94, 0x36, 0x6f, 0xbb, 0xbe, 0x18, 0xf9, 0xaa, 0x4d, 0x9d,
+	0x03, 0x32, 0xa9, 0x32, 0x9e, 0xeb, 0xbd, 0xc3, 0x20, 0xf4, 0x13, 0x11, 0xe9, 0xbb, 0x37, 0x76,
+	0x7a, 0xc8, 0x3c, 0xe7, 0x61, 0x3f, 0xa0, 0xec, 0x42, 0x73, 0x1f, 0x51, 0x7e, 0x04, 0xb8, 0x38,
+	0x77, 0x4c, 0x34, 0x1c, 0x7c, 0xa5, 0xc4, 0x4e, 0xa6, 0x5c, 0xa4, 0x25, 0xb7, 0xca, 0x69, 0xc9,
+	0xea, 0xc7, 0x9f, 0xfc, 0x2e, 0x80, 0x0e, 0x2b, 0x14, 0x91, 0x29, 0x02, 0x6d, 0x4c, 0xed, 0xfc,
+	0x14, 0x23, 0x5d, 0x4c, 0x64, 0xae, 0xa3, 0xe3, 0xd0, 0xf6, 0x5b, 0x1a, 0xb6, 0xd9, 0x0b, 0x39,
+	0xd1, 0xe9, 0x77, 0xbe, 0x59, 0x7a, 0xe8, 0xa8, 0x98, 0x02, 0x7d, 0x55, 0x47, 0xe7, 0xfb, 0xcf,
+	0xd2, 0xc3, 0xbb, 0x18, 0x55, 0xa0, 0x96, 0xde, 0x80, 0xbe, 0x99, 0xa4, 0x4e, 0x9a, 0x54, 0xd9,
+	0x04, 0x3d, 0x83, 0x55, 0x39, 0x93, 0x1b, 0x70, 0xde, 0x3b, 0x74, 0xa3, 0x48, 0x84, 0xce, 0xc1,
+	0x6c, 0x3c, 0x36, 0xbb, 0x50, 0x8f, 0xfc, 0xd6, 0xaa, 0x26, 0xdd, 0x21, 0x0a, 0x6d, 0x46, 0x43,
+	0xe8, 0x45, 0x41, 0xa8, 0x92, 0xcc, 0x1d, 0x2f, 0xca, 0xec, 0x3e, 0x71, 0x76, 0xa2, 0x20, 0xa4,
+	0xdc, 0x72, 0xfc, 0x9d, 0xed, 0x03, 0x18, 0xe0, 0x3f, 0x81, 0xa9, 0x93, 0x49, 0xf3, 0x33, 0x95,
+	0xbd, 0xb2, 0x56, 0x9b, 0x3f, 0xb0, 0x3f, 0x9e, 0x05, 0xfe, 0xbe, 0xd4, 0xbf, 0x53, 0xf5, 0x88,
+	0xdf, 0x80, 0x68, 0xc9, 0xea, 0xd6, 0x1c, 0x6b, 0x0e, 0x54, 0x66, 0xe0, 0x81, 0xc9, 0x0c, 0x5c,
+	0x78, 0x29, 0x59, 0x3d, 0xf5, 0x52, 0xf2, 0x01, 0x74, 0xcb, 0x2a, 0x89, 0x2a, 0x4e, 0x87, 0x99,
+	0xc1, 0x39, 0x06, 0xd0, 0xdc, 0x91, 0xc9, 0xd4, 0x0d, 0x07, 0x15, 0x2c, 0xab, 0x7f, 0x00, 0x06,
+	0x55, 0xd6, 0x05, 0xcb, 0x44, 0xd9, 0x83, 0xda, 0xf0, 0x7b, 0x60, 0x99, 0x7f, 0xcb, 0x70, 0x28,
+	0xe4, 0xcd, 0x29, 0xbe, 0x50, 0x0e, 0xcf, 0x42, 0x04, 0x85, 0x65, 0xe6, 0x47, 0xc8, 0x6a, 0xf1,
+	0x23, 0xe4, 0xf0, 0xc7, 0xd0, 0x2d, 0x4f, 0xcd, 0xdc, 0x68, 0x55, 0x8a, 0x1b, 0xad, 0x25, 0xb5,
+	0xb0, 0x9b, 0x71, 0x22, 0xa7, 0x4e, 0x29, 0x8c, 0xb1, 0x10, 0x81, 0xdd, 0xdc, 0x7c, 0x02, 0x4d,
+	0xf5, 0xd3, 0x27, 0x5b, 0x85, 0xde, 0xe3, 0xe8, 0x28, 0x92, 0xcf, 0x22, 0x85, 0x18, 0x9c, 0x63,
+	0xe7, 0x61, 0xc5, 0xcc, 0x56, 0xff, 0x5d, 0x3a, 0xa8, 0xb0, 0x01, 0x74, 0x49, 0x1a, 0x06, 0x53,
+	0x65, 0x57, 0xc1, 0xd6, 0xdb, 0xc0, 0x5d, 0x19, 0x89, 0x1d, 0x99, 0x05, 0xe3, 0x13, 0x43, 0xad,
+	0xb1, 0x15, 0xe8, 0xec, 0x65, 0x32, 0xde, 0x13, 0x91, 0x1f, 0x44, 0x93, 0x41, 0xfd, 0xe6, 0x7d,
+	0x68, 0xaa, 0x7f, 0x51, 0x4b, 0x5d, 0x2a, 0xc4, 0xe0, 0x1c, 0x72, 0x7f, 0xe4, 0x06, 0x59, 0x10,
+	0x4d, 0x76, 0xc4, 0x71, 0xa6, 0x9c, 0x0c, 0x9e, 0xc3, 0x07, 0x55, 0xd6, 0x07, 0xd0, 0xad, 0xde,
+	0x8b, 0xfc, 0x41, 0xed, 0xce, 0xf6, 0xcf, 0x3e, 0xbd, 0x56, 0xf9, 0xf9, 0xa7, 0xd7, 0x2a, 0xff,
+	0xf0, 0xe9, 0xb5, 0x73, 0x7f, 0xf4, 0x8b, 0x6b, 0x95, 0x8f, 0xdf, 0x2e, 0xfd, 0x69, 0x3b, 0x75,
+	0xb3, 0x24, 0x38, 0x56, 0x97, 0xd1, 0x06, 0x88, 0xc4, 0xed, 0xf8, 0x68, 0x72, 0x3b, 0x3e, 0xb8,
+	0x6d, 0x54, 0xe5, 0xa0, 0x49, 0x3f, 0xd0, 0xbe, 0xf3, 0xdf, 0x03, 0x00, 0x1e, 0x70, 0xc1, 0x20,
+	0xbf, 0x3b, 0x00, 0x00,
 }
 
 func (m *Message) Marshal() (dAtA []byte, err error) {
@@ -6016,10 +6043,10 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PartialResultTypes) > 0 {
-		dAtA11 := make([]byte, len(m.PartialResultTypes)*10)
+	if len(m.GroupingIds) > 0 {
+		dAtA11 := make([]byte, len(m.GroupingIds)*10)
 		var j10 int
-		for _, num := range m.PartialResultTypes {
+		for _, num := range m.GroupingIds {
 			for num >= 1<<7 {
 				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
@@ -6032,6 +6059,24 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], dAtA11[:j10])
 		i = encodeVarintPipeline(dAtA, i, uint64(j10))
 		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PartialResultTypes) > 0 {
+		dAtA13 := make([]byte, len(m.PartialResultTypes)*10)
+		var j12 int
+		for _, num := range m.PartialResultTypes {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintPipeline(dAtA, i, uint64(j12))
+		i--
 		dAtA[i] = 0x4a
 	}
 	if len(m.PartialResults) > 0 {
@@ -6186,20 +6231,20 @@ func (m *Insert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA14 := make([]byte, len(m.PartitionTableIds)*10)
-		var j13 int
+		dAtA16 := make([]byte, len(m.PartitionTableIds)*10)
+		var j15 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j15++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintPipeline(dAtA, i, uint64(j13))
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintPipeline(dAtA, i, uint64(j15))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -6277,21 +6322,21 @@ func (m *Array) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Array) > 0 {
-		dAtA17 := make([]byte, len(m.Array)*10)
-		var j16 int
+		dAtA19 := make([]byte, len(m.Array)*10)
+		var j18 int
 		for _, num1 := range m.Array {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j16++
+				j18++
 			}
-			dAtA17[j16] = uint8(num)
-			j16++
+			dAtA19[j18] = uint8(num)
+			j18++
 		}
-		i -= j16
-		copy(dAtA[i:], dAtA17[:j16])
-		i = encodeVarintPipeline(dAtA, i, uint64(j16))
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintPipeline(dAtA, i, uint64(j18))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -6445,20 +6490,20 @@ func (m *Deletion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA20 := make([]byte, len(m.PartitionTableIds)*10)
-		var j19 int
+		dAtA22 := make([]byte, len(m.PartitionTableIds)*10)
+		var j21 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j21++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA22[j21] = uint8(num)
+			j21++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintPipeline(dAtA, i, uint64(j19))
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintPipeline(dAtA, i, uint64(j21))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -6554,21 +6599,21 @@ func (m *PreInsert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Idx) > 0 {
-		dAtA22 := make([]byte, len(m.Idx)*10)
-		var j21 int
+		dAtA24 := make([]byte, len(m.Idx)*10)
+		var j23 int
 		for _, num1 := range m.Idx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j21++
+				j23++
 			}
-			dAtA22[j21] = uint8(num)
-			j21++
+			dAtA24[j23] = uint8(num)
+			j23++
 		}
-		i -= j21
-		copy(dAtA[i:], dAtA22[:j21])
-		i = encodeVarintPipeline(dAtA, i, uint64(j21))
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintPipeline(dAtA, i, uint64(j23))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -6866,21 +6911,21 @@ func (m *OnDuplicateKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA29 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j28 int
+		dAtA31 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j30 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j28++
+				j30++
 			}
-			dAtA29[j28] = uint8(num)
-			j28++
+			dAtA31[j30] = uint8(num)
+			j30++
 		}
-		i -= j28
-		copy(dAtA[i:], dAtA29[:j28])
-		i = encodeVarintPipeline(dAtA, i, uint64(j28))
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintPipeline(dAtA, i, uint64(j30))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -7112,40 +7157,40 @@ func (m *Join) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ColList) > 0 {
-		dAtA33 := make([]byte, len(m.ColList)*10)
-		var j32 int
+		dAtA35 := make([]byte, len(m.ColList)*10)
+		var j34 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j32++
+				j34++
 			}
-			dAtA33[j32] = uint8(num)
-			j32++
+			dAtA35[j34] = uint8(num)
+			j34++
 		}
-		i -= j32
-		copy(dAtA[i:], dAtA33[:j32])
-		i = encodeVarintPipeline(dAtA, i, uint64(j32))
+		i -= j34
+		copy(dAtA[i:], dAtA35[:j34])
+		i = encodeVarintPipeline(dAtA, i, uint64(j34))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA35 := make([]byte, len(m.RelList)*10)
-		var j34 int
+		dAtA37 := make([]byte, len(m.RelList)*10)
+		var j36 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA35[j34] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA37[j36] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j34++
+				j36++
 			}
-			dAtA35[j34] = uint8(num)
-			j34++
+			dAtA37[j36] = uint8(num)
+			j36++
 		}
-		i -= j34
-		copy(dAtA[i:], dAtA35[:j34])
-		i = encodeVarintPipeline(dAtA, i, uint64(j34))
+		i -= j36
+		copy(dAtA[i:], dAtA37[:j36])
+		i = encodeVarintPipeline(dAtA, i, uint64(j36))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -7275,21 +7320,21 @@ func (m *AntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA38 := make([]byte, len(m.Result)*10)
-		var j37 int
+		dAtA40 := make([]byte, len(m.Result)*10)
+		var j39 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA38[j37] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA40[j39] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j37++
+				j39++
 			}
-			dAtA38[j37] = uint8(num)
-			j37++
+			dAtA40[j39] = uint8(num)
+			j39++
 		}
-		i -= j37
-		copy(dAtA[i:], dAtA38[:j37])
-		i = encodeVarintPipeline(dAtA, i, uint64(j37))
+		i -= j39
+		copy(dAtA[i:], dAtA40[:j39])
+		i = encodeVarintPipeline(dAtA, i, uint64(j39))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -7419,40 +7464,40 @@ func (m *LeftJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ColList) > 0 {
-		dAtA41 := make([]byte, len(m.ColList)*10)
-		var j40 int
+		dAtA43 := make([]byte, len(m.ColList)*10)
+		var j42 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j40++
+				j42++
 			}
-			dAtA41[j40] = uint8(num)
-			j40++
+			dAtA43[j42] = uint8(num)
+			j42++
 		}
-		i -= j40
-		copy(dAtA[i:], dAtA41[:j40])
-		i = encodeVarintPipeline(dAtA, i, uint64(j40))
+		i -= j42
+		copy(dAtA[i:], dAtA43[:j42])
+		i = encodeVarintPipeline(dAtA, i, uint64(j42))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA43 := make([]byte, len(m.RelList)*10)
-		var j42 int
+		dAtA45 := make([]byte, len(m.RelList)*10)
+		var j44 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j42++
+				j44++
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
+			dAtA45[j44] = uint8(num)
+			j44++
 		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintPipeline(dAtA, i, uint64(j42))
+		i -= j44
+		copy(dAtA[i:], dAtA45[:j44])
+		i = encodeVarintPipeline(dAtA, i, uint64(j44))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -7596,40 +7641,40 @@ func (m *RightJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ColList) > 0 {
-		dAtA46 := make([]byte, len(m.ColList)*10)
-		var j45 int
+		dAtA48 := make([]byte, len(m.ColList)*10)
+		var j47 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j47++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA48[j47] = uint8(num)
+			j47++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintPipeline(dAtA, i, uint64(j45))
+		i -= j47
+		copy(dAtA[i:], dAtA48[:j47])
+		i = encodeVarintPipeline(dAtA, i, uint64(j47))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA48 := make([]byte, len(m.RelList)*10)
-		var j47 int
+		dAtA50 := make([]byte, len(m.RelList)*10)
+		var j49 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA48[j47] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA50[j49] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j47++
+				j49++
 			}
-			dAtA48[j47] = uint8(num)
-			j47++
+			dAtA50[j49] = uint8(num)
+			j49++
 		}
-		i -= j47
-		copy(dAtA[i:], dAtA48[:j47])
-		i = encodeVarintPipeline(dAtA, i, uint64(j47))
+		i -= j49
+		copy(dAtA[i:], dAtA50[:j49])
+		i = encodeVarintPipeline(dAtA, i, uint64(j49))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -7759,21 +7804,21 @@ func (m *RightSemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA51 := make([]byte, len(m.Result)*10)
-		var j50 int
+		dAtA53 := make([]byte, len(m.Result)*10)
+		var j52 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA53[j52] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j50++
+				j52++
 			}
-			dAtA51[j50] = uint8(num)
-			j50++
+			dAtA53[j52] = uint8(num)
+			j52++
 		}
-		i -= j50
-		copy(dAtA[i:], dAtA51[:j50])
-		i = encodeVarintPipeline(dAtA, i, uint64(j50))
+		i -= j52
+		copy(dAtA[i:], dAtA53[:j52])
+		i = encodeVarintPipeline(dAtA, i, uint64(j52))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -7903,21 +7948,21 @@ func (m *RightAntiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA54 := make([]byte, len(m.Result)*10)
-		var j53 int
+		dAtA56 := make([]byte, len(m.Result)*10)
+		var j55 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j53++
+				j55++
 			}
-			dAtA54[j53] = uint8(num)
-			j53++
+			dAtA56[j55] = uint8(num)
+			j55++
 		}
-		i -= j53
-		copy(dAtA[i:], dAtA54[:j53])
-		i = encodeVarintPipeline(dAtA, i, uint64(j53))
+		i -= j55
+		copy(dAtA[i:], dAtA56[:j55])
+		i = encodeVarintPipeline(dAtA, i, uint64(j55))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8047,21 +8092,21 @@ func (m *SemiJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA57 := make([]byte, len(m.Result)*10)
-		var j56 int
+		dAtA59 := make([]byte, len(m.Result)*10)
+		var j58 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA59[j58] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j56++
+				j58++
 			}
-			dAtA57[j56] = uint8(num)
-			j56++
+			dAtA59[j58] = uint8(num)
+			j58++
 		}
-		i -= j56
-		copy(dAtA[i:], dAtA57[:j56])
-		i = encodeVarintPipeline(dAtA, i, uint64(j56))
+		i -= j58
+		copy(dAtA[i:], dAtA59[:j58])
+		i = encodeVarintPipeline(dAtA, i, uint64(j58))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8191,40 +8236,40 @@ func (m *SingleJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ColList) > 0 {
-		dAtA60 := make([]byte, len(m.ColList)*10)
-		var j59 int
+		dAtA62 := make([]byte, len(m.ColList)*10)
+		var j61 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j59++
+				j61++
 			}
-			dAtA60[j59] = uint8(num)
-			j59++
+			dAtA62[j61] = uint8(num)
+			j61++
 		}
-		i -= j59
-		copy(dAtA[i:], dAtA60[:j59])
-		i = encodeVarintPipeline(dAtA, i, uint64(j59))
+		i -= j61
+		copy(dAtA[i:], dAtA62[:j61])
+		i = encodeVarintPipeline(dAtA, i, uint64(j61))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA62 := make([]byte, len(m.RelList)*10)
-		var j61 int
+		dAtA64 := make([]byte, len(m.RelList)*10)
+		var j63 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA64[j63] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j61++
+				j63++
 			}
-			dAtA62[j61] = uint8(num)
-			j61++
+			dAtA64[j63] = uint8(num)
+			j63++
 		}
-		i -= j61
-		copy(dAtA[i:], dAtA62[:j61])
-		i = encodeVarintPipeline(dAtA, i, uint64(j61))
+		i -= j63
+		copy(dAtA[i:], dAtA64[:j63])
+		i = encodeVarintPipeline(dAtA, i, uint64(j63))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8354,21 +8399,21 @@ func (m *MarkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.Result) > 0 {
-		dAtA65 := make([]byte, len(m.Result)*10)
-		var j64 int
+		dAtA67 := make([]byte, len(m.Result)*10)
+		var j66 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA65[j64] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j64++
+				j66++
 			}
-			dAtA65[j64] = uint8(num)
-			j64++
+			dAtA67[j66] = uint8(num)
+			j66++
 		}
-		i -= j64
-		copy(dAtA[i:], dAtA65[:j64])
-		i = encodeVarintPipeline(dAtA, i, uint64(j64))
+		i -= j66
+		copy(dAtA[i:], dAtA67[:j66])
+		i = encodeVarintPipeline(dAtA, i, uint64(j66))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8429,40 +8474,40 @@ func (m *Product) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ColList) > 0 {
-		dAtA67 := make([]byte, len(m.ColList)*10)
-		var j66 int
+		dAtA69 := make([]byte, len(m.ColList)*10)
+		var j68 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA69[j68] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j66++
+				j68++
 			}
-			dAtA67[j66] = uint8(num)
-			j66++
+			dAtA69[j68] = uint8(num)
+			j68++
 		}
-		i -= j66
-		copy(dAtA[i:], dAtA67[:j66])
-		i = encodeVarintPipeline(dAtA, i, uint64(j66))
+		i -= j68
+		copy(dAtA[i:], dAtA69[:j68])
+		i = encodeVarintPipeline(dAtA, i, uint64(j68))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA69 := make([]byte, len(m.RelList)*10)
-		var j68 int
+		dAtA71 := make([]byte, len(m.RelList)*10)
+		var j70 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA69[j68] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA71[j70] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j68++
+				j70++
 			}
-			dAtA69[j68] = uint8(num)
-			j68++
+			dAtA71[j70] = uint8(num)
+			j70++
 		}
-		i -= j68
-		copy(dAtA[i:], dAtA69[:j68])
-		i = encodeVarintPipeline(dAtA, i, uint64(j68))
+		i -= j70
+		copy(dAtA[i:], dAtA71[:j70])
+		i = encodeVarintPipeline(dAtA, i, uint64(j70))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8525,40 +8570,40 @@ func (m *ProductL2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ColList) > 0 {
-		dAtA72 := make([]byte, len(m.ColList)*10)
-		var j71 int
+		dAtA74 := make([]byte, len(m.ColList)*10)
+		var j73 int
 		for _, num1 := range m.ColList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA72[j71] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA74[j73] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j71++
+				j73++
 			}
-			dAtA72[j71] = uint8(num)
-			j71++
+			dAtA74[j73] = uint8(num)
+			j73++
 		}
-		i -= j71
-		copy(dAtA[i:], dAtA72[:j71])
-		i = encodeVarintPipeline(dAtA, i, uint64(j71))
+		i -= j73
+		copy(dAtA[i:], dAtA74[:j73])
+		i = encodeVarintPipeline(dAtA, i, uint64(j73))
 		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.RelList) > 0 {
-		dAtA74 := make([]byte, len(m.RelList)*10)
-		var j73 int
+		dAtA76 := make([]byte, len(m.RelList)*10)
+		var j75 int
 		for _, num1 := range m.RelList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA74[j73] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j73++
+				j75++
 			}
-			dAtA74[j73] = uint8(num)
-			j73++
+			dAtA76[j75] = uint8(num)
+			j75++
 		}
-		i -= j73
-		copy(dAtA[i:], dAtA74[:j73])
-		i = encodeVarintPipeline(dAtA, i, uint64(j73))
+		i -= j75
+		copy(dAtA[i:], dAtA76[:j75])
+		i = encodeVarintPipeline(dAtA, i, uint64(j75))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8618,21 +8663,21 @@ func (m *IndexJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Result) > 0 {
-		dAtA76 := make([]byte, len(m.Result)*10)
-		var j75 int
+		dAtA78 := make([]byte, len(m.Result)*10)
+		var j77 int
 		for _, num1 := range m.Result {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j75++
+				j77++
 			}
-			dAtA76[j75] = uint8(num)
-			j75++
+			dAtA78[j77] = uint8(num)
+			j77++
 		}
-		i -= j75
-		copy(dAtA[i:], dAtA76[:j75])
-		i = encodeVarintPipeline(dAtA, i, uint64(j75))
+		i -= j77
+		copy(dAtA[i:], dAtA78[:j77])
+		i = encodeVarintPipeline(dAtA, i, uint64(j77))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8781,21 +8826,21 @@ func (m *FileOffset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Offset) > 0 {
-		dAtA78 := make([]byte, len(m.Offset)*10)
-		var j77 int
+		dAtA80 := make([]byte, len(m.Offset)*10)
+		var j79 int
 		for _, num1 := range m.Offset {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j77++
+				j79++
 			}
-			dAtA78[j77] = uint8(num)
-			j77++
+			dAtA80[j79] = uint8(num)
+			j79++
 		}
-		i -= j77
-		copy(dAtA[i:], dAtA78[:j77])
-		i = encodeVarintPipeline(dAtA, i, uint64(j77))
+		i -= j79
+		copy(dAtA[i:], dAtA80[:j79])
+		i = encodeVarintPipeline(dAtA, i, uint64(j79))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -8938,21 +8983,21 @@ func (m *ExternalScan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.FileSize) > 0 {
-		dAtA81 := make([]byte, len(m.FileSize)*10)
-		var j80 int
+		dAtA83 := make([]byte, len(m.FileSize)*10)
+		var j82 int
 		for _, num1 := range m.FileSize {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA81[j80] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j80++
+				j82++
 			}
-			dAtA81[j80] = uint8(num)
-			j80++
+			dAtA83[j82] = uint8(num)
+			j82++
 		}
-		i -= j80
-		copy(dAtA[i:], dAtA81[:j80])
-		i = encodeVarintPipeline(dAtA, i, uint64(j80))
+		i -= j82
+		copy(dAtA[i:], dAtA83[:j82])
+		i = encodeVarintPipeline(dAtA, i, uint64(j82))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9993,6 +10038,11 @@ func (m *ProcessLimitation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MemoryQuota != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.MemoryQuota))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.ReaderSize != 0 {
 		i = encodeVarintPipeline(dAtA, i, uint64(m.ReaderSize))
 		i--
@@ -10104,6 +10154,11 @@ func (m *ProcessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Timeout != 0 {
+		i = encodeVarintPipeline(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size, err := m.PrepareParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10125,21 +10180,21 @@ func (m *ProcessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x4a
 	if len(m.AnalysisNodeList) > 0 {
-		dAtA124 := make([]byte, len(m.AnalysisNodeList)*10)
-		var j123 int
+		dAtA126 := make([]byte, len(m.AnalysisNodeList)*10)
+		var j125 int
 		for _, num1 := range m.AnalysisNodeList {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA124[j123] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA126[j125] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j123++
+				j125++
 			}
-			dAtA124[j123] = uint8(num)
-			j123++
+			dAtA126[j125] = uint8(num)
+			j125++
 		}
-		i -= j123
-		copy(dAtA[i:], dAtA124[:j123])
-		i = encodeVarintPipeline(dAtA, i, uint64(j123))
+		i -= j125
+		copy(dAtA[i:], dAtA126[:j125])
+		i = encodeVarintPipeline(dAtA, i, uint64(j125))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -10396,40 +10451,40 @@ func (m *Pipeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.NilBatchCnt) > 0 {
-		dAtA129 := make([]byte, len(m.NilBatchCnt)*10)
-		var j128 int
+		dAtA131 := make([]byte, len(m.NilBatchCnt)*10)
+		var j130 int
 		for _, num1 := range m.NilBatchCnt {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA129[j128] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA131[j130] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j128++
+				j130++
 			}
-			dAtA129[j128] = uint8(num)
-			j128++
+			dAtA131[j130] = uint8(num)
+			j130++
 		}
-		i -= j128
-		copy(dAtA[i:], dAtA129[:j128])
-		i = encodeVarintPipeline(dAtA, i, uint64(j128))
+		i -= j130
+		copy(dAtA[i:], dAtA131[:j130])
+		i = encodeVarintPipeline(dAtA, i, uint64(j130))
 		i--
 		dAtA[i] = 0x72
 	}
 	if len(m.ChannelBufferSize) > 0 {
-		dAtA131 := make([]byte, len(m.ChannelBufferSize)*10)
-		var j130 int
+		dAtA133 := make([]byte, len(m.ChannelBufferSize)*10)
+		var j132 int
 		for _, num1 := range m.ChannelBufferSize {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA131[j130] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA133[j132] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j130++
+				j132++
 			}
-			dAtA131[j130] = uint8(num)
-			j130++
+			dAtA133[j132] = uint8(num)
+			j132++
 		}
-		i -= j130
-		copy(dAtA[i:], dAtA131[:j130])
-		i = encodeVarintPipeline(dAtA, i, uint64(j130))
+		i -= j132
+		copy(dAtA[i:], dAtA133[:j132])
+		i = encodeVarintPipeline(dAtA, i, uint64(j132))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -10941,6 +10996,13 @@ func (m *Group) ProtoSize() (n int) {
 		}
 		n += 1 + sovPipeline(uint64(l)) + l
 	}
+	if len(m.GroupingIds) > 0 {
+		l = 0
+		for _, e := range m.GroupingIds {
+			l += sovPipeline(uint64(e))
+		}
+		n += 1 + sovPipeline(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12541,6 +12603,9 @@ func (m *ProcessLimitation) ProtoSize() (n int) {
 	if m.ReaderSize != 0 {
 		n += 1 + sovPipeline(uint64(m.ReaderSize))
 	}
+	if m.MemoryQuota != 0 {
+		n += 1 + sovPipeline(uint64(m.MemoryQuota))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12610,6 +12675,9 @@ func (m *ProcessInfo) ProtoSize() (n int) {
 	n += 1 + l + sovPipeline(uint64(l))
 	l = m.PrepareParams.ProtoSize()
 	n += 1 + l + sovPipeline(uint64(l))
+	if m.Timeout != 0 {
+		n += 1 + sovPipeline(uint64(m.Timeout))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14705,6 +14773,82 @@ func (m *Group) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PartialResultTypes", wireType)
 			}
+		case 10:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GroupingIds = append(m.GroupingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPipeline
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPipeline
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GroupingIds) == 0 {
+					m.GroupingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPipeline
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GroupingIds = append(m.GroupingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupingIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -25417,6 +25561,25 @@ func (m *ProcessLimitation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryQuota", wireType)
+			}
+			m.MemoryQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryQuota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -26019,6 +26182,25 @@ func (m *ProcessInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPipeline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPipeline(dAtA[iNdEx:])
@@ -4530,7 +4530,6 @@ func (m *TableDef) GetDbName() string {
 // XXX: Deprecated and to be removed soon.
 type TableDef_DefType struct {
 	// Types that are valid to be assigned to Def:
-	//
 	//	*TableDef_DefType_Properties
 	Def                  isTableDef_DefType_Def `protobuf_oneof:"def"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -4792,18 +4791,18 @@ func (m *HashMapStats) GetRanges() []float64 {
 }
 
 type Stats struct {
-	// for scan, number of blocks to read from S3
+	//for scan, number of blocks to read from S3
 	BlockNum int32 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
-	// for scan, cost of reading from S3, basically the read lines
-	// for other nodes, it means the estimated cost of current node
+	//for scan, cost of reading from S3, basically the read lines
+	//for other nodes, it means the estimated cost of current node
 	Cost float64 `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
-	// number of output lines
+	//number of output lines
 	Outcnt float64 `protobuf:"fixed64,3,opt,name=outcnt,proto3" json:"outcnt,omitempty"`
 	// average size of one row, currently not used
 	Rowsize float64 `protobuf:"fixed64,4,opt,name=rowsize,proto3" json:"rowsize,omitempty"`
-	// for scan, this means total count of all table, before filtering
+	//for scan, this means total count of all table, before filtering
 	TableCnt float64 `protobuf:"fixed64,5,opt,name=table_cnt,json=tableCnt,proto3" json:"table_cnt,omitempty"`
-	// for scan, selectivity means outcnt divide total count
+	//for scan, selectivity means outcnt divide total count
 	Selectivity          float64       `protobuf:"fixed64,6,opt,name=selectivity,proto3" json:"selectivity,omitempty"`
 	ForceOneCN           bool          `protobuf:"varint,7,opt,name=forceOneCN,proto3" json:"forceOneCN,omitempty"`
 	HashmapStats         *HashMapStats `protobuf:"bytes,8,opt,name=hashmapStats,proto3" json:"hashmapStats,omitempty"`
@@ -5130,14 +5129,18 @@ func (m *OrderBySpec) GetFlag() OrderBySpec_OrderByFlag {
 }
 
 type WindowSpec struct {
-	WindowFunc           *Expr          `protobuf:"bytes,1,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
-	PartitionBy          []*Expr        `protobuf:"bytes,2,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
-	OrderBy              []*OrderBySpec `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Frame                *FrameClause   `protobuf:"bytes,4,opt,name=frame,proto3" json:"frame,omitempty"`
-	Name                 string         `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	WindowFunc  *Expr          `protobuf:"bytes,1,opt,name=window_func,json=windowFunc,proto3" json:"window_func,omitempty"`
+	PartitionBy []*Expr        `protobuf:"bytes,2,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
+	OrderBy     []*OrderBySpec `protobuf:"bytes,3,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Frame       *FrameClause   `protobuf:"bytes,4,opt,name=frame,proto3" json:"frame,omitempty"`
+	Name        string         `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// FROM LAST of NTH_VALUE
+	FromLast bool `protobuf:"varint,6,opt,name=from_last,json=fromLast,proto3" json:"from_last,omitempty"`
+	// IGNORE NULLS of the value functions
+	IgnoreNulls          bool     `protobuf:"varint,7,opt,name=ignore_nulls,json=ignoreNulls,proto3" json:"ignore_nulls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
@@ -5208,6 +5211,20 @@ func (m *WindowSpec) GetName() string {
 	return ""
 }
 
+func (m *WindowSpec) GetFromLast() bool {
+	if m != nil {
+		return m.FromLast
+	}
+	return false
+}
+
+func (m *WindowSpec) GetIgnoreNulls() bool {
+	if m != nil {
+		return m.IgnoreNulls
+	}
+	return false
+}
+
 type SampleFuncSpec struct {
 	Rows                 int32    `protobuf:"varint,1,opt,name=Rows,proto3" json:"Rows,omitempty"`
 	Percent              float64  `protobuf:"fixed64,2,opt,name=Percent,proto3" json:"Percent,omitempty"`
@@ -6075,13 +6092,16 @@ type Node struct {
 	OnUpdateExprs      []*Expr                     `protobuf:"bytes,53,rep,name=onUpdateExprs,proto3" json:"onUpdateExprs,omitempty"`
 	Fuzzymessage       *OriginTableMessageForFuzzy `protobuf:"bytes,54,opt,name=fuzzymessage,proto3" json:"fuzzymessage,omitempty"`
 	IfInsertFromUnique bool                        `protobuf:"varint,55,opt,name=ifInsertFromUnique,proto3" json:"ifInsertFromUnique,omitempty"`
-	// for message
-	SendMsgList          []*MsgHeader `protobuf:"bytes,56,rep,name=send_msg_list,json=sendMsgList,proto3" json:"send_msg_list,omitempty"`
-	RecvMsgList          []*MsgHeader `protobuf:"bytes,57,rep,name=recv_msg_list,json=recvMsgList,proto3" json:"recv_msg_list,omitempty"`
-	ScanSnapshot         *Snapshot    `protobuf:"bytes,58,opt,name=scan_snapshot,json=scanSnapshot,proto3" json:"scan_snapshot,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	//for message
+	SendMsgList  []*MsgHeader `protobuf:"bytes,56,rep,name=send_msg_list,json=sendMsgList,proto3" json:"send_msg_list,omitempty"`
+	RecvMsgList  []*MsgHeader `protobuf:"bytes,57,rep,name=recv_msg_list,json=recvMsgList,proto3" json:"recv_msg_list,omitempty"`
+	ScanSnapshot *Snapshot    `protobuf:"bytes,58,opt,name=scan_snapshot,json=scanSnapshot,proto3" json:"scan_snapshot,omitempty"`
+	// grouping sets of the agg node, the bit i of an id is set if the
+	// group by column i is absent from the set.
+	GroupingIds          []uint64 `protobuf:"varint,59,rep,packed,name=grouping_ids,json=groupingIds,proto3" json:"grouping_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
@@ -6509,6 +6529,13 @@ func (m *Node) GetScanSnapshot() *Snapshot {
 	return nil
 }
 
+func (m *Node) GetGroupingIds() []uint64 {
+	if m != nil {
+		return m.GroupingIds
+	}
+	return nil
+}
+
 // Snapshot Represents a snapshot of the database
 type Snapshot struct {
 	// The timestamp of the snapshot
@@ -6926,7 +6953,7 @@ func (m *PreInsertUkCtx) GetUkType() Type {
 }
 
 type PreDeleteCtx struct {
-	// the indexes of row_id&pk column in the batch
+	//the indexes of row_id&pk column in the batch
 	Idx                  []int32  `protobuf:"varint,1,rep,packed,name=idx,proto3" json:"idx,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -7353,8 +7380,12 @@ type Query struct {
 	LoadTag bool `protobuf:"varint,6,opt,name=loadTag,proto3" json:"loadTag,omitempty"`
 	// load write S3
 	LoadWriteS3 bool `protobuf:"varint,7,opt,name=loadWriteS3,proto3" json:"loadWriteS3,omitempty"`
-	// detectSqls are sqls detect fk self refer constraint
-	DetectSqls           []string `protobuf:"bytes,8,rep,name=detectSqls,proto3" json:"detectSqls,omitempty"`
+	//detectSqls are sqls detect fk self refer constraint
+	DetectSqls []string `protobuf:"bytes,8,rep,name=detectSqls,proto3" json:"detectSqls,omitempty"`
+	// the optimizer hints accepted from the /*+ ... */ comments, for explain
+	Hints []string `protobuf:"bytes,9,rep,name=hints,proto3" json:"hints,omitempty"`
+	// degree of parallelism given by the DOP hint, 0 means no limit
+	Dop                  int32    `protobuf:"varint,10,opt,name=dop,proto3" json:"dop,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -7449,11 +7480,24 @@ func (m *Query) GetDetectSqls() []string {
 	return nil
 }
 
+func (m *Query) GetHints() []string {
+	if m != nil {
+		return m.Hints
+	}
+	return nil
+}
+
+func (m *Query) GetDop() int32 {
+	if m != nil {
+		return m.Dop
+	}
+	return 0
+}
+
 type TransationControl struct {
-	// TransationControl type
+	//TransationControl type
 	TclType TransationControl_TclType `protobuf:"varint,1,opt,name=tcl_type,json=tclType,proto3,enum=plan.TransationControl_TclType" json:"tcl_type,omitempty"`
 	// Types that are valid to be assigned to Action:
-	//
 	//	*TransationControl_Begin
 	//	*TransationControl_Commit
 	//	*TransationControl_Rollback
@@ -7703,7 +7747,6 @@ func (m *TransationRollback) GetCompletionType() TransationCompletionType {
 
 type Plan struct {
 	// Types that are valid to be assigned to Plan:
-	//
 	//	*Plan_Query
 	//	*Plan_Tcl
 	//	*Plan_Ddl
@@ -7880,10 +7923,9 @@ func (m *Column) GetColumn() []*Expr {
 }
 
 type DataControl struct {
-	// DataDefinition type
+	//DataDefinition type
 	DclType DataControl_DclType `protobuf:"varint,1,opt,name=dcl_type,json=dclType,proto3,enum=plan.DataControl_DclType" json:"dcl_type,omitempty"`
 	// Types that are valid to be assigned to Control:
-	//
 	//	*DataControl_SetVariables
 	//	*DataControl_Prepare
 	//	*DataControl_Execute
@@ -8017,14 +8059,13 @@ func (*DataControl) XXX_OneofWrappers() []interface{} {
 }
 
 type DataDefinition struct {
-	// DataDefinition type
+	//DataDefinition type
 	DdlType DataDefinition_DdlType `protobuf:"varint,1,opt,name=ddl_type,json=ddlType,proto3,enum=plan.DataDefinition_DdlType" json:"ddl_type,omitempty"`
-	// other show statement we will rewrite to a select statement
-	// then we will get a Query
-	// eg: 'show databases' will rewrite to 'select md.datname as `Database` from mo_database md'
+	//other show statement we will rewrite to a select statement
+	//then we will get a Query
+	//eg: 'show databases' will rewrite to 'select md.datname as `Database` from mo_database md'
 	Query *Query `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
 	// Types that are valid to be assigned to Definition:
-	//
 	//	*DataDefinition_CreateDatabase
 	//	*DataDefinition_AlterDatabase
 	//	*DataDefinition_DropDatabase
@@ -9615,7 +9656,7 @@ type AlterTable struct {
 	CreateTmpTableSql string                   `protobuf:"bytes,7,opt,name=create_tmp_table_sql,json=createTmpTableSql,proto3" json:"create_tmp_table_sql,omitempty"`
 	InsertTmpDataSql  string                   `protobuf:"bytes,8,opt,name=insert_tmp_data_sql,json=insertTmpDataSql,proto3" json:"insert_tmp_data_sql,omitempty"`
 	ChangeTblColIdMap map[uint64]*ColDef       `protobuf:"bytes,9,rep,name=change_tbl_colId_map,json=changeTblColIdMap,proto3" json:"change_tbl_colId_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// detect fk self refer constraint
+	//detect fk self refer constraint
 	DetectSqls []string `protobuf:"bytes,10,rep,name=detectSqls,proto3" json:"detectSqls,omitempty"`
 	// alter table may insert fk records related to this table
 	// into mo_foreign_keys
@@ -9737,7 +9778,6 @@ func (m *AlterTable) GetUpdateFkSqls() []string {
 
 type AlterTable_Action struct {
 	// Types that are valid to be assigned to Action:
-	//
 	//	*AlterTable_Action_Drop
 	//	*AlterTable_Action_AddFk
 	//	*AlterTable_Action_AddIndex
@@ -9942,7 +9982,7 @@ type DropTable struct {
 	// drop table may delete fk records related to this table
 	// into mo_foreign_keys
 	UpdateFkSqls []string `protobuf:"bytes,11,rep,name=updateFkSqls,proto3" json:"updateFkSqls,omitempty"`
-	// fk child table id that refers to me
+	//fk child table id that refers to me
 	FkChildTblsReferToMe []uint64 `protobuf:"varint,12,rep,packed,name=fkChildTblsReferToMe,proto3" json:"fkChildTblsReferToMe,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -11641,681 +11681,678 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 10780 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x5b, 0x8f, 0x1b, 0x57,
-	0x9a, 0x58, 0xf3, 0x4e, 0x7e, 0x64, 0xb3, 0xab, 0x8f, 0x5a, 0x12, 0x25, 0xcb, 0x52, 0xbb, 0x2c,
-	0xdb, 0xb2, 0xc6, 0x23, 0xdb, 0x92, 0x2f, 0xb2, 0x77, 0x66, 0xc7, 0x6c, 0x36, 0x5b, 0x4d, 0x8b,
-	0x4d, 0xf6, 0x14, 0xd9, 0x92, 0x3d, 0x8b, 0xa4, 0x50, 0x64, 0x15, 0xbb, 0xcb, 0x5d, 0xac, 0xa2,
-	0xab, 0x8a, 0xea, 0x6e, 0x03, 0x0b, 0x4c, 0x12, 0x20, 0xc1, 0x06, 0xc8, 0x53, 0x80, 0x7d, 0x09,
-	0x36, 0x98, 0xec, 0x53, 0xb0, 0x48, 0x80, 0x00, 0x59, 0x60, 0x83, 0xbc, 0x26, 0x0f, 0x9b, 0x20,
-	0x08, 0x02, 0xe4, 0x21, 0x48, 0x02, 0x6c, 0x82, 0xc9, 0x0f, 0xd8, 0x87, 0xcd, 0x4b, 0x5e, 0xb2,
-	0xc1, 0xf7, 0x9d, 0x53, 0x55, 0xa7, 0x48, 0xf6, 0xc8, 0xf6, 0xcc, 0x22, 0xc9, 0x4b, 0x77, 0x9d,
-	0xef, 0x72, 0xee, 0xe7, 0xbb, 0x9d, 0x0b, 0x01, 0x66, 0x8e, 0xe1, 0x3e, 0x98, 0xf9, 0x5e, 0xe8,
-	0xb1, 0x3c, 0x7e, 0xdf, 0xfc, 0xe1, 0xb1, 0x1d, 0x9e, 0xcc, 0x47, 0x0f, 0xc6, 0xde, 0xf4, 0xdd,
-	0x63, 0xef, 0xd8, 0x7b, 0x97, 0x90, 0xa3, 0xf9, 0x84, 0x52, 0x94, 0xa0, 0x2f, 0xce, 0x74, 0x13,
-	0x1c, 0x6f, 0x7c, 0x2a, 0xbe, 0x37, 0x42, 0x7b, 0x6a, 0x05, 0xa1, 0x31, 0x9d, 0x71, 0x80, 0xfa,
-	0x27, 0x19, 0xc8, 0x0f, 0x2f, 0x66, 0x16, 0xab, 0x43, 0xd6, 0x36, 0x1b, 0x99, 0xed, 0xcc, 0xbd,
-	0x82, 0x96, 0xb5, 0x4d, 0xb6, 0x0d, 0x55, 0xd7, 0x0b, 0x7b, 0x73, 0xc7, 0x31, 0x46, 0x8e, 0xd5,
-	0xc8, 0x6e, 0x67, 0xee, 0x95, 0x35, 0x19, 0xc4, 0x5e, 0x81, 0x8a, 0x31, 0x0f, 0x3d, 0xdd, 0x76,
-	0xc7, 0x7e, 0x23, 0x47, 0xf8, 0x32, 0x02, 0x3a, 0xee, 0xd8, 0x67, 0x5b, 0x50, 0x38, 0xb3, 0xcd,
-	0xf0, 0xa4, 0x91, 0xa7, 0x1c, 0x79, 0x02, 0xa1, 0xc1, 0xd8, 0x70, 0xac, 0x46, 0x81, 0x43, 0x29,
-	0x81, 0xd0, 0x90, 0x0a, 0x29, 0x6e, 0x67, 0xee, 0x55, 0x34, 0x9e, 0x60, 0xb7, 0x01, 0x2c, 0x77,
-	0x3e, 0x7d, 0x61, 0x38, 0x73, 0x2b, 0x68, 0x94, 0x08, 0x25, 0x41, 0xd4, 0x9f, 0x40, 0x65, 0x1a,
-	0x1c, 0xef, 0x5b, 0x86, 0x69, 0xf9, 0xec, 0x3a, 0x94, 0xa6, 0xc1, 0xb1, 0x1e, 0x1a, 0xc7, 0xa2,
-	0x09, 0xc5, 0x69, 0x70, 0x3c, 0x34, 0x8e, 0xd9, 0x0d, 0x28, 0x13, 0xe2, 0x62, 0xc6, 0xdb, 0x50,
-	0xd0, 0x90, 0x10, 0x5b, 0xac, 0xfe, 0x79, 0x01, 0x4a, 0x5d, 0x3b, 0xb4, 0x7c, 0xc3, 0x61, 0xd7,
-	0xa0, 0x68, 0x07, 0xee, 0xdc, 0x71, 0x88, 0xbd, 0xac, 0x89, 0x14, 0xbb, 0x06, 0x05, 0xfb, 0xf1,
-	0x0b, 0xc3, 0xe1, 0xbc, 0xfb, 0x6b, 0x1a, 0x4f, 0xb2, 0x06, 0x14, 0xed, 0xf7, 0x3f, 0x42, 0x44,
-	0x4e, 0x20, 0x44, 0x9a, 0x30, 0x8f, 0x1e, 0x22, 0x26, 0x1f, 0x63, 0x28, 0x4d, 0x98, 0x8f, 0x3e,
-	0x40, 0x0c, 0xb6, 0x3e, 0x47, 0x18, 0x4a, 0x63, 0x29, 0x73, 0x2a, 0x05, 0x3b, 0x60, 0x1d, 0x4b,
-	0x99, 0x47, 0xa5, 0xcc, 0x79, 0x29, 0x25, 0x81, 0x10, 0x69, 0xc2, 0xf0, 0x52, 0xca, 0x31, 0x26,
-	0x2e, 0x65, 0xce, 0x4b, 0xa9, 0x6c, 0x67, 0xee, 0xe5, 0x09, 0xc3, 0x4b, 0xd9, 0x82, 0xbc, 0x89,
-	0x70, 0xd8, 0xce, 0xdc, 0xcb, 0xec, 0xaf, 0x69, 0x94, 0x42, 0x68, 0x80, 0xd0, 0x2a, 0x76, 0x30,
-	0x42, 0x03, 0x01, 0x1d, 0x21, 0xb4, 0x86, 0xbd, 0x81, 0xd0, 0x91, 0x80, 0x4e, 0x10, 0xba, 0xbe,
-	0x9d, 0xb9, 0x97, 0x45, 0x28, 0xa6, 0xd8, 0x4d, 0x28, 0x99, 0x46, 0x68, 0x21, 0xa2, 0x2e, 0x9a,
-	0x1c, 0x01, 0x10, 0x87, 0x33, 0x0e, 0x71, 0x1b, 0xa2, 0xd1, 0x11, 0x80, 0xa9, 0x50, 0x45, 0xb2,
-	0x08, 0xaf, 0x08, 0xbc, 0x0c, 0x64, 0x1f, 0x42, 0xcd, 0xb4, 0xc6, 0xf6, 0xd4, 0x70, 0x78, 0x9b,
-	0x36, 0xb7, 0x33, 0xf7, 0xaa, 0x0f, 0x37, 0x1e, 0xd0, 0x9a, 0x88, 0x31, 0xfb, 0x6b, 0x5a, 0x8a,
-	0x8c, 0x3d, 0x86, 0x75, 0x91, 0x7e, 0xff, 0x21, 0x75, 0x2c, 0x23, 0x3e, 0x25, 0xc5, 0xf7, 0xfe,
-	0xc3, 0xc7, 0xfb, 0x6b, 0x5a, 0x9a, 0x90, 0xdd, 0x85, 0x5a, 0xbc, 0x44, 0x90, 0xf1, 0x8a, 0xa8,
-	0x55, 0x0a, 0x8a, 0xcd, 0xfa, 0x2a, 0xf0, 0x5c, 0x24, 0xd8, 0x12, 0xfd, 0x16, 0x01, 0xd8, 0x36,
-	0x80, 0x69, 0x4d, 0x8c, 0xb9, 0x13, 0x22, 0xfa, 0xaa, 0xe8, 0x40, 0x09, 0xc6, 0x6e, 0x43, 0x65,
-	0x3e, 0xc3, 0x56, 0x3e, 0x33, 0x9c, 0xc6, 0x35, 0x41, 0x90, 0x80, 0x30, 0x77, 0x9c, 0xe7, 0x88,
-	0xbd, 0x2e, 0x46, 0x37, 0x02, 0xe0, 0x5a, 0xb1, 0x83, 0x1d, 0xdb, 0x6d, 0x34, 0x68, 0x9e, 0xf2,
-	0x04, 0xbb, 0x05, 0xb9, 0xc0, 0x1f, 0x37, 0x6e, 0x50, 0x2b, 0x81, 0xb7, 0xb2, 0x7d, 0x3e, 0xf3,
-	0x35, 0x04, 0xef, 0x94, 0xa0, 0x40, 0x6b, 0x46, 0xbd, 0x05, 0xe5, 0x43, 0xc3, 0x37, 0xa6, 0x9a,
-	0x35, 0x61, 0x0a, 0xe4, 0x66, 0x5e, 0x20, 0x56, 0x0b, 0x7e, 0xaa, 0x5d, 0x28, 0x3e, 0x33, 0x7c,
-	0xc4, 0x31, 0xc8, 0xbb, 0xc6, 0xd4, 0x22, 0x64, 0x45, 0xa3, 0x6f, 0x5c, 0x21, 0xc1, 0x45, 0x10,
-	0x5a, 0x53, 0x21, 0x0a, 0x44, 0x0a, 0xe1, 0xc7, 0x8e, 0x37, 0x12, 0x2b, 0xa1, 0xac, 0x89, 0x94,
-	0xfa, 0x37, 0x33, 0x50, 0x6c, 0x79, 0x0e, 0x66, 0x77, 0x1d, 0x4a, 0xbe, 0xe5, 0xe8, 0x49, 0x71,
-	0x45, 0xdf, 0x72, 0x0e, 0xbd, 0x00, 0x11, 0x63, 0x8f, 0x23, 0xf8, 0xda, 0x2c, 0x8e, 0x3d, 0x42,
-	0x44, 0x15, 0xc8, 0x49, 0x15, 0xb8, 0x01, 0xe5, 0x70, 0xe4, 0xe8, 0x04, 0xcf, 0x13, 0xbc, 0x14,
-	0x8e, 0x9c, 0x1e, 0xa2, 0xae, 0x43, 0xc9, 0x1c, 0x71, 0x4c, 0x81, 0x30, 0x45, 0x73, 0x84, 0x08,
-	0xf5, 0x13, 0xa8, 0x68, 0xc6, 0x99, 0xa8, 0xc6, 0x55, 0x28, 0x62, 0x06, 0x42, 0xca, 0xe5, 0xb5,
-	0x42, 0x38, 0x72, 0x3a, 0x26, 0x82, 0xb1, 0x12, 0xb6, 0x49, 0x75, 0xc8, 0x6b, 0x85, 0xb1, 0xe7,
-	0x74, 0x4c, 0x75, 0x08, 0xd0, 0xf2, 0x7c, 0xff, 0x7b, 0x37, 0x61, 0x0b, 0x0a, 0xa6, 0x35, 0x0b,
-	0x4f, 0xb8, 0x80, 0xd0, 0x78, 0x42, 0xbd, 0x0f, 0x65, 0x1c, 0x97, 0xae, 0x1d, 0x84, 0xec, 0x36,
-	0xe4, 0x1d, 0x3b, 0x08, 0x1b, 0x99, 0xed, 0xdc, 0xc2, 0xa8, 0x11, 0x5c, 0xdd, 0x86, 0xf2, 0x81,
-	0x71, 0xfe, 0x0c, 0x47, 0x0e, 0x73, 0xa3, 0x21, 0x14, 0x43, 0x22, 0xc6, 0xb3, 0x06, 0x30, 0x34,
-	0xfc, 0x63, 0x2b, 0x24, 0x79, 0xf6, 0x17, 0x19, 0xa8, 0x0e, 0xe6, 0xa3, 0xaf, 0xe7, 0x96, 0x7f,
-	0x81, 0x75, 0xbe, 0x07, 0xb9, 0xf0, 0x62, 0x46, 0x1c, 0xf5, 0x87, 0xd7, 0x78, 0xf6, 0x12, 0xfe,
-	0x01, 0x32, 0x69, 0x48, 0x82, 0x8d, 0x70, 0x3d, 0xd3, 0x8a, 0xfa, 0xa0, 0xa0, 0x15, 0x31, 0xd9,
-	0x31, 0x51, 0x29, 0x78, 0x33, 0x31, 0x0a, 0x59, 0x6f, 0xc6, 0xb6, 0xa1, 0x30, 0x3e, 0xb1, 0x1d,
-	0x93, 0x06, 0x20, 0x5d, 0x67, 0x8e, 0xc0, 0x51, 0xf2, 0xbd, 0x33, 0x3d, 0xb0, 0xbf, 0x89, 0x84,
-	0x7c, 0xc9, 0xf7, 0xce, 0x06, 0xf6, 0x37, 0x96, 0x3a, 0x14, 0x9a, 0x06, 0xa0, 0x38, 0x68, 0x35,
-	0xbb, 0x4d, 0x4d, 0x59, 0xc3, 0xef, 0xf6, 0x17, 0x9d, 0xc1, 0x70, 0xa0, 0x64, 0x58, 0x1d, 0xa0,
-	0xd7, 0x1f, 0xea, 0x22, 0x9d, 0x65, 0x45, 0xc8, 0x76, 0x7a, 0x4a, 0x0e, 0x69, 0x10, 0xde, 0xe9,
-	0x29, 0x79, 0x56, 0x82, 0x5c, 0xb3, 0xf7, 0xa5, 0x52, 0xa0, 0x8f, 0x6e, 0x57, 0x29, 0xaa, 0x7f,
-	0x94, 0x85, 0x4a, 0x7f, 0xf4, 0x95, 0x35, 0x0e, 0xb1, 0xcd, 0x38, 0x4b, 0x2d, 0xff, 0x85, 0xe5,
-	0x53, 0xb3, 0x73, 0x9a, 0x48, 0x61, 0x43, 0xcc, 0x11, 0x35, 0x2e, 0xa7, 0x65, 0xcd, 0x11, 0xd1,
-	0x8d, 0x4f, 0xac, 0xa9, 0x41, 0x8d, 0x43, 0x3a, 0x4a, 0xe1, 0xaa, 0xf0, 0x46, 0x5f, 0x51, 0xf3,
-	0x72, 0x1a, 0x7e, 0xb2, 0x3b, 0x50, 0xe5, 0x79, 0xc8, 0xf3, 0x0b, 0x38, 0x68, 0x71, 0xf2, 0x15,
-	0xe5, 0xc9, 0x47, 0x9c, 0x94, 0x2b, 0x47, 0x0a, 0x0d, 0xc6, 0x41, 0x3d, 0x31, 0xa3, 0xbd, 0xd1,
-	0x57, 0x1c, 0x5b, 0xe6, 0x33, 0xda, 0x1b, 0x7d, 0x45, 0xa8, 0x1f, 0xc0, 0x66, 0x30, 0x1f, 0x05,
-	0x63, 0xdf, 0x9e, 0x85, 0xb6, 0xe7, 0x72, 0x9a, 0x0a, 0xd1, 0x28, 0x32, 0x82, 0x88, 0xef, 0x41,
-	0x79, 0x36, 0x1f, 0xe9, 0xb6, 0x3b, 0xf1, 0x48, 0xb8, 0x57, 0x1f, 0xae, 0xf3, 0x81, 0x39, 0x9c,
-	0x8f, 0x3a, 0xee, 0xc4, 0xd3, 0x4a, 0x33, 0xfe, 0xa1, 0xbe, 0x09, 0x25, 0x01, 0x43, 0xed, 0x1d,
-	0x5a, 0xae, 0xe1, 0x86, 0x7a, 0xac, 0xf6, 0xcb, 0x1c, 0xd0, 0x31, 0xd5, 0x3f, 0xce, 0x80, 0x32,
-	0x90, 0x8a, 0x39, 0xb0, 0x42, 0x63, 0xa5, 0x54, 0x78, 0x15, 0xc0, 0x18, 0x8f, 0xbd, 0x39, 0xcf,
-	0x86, 0x4f, 0x9e, 0x8a, 0x80, 0x74, 0x4c, 0xb9, 0x6f, 0x72, 0xa9, 0xbe, 0x79, 0x0d, 0x6a, 0x11,
-	0x9f, 0xb4, 0xa0, 0xab, 0x02, 0x16, 0xf5, 0x4e, 0x30, 0x4f, 0xad, 0xea, 0x52, 0x30, 0xe7, 0xdc,
-	0xd7, 0xa0, 0x48, 0x36, 0x42, 0x10, 0xf5, 0x38, 0x4f, 0xa9, 0x7f, 0x37, 0x0b, 0xe5, 0xbd, 0xb9,
-	0x3b, 0xc6, 0x2a, 0xb3, 0xd7, 0x21, 0x3f, 0x99, 0xbb, 0x63, 0xaa, 0x6e, 0xac, 0x32, 0xe2, 0x99,
-	0xa2, 0x11, 0x12, 0xd7, 0xa0, 0xe1, 0x1f, 0xe3, 0xda, 0x5d, 0x5a, 0x83, 0x08, 0x57, 0xff, 0x45,
-	0x86, 0xe7, 0xb8, 0xe7, 0x18, 0xc7, 0xac, 0x0c, 0xf9, 0x5e, 0xbf, 0xd7, 0x56, 0xd6, 0x58, 0x0d,
-	0xca, 0x9d, 0xde, 0xb0, 0xad, 0xf5, 0x9a, 0x5d, 0x25, 0x43, 0x13, 0x7a, 0xd8, 0xdc, 0xe9, 0xb6,
-	0x95, 0x2c, 0x62, 0x9e, 0xf5, 0xbb, 0xcd, 0x61, 0xa7, 0xdb, 0x56, 0xf2, 0x1c, 0xa3, 0x75, 0x5a,
-	0x43, 0xa5, 0xcc, 0x14, 0xa8, 0x1d, 0x6a, 0xfd, 0xdd, 0xa3, 0x56, 0x5b, 0xef, 0x1d, 0x75, 0xbb,
-	0x8a, 0xc2, 0xae, 0xc0, 0x46, 0x0c, 0xe9, 0x73, 0xe0, 0x36, 0xb2, 0x3c, 0x6b, 0x6a, 0x4d, 0xed,
-	0x89, 0xf2, 0x19, 0x2b, 0x43, 0xae, 0xf9, 0xe4, 0x89, 0xf2, 0x73, 0x5c, 0x1b, 0x95, 0xe7, 0x9d,
-	0x9e, 0xfe, 0xac, 0xd9, 0x3d, 0x6a, 0x2b, 0x3f, 0xcf, 0x46, 0xe9, 0xbe, 0xb6, 0xdb, 0xd6, 0x94,
-	0x9f, 0xe7, 0xd9, 0x26, 0xd4, 0x7e, 0xd6, 0xef, 0xb5, 0x0f, 0x9a, 0x87, 0x87, 0x54, 0x91, 0x9f,
-	0x97, 0xd5, 0x3f, 0xcd, 0x43, 0x1e, 0x5b, 0xc2, 0xd4, 0x44, 0x0e, 0xc4, 0x4d, 0xc4, 0x85, 0xb8,
-	0x93, 0xff, 0xd3, 0x3f, 0xbb, 0xb3, 0xc6, 0x25, 0xc0, 0x6b, 0x90, 0x73, 0xec, 0x90, 0x06, 0x30,
-	0x9e, 0x3d, 0xc2, 0x36, 0xda, 0x5f, 0xd3, 0x10, 0xc7, 0x6e, 0x43, 0x86, 0x8b, 0x82, 0xea, 0xc3,
-	0xba, 0x98, 0x5e, 0x42, 0x97, 0xec, 0xaf, 0x69, 0x99, 0x19, 0xbb, 0x05, 0x99, 0x17, 0x42, 0x2e,
-	0xd4, 0x38, 0x9e, 0x6b, 0x13, 0xc4, 0xbe, 0x60, 0xdb, 0x90, 0x1b, 0x7b, 0xdc, 0xf2, 0x89, 0xf1,
-	0x5c, 0xb6, 0x62, 0xfe, 0x63, 0xcf, 0x61, 0xaf, 0x43, 0xce, 0x37, 0xce, 0x68, 0x44, 0xe3, 0xe1,
-	0x8a, 0x85, 0x37, 0x12, 0xf9, 0xc6, 0x19, 0x56, 0x62, 0x42, 0x2b, 0x29, 0xae, 0x44, 0x34, 0xde,
-	0x58, 0xcc, 0x84, 0x6d, 0x43, 0xe6, 0x8c, 0xd6, 0x52, 0xac, 0xec, 0x9f, 0xdb, 0xae, 0xe9, 0x9d,
-	0x0d, 0x66, 0xd6, 0x18, 0x29, 0xce, 0xd8, 0x1b, 0x90, 0x0b, 0xe6, 0x23, 0x5a, 0x4b, 0xd5, 0x87,
-	0x9b, 0x4b, 0x52, 0x11, 0x0b, 0x0a, 0xe6, 0x23, 0xf6, 0x26, 0xe4, 0xc7, 0x9e, 0xef, 0x8b, 0xf5,
-	0xa4, 0x44, 0x15, 0x8e, 0x14, 0x02, 0x1a, 0x3f, 0x88, 0xc7, 0x02, 0x43, 0xb2, 0x9d, 0x62, 0xa2,
-	0x44, 0x22, 0x63, 0x81, 0x21, 0xbb, 0x2b, 0xc4, 0x7c, 0x4d, 0xae, 0x75, 0xa4, 0x04, 0x30, 0x1f,
-	0xc4, 0xe2, 0x20, 0x4d, 0x8d, 0x73, 0xb2, 0xac, 0x62, 0xa2, 0x48, 0xfa, 0x63, 0x9d, 0xa6, 0xc6,
-	0x39, 0xbb, 0x0b, 0xb9, 0x17, 0xd6, 0x98, 0x8c, 0xac, 0xb8, 0x34, 0x31, 0x48, 0xcf, 0xa8, 0x79,
-	0x88, 0x46, 0x7d, 0x66, 0xcc, 0xcf, 0x71, 0x39, 0x6e, 0x70, 0xcd, 0x63, 0xcc, 0xcf, 0x3b, 0x26,
-	0x4a, 0x36, 0xd7, 0x7c, 0x41, 0x56, 0x56, 0x46, 0xc3, 0x4f, 0xb4, 0xf0, 0x03, 0xcb, 0xb1, 0xc6,
-	0xa1, 0xfd, 0xc2, 0x0e, 0x2f, 0xc8, 0xb4, 0xca, 0x68, 0x32, 0x68, 0xa7, 0x08, 0x79, 0xeb, 0x7c,
-	0xe6, 0xab, 0x0f, 0x01, 0x92, 0x72, 0x30, 0x27, 0xc7, 0x72, 0x23, 0xcb, 0xc1, 0xb1, 0x5c, 0x94,
-	0x0c, 0xa6, 0x11, 0x1a, 0x34, 0x7d, 0x6a, 0x1a, 0x7d, 0xab, 0x37, 0xa0, 0x12, 0x9b, 0x64, 0xac,
-	0x06, 0x19, 0x43, 0x48, 0xe4, 0x8c, 0xa1, 0xde, 0x43, 0x0b, 0x29, 0x32, 0xba, 0xd2, 0x38, 0x4c,
-	0x45, 0x72, 0x3a, 0x33, 0x52, 0x7f, 0x04, 0x35, 0xcd, 0x0a, 0xe6, 0x4e, 0xd8, 0xf2, 0x9c, 0x5d,
-	0x6b, 0xc2, 0xde, 0x01, 0x88, 0xd3, 0x81, 0x50, 0x9c, 0xc9, 0x64, 0xda, 0xb5, 0x26, 0x9a, 0x84,
-	0x57, 0xff, 0x71, 0x9e, 0x4c, 0x90, 0x5d, 0xae, 0xfb, 0x85, 0x92, 0xcf, 0x48, 0x4a, 0x3e, 0x16,
-	0x69, 0xd9, 0xb4, 0xa1, 0x73, 0x62, 0x9b, 0xa6, 0xe5, 0x46, 0x06, 0x0d, 0x4f, 0x61, 0xef, 0x1b,
-	0xce, 0x31, 0xcd, 0xf0, 0xfa, 0x43, 0x16, 0x15, 0x3a, 0x9d, 0xf9, 0x56, 0x10, 0x70, 0x55, 0x6a,
-	0x38, 0xc7, 0xd1, 0x62, 0x2b, 0xfc, 0xaa, 0xc5, 0x76, 0x03, 0xca, 0xae, 0x17, 0xea, 0xe4, 0x6e,
-	0x14, 0xa9, 0x8c, 0x92, 0xf0, 0xab, 0xd8, 0x5b, 0x50, 0x12, 0x86, 0xa2, 0x98, 0xe5, 0x62, 0x2d,
-	0xee, 0x72, 0xa0, 0x16, 0x61, 0x59, 0x03, 0xed, 0x8e, 0xe9, 0xd4, 0x72, 0xc3, 0x48, 0x75, 0x88,
-	0x24, 0xfb, 0x01, 0x54, 0x3c, 0x57, 0xe7, 0xd6, 0xa4, 0x98, 0xe6, 0x62, 0x3e, 0xf5, 0xdd, 0x23,
-	0x82, 0x6a, 0x65, 0x4f, 0x7c, 0x61, 0x55, 0x1c, 0xef, 0x4c, 0x1f, 0x1b, 0xbe, 0x49, 0x53, 0xbd,
-	0xac, 0x95, 0x1c, 0xef, 0xac, 0x65, 0xf8, 0x26, 0x57, 0xa5, 0x5f, 0xbb, 0xf3, 0x29, 0x4d, 0xef,
-	0x75, 0x4d, 0xa4, 0xd8, 0x2d, 0xa8, 0x8c, 0x9d, 0x79, 0x10, 0x5a, 0xfe, 0xce, 0x05, 0xf7, 0x0f,
-	0xb4, 0x04, 0x80, 0xf5, 0x9a, 0xf9, 0xf6, 0xd4, 0xf0, 0x2f, 0x68, 0x2e, 0x97, 0xb5, 0x28, 0x89,
-	0x26, 0xcc, 0xec, 0xd4, 0x36, 0xcf, 0xb9, 0x93, 0xa0, 0xf1, 0x04, 0xd2, 0x9f, 0x90, 0x0b, 0x17,
-	0xd0, 0x74, 0x2d, 0x6b, 0x51, 0x92, 0xc6, 0x81, 0x3e, 0x69, 0xce, 0x56, 0x34, 0x91, 0x4a, 0xd9,
-	0x81, 0x9b, 0x97, 0xda, 0x81, 0x6c, 0x51, 0x15, 0x7b, 0xbe, 0x7d, 0x6c, 0x0b, 0x45, 0x7a, 0x85,
-	0xab, 0x62, 0x0e, 0x22, 0x43, 0xf1, 0x6b, 0x28, 0x89, 0x2e, 0x46, 0x95, 0x80, 0x93, 0x3e, 0x2d,
-	0x2f, 0xb9, 0x4a, 0x40, 0x38, 0x7b, 0x1d, 0xd6, 0x45, 0x5e, 0x41, 0xe8, 0xdb, 0xee, 0xb1, 0x98,
-	0x3c, 0x35, 0x0e, 0x1c, 0x10, 0x0c, 0xf5, 0x1b, 0x0e, 0xaf, 0x6e, 0x8c, 0x6c, 0x07, 0x17, 0x57,
-	0x4e, 0xb8, 0xcf, 0x73, 0xc7, 0x69, 0x72, 0x90, 0xda, 0x87, 0x72, 0x34, 0x20, 0xbf, 0x91, 0x32,
-	0xd5, 0xdf, 0x82, 0x6a, 0xc7, 0x35, 0xad, 0xf3, 0x3e, 0xa9, 0x6c, 0xf6, 0x0e, 0xb0, 0xb1, 0x6f,
-	0x19, 0xa1, 0xa5, 0x5b, 0xe7, 0xa1, 0x6f, 0xe8, 0xdc, 0xc5, 0xe6, 0xee, 0xad, 0xc2, 0x31, 0x6d,
-	0x44, 0x0c, 0x11, 0xae, 0xfe, 0x97, 0x0c, 0xac, 0x1f, 0xf2, 0x91, 0x7a, 0x6a, 0x5d, 0xec, 0x72,
-	0x27, 0x60, 0x1c, 0xad, 0xb2, 0xbc, 0x46, 0xdf, 0xec, 0x36, 0x54, 0x67, 0xa7, 0xd6, 0x85, 0x9e,
-	0x32, 0x98, 0x2b, 0x08, 0x6a, 0xd1, 0x7a, 0x7a, 0x1b, 0x8a, 0x1e, 0x95, 0x2e, 0x14, 0x85, 0x90,
-	0xaf, 0x52, 0xb5, 0x34, 0x41, 0xc0, 0x54, 0x58, 0x8f, 0xb3, 0x92, 0x4d, 0x00, 0x91, 0x19, 0x0d,
-	0xdb, 0x16, 0x14, 0x10, 0x15, 0x34, 0x0a, 0xdb, 0x39, 0xb4, 0x7a, 0x29, 0xc1, 0xde, 0x83, 0xf5,
-	0xb1, 0x37, 0x9d, 0xe9, 0x11, 0xbb, 0x50, 0x19, 0x69, 0x39, 0x50, 0x45, 0x92, 0x43, 0x9e, 0x97,
-	0xfa, 0xfb, 0x39, 0x28, 0x53, 0x1d, 0x84, 0x28, 0xb0, 0xcd, 0xf3, 0x48, 0x14, 0x54, 0xb4, 0x82,
-	0x6d, 0xa2, 0x7c, 0x7c, 0x15, 0xc0, 0x46, 0x12, 0x5d, 0x12, 0x08, 0x15, 0x82, 0x44, 0x55, 0x99,
-	0x19, 0x7e, 0x18, 0x34, 0x72, 0xbc, 0x2a, 0x94, 0xc0, 0x39, 0x3a, 0x77, 0xed, 0xaf, 0xe7, 0xbc,
-	0xf6, 0x65, 0x4d, 0xa4, 0xd8, 0x3d, 0x50, 0x78, 0x66, 0xd4, 0xe9, 0xb2, 0x0d, 0x53, 0x27, 0x38,
-	0xf5, 0x79, 0x34, 0x33, 0x39, 0x8d, 0x75, 0x8e, 0x4a, 0x82, 0x8b, 0x03, 0x20, 0x50, 0x1b, 0x21,
-	0xf2, 0x42, 0x2f, 0xa5, 0x17, 0x7a, 0x03, 0x4a, 0x2f, 0xec, 0xc0, 0xc6, 0x51, 0x2d, 0xf3, 0xa5,
-	0x23, 0x92, 0xd2, 0x30, 0x54, 0x5e, 0x36, 0x0c, 0x71, 0xb3, 0x0d, 0xe7, 0x98, 0x5b, 0x8f, 0x51,
-	0xb3, 0x9b, 0xce, 0xb1, 0xc7, 0xde, 0x87, 0xab, 0x09, 0x5a, 0xb4, 0x86, 0x62, 0x29, 0x14, 0x2e,
-	0xd0, 0x58, 0x4c, 0x49, 0x2d, 0x22, 0xf3, 0xfe, 0x3e, 0x6c, 0x4a, 0x2c, 0x33, 0xb4, 0x11, 0x02,
-	0x92, 0x13, 0x15, 0x6d, 0x23, 0x26, 0x27, 0xd3, 0x21, 0x50, 0xff, 0x4d, 0x16, 0xd6, 0xf7, 0x3c,
-	0xdf, 0xb2, 0x8f, 0xdd, 0x64, 0xd6, 0x2d, 0x19, 0x99, 0xd1, 0x4c, 0xcc, 0x4a, 0x33, 0xf1, 0x0e,
-	0x54, 0x27, 0x9c, 0x51, 0x0f, 0x47, 0xdc, 0xf7, 0xcc, 0x6b, 0x20, 0x40, 0xc3, 0x91, 0x83, 0x2b,
-	0x30, 0x22, 0x20, 0xe6, 0x3c, 0x31, 0x47, 0x4c, 0xa8, 0x1f, 0xd8, 0xa7, 0x24, 0x29, 0x4d, 0xcb,
-	0xb1, 0x42, 0x3e, 0x3c, 0xf5, 0x87, 0xaf, 0x0a, 0xa3, 0x42, 0xae, 0xd3, 0x03, 0xcd, 0x9a, 0x34,
-	0xc9, 0xc6, 0x40, 0xc1, 0xb9, 0x4b, 0xe4, 0x82, 0x57, 0x48, 0xd9, 0xe2, 0xb7, 0xe4, 0xe5, 0xab,
-	0x5d, 0x1d, 0x42, 0x25, 0x06, 0xa3, 0xc1, 0xa8, 0xb5, 0x85, 0x91, 0xb8, 0xc6, 0xaa, 0x50, 0x6a,
-	0x35, 0x07, 0xad, 0xe6, 0x6e, 0x5b, 0xc9, 0x20, 0x6a, 0xd0, 0x1e, 0x72, 0xc3, 0x30, 0xcb, 0x36,
-	0xa0, 0x8a, 0xa9, 0xdd, 0xf6, 0x5e, 0xf3, 0xa8, 0x3b, 0x54, 0x72, 0x6c, 0x1d, 0x2a, 0xbd, 0xbe,
-	0xde, 0x6c, 0x0d, 0x3b, 0xfd, 0x9e, 0x92, 0x57, 0x3f, 0x83, 0x72, 0xeb, 0xc4, 0x1a, 0x9f, 0x5e,
-	0xd6, 0x8b, 0xe4, 0xbb, 0x59, 0xe3, 0x53, 0x61, 0xe4, 0x2d, 0xf8, 0x6e, 0xd6, 0xf8, 0x54, 0x7d,
-	0x06, 0xb5, 0x56, 0x24, 0xc8, 0x2f, 0xcb, 0xe5, 0x21, 0xd4, 0x69, 0xf1, 0x8d, 0x47, 0xd1, 0xea,
-	0xcb, 0xae, 0x58, 0x7d, 0x35, 0xa4, 0x69, 0x8d, 0xc4, 0xf2, 0xfb, 0x10, 0xaa, 0x87, 0xbe, 0x37,
-	0xb3, 0xfc, 0x90, 0xb2, 0x55, 0x20, 0x77, 0x6a, 0x5d, 0x88, 0x5c, 0xf1, 0x33, 0xf1, 0x6e, 0xb3,
-	0xb2, 0x77, 0xfb, 0x10, 0xca, 0x11, 0xdb, 0xb7, 0xe6, 0xf9, 0x09, 0x4a, 0x31, 0xe2, 0xb1, 0xad,
-	0x00, 0x0b, 0x7b, 0x00, 0x30, 0x8b, 0x01, 0xc2, 0x62, 0x88, 0xcc, 0x57, 0x91, 0xb9, 0x26, 0x51,
-	0xa8, 0x7f, 0x91, 0x83, 0xfa, 0xa1, 0xe1, 0x87, 0x36, 0x0e, 0x0e, 0xef, 0x86, 0xb7, 0x20, 0x4f,
-	0x53, 0x9e, 0x3b, 0xd2, 0x57, 0x62, 0xdb, 0x97, 0xd3, 0x90, 0xea, 0x27, 0x02, 0xf6, 0x29, 0xd4,
-	0x67, 0x11, 0x58, 0x27, 0x79, 0xce, 0xfb, 0x66, 0x91, 0x85, 0xfa, 0x7c, 0x7d, 0x26, 0x27, 0xd9,
-	0x8f, 0x61, 0x2b, 0xcd, 0x6b, 0x05, 0x41, 0x22, 0x47, 0xe5, 0xc1, 0xba, 0x92, 0x62, 0xe4, 0x64,
-	0xac, 0x05, 0x9b, 0x09, 0xfb, 0xd8, 0x73, 0xe6, 0x53, 0x37, 0x10, 0xc6, 0xf8, 0xb5, 0x85, 0xd2,
-	0x5b, 0x1c, 0xab, 0x29, 0xb3, 0x05, 0x08, 0x53, 0xa1, 0x16, 0xc3, 0x7a, 0xf3, 0x29, 0x2d, 0x89,
-	0xbc, 0x96, 0x82, 0xb1, 0x47, 0x00, 0x71, 0x1a, 0xdd, 0xaf, 0xdc, 0x8a, 0xf6, 0x75, 0x42, 0x6b,
-	0xaa, 0x49, 0x64, 0x68, 0x32, 0xa0, 0x30, 0xf0, 0xed, 0xf0, 0x64, 0x4a, 0x52, 0x2c, 0xa7, 0x25,
-	0x00, 0x12, 0x96, 0x81, 0x8e, 0xbe, 0x5e, 0xcc, 0x22, 0x04, 0x5a, 0xdd, 0x0e, 0x06, 0xf3, 0x51,
-	0x9c, 0x2f, 0xaa, 0xc1, 0xa4, 0x95, 0xd3, 0xe0, 0x58, 0x78, 0xc4, 0x49, 0x0d, 0x0f, 0x82, 0x63,
-	0xf6, 0x10, 0xae, 0x26, 0x44, 0x89, 0xfc, 0x0d, 0x1a, 0x40, 0x92, 0x3b, 0xe9, 0xbe, 0x58, 0x08,
-	0x07, 0xea, 0xe7, 0xb0, 0x9e, 0x1a, 0x9d, 0x97, 0x2a, 0xe4, 0x1b, 0x50, 0xc6, 0xff, 0xa8, 0x8e,
-	0xc5, 0x04, 0x2c, 0x61, 0x7a, 0x10, 0xfa, 0xaa, 0x05, 0xca, 0x62, 0x5f, 0xb3, 0xbb, 0x14, 0x25,
-	0xa2, 0x41, 0x59, 0x8e, 0xf6, 0x44, 0x28, 0x74, 0xfa, 0x97, 0x07, 0x31, 0x4b, 0xb5, 0x5e, 0x1a,
-	0x2c, 0xf5, 0x1f, 0x65, 0xa5, 0x3a, 0x63, 0x8f, 0xb3, 0x37, 0xe4, 0xe9, 0x27, 0x2d, 0xdc, 0xa4,
-	0xcf, 0x48, 0xe3, 0xbc, 0x0d, 0x8a, 0xe7, 0x9b, 0xb6, 0x6b, 0x50, 0xd4, 0x8a, 0x77, 0x77, 0x96,
-	0x2c, 0xbc, 0x0d, 0x01, 0x3f, 0x14, 0x60, 0xf4, 0x10, 0x4c, 0x2b, 0x0e, 0x02, 0x08, 0x17, 0x5e,
-	0x06, 0xc9, 0xda, 0x29, 0x9f, 0xd6, 0x4e, 0x6f, 0x41, 0xc5, 0xb1, 0x82, 0x40, 0x0f, 0x4f, 0x0c,
-	0x97, 0xf4, 0x77, 0xba, 0xd1, 0x65, 0x44, 0x0e, 0x4f, 0x0c, 0x17, 0x09, 0x6d, 0x57, 0x17, 0x61,
-	0xfe, 0xe2, 0x32, 0xa1, 0xed, 0x92, 0x13, 0x84, 0x7a, 0x7f, 0x6b, 0xd5, 0xc0, 0x0a, 0xb5, 0xc8,
-	0x96, 0xc7, 0x55, 0x7d, 0x15, 0x4a, 0xcf, 0x6c, 0xeb, 0x4c, 0xc8, 0xb2, 0x17, 0xb6, 0x75, 0x16,
-	0xc9, 0x32, 0xfc, 0x56, 0xff, 0x73, 0x19, 0xca, 0x44, 0xbc, 0x7b, 0x79, 0x74, 0xf0, 0xbb, 0x78,
-	0x08, 0xdb, 0x42, 0x4f, 0xe5, 0x57, 0xf8, 0x25, 0x5c, 0x6b, 0xbd, 0x0a, 0x20, 0xe9, 0x50, 0x6e,
-	0x11, 0x54, 0xc2, 0x58, 0x75, 0xa2, 0x69, 0x4d, 0x86, 0x59, 0xf0, 0xb5, 0x23, 0x42, 0x1b, 0x09,
-	0x80, 0x3d, 0xe0, 0x86, 0x2f, 0x05, 0x35, 0x4a, 0xb2, 0x60, 0xa1, 0x36, 0x44, 0x7e, 0x30, 0x59,
-	0xc3, 0x98, 0x20, 0xfb, 0xc0, 0xf2, 0x83, 0x68, 0x39, 0xad, 0x6b, 0x51, 0x12, 0x25, 0x1a, 0x1a,
-	0x4f, 0xc2, 0x6f, 0x8d, 0x96, 0xaf, 0x6c, 0xfd, 0x69, 0x44, 0xc0, 0xee, 0x41, 0x89, 0x54, 0xb6,
-	0x85, 0x1a, 0x5c, 0x12, 0x9d, 0x91, 0x31, 0xa5, 0x45, 0x68, 0xf6, 0x36, 0x14, 0x26, 0xa7, 0xd6,
-	0x45, 0xd0, 0x58, 0x97, 0x45, 0x42, 0x4a, 0x17, 0x6a, 0x9c, 0x82, 0xdd, 0x85, 0xba, 0x6f, 0x4d,
-	0x74, 0x8a, 0x17, 0xa2, 0xf2, 0x0e, 0x1a, 0x75, 0xd2, 0xcd, 0x35, 0xdf, 0x9a, 0xb4, 0x10, 0x38,
-	0x1c, 0x39, 0x01, 0x7b, 0x13, 0x8a, 0xa4, 0x95, 0xd0, 0x2f, 0x90, 0x4a, 0x8e, 0x54, 0x9c, 0x26,
-	0xb0, 0xec, 0x21, 0x54, 0x12, 0xb1, 0x71, 0x95, 0x1a, 0xb4, 0xb5, 0x20, 0x8f, 0x48, 0x8c, 0x6b,
-	0x09, 0x19, 0x7b, 0x1f, 0x40, 0x78, 0x2c, 0xfa, 0xe8, 0x82, 0x22, 0xf0, 0xd5, 0xd8, 0xa3, 0x93,
-	0x14, 0xa0, 0xec, 0xd7, 0xbc, 0x05, 0x05, 0xd4, 0x12, 0x41, 0xe3, 0x3a, 0xd5, 0x66, 0x33, 0xad,
-	0x42, 0xa8, 0x75, 0x84, 0x67, 0xf7, 0xa0, 0x8c, 0x93, 0x4b, 0xc7, 0x21, 0x6c, 0xc8, 0x2e, 0x9c,
-	0x98, 0x89, 0x68, 0xa5, 0x59, 0x67, 0x83, 0xaf, 0x1d, 0x76, 0x1f, 0xf2, 0xa6, 0x35, 0x09, 0x1a,
-	0x37, 0x28, 0xc7, 0x6b, 0xd2, 0x58, 0xa2, 0xe1, 0xb0, 0x6b, 0x4d, 0xb8, 0x6a, 0x41, 0x1a, 0xb6,
-	0x0f, 0x75, 0x9c, 0x7a, 0x0f, 0xc9, 0xf0, 0xc6, 0x2e, 0x6f, 0xdc, 0x24, 0xae, 0xd7, 0x16, 0xb8,
-	0x7a, 0x82, 0x88, 0x06, 0xa8, 0xed, 0x86, 0xfe, 0x85, 0xb6, 0xee, 0xca, 0x30, 0x76, 0x13, 0xca,
-	0x76, 0xd0, 0xf5, 0xc6, 0xa7, 0x96, 0xd9, 0x78, 0x85, 0x6f, 0xda, 0x45, 0x69, 0xf6, 0x09, 0xac,
-	0xd3, 0x64, 0xc4, 0x24, 0x16, 0xde, 0xb8, 0x25, 0xab, 0xbc, 0xa1, 0x8c, 0xd2, 0xd2, 0x94, 0x68,
-	0x6e, 0xd9, 0x81, 0x1e, 0x5a, 0xd3, 0x99, 0xe7, 0xa3, 0xf3, 0xf7, 0x2a, 0x77, 0x78, 0xec, 0x60,
-	0x18, 0x81, 0x50, 0xce, 0xc7, 0xfb, 0x85, 0xba, 0x37, 0x99, 0x04, 0x56, 0xd8, 0xb8, 0x4d, 0x6b,
-	0xad, 0x1e, 0x6d, 0x1b, 0xf6, 0x09, 0x4a, 0x46, 0x69, 0xa0, 0x9b, 0x17, 0xae, 0x31, 0xb5, 0xc7,
-	0x8d, 0x3b, 0xdc, 0xc7, 0xb4, 0x83, 0x5d, 0x0e, 0x90, 0xdd, 0xbc, 0x6d, 0xd9, 0xcd, 0xbb, 0xf9,
-	0x84, 0xbc, 0x38, 0xaa, 0xcf, 0x87, 0x0b, 0x7a, 0x3f, 0x35, 0xd1, 0x25, 0x03, 0x61, 0x7f, 0x4d,
-	0x56, 0xff, 0x3b, 0x05, 0xc8, 0x99, 0xd6, 0xe4, 0xe6, 0x67, 0xc0, 0x96, 0x7b, 0xf2, 0x65, 0x46,
-	0x48, 0x41, 0x18, 0x21, 0x9f, 0x66, 0x1f, 0x67, 0xd4, 0x4f, 0x60, 0x3d, 0xb5, 0x2c, 0x57, 0x1a,
-	0x53, 0xdc, 0xa9, 0x30, 0xa6, 0x22, 0x70, 0xc2, 0x13, 0xea, 0xbf, 0xcf, 0x41, 0x6d, 0xdf, 0x08,
-	0x4e, 0x0e, 0x8c, 0xd9, 0x20, 0x34, 0xc2, 0x00, 0xfb, 0xf6, 0xc4, 0x08, 0x4e, 0xa6, 0xc6, 0x8c,
-	0xc7, 0xd5, 0x33, 0x3c, 0x52, 0x23, 0x60, 0x03, 0xfb, 0x1b, 0x0b, 0x47, 0x15, 0x93, 0x7d, 0xf7,
-	0xf0, 0xa9, 0xd8, 0x9f, 0x89, 0xd3, 0x28, 0x07, 0x82, 0x93, 0xf9, 0x64, 0xe2, 0x58, 0x42, 0x5e,
-	0x45, 0x49, 0x76, 0x17, 0xd6, 0xc5, 0x27, 0xb9, 0x6f, 0xe7, 0x62, 0xb3, 0x36, 0x0d, 0x64, 0x8f,
-	0xa0, 0x2a, 0x00, 0xc3, 0x48, 0x6a, 0xd5, 0xe3, 0xc8, 0x59, 0x82, 0xd0, 0x64, 0x2a, 0xf6, 0x53,
-	0xb8, 0x2a, 0x25, 0xf7, 0x3c, 0xff, 0x60, 0xee, 0x84, 0x76, 0xab, 0x27, 0x6c, 0xe5, 0x57, 0x96,
-	0xd8, 0x13, 0x12, 0x6d, 0x35, 0x67, 0xba, 0xb6, 0x07, 0xb6, 0x2b, 0x2c, 0x89, 0x34, 0x70, 0x81,
-	0xca, 0x38, 0x27, 0xd9, 0x97, 0xa6, 0x32, 0xce, 0x71, 0xa6, 0x0b, 0xc0, 0x81, 0x15, 0x9e, 0x78,
-	0x26, 0x59, 0x12, 0xf1, 0x4c, 0x1f, 0xc8, 0x28, 0x2d, 0x4d, 0x89, 0xdd, 0x89, 0x6e, 0xfc, 0xd8,
-	0x0d, 0xc9, 0x5d, 0xca, 0x69, 0x51, 0x12, 0xf5, 0x82, 0x6f, 0xb8, 0xc7, 0x56, 0xd0, 0xa8, 0x6e,
-	0xe7, 0xee, 0x65, 0x34, 0x91, 0x52, 0xff, 0x46, 0x16, 0x0a, 0x7c, 0x24, 0x5f, 0x81, 0xca, 0xc8,
-	0xf1, 0xc6, 0xa7, 0xba, 0x3b, 0x9f, 0x46, 0x41, 0x77, 0x02, 0xa0, 0x69, 0x45, 0x6e, 0x4e, 0xc0,
-	0x83, 0xb0, 0x19, 0x8d, 0xbe, 0x31, 0x4b, 0x6f, 0x1e, 0x62, 0x59, 0x39, 0x82, 0x8a, 0x14, 0x56,
-	0xc2, 0xf7, 0xce, 0x68, 0x36, 0xe4, 0x09, 0x11, 0x25, 0x29, 0xae, 0x4f, 0x2a, 0x06, 0x99, 0x0a,
-	0x84, 0x2b, 0x13, 0xa0, 0xe5, 0x86, 0x8b, 0x21, 0xbf, 0xe2, 0x52, 0xc8, 0x8f, 0xdd, 0x06, 0x74,
-	0xa2, 0xc6, 0x56, 0xdf, 0xb5, 0x5a, 0x3d, 0xea, 0xe1, 0xb2, 0x26, 0x41, 0xd8, 0x47, 0xf1, 0x5c,
-	0xa4, 0x16, 0x89, 0x58, 0xab, 0x10, 0x9e, 0xf2, 0xac, 0xd5, 0x52, 0x74, 0xea, 0x73, 0x00, 0xcd,
-	0x3b, 0x0b, 0xac, 0x90, 0xcc, 0xab, 0xeb, 0x54, 0xfd, 0xd4, 0x76, 0x9a, 0x77, 0x76, 0xe8, 0x05,
-	0xd1, 0xae, 0x64, 0x36, 0xde, 0x95, 0x8c, 0x2d, 0xb1, 0xdc, 0x6a, 0x4b, 0x4c, 0x7d, 0x17, 0x4a,
-	0xa8, 0x62, 0x8d, 0xd0, 0x60, 0x77, 0x45, 0x18, 0x92, 0x9b, 0x58, 0x22, 0x40, 0x9a, 0x94, 0x2a,
-	0x02, 0x93, 0xdd, 0xa8, 0x26, 0xc4, 0xf3, 0x9a, 0x14, 0xe5, 0x88, 0x45, 0xb5, 0xc8, 0x50, 0x28,
-	0xed, 0x57, 0xa0, 0x82, 0x95, 0xa5, 0x9d, 0x09, 0x51, 0xb3, 0xb2, 0xef, 0x9d, 0xb5, 0x30, 0xad,
-	0xfe, 0xd7, 0x0c, 0x54, 0xfb, 0xbe, 0x89, 0x3a, 0x62, 0x30, 0xb3, 0xc6, 0x2f, 0x35, 0x1c, 0x51,
-	0xc5, 0x7b, 0x8e, 0x63, 0xc4, 0x66, 0x17, 0xaa, 0xf8, 0x08, 0xc0, 0xde, 0x87, 0xfc, 0xc4, 0x31,
-	0x8e, 0xa9, 0xb1, 0xb1, 0x43, 0x29, 0x65, 0x1f, 0x7d, 0xef, 0x39, 0xc6, 0xb1, 0x46, 0xa4, 0xea,
-	0xef, 0xc4, 0xe5, 0xd3, 0x1e, 0x85, 0xbc, 0x33, 0xb1, 0x46, 0xbb, 0x64, 0x83, 0x96, 0x92, 0x61,
-	0x65, 0xc8, 0xef, 0xb6, 0x07, 0x2d, 0xee, 0x46, 0xa2, 0x43, 0x39, 0xd0, 0xf7, 0x3a, 0xda, 0x60,
-	0xa8, 0xe4, 0x69, 0xdb, 0x8d, 0x00, 0xdd, 0xe6, 0x60, 0xa8, 0x94, 0x19, 0x40, 0xf1, 0xa8, 0xd7,
-	0xf9, 0xe9, 0x51, 0x5b, 0x51, 0xd4, 0xff, 0x98, 0x01, 0x48, 0x02, 0xe8, 0xec, 0x07, 0x50, 0x3d,
-	0xa3, 0x94, 0x2e, 0xed, 0xac, 0xc8, 0x6d, 0x04, 0x8e, 0x26, 0xf3, 0xe3, 0x87, 0x92, 0x37, 0x81,
-	0x6a, 0x76, 0x79, 0x8b, 0xa5, 0x3a, 0x4b, 0x34, 0x34, 0x7b, 0x07, 0xca, 0x1e, 0xb6, 0x03, 0x49,
-	0x73, 0xb2, 0x8e, 0x95, 0x9a, 0xaf, 0x95, 0x3c, 0x9e, 0x40, 0x75, 0x3c, 0xf1, 0xa3, 0xa8, 0x51,
-	0x4c, 0xba, 0x87, 0xa0, 0x96, 0x63, 0xcc, 0x03, 0x4b, 0xe3, 0xf8, 0x58, 0xec, 0x16, 0x12, 0xb1,
-	0xab, 0xfe, 0x0c, 0xea, 0x03, 0x63, 0x3a, 0xe3, 0xc2, 0x99, 0x1a, 0xc6, 0x20, 0x8f, 0x73, 0x42,
-	0x4c, 0x46, 0xfa, 0xc6, 0x25, 0x76, 0x68, 0xf9, 0x63, 0xcb, 0x8d, 0x56, 0x64, 0x94, 0x44, 0x61,
-	0x7b, 0x14, 0xd8, 0xee, 0xb1, 0xe6, 0x9d, 0x45, 0xe7, 0x5e, 0xa2, 0xb4, 0xfa, 0x4f, 0x32, 0x50,
-	0x95, 0xaa, 0xc1, 0xde, 0x4d, 0x39, 0x8f, 0xaf, 0x2c, 0xd5, 0x93, 0x7f, 0x4b, 0x4e, 0xe4, 0x9b,
-	0x50, 0x08, 0x42, 0xc3, 0x8f, 0xf6, 0x62, 0x14, 0x89, 0x63, 0xc7, 0x9b, 0xbb, 0xa6, 0xc6, 0xd1,
-	0x4c, 0x85, 0x9c, 0xe5, 0x9a, 0x62, 0x59, 0x2c, 0x53, 0x21, 0x52, 0xdd, 0x86, 0x4a, 0x9c, 0x3d,
-	0x4e, 0x01, 0xad, 0xff, 0x7c, 0xa0, 0xac, 0xb1, 0x0a, 0x14, 0xb4, 0x66, 0xef, 0x49, 0x5b, 0xc9,
-	0xa8, 0x7f, 0x9c, 0x01, 0x48, 0xb8, 0xd8, 0x83, 0x54, 0x6d, 0x6f, 0x2e, 0xe6, 0xfa, 0x80, 0xfe,
-	0x4a, 0x95, 0xbd, 0x05, 0x95, 0xb9, 0x4b, 0x40, 0xcb, 0x14, 0x7a, 0x27, 0x01, 0xb0, 0x5b, 0x90,
-	0x8b, 0x4e, 0xc8, 0x2c, 0x9c, 0x4a, 0x78, 0x61, 0x38, 0xea, 0xa7, 0x50, 0x89, 0xb3, 0x63, 0xeb,
-	0x50, 0xd9, 0xeb, 0x77, 0xbb, 0xfd, 0xe7, 0x9d, 0xde, 0x13, 0x65, 0x0d, 0x93, 0x87, 0x5a, 0xbb,
-	0xd5, 0xde, 0xc5, 0x64, 0x06, 0xe7, 0x6c, 0xeb, 0x48, 0xd3, 0xda, 0xbd, 0xa1, 0xae, 0xf5, 0x9f,
-	0x2b, 0x59, 0xf5, 0x6f, 0xe5, 0x61, 0xb3, 0xef, 0xee, 0xce, 0x67, 0x8e, 0x3d, 0x36, 0x42, 0xeb,
-	0xa9, 0x75, 0xd1, 0x0a, 0xcf, 0x51, 0x9d, 0x1a, 0x61, 0xe8, 0xf3, 0xc5, 0x5c, 0xd1, 0x78, 0x82,
-	0xc7, 0xe2, 0x02, 0xcb, 0x0f, 0x29, 0xd4, 0x28, 0xaf, 0xe2, 0x3a, 0x87, 0xb7, 0x3c, 0x87, 0xd6,
-	0x32, 0xfb, 0x31, 0x5c, 0xe5, 0xf1, 0x3b, 0x4e, 0x89, 0xf6, 0xa5, 0x2e, 0x64, 0xcf, 0xe2, 0xd4,
-	0x65, 0x9c, 0x10, 0x59, 0x91, 0x8c, 0x84, 0xda, 0x1d, 0xa8, 0x26, 0xec, 0xdc, 0x0b, 0xa8, 0x68,
-	0x10, 0x13, 0x52, 0x4d, 0x3c, 0x57, 0x37, 0xa3, 0x5a, 0xeb, 0xb6, 0x79, 0x4e, 0x9e, 0x51, 0x41,
-	0xab, 0x7b, 0x49, 0x63, 0x50, 0xe5, 0x7e, 0x01, 0x9b, 0x29, 0x4a, 0xaa, 0x05, 0xf7, 0x8d, 0xde,
-	0x89, 0x62, 0xf9, 0x0b, 0xad, 0x97, 0x21, 0x58, 0x1d, 0x6e, 0xfc, 0x6d, 0x78, 0x69, 0x28, 0x0a,
-	0x33, 0x3b, 0xd0, 0xed, 0x63, 0xd7, 0xf3, 0x2d, 0x21, 0xde, 0xcb, 0x76, 0xd0, 0xa1, 0x74, 0xe2,
-	0x9e, 0x48, 0x5b, 0xd2, 0x5c, 0x9b, 0x44, 0x3b, 0xb2, 0x1c, 0x6d, 0x73, 0x7d, 0x99, 0xd7, 0x4a,
-	0x94, 0xee, 0x98, 0xe8, 0x99, 0x73, 0x54, 0xe4, 0x71, 0x00, 0x79, 0x1c, 0x35, 0x02, 0x3e, 0xe3,
-	0xb0, 0x9b, 0x3d, 0xd8, 0x5a, 0x55, 0xc9, 0x15, 0x76, 0xd5, 0xb6, 0x6c, 0x57, 0x2d, 0xc4, 0xaa,
-	0x12, 0x1b, 0xeb, 0x5f, 0x66, 0xa1, 0xd2, 0xe1, 0x43, 0x18, 0x9e, 0xb3, 0xd7, 0x20, 0xe7, 0x5b,
-	0x93, 0xcb, 0xb6, 0x7b, 0x11, 0xc7, 0xee, 0xc3, 0xa6, 0x61, 0x9a, 0xba, 0x31, 0x99, 0x58, 0xe3,
-	0xd0, 0x32, 0x75, 0xd4, 0x99, 0x62, 0xda, 0x6e, 0x18, 0xa6, 0xd9, 0x14, 0x70, 0x5a, 0xfe, 0x3c,
-	0x2a, 0x11, 0xb9, 0x09, 0x3c, 0x78, 0x9e, 0x8b, 0xa2, 0x12, 0xc2, 0x4b, 0x20, 0x0b, 0x8f, 0xfd,
-	0x20, 0xd2, 0xb8, 0xa6, 0x35, 0x11, 0xf2, 0xa8, 0x9e, 0x36, 0xcb, 0x85, 0x06, 0xe6, 0xf1, 0xa8,
-	0x2b, 0x8b, 0x4e, 0xac, 0x6d, 0xf2, 0x00, 0x77, 0x5e, 0xdb, 0x4c, 0xfb, 0xb0, 0x1d, 0x33, 0xb8,
-	0x3c, 0x9a, 0x51, 0xbc, 0x34, 0x9a, 0x91, 0x0e, 0x93, 0xe0, 0x24, 0x2b, 0xd1, 0x74, 0x4f, 0xc4,
-	0x71, 0xc7, 0x3c, 0x57, 0xff, 0x61, 0x0e, 0x40, 0xb3, 0x66, 0x8e, 0x31, 0xb6, 0xfe, 0xff, 0xe9,
-	0xbd, 0x3b, 0x50, 0xe5, 0xe1, 0x5a, 0x7d, 0xec, 0xb9, 0x66, 0x74, 0x18, 0x83, 0x83, 0x5a, 0x1e,
-	0x09, 0xb0, 0x95, 0xdd, 0x5b, 0xfc, 0xce, 0xdd, 0x5b, 0xfa, 0x0e, 0xdd, 0x5b, 0x5e, 0xee, 0x5e,
-	0xf6, 0x19, 0xbc, 0xea, 0x5b, 0x67, 0xbe, 0x1d, 0x5a, 0xfa, 0xc4, 0xf7, 0xa6, 0x7a, 0x6a, 0x39,
-	0xe3, 0x6c, 0xaf, 0x50, 0x6f, 0xdc, 0x10, 0x44, 0x7b, 0xbe, 0x37, 0x4d, 0x2f, 0x69, 0xf5, 0x2f,
-	0xf3, 0x50, 0x6d, 0xba, 0x86, 0x73, 0xf1, 0x8d, 0x45, 0x07, 0x36, 0x28, 0x52, 0x3f, 0x9b, 0x87,
-	0xbc, 0xdf, 0xf9, 0x86, 0x69, 0x85, 0x20, 0xd4, 0xe3, 0x77, 0xa0, 0xea, 0xcd, 0xc3, 0x18, 0xcf,
-	0xb7, 0x50, 0x81, 0x83, 0x88, 0x20, 0xe6, 0x27, 0xab, 0x31, 0x27, 0xf1, 0x93, 0x07, 0x91, 0xf0,
-	0xc7, 0x56, 0x65, 0xcc, 0x4f, 0x04, 0xb8, 0xc4, 0xed, 0x29, 0xf5, 0x7c, 0x30, 0x9f, 0x5a, 0xbc,
-	0xf7, 0x73, 0xfc, 0x60, 0x5c, 0x4b, 0xc0, 0x30, 0x97, 0xa9, 0x35, 0xf5, 0xfc, 0x0b, 0x9e, 0x4b,
-	0x91, 0xe7, 0xc2, 0x41, 0x94, 0xcb, 0x3b, 0xc0, 0xce, 0x0c, 0x3b, 0xd4, 0xd3, 0x59, 0x71, 0x4b,
-	0x5e, 0x41, 0xcc, 0x50, 0xce, 0xee, 0x1a, 0x14, 0x4d, 0x3b, 0x38, 0xed, 0xf4, 0x85, 0x15, 0x2f,
-	0x52, 0x28, 0xc5, 0x82, 0x47, 0x9d, 0xbe, 0x3e, 0xba, 0x10, 0x7b, 0x9c, 0x39, 0xad, 0x8c, 0x80,
-	0x9d, 0x8b, 0x90, 0x36, 0x5f, 0x08, 0xc9, 0x5b, 0xcb, 0x05, 0x3e, 0xb7, 0xd4, 0xeb, 0x08, 0xef,
-	0x20, 0x98, 0x0b, 0xfc, 0xfb, 0xb0, 0x49, 0x94, 0xa2, 0xe1, 0x9c, 0xb4, 0x4a, 0xa4, 0x1b, 0x88,
-	0xe8, 0x13, 0x9c, 0xd3, 0xde, 0x82, 0x8a, 0x6b, 0x85, 0x67, 0x9e, 0x8f, 0xb5, 0xa9, 0xf1, 0xde,
-	0x8b, 0x01, 0x68, 0x12, 0x04, 0x63, 0xc3, 0xc5, 0xca, 0xd3, 0xbe, 0x27, 0xd6, 0x47, 0xa4, 0xd1,
-	0xa4, 0xe6, 0x8a, 0x86, 0xb0, 0x75, 0xde, 0x25, 0x09, 0x84, 0x7d, 0x02, 0x37, 0x52, 0xbd, 0xa1,
-	0x1b, 0xbe, 0x6f, 0x5c, 0xe8, 0x53, 0xe3, 0x2b, 0xcf, 0xa7, 0xe0, 0x47, 0x4e, 0xbb, 0x26, 0x77,
-	0x72, 0x13, 0xd1, 0x07, 0x88, 0xbd, 0x94, 0xd5, 0x76, 0x3d, 0xbf, 0xa1, 0x5c, 0xc6, 0x8a, 0x58,
-	0x72, 0xd8, 0xa9, 0x83, 0xc8, 0xff, 0x08, 0x68, 0x2b, 0x35, 0xa7, 0x55, 0x09, 0xb6, 0x43, 0x20,
-	0xd5, 0x97, 0x42, 0xe1, 0x87, 0xfe, 0xdc, 0xb5, 0x78, 0xf0, 0x80, 0x3e, 0x4d, 0xb1, 0x93, 0x18,
-	0xa7, 0xd9, 0x2e, 0x5c, 0xe1, 0x8e, 0x84, 0x65, 0xea, 0x52, 0x88, 0x38, 0x7b, 0x79, 0x88, 0x98,
-	0x45, 0xf4, 0x31, 0x38, 0x50, 0x7f, 0x9e, 0x81, 0x9b, 0x7d, 0xda, 0xd5, 0xa4, 0x15, 0x77, 0x60,
-	0x05, 0x81, 0x71, 0x8c, 0x5e, 0xe0, 0xde, 0xfc, 0x9b, 0x6f, 0x2e, 0xd8, 0x3d, 0xd8, 0x38, 0x34,
-	0x7c, 0xcb, 0x0d, 0xe3, 0xf5, 0x28, 0xd4, 0xc6, 0x22, 0x98, 0x3d, 0xa6, 0x30, 0xac, 0xe5, 0x86,
-	0x47, 0xb1, 0x02, 0x16, 0x75, 0x49, 0x07, 0xe6, 0x96, 0xa8, 0xd4, 0xff, 0xfd, 0x0a, 0xe4, 0x7b,
-	0x9e, 0x69, 0xb1, 0xf7, 0xa0, 0x42, 0xc7, 0xe2, 0x96, 0xa3, 0xff, 0x88, 0xa6, 0x3f, 0x64, 0x0b,
-	0x95, 0x5d, 0xf1, 0x75, 0xf9, 0x41, 0xba, 0xd7, 0xc8, 0xaa, 0xa3, 0xed, 0x43, 0x94, 0x70, 0x55,
-	0xe1, 0x67, 0x92, 0xa3, 0xc4, 0x31, 0xd8, 0xb7, 0x14, 0x12, 0xf3, 0x2d, 0x97, 0x6c, 0x87, 0x82,
-	0x16, 0xa7, 0xc9, 0x96, 0xf6, 0x3d, 0x94, 0xc6, 0x3a, 0x9d, 0x25, 0x29, 0xac, 0xb0, 0xa5, 0x39,
-	0x9e, 0x4e, 0x16, 0xbe, 0x07, 0x95, 0xaf, 0x3c, 0xdb, 0xe5, 0x15, 0x2f, 0x2e, 0x55, 0xfc, 0x73,
-	0xcf, 0xe6, 0xdb, 0x16, 0xe5, 0xaf, 0xc4, 0x17, 0x7b, 0x1d, 0x4a, 0x9e, 0xcb, 0xf3, 0x2e, 0x2d,
-	0xe5, 0x5d, 0xf4, 0xdc, 0x2e, 0x3f, 0xa3, 0xb2, 0x3e, 0x9a, 0xdb, 0x8e, 0x89, 0xc2, 0xcc, 0xb1,
-	0x26, 0xa1, 0x88, 0xd2, 0x57, 0x09, 0xd8, 0x77, 0xbb, 0xd6, 0x24, 0x44, 0x17, 0x61, 0x62, 0x3b,
-	0x28, 0xf4, 0x29, 0xb3, 0xca, 0x52, 0x66, 0xc0, 0xd1, 0x94, 0xe1, 0x1b, 0x50, 0x3e, 0xf6, 0xbd,
-	0xf9, 0x0c, 0x6d, 0x7e, 0x58, 0x8e, 0x8b, 0x13, 0x6e, 0xe7, 0x02, 0x5b, 0x4f, 0x9f, 0xb6, 0x7b,
-	0xac, 0x07, 0x56, 0x48, 0xde, 0xf5, 0x42, 0xeb, 0x23, 0xfc, 0xc0, 0xa2, 0x5c, 0x8d, 0xe3, 0x63,
-	0x5d, 0x1c, 0xba, 0x59, 0xca, 0xd5, 0x38, 0x3e, 0xa6, 0xc2, 0x1f, 0xc0, 0xfa, 0x99, 0xed, 0xea,
-	0xc1, 0xcc, 0x1a, 0x73, 0xda, 0xf5, 0xe5, 0x6c, 0xcf, 0x6c, 0x17, 0xfd, 0x03, 0xa2, 0x97, 0x1d,
-	0x94, 0xfa, 0x4b, 0x1d, 0x94, 0x6d, 0x28, 0x38, 0xf6, 0xd4, 0x0e, 0xe9, 0x54, 0xc3, 0x82, 0x05,
-	0x43, 0x08, 0xa6, 0x42, 0x51, 0x04, 0xc1, 0x94, 0x25, 0x12, 0x81, 0x49, 0x2b, 0xc7, 0xcd, 0x97,
-	0x28, 0xc7, 0x7b, 0x50, 0xf2, 0x46, 0x5f, 0xe9, 0xa8, 0xc6, 0xd9, 0x6a, 0x35, 0x5e, 0xf4, 0x46,
-	0x5f, 0x69, 0xd6, 0x84, 0x7d, 0x48, 0x3b, 0x05, 0x96, 0x1b, 0xea, 0x11, 0xc3, 0x95, 0xd5, 0x0c,
-	0x35, 0x4e, 0xd6, 0xe7, 0x6c, 0xef, 0x43, 0xd5, 0x27, 0xcf, 0x59, 0x27, 0x37, 0x7b, 0x4b, 0x76,
-	0x3d, 0x12, 0x97, 0x5a, 0x03, 0x3f, 0x71, 0xaf, 0x5f, 0x87, 0x75, 0x7e, 0xfa, 0x80, 0x6f, 0x37,
-	0x07, 0x14, 0x6c, 0xad, 0x68, 0x35, 0x02, 0xf2, 0xad, 0xe8, 0x80, 0x3d, 0x00, 0x88, 0xb4, 0x7a,
-	0x78, 0x4e, 0x47, 0x9e, 0xe3, 0xaa, 0xf0, 0xdd, 0xd6, 0x56, 0x78, 0xae, 0x55, 0xcc, 0xe8, 0x13,
-	0x45, 0xd7, 0xc8, 0x76, 0x4d, 0x9c, 0x0e, 0xa1, 0x71, 0x1c, 0x34, 0x1a, 0xb4, 0x5a, 0xaa, 0x02,
-	0x36, 0x34, 0x8e, 0x03, 0xf6, 0x01, 0xd4, 0x0c, 0xae, 0x3b, 0xf9, 0xb1, 0xc8, 0x1b, 0xb2, 0x9b,
-	0x28, 0x69, 0x55, 0xad, 0x6a, 0x48, 0x2a, 0xf6, 0x63, 0x60, 0x51, 0x84, 0x9d, 0x4c, 0x6e, 0x3e,
-	0x2f, 0x6e, 0x2e, 0xcd, 0x8b, 0x0d, 0x11, 0x62, 0x8f, 0x8f, 0xf2, 0x7e, 0x0c, 0xeb, 0x69, 0x5b,
-	0xe7, 0xd6, 0x8a, 0x98, 0x32, 0x0d, 0x99, 0x56, 0x1b, 0xcb, 0xd6, 0xcf, 0xeb, 0xb0, 0xee, 0x7a,
-	0xa1, 0x3e, 0x36, 0xc6, 0x27, 0x16, 0x31, 0xf2, 0xb8, 0x69, 0xcd, 0xf5, 0xc2, 0x56, 0x04, 0xc3,
-	0xfe, 0x89, 0x3c, 0x98, 0xf0, 0x9c, 0x42, 0xa6, 0x71, 0xff, 0xc4, 0xe6, 0x2f, 0xaa, 0xf2, 0xc8,
-	0x12, 0xc6, 0x71, 0xe2, 0x96, 0x1d, 0x31, 0xdc, 0x49, 0x8d, 0x53, 0x6c, 0xf2, 0x69, 0xe0, 0x27,
-	0xe6, 0xdf, 0x1d, 0xa8, 0x06, 0xde, 0xdc, 0x1f, 0x5b, 0x7a, 0x10, 0x5a, 0xb3, 0xc6, 0x36, 0xf5,
-	0x28, 0x70, 0xd0, 0x20, 0xb4, 0x66, 0xec, 0x31, 0xd4, 0x67, 0xbe, 0xa5, 0x4b, 0xe3, 0xf4, 0x9a,
-	0xdc, 0xc4, 0x43, 0xdf, 0x4a, 0x86, 0xaa, 0x36, 0x93, 0x52, 0x11, 0xa7, 0xd4, 0x02, 0x75, 0x81,
-	0x33, 0x69, 0x04, 0x72, 0x26, 0x16, 0xfd, 0x4f, 0x60, 0x53, 0xe2, 0x9c, 0x9f, 0x12, 0xf3, 0xeb,
-	0xa9, 0x10, 0x7f, 0x44, 0x7e, 0x74, 0x8a, 0xec, 0xf5, 0x59, 0x2a, 0xcd, 0x9a, 0x0b, 0x0e, 0x17,
-	0xda, 0x5d, 0x77, 0x89, 0xff, 0xfa, 0x25, 0x5e, 0x54, 0xca, 0x13, 0x7b, 0xca, 0x23, 0xbc, 0x9d,
-	0xa0, 0xed, 0x9a, 0x8d, 0x37, 0xf8, 0x79, 0x7b, 0x4a, 0xb0, 0x47, 0x50, 0xa3, 0x30, 0x5e, 0x48,
-	0x67, 0xfd, 0x82, 0xc6, 0x9b, 0x72, 0xc4, 0x89, 0x62, 0xe2, 0x84, 0xd0, 0xaa, 0x4e, 0xfc, 0x1d,
-	0xb0, 0x8f, 0x60, 0x93, 0x07, 0xff, 0x64, 0x01, 0xf9, 0xd6, 0xf2, 0xe4, 0x22, 0xa2, 0xbd, 0x44,
-	0x4a, 0x6a, 0x70, 0xc3, 0x9f, 0xbb, 0xa4, 0xe7, 0x05, 0xe7, 0xcc, 0xf7, 0x46, 0x16, 0xe7, 0xbf,
-	0x47, 0xfc, 0xa2, 0x39, 0x1a, 0x27, 0xe3, 0xbc, 0x24, 0x8f, 0xae, 0xf9, 0x32, 0xe8, 0x10, 0xf9,
-	0x2e, 0xc9, 0x93, 0x4b, 0x76, 0xca, 0xf3, 0xed, 0xef, 0x92, 0xe7, 0x0e, 0xf2, 0x51, 0x9e, 0x0c,
-	0xf2, 0xf3, 0xb9, 0x6d, 0x36, 0xee, 0xf3, 0x53, 0x80, 0xf8, 0xcd, 0xde, 0x80, 0xba, 0x6f, 0x8d,
-	0xe7, 0x7e, 0x60, 0xbf, 0xb0, 0xf4, 0xc0, 0x76, 0x4f, 0x1b, 0x3f, 0xa0, 0x7e, 0x5c, 0x8f, 0xa1,
-	0x03, 0xdb, 0x3d, 0xc5, 0x19, 0x6b, 0x9d, 0x87, 0x96, 0xef, 0xea, 0x68, 0x35, 0x35, 0xde, 0x91,
-	0x67, 0x6c, 0x9b, 0x10, 0x83, 0xb1, 0xe1, 0x6a, 0x60, 0xc5, 0xdf, 0xec, 0xc7, 0xb0, 0x91, 0x58,
-	0xe1, 0x33, 0x34, 0x41, 0x1a, 0x3f, 0x5c, 0xb9, 0xfb, 0x43, 0xe6, 0x89, 0x96, 0x6c, 0x8d, 0x72,
-	0x4b, 0x26, 0x3d, 0xb7, 0x02, 0x3e, 0xb7, 0x1e, 0x7c, 0xab, 0xb9, 0x35, 0xa0, 0xb9, 0xf5, 0x26,
-	0x94, 0x6d, 0x37, 0xb4, 0xfc, 0x17, 0x86, 0xd3, 0x78, 0x77, 0x49, 0x80, 0xc7, 0x38, 0x76, 0x17,
-	0x4a, 0x81, 0x63, 0xa3, 0x60, 0x6a, 0xbc, 0xb7, 0x44, 0x16, 0xa1, 0x50, 0x63, 0x4f, 0x6c, 0xc7,
-	0xe1, 0x1a, 0xfb, 0xfd, 0x25, 0x8d, 0xbd, 0x67, 0x3b, 0x0e, 0xd7, 0xd8, 0x13, 0xf1, 0x85, 0x5a,
-	0x8e, 0x38, 0xb0, 0xfc, 0x87, 0xcb, 0x5a, 0x0e, 0x71, 0xcf, 0xe8, 0x02, 0x4d, 0x35, 0xa0, 0x58,
-	0x17, 0x0f, 0xd9, 0x3d, 0x92, 0x5b, 0x98, 0x0e, 0x82, 0x69, 0x10, 0xc4, 0x69, 0x74, 0x16, 0x44,
-	0xa4, 0x0f, 0x1d, 0x9c, 0x0f, 0xf8, 0xb9, 0x6e, 0x0e, 0x41, 0xef, 0xe6, 0x3d, 0x58, 0x8f, 0x4e,
-	0xb3, 0x60, 0x71, 0x41, 0xe3, 0xc3, 0xa5, 0x1a, 0xa4, 0x09, 0xd8, 0x2e, 0xd4, 0x26, 0x68, 0xc1,
-	0x4d, 0xb9, 0x41, 0xd7, 0xf8, 0x88, 0x2a, 0xb2, 0x1d, 0x69, 0xd0, 0xcb, 0x0c, 0x3e, 0x2d, 0xc5,
-	0xc5, 0x1e, 0x00, 0xb3, 0x27, 0x7c, 0x14, 0xd0, 0x63, 0xe2, 0x46, 0x5b, 0xe3, 0x63, 0x9a, 0x52,
-	0x2b, 0x30, 0xec, 0x11, 0xac, 0x07, 0x96, 0x6b, 0xea, 0xd3, 0x40, 0xd8, 0x03, 0x8f, 0xa9, 0x9e,
-	0x42, 0x78, 0xc6, 0xd7, 0xc7, 0xb4, 0x2a, 0x52, 0x1d, 0x04, 0xdc, 0x30, 0x78, 0x04, 0x38, 0x3b,
-	0x5f, 0x24, 0x4c, 0x9f, 0x5c, 0xc2, 0x84, 0x54, 0x12, 0x13, 0x4e, 0x5d, 0x3d, 0x70, 0x8d, 0x59,
-	0x70, 0xe2, 0x85, 0x8d, 0x4f, 0x65, 0x6d, 0x3d, 0x10, 0x50, 0xad, 0x86, 0x44, 0x51, 0x4a, 0xfd,
-	0x45, 0x01, 0xca, 0x91, 0x15, 0xc9, 0xaa, 0x50, 0x3a, 0xea, 0x3d, 0xed, 0xf5, 0x9f, 0xf7, 0x94,
-	0x35, 0x56, 0x07, 0xa0, 0xb3, 0xde, 0xfa, 0xa0, 0xd5, 0xec, 0xf1, 0xbb, 0x11, 0x74, 0xc2, 0x9c,
-	0xa7, 0xb3, 0x6c, 0x13, 0xd6, 0xf7, 0x8e, 0x7a, 0x74, 0xf4, 0x87, 0x83, 0x72, 0x08, 0x6a, 0x7f,
-	0xc1, 0x23, 0xbf, 0x1c, 0x94, 0x47, 0xd0, 0x41, 0x73, 0xd8, 0xd6, 0x3a, 0x11, 0xa8, 0x40, 0xa7,
-	0x88, 0xfa, 0x47, 0x5a, 0x4b, 0xe4, 0x54, 0xc4, 0x62, 0x0f, 0xb5, 0xfe, 0xe7, 0xed, 0xd6, 0x50,
-	0x01, 0x76, 0x15, 0x36, 0xe3, 0x3c, 0xa2, 0xfc, 0x95, 0x2a, 0xab, 0x41, 0x39, 0xca, 0x47, 0xd9,
-	0xc2, 0x5c, 0xb5, 0x76, 0xeb, 0x48, 0x1b, 0x74, 0x9e, 0xb5, 0xf5, 0xd6, 0xb0, 0xad, 0x5c, 0x65,
-	0x65, 0xc8, 0x0f, 0x3a, 0xbd, 0xa7, 0xca, 0x35, 0xb6, 0x0e, 0x15, 0xfc, 0xe2, 0xb9, 0x5f, 0x67,
-	0x0c, 0xea, 0x09, 0x2d, 0xc1, 0x1a, 0x14, 0x94, 0x7e, 0xf2, 0x44, 0xb9, 0x8d, 0xd9, 0xee, 0x76,
-	0x06, 0xc3, 0x4e, 0xaf, 0x35, 0x54, 0xee, 0x30, 0x80, 0xe2, 0x5e, 0xa7, 0x3b, 0x6c, 0x6b, 0xca,
-	0x36, 0xe6, 0xf7, 0x79, 0xbf, 0xd3, 0x53, 0x5e, 0xa3, 0x13, 0xf4, 0xcd, 0x83, 0xc3, 0x6e, 0x5b,
-	0x51, 0xa9, 0x94, 0xbe, 0x36, 0x54, 0x5e, 0x67, 0x15, 0x28, 0x1c, 0xf5, 0xb0, 0x6e, 0x77, 0xb1,
-	0x40, 0xfa, 0xd4, 0x9b, 0xdd, 0xae, 0xf2, 0x86, 0x14, 0xbd, 0x7e, 0x13, 0xbf, 0x9f, 0x77, 0x7a,
-	0xbb, 0xfd, 0xe7, 0xca, 0x5b, 0x48, 0xb6, 0xa3, 0xf5, 0x9b, 0xbb, 0xad, 0xe6, 0x60, 0xa8, 0xdc,
-	0xc3, 0x0c, 0x06, 0x87, 0xdd, 0xce, 0x50, 0x79, 0x1b, 0xa9, 0x9e, 0x34, 0x87, 0xfb, 0x6d, 0x4d,
-	0xb9, 0x8f, 0xdf, 0xcd, 0xc1, 0xa0, 0xad, 0x0d, 0x95, 0x87, 0xf8, 0xdd, 0xe9, 0xd1, 0xf7, 0x23,
-	0xfc, 0xde, 0x6d, 0x77, 0xdb, 0xc3, 0xb6, 0xf2, 0x01, 0x76, 0x98, 0xd6, 0x3e, 0xec, 0x36, 0x5b,
-	0x6d, 0xe5, 0x43, 0x4c, 0x74, 0xfb, 0xad, 0xa7, 0x7a, 0xff, 0x50, 0xf9, 0x08, 0xcb, 0xa0, 0xd8,
-	0xfb, 0x00, 0x3b, 0xf3, 0x63, 0xec, 0xa7, 0x38, 0x49, 0xb5, 0x7b, 0x8c, 0xc5, 0x1e, 0x74, 0x7a,
-	0x47, 0x03, 0xe5, 0x13, 0x24, 0xa6, 0x4f, 0xc2, 0x7c, 0xca, 0xb6, 0x40, 0xe9, 0xf7, 0xf4, 0xdd,
-	0xa3, 0xc3, 0x6e, 0xa7, 0xd5, 0x1c, 0xb6, 0xf5, 0xa7, 0xed, 0x2f, 0x95, 0xdf, 0xc2, 0x61, 0x3f,
-	0xd4, 0xda, 0xba, 0xa8, 0xc7, 0x8f, 0xa2, 0xb4, 0xa8, 0xcb, 0x8f, 0xb1, 0x88, 0x04, 0xaf, 0x1f,
-	0x3d, 0x55, 0x7e, 0x7b, 0x01, 0x34, 0x78, 0xaa, 0xfc, 0x04, 0xc7, 0x7c, 0xd8, 0x39, 0x68, 0xeb,
-	0xa2, 0x33, 0x3e, 0xc3, 0x8e, 0xdc, 0xeb, 0x74, 0xbb, 0x4a, 0x93, 0x02, 0xad, 0x4d, 0x6d, 0xd8,
-	0xa1, 0x81, 0xde, 0x61, 0x0a, 0xd4, 0xf6, 0x8e, 0x7e, 0xf6, 0xb3, 0x2f, 0x75, 0x31, 0x12, 0x2d,
-	0xf5, 0x77, 0xa1, 0x1c, 0xb9, 0x0b, 0x58, 0xfb, 0x4e, 0xaf, 0xd7, 0xd6, 0x94, 0x35, 0xcc, 0xa1,
-	0xdb, 0xde, 0x1b, 0x2a, 0x19, 0x0a, 0x26, 0x77, 0x9e, 0xec, 0x0f, 0x95, 0x2c, 0x7e, 0xf6, 0x8f,
-	0x90, 0x2d, 0x47, 0x43, 0xd5, 0x3e, 0xe8, 0x28, 0x79, 0xfc, 0x6a, 0xf6, 0x86, 0x1d, 0xa5, 0x40,
-	0x43, 0xd9, 0xe9, 0x3d, 0xe9, 0xb6, 0x95, 0x22, 0x42, 0x0f, 0x9a, 0xda, 0x53, 0xa5, 0x84, 0x4c,
-	0xcd, 0xc3, 0xc3, 0xee, 0x97, 0x4a, 0x99, 0xe7, 0xbf, 0xdb, 0xfe, 0x42, 0xa9, 0xb0, 0x22, 0x64,
-	0xbb, 0x0f, 0x15, 0x50, 0xef, 0x41, 0xa9, 0x79, 0x7c, 0x7c, 0x80, 0xde, 0x18, 0x56, 0xfa, 0xa8,
-	0xdb, 0xe5, 0x97, 0x86, 0x76, 0xfa, 0xc3, 0x61, 0xff, 0x40, 0xc9, 0xe0, 0x64, 0x1a, 0xf6, 0x0f,
-	0x95, 0xac, 0xda, 0x81, 0x72, 0x24, 0x25, 0xa5, 0x8b, 0x1a, 0x65, 0xc8, 0x1f, 0x6a, 0xed, 0x67,
-	0x7c, 0x07, 0xa4, 0xd7, 0xfe, 0x02, 0xab, 0x89, 0x5f, 0x98, 0x51, 0x0e, 0x0b, 0xe4, 0x37, 0x2a,
-	0xe8, 0xa6, 0x46, 0xb7, 0xd3, 0x6b, 0x37, 0x35, 0xa5, 0xa0, 0xfe, 0x75, 0x28, 0x47, 0x4b, 0x94,
-	0xdd, 0x85, 0xec, 0x70, 0x20, 0xc2, 0x62, 0x5b, 0x0f, 0x92, 0xdb, 0xb3, 0xc3, 0xe8, 0x4b, 0xcb,
-	0x0e, 0x07, 0xec, 0x1d, 0x28, 0xf2, 0xbb, 0x33, 0x22, 0x60, 0xb9, 0x95, 0x5e, 0xf6, 0x43, 0xc2,
-	0x69, 0x82, 0x46, 0xed, 0x42, 0x3d, 0x8d, 0x61, 0xb7, 0x01, 0x38, 0x4e, 0xf2, 0x68, 0x25, 0x08,
-	0xfa, 0x86, 0xe2, 0x6e, 0xce, 0xae, 0x38, 0xab, 0x13, 0xa7, 0xd5, 0xbf, 0xcc, 0x02, 0x24, 0x3a,
-	0x12, 0xb5, 0x70, 0xec, 0xaf, 0x16, 0x44, 0x98, 0x5e, 0x3e, 0x9f, 0x5f, 0xe1, 0xdb, 0x60, 0xec,
-	0x1a, 0x14, 0x27, 0x9e, 0x3f, 0x35, 0xc2, 0xe8, 0x66, 0x0e, 0x4f, 0xa1, 0x45, 0xca, 0xa3, 0xc3,
-	0x68, 0x0c, 0xb8, 0x16, 0x3f, 0x45, 0x96, 0xd7, 0x6a, 0x02, 0xd8, 0x45, 0x18, 0x9a, 0x8b, 0x96,
-	0x3b, 0x76, 0xbc, 0xc0, 0x32, 0xd1, 0x1d, 0x2a, 0x90, 0xc6, 0x87, 0x08, 0xb4, 0x73, 0xc1, 0x1b,
-	0xe4, 0x4f, 0x6d, 0xd7, 0x08, 0x2d, 0x53, 0x1c, 0x65, 0x91, 0x20, 0xec, 0x15, 0xa8, 0x7c, 0x15,
-	0x78, 0xc2, 0x43, 0xe5, 0x07, 0x78, 0xca, 0x08, 0xa0, 0xe1, 0x7b, 0x15, 0xc0, 0x0a, 0xc6, 0xc6,
-	0x8c, 0x67, 0x5e, 0xa6, 0xcc, 0x2b, 0x02, 0xb2, 0x73, 0xc1, 0xba, 0x50, 0x1f, 0x8e, 0x5a, 0x9e,
-	0x33, 0xf4, 0xd0, 0xc5, 0x68, 0x79, 0x8e, 0xf0, 0x32, 0xef, 0x2e, 0xda, 0x0b, 0x0f, 0xd2, 0x64,
-	0x3c, 0x22, 0xbe, 0xc0, 0x7b, 0xb3, 0x09, 0x57, 0x56, 0x90, 0x7d, 0xa7, 0xbd, 0xfe, 0x7f, 0x96,
-	0x03, 0x48, 0x8c, 0xbe, 0x54, 0x98, 0x3c, 0x93, 0x0e, 0x93, 0x3f, 0x84, 0x6b, 0xe2, 0x38, 0xbc,
-	0x38, 0x42, 0x7d, 0xae, 0xdb, 0xae, 0x3e, 0x32, 0xa2, 0x1d, 0x09, 0x26, 0xb0, 0x7c, 0xe7, 0xbd,
-	0xe3, 0xee, 0x18, 0x21, 0x7b, 0x0c, 0x1b, 0x32, 0x4f, 0x78, 0x31, 0x4b, 0xef, 0xa8, 0x48, 0xb7,
-	0x0b, 0xd6, 0x13, 0xf6, 0xe1, 0xc5, 0x8c, 0xbd, 0x07, 0x57, 0x7d, 0x6b, 0xe2, 0x5b, 0xc1, 0x89,
-	0x1e, 0x06, 0x72, 0x61, 0x7c, 0x9b, 0x7f, 0x53, 0x20, 0x87, 0x41, 0x5c, 0xd6, 0x7b, 0x70, 0x55,
-	0x98, 0x83, 0x0b, 0xd5, 0xe3, 0x57, 0xf9, 0x36, 0x39, 0x52, 0xae, 0xdd, 0xab, 0x00, 0xc2, 0x12,
-	0x8e, 0x2e, 0x70, 0x97, 0xb5, 0x0a, 0xb7, 0x7a, 0xd1, 0x75, 0x79, 0x07, 0x98, 0x1d, 0xe8, 0x0b,
-	0x21, 0x56, 0xb1, 0xef, 0xa0, 0xd8, 0xc1, 0x61, 0x2a, 0xbc, 0x7a, 0x59, 0xf4, 0xb6, 0x7c, 0x59,
-	0xf4, 0x76, 0x0b, 0x0a, 0x64, 0x2c, 0x8b, 0x60, 0x2a, 0x4f, 0x30, 0x15, 0xf2, 0x28, 0x30, 0x28,
-	0xe6, 0x57, 0x7f, 0x58, 0x7f, 0x40, 0xd7, 0xdf, 0x71, 0x7c, 0x10, 0xaa, 0x11, 0x4e, 0xfd, 0x83,
-	0x0c, 0xd4, 0xd3, 0x06, 0x1e, 0x3f, 0xca, 0x96, 0x9c, 0xd1, 0x2b, 0x24, 0xe7, 0xf2, 0x5e, 0x81,
-	0xca, 0xec, 0x54, 0x1c, 0xc8, 0x8b, 0x36, 0x80, 0x67, 0xa7, 0xfc, 0x20, 0x1e, 0x7b, 0x1b, 0x4a,
-	0xb3, 0x53, 0x3e, 0x8f, 0x2f, 0x1b, 0x96, 0xe2, 0x8c, 0x9f, 0x91, 0x79, 0x1b, 0x4a, 0x73, 0x41,
-	0x9a, 0xbf, 0x8c, 0x74, 0x4e, 0xa4, 0xea, 0x36, 0xd4, 0x64, 0x97, 0x0a, 0xa7, 0x23, 0x1a, 0x62,
-	0xbc, 0x62, 0xf8, 0x89, 0x2d, 0xa8, 0xc9, 0xbe, 0xd3, 0xb7, 0x89, 0xe0, 0xa7, 0xc2, 0x09, 0xd9,
-	0x97, 0x84, 0x13, 0xb6, 0x69, 0xa7, 0x5f, 0xa7, 0x23, 0x3b, 0x63, 0x2f, 0xba, 0xde, 0x0b, 0x27,
-	0x46, 0xd0, 0x9c, 0x87, 0x5e, 0xcb, 0x73, 0xc4, 0x5e, 0x92, 0x38, 0x03, 0x9d, 0x8f, 0xc2, 0x81,
-	0xe2, 0x90, 0xf3, 0xef, 0x65, 0x60, 0x73, 0xc9, 0x77, 0xc0, 0x76, 0x24, 0x77, 0xf4, 0xf1, 0x13,
-	0x9d, 0xf9, 0xa9, 0x11, 0x8e, 0x4f, 0xf4, 0x99, 0x6f, 0x4d, 0xec, 0xf3, 0xe8, 0xa1, 0x01, 0x82,
-	0x1d, 0x12, 0x88, 0x36, 0xd6, 0x66, 0x33, 0xf2, 0x98, 0xa6, 0x76, 0x28, 0x2e, 0xd4, 0x02, 0x81,
-	0xba, 0x14, 0x4a, 0x89, 0x36, 0xdd, 0xf3, 0x97, 0x9c, 0x11, 0xb8, 0x05, 0xc5, 0x4e, 0xec, 0xa3,
-	0xc4, 0x77, 0x6e, 0x73, 0xe2, 0x9e, 0xad, 0x07, 0x95, 0x16, 0xdd, 0xd9, 0x3d, 0x30, 0x66, 0xec,
-	0x3e, 0xe4, 0xa6, 0xc6, 0x4c, 0x1c, 0x07, 0x68, 0xc4, 0x91, 0x42, 0x8e, 0x7d, 0x70, 0x60, 0xcc,
-	0xb8, 0x14, 0x41, 0xa2, 0x9b, 0x1f, 0x41, 0x39, 0x02, 0x7c, 0x27, 0x79, 0xf1, 0xdf, 0x72, 0x50,
-	0xd9, 0x95, 0xa3, 0x19, 0x68, 0x38, 0x86, 0xfe, 0xdc, 0x45, 0xa7, 0x53, 0xc4, 0x55, 0xab, 0x63,
-	0xc3, 0x1d, 0x0a, 0x50, 0x34, 0xb4, 0xd9, 0x5f, 0x31, 0xb4, 0xb7, 0x00, 0x7c, 0xb2, 0xd6, 0xc9,
-	0x60, 0xcf, 0xc5, 0xa7, 0x14, 0x3a, 0x26, 0xda, 0xeb, 0x2b, 0xb7, 0x6e, 0xf2, 0xdf, 0x7e, 0xeb,
-	0xa6, 0xb0, 0x72, 0xeb, 0xe6, 0xff, 0x99, 0xcd, 0x96, 0x37, 0x13, 0x11, 0x79, 0x6a, 0x5d, 0x10,
-	0x59, 0x85, 0x9f, 0x64, 0x9a, 0xc5, 0x87, 0x1a, 0x91, 0xee, 0x53, 0xa8, 0x47, 0xdd, 0x2c, 0x1a,
-	0x06, 0xa9, 0x73, 0x94, 0x02, 0xc7, 0x03, 0x35, 0xeb, 0xa1, 0x9c, 0x4c, 0xaf, 0x9d, 0xea, 0xaf,
-	0x5e, 0x3b, 0xea, 0xff, 0xca, 0x42, 0xe1, 0xa7, 0x73, 0xcb, 0xbf, 0x60, 0x1f, 0x41, 0x25, 0x08,
-	0xa7, 0xa1, 0x1c, 0x43, 0xbe, 0xc1, 0xd9, 0x08, 0x4f, 0x21, 0x60, 0x6b, 0x6a, 0xb9, 0x21, 0x77,
-	0xef, 0x90, 0x96, 0x64, 0xc5, 0x16, 0x14, 0x82, 0xd0, 0x9a, 0xf1, 0x98, 0x75, 0x41, 0xe3, 0x09,
-	0xb6, 0x0d, 0x05, 0xd7, 0x33, 0xad, 0x20, 0xbd, 0x23, 0x8d, 0x2e, 0x84, 0xc6, 0x11, 0x4c, 0x85,
-	0xa2, 0xb8, 0x72, 0x91, 0x5f, 0x8e, 0xe3, 0x72, 0x0c, 0x1d, 0x16, 0xb3, 0x0c, 0xf4, 0x3b, 0xa3,
-	0x9b, 0x35, 0x71, 0x1a, 0xa5, 0xa0, 0xe3, 0x19, 0xe6, 0xd0, 0x38, 0x8e, 0xae, 0xa6, 0x89, 0x24,
-	0xdb, 0x86, 0x2a, 0x7e, 0x3e, 0xf7, 0xed, 0xd0, 0x1a, 0x3c, 0x12, 0x32, 0x5c, 0x06, 0xa1, 0xd2,
-	0x37, 0xad, 0xd0, 0x1a, 0x87, 0x83, 0xaf, 0x1d, 0x2e, 0xb5, 0x69, 0x73, 0x2e, 0x82, 0xa8, 0x26,
-	0xac, 0xa7, 0x9a, 0x9b, 0x76, 0x79, 0xd0, 0x3c, 0x6c, 0x77, 0xd1, 0x74, 0xce, 0x48, 0xb6, 0x77,
-	0x56, 0xb6, 0xb7, 0x73, 0x92, 0x21, 0x4e, 0x26, 0xdb, 0xd1, 0xe1, 0x6e, 0x73, 0xd8, 0x56, 0x0a,
-	0x64, 0x58, 0xb7, 0xb5, 0x27, 0x6d, 0xa5, 0xa8, 0xfe, 0x61, 0x16, 0x36, 0x87, 0xbe, 0xe1, 0x06,
-	0x06, 0x3f, 0x2e, 0xed, 0x86, 0xbe, 0xe7, 0xb0, 0x4f, 0xa1, 0x1c, 0x8e, 0x1d, 0x79, 0x18, 0xee,
-	0x44, 0x83, 0xbe, 0x40, 0xfa, 0x60, 0x38, 0xe6, 0xbe, 0x76, 0x29, 0xe4, 0x1f, 0xec, 0x87, 0x50,
-	0x18, 0x59, 0xc7, 0xb6, 0x2b, 0x16, 0xe0, 0xd5, 0x45, 0xc6, 0x1d, 0x44, 0xee, 0xaf, 0x69, 0x9c,
-	0x8a, 0xbd, 0x07, 0xc5, 0xb1, 0x37, 0x8d, 0x24, 0x55, 0x72, 0xb2, 0x53, 0x2a, 0x08, 0xb1, 0xfb,
-	0x6b, 0x9a, 0xa0, 0x63, 0x1f, 0x41, 0xd9, 0xf7, 0x1c, 0x67, 0x64, 0x8c, 0x4f, 0x85, 0x0c, 0x6b,
-	0x2c, 0xf2, 0x68, 0x02, 0xbf, 0xbf, 0xa6, 0xc5, 0xb4, 0xea, 0x03, 0x28, 0x89, 0xca, 0x62, 0x07,
-	0xec, 0xb4, 0x9f, 0x74, 0x44, 0x47, 0xb6, 0xfa, 0x07, 0x07, 0x9d, 0x21, 0xbf, 0x42, 0xa2, 0xf5,
-	0xbb, 0xdd, 0x9d, 0x66, 0xeb, 0xa9, 0x92, 0xdd, 0x29, 0x43, 0xd1, 0xa0, 0xd3, 0x88, 0xea, 0xdf,
-	0xce, 0xc0, 0xc6, 0x42, 0x03, 0xd8, 0x63, 0xc8, 0x4f, 0x51, 0x6f, 0xf2, 0xee, 0xb9, 0xbb, 0xb2,
-	0x95, 0x52, 0x9a, 0x6b, 0x53, 0xe4, 0x50, 0x3f, 0x81, 0x7a, 0x1a, 0x2e, 0x59, 0xe0, 0xeb, 0x50,
-	0xd1, 0xda, 0xcd, 0x5d, 0xbd, 0xdf, 0xeb, 0x7e, 0xc9, 0x1d, 0x59, 0x4a, 0x3e, 0xd7, 0x3a, 0xc3,
-	0xb6, 0x92, 0x55, 0x7f, 0x07, 0x94, 0xc5, 0x8e, 0x61, 0x4f, 0x60, 0x63, 0xec, 0x4d, 0x67, 0x8e,
-	0xc5, 0x05, 0x45, 0x32, 0x64, 0xb7, 0x57, 0xf4, 0xa4, 0x20, 0xa3, 0x11, 0xab, 0x8f, 0x53, 0x69,
-	0xf5, 0xaf, 0x01, 0x5b, 0xee, 0xc1, 0xdf, 0x5c, 0xf6, 0xff, 0x33, 0x03, 0xf9, 0x43, 0xc7, 0x70,
-	0xd9, 0xeb, 0x50, 0xa0, 0xfb, 0xc3, 0x42, 0xf9, 0x56, 0xa5, 0x05, 0x8e, 0xd3, 0x82, 0x70, 0xec,
-	0x07, 0x90, 0x0b, 0xc7, 0xd1, 0x75, 0x99, 0xeb, 0x97, 0x4c, 0xbe, 0xfd, 0x35, 0x0d, 0xa9, 0xd8,
-	0x3d, 0xc8, 0x99, 0x66, 0x74, 0x74, 0x46, 0x78, 0x13, 0x68, 0x9f, 0xee, 0x5a, 0x13, 0xdb, 0xb5,
-	0xc5, 0x7d, 0x67, 0x24, 0x61, 0x6f, 0x40, 0xce, 0x1c, 0x3b, 0xe9, 0x73, 0x50, 0xdc, 0x92, 0x8d,
-	0x33, 0x34, 0xc7, 0x0e, 0x53, 0x61, 0x3d, 0xf4, 0x2f, 0x74, 0x7f, 0xee, 0xd2, 0xd6, 0x6b, 0x20,
-	0x2c, 0xba, 0x2a, 0x2a, 0xb3, 0x39, 0xed, 0x53, 0x06, 0xe2, 0xd8, 0xed, 0xcc, 0xb7, 0x66, 0x86,
-	0x1f, 0xdb, 0x72, 0x76, 0x70, 0xc8, 0x01, 0x3b, 0x45, 0xa0, 0xe7, 0x87, 0xd4, 0x77, 0xe8, 0x2e,
-	0x2d, 0xda, 0x3e, 0x6a, 0xf4, 0xb5, 0xe2, 0x56, 0x83, 0xc0, 0xa8, 0x7f, 0x96, 0x83, 0xaa, 0x54,
-	0x1f, 0xf6, 0x01, 0x94, 0xcd, 0xf4, 0x42, 0xbc, 0xb1, 0x54, 0xe9, 0x07, 0xbb, 0xd1, 0x12, 0x34,
-	0xc5, 0xf4, 0xfe, 0x04, 0xd6, 0x03, 0x2b, 0xd4, 0x5f, 0x18, 0xbe, 0xcd, 0x2f, 0xfe, 0x67, 0xe5,
-	0x18, 0xf2, 0xc0, 0x0a, 0x9f, 0x45, 0x98, 0xfd, 0x35, 0xad, 0x16, 0x48, 0x69, 0x32, 0xd0, 0x44,
-	0x93, 0x72, 0xa9, 0xc7, 0x11, 0x38, 0x70, 0x7f, 0x4d, 0x8b, 0xf0, 0x48, 0x6a, 0x9d, 0x5b, 0xe3,
-	0x79, 0x18, 0x19, 0x68, 0xeb, 0x51, 0x83, 0x08, 0x48, 0xef, 0xb0, 0xf0, 0x4f, 0xf6, 0x10, 0x65,
-	0x9d, 0xe1, 0x38, 0x1e, 0xe9, 0xec, 0x82, 0x1c, 0xb0, 0xdc, 0x8d, 0xe1, 0xfc, 0xdd, 0x97, 0x28,
-	0xc5, 0xde, 0x84, 0x82, 0x17, 0x9e, 0x58, 0xbe, 0xb8, 0xb0, 0x18, 0xdd, 0xca, 0x45, 0xd0, 0x6e,
-	0xab, 0x8b, 0x33, 0x85, 0xd0, 0xea, 0x2f, 0x32, 0x50, 0x12, 0x3d, 0x80, 0xee, 0xfc, 0xa0, 0x3d,
-	0xd4, 0x9f, 0x35, 0xb5, 0x4e, 0x73, 0xa7, 0xdb, 0x16, 0xc7, 0xb7, 0x9e, 0x68, 0xcd, 0x9e, 0x90,
-	0x93, 0x5a, 0xfb, 0x59, 0xff, 0x69, 0x9b, 0xbb, 0xb5, 0xbb, 0xed, 0xde, 0x97, 0x4a, 0x8e, 0x87,
-	0x74, 0xda, 0x87, 0x4d, 0x0d, 0xa5, 0x64, 0x15, 0x4a, 0xed, 0x2f, 0xda, 0xad, 0x23, 0x12, 0x93,
-	0x75, 0x80, 0xdd, 0x76, 0xb3, 0xdb, 0xed, 0xb7, 0x50, 0x6c, 0x16, 0x19, 0x83, 0x7a, 0x4b, 0x6b,
-	0x37, 0x87, 0x6d, 0xbd, 0xd9, 0x6a, 0xf5, 0x8f, 0x7a, 0x43, 0xa5, 0x84, 0x25, 0x36, 0xd1, 0xf9,
-	0x8f, 0x41, 0xf4, 0x74, 0xc1, 0xae, 0xd6, 0x3f, 0x8c, 0x21, 0x95, 0x9d, 0x0a, 0x1a, 0xcb, 0x34,
-	0x56, 0xea, 0x1f, 0xd4, 0xa1, 0x9e, 0x9e, 0x9a, 0xec, 0x63, 0x28, 0x9b, 0x66, 0x6a, 0x8c, 0x6f,
-	0xad, 0x9a, 0xc2, 0x0f, 0x76, 0xcd, 0x68, 0x98, 0xf9, 0x07, 0x7b, 0x2d, 0x5a, 0x48, 0xd9, 0xa5,
-	0x85, 0x14, 0x2d, 0xa3, 0x9f, 0xc0, 0x86, 0xb8, 0xd6, 0x8a, 0x6e, 0xec, 0xc8, 0x08, 0xac, 0xf4,
-	0x2a, 0x69, 0x11, 0x72, 0x57, 0xe0, 0xf6, 0xd7, 0xb4, 0xfa, 0x38, 0x05, 0x61, 0x3f, 0x82, 0xba,
-	0x41, 0x2e, 0x4e, 0xcc, 0x9f, 0x97, 0x8d, 0x80, 0x26, 0xe2, 0x24, 0xf6, 0x75, 0x43, 0x06, 0xe0,
-	0x44, 0x34, 0x7d, 0x6f, 0x96, 0x30, 0x17, 0xe4, 0x89, 0xb8, 0xeb, 0x7b, 0x33, 0x89, 0xb7, 0x66,
-	0x4a, 0x69, 0xf6, 0x11, 0xd4, 0x44, 0xcd, 0x13, 0x67, 0x29, 0x5e, 0xb2, 0xbc, 0xda, 0x64, 0x4a,
-	0xec, 0xaf, 0x69, 0xd5, 0x71, 0x92, 0x64, 0x8f, 0xa0, 0xca, 0x2b, 0x9c, 0x38, 0x4f, 0xf1, 0x5c,
-	0xa3, 0xda, 0x46, 0x5c, 0x60, 0xc4, 0x29, 0xf6, 0x1e, 0x00, 0xd5, 0x93, 0xf3, 0x94, 0x53, 0x7b,
-	0x6a, 0xbe, 0x37, 0x8b, 0x58, 0x2a, 0x66, 0x94, 0x90, 0xaa, 0xc7, 0x2f, 0x18, 0x54, 0x96, 0xab,
-	0x47, 0x67, 0xe1, 0x93, 0xea, 0xf1, 0x0b, 0x05, 0x71, 0xf5, 0x38, 0x1b, 0x2c, 0x55, 0x2f, 0xe2,
-	0xe2, 0xd5, 0xe3, 0x4c, 0x51, 0xf5, 0x38, 0x4f, 0x75, 0xb1, 0x7a, 0x11, 0x0b, 0x55, 0x8f, 0x73,
-	0xfc, 0x68, 0xc9, 0x76, 0xab, 0x5d, 0x6a, 0xbb, 0xe1, 0xb0, 0xa5, 0xad, 0xb7, 0x1f, 0x41, 0x3d,
-	0x38, 0xf1, 0xce, 0x24, 0x01, 0xb2, 0x2e, 0x73, 0x0f, 0x4e, 0xbc, 0x33, 0x59, 0x82, 0xac, 0x07,
-	0x32, 0x00, 0x6b, 0xcb, 0x9b, 0x48, 0x57, 0x88, 0xea, 0x72, 0x6d, 0xa9, 0x85, 0xcf, 0x6c, 0xeb,
-	0x0c, 0x6b, 0x6b, 0x44, 0x09, 0xec, 0x94, 0xc4, 0x2d, 0x0e, 0xc4, 0x26, 0x70, 0x6a, 0x7f, 0x48,
-	0x94, 0x04, 0xb1, 0xaf, 0x1c, 0xe0, 0xdc, 0x9a, 0xbb, 0x32, 0x9b, 0x22, 0xcf, 0xad, 0x23, 0x37,
-	0xc5, 0x58, 0xe3, 0xa4, 0x82, 0x35, 0x59, 0x15, 0x81, 0xf5, 0xf5, 0xdc, 0x72, 0xc7, 0x96, 0xd8,
-	0x2e, 0x4e, 0xad, 0x8a, 0x81, 0xc0, 0x25, 0xab, 0x22, 0x82, 0xc4, 0xf3, 0x3a, 0x66, 0x67, 0x8b,
-	0xf3, 0x5a, 0x62, 0xa6, 0x79, 0x1d, 0xb3, 0xc6, 0x0b, 0x2a, 0xe6, 0xbd, 0xb2, 0xb4, 0xa0, 0x24,
-	0x66, 0xbe, 0xa0, 0x62, 0xee, 0x47, 0x20, 0x66, 0x13, 0xef, 0xdc, 0xd4, 0xa6, 0x32, 0xaf, 0xb5,
-	0xe8, 0x5d, 0x18, 0xc7, 0x29, 0xf5, 0x1f, 0x14, 0xa0, 0x24, 0x84, 0x07, 0xbb, 0x02, 0x1b, 0x42,
-	0x86, 0xed, 0x36, 0x87, 0xcd, 0x9d, 0xe6, 0x00, 0xad, 0x0e, 0x06, 0x75, 0x2e, 0xc4, 0x62, 0x58,
-	0x06, 0x05, 0x1b, 0x49, 0xb1, 0x18, 0x94, 0x45, 0xc1, 0x26, 0x78, 0xf9, 0xfb, 0x2d, 0x39, 0xb6,
-	0x01, 0x55, 0xce, 0xc8, 0x01, 0x74, 0x3c, 0x9a, 0xb8, 0x78, 0xba, 0x20, 0xb1, 0xf0, 0x58, 0x65,
-	0x31, 0x61, 0xe1, 0x80, 0x52, 0xcc, 0xc2, 0xd3, 0x65, 0xac, 0xcc, 0x50, 0x3b, 0xea, 0xb5, 0x92,
-	0x72, 0x2a, 0x74, 0xa4, 0x95, 0x67, 0xf3, 0xac, 0xd3, 0x7e, 0xae, 0x00, 0x32, 0xf1, 0x5c, 0x28,
-	0x5d, 0x45, 0xbb, 0x89, 0x32, 0xa1, 0x64, 0x8d, 0x5d, 0x87, 0x2b, 0x83, 0xfd, 0xfe, 0x73, 0x9d,
-	0x33, 0xc5, 0x4d, 0x58, 0x67, 0x5b, 0xa0, 0x48, 0x08, 0x9e, 0x7d, 0x1d, 0x8b, 0x24, 0x68, 0x44,
-	0x38, 0x50, 0x36, 0x28, 0xf4, 0x8f, 0xb0, 0x21, 0x57, 0x24, 0x0a, 0x36, 0x85, 0xb3, 0xf6, 0xbb,
-	0x47, 0x07, 0xbd, 0x81, 0xb2, 0x89, 0x95, 0x20, 0x08, 0xaf, 0x39, 0x8b, 0xb3, 0x49, 0xd4, 0xcf,
-	0x15, 0xd2, 0x48, 0x08, 0x7b, 0xde, 0xd4, 0x7a, 0x9d, 0xde, 0x93, 0x81, 0xb2, 0x15, 0xe7, 0xdc,
-	0xd6, 0xb4, 0xbe, 0x36, 0x50, 0xae, 0xc6, 0x80, 0xc1, 0xb0, 0x39, 0x3c, 0x1a, 0x28, 0xd7, 0xe2,
-	0x5a, 0x1e, 0x6a, 0xfd, 0x56, 0x7b, 0x30, 0xe8, 0x76, 0x06, 0x43, 0xe5, 0x3a, 0xbb, 0x0a, 0x9b,
-	0x49, 0x8d, 0x22, 0xe2, 0x86, 0x54, 0x51, 0xed, 0x49, 0x7b, 0xa8, 0xdc, 0x88, 0xab, 0xd1, 0xea,
-	0x77, 0xbb, 0x4d, 0x0a, 0x55, 0xdf, 0x44, 0x22, 0x8a, 0xbc, 0x8b, 0xd6, 0xbc, 0x82, 0xf5, 0x3a,
-	0xea, 0xc9, 0xa0, 0x5b, 0xd2, 0xd4, 0x18, 0xb4, 0x7f, 0x7a, 0xd4, 0xee, 0xb5, 0xda, 0xca, 0xab,
-	0xc9, 0xd4, 0x88, 0x61, 0xb7, 0xe3, 0xa9, 0x11, 0x83, 0xee, 0xc4, 0x65, 0x46, 0xa0, 0x81, 0xb2,
-	0x8d, 0xf9, 0x89, 0x7a, 0xf4, 0x7a, 0xed, 0xd6, 0x10, 0xdb, 0xfa, 0x5a, 0xdc, 0x8b, 0x47, 0x87,
-	0x4f, 0xb4, 0xe6, 0x6e, 0x5b, 0x51, 0x77, 0x6a, 0xf4, 0x02, 0x9c, 0x50, 0x72, 0xea, 0xe7, 0xc0,
-	0xe4, 0xa7, 0x94, 0xc4, 0xeb, 0x0c, 0x0c, 0xf2, 0x13, 0xdf, 0x9b, 0x46, 0xd7, 0x81, 0xf0, 0x1b,
-	0x3d, 0xac, 0xd9, 0x7c, 0x44, 0x7b, 0xcf, 0xc9, 0xed, 0x00, 0x19, 0xa4, 0xfe, 0xd3, 0x0c, 0xd4,
-	0xd3, 0x0a, 0x0e, 0x0d, 0x3b, 0x7b, 0xa2, 0xbb, 0x5e, 0xc8, 0x5f, 0x10, 0x08, 0xa2, 0xf8, 0x81,
-	0x3d, 0xe9, 0x79, 0x21, 0x3d, 0x21, 0x40, 0x0e, 0x5f, 0xac, 0xaf, 0x78, 0xae, 0x71, 0x9a, 0x75,
-	0xe0, 0x4a, 0xea, 0xa5, 0xa9, 0xd4, 0xfb, 0x0d, 0x8d, 0xf8, 0x7d, 0x9c, 0x85, 0xfa, 0x6b, 0x2c,
-	0x58, 0x6e, 0x93, 0x02, 0xb9, 0xe0, 0x6b, 0x47, 0x5c, 0x04, 0xc5, 0x4f, 0x75, 0x1f, 0xd6, 0x53,
-	0xfa, 0x94, 0x42, 0x46, 0x93, 0x74, 0x4d, 0xcb, 0xf6, 0xe4, 0xe5, 0xd5, 0x54, 0xff, 0x28, 0x03,
-	0x35, 0x59, 0xbb, 0x7e, 0xef, 0x9c, 0xe8, 0x0c, 0xa9, 0xf8, 0xd6, 0x6d, 0x33, 0x7a, 0x39, 0x20,
-	0x02, 0x75, 0xe8, 0xe5, 0x4b, 0x1e, 0xd3, 0xda, 0x3b, 0x1d, 0xc4, 0xcd, 0x91, 0x41, 0xe8, 0xe8,
-	0xd2, 0xe9, 0xf0, 0xbd, 0xa7, 0x48, 0x20, 0x4e, 0xa1, 0x26, 0x10, 0xf5, 0x0e, 0x54, 0xf6, 0x4e,
-	0xa3, 0x47, 0x2c, 0xe4, 0x77, 0x34, 0x2a, 0xfc, 0x4a, 0x89, 0xfa, 0x27, 0x19, 0xa8, 0x27, 0x77,
-	0x23, 0xe9, 0xec, 0x09, 0x7f, 0xa1, 0x8c, 0x4f, 0x87, 0xac, 0x39, 0x4a, 0x1e, 0xc5, 0xcc, 0xca,
-	0x8f, 0x62, 0xbe, 0x2e, 0x32, 0xcb, 0xc9, 0x3a, 0x28, 0x2e, 0x4b, 0x5c, 0x58, 0x79, 0x04, 0x35,
-	0xfc, 0xaf, 0x59, 0x13, 0xcb, 0xf7, 0xad, 0xe8, 0xb1, 0xb6, 0x25, 0xe2, 0x14, 0x11, 0xf9, 0x11,
-	0xd6, 0x44, 0x98, 0x33, 0x2b, 0xaf, 0x6f, 0x22, 0x5e, 0xfd, 0x7b, 0x79, 0xa8, 0x4a, 0xb6, 0xca,
-	0xb7, 0x9a, 0x7e, 0xb7, 0xa0, 0x92, 0x5c, 0x0c, 0x14, 0xb7, 0x04, 0x62, 0x40, 0x6a, 0xac, 0x72,
-	0x0b, 0x63, 0xd5, 0x80, 0x92, 0x38, 0xa4, 0x22, 0xa2, 0x55, 0x51, 0x32, 0x1d, 0x8e, 0x29, 0xbc,
-	0x24, 0x94, 0xf9, 0x3e, 0xd4, 0xa4, 0xe7, 0x38, 0xa2, 0x5b, 0xc6, 0x8b, 0xf4, 0xd5, 0xe4, 0x69,
-	0x8e, 0x80, 0x5d, 0x85, 0xe2, 0xe4, 0x54, 0x37, 0x47, 0x51, 0x70, 0xaa, 0x30, 0x39, 0xdd, 0x1d,
-	0x51, 0x28, 0x78, 0x12, 0xab, 0x67, 0x1e, 0xe1, 0x28, 0x4f, 0x22, 0x25, 0x7c, 0x0f, 0x4a, 0x93,
-	0x53, 0x7e, 0xf8, 0xbf, 0x22, 0x6f, 0x19, 0x27, 0x5d, 0x5e, 0x9c, 0x9c, 0xd2, 0x4d, 0x80, 0x4f,
-	0x40, 0x59, 0x88, 0x84, 0x05, 0xe2, 0x00, 0xdc, 0x62, 0xa5, 0x36, 0xd2, 0x41, 0xb1, 0x80, 0xbd,
-	0x0b, 0x5b, 0x42, 0x5f, 0x1a, 0x81, 0xce, 0x0f, 0x50, 0xd2, 0x5d, 0x53, 0xfe, 0x20, 0xc7, 0x26,
-	0xc7, 0x35, 0x83, 0x01, 0x61, 0x70, 0xb2, 0xaa, 0x50, 0x93, 0xe6, 0x2e, 0xbf, 0xc8, 0x5b, 0xd1,
-	0x52, 0x30, 0xf6, 0x18, 0x6a, 0x93, 0x53, 0x3e, 0x17, 0x86, 0xde, 0x81, 0x25, 0x8e, 0xc2, 0x6d,
-	0x2d, 0xce, 0x02, 0x3a, 0x31, 0x95, 0xa2, 0x54, 0xff, 0x55, 0x06, 0xea, 0x89, 0x11, 0x8a, 0x2b,
-	0x94, 0xdd, 0x97, 0xdf, 0x1d, 0x6c, 0x2c, 0xda, 0xa9, 0x48, 0xf2, 0x60, 0x78, 0x31, 0xe3, 0x4f,
-	0x21, 0xad, 0xba, 0x5e, 0xbd, 0xea, 0xf1, 0x94, 0xdc, 0xaa, 0xc7, 0x53, 0xd4, 0x27, 0x90, 0x1b,
-	0x5e, 0xcc, 0x78, 0xc0, 0x03, 0x55, 0x18, 0x77, 0x8e, 0xb8, 0xf2, 0xa2, 0xad, 0xc1, 0xa7, 0xed,
-	0x2f, 0xf9, 0x95, 0xa7, 0x43, 0xad, 0x73, 0xd0, 0xd4, 0xbe, 0xa4, 0x6d, 0x55, 0x52, 0xf2, 0x7b,
-	0x7d, 0xad, 0xdd, 0x79, 0xd2, 0x23, 0x40, 0x9e, 0xc2, 0x21, 0x49, 0x15, 0x9b, 0xa6, 0xb9, 0x77,
-	0x2a, 0xdf, 0x32, 0xcd, 0xa4, 0x1e, 0x13, 0x4a, 0xdf, 0x92, 0xc8, 0x2e, 0xde, 0x92, 0x60, 0xf1,
-	0x12, 0x8d, 0xd7, 0x3b, 0x7b, 0x0b, 0xf2, 0x93, 0x53, 0xeb, 0x22, 0xed, 0x69, 0xa4, 0x57, 0x17,
-	0x11, 0xa8, 0xbf, 0xcc, 0x00, 0x4b, 0x55, 0x84, 0x1b, 0xbf, 0xdf, 0xb7, 0x2e, 0x1f, 0x43, 0x43,
-	0xbc, 0x1b, 0xc4, 0xa9, 0xa4, 0x28, 0xa9, 0xe8, 0xd2, 0xab, 0x5e, 0x72, 0xf6, 0x22, 0xb9, 0x01,
-	0xce, 0xde, 0x05, 0xfe, 0x08, 0x0c, 0x8e, 0x78, 0x3a, 0xb6, 0x20, 0x2d, 0x7e, 0x2d, 0xa1, 0x49,
-	0x5e, 0x7d, 0x91, 0x5f, 0xb3, 0xe1, 0x61, 0xe3, 0x8d, 0x64, 0xd4, 0x48, 0x20, 0xa8, 0xbf, 0x9f,
-	0x81, 0x2b, 0xe9, 0x09, 0xf1, 0xeb, 0xb5, 0x32, 0xfd, 0x74, 0x4f, 0x6e, 0xf1, 0xe9, 0x9e, 0x55,
-	0xf3, 0x29, 0xbf, 0x72, 0x3e, 0xfd, 0x9d, 0x0c, 0x6c, 0x49, 0xbd, 0x9f, 0xb8, 0x2b, 0x7f, 0x45,
-	0x35, 0x93, 0x5e, 0xf0, 0xc9, 0xa7, 0x5e, 0xf0, 0x51, 0xff, 0x30, 0x03, 0xd7, 0x16, 0x6a, 0xa2,
-	0x59, 0x7f, 0xa5, 0x75, 0x49, 0xbf, 0xf4, 0x43, 0x91, 0x62, 0x7e, 0xfa, 0x85, 0xdf, 0x04, 0x60,
-	0xe9, 0xa7, 0x7b, 0xba, 0x38, 0x8e, 0xff, 0x3a, 0x5d, 0x49, 0x33, 0x39, 0xc7, 0xcd, 0x3e, 0x84,
-	0x6a, 0x62, 0x02, 0x45, 0xb7, 0x2b, 0x57, 0x1e, 0x02, 0x97, 0xe9, 0x56, 0xca, 0xc5, 0xec, 0xb7,
-	0x93, 0x8b, 0x8f, 0xa1, 0x16, 0x67, 0xbc, 0x6b, 0x4d, 0xd2, 0x41, 0x81, 0x85, 0xa7, 0x00, 0x52,
-	0x94, 0xea, 0x07, 0xb0, 0x99, 0xb4, 0xa2, 0x25, 0x9e, 0xaf, 0xb8, 0x03, 0x55, 0xd7, 0x3a, 0xd3,
-	0xa3, 0xc7, 0x2d, 0xc4, 0x96, 0xbc, 0x6b, 0x9d, 0x09, 0x02, 0x75, 0x4f, 0x96, 0x7b, 0xf1, 0x73,
-	0x9e, 0x8e, 0x29, 0x8f, 0x4c, 0xc9, 0x73, 0xcc, 0x08, 0x85, 0xb9, 0x49, 0x03, 0x53, 0x72, 0xad,
-	0x33, 0x9a, 0x73, 0x67, 0x22, 0x9f, 0xa6, 0x69, 0x8a, 0x1d, 0xc5, 0x55, 0x37, 0xc5, 0x6f, 0x40,
-	0x79, 0xe6, 0xa7, 0x46, 0xb6, 0x34, 0xf3, 0x79, 0xb1, 0x77, 0xc5, 0x86, 0xff, 0x65, 0xbb, 0x8f,
-	0xfc, 0x08, 0x80, 0xb8, 0x58, 0x9b, 0x4f, 0x9e, 0xfb, 0xfd, 0x50, 0x88, 0x3c, 0x5c, 0x7f, 0xa2,
-	0xe4, 0x78, 0x97, 0x31, 0x73, 0x6f, 0x9d, 0x76, 0x19, 0xc9, 0xa4, 0xb3, 0xbe, 0x16, 0x67, 0x0e,
-	0xf0, 0x53, 0xfd, 0x03, 0x00, 0x48, 0x1a, 0x9e, 0xd2, 0xde, 0x99, 0x05, 0xed, 0xfd, 0x9d, 0xb6,
-	0x1b, 0x3f, 0x80, 0xfa, 0xd8, 0x9b, 0x5d, 0xe8, 0x09, 0x47, 0x6e, 0x25, 0x47, 0x0d, 0xa9, 0x86,
-	0xc9, 0x99, 0xe7, 0xe5, 0xcd, 0xaa, 0xfc, 0xca, 0xcd, 0xaa, 0xf7, 0xa1, 0xc4, 0x63, 0xdf, 0x81,
-	0x38, 0x3d, 0x7f, 0x7d, 0x51, 0x33, 0x3d, 0x10, 0x0f, 0x35, 0x45, 0x74, 0xac, 0x8d, 0x8e, 0xad,
-	0x78, 0xa5, 0x46, 0x3e, 0x4b, 0x7f, 0x7b, 0x99, 0x33, 0x22, 0xe3, 0x4f, 0x23, 0x18, 0x72, 0x52,
-	0xd2, 0xd8, 0xe1, 0x54, 0x04, 0x64, 0x48, 0x63, 0x97, 0x64, 0x8d, 0x3d, 0x9c, 0xf2, 0x30, 0x0c,
-	0x6a, 0xec, 0x1f, 0xc2, 0x15, 0x71, 0x2e, 0x11, 0x19, 0xb0, 0x3b, 0x89, 0x9e, 0xdf, 0xc7, 0x13,
-	0x97, 0x19, 0x87, 0x53, 0x32, 0x85, 0x91, 0xfc, 0x0b, 0xd8, 0x1a, 0x9f, 0x18, 0xee, 0xb1, 0xa5,
-	0x87, 0x23, 0x47, 0xa7, 0x87, 0x0d, 0xf5, 0xa9, 0x31, 0x13, 0x36, 0xc8, 0x5b, 0x4b, 0x95, 0x6d,
-	0x11, 0xf1, 0x70, 0xe4, 0xd0, 0x3e, 0x7e, 0xbc, 0xa5, 0xb9, 0x39, 0x5e, 0x84, 0x2f, 0x6c, 0xe8,
-	0xc0, 0xe2, 0x86, 0xce, 0x92, 0x69, 0x51, 0x5d, 0x36, 0x2d, 0x6e, 0xfe, 0xa7, 0x3c, 0x14, 0xc5,
-	0x53, 0x57, 0xf7, 0x21, 0x6f, 0xfa, 0xde, 0x2c, 0x3e, 0x4d, 0xb3, 0xc2, 0x32, 0xa0, 0x67, 0xc9,
-	0xd1, 0x88, 0x78, 0x00, 0x45, 0xc3, 0x34, 0xf5, 0xc9, 0x69, 0x7a, 0xd3, 0x65, 0x41, 0x49, 0xef,
-	0xaf, 0x69, 0x05, 0x83, 0xb4, 0xf5, 0xc7, 0x50, 0x41, 0x7a, 0x1e, 0x4f, 0x4a, 0x39, 0x2f, 0xcb,
-	0xea, 0x74, 0x7f, 0x4d, 0x2b, 0x1b, 0x91, 0x6a, 0xfd, 0x71, 0x3a, 0x7c, 0xc5, 0x75, 0xdd, 0xcd,
-	0x25, 0xd6, 0xcb, 0x02, 0x59, 0xbf, 0x0d, 0x3c, 0x9e, 0x11, 0x4b, 0x8a, 0x82, 0x1c, 0xdf, 0x5f,
-	0x92, 0x2b, 0xfb, 0x6b, 0x5a, 0xcd, 0xe0, 0x67, 0x28, 0xb8, 0x9c, 0xf9, 0x30, 0x0a, 0x2d, 0xc5,
-	0x0f, 0x08, 0xaf, 0xe8, 0x19, 0x5c, 0xe7, 0x71, 0x7c, 0x89, 0x16, 0x3d, 0xb2, 0x99, 0x66, 0x74,
-	0x26, 0xa1, 0xb4, 0xc4, 0x16, 0x4b, 0x13, 0x62, 0x8b, 0x45, 0xcb, 0x63, 0xa8, 0x52, 0x94, 0x47,
-	0xf0, 0x95, 0x97, 0xba, 0x36, 0x11, 0x06, 0x14, 0xbb, 0x4e, 0x44, 0x43, 0x2b, 0x6a, 0xa7, 0x6f,
-	0xc9, 0xe1, 0xc1, 0x5b, 0x2b, 0x3b, 0x4a, 0x8b, 0x23, 0x85, 0xbc, 0xb1, 0x1a, 0xe7, 0x61, 0x3b,
-	0x50, 0x33, 0x24, 0x2d, 0x21, 0x62, 0x85, 0xb7, 0x56, 0x8c, 0x53, 0x4c, 0x43, 0x79, 0x48, 0xe9,
-	0x64, 0x0f, 0xeb, 0xa6, 0x06, 0xd7, 0x56, 0x4f, 0x65, 0x79, 0x33, 0x3e, 0xcf, 0x37, 0xe3, 0xd5,
-	0xf4, 0x85, 0xd2, 0xf4, 0x15, 0x20, 0x69, 0x6b, 0xfe, 0x33, 0x74, 0x58, 0xe5, 0xc5, 0x5b, 0x85,
-	0x52, 0xf4, 0xe2, 0x1a, 0x9d, 0x26, 0x6b, 0xf5, 0x0f, 0xbf, 0x54, 0x32, 0x08, 0xee, 0xf4, 0x06,
-	0xc3, 0x66, 0x4f, 0xec, 0x50, 0x76, 0x7a, 0x62, 0x87, 0x52, 0xfd, 0x77, 0x39, 0xa8, 0xc4, 0x11,
-	0xd6, 0xef, 0xef, 0xa5, 0xc6, 0xee, 0x5f, 0x4e, 0x76, 0xff, 0x16, 0xac, 0x2c, 0xbe, 0x7b, 0xce,
-	0x2f, 0x1a, 0x6f, 0xa4, 0x6d, 0x99, 0x60, 0xf9, 0x4e, 0x42, 0xe1, 0x5b, 0xde, 0x49, 0x90, 0xcf,
-	0x2f, 0x15, 0xd3, 0xe7, 0x97, 0x16, 0x5e, 0xdd, 0x2b, 0xd1, 0x4e, 0xbf, 0xfc, 0xea, 0xde, 0xa5,
-	0x5b, 0xfc, 0xe5, 0xcb, 0xb7, 0xf8, 0xe9, 0xb7, 0x17, 0x9e, 0xd9, 0xd6, 0x99, 0x38, 0xc6, 0x23,
-	0x52, 0x69, 0xf5, 0x01, 0x2f, 0x51, 0x1f, 0xdf, 0x42, 0x14, 0xb1, 0x87, 0xb0, 0x35, 0x39, 0x8d,
-	0x5f, 0x18, 0x4a, 0xbc, 0x9d, 0x1a, 0x35, 0x63, 0x25, 0x4e, 0xfd, 0xfb, 0x19, 0x80, 0x24, 0x0c,
-	0xf9, 0x6b, 0x47, 0x5b, 0x24, 0x87, 0x36, 0xf7, 0x2b, 0x1c, 0xda, 0x97, 0xdc, 0x83, 0x55, 0xbf,
-	0x86, 0x4a, 0x1c, 0x78, 0xfe, 0xfe, 0x73, 0xec, 0x3b, 0x15, 0xf9, 0xbb, 0x51, 0xe4, 0x29, 0x8e,
-	0xdc, 0xfe, 0xba, 0x7d, 0x91, 0x2a, 0x3e, 0xf7, 0x92, 0xe2, 0xcf, 0x79, 0xf8, 0x27, 0x2e, 0xfc,
-	0x37, 0xbc, 0xb0, 0xe4, 0x39, 0x9f, 0x4f, 0xcd, 0x79, 0x75, 0x2e, 0x62, 0x58, 0xbf, 0x7e, 0xd1,
-	0xdf, 0xa9, 0xc1, 0x7f, 0x9e, 0x89, 0x02, 0x2d, 0xf1, 0xbb, 0x4d, 0x97, 0x1a, 0x5a, 0xab, 0x63,
-	0x45, 0xdf, 0xa5, 0xb8, 0x5f, 0xe9, 0x29, 0xe6, 0x7f, 0x95, 0xa7, 0xf8, 0x16, 0x14, 0xb8, 0x42,
-	0x28, 0x5c, 0xe6, 0x25, 0x72, 0xfc, 0x4b, 0x5f, 0x3a, 0x55, 0x55, 0x61, 0x58, 0xf2, 0xf6, 0x6e,
-	0x45, 0xf9, 0x46, 0xaf, 0xb4, 0x62, 0x02, 0x1d, 0xf5, 0x4a, 0xe2, 0x30, 0x7e, 0xf7, 0x3e, 0xf9,
-	0x8d, 0xb9, 0x8a, 0xff, 0x3c, 0x0b, 0xeb, 0xa9, 0x3d, 0xa7, 0xef, 0x51, 0x99, 0x95, 0xd2, 0x3c,
-	0xb7, 0x5a, 0x9a, 0x5f, 0x2a, 0x58, 0xf3, 0x97, 0x0b, 0xd6, 0xff, 0x2b, 0x1a, 0x80, 0x1f, 0x0a,
-	0x14, 0x8f, 0xaa, 0x96, 0xa3, 0x43, 0x81, 0xfc, 0xb8, 0x1b, 0x4a, 0xd3, 0x5a, 0xca, 0x2a, 0x5f,
-	0x65, 0xbf, 0x67, 0x56, 0xda, 0xef, 0xb7, 0xe3, 0x5f, 0x1a, 0xe8, 0xec, 0x72, 0xa7, 0x70, 0x5d,
-	0x93, 0x20, 0xec, 0x13, 0xb8, 0xc1, 0xad, 0x1a, 0x6e, 0xc8, 0xe9, 0xde, 0x44, 0x8f, 0x7f, 0x87,
-	0x40, 0x9c, 0x87, 0xbb, 0xc6, 0x09, 0xf8, 0x33, 0xb8, 0x93, 0x66, 0x84, 0x55, 0x3b, 0xb0, 0x9e,
-	0xda, 0x00, 0x94, 0x7e, 0xd3, 0x24, 0x23, 0xff, 0xa6, 0x09, 0xdb, 0x86, 0xc2, 0xd9, 0x89, 0xe5,
-	0x5b, 0x2b, 0xde, 0xb2, 0xe1, 0x08, 0xf5, 0x47, 0x50, 0x93, 0x0f, 0x23, 0xb0, 0x77, 0xa0, 0x60,
-	0x87, 0xd6, 0x34, 0xf2, 0x80, 0xaf, 0x2d, 0x9f, 0x57, 0x20, 0x27, 0x98, 0x13, 0xa9, 0xbf, 0xc8,
-	0x80, 0xb2, 0x88, 0x93, 0x7e, 0x78, 0x25, 0x73, 0xc9, 0x0f, 0xaf, 0x64, 0x53, 0x95, 0x5c, 0xf5,
-	0xdb, 0x29, 0xf1, 0x7b, 0x1a, 0xf9, 0x4b, 0xde, 0xd3, 0x60, 0x6f, 0x42, 0xd9, 0xb7, 0xe8, 0x57,
-	0x2d, 0xcc, 0xf4, 0xe3, 0xe5, 0xfc, 0x3a, 0x53, 0x84, 0x53, 0x7f, 0x2f, 0x03, 0x25, 0x71, 0x72,
-	0x62, 0xa5, 0xa3, 0xfa, 0x36, 0x94, 0xf8, 0x2f, 0x5c, 0x44, 0x8e, 0xfb, 0xd2, 0x71, 0xc5, 0x08,
-	0xcf, 0x6e, 0xf3, 0xf3, 0x24, 0x69, 0xc7, 0xf5, 0xd0, 0x31, 0x5c, 0x8d, 0xe0, 0x38, 0xd5, 0x78,
-	0x18, 0x02, 0x5d, 0xaf, 0x40, 0xdc, 0x89, 0x06, 0x02, 0xa1, 0x69, 0x16, 0xa8, 0x3f, 0x86, 0x92,
-	0x38, 0x99, 0xb1, 0xb2, 0x2a, 0x2f, 0xfb, 0x6d, 0x87, 0x6d, 0x80, 0xe4, 0xa8, 0xc6, 0xaa, 0x1c,
-	0xd4, 0xfb, 0x50, 0x8e, 0x4e, 0x67, 0xe0, 0xfc, 0x4b, 0x8a, 0x16, 0x07, 0x71, 0xe5, 0xca, 0x38,
-	0xe2, 0xc1, 0xb7, 0xae, 0x37, 0x3e, 0xa5, 0x88, 0xd8, 0xbb, 0x50, 0x76, 0xa2, 0x77, 0xf4, 0x32,
-	0x97, 0xbf, 0xa3, 0x17, 0x13, 0xb1, 0xfb, 0x10, 0x8b, 0xe3, 0x97, 0x79, 0xcb, 0x6a, 0x33, 0x3a,
-	0x71, 0x4e, 0xb3, 0xec, 0x91, 0x88, 0xfc, 0x74, 0xe9, 0x26, 0x7f, 0x2a, 0xd8, 0x92, 0xaa, 0x93,
-	0x26, 0x91, 0xa9, 0x75, 0xa8, 0xc9, 0x5b, 0xca, 0x6a, 0x13, 0x36, 0x0f, 0xac, 0xd0, 0x40, 0x99,
-	0x35, 0x18, 0x1b, 0x2e, 0xd2, 0xf3, 0xf9, 0x8b, 0x1f, 0xe9, 0xf9, 0xbb, 0x48, 0xa7, 0x71, 0x22,
-	0xf5, 0x17, 0x79, 0x50, 0x16, 0x71, 0x28, 0x4c, 0xe2, 0x37, 0xbe, 0x33, 0xd1, 0x1b, 0xa1, 0x4e,
-	0xfc, 0x2c, 0x3b, 0xcd, 0x0b, 0x39, 0xb0, 0x01, 0x1c, 0x44, 0x04, 0x5c, 0x98, 0xa4, 0x1e, 0xdb,
-	0x2c, 0xdb, 0xc1, 0x3e, 0x7f, 0x6e, 0xf3, 0x3a, 0xbf, 0xef, 0xec, 0x78, 0x63, 0x9a, 0xd6, 0x35,
-	0xba, 0xde, 0xdc, 0xf5, 0xc6, 0xc8, 0x15, 0x39, 0xdc, 0x81, 0xb8, 0x9b, 0x50, 0x16, 0x5e, 0x36,
-	0x45, 0xf0, 0xc5, 0x25, 0xd6, 0x90, 0xff, 0x7c, 0x48, 0x4d, 0x2b, 0x73, 0xc0, 0x30, 0x88, 0xde,
-	0x25, 0x1b, 0x8b, 0xc7, 0xb6, 0x73, 0xf4, 0x2e, 0x59, 0xcb, 0xa5, 0x33, 0xfd, 0xf4, 0x9e, 0xfb,
-	0x58, 0xbc, 0xb7, 0x2f, 0x5e, 0x7d, 0x43, 0xd4, 0xeb, 0xfc, 0x39, 0x72, 0xdf, 0x0a, 0x02, 0xfe,
-	0xe8, 0x05, 0x7f, 0x8f, 0xa2, 0x16, 0x01, 0xe3, 0xd7, 0x35, 0xc4, 0x03, 0xee, 0x48, 0x02, 0xe2,
-	0x75, 0x0d, 0xfe, 0x7c, 0x3b, 0x12, 0xdc, 0x80, 0xf2, 0x37, 0x9e, 0x6b, 0x91, 0xe3, 0x5e, 0xa5,
-	0x5a, 0x95, 0x30, 0x7d, 0x60, 0xcc, 0xd4, 0x7f, 0x9b, 0x81, 0xad, 0xc5, 0x5e, 0xa5, 0x09, 0x53,
-	0x83, 0x72, 0xab, 0xdf, 0xd5, 0x7b, 0xcd, 0x83, 0xb6, 0xb2, 0xc6, 0x36, 0xa0, 0xda, 0xdf, 0xf9,
-	0xbc, 0xdd, 0x1a, 0x72, 0x40, 0x86, 0xae, 0x23, 0x0d, 0xf4, 0xfd, 0xce, 0xee, 0x6e, 0xbb, 0xc7,
-	0xbd, 0x94, 0xfe, 0xce, 0xe7, 0x7a, 0xb7, 0xdf, 0xe2, 0x6f, 0x47, 0x47, 0x1b, 0xdf, 0x03, 0x25,
-	0x4f, 0x9b, 0xcd, 0x74, 0xac, 0x12, 0x93, 0x05, 0x7e, 0x6a, 0xf0, 0xf9, 0x40, 0x6f, 0xf5, 0x86,
-	0x4a, 0x11, 0x53, 0xbd, 0xa3, 0x6e, 0x97, 0x52, 0x74, 0x3c, 0xa8, 0xd5, 0x3f, 0x38, 0xd4, 0xda,
-	0x83, 0x81, 0x3e, 0xe8, 0xfc, 0xac, 0xad, 0x94, 0xa9, 0x64, 0xad, 0xf3, 0xa4, 0xd3, 0xe3, 0x80,
-	0x0a, 0x2b, 0x41, 0xee, 0xa0, 0xd3, 0x53, 0x80, 0x3e, 0x9a, 0x5f, 0x28, 0x55, 0xfc, 0x18, 0x1c,
-	0x1d, 0x28, 0xb5, 0xfb, 0xaf, 0x41, 0x4d, 0xfe, 0xd1, 0x04, 0x3a, 0x28, 0xe8, 0xb9, 0x16, 0x7f,
-	0xab, 0xac, 0xfb, 0xcd, 0x07, 0x4a, 0xe6, 0xfe, 0xef, 0x4a, 0x0f, 0xdb, 0x12, 0x8d, 0x08, 0xe4,
-	0xd3, 0xad, 0x38, 0x7e, 0x49, 0x87, 0xc2, 0xf6, 0x74, 0xa7, 0x67, 0xbf, 0x39, 0xd8, 0xe7, 0x21,
-	0x7e, 0x81, 0x21, 0x40, 0x2e, 0x79, 0xe3, 0x8a, 0x6e, 0xc1, 0xd1, 0x67, 0xbc, 0xcf, 0x5d, 0xa0,
-	0xeb, 0x4b, 0x9d, 0x01, 0x36, 0x4e, 0x81, 0x1a, 0x7e, 0xc5, 0xb8, 0xd2, 0x7d, 0x15, 0xaa, 0xd2,
-	0xb3, 0x84, 0x54, 0x86, 0x11, 0x9c, 0x88, 0x67, 0xb3, 0xd0, 0xdd, 0x54, 0x32, 0xf7, 0xdf, 0x44,
-	0x8d, 0x21, 0x3f, 0x0a, 0x08, 0x50, 0xec, 0x79, 0xfe, 0xd4, 0x70, 0x04, 0x9d, 0x35, 0x0f, 0x90,
-	0xee, 0x5d, 0xb8, 0xba, 0xf2, 0x89, 0x43, 0x3a, 0xec, 0x6a, 0x4f, 0x67, 0x8e, 0xc5, 0xcf, 0x6b,
-	0xee, 0x5f, 0x8c, 0x7c, 0xdb, 0x54, 0x32, 0xf7, 0x3f, 0x83, 0xc6, 0x65, 0xc7, 0x0f, 0x31, 0xdf,
-	0xd6, 0x7e, 0x93, 0x8e, 0x78, 0xe2, 0x90, 0xf4, 0x75, 0x9e, 0xca, 0xf0, 0x13, 0xb2, 0xdd, 0x36,
-	0x1d, 0x69, 0xb8, 0xff, 0xf3, 0x8c, 0x24, 0x88, 0xa2, 0x23, 0x64, 0x31, 0x40, 0xf4, 0xb5, 0x0c,
-	0xd2, 0x2c, 0xc3, 0x54, 0x32, 0xec, 0x1a, 0xb0, 0x14, 0xa8, 0xeb, 0x8d, 0x0d, 0x47, 0xc9, 0xd2,
-	0xe1, 0x85, 0x08, 0x4e, 0x07, 0x7d, 0x95, 0x1c, 0x7b, 0x15, 0x6e, 0xc4, 0xb0, 0xae, 0x77, 0x76,
-	0xe8, 0xdb, 0xe8, 0x31, 0x5f, 0x70, 0x74, 0x7e, 0xe7, 0x27, 0x7f, 0xfa, 0xcb, 0xdb, 0x99, 0xff,
-	0xf0, 0xcb, 0xdb, 0x99, 0xff, 0xfe, 0xcb, 0xdb, 0x6b, 0xbf, 0xf8, 0x1f, 0xb7, 0x33, 0x3f, 0x93,
-	0x7f, 0xb1, 0x70, 0x6a, 0x84, 0xbe, 0x7d, 0xce, 0xa7, 0x7e, 0x94, 0x70, 0xad, 0x77, 0x67, 0xa7,
-	0xc7, 0xef, 0xce, 0x46, 0xef, 0xa2, 0x7c, 0x19, 0x15, 0xe9, 0xb7, 0x09, 0x1f, 0xfd, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf8, 0x6a, 0xae, 0xa8, 0xfb, 0x70, 0x00, 0x00,
+	// 10725 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x5d, 0x8f, 0x1b, 0xc7,
+	0x96, 0x98, 0xf8, 0x4d, 0x1e, 0x7e, 0x4c, 0x4f, 0xeb, 0x8b, 0x92, 0x65, 0x79, 0xdc, 0xd6, 0xb5,
+	0x65, 0x5d, 0x5f, 0xc9, 0x1e, 0xf9, 0x43, 0xf6, 0x5e, 0xaf, 0xcd, 0xe1, 0x50, 0x12, 0x2d, 0x0e,
+	0x39, 0xb7, 0xc8, 0x91, 0x6c, 0x2f, 0x92, 0x46, 0x93, 0xdd, 0x9c, 0x69, 0x4f, 0xb3, 0x9b, 0xee,
+	0x6e, 0x6a, 0x66, 0x0c, 0x2c, 0xe0, 0x24, 0x40, 0x82, 0x04, 0xc8, 0xd3, 0x02, 0xfb, 0x12, 0x6c,
+	0x70, 0xef, 0x3e, 0x05, 0x8b, 0x04, 0x08, 0x90, 0x00, 0x09, 0xf2, 0x9a, 0x3c, 0xdc, 0x04, 0x41,
+	0x90, 0xb7, 0x20, 0x09, 0xb0, 0x09, 0x6e, 0x7e, 0xc0, 0x3e, 0x6c, 0xde, 0x37, 0x38, 0xa7, 0xaa,
+	0xbb, 0xab, 0x49, 0x8e, 0x65, 0xfb, 0xde, 0x45, 0x92, 0x97, 0x99, 0xaa, 0x73, 0x4e, 0x55, 0xd7,
+	0xe7, 0xf9, 0xaa, 0x53, 0x45, 0x80, 0xb9, 0x63, 0xb8, 0x77, 0xe7, 0xbe, 0x17, 0x7a, 0x6a, 0x1e,
+	0xd3, 0xd7, 0x7f, 0x76, 0x68, 0x87, 0x47, 0x8b, 0xf1, 0xdd, 0x89, 0x37, 0xbb, 0x77, 0xe8, 0x1d,
+	0x7a, 0xf7, 0x08, 0x39, 0x5e, 0x4c, 0x29, 0x47, 0x19, 0x4a, 0xf1, 0x42, 0xd7, 0xc1, 0xf1, 0x26,
+	0xc7, 0x22, 0xbd, 0x11, 0xda, 0x33, 0x2b, 0x08, 0x8d, 0xd9, 0x9c, 0x03, 0xb4, 0x7f, 0x95, 0x81,
+	0xfc, 0xe8, 0x6c, 0x6e, 0xa9, 0x0d, 0xc8, 0xda, 0x66, 0x33, 0xb3, 0x95, 0xb9, 0x5d, 0x60, 0x59,
+	0xdb, 0x54, 0xb7, 0xa0, 0xea, 0x7a, 0x61, 0x7f, 0xe1, 0x38, 0xc6, 0xd8, 0xb1, 0x9a, 0xd9, 0xad,
+	0xcc, 0xed, 0x32, 0x93, 0x41, 0xea, 0x4b, 0x50, 0x31, 0x16, 0xa1, 0xa7, 0xdb, 0xee, 0xc4, 0x6f,
+	0xe6, 0x08, 0x5f, 0x46, 0x40, 0xd7, 0x9d, 0xf8, 0xea, 0x25, 0x28, 0x9c, 0xd8, 0x66, 0x78, 0xd4,
+	0xcc, 0x53, 0x8d, 0x3c, 0x83, 0xd0, 0x60, 0x62, 0x38, 0x56, 0xb3, 0xc0, 0xa1, 0x94, 0x41, 0x68,
+	0x48, 0x1f, 0x29, 0x6e, 0x65, 0x6e, 0x57, 0x18, 0xcf, 0xa8, 0x37, 0x01, 0x2c, 0x77, 0x31, 0x7b,
+	0x6e, 0x38, 0x0b, 0x2b, 0x68, 0x96, 0x08, 0x25, 0x41, 0xb4, 0x4f, 0xa0, 0x32, 0x0b, 0x0e, 0x1f,
+	0x5b, 0x86, 0x69, 0xf9, 0xea, 0x55, 0x28, 0xcd, 0x82, 0x43, 0x3d, 0x34, 0x0e, 0x45, 0x17, 0x8a,
+	0xb3, 0xe0, 0x70, 0x64, 0x1c, 0xaa, 0xd7, 0xa0, 0x4c, 0x88, 0xb3, 0x39, 0xef, 0x43, 0x81, 0x21,
+	0x21, 0xf6, 0x58, 0xfb, 0x8b, 0x02, 0x94, 0x7a, 0x76, 0x68, 0xf9, 0x86, 0xa3, 0x5e, 0x81, 0xa2,
+	0x1d, 0xb8, 0x0b, 0xc7, 0xa1, 0xe2, 0x65, 0x26, 0x72, 0xea, 0x15, 0x28, 0xd8, 0x0f, 0x9e, 0x1b,
+	0x0e, 0x2f, 0xfb, 0xf8, 0x02, 0xe3, 0x59, 0xb5, 0x09, 0x45, 0xfb, 0x9d, 0xf7, 0x11, 0x91, 0x13,
+	0x08, 0x91, 0x27, 0xcc, 0xfd, 0x6d, 0xc4, 0xe4, 0x63, 0xcc, 0xfd, 0xed, 0x08, 0xf3, 0xfe, 0xbb,
+	0x88, 0xc1, 0xde, 0xe7, 0x08, 0x43, 0x79, 0xfc, 0xca, 0x82, 0xbe, 0x82, 0x03, 0x50, 0xc7, 0xaf,
+	0x2c, 0xa2, 0xaf, 0x2c, 0xf8, 0x57, 0x4a, 0x02, 0x21, 0xf2, 0x84, 0xe1, 0x5f, 0x29, 0xc7, 0x98,
+	0xf8, 0x2b, 0x0b, 0xfe, 0x95, 0xca, 0x56, 0xe6, 0x76, 0x9e, 0x30, 0xfc, 0x2b, 0x97, 0x20, 0x6f,
+	0x22, 0x1c, 0xb6, 0x32, 0xb7, 0x33, 0x8f, 0x2f, 0xb0, 0xbc, 0x29, 0xa0, 0x01, 0x42, 0xab, 0x38,
+	0xc0, 0x08, 0x0d, 0x04, 0x74, 0x8c, 0xd0, 0x1a, 0x8e, 0x06, 0x42, 0xc7, 0x02, 0x3a, 0x45, 0x68,
+	0x7d, 0x2b, 0x73, 0x3b, 0x8b, 0x50, 0xcc, 0xa9, 0xd7, 0xa1, 0x64, 0x1a, 0xa1, 0x85, 0x88, 0x86,
+	0xe8, 0x72, 0x04, 0x40, 0x1c, 0xae, 0x38, 0xc4, 0x6d, 0x88, 0x4e, 0x47, 0x00, 0x55, 0x83, 0x2a,
+	0x92, 0x45, 0x78, 0x45, 0xe0, 0x65, 0xa0, 0xfa, 0x1e, 0xd4, 0x4c, 0x6b, 0x62, 0xcf, 0x0c, 0x87,
+	0xf7, 0x69, 0x73, 0x2b, 0x73, 0xbb, 0xba, 0xbd, 0x71, 0x97, 0xf6, 0x44, 0x8c, 0x79, 0x7c, 0x81,
+	0xa5, 0xc8, 0xd4, 0x07, 0x50, 0x17, 0xf9, 0x77, 0xb6, 0x69, 0x60, 0x55, 0x2a, 0xa7, 0xa4, 0xca,
+	0xbd, 0xb3, 0xfd, 0xe0, 0xf1, 0x05, 0x96, 0x26, 0x54, 0x6f, 0x41, 0x2d, 0xde, 0x22, 0x58, 0xf0,
+	0xa2, 0x68, 0x55, 0x0a, 0x8a, 0xdd, 0xfa, 0x2a, 0xf0, 0x5c, 0x24, 0xb8, 0x24, 0xc6, 0x2d, 0x02,
+	0xa8, 0x5b, 0x00, 0xa6, 0x35, 0x35, 0x16, 0x4e, 0x88, 0xe8, 0xcb, 0x62, 0x00, 0x25, 0x98, 0x7a,
+	0x13, 0x2a, 0x8b, 0x39, 0xf6, 0xf2, 0xa9, 0xe1, 0x34, 0xaf, 0x08, 0x82, 0x04, 0x84, 0xb5, 0xe3,
+	0x3a, 0x47, 0xec, 0x55, 0x31, 0xbb, 0x11, 0x00, 0xf7, 0x8a, 0x1d, 0xec, 0xd8, 0x6e, 0xb3, 0x49,
+	0xeb, 0x94, 0x67, 0xd4, 0x1b, 0x90, 0x0b, 0xfc, 0x49, 0xf3, 0x1a, 0xf5, 0x12, 0x78, 0x2f, 0x3b,
+	0xa7, 0x73, 0x9f, 0x21, 0x78, 0xa7, 0x04, 0x05, 0xda, 0x33, 0xda, 0x0d, 0x28, 0xef, 0x1b, 0xbe,
+	0x31, 0x63, 0xd6, 0x54, 0x55, 0x20, 0x37, 0xf7, 0x02, 0xb1, 0x5b, 0x30, 0xa9, 0xf5, 0xa0, 0xf8,
+	0xd4, 0xf0, 0x11, 0xa7, 0x42, 0xde, 0x35, 0x66, 0x16, 0x21, 0x2b, 0x8c, 0xd2, 0xb8, 0x43, 0x82,
+	0xb3, 0x20, 0xb4, 0x66, 0x82, 0x15, 0x88, 0x1c, 0xc2, 0x0f, 0x1d, 0x6f, 0x2c, 0x76, 0x42, 0x99,
+	0x89, 0x9c, 0xf6, 0xb7, 0x33, 0x50, 0x6c, 0x7b, 0x0e, 0x56, 0x77, 0x15, 0x4a, 0xbe, 0xe5, 0xe8,
+	0xc9, 0xe7, 0x8a, 0xbe, 0xe5, 0xec, 0x7b, 0x01, 0x22, 0x26, 0x1e, 0x47, 0xf0, 0xbd, 0x59, 0x9c,
+	0x78, 0x84, 0x88, 0x1a, 0x90, 0x93, 0x1a, 0x70, 0x0d, 0xca, 0xe1, 0xd8, 0xd1, 0x09, 0x9e, 0x27,
+	0x78, 0x29, 0x1c, 0x3b, 0x7d, 0x44, 0x5d, 0x85, 0x92, 0x39, 0xe6, 0x98, 0x02, 0x61, 0x8a, 0xe6,
+	0x18, 0x11, 0xda, 0x87, 0x50, 0x61, 0xc6, 0x89, 0x68, 0xc6, 0x65, 0x28, 0x62, 0x05, 0x82, 0xcb,
+	0xe5, 0x59, 0x21, 0x1c, 0x3b, 0x5d, 0x13, 0xc1, 0xd8, 0x08, 0xdb, 0xa4, 0x36, 0xe4, 0x59, 0x61,
+	0xe2, 0x39, 0x5d, 0x53, 0x1b, 0x01, 0xb4, 0x3d, 0xdf, 0xff, 0xd1, 0x5d, 0xb8, 0x04, 0x05, 0xd3,
+	0x9a, 0x87, 0x47, 0x9c, 0x41, 0x30, 0x9e, 0xd1, 0xee, 0x40, 0x19, 0xe7, 0xa5, 0x67, 0x07, 0xa1,
+	0x7a, 0x13, 0xf2, 0x8e, 0x1d, 0x84, 0xcd, 0xcc, 0x56, 0x6e, 0x69, 0xd6, 0x08, 0xae, 0x6d, 0x41,
+	0x79, 0xcf, 0x38, 0x7d, 0x8a, 0x33, 0xa7, 0x5e, 0x12, 0x53, 0x28, 0xa6, 0x44, 0xcc, 0x67, 0x0d,
+	0x60, 0x64, 0xf8, 0x87, 0x56, 0x48, 0xfc, 0xec, 0x2f, 0x33, 0x50, 0x1d, 0x2e, 0xc6, 0x5f, 0x2f,
+	0x2c, 0xff, 0x0c, 0xdb, 0x7c, 0x1b, 0x72, 0xe1, 0xd9, 0x9c, 0x4a, 0x34, 0xb6, 0xaf, 0xf0, 0xea,
+	0x25, 0xfc, 0x5d, 0x2c, 0xc4, 0x90, 0x04, 0x3b, 0xe1, 0x7a, 0xa6, 0x15, 0x8d, 0x41, 0x81, 0x15,
+	0x31, 0xdb, 0x35, 0x51, 0x28, 0x78, 0x73, 0x31, 0x0b, 0x59, 0x6f, 0xae, 0x6e, 0x41, 0x61, 0x72,
+	0x64, 0x3b, 0x26, 0x4d, 0x40, 0xba, 0xcd, 0x1c, 0x81, 0xb3, 0xe4, 0x7b, 0x27, 0x7a, 0x60, 0x7f,
+	0x13, 0x31, 0xf9, 0x92, 0xef, 0x9d, 0x0c, 0xed, 0x6f, 0x2c, 0x6d, 0x24, 0x24, 0x0d, 0x40, 0x71,
+	0xd8, 0x6e, 0xf5, 0x5a, 0x4c, 0xb9, 0x80, 0xe9, 0xce, 0xe7, 0xdd, 0xe1, 0x68, 0xa8, 0x64, 0xd4,
+	0x06, 0x40, 0x7f, 0x30, 0xd2, 0x45, 0x3e, 0xab, 0x16, 0x21, 0xdb, 0xed, 0x2b, 0x39, 0xa4, 0x41,
+	0x78, 0xb7, 0xaf, 0xe4, 0xd5, 0x12, 0xe4, 0x5a, 0xfd, 0x2f, 0x94, 0x02, 0x25, 0x7a, 0x3d, 0xa5,
+	0xa8, 0xfd, 0x59, 0x16, 0x2a, 0x83, 0xf1, 0x57, 0xd6, 0x24, 0xc4, 0x3e, 0xe3, 0x2a, 0xb5, 0xfc,
+	0xe7, 0x96, 0x4f, 0xdd, 0xce, 0x31, 0x91, 0xc3, 0x8e, 0x98, 0x63, 0xea, 0x5c, 0x8e, 0x65, 0xcd,
+	0x31, 0xd1, 0x4d, 0x8e, 0xac, 0x99, 0xd1, 0xcc, 0x09, 0x3a, 0xca, 0xe1, 0xae, 0xf0, 0xc6, 0x5f,
+	0x51, 0xf7, 0x72, 0x0c, 0x93, 0xea, 0x2b, 0x50, 0xe5, 0x75, 0xc8, 0xeb, 0x0b, 0x38, 0x68, 0x79,
+	0xf1, 0x15, 0xe5, 0xc5, 0x47, 0x25, 0xa9, 0x56, 0x8e, 0x14, 0x12, 0x8c, 0x83, 0xfa, 0x62, 0x45,
+	0x7b, 0xe3, 0xaf, 0x38, 0xb6, 0xcc, 0x57, 0xb4, 0x37, 0xfe, 0x8a, 0x50, 0x3f, 0x85, 0xcd, 0x60,
+	0x31, 0x0e, 0x26, 0xbe, 0x3d, 0x0f, 0x6d, 0xcf, 0xe5, 0x34, 0x15, 0xa2, 0x51, 0x64, 0x04, 0x11,
+	0xdf, 0x86, 0xf2, 0x7c, 0x31, 0xd6, 0x6d, 0x77, 0xea, 0x11, 0x73, 0xaf, 0x6e, 0xd7, 0xf9, 0xc4,
+	0xec, 0x2f, 0xc6, 0x5d, 0x77, 0xea, 0xb1, 0xd2, 0x9c, 0x27, 0xb4, 0xd7, 0xa1, 0x24, 0x60, 0x28,
+	0xbd, 0x43, 0xcb, 0x35, 0xdc, 0x50, 0x8f, 0xc5, 0x7e, 0x99, 0x03, 0xba, 0xa6, 0xf6, 0x2f, 0x33,
+	0xa0, 0x0c, 0xa5, 0xcf, 0xec, 0x59, 0xa1, 0xb1, 0x96, 0x2b, 0xbc, 0x0c, 0x60, 0x4c, 0x26, 0xde,
+	0x82, 0x57, 0xc3, 0x17, 0x4f, 0x45, 0x40, 0xba, 0xa6, 0x3c, 0x36, 0xb9, 0xd4, 0xd8, 0xbc, 0x0a,
+	0xb5, 0xa8, 0x9c, 0xb4, 0xa1, 0xab, 0x02, 0x16, 0x8d, 0x4e, 0xb0, 0x48, 0xed, 0xea, 0x52, 0xb0,
+	0xe0, 0xa5, 0xaf, 0x40, 0x91, 0x74, 0x84, 0x20, 0x1a, 0x71, 0x9e, 0xd3, 0xfe, 0x41, 0x16, 0xca,
+	0x0f, 0x17, 0xee, 0x04, 0x9b, 0xac, 0xbe, 0x06, 0xf9, 0xe9, 0xc2, 0x9d, 0x34, 0x33, 0xb2, 0xc8,
+	0x88, 0x57, 0x0a, 0x23, 0x24, 0xee, 0x41, 0xc3, 0x3f, 0xc4, 0xbd, 0xbb, 0xb2, 0x07, 0x11, 0xae,
+	0xfd, 0xeb, 0x0c, 0xaf, 0xf1, 0xa1, 0x63, 0x1c, 0xaa, 0x65, 0xc8, 0xf7, 0x07, 0xfd, 0x8e, 0x72,
+	0x41, 0xad, 0x41, 0xb9, 0xdb, 0x1f, 0x75, 0x58, 0xbf, 0xd5, 0x53, 0x32, 0xb4, 0xa0, 0x47, 0xad,
+	0x9d, 0x5e, 0x47, 0xc9, 0x22, 0xe6, 0xe9, 0xa0, 0xd7, 0x1a, 0x75, 0x7b, 0x1d, 0x25, 0xcf, 0x31,
+	0xac, 0xdb, 0x1e, 0x29, 0x65, 0x55, 0x81, 0xda, 0x3e, 0x1b, 0xec, 0x1e, 0xb4, 0x3b, 0x7a, 0xff,
+	0xa0, 0xd7, 0x53, 0x14, 0xf5, 0x22, 0x6c, 0xc4, 0x90, 0x01, 0x07, 0x6e, 0x61, 0x91, 0xa7, 0x2d,
+	0xd6, 0x62, 0x8f, 0x94, 0x4f, 0xd5, 0x32, 0xe4, 0x5a, 0x8f, 0x1e, 0x29, 0xdf, 0xe2, 0xde, 0xa8,
+	0x3c, 0xeb, 0xf6, 0xf5, 0xa7, 0xad, 0xde, 0x41, 0x47, 0xf9, 0x36, 0x1b, 0xe5, 0x07, 0x6c, 0xb7,
+	0xc3, 0x94, 0x6f, 0xf3, 0xea, 0x26, 0xd4, 0xbe, 0x1c, 0xf4, 0x3b, 0x7b, 0xad, 0xfd, 0x7d, 0x6a,
+	0xc8, 0xb7, 0x65, 0xed, 0xd7, 0x79, 0xc8, 0x63, 0x4f, 0x54, 0x2d, 0xe1, 0x03, 0x71, 0x17, 0x71,
+	0x23, 0xee, 0xe4, 0x7f, 0xfd, 0xe7, 0xaf, 0x5c, 0xe0, 0x1c, 0xe0, 0x55, 0xc8, 0x39, 0x76, 0xd8,
+	0xcc, 0xca, 0xab, 0x47, 0xe8, 0x46, 0x8f, 0x2f, 0x30, 0xc4, 0xa9, 0x37, 0x21, 0xc3, 0x59, 0x41,
+	0x75, 0xbb, 0x21, 0x96, 0x97, 0x90, 0x25, 0x8f, 0x2f, 0xb0, 0xcc, 0x5c, 0xbd, 0x01, 0x99, 0xe7,
+	0x82, 0x2f, 0xd4, 0x38, 0x9e, 0x4b, 0x13, 0xc4, 0x3e, 0x57, 0xb7, 0x20, 0x37, 0xf1, 0xb8, 0xe6,
+	0x13, 0xe3, 0x39, 0x6f, 0xc5, 0xfa, 0x27, 0x9e, 0xa3, 0xbe, 0x06, 0x39, 0xdf, 0x38, 0x69, 0x16,
+	0xe5, 0xe9, 0x8a, 0x99, 0x37, 0x12, 0xf9, 0xc6, 0x09, 0x36, 0x62, 0xda, 0x2c, 0xc9, 0x8d, 0x88,
+	0xe6, 0x1b, 0x3f, 0x33, 0x55, 0xb7, 0x20, 0x73, 0xd2, 0x2c, 0xcb, 0xc2, 0xfe, 0x99, 0xed, 0x9a,
+	0xde, 0xc9, 0x70, 0x6e, 0x4d, 0x90, 0xe2, 0x44, 0xfd, 0x09, 0xe4, 0x82, 0xc5, 0x98, 0xf6, 0x52,
+	0x75, 0x7b, 0x73, 0x85, 0x2b, 0xe2, 0x87, 0x82, 0xc5, 0x58, 0x7d, 0x1d, 0xf2, 0x13, 0xcf, 0xf7,
+	0x9b, 0x20, 0xd7, 0x95, 0x08, 0x04, 0x54, 0x7e, 0x10, 0x8f, 0x1f, 0x0c, 0x9b, 0x55, 0x99, 0x28,
+	0xe1, 0xc8, 0xf8, 0xc1, 0x50, 0xbd, 0x25, 0xd8, 0x7c, 0x4d, 0x6e, 0x75, 0x24, 0x04, 0xb0, 0x1e,
+	0xc4, 0xe2, 0x24, 0xcd, 0x8c, 0xd3, 0x66, 0x5d, 0x26, 0x8a, 0xb8, 0x3f, 0xb6, 0x69, 0x66, 0x9c,
+	0xaa, 0xb7, 0x20, 0xf7, 0xdc, 0x9a, 0x34, 0x1b, 0xf2, 0xd7, 0xc4, 0x24, 0x3d, 0xa5, 0xee, 0x21,
+	0x1a, 0xe5, 0x99, 0xb1, 0x38, 0xc5, 0xed, 0xb8, 0xc1, 0x25, 0x8f, 0xb1, 0x38, 0xed, 0x9a, 0xc8,
+	0xd9, 0x5c, 0xf3, 0x39, 0x69, 0x59, 0x19, 0x86, 0x49, 0xd4, 0xf0, 0x03, 0xcb, 0xb1, 0x26, 0xa1,
+	0xfd, 0xdc, 0x0e, 0xcf, 0x48, 0xb5, 0xca, 0x30, 0x19, 0xb4, 0x53, 0x84, 0xbc, 0x75, 0x3a, 0xf7,
+	0xb5, 0x6d, 0x80, 0xe4, 0x3b, 0x58, 0x93, 0x63, 0xb9, 0x91, 0xe6, 0xe0, 0x58, 0x2e, 0x72, 0x06,
+	0xd3, 0x08, 0x0d, 0x5a, 0x3e, 0x35, 0x46, 0x69, 0xed, 0x1a, 0x54, 0x62, 0x95, 0x4c, 0xad, 0x41,
+	0xc6, 0x10, 0x1c, 0x39, 0x63, 0x68, 0xb7, 0x01, 0x04, 0xea, 0x9d, 0xed, 0x07, 0x69, 0x1c, 0xe6,
+	0x22, 0x3e, 0x9d, 0x19, 0x6b, 0x3f, 0x87, 0x1a, 0xb3, 0x82, 0x85, 0x13, 0xb6, 0x3d, 0x67, 0xd7,
+	0x9a, 0xaa, 0x6f, 0x01, 0xc4, 0xf9, 0x40, 0x08, 0xce, 0x64, 0x31, 0xed, 0x5a, 0x53, 0x26, 0xe1,
+	0xb5, 0x7f, 0x92, 0x87, 0xa2, 0x28, 0x98, 0x08, 0xf9, 0x8c, 0x24, 0xe4, 0x63, 0x96, 0x96, 0x4d,
+	0x2b, 0x3a, 0x47, 0xb6, 0x69, 0x5a, 0x6e, 0xa4, 0xd0, 0xf0, 0x1c, 0x8e, 0xbe, 0xe1, 0x1c, 0xd2,
+	0x0a, 0x6f, 0x6c, 0xab, 0xd1, 0x47, 0x67, 0x73, 0xdf, 0x0a, 0x02, 0x2e, 0x4a, 0x0d, 0xe7, 0x30,
+	0xda, 0x6c, 0x85, 0xef, 0xda, 0x6c, 0xd7, 0xa0, 0xec, 0x7a, 0xa1, 0x4e, 0xe6, 0x46, 0x91, 0xbe,
+	0x51, 0x12, 0x76, 0x95, 0xfa, 0x06, 0x94, 0x84, 0xa2, 0xd8, 0x2c, 0xc9, 0x7b, 0x71, 0x97, 0x03,
+	0x59, 0x84, 0x55, 0x9b, 0xa8, 0x77, 0xcc, 0x66, 0x96, 0x1b, 0x46, 0xa2, 0x43, 0x64, 0xd5, 0x9f,
+	0x42, 0xc5, 0x73, 0x75, 0xae, 0x4d, 0x36, 0x2b, 0xf2, 0x7a, 0x1a, 0xb8, 0x07, 0x04, 0x65, 0x65,
+	0x4f, 0xa4, 0xb0, 0x29, 0x8e, 0x77, 0xa2, 0x4f, 0x0c, 0xdf, 0xa4, 0xa5, 0x5e, 0x66, 0x25, 0xc7,
+	0x3b, 0x69, 0x1b, 0xbe, 0xc9, 0x45, 0xe9, 0xd7, 0xee, 0x62, 0x46, 0xcb, 0xbb, 0xce, 0x44, 0x4e,
+	0xbd, 0x01, 0x95, 0x89, 0xb3, 0x08, 0x42, 0xcb, 0xdf, 0x39, 0xe3, 0xf6, 0x01, 0x4b, 0x00, 0xd8,
+	0xae, 0xb9, 0x6f, 0xcf, 0x0c, 0xff, 0x8c, 0xd6, 0x72, 0x99, 0x45, 0x59, 0x54, 0x61, 0xe6, 0xc7,
+	0xb6, 0x79, 0xca, 0x8d, 0x04, 0xc6, 0x33, 0x48, 0x7f, 0x44, 0x26, 0x5c, 0x40, 0xcb, 0xb5, 0xcc,
+	0xa2, 0x2c, 0xcd, 0x03, 0x25, 0x69, 0xcd, 0x56, 0x98, 0xc8, 0xa5, 0xf4, 0xc0, 0xcd, 0x73, 0xf5,
+	0x40, 0x75, 0x59, 0x14, 0x7b, 0xbe, 0x7d, 0x68, 0x0b, 0x41, 0x7a, 0x91, 0x90, 0xc0, 0x41, 0xa4,
+	0x28, 0x7e, 0x0d, 0x25, 0x31, 0xc4, 0xea, 0x4d, 0xbe, 0xe8, 0xd3, 0xfc, 0x92, 0x8b, 0x04, 0x84,
+	0xab, 0xaf, 0x41, 0x5d, 0xd4, 0x15, 0x84, 0xbe, 0xed, 0x1e, 0x8a, 0xc5, 0x53, 0xe3, 0xc0, 0x21,
+	0xc1, 0x50, 0xbe, 0xe1, 0xf4, 0xea, 0xc6, 0xd8, 0x76, 0x70, 0x73, 0xe5, 0x84, 0xf9, 0xbc, 0x70,
+	0x9c, 0x16, 0x07, 0x69, 0x03, 0x28, 0x47, 0x13, 0xf2, 0x3b, 0xf9, 0xa6, 0xf6, 0x7b, 0x50, 0xed,
+	0xba, 0xa6, 0x75, 0x3a, 0x20, 0x91, 0xad, 0xbe, 0x05, 0xea, 0xc4, 0xb7, 0x8c, 0xd0, 0xd2, 0xad,
+	0xd3, 0xd0, 0x37, 0x74, 0x6e, 0x62, 0x73, 0xf3, 0x56, 0xe1, 0x98, 0x0e, 0x22, 0x46, 0x08, 0xd7,
+	0xfe, 0x5b, 0x06, 0xea, 0xfb, 0x7c, 0xa6, 0x9e, 0x58, 0x67, 0xbb, 0xdc, 0x08, 0x98, 0x44, 0xbb,
+	0x2c, 0xcf, 0x28, 0xad, 0xde, 0x84, 0xea, 0xfc, 0xd8, 0x3a, 0xd3, 0x53, 0x0a, 0x73, 0x05, 0x41,
+	0x6d, 0xda, 0x4f, 0x6f, 0x42, 0xd1, 0xa3, 0xaf, 0x37, 0x73, 0x32, 0x7f, 0x95, 0x9a, 0xc5, 0x04,
+	0x81, 0xaa, 0x41, 0x3d, 0xae, 0x4a, 0x56, 0x01, 0x44, 0x65, 0x34, 0x6d, 0x97, 0xa0, 0x80, 0xa8,
+	0xa0, 0x59, 0xd8, 0xca, 0xa1, 0xd6, 0x4b, 0x19, 0xf5, 0x6d, 0xa8, 0x4f, 0xbc, 0xd9, 0x5c, 0x8f,
+	0x8a, 0x0b, 0x91, 0x91, 0xe6, 0x03, 0x55, 0x24, 0xd9, 0xe7, 0x75, 0x69, 0x7f, 0x9c, 0x83, 0x32,
+	0xb5, 0x41, 0xb0, 0x02, 0xdb, 0x3c, 0x8d, 0x58, 0x41, 0x85, 0x15, 0x6c, 0x13, 0xf9, 0xe3, 0xcb,
+	0x00, 0x36, 0x92, 0xe8, 0x12, 0x43, 0xa8, 0x10, 0x24, 0x6a, 0xca, 0xdc, 0xf0, 0xc3, 0xa0, 0x99,
+	0xe3, 0x4d, 0xa1, 0x0c, 0xae, 0xd1, 0x85, 0x6b, 0x7f, 0xbd, 0xe0, 0xad, 0x2f, 0x33, 0x91, 0x53,
+	0x6f, 0x83, 0xc2, 0x2b, 0xa3, 0x41, 0x97, 0x75, 0x98, 0x06, 0xc1, 0x69, 0xcc, 0xa3, 0x95, 0xc9,
+	0x69, 0xac, 0x53, 0x14, 0x12, 0x9c, 0x1d, 0x00, 0x81, 0x3a, 0x08, 0x91, 0x37, 0x7a, 0x29, 0xbd,
+	0xd1, 0x9b, 0x50, 0x7a, 0x6e, 0x07, 0x36, 0xce, 0x6a, 0x99, 0x6f, 0x1d, 0x91, 0x95, 0xa6, 0xa1,
+	0xf2, 0xa2, 0x69, 0x88, 0xbb, 0x6d, 0x38, 0x87, 0x5c, 0x7b, 0x8c, 0xba, 0xdd, 0x72, 0x0e, 0x3d,
+	0xf5, 0x1d, 0xb8, 0x9c, 0xa0, 0x45, 0x6f, 0xc8, 0x97, 0x42, 0xee, 0x02, 0xa6, 0xc6, 0x94, 0xd4,
+	0x23, 0x52, 0xef, 0xef, 0xc0, 0xa6, 0x54, 0x64, 0x8e, 0x3a, 0x42, 0x40, 0x7c, 0xa2, 0xc2, 0x36,
+	0x62, 0x72, 0x52, 0x1d, 0x02, 0xed, 0xdf, 0x67, 0xa1, 0xfe, 0xd0, 0xf3, 0x2d, 0xfb, 0xd0, 0x4d,
+	0x56, 0xdd, 0x8a, 0x92, 0x19, 0xad, 0xc4, 0xac, 0xb4, 0x12, 0x5f, 0x81, 0xea, 0x94, 0x17, 0xd4,
+	0xc3, 0x31, 0xb7, 0x3d, 0xf3, 0x0c, 0x04, 0x68, 0x34, 0x76, 0x70, 0x07, 0x46, 0x04, 0x54, 0x38,
+	0x4f, 0x85, 0xa3, 0x42, 0x28, 0x1f, 0xd4, 0x8f, 0x88, 0x53, 0x9a, 0x96, 0x63, 0x85, 0x7c, 0x7a,
+	0x1a, 0xdb, 0x2f, 0x0b, 0xa5, 0x42, 0x6e, 0xd3, 0x5d, 0x66, 0x4d, 0x5b, 0xa4, 0x63, 0x20, 0xe3,
+	0xdc, 0x25, 0x72, 0xf5, 0x23, 0x99, 0xcb, 0x16, 0xbf, 0x67, 0x59, 0xbe, 0xdb, 0xb5, 0x11, 0x54,
+	0x62, 0x30, 0x2a, 0x8c, 0xac, 0x23, 0x94, 0xc4, 0x0b, 0x6a, 0x15, 0x4a, 0xed, 0xd6, 0xb0, 0xdd,
+	0xda, 0xed, 0x28, 0x19, 0x44, 0x0d, 0x3b, 0x23, 0xae, 0x18, 0x66, 0xd5, 0x0d, 0xa8, 0x62, 0x6e,
+	0xb7, 0xf3, 0xb0, 0x75, 0xd0, 0x1b, 0x29, 0x39, 0xb5, 0x0e, 0x95, 0xfe, 0x40, 0x6f, 0xb5, 0x47,
+	0xdd, 0x41, 0x5f, 0xc9, 0x6b, 0x9f, 0x42, 0xb9, 0x7d, 0x64, 0x4d, 0x8e, 0xcf, 0x1b, 0x45, 0xb2,
+	0xdd, 0xac, 0xc9, 0x71, 0x33, 0xbb, 0xc2, 0x64, 0x38, 0x42, 0x7b, 0x0a, 0xb5, 0x76, 0xc4, 0xc8,
+	0xcf, 0xab, 0x65, 0x1b, 0x1a, 0xb4, 0xf9, 0x26, 0xe3, 0x68, 0xf7, 0x65, 0xd7, 0xec, 0xbe, 0x1a,
+	0xd2, 0xb4, 0xc7, 0x62, 0xfb, 0xbd, 0x07, 0xd5, 0x7d, 0xdf, 0x9b, 0x5b, 0x7e, 0x48, 0xd5, 0x2a,
+	0x90, 0x3b, 0xb6, 0xce, 0x44, 0xad, 0x98, 0x4c, 0xac, 0xdb, 0xac, 0x6c, 0xdd, 0x6e, 0x43, 0x39,
+	0x2a, 0xf6, 0xbd, 0xcb, 0x7c, 0x02, 0x75, 0x51, 0xc6, 0xb6, 0x02, 0xfc, 0xd8, 0x5d, 0x80, 0x79,
+	0x0c, 0x10, 0x1a, 0x43, 0xa4, 0xbe, 0x8a, 0xca, 0x99, 0x44, 0xa1, 0xfd, 0x65, 0x0e, 0x1a, 0xfb,
+	0x86, 0x1f, 0xda, 0x38, 0x39, 0x7c, 0x18, 0xde, 0x80, 0x3c, 0x2d, 0x79, 0x6e, 0x48, 0x5f, 0x8c,
+	0x75, 0x5f, 0x4e, 0x43, 0xa2, 0x9f, 0x08, 0xd4, 0x8f, 0xa0, 0x31, 0x8f, 0xc0, 0x3a, 0xf1, 0x73,
+	0x3e, 0x36, 0xcb, 0x45, 0x68, 0xcc, 0xeb, 0x73, 0x39, 0xab, 0x7e, 0x0c, 0x97, 0xd2, 0x65, 0xad,
+	0x20, 0x48, 0xf8, 0xa8, 0x3c, 0x59, 0x17, 0x53, 0x05, 0x39, 0x99, 0xda, 0x86, 0xcd, 0xa4, 0xf8,
+	0xc4, 0x73, 0x16, 0x33, 0x37, 0x10, 0xca, 0xf8, 0x95, 0xa5, 0xaf, 0xb7, 0x39, 0x96, 0x29, 0xf3,
+	0x25, 0x88, 0xaa, 0x41, 0x2d, 0x86, 0xf5, 0x17, 0x33, 0xda, 0x12, 0x79, 0x96, 0x82, 0xa9, 0xf7,
+	0x01, 0xe2, 0x3c, 0x9a, 0x5f, 0xb9, 0x35, 0xfd, 0xeb, 0x86, 0xd6, 0x8c, 0x49, 0x64, 0xa8, 0x32,
+	0x20, 0x33, 0xf0, 0xed, 0xf0, 0x68, 0x46, 0x5c, 0x2c, 0xc7, 0x12, 0x00, 0x31, 0xcb, 0x40, 0x47,
+	0x5b, 0x2f, 0x2e, 0x22, 0x18, 0x5a, 0xc3, 0x0e, 0x86, 0x8b, 0x71, 0x5c, 0x2f, 0x8a, 0xc1, 0xa4,
+	0x97, 0xb3, 0xe0, 0x50, 0x58, 0xc4, 0x49, 0x0b, 0xf7, 0x82, 0x43, 0x75, 0x1b, 0x2e, 0x27, 0x44,
+	0x09, 0xff, 0x0d, 0x9a, 0x40, 0x9c, 0x3b, 0x19, 0xbe, 0x98, 0x09, 0x07, 0xda, 0x67, 0x50, 0x4f,
+	0xcd, 0xce, 0x0b, 0x05, 0xf2, 0x35, 0x28, 0xe3, 0x7f, 0x14, 0xc7, 0x62, 0x01, 0x96, 0x30, 0x3f,
+	0x0c, 0x7d, 0xcd, 0x02, 0x65, 0x79, 0xac, 0xd5, 0x5b, 0xe4, 0x25, 0xc2, 0xe4, 0x1a, 0x6f, 0x4f,
+	0x84, 0x42, 0xa3, 0x7f, 0x75, 0x12, 0xb3, 0xd4, 0xea, 0x95, 0xc9, 0xd2, 0x7e, 0x95, 0x85, 0x7a,
+	0x6a, 0xc4, 0xd5, 0x9f, 0xc8, 0xcb, 0x4f, 0xda, 0xb8, 0xc9, 0x98, 0x91, 0xc4, 0x79, 0x13, 0x14,
+	0xcf, 0x37, 0x6d, 0xd7, 0x20, 0xaf, 0x15, 0x1f, 0xee, 0x2c, 0x69, 0x78, 0x1b, 0x02, 0xbe, 0x2f,
+	0xc0, 0x68, 0x21, 0x98, 0x56, 0xec, 0x04, 0x10, 0x26, 0xbc, 0x0c, 0x92, 0xa5, 0x53, 0x3e, 0x2d,
+	0x9d, 0xde, 0x80, 0x8a, 0x63, 0x05, 0x81, 0x1e, 0x1e, 0x19, 0x6e, 0xb3, 0xb0, 0xd2, 0xe9, 0x32,
+	0x22, 0x47, 0x47, 0x86, 0x8b, 0x84, 0xb6, 0xab, 0x0b, 0x37, 0x7f, 0x71, 0x95, 0xd0, 0x76, 0xc9,
+	0x08, 0x42, 0xb9, 0x7f, 0x69, 0xdd, 0xc4, 0x0a, 0xb1, 0xa8, 0xae, 0xce, 0xab, 0xf6, 0x32, 0x94,
+	0x9e, 0xda, 0xd6, 0x89, 0xe0, 0x65, 0xcf, 0x6d, 0xeb, 0x24, 0xe2, 0x65, 0x98, 0xd6, 0xfe, 0x6b,
+	0x19, 0xca, 0x44, 0xbc, 0x7b, 0xbe, 0x77, 0xf0, 0x87, 0x58, 0x08, 0x5b, 0x90, 0x8f, 0x45, 0xcd,
+	0x32, 0x47, 0x24, 0x0c, 0x4a, 0x5b, 0x49, 0x86, 0x72, 0x8d, 0xa0, 0x12, 0xc6, 0xa2, 0x13, 0x55,
+	0x6b, 0x52, 0xcc, 0x82, 0xaf, 0x1d, 0xe1, 0xda, 0x48, 0x00, 0xea, 0x5d, 0xae, 0xf8, 0x92, 0x53,
+	0xa3, 0x24, 0x33, 0x16, 0xea, 0x43, 0x64, 0x07, 0x93, 0x36, 0x8c, 0x19, 0xd2, 0x0f, 0x2c, 0x3f,
+	0x88, 0xb6, 0x53, 0x9d, 0x45, 0x59, 0xe4, 0x68, 0xa8, 0x3c, 0x35, 0xab, 0x72, 0x2d, 0x29, 0xed,
+	0x8f, 0x11, 0x81, 0x7a, 0x1b, 0x4a, 0x24, 0xb2, 0x2d, 0x94, 0xe0, 0x12, 0xeb, 0x8c, 0x94, 0x29,
+	0x16, 0xa1, 0xd5, 0x37, 0xa1, 0x30, 0x3d, 0xb6, 0xce, 0x82, 0x66, 0x5d, 0x66, 0x09, 0x29, 0x59,
+	0xc8, 0x38, 0x85, 0x7a, 0x0b, 0x1a, 0xbe, 0x35, 0xd5, 0xc9, 0x5f, 0x88, 0xc2, 0x3b, 0x68, 0x36,
+	0x48, 0x36, 0xd7, 0x7c, 0x6b, 0xda, 0x46, 0xe0, 0x68, 0xec, 0x04, 0xea, 0xeb, 0x50, 0x24, 0xa9,
+	0x84, 0x76, 0x81, 0xf4, 0xe5, 0x48, 0xc4, 0x31, 0x81, 0x55, 0xb7, 0xa1, 0x92, 0xb0, 0x8d, 0xcb,
+	0xd4, 0xa1, 0x4b, 0x4b, 0xfc, 0x88, 0xd8, 0x38, 0x4b, 0xc8, 0xd4, 0x77, 0x00, 0x84, 0xc5, 0xa2,
+	0x8f, 0xcf, 0xc8, 0x03, 0x5f, 0x8d, 0x2d, 0x3a, 0x49, 0x00, 0xca, 0x76, 0xcd, 0x1b, 0x50, 0x40,
+	0x29, 0x11, 0x34, 0xaf, 0x6e, 0xe5, 0x12, 0x8d, 0x4a, 0x12, 0x6b, 0x8c, 0xe3, 0xd1, 0x19, 0x87,
+	0x8b, 0x4b, 0xc7, 0x29, 0x6c, 0xca, 0x26, 0x9c, 0x58, 0x89, 0xa8, 0xa5, 0x59, 0x27, 0xc3, 0xaf,
+	0x1d, 0xf5, 0x0e, 0xe4, 0x4d, 0x6b, 0x1a, 0x34, 0xaf, 0x6d, 0xe5, 0x12, 0x36, 0x1d, 0xad, 0x47,
+	0xb4, 0xf8, 0xb8, 0x68, 0x41, 0x1a, 0xf5, 0x31, 0x34, 0x70, 0xe9, 0x6d, 0x93, 0xe2, 0x8d, 0x43,
+	0xde, 0xbc, 0x4e, 0xa5, 0x5e, 0x5d, 0x2a, 0xd5, 0x17, 0x44, 0x34, 0x41, 0x1d, 0x37, 0xf4, 0xcf,
+	0x58, 0xdd, 0x95, 0x61, 0xea, 0x75, 0x28, 0xdb, 0x41, 0xcf, 0x9b, 0x1c, 0x5b, 0x66, 0xf3, 0x25,
+	0x7e, 0x68, 0x17, 0xe5, 0xd5, 0x0f, 0xa1, 0x4e, 0x8b, 0x11, 0xb3, 0xf8, 0xf1, 0xe6, 0x0d, 0x59,
+	0xe4, 0x8d, 0x64, 0x14, 0x4b, 0x53, 0xa2, 0xba, 0x65, 0x07, 0x7a, 0x68, 0xcd, 0xe6, 0x9e, 0x8f,
+	0xc6, 0xdf, 0xcb, 0xdc, 0xe0, 0xb1, 0x83, 0x51, 0x04, 0x42, 0x3e, 0x1f, 0x9f, 0x17, 0xea, 0xde,
+	0x74, 0x1a, 0x58, 0x61, 0xf3, 0x26, 0xed, 0xb5, 0x46, 0x74, 0x6c, 0x38, 0x20, 0x28, 0x29, 0xa5,
+	0x81, 0x6e, 0x9e, 0xb9, 0xc6, 0xcc, 0x9e, 0x34, 0x5f, 0xe1, 0x36, 0xa6, 0x1d, 0xec, 0x72, 0x80,
+	0x6c, 0xe6, 0x6d, 0xc9, 0x66, 0xde, 0xf5, 0x47, 0x64, 0xc5, 0x51, 0x7b, 0xde, 0x5b, 0x92, 0xfb,
+	0xa9, 0x85, 0x2e, 0x29, 0x08, 0x78, 0x34, 0x93, 0x10, 0xee, 0x14, 0x20, 0x67, 0x5a, 0xd3, 0xeb,
+	0x9f, 0x82, 0xba, 0x3a, 0x92, 0x2f, 0x52, 0x42, 0x0a, 0x42, 0x09, 0xf9, 0x28, 0xfb, 0x20, 0xa3,
+	0x7d, 0x08, 0xf5, 0xd4, 0xb6, 0x5c, 0xab, 0x4c, 0x71, 0xa3, 0xc2, 0x98, 0x09, 0xc7, 0x09, 0xcf,
+	0x68, 0xff, 0x29, 0x07, 0xb5, 0xc7, 0x46, 0x70, 0xb4, 0x67, 0xcc, 0x87, 0xa1, 0x11, 0x06, 0x38,
+	0xb6, 0x47, 0x46, 0x70, 0x34, 0x33, 0xe6, 0xdc, 0xaf, 0x9e, 0xe1, 0x9e, 0x1a, 0x01, 0x43, 0xdf,
+	0x3a, 0xce, 0x2a, 0x66, 0x07, 0xee, 0xfe, 0x13, 0x71, 0x3e, 0x13, 0xe7, 0x91, 0x0f, 0x04, 0x47,
+	0x8b, 0xe9, 0xd4, 0xb1, 0x04, 0xbf, 0x8a, 0xb2, 0xea, 0x2d, 0xa8, 0x8b, 0x24, 0x99, 0x6f, 0xa7,
+	0xe2, 0xb0, 0x36, 0x0d, 0x54, 0xef, 0x43, 0x55, 0x00, 0x46, 0x11, 0xd7, 0x6a, 0xc4, 0x9e, 0xb3,
+	0x04, 0xc1, 0x64, 0x2a, 0xf5, 0x17, 0x70, 0x59, 0xca, 0x3e, 0xf4, 0xfc, 0xbd, 0x85, 0x13, 0xda,
+	0xed, 0xbe, 0xd0, 0x95, 0x5f, 0x5a, 0x29, 0x9e, 0x90, 0xb0, 0xf5, 0x25, 0xd3, 0xad, 0xdd, 0xb3,
+	0x5d, 0xa1, 0x49, 0xa4, 0x81, 0x4b, 0x54, 0xc6, 0x69, 0xb3, 0xbc, 0x42, 0x65, 0x9c, 0xe2, 0x4a,
+	0x17, 0x80, 0x3d, 0x2b, 0x3c, 0xf2, 0xcc, 0x66, 0x45, 0x5e, 0xe9, 0x43, 0x19, 0xc5, 0xd2, 0x94,
+	0x38, 0x9c, 0x68, 0xc6, 0x4f, 0xdc, 0x90, 0xcc, 0xa5, 0x1c, 0x8b, 0xb2, 0x28, 0x17, 0x7c, 0xc3,
+	0x3d, 0xb4, 0x82, 0x66, 0x75, 0x2b, 0x77, 0x3b, 0xc3, 0x44, 0x4e, 0xfb, 0x5b, 0x59, 0x28, 0xf0,
+	0x99, 0x7c, 0x09, 0x2a, 0x63, 0x3c, 0x8d, 0xd7, 0xd1, 0xad, 0x22, 0x9c, 0xee, 0x04, 0x40, 0xd5,
+	0x8a, 0xcc, 0x9c, 0x80, 0x3b, 0x61, 0x33, 0x8c, 0xd2, 0x58, 0xa5, 0xb7, 0x08, 0xf1, 0x5b, 0x39,
+	0x82, 0x8a, 0x1c, 0x36, 0xc2, 0xf7, 0x4e, 0x68, 0x35, 0xe4, 0x09, 0x11, 0x65, 0xf1, 0x13, 0x5c,
+	0xc4, 0x60, 0xa1, 0x02, 0xe1, 0xca, 0x04, 0x68, 0xbb, 0xe1, 0xb2, 0xcb, 0xaf, 0xb8, 0xe2, 0xf2,
+	0xc3, 0x53, 0xf7, 0xa9, 0xe7, 0x4f, 0xac, 0x81, 0x6b, 0xb5, 0xfb, 0x34, 0xc2, 0x65, 0x26, 0x41,
+	0xd4, 0xf7, 0xe3, 0xb5, 0x48, 0x3d, 0x6a, 0x96, 0x65, 0xe6, 0x29, 0xaf, 0x5a, 0x96, 0xa2, 0xd3,
+	0x9e, 0x01, 0x30, 0xef, 0x24, 0xb0, 0x42, 0x52, 0xaf, 0xae, 0x52, 0xf3, 0x53, 0xc7, 0x69, 0xde,
+	0x09, 0x9e, 0x9a, 0x89, 0x53, 0xc9, 0x6c, 0x7c, 0x2a, 0x19, 0x6b, 0x62, 0xb9, 0xf5, 0x9a, 0x98,
+	0x76, 0x0f, 0x4a, 0x28, 0x62, 0x8d, 0xd0, 0x40, 0x4f, 0x2b, 0xb9, 0x21, 0xb9, 0x8a, 0x25, 0x1c,
+	0xa4, 0xc9, 0x57, 0x85, 0x63, 0xb2, 0x17, 0xb5, 0x84, 0xca, 0xbc, 0x2a, 0x79, 0x39, 0x62, 0x56,
+	0x2d, 0x2a, 0x14, 0x42, 0xfb, 0x25, 0xa8, 0x60, 0x63, 0xe9, 0x64, 0x42, 0xb4, 0x0c, 0xcf, 0xb8,
+	0xda, 0x98, 0xd7, 0xfe, 0x7b, 0x06, 0xaa, 0x03, 0xdf, 0x44, 0x19, 0x81, 0x3e, 0xe6, 0x17, 0x2a,
+	0x8e, 0x28, 0xe2, 0x3d, 0xc7, 0x31, 0x62, 0xb5, 0xab, 0xc2, 0x12, 0x80, 0xfa, 0x0e, 0xe4, 0xa7,
+	0x8e, 0x71, 0xd8, 0xcc, 0xc9, 0x06, 0xa5, 0x54, 0x7d, 0x94, 0xc6, 0xe3, 0x08, 0x46, 0xa4, 0xda,
+	0x1f, 0x40, 0x55, 0x02, 0xa6, 0x4e, 0x26, 0x2e, 0xd0, 0x29, 0xd9, 0xb0, 0xad, 0x64, 0xf0, 0xe8,
+	0x62, 0xb7, 0x33, 0x6c, 0x73, 0x33, 0x12, 0x0d, 0xca, 0xa1, 0xfe, 0xb0, 0xcb, 0x86, 0x23, 0x25,
+	0x4f, 0xc7, 0x6e, 0x04, 0xe8, 0xb5, 0x86, 0x78, 0x4e, 0x01, 0x50, 0x3c, 0xe8, 0x77, 0x7f, 0x71,
+	0xd0, 0x51, 0x14, 0xed, 0x8f, 0xb2, 0x00, 0x89, 0x03, 0x5d, 0xfd, 0x29, 0x54, 0x4f, 0x28, 0xa7,
+	0x4b, 0x27, 0x2b, 0x72, 0x1f, 0x81, 0xa3, 0x49, 0xfd, 0xf8, 0x99, 0x64, 0x4d, 0xa0, 0x98, 0x5d,
+	0x3d, 0x62, 0xa9, 0xce, 0x13, 0x09, 0xad, 0xbe, 0x05, 0x65, 0x0f, 0xfb, 0x81, 0xa4, 0x39, 0x59,
+	0xc6, 0x4a, 0xdd, 0x67, 0x25, 0xcf, 0x37, 0x23, 0x71, 0x3c, 0xf5, 0x23, 0xaf, 0x51, 0x4c, 0xfa,
+	0x10, 0x41, 0x6d, 0xc7, 0x58, 0x04, 0x16, 0xe3, 0xf8, 0x98, 0xed, 0x16, 0x24, 0xb6, 0xfb, 0x12,
+	0x54, 0xa6, 0xbe, 0x37, 0xd3, 0x1d, 0x23, 0xf6, 0xb8, 0x94, 0x11, 0xd0, 0x33, 0x82, 0x90, 0x04,
+	0xd9, 0xa1, 0xeb, 0xf9, 0x16, 0xf9, 0x67, 0x03, 0xb1, 0x05, 0xaa, 0x1c, 0x86, 0x3e, 0xda, 0x40,
+	0xfb, 0x12, 0x1a, 0x43, 0x63, 0x36, 0xe7, 0xcc, 0x9d, 0x06, 0x46, 0x85, 0x3c, 0xae, 0x29, 0xb1,
+	0x98, 0x29, 0x8d, 0x5b, 0x74, 0xdf, 0xf2, 0x27, 0x96, 0x1b, 0xed, 0xe8, 0x28, 0x8b, 0xcc, 0xfa,
+	0x20, 0xb0, 0xdd, 0x43, 0xe6, 0x9d, 0x44, 0x71, 0x33, 0x51, 0x5e, 0xfb, 0xa7, 0x19, 0xa8, 0x4a,
+	0xdd, 0x50, 0xef, 0xa5, 0x8c, 0xcf, 0x97, 0x56, 0xfa, 0xc9, 0xd3, 0x92, 0x11, 0xfa, 0x3a, 0x14,
+	0x82, 0xd0, 0xf0, 0xa3, 0xb3, 0x1c, 0x45, 0x2a, 0xb1, 0xe3, 0x2d, 0x5c, 0x93, 0x71, 0x34, 0x3a,
+	0xaa, 0x2d, 0xd7, 0x6c, 0xe6, 0xce, 0xa1, 0x42, 0xa4, 0xb6, 0x05, 0x95, 0xb8, 0x7a, 0x5c, 0x42,
+	0x6c, 0xf0, 0x6c, 0xa8, 0x5c, 0x50, 0x2b, 0x50, 0x60, 0xad, 0xfe, 0xa3, 0x8e, 0x92, 0xc1, 0x83,
+	0x42, 0x48, 0x4a, 0xa9, 0x77, 0x53, 0xad, 0xbd, 0xbe, 0x5c, 0xeb, 0x5d, 0xfa, 0x2b, 0x35, 0xf6,
+	0x06, 0x54, 0x16, 0x2e, 0x01, 0x2d, 0x53, 0xc8, 0xad, 0x04, 0x80, 0x51, 0x0d, 0x51, 0x84, 0xcd,
+	0x52, 0x54, 0xc3, 0x73, 0xc3, 0xd1, 0x3e, 0x82, 0x4a, 0x5c, 0x1d, 0xfa, 0x42, 0x1e, 0x0e, 0x7a,
+	0xbd, 0xc1, 0xb3, 0x6e, 0xff, 0x91, 0x72, 0x01, 0xb3, 0xfb, 0xac, 0xd3, 0xee, 0xec, 0x62, 0x36,
+	0x83, 0x6b, 0xbe, 0x7d, 0xc0, 0x58, 0xa7, 0x3f, 0xd2, 0xd9, 0xe0, 0x99, 0x92, 0xd5, 0xfe, 0x4e,
+	0x1e, 0x36, 0x07, 0xee, 0xee, 0x62, 0xee, 0xd8, 0x13, 0x23, 0xb4, 0x9e, 0x58, 0x67, 0xed, 0xf0,
+	0x14, 0xc5, 0xb1, 0x11, 0x86, 0x3e, 0x67, 0x06, 0x15, 0xc6, 0x33, 0xdc, 0x97, 0x17, 0x58, 0x7e,
+	0x48, 0xae, 0x4a, 0x99, 0x0b, 0x34, 0x38, 0xbc, 0xed, 0x39, 0xc4, 0x0b, 0xd4, 0x8f, 0xe1, 0x32,
+	0xf7, 0xff, 0x71, 0x4a, 0xd4, 0x4f, 0x75, 0xc1, 0xbb, 0x96, 0x97, 0xbe, 0xca, 0x09, 0xb1, 0x28,
+	0x92, 0x21, 0x0c, 0x5d, 0x5a, 0x49, 0x71, 0x6e, 0x45, 0x54, 0x18, 0xc4, 0x84, 0xd4, 0x12, 0xf4,
+	0x57, 0x45, 0xad, 0xd6, 0xd1, 0x99, 0x8e, 0x96, 0x55, 0x81, 0x35, 0xbc, 0xa4, 0x33, 0x28, 0xb2,
+	0x3f, 0x87, 0xcd, 0x14, 0x25, 0xb5, 0x82, 0xdb, 0x56, 0x6f, 0x45, 0x67, 0x01, 0x4b, 0xbd, 0x97,
+	0x21, 0xd8, 0x1c, 0xae, 0x3c, 0x6e, 0x78, 0x69, 0x28, 0xee, 0x1d, 0x3b, 0xd0, 0xf9, 0x6e, 0x10,
+	0x7b, 0xa3, 0x6c, 0x07, 0x5d, 0xca, 0x27, 0xe6, 0x8d, 0x74, 0xa4, 0xcd, 0xa5, 0x51, 0x74, 0xa2,
+	0xcb, 0xd1, 0x36, 0x97, 0xb7, 0x79, 0x56, 0xa2, 0x7c, 0xd7, 0x44, 0xcb, 0x9e, 0xa3, 0x22, 0x8b,
+	0x05, 0xc8, 0x62, 0xa9, 0x11, 0xf0, 0x29, 0x87, 0x5d, 0xef, 0xc3, 0xa5, 0x75, 0x8d, 0x5c, 0xa3,
+	0x97, 0x6d, 0xc9, 0x7a, 0xd9, 0x92, 0xaf, 0x2b, 0xd1, 0xd1, 0xfe, 0x4d, 0x16, 0x2a, 0x5d, 0x3e,
+	0x85, 0xe1, 0x29, 0x1e, 0x81, 0xfa, 0xd6, 0xf4, 0xbc, 0xe3, 0x62, 0xc4, 0xa1, 0x6b, 0xd3, 0x30,
+	0x4d, 0xdd, 0x98, 0x4e, 0xad, 0x49, 0x68, 0x99, 0x3a, 0xca, 0x5c, 0xb1, 0x6c, 0x37, 0x0c, 0xd3,
+	0x6c, 0x09, 0x38, 0x6d, 0x7f, 0xee, 0xd5, 0x88, 0xcc, 0x0c, 0xea, 0x87, 0xd8, 0xec, 0x0d, 0x3b,
+	0x10, 0x56, 0x06, 0x69, 0x88, 0x78, 0x60, 0xc3, 0xfb, 0x6e, 0x5a, 0x53, 0xc1, 0xcf, 0x1a, 0x69,
+	0xb5, 0x5e, 0x48, 0x70, 0xee, 0xcf, 0xba, 0xb8, 0x6c, 0x04, 0xdb, 0x26, 0x77, 0x90, 0xe7, 0xd9,
+	0x66, 0xda, 0x06, 0xee, 0x9a, 0xc1, 0xf9, 0xde, 0x90, 0xe2, 0xb9, 0xde, 0x90, 0xb4, 0x9b, 0x05,
+	0x17, 0x59, 0x89, 0x96, 0x7b, 0xc2, 0xce, 0xbb, 0xe6, 0xa9, 0xf6, 0x8f, 0x73, 0x78, 0x16, 0x37,
+	0x77, 0x8c, 0x89, 0xf5, 0xff, 0xcf, 0xe8, 0xbd, 0x82, 0x0e, 0x0d, 0xc7, 0x0a, 0x71, 0x8b, 0xb9,
+	0x66, 0x14, 0xcc, 0xc1, 0x41, 0x6d, 0x8f, 0x18, 0xd8, 0xda, 0xe1, 0x2d, 0xfe, 0xe0, 0xe1, 0x2d,
+	0xfd, 0x80, 0xe1, 0x2d, 0xaf, 0x0e, 0xaf, 0xfa, 0x29, 0xbc, 0xec, 0x5b, 0x27, 0xbe, 0x1d, 0x5a,
+	0x3a, 0xc9, 0xaa, 0xd4, 0x76, 0xc6, 0xd5, 0x5e, 0xa1, 0xd1, 0xb8, 0x26, 0x88, 0x1e, 0xfa, 0xde,
+	0x2c, 0xbd, 0xa5, 0xb5, 0xbf, 0xca, 0x43, 0xb5, 0xe5, 0x1a, 0xce, 0xd9, 0x37, 0x16, 0x05, 0x7c,
+	0x90, 0xa7, 0x7f, 0xbe, 0x08, 0xf9, 0xb8, 0xf3, 0x03, 0xd7, 0x0a, 0x41, 0x68, 0xc4, 0xf1, 0x88,
+	0x6c, 0x11, 0xc6, 0x78, 0x7e, 0x04, 0x0b, 0x1c, 0x44, 0x04, 0x71, 0x79, 0xd2, 0x3a, 0x73, 0x52,
+	0x79, 0xb2, 0x40, 0x92, 0xf2, 0xb1, 0x56, 0x1a, 0x97, 0x27, 0x02, 0xdc, 0xe2, 0xf6, 0x8c, 0x46,
+	0x3e, 0x58, 0xcc, 0x2c, 0x3e, 0xfa, 0x39, 0x1e, 0x58, 0xd7, 0x16, 0x30, 0xac, 0x65, 0x66, 0xcd,
+	0x3c, 0xff, 0x8c, 0xd7, 0x52, 0xe4, 0xb5, 0x70, 0x10, 0xd5, 0xf2, 0x16, 0xa8, 0x27, 0x86, 0x1d,
+	0xea, 0xe9, 0xaa, 0xb8, 0x25, 0xa0, 0x20, 0x66, 0x24, 0x57, 0x77, 0x05, 0x8a, 0xa6, 0x1d, 0x1c,
+	0x77, 0x07, 0xc2, 0x0a, 0x10, 0x39, 0xe4, 0x62, 0xc1, 0xfd, 0xee, 0x40, 0x1f, 0x9f, 0x89, 0x33,
+	0xd2, 0x1c, 0x2b, 0x23, 0x60, 0xe7, 0x2c, 0xa4, 0xc3, 0x1b, 0x42, 0xf2, 0xde, 0x72, 0x86, 0xcf,
+	0x35, 0xfd, 0x06, 0xc2, 0xbb, 0x08, 0xe6, 0x0c, 0xff, 0x0e, 0x6c, 0x12, 0xa5, 0xe8, 0x38, 0x27,
+	0xad, 0x12, 0xe9, 0x06, 0x22, 0x06, 0x8b, 0x30, 0xa6, 0xbd, 0x01, 0x15, 0xd7, 0x0a, 0x4f, 0x3c,
+	0x1f, 0x5b, 0x53, 0xe3, 0xa3, 0x17, 0x03, 0x50, 0x25, 0x08, 0x26, 0x86, 0x8b, 0x8d, 0x6f, 0xd6,
+	0x45, 0x7b, 0x44, 0x1e, 0x55, 0x72, 0x2e, 0x68, 0x08, 0xdb, 0xe0, 0x43, 0x92, 0x40, 0xd4, 0x0f,
+	0xe1, 0x5a, 0x6a, 0x34, 0x74, 0xc3, 0xf7, 0x8d, 0x33, 0x7d, 0x66, 0x7c, 0xe5, 0xf9, 0xe4, 0x3c,
+	0xc9, 0xb1, 0x2b, 0xf2, 0x20, 0xb7, 0x10, 0xbd, 0x87, 0xd8, 0x73, 0x8b, 0xda, 0xae, 0x87, 0xc7,
+	0xae, 0xe7, 0x14, 0x45, 0x2c, 0xe9, 0x49, 0x34, 0x40, 0x64, 0xbf, 0x04, 0x74, 0x14, 0x9b, 0x63,
+	0x55, 0x82, 0xed, 0x10, 0x48, 0xf3, 0x25, 0x57, 0xfa, 0xbe, 0xbf, 0x70, 0x2d, 0xee, 0x7c, 0xa0,
+	0xa4, 0x29, 0x4e, 0x22, 0xe3, 0xbc, 0xba, 0x0b, 0x17, 0xb9, 0x21, 0x62, 0x99, 0xba, 0xe4, 0x62,
+	0xce, 0x9e, 0xef, 0x62, 0x56, 0x23, 0xfa, 0x18, 0x1c, 0x68, 0xdf, 0x66, 0xe0, 0xfa, 0x80, 0x4e,
+	0x45, 0x69, 0xc7, 0xed, 0x59, 0x41, 0x60, 0x1c, 0xa2, 0x15, 0xf9, 0x70, 0xf1, 0xcd, 0x37, 0xe8,
+	0x83, 0xd8, 0xd8, 0x37, 0x7c, 0xcb, 0x0d, 0xe3, 0xfd, 0x28, 0xc4, 0xc6, 0x32, 0x58, 0x7d, 0x40,
+	0x6e, 0x5c, 0xcb, 0x0d, 0x0f, 0x62, 0x01, 0xdc, 0xcc, 0xae, 0x71, 0xec, 0xad, 0x50, 0x69, 0xbf,
+	0xba, 0x01, 0xf9, 0xbe, 0x67, 0x5a, 0xea, 0xdb, 0x50, 0xa1, 0xb0, 0xba, 0xd5, 0xd3, 0x03, 0x44,
+	0xd3, 0x1f, 0xd2, 0x85, 0xca, 0xae, 0x48, 0x9d, 0x1f, 0x88, 0xf7, 0x2a, 0x69, 0x75, 0x74, 0xfc,
+	0x88, 0x1c, 0xae, 0x2a, 0xec, 0x54, 0x04, 0x31, 0x8e, 0xc1, 0xb1, 0x25, 0x97, 0x9a, 0x6f, 0xb9,
+	0xa4, 0x3b, 0x14, 0x58, 0x9c, 0x27, 0x5d, 0xdc, 0xf7, 0x90, 0x1b, 0xeb, 0x14, 0x8b, 0x52, 0x58,
+	0xa3, 0x8b, 0x73, 0x3c, 0x45, 0x26, 0xbe, 0x0d, 0x95, 0xaf, 0x3c, 0xdb, 0xe5, 0x0d, 0x2f, 0xae,
+	0x34, 0xfc, 0x33, 0xcf, 0xe6, 0xc7, 0x1e, 0xe5, 0xaf, 0x44, 0x4a, 0x7d, 0x0d, 0x4a, 0x9e, 0xcb,
+	0xeb, 0x2e, 0xad, 0xd4, 0x5d, 0xf4, 0xdc, 0x1e, 0x8f, 0x71, 0xa9, 0x8f, 0x17, 0xe8, 0xf4, 0x43,
+	0x52, 0x6b, 0x1a, 0x0a, 0x2f, 0x7f, 0x95, 0x80, 0x03, 0xb7, 0x67, 0x4d, 0x31, 0x7a, 0xa1, 0x3a,
+	0xb5, 0x1d, 0x64, 0xfa, 0x54, 0x59, 0x65, 0xa5, 0x32, 0xe0, 0x68, 0xaa, 0xf0, 0x27, 0x50, 0x3e,
+	0xf4, 0xbd, 0xc5, 0x1c, 0x6d, 0x06, 0x58, 0xa1, 0x2c, 0x11, 0x6e, 0xe7, 0x0c, 0x7b, 0x4f, 0x49,
+	0xdb, 0x3d, 0xd4, 0xd1, 0xe9, 0x54, 0x5d, 0xed, 0x7d, 0x84, 0x1f, 0x5a, 0x54, 0xab, 0x71, 0x78,
+	0xa8, 0x8b, 0xa0, 0x9d, 0x95, 0x5a, 0x8d, 0xc3, 0x43, 0xfa, 0xf8, 0x5d, 0xa8, 0x9f, 0xe0, 0x81,
+	0xfc, 0xdc, 0x9a, 0x70, 0xda, 0xfa, 0x6a, 0xb5, 0x27, 0xb6, 0x8b, 0xf6, 0x01, 0xd1, 0xcb, 0x06,
+	0x4e, 0xe3, 0x85, 0x06, 0xce, 0x16, 0x14, 0x1c, 0x7b, 0x66, 0x87, 0x14, 0x15, 0xb1, 0xa4, 0xc1,
+	0x10, 0x42, 0xd5, 0xa0, 0x28, 0x9c, 0x68, 0xca, 0x0a, 0x89, 0xc0, 0xa4, 0x85, 0xe3, 0xe6, 0x0b,
+	0x84, 0xe3, 0x6d, 0xc0, 0xf0, 0x43, 0x1d, 0xc5, 0xb8, 0xba, 0x5e, 0x8c, 0x17, 0xbd, 0xf1, 0x57,
+	0x18, 0x65, 0xf9, 0x1e, 0x9d, 0x34, 0x58, 0x6e, 0xa8, 0x47, 0x05, 0x2e, 0xae, 0x2f, 0x50, 0xe3,
+	0x64, 0x03, 0x5e, 0xec, 0x1d, 0xa8, 0xfa, 0x64, 0x79, 0xeb, 0x64, 0xa6, 0x5f, 0x92, 0x4d, 0x8f,
+	0xc4, 0x24, 0x67, 0xe0, 0xc7, 0x69, 0x14, 0x1a, 0x3c, 0x7a, 0x81, 0x1f, 0x57, 0x07, 0xe4, 0xac,
+	0xad, 0xb0, 0x1a, 0x01, 0xf9, 0x51, 0x76, 0x80, 0x67, 0x7c, 0x91, 0x54, 0x0f, 0x4f, 0x9b, 0x57,
+	0xe5, 0xa6, 0xf0, 0xd3, 0xda, 0x76, 0x78, 0xca, 0x2a, 0x66, 0x94, 0x44, 0xd6, 0x35, 0xb6, 0x5d,
+	0x13, 0x97, 0x43, 0x68, 0x1c, 0x06, 0xcd, 0x26, 0xed, 0x96, 0xaa, 0x80, 0x8d, 0x8c, 0xc3, 0x40,
+	0x7d, 0x17, 0x6a, 0x06, 0x97, 0x9d, 0x3c, 0xac, 0xf2, 0x9a, 0x6c, 0x66, 0x4a, 0x52, 0x95, 0x55,
+	0x8d, 0x24, 0xa3, 0x7e, 0x00, 0x6a, 0xe4, 0xa1, 0x27, 0x95, 0x9b, 0xaf, 0x8b, 0xeb, 0x2b, 0xeb,
+	0x62, 0x43, 0xb8, 0xe8, 0xe3, 0x50, 0xe0, 0x0f, 0xa0, 0x9e, 0xd6, 0x75, 0x6e, 0xac, 0xf1, 0x49,
+	0xd3, 0x94, 0xb1, 0xda, 0x44, 0xca, 0xe1, 0xf8, 0x60, 0x28, 0xd1, 0xc4, 0x98, 0x1c, 0x59, 0x54,
+	0x90, 0xfb, 0x5d, 0x6b, 0xae, 0x17, 0xb6, 0x23, 0x18, 0x8e, 0x4f, 0x64, 0xc1, 0x84, 0xa7, 0xcd,
+	0x9b, 0xf2, 0xf8, 0xc4, 0xea, 0x2f, 0x8a, 0x72, 0x91, 0xa4, 0x79, 0xe2, 0x9a, 0x1d, 0x15, 0x78,
+	0x25, 0x35, 0x4f, 0xb1, 0xca, 0xc7, 0xc0, 0x8f, 0xd3, 0x14, 0xeb, 0xea, 0x2d, 0xfc, 0x89, 0xa5,
+	0x07, 0xa1, 0x35, 0x6f, 0x6e, 0xd1, 0x88, 0x02, 0x07, 0x0d, 0x43, 0x6b, 0xae, 0x3e, 0x80, 0xc6,
+	0xdc, 0xb7, 0x74, 0x69, 0x9e, 0x5e, 0x95, 0xbb, 0xb8, 0xef, 0x5b, 0xc9, 0x54, 0xd5, 0xe6, 0x52,
+	0x2e, 0x2a, 0x29, 0xf5, 0x40, 0x5b, 0x2a, 0x99, 0x74, 0xa2, 0x36, 0x97, 0x72, 0xea, 0x27, 0xb0,
+	0x29, 0x95, 0x5c, 0x1c, 0x53, 0xe1, 0xd7, 0x52, 0x47, 0x04, 0x11, 0xf9, 0xc1, 0x31, 0x16, 0x6f,
+	0xcc, 0x53, 0x79, 0xb5, 0x05, 0xca, 0x8a, 0xde, 0x75, 0x8b, 0xca, 0x5f, 0x3d, 0xc7, 0x8a, 0x4a,
+	0x59, 0x62, 0x4f, 0xb8, 0x87, 0xb8, 0x1b, 0x74, 0x5c, 0xb3, 0xf9, 0x13, 0x1e, 0xaf, 0x4f, 0x19,
+	0xf5, 0x3e, 0xd4, 0xc8, 0x0d, 0x18, 0x52, 0xac, 0x60, 0xd0, 0x7c, 0x5d, 0xf6, 0x58, 0x91, 0x4f,
+	0x9d, 0x10, 0xac, 0xea, 0xc4, 0xe9, 0x40, 0x7d, 0x1f, 0x36, 0xb9, 0xf3, 0x50, 0x66, 0x90, 0x6f,
+	0xac, 0x2e, 0x2e, 0x22, 0x7a, 0x98, 0x70, 0x49, 0x06, 0xd7, 0xfc, 0x85, 0x4b, 0x72, 0x5e, 0x94,
+	0x9c, 0xfb, 0xde, 0xd8, 0xe2, 0xe5, 0x6f, 0x6f, 0xe5, 0x92, 0xee, 0x30, 0x4e, 0xc6, 0xcb, 0x12,
+	0x3f, 0xba, 0xe2, 0xcb, 0xa0, 0x7d, 0x2c, 0x77, 0x4e, 0x9d, 0x9c, 0xb3, 0x53, 0x9d, 0x6f, 0xfe,
+	0x90, 0x3a, 0x77, 0xb0, 0x1c, 0xd5, 0xa9, 0x42, 0x7e, 0xb1, 0xb0, 0xcd, 0xe6, 0x1d, 0x1e, 0x45,
+	0x88, 0x69, 0x3c, 0xd3, 0xf4, 0xad, 0xc9, 0xc2, 0x0f, 0xec, 0xe7, 0x96, 0x1e, 0xd8, 0xee, 0x71,
+	0xf3, 0xa7, 0x34, 0x8e, 0xf5, 0x18, 0x3a, 0xb4, 0xdd, 0x63, 0x5c, 0xb1, 0xd6, 0x69, 0x68, 0xf9,
+	0xae, 0x8e, 0x5a, 0x53, 0xf3, 0x2d, 0x79, 0xc5, 0x76, 0x08, 0x31, 0x9c, 0x18, 0x2e, 0x03, 0x2b,
+	0x4e, 0xab, 0x1f, 0xc3, 0x46, 0xa2, 0x85, 0xcf, 0x51, 0x05, 0x69, 0xfe, 0x6c, 0xed, 0xe9, 0x11,
+	0xa9, 0x27, 0xac, 0x31, 0x4f, 0xe5, 0x97, 0xd6, 0x56, 0xc0, 0xd7, 0xd6, 0xdd, 0xef, 0xb5, 0xb6,
+	0x86, 0x98, 0x57, 0x5f, 0x87, 0xb2, 0xed, 0x86, 0x96, 0x8f, 0x1e, 0x8e, 0x7b, 0x2b, 0x0c, 0x3c,
+	0xc6, 0xe1, 0xd1, 0x71, 0xe0, 0xd8, 0xc8, 0x98, 0x9a, 0x6f, 0xaf, 0x90, 0x45, 0x28, 0x94, 0xd8,
+	0x53, 0xdb, 0x71, 0xb8, 0xc4, 0x7e, 0x67, 0x45, 0x62, 0x3f, 0xb4, 0x1d, 0x87, 0x4b, 0xec, 0xa9,
+	0x48, 0xa1, 0x94, 0xa3, 0x12, 0xf8, 0xfd, 0xed, 0x55, 0x29, 0x87, 0xb8, 0xa7, 0x74, 0x01, 0xa7,
+	0x1a, 0x90, 0xaf, 0x8b, 0xbb, 0xfc, 0xee, 0xcb, 0x3d, 0x4c, 0x3b, 0xc1, 0x18, 0x04, 0x71, 0x1e,
+	0x8d, 0x05, 0xe1, 0x29, 0x44, 0x03, 0xe7, 0x5d, 0x1e, 0x17, 0xce, 0x21, 0x68, 0xdd, 0xbc, 0x0d,
+	0xf5, 0x28, 0x1a, 0x06, 0x3f, 0x17, 0x34, 0xdf, 0x5b, 0x69, 0x41, 0x9a, 0x40, 0xdd, 0x85, 0xda,
+	0x14, 0x35, 0xb8, 0x19, 0x57, 0xe8, 0x9a, 0xef, 0x53, 0x43, 0xb6, 0x22, 0x09, 0x7a, 0x9e, 0xc2,
+	0xc7, 0x52, 0xa5, 0xd4, 0xbb, 0xa0, 0xda, 0x53, 0x3e, 0x0b, 0x68, 0x31, 0x71, 0xa5, 0xad, 0xf9,
+	0x01, 0x2d, 0xa9, 0x35, 0x18, 0xf5, 0x3e, 0xd4, 0x03, 0xcb, 0x35, 0x31, 0xd6, 0x80, 0x2f, 0xed,
+	0x07, 0x5b, 0xb9, 0x84, 0x79, 0xc6, 0xd7, 0xcf, 0xd0, 0x85, 0xee, 0x9a, 0x7b, 0x01, 0x57, 0x0c,
+	0xee, 0x03, 0xae, 0xce, 0xe7, 0x49, 0xa1, 0x0f, 0xcf, 0x29, 0x84, 0x54, 0x52, 0x21, 0x5c, 0xba,
+	0x7a, 0xe0, 0x1a, 0xf3, 0xe0, 0xc8, 0x0b, 0x9b, 0x1f, 0xc9, 0xd2, 0x7a, 0x28, 0xa0, 0xac, 0x86,
+	0x44, 0x51, 0x0e, 0x05, 0x59, 0xac, 0xd8, 0xa0, 0x99, 0xfa, 0x7b, 0x3c, 0xc6, 0x29, 0x82, 0x75,
+	0xcd, 0x40, 0xfb, 0x65, 0x01, 0xca, 0x91, 0xa2, 0x89, 0xd1, 0x45, 0x07, 0xfd, 0x27, 0xfd, 0xc1,
+	0xb3, 0xbe, 0x72, 0x01, 0xfd, 0xbe, 0x14, 0x4e, 0xae, 0x0f, 0xdb, 0xad, 0x3e, 0xbf, 0x7e, 0x41,
+	0x41, 0xec, 0x3c, 0x9f, 0x55, 0x37, 0xa1, 0xfe, 0xf0, 0xa0, 0x4f, 0xd1, 0x45, 0x1c, 0x94, 0x43,
+	0x50, 0xe7, 0x73, 0xee, 0x5c, 0xe6, 0x20, 0x0c, 0x3c, 0xaf, 0xef, 0xb5, 0x46, 0x1d, 0xd6, 0x8d,
+	0x40, 0x05, 0x0a, 0x54, 0x1a, 0x1c, 0xb0, 0xb6, 0xa8, 0xa9, 0x88, 0x9f, 0xdd, 0x67, 0x83, 0xcf,
+	0x3a, 0xed, 0x91, 0x02, 0xea, 0x65, 0xd8, 0x8c, 0xeb, 0x88, 0xea, 0x57, 0xaa, 0xe8, 0xb7, 0x8e,
+	0xea, 0x51, 0x2e, 0x61, 0xad, 0xac, 0xd3, 0x3e, 0x60, 0xc3, 0xee, 0xd3, 0x8e, 0xde, 0x1e, 0x75,
+	0x94, 0xcb, 0xe8, 0x7e, 0x1c, 0x76, 0xfb, 0x4f, 0x94, 0x2b, 0xe8, 0xdc, 0xc3, 0x14, 0xaf, 0xfd,
+	0xaa, 0xaa, 0x42, 0x23, 0xa1, 0x25, 0x58, 0x93, 0xfc, 0xde, 0x8f, 0x1e, 0x29, 0x37, 0xb1, 0xda,
+	0xdd, 0xee, 0x70, 0xd4, 0xed, 0xb7, 0x47, 0xca, 0x2b, 0xe8, 0xda, 0x7e, 0xd8, 0xed, 0x8d, 0x3a,
+	0x4c, 0xd9, 0xc2, 0xfa, 0x3e, 0x1b, 0x74, 0xfb, 0xca, 0xab, 0x08, 0x1d, 0xb6, 0xf6, 0xf6, 0x7b,
+	0x1d, 0x45, 0xa3, 0xaf, 0x0c, 0xd8, 0x48, 0x79, 0x0d, 0x9d, 0x9c, 0x07, 0x7d, 0x6c, 0xdb, 0x2d,
+	0xfc, 0x20, 0x25, 0x75, 0xbc, 0x71, 0xf2, 0x13, 0xc9, 0x41, 0xfe, 0x3a, 0xa6, 0x9f, 0x75, 0xfb,
+	0xbb, 0x83, 0x67, 0xca, 0x1b, 0x48, 0xb6, 0xc3, 0x06, 0xad, 0xdd, 0x36, 0xfa, 0xd1, 0x6f, 0x63,
+	0x05, 0xc3, 0xfd, 0x5e, 0x77, 0xa4, 0xbc, 0x89, 0x54, 0x8f, 0x5a, 0xa3, 0xc7, 0x1d, 0xa6, 0xdc,
+	0xc1, 0x74, 0x6b, 0x38, 0xec, 0xb0, 0x91, 0xb2, 0x8d, 0xe9, 0x6e, 0x9f, 0xd2, 0xf7, 0x31, 0xbd,
+	0xdb, 0xe9, 0x75, 0x46, 0x1d, 0xe5, 0x5d, 0x1c, 0x30, 0xd6, 0xd9, 0xef, 0xb5, 0xda, 0x1d, 0xe5,
+	0x3d, 0xcc, 0xf4, 0x06, 0xed, 0x27, 0xfa, 0x60, 0x5f, 0x79, 0x1f, 0xbf, 0x41, 0xee, 0xfd, 0x21,
+	0x0e, 0xe6, 0x07, 0x38, 0x4e, 0x71, 0x96, 0x5a, 0xf7, 0x00, 0x3f, 0xbb, 0xd7, 0xed, 0x1f, 0x0c,
+	0x95, 0x0f, 0x91, 0x98, 0x92, 0x84, 0xf9, 0x48, 0xbd, 0x04, 0xca, 0xa0, 0xaf, 0xef, 0x1e, 0xec,
+	0xf7, 0xba, 0xed, 0xd6, 0xa8, 0xa3, 0x3f, 0xe9, 0x7c, 0xa1, 0xfc, 0x1e, 0x4e, 0xfb, 0x3e, 0xeb,
+	0xe8, 0xa2, 0x1d, 0x3f, 0x8f, 0xf2, 0xa2, 0x2d, 0x1f, 0xe3, 0x27, 0x12, 0xbc, 0x7e, 0xf0, 0x44,
+	0xf9, 0xfd, 0x25, 0xd0, 0xf0, 0x89, 0xf2, 0x09, 0xce, 0xf9, 0xa8, 0xbb, 0xd7, 0xd1, 0xc5, 0x60,
+	0xe0, 0xd5, 0x85, 0xfc, 0xc3, 0x6e, 0xaf, 0xa7, 0xb4, 0xc8, 0x17, 0xdb, 0x62, 0xa3, 0x2e, 0x4d,
+	0xf4, 0x0e, 0x5e, 0x83, 0x78, 0x78, 0xf0, 0xe5, 0x97, 0x5f, 0xe8, 0x62, 0x26, 0xda, 0xda, 0x1f,
+	0x42, 0x39, 0xb2, 0x28, 0xb0, 0xf5, 0xdd, 0x7e, 0xbf, 0x83, 0x57, 0x83, 0xca, 0x90, 0xef, 0x75,
+	0x1e, 0x8e, 0x94, 0x0c, 0x02, 0x59, 0xf7, 0xd1, 0xe3, 0x91, 0x92, 0xc5, 0xe4, 0xe0, 0x00, 0x8b,
+	0xe5, 0x68, 0xaa, 0x3a, 0x7b, 0x5d, 0x25, 0x8f, 0xa9, 0x56, 0x7f, 0xd4, 0x55, 0x0a, 0x34, 0x95,
+	0xdd, 0xfe, 0xa3, 0x5e, 0x47, 0x29, 0x22, 0x74, 0xaf, 0xc5, 0x9e, 0x28, 0x25, 0x2c, 0xd4, 0xda,
+	0xdf, 0xef, 0x7d, 0xa1, 0x94, 0x79, 0xfd, 0xbb, 0x9d, 0xcf, 0x95, 0x0a, 0x5e, 0x2f, 0xea, 0x6d,
+	0x2b, 0xa0, 0xdd, 0x86, 0x52, 0xeb, 0xf0, 0x70, 0x0f, 0x0d, 0x36, 0x6c, 0x34, 0x06, 0xdb, 0xd1,
+	0xbd, 0xa4, 0x9d, 0xc1, 0x68, 0x34, 0xd8, 0x53, 0x32, 0xb8, 0x98, 0x46, 0x83, 0x7d, 0x25, 0xab,
+	0x75, 0xa1, 0x1c, 0x31, 0x52, 0xe9, 0x2e, 0x48, 0x19, 0xf2, 0xfb, 0xac, 0xf3, 0x94, 0x1f, 0xb2,
+	0xf4, 0x3b, 0x9f, 0x63, 0x33, 0x31, 0x85, 0x15, 0xe5, 0xf0, 0x83, 0xfc, 0xd2, 0x06, 0x5d, 0x06,
+	0xe9, 0x75, 0xfb, 0x9d, 0x16, 0x53, 0x0a, 0xda, 0xdf, 0x84, 0x72, 0xbc, 0x8b, 0x6f, 0x41, 0x76,
+	0x34, 0x14, 0x9e, 0xb3, 0x4b, 0x77, 0x93, 0x0b, 0xba, 0xa3, 0x28, 0xc5, 0xb2, 0xa3, 0xa1, 0xfa,
+	0x16, 0x14, 0xf9, 0xf5, 0x9c, 0x66, 0x36, 0xc5, 0x83, 0x45, 0x2d, 0x23, 0xc2, 0x31, 0x41, 0xa3,
+	0xf5, 0xa0, 0x91, 0xc6, 0xa0, 0x17, 0x81, 0xe3, 0x24, 0xa3, 0x57, 0x82, 0xa0, 0xf9, 0xc8, 0x73,
+	0xdd, 0x5d, 0x11, 0x0e, 0x14, 0xe7, 0xb5, 0xbf, 0xca, 0x02, 0x24, 0x62, 0x14, 0x05, 0x75, 0x6c,
+	0xd2, 0x16, 0x84, 0x27, 0x5f, 0xbe, 0x02, 0x50, 0xe1, 0x27, 0x6d, 0xe8, 0x7d, 0x99, 0x7a, 0xfe,
+	0xcc, 0x08, 0xa3, 0xcb, 0x3f, 0x3c, 0x87, 0x4a, 0x2b, 0x77, 0x20, 0xa3, 0xbe, 0xe0, 0x5a, 0x3c,
+	0x50, 0x2d, 0xcf, 0xc4, 0xb9, 0x8b, 0xd9, 0x43, 0x18, 0x6a, 0x94, 0x96, 0x3b, 0x71, 0xbc, 0xc0,
+	0x32, 0xd1, 0x62, 0x2a, 0x90, 0x52, 0x00, 0x11, 0x68, 0xe7, 0x8c, 0x77, 0xc8, 0x9f, 0xd9, 0xae,
+	0x11, 0x5a, 0xa6, 0x88, 0x96, 0x91, 0x20, 0xe8, 0xe3, 0xc1, 0x2b, 0x99, 0x5c, 0x24, 0xf2, 0x18,
+	0xa1, 0x32, 0x02, 0x68, 0xfa, 0x5e, 0x06, 0xb0, 0x82, 0x89, 0x31, 0xe7, 0x95, 0x97, 0xa9, 0xf2,
+	0x8a, 0x80, 0xec, 0x9c, 0xa9, 0x3d, 0x68, 0x8c, 0xc6, 0x6d, 0xcf, 0x19, 0x79, 0x68, 0x85, 0xb4,
+	0x3d, 0x47, 0x18, 0xa2, 0xb7, 0x96, 0x55, 0x8a, 0xbb, 0x69, 0x32, 0xee, 0x34, 0x5f, 0x2a, 0x7b,
+	0xbd, 0x05, 0x17, 0xd7, 0x90, 0xfd, 0xa0, 0x70, 0x82, 0x7f, 0x9e, 0x03, 0x48, 0xf4, 0xc2, 0x94,
+	0x27, 0x3d, 0x93, 0xf6, 0xa4, 0x6f, 0xc3, 0x15, 0x11, 0x71, 0x2f, 0xa2, 0xb4, 0x4f, 0x75, 0xdb,
+	0xd5, 0xc7, 0x46, 0x74, 0x68, 0xa1, 0x0a, 0x2c, 0x3f, 0xdc, 0xef, 0xba, 0x3b, 0x46, 0xa8, 0x3e,
+	0x80, 0x0d, 0xb9, 0x0c, 0x5e, 0x60, 0xc8, 0x9d, 0x73, 0x81, 0xa1, 0x9e, 0x14, 0x1f, 0x9d, 0xcd,
+	0xd5, 0xb7, 0xe1, 0xb2, 0x6f, 0x4d, 0x7d, 0x2b, 0x38, 0xd2, 0xc3, 0x40, 0xfe, 0x18, 0x8f, 0x24,
+	0xd8, 0x14, 0xc8, 0x51, 0x10, 0x7f, 0xeb, 0x6d, 0xb8, 0x2c, 0x34, 0xc6, 0xa5, 0xe6, 0xf1, 0xdb,
+	0x82, 0x9b, 0x1c, 0x29, 0xb7, 0xee, 0x65, 0x00, 0xa1, 0x2c, 0x47, 0x77, 0xc4, 0xcb, 0xac, 0xc2,
+	0x15, 0x63, 0xb4, 0x6e, 0xde, 0x02, 0xd5, 0x0e, 0xf4, 0x25, 0x2f, 0xac, 0x38, 0x9a, 0x50, 0xec,
+	0x60, 0x3f, 0xe5, 0x81, 0x3d, 0xcf, 0xc1, 0x5b, 0x3e, 0xcf, 0xc1, 0x7b, 0x09, 0x0a, 0xa4, 0x4f,
+	0x0b, 0x7f, 0x2b, 0xcf, 0xa8, 0x1a, 0xe4, 0x91, 0x61, 0x90, 0x5b, 0xb0, 0xb1, 0xdd, 0xb8, 0x8b,
+	0x40, 0xd2, 0xdb, 0x11, 0xca, 0x08, 0xa7, 0xfd, 0x49, 0x06, 0x1a, 0x69, 0x1d, 0x90, 0x47, 0xcb,
+	0x25, 0x61, 0x80, 0x85, 0x24, 0xf4, 0xef, 0x25, 0xa8, 0xcc, 0x8f, 0x45, 0xcc, 0x5f, 0x74, 0xc6,
+	0x3c, 0x3f, 0xe6, 0xb1, 0x7e, 0xea, 0x9b, 0x50, 0x9a, 0x1f, 0xf3, 0x75, 0x7c, 0xde, 0xb4, 0x14,
+	0xe7, 0x3c, 0x0c, 0xe7, 0x4d, 0x28, 0x2d, 0x04, 0x69, 0xfe, 0x3c, 0xd2, 0x05, 0x91, 0x6a, 0x5b,
+	0x50, 0x93, 0xad, 0x2e, 0x5c, 0x8e, 0xa8, 0xab, 0xf1, 0x86, 0x61, 0x12, 0x7b, 0x50, 0x93, 0xcd,
+	0xab, 0xef, 0xe3, 0xe4, 0x4f, 0x79, 0x1c, 0xb2, 0x2f, 0xf0, 0x38, 0x6c, 0x51, 0x30, 0x81, 0x4e,
+	0x51, 0x41, 0x18, 0x4a, 0xcc, 0x3d, 0xfc, 0x70, 0x64, 0x04, 0xad, 0x45, 0xe8, 0xb5, 0x3d, 0x47,
+	0x1c, 0x37, 0x89, 0x30, 0xeb, 0x7c, 0xe4, 0x31, 0x14, 0x71, 0xd4, 0x7f, 0x3f, 0x03, 0x9b, 0x2b,
+	0xe6, 0x05, 0xf6, 0x23, 0x79, 0x06, 0x00, 0x93, 0xa8, 0x26, 0xcd, 0x8c, 0x70, 0x72, 0xa4, 0xcf,
+	0x7d, 0x6b, 0x6a, 0x9f, 0x46, 0x6f, 0x19, 0x10, 0x6c, 0x9f, 0x40, 0x74, 0xf6, 0x36, 0x9f, 0x93,
+	0x51, 0x85, 0x4e, 0x17, 0x7e, 0x67, 0x17, 0x08, 0xd4, 0x43, 0x48, 0x7c, 0xae, 0x9f, 0x3f, 0x27,
+	0x0c, 0xe1, 0x06, 0x14, 0xbb, 0xb1, 0x19, 0x13, 0x5f, 0xeb, 0xcd, 0x89, 0xab, 0xbc, 0x1e, 0x54,
+	0xda, 0x74, 0x2d, 0x78, 0xcf, 0x98, 0xab, 0x77, 0xf0, 0xaa, 0xd7, 0x5c, 0x44, 0x1c, 0x34, 0x63,
+	0x67, 0x22, 0xc7, 0xde, 0xdd, 0x33, 0xe6, 0x9c, 0x8b, 0x20, 0xd1, 0xf5, 0xf7, 0xa1, 0x1c, 0x01,
+	0x7e, 0x10, 0xbf, 0xf8, 0x1f, 0x39, 0xa8, 0xec, 0xca, 0x0e, 0x0f, 0xd4, 0x2d, 0x43, 0x7f, 0xe1,
+	0xa2, 0x5d, 0x2a, 0x5c, 0xaf, 0x55, 0x74, 0x30, 0x0b, 0x50, 0x34, 0xb5, 0xd9, 0xef, 0x98, 0xda,
+	0x1b, 0x80, 0x9e, 0x19, 0xdd, 0x36, 0x49, 0xa7, 0xcf, 0xc5, 0x81, 0x10, 0x5d, 0x13, 0x55, 0xfa,
+	0xb5, 0xa7, 0x3b, 0xf9, 0xef, 0x7f, 0xba, 0x53, 0x58, 0x7b, 0xba, 0xf3, 0xff, 0xcc, 0x79, 0xcc,
+	0xeb, 0x09, 0x8b, 0xc4, 0xc0, 0x77, 0x24, 0xab, 0x10, 0x59, 0xc4, 0x10, 0x9f, 0x58, 0x67, 0x48,
+	0xf7, 0x11, 0x34, 0xa2, 0x61, 0x16, 0x1d, 0x83, 0x54, 0xa8, 0xa6, 0xc0, 0xd1, 0xe7, 0x59, 0x3d,
+	0x94, 0xb3, 0xe9, 0xbd, 0x53, 0xfd, 0xee, 0xbd, 0xa3, 0xfd, 0x2a, 0x07, 0x85, 0x5f, 0xe0, 0xa5,
+	0x45, 0xf5, 0x7d, 0xa8, 0x04, 0xe1, 0x2c, 0x94, 0xdd, 0xcc, 0xd7, 0x78, 0x31, 0xc2, 0x93, 0x97,
+	0xd8, 0xc2, 0x98, 0x5c, 0x6e, 0x01, 0x22, 0x2d, 0xa6, 0x70, 0xf5, 0xa0, 0xb3, 0x86, 0xbb, 0xb5,
+	0x0b, 0x8c, 0x67, 0xd0, 0xf1, 0x88, 0x3e, 0xe7, 0x20, 0x7d, 0x68, 0x8d, 0x26, 0x04, 0xe3, 0x08,
+	0x74, 0x3c, 0x8a, 0x5b, 0x1d, 0xf9, 0x55, 0x57, 0x2f, 0xc7, 0x50, 0x3c, 0x9a, 0x65, 0xa0, 0x69,
+	0x1a, 0x5d, 0xde, 0x89, 0xf3, 0xc8, 0x05, 0x1d, 0xcf, 0x30, 0x47, 0xc6, 0x61, 0x74, 0xfb, 0x4d,
+	0x64, 0x31, 0x3c, 0x09, 0x93, 0xcf, 0xf0, 0x44, 0x6b, 0x78, 0x3f, 0x0a, 0xbd, 0x90, 0x40, 0x28,
+	0xf4, 0x4d, 0x2b, 0xb4, 0x26, 0xe1, 0xf0, 0x6b, 0x87, 0x73, 0xed, 0x0a, 0x93, 0x20, 0xd8, 0xa7,
+	0x23, 0xdb, 0x0d, 0x03, 0x92, 0xd7, 0x15, 0xc6, 0x33, 0xb8, 0x73, 0x4c, 0x6f, 0x4e, 0x33, 0x51,
+	0x60, 0x98, 0xd4, 0x4c, 0xa8, 0xa7, 0x86, 0x25, 0x6d, 0x1a, 0xa1, 0x1a, 0xd9, 0xe9, 0xa1, 0x8a,
+	0x9d, 0x91, 0x74, 0xf4, 0xac, 0xac, 0x97, 0xe7, 0x24, 0x85, 0x9d, 0x54, 0xbb, 0x83, 0xfd, 0xdd,
+	0xd6, 0xa8, 0xa3, 0x14, 0x48, 0x01, 0xef, 0xb0, 0x47, 0x1d, 0xa5, 0xa8, 0xfd, 0x69, 0x16, 0x36,
+	0x47, 0xbe, 0xe1, 0x06, 0x06, 0x8f, 0xdc, 0x76, 0x43, 0xdf, 0x73, 0xd4, 0x8f, 0xa0, 0x1c, 0x4e,
+	0x1c, 0x79, 0xba, 0x5e, 0x89, 0x16, 0xc7, 0x12, 0xe9, 0xdd, 0xd1, 0x84, 0x9b, 0xed, 0xa5, 0x90,
+	0x27, 0xd4, 0x9f, 0x41, 0x61, 0x6c, 0x1d, 0xda, 0xae, 0xd8, 0xa8, 0x97, 0x97, 0x0b, 0xee, 0x20,
+	0x12, 0x1f, 0x10, 0x21, 0x2a, 0xf5, 0x6d, 0xbc, 0xf6, 0x38, 0x8b, 0x38, 0x5a, 0x12, 0x64, 0x2a,
+	0x7d, 0x08, 0xb1, 0xf8, 0x48, 0x08, 0xa7, 0x53, 0xdf, 0xc7, 0xfb, 0xfb, 0x8e, 0x33, 0x36, 0x26,
+	0xc7, 0x82, 0xd7, 0x35, 0x97, 0xcb, 0x30, 0x81, 0x7f, 0x7c, 0x81, 0xc5, 0xb4, 0xda, 0x5d, 0x28,
+	0x89, 0xc6, 0xe2, 0x00, 0xec, 0x74, 0x1e, 0x75, 0xc5, 0x40, 0xb6, 0x07, 0x7b, 0x7b, 0xdd, 0x11,
+	0xbf, 0xcd, 0xc2, 0x06, 0xbd, 0xde, 0x4e, 0xab, 0xfd, 0x44, 0xc9, 0xee, 0x94, 0xa1, 0x68, 0x50,
+	0x60, 0xa4, 0xf6, 0x77, 0x33, 0xb0, 0xb1, 0xd4, 0x01, 0xf5, 0x01, 0xe4, 0x67, 0x9e, 0x19, 0x0d,
+	0xcf, 0xad, 0xb5, 0xbd, 0x94, 0xf2, 0x5c, 0xea, 0x62, 0x09, 0xed, 0x43, 0x68, 0xa4, 0xe1, 0x92,
+	0xa6, 0x5e, 0x87, 0x0a, 0xeb, 0xb4, 0x76, 0xf5, 0x41, 0xbf, 0xf7, 0x05, 0x37, 0x78, 0x29, 0xfb,
+	0x8c, 0x75, 0x47, 0x1d, 0x25, 0xab, 0xfd, 0x01, 0x28, 0xcb, 0x03, 0xa3, 0x3e, 0x82, 0x0d, 0xbc,
+	0xca, 0xe2, 0x58, 0x9c, 0xa1, 0x24, 0x53, 0x76, 0x73, 0xcd, 0x48, 0x0a, 0x32, 0x9a, 0xb1, 0xc6,
+	0x24, 0x95, 0xd7, 0xfe, 0x06, 0xa8, 0xab, 0x23, 0xf8, 0xbb, 0xab, 0xfe, 0x7f, 0x67, 0x20, 0xbf,
+	0xef, 0x18, 0x78, 0x45, 0xa2, 0x40, 0x57, 0x99, 0x9b, 0x19, 0xf9, 0xa0, 0x88, 0x18, 0x01, 0x2e,
+	0x0b, 0xc2, 0xa9, 0x3f, 0x85, 0x5c, 0x38, 0x89, 0x6e, 0xee, 0x5c, 0x3d, 0x67, 0xf1, 0xe1, 0x7d,
+	0xe2, 0x70, 0xe2, 0xe0, 0x33, 0x12, 0xa6, 0x19, 0x45, 0xe1, 0x08, 0xab, 0x03, 0xf5, 0xd8, 0x5d,
+	0x6b, 0x6a, 0xbb, 0xb6, 0xb8, 0x7a, 0x8d, 0x24, 0x78, 0xb5, 0xda, 0x9c, 0x38, 0xe9, 0x90, 0x2c,
+	0xae, 0xf1, 0xc6, 0x15, 0x9a, 0x13, 0x7c, 0xf7, 0xa5, 0x1e, 0xfa, 0x67, 0xba, 0xbf, 0x70, 0xe9,
+	0x14, 0x37, 0x10, 0x9a, 0x5f, 0x15, 0x85, 0xde, 0x82, 0x8e, 0x3c, 0x03, 0x11, 0x01, 0x3c, 0xf7,
+	0xad, 0xb9, 0xe1, 0xc7, 0x3a, 0x1f, 0x1e, 0x15, 0x12, 0x00, 0x2f, 0x26, 0x63, 0xed, 0xda, 0x5b,
+	0x74, 0xad, 0x17, 0x75, 0x24, 0x2d, 0x4a, 0xad, 0xb9, 0x60, 0x21, 0x30, 0xda, 0x9f, 0xe7, 0xa0,
+	0x2a, 0xb5, 0x47, 0x7d, 0x17, 0xca, 0xe6, 0xc4, 0x59, 0xc3, 0x37, 0x25, 0xa2, 0xbb, 0xbb, 0xd1,
+	0x16, 0x34, 0x79, 0x82, 0x42, 0x47, 0xad, 0x50, 0x7f, 0x6e, 0xf8, 0x36, 0x7f, 0x83, 0x20, 0x2b,
+	0xbb, 0xa3, 0x87, 0x56, 0xf8, 0x34, 0xc2, 0xe0, 0xb3, 0x31, 0x81, 0x94, 0x27, 0x45, 0x4e, 0x74,
+	0x29, 0x97, 0x7a, 0xa7, 0x81, 0x03, 0xf1, 0x9d, 0x17, 0x81, 0x47, 0x52, 0xeb, 0xd4, 0x9a, 0x2c,
+	0xc2, 0x48, 0x91, 0xab, 0x47, 0x1d, 0x22, 0x20, 0x92, 0x0a, 0xbc, 0xba, 0x8d, 0x3c, 0xd1, 0x70,
+	0x1c, 0x8f, 0x64, 0x7b, 0x41, 0xf6, 0x7d, 0xee, 0xc6, 0x70, 0xfe, 0x04, 0x4d, 0x94, 0xc3, 0x28,
+	0x31, 0x2f, 0x3c, 0xb2, 0xfc, 0x66, 0x51, 0x16, 0x33, 0x03, 0x04, 0xed, 0xb6, 0x7b, 0xb8, 0x52,
+	0x08, 0xad, 0xfd, 0x32, 0x03, 0x25, 0x31, 0x02, 0x68, 0xf6, 0xe3, 0x05, 0xb4, 0xa7, 0x2d, 0xd6,
+	0x45, 0x3f, 0x91, 0x88, 0x04, 0x7b, 0xc4, 0x5a, 0x7d, 0xc1, 0x27, 0x59, 0xe7, 0xe9, 0xe0, 0x49,
+	0x87, 0x9b, 0xbf, 0xbb, 0x9d, 0xfe, 0x17, 0x4a, 0x8e, 0xbb, 0x7e, 0x3a, 0xfb, 0x2d, 0x86, 0x5c,
+	0xb2, 0x0a, 0xa5, 0xce, 0xe7, 0x9d, 0xf6, 0x01, 0xb1, 0xc9, 0x06, 0xc0, 0x6e, 0xa7, 0xd5, 0xeb,
+	0x0d, 0xd0, 0x17, 0xa1, 0x14, 0xd1, 0x8d, 0xd3, 0x66, 0x1d, 0xf4, 0x4b, 0xb4, 0xda, 0xed, 0xc1,
+	0x41, 0x7f, 0xa4, 0x94, 0xf0, 0x8b, 0x2d, 0x74, 0x12, 0xc4, 0x20, 0x7a, 0x45, 0x61, 0x97, 0x0d,
+	0xf6, 0x63, 0x48, 0x65, 0xa7, 0x82, 0x4a, 0x35, 0xcd, 0x95, 0xf6, 0x27, 0x0d, 0x68, 0xa4, 0x97,
+	0xa6, 0xfa, 0x01, 0x94, 0x4d, 0x33, 0x35, 0xc7, 0x37, 0xd6, 0x2d, 0xe1, 0xbb, 0xbb, 0x66, 0x34,
+	0xcd, 0x3c, 0x81, 0x27, 0xae, 0x7c, 0x23, 0x65, 0x57, 0x36, 0x52, 0xb4, 0x8d, 0x3e, 0x81, 0x0d,
+	0x71, 0xc3, 0x16, 0xcd, 0xdd, 0xb1, 0x11, 0x58, 0xe9, 0x5d, 0xd2, 0x26, 0xe4, 0xae, 0xc0, 0x3d,
+	0xbe, 0xc0, 0x1a, 0x93, 0x14, 0x44, 0xfd, 0x39, 0x34, 0x0c, 0x32, 0x85, 0xe2, 0xf2, 0x79, 0x59,
+	0x59, 0x68, 0x21, 0x4e, 0x2a, 0x5e, 0x37, 0x64, 0x00, 0x2e, 0x44, 0xd3, 0xf7, 0xe6, 0x49, 0xe1,
+	0x82, 0xbc, 0x10, 0x77, 0x7d, 0x6f, 0x2e, 0x95, 0xad, 0x99, 0x52, 0x1e, 0xa3, 0x78, 0x45, 0xcb,
+	0x13, 0xa3, 0x2a, 0xde, 0xb2, 0xbc, 0xd9, 0xa4, 0x72, 0xe0, 0x73, 0x4c, 0x93, 0x24, 0x8b, 0xa1,
+	0xe0, 0xbc, 0xc1, 0x89, 0x91, 0x15, 0xaf, 0x35, 0x6a, 0x6d, 0x54, 0x0a, 0x8c, 0x38, 0xa7, 0xbe,
+	0x0d, 0x40, 0xed, 0xe4, 0x65, 0xca, 0xa9, 0xe3, 0x39, 0xdf, 0x9b, 0x47, 0x45, 0x2a, 0x66, 0x94,
+	0x91, 0x9a, 0xc7, 0xef, 0x3a, 0x54, 0x56, 0x9b, 0x47, 0x61, 0xf9, 0x49, 0xf3, 0x28, 0x9b, 0x34,
+	0x8f, 0x17, 0x83, 0x95, 0xe6, 0x45, 0xa5, 0xc0, 0x88, 0x73, 0x71, 0xf3, 0x78, 0x99, 0xea, 0x72,
+	0xf3, 0xa2, 0x22, 0x15, 0x33, 0xca, 0xe0, 0xb4, 0x2d, 0xe9, 0x78, 0xb5, 0x73, 0x75, 0x3c, 0x9c,
+	0xb6, 0xb4, 0x96, 0xf7, 0x73, 0x68, 0x04, 0x47, 0xde, 0x89, 0xc4, 0x40, 0xea, 0x72, 0xe9, 0xe1,
+	0x91, 0x77, 0x22, 0x73, 0x90, 0x7a, 0x20, 0x03, 0xb0, 0xb5, 0xbc, 0x8b, 0x74, 0x9b, 0xa9, 0x21,
+	0xb7, 0x96, 0x7a, 0x88, 0xb7, 0x4c, 0xb0, 0xb5, 0x46, 0x94, 0xc1, 0x41, 0x49, 0xcc, 0xe7, 0xa0,
+	0xb9, 0x21, 0x0f, 0x4a, 0x2f, 0xb2, 0xa2, 0xf1, 0x4b, 0x10, 0xdb, 0xd4, 0x01, 0xae, 0xad, 0x85,
+	0x2b, 0x17, 0x53, 0xe4, 0xb5, 0x75, 0xe0, 0xa6, 0x0a, 0xd6, 0x38, 0xa9, 0x28, 0x9a, 0xec, 0x8a,
+	0xc0, 0xfa, 0x7a, 0x61, 0xb9, 0x13, 0xab, 0xb9, 0xb9, 0xba, 0x2b, 0x86, 0x02, 0x97, 0xec, 0x8a,
+	0x08, 0x12, 0xaf, 0xeb, 0xb8, 0xb8, 0xba, 0xbc, 0xae, 0xa5, 0xc2, 0x35, 0x53, 0xca, 0x27, 0x1b,
+	0x2a, 0x2e, 0x7b, 0x71, 0x65, 0x43, 0x49, 0x85, 0xeb, 0x86, 0x0c, 0xc0, 0x91, 0x12, 0x2d, 0xa7,
+	0xc1, 0x4d, 0x9d, 0x4f, 0xf3, 0x56, 0x8b, 0xd1, 0x85, 0x49, 0x9c, 0xd3, 0xfe, 0x51, 0x01, 0x4a,
+	0x82, 0x79, 0xe0, 0x83, 0x2e, 0x82, 0x87, 0xed, 0xb6, 0x46, 0xad, 0x9d, 0xd6, 0x10, 0xb5, 0x0e,
+	0x15, 0x1a, 0x9c, 0x89, 0xc5, 0xb0, 0x0c, 0x32, 0x36, 0xe2, 0x62, 0x31, 0x28, 0x8b, 0x8c, 0x4d,
+	0x94, 0xe5, 0x4f, 0xc9, 0xe4, 0xd0, 0xa7, 0xca, 0x0b, 0x72, 0x00, 0x45, 0x6a, 0x53, 0x29, 0x9e,
+	0x2f, 0x48, 0x45, 0xb8, 0x4f, 0xb3, 0x98, 0x14, 0xe1, 0x80, 0x52, 0x5c, 0x84, 0xe7, 0xcb, 0xd8,
+	0x98, 0x11, 0x3b, 0xe8, 0xb7, 0x93, 0xef, 0x54, 0xb0, 0x90, 0xa8, 0xe6, 0x69, 0xb7, 0xf3, 0x4c,
+	0x01, 0x2c, 0xc4, 0x6b, 0xa1, 0x7c, 0x15, 0xf5, 0x26, 0xaa, 0x84, 0xb2, 0x35, 0xf5, 0x2a, 0x5c,
+	0x1c, 0x3e, 0x1e, 0x3c, 0xd3, 0x79, 0xa1, 0xb8, 0x0b, 0x75, 0x74, 0x30, 0x4b, 0x08, 0x5e, 0x7d,
+	0x03, 0x3f, 0x49, 0xd0, 0x88, 0x70, 0xa8, 0x6c, 0xd0, 0x11, 0x01, 0xc2, 0x46, 0x5c, 0x90, 0x28,
+	0xd8, 0x15, 0x5e, 0x74, 0xd0, 0x3b, 0xd8, 0xeb, 0x0f, 0x95, 0x4d, 0x6c, 0x04, 0x41, 0x78, 0xcb,
+	0xd5, 0xb8, 0x9a, 0x44, 0xfc, 0x5c, 0x24, 0x89, 0x84, 0xb0, 0x67, 0x2d, 0xd6, 0xef, 0xf6, 0x1f,
+	0x0d, 0x95, 0x4b, 0x71, 0xcd, 0x1d, 0xc6, 0x06, 0x6c, 0xa8, 0x5c, 0x8e, 0x01, 0xc3, 0x51, 0x6b,
+	0x74, 0x30, 0x54, 0xae, 0xc4, 0xad, 0xdc, 0x67, 0x83, 0x76, 0x67, 0x38, 0xec, 0x75, 0x87, 0x23,
+	0xe5, 0x2a, 0x1e, 0x4b, 0x24, 0x2d, 0x8a, 0x88, 0x9b, 0x52, 0x43, 0xd9, 0xa3, 0xce, 0x48, 0xb9,
+	0x16, 0x37, 0xa3, 0x3d, 0xe8, 0xe1, 0x2b, 0x3f, 0x83, 0xbe, 0x72, 0x1d, 0x89, 0xc8, 0x43, 0x2f,
+	0x7a, 0xf3, 0x12, 0xb6, 0xeb, 0xa0, 0x2f, 0x83, 0x6e, 0x48, 0x4b, 0x63, 0xd8, 0xf9, 0xc5, 0x41,
+	0xa7, 0xdf, 0xee, 0x28, 0x2f, 0x27, 0x4b, 0x23, 0x86, 0xdd, 0x8c, 0x97, 0x46, 0x0c, 0x7a, 0x25,
+	0xfe, 0x66, 0x04, 0x1a, 0x2a, 0x5b, 0x58, 0x9f, 0x68, 0x47, 0xbf, 0xdf, 0x69, 0x8f, 0xb0, 0xaf,
+	0xaf, 0xc6, 0xa3, 0x78, 0xb0, 0xff, 0x88, 0xe1, 0x15, 0x72, 0x6d, 0xa7, 0x46, 0x8f, 0xd1, 0x09,
+	0x21, 0xa7, 0x7d, 0x06, 0xaa, 0xfc, 0xaa, 0x93, 0x78, 0x28, 0x42, 0x85, 0x3c, 0x86, 0x18, 0x46,
+	0x37, 0x93, 0x30, 0x8d, 0x96, 0xd8, 0x7c, 0x31, 0xa6, 0x63, 0xec, 0xe4, 0xa2, 0x82, 0x0c, 0xd2,
+	0xfe, 0x59, 0x06, 0x1a, 0x69, 0x01, 0x87, 0x8a, 0x9d, 0x3d, 0xd5, 0x31, 0x1e, 0x81, 0x1e, 0x33,
+	0x08, 0x22, 0x3f, 0x83, 0x3d, 0xed, 0x7b, 0x21, 0xbd, 0x66, 0x40, 0x86, 0x61, 0x2c, 0xaf, 0x78,
+	0xad, 0x71, 0x5e, 0xed, 0xc2, 0xc5, 0xd4, 0xa3, 0x57, 0xa9, 0xa7, 0x24, 0x9a, 0xf1, 0x53, 0x3d,
+	0x4b, 0xed, 0x67, 0x6a, 0xb0, 0xda, 0x27, 0x05, 0x72, 0x78, 0x01, 0x8f, 0xdf, 0x49, 0xc5, 0xa4,
+	0xf6, 0x18, 0xea, 0x29, 0x79, 0x4a, 0xae, 0xa5, 0x69, 0xba, 0xa5, 0x65, 0x7b, 0xfa, 0xe2, 0x66,
+	0x6a, 0x7f, 0x96, 0x81, 0x9a, 0x2c, 0x5d, 0x7f, 0x74, 0x4d, 0x14, 0x8e, 0x2a, 0xd2, 0xe8, 0xc9,
+	0x15, 0x8f, 0x18, 0x44, 0xa0, 0x2e, 0x3d, 0xc2, 0xc9, 0x7d, 0x5f, 0x0f, 0x8f, 0x87, 0x71, 0x77,
+	0x64, 0x10, 0x1a, 0xc4, 0x14, 0x68, 0xfe, 0xf0, 0x09, 0x12, 0x88, 0x80, 0xd6, 0x04, 0xa2, 0xbd,
+	0x02, 0x95, 0x87, 0xc7, 0xd1, 0x7b, 0x1a, 0xf2, 0x93, 0x1e, 0x15, 0x7e, 0xbb, 0x05, 0x1f, 0x00,
+	0x6d, 0x24, 0xd7, 0x34, 0x29, 0x8c, 0x85, 0x3f, 0x96, 0xc6, 0x97, 0x03, 0x3e, 0x96, 0x16, 0xbf,
+	0xcf, 0x99, 0x95, 0xdf, 0xe7, 0x7c, 0x4d, 0x54, 0x96, 0x93, 0x65, 0x50, 0xfc, 0x2d, 0x5e, 0x3b,
+	0x06, 0x3a, 0xe0, 0x7f, 0x66, 0x4d, 0x2d, 0xdf, 0xb7, 0xa2, 0x77, 0xe3, 0x56, 0x88, 0x53, 0x44,
+	0x64, 0x47, 0x58, 0xd3, 0x66, 0x41, 0x66, 0xdd, 0xe9, 0x9b, 0xa4, 0x88, 0xd7, 0xfe, 0x61, 0x1e,
+	0xaa, 0x92, 0xae, 0xf2, 0xbd, 0x96, 0xdf, 0x0d, 0x7c, 0xf5, 0x2c, 0xba, 0xa3, 0x28, 0x2e, 0x1c,
+	0xc4, 0x80, 0xd4, 0x5c, 0xe5, 0x96, 0xe6, 0x0a, 0x6f, 0x5c, 0xf1, 0x78, 0x17, 0xe1, 0xd5, 0x8a,
+	0xb2, 0x69, 0xb7, 0x4d, 0xe1, 0x05, 0x2e, 0xcf, 0x77, 0xa0, 0xc6, 0x5f, 0xc7, 0x88, 0x1f, 0x30,
+	0xcb, 0xad, 0xa1, 0xaf, 0x26, 0xaf, 0x84, 0x04, 0x78, 0x33, 0x79, 0x7a, 0xac, 0x9b, 0xe3, 0xc8,
+	0x89, 0x55, 0x98, 0x1e, 0xef, 0x8e, 0xc9, 0x65, 0x3c, 0x8d, 0xc5, 0x33, 0xf7, 0x84, 0x94, 0xa7,
+	0x91, 0x10, 0xbe, 0x0d, 0xa5, 0xe9, 0x31, 0xbf, 0x47, 0x50, 0xd9, 0xca, 0xad, 0x1b, 0xf2, 0xe2,
+	0xf4, 0x98, 0x2e, 0x15, 0x7c, 0x08, 0xca, 0x92, 0xc7, 0x2c, 0x68, 0xc2, 0xda, 0x46, 0x6d, 0xa4,
+	0x9d, 0x67, 0x81, 0x7a, 0x0f, 0x2e, 0x09, 0x79, 0x69, 0x04, 0x3a, 0x8f, 0xc5, 0xa4, 0x6b, 0xaf,
+	0xfc, 0x6d, 0x90, 0x4d, 0x8e, 0x6b, 0x05, 0x43, 0xc2, 0xe0, 0x62, 0xd5, 0xa0, 0x26, 0xad, 0x5d,
+	0x7e, 0xa7, 0xb8, 0xc2, 0x52, 0x30, 0xf5, 0x01, 0xd4, 0xa6, 0xc7, 0x7c, 0x2d, 0x8c, 0xbc, 0x3d,
+	0x4b, 0x44, 0xd5, 0x5d, 0x5a, 0x5e, 0x05, 0x14, 0x7c, 0x95, 0xa2, 0xd4, 0xfe, 0x6d, 0x06, 0x1a,
+	0x89, 0x12, 0x8a, 0x3b, 0x14, 0x5d, 0xad, 0xc9, 0x13, 0x88, 0xcd, 0x65, 0x3d, 0x15, 0x49, 0xd0,
+	0x33, 0xce, 0x5f, 0x65, 0x5a, 0x77, 0xd3, 0x7b, 0xdd, 0x3b, 0x2e, 0xb9, 0x75, 0xef, 0xb8, 0x68,
+	0x8f, 0x20, 0x87, 0xe7, 0x21, 0xe4, 0xf0, 0x40, 0x11, 0xc6, 0x8d, 0x23, 0x2e, 0xbc, 0xe8, 0x08,
+	0x11, 0x4f, 0x5b, 0xe9, 0xf6, 0xd5, 0x3e, 0xeb, 0xee, 0xb5, 0xd8, 0x17, 0x74, 0xfc, 0x4a, 0x42,
+	0xfe, 0xe1, 0x80, 0x75, 0xba, 0x8f, 0xfa, 0x04, 0xc8, 0x93, 0x3b, 0x24, 0x69, 0x62, 0xcb, 0x34,
+	0x1f, 0x1e, 0xcb, 0x17, 0x5e, 0x33, 0xa9, 0x77, 0x8d, 0xd2, 0x17, 0x2e, 0xb2, 0xcb, 0x17, 0x2e,
+	0xd4, 0x78, 0x8b, 0xc6, 0xfb, 0x1d, 0xef, 0x7e, 0xe3, 0x35, 0xec, 0xb4, 0xa5, 0x91, 0xde, 0x5d,
+	0x44, 0xa0, 0xfd, 0x26, 0x03, 0x6a, 0xaa, 0x21, 0x5c, 0xf9, 0xfd, 0xb1, 0x6d, 0xf9, 0x00, 0x9a,
+	0xe2, 0x09, 0x23, 0x4e, 0x25, 0x79, 0x53, 0xc5, 0x90, 0x5e, 0xf6, 0x92, 0x30, 0x8e, 0xe4, 0x32,
+	0xba, 0x7a, 0x0f, 0xf8, 0x7b, 0x34, 0x38, 0xe3, 0x69, 0xdf, 0x82, 0xb4, 0xf9, 0x59, 0x42, 0x93,
+	0x3c, 0x40, 0x23, 0x3f, 0xac, 0xc3, 0xdd, 0xcb, 0x1b, 0xc9, 0xac, 0x11, 0x43, 0xd0, 0xfe, 0x38,
+	0x03, 0x17, 0xd3, 0x0b, 0xe2, 0xb7, 0xeb, 0x65, 0xfa, 0x15, 0xa1, 0xdc, 0xf2, 0x2b, 0x42, 0xeb,
+	0xd6, 0x53, 0x7e, 0xed, 0x7a, 0xfa, 0x7b, 0x19, 0xb8, 0x24, 0x8d, 0x7e, 0x62, 0xae, 0xfc, 0x35,
+	0xb5, 0x4c, 0x7a, 0x4c, 0x28, 0x9f, 0x7a, 0x4c, 0x48, 0xfb, 0xd3, 0x0c, 0x5c, 0x59, 0x6a, 0x09,
+	0xb3, 0xfe, 0x5a, 0xdb, 0x92, 0x7e, 0x74, 0x88, 0x3c, 0xca, 0x3c, 0x90, 0x86, 0x5f, 0x2a, 0x50,
+	0xd3, 0xaf, 0x08, 0xe1, 0xa1, 0x8b, 0xf6, 0xef, 0xd2, 0x8d, 0x34, 0x93, 0x90, 0x70, 0x8c, 0x60,
+	0x4a, 0x54, 0xa0, 0xe8, 0xa2, 0xe7, 0xda, 0x78, 0x72, 0x99, 0x6e, 0x2d, 0x5f, 0xcc, 0x7e, 0x3f,
+	0xbe, 0xf8, 0x00, 0x6a, 0x71, 0xc5, 0xbb, 0xd6, 0x34, 0xed, 0x14, 0x58, 0x7a, 0x95, 0x20, 0x45,
+	0xa9, 0xbd, 0x0b, 0x9b, 0x49, 0x2f, 0xda, 0xe2, 0x25, 0x8d, 0x57, 0xa0, 0xea, 0x5a, 0x78, 0xff,
+	0x94, 0xb2, 0xd1, 0xd1, 0xbd, 0x6b, 0x9d, 0x08, 0x02, 0xed, 0xa1, 0xcc, 0xf7, 0xe2, 0x97, 0x45,
+	0x1d, 0x53, 0x9e, 0x99, 0x92, 0xe7, 0x98, 0x11, 0x0a, 0x6b, 0x93, 0x26, 0xa6, 0xe4, 0x5a, 0x27,
+	0xb4, 0xe6, 0x4e, 0x44, 0x3d, 0x2d, 0xd3, 0x14, 0x27, 0x8f, 0xeb, 0x2e, 0xad, 0x5f, 0x83, 0x32,
+	0x46, 0xbe, 0xc9, 0x15, 0xcc, 0x7d, 0xfe, 0xd9, 0x5b, 0x22, 0x30, 0xe0, 0xbc, 0x53, 0x4a, 0xc2,
+	0x46, 0x77, 0x7c, 0xf3, 0xc9, 0xcb, 0xc3, 0xef, 0x09, 0x96, 0x87, 0xfb, 0x4f, 0x7c, 0x39, 0x3e,
+	0x8d, 0xc4, 0x48, 0x04, 0x4c, 0x22, 0x24, 0xb0, 0xbe, 0x16, 0xb1, 0x09, 0x98, 0xd4, 0xfe, 0x04,
+	0x00, 0x92, 0x8e, 0xa7, 0xa4, 0x77, 0x66, 0x49, 0x7a, 0xff, 0xa0, 0x63, 0xc9, 0x77, 0xf1, 0x8d,
+	0xa3, 0xf9, 0x99, 0x9e, 0x94, 0xc8, 0xad, 0x2d, 0x51, 0x43, 0xaa, 0x51, 0x12, 0x3e, 0xbd, 0x7a,
+	0xa8, 0x95, 0x5f, 0x7b, 0xa8, 0xf5, 0x0e, 0x94, 0xb8, 0xef, 0x3b, 0x10, 0x81, 0xf8, 0x57, 0x97,
+	0x25, 0xd3, 0x5d, 0xf1, 0x66, 0x54, 0x44, 0xa7, 0x76, 0xa0, 0x11, 0x3f, 0x98, 0x23, 0x87, 0xe5,
+	0xdf, 0x5c, 0x2d, 0x19, 0x91, 0xf1, 0x57, 0x1a, 0x0c, 0x39, 0x2b, 0x49, 0xec, 0x70, 0x26, 0x1c,
+	0x32, 0x24, 0xb1, 0x4b, 0xb2, 0xc4, 0x1e, 0xcd, 0xb8, 0x1b, 0x06, 0x25, 0xf6, 0xcf, 0xe0, 0xa2,
+	0x08, 0x71, 0xc4, 0x02, 0x38, 0x9c, 0x44, 0xcf, 0xaf, 0xf6, 0x89, 0x7b, 0x91, 0xa3, 0x19, 0xa9,
+	0xc2, 0x48, 0xfe, 0x39, 0x5c, 0x9a, 0x1c, 0xe1, 0xa5, 0x77, 0x7c, 0xd7, 0x43, 0xa7, 0x37, 0x16,
+	0x75, 0x3c, 0xeb, 0xe4, 0x3a, 0xc8, 0x1b, 0x2b, 0x8d, 0x6d, 0x13, 0xf1, 0x68, 0xec, 0xd0, 0x79,
+	0x7f, 0x7c, 0xf4, 0xb9, 0x39, 0x59, 0x86, 0x2f, 0x1d, 0xfc, 0xc0, 0xca, 0xc1, 0xcf, 0xb2, 0x6a,
+	0x51, 0x5d, 0x55, 0x2d, 0xae, 0xff, 0x97, 0x3c, 0x14, 0xf9, 0xc0, 0xd2, 0xdb, 0x1b, 0xbe, 0x37,
+	0x8f, 0xa3, 0x6e, 0xd6, 0x68, 0x06, 0xf4, 0x42, 0x3a, 0x2a, 0x11, 0x77, 0xa1, 0x88, 0x27, 0x9b,
+	0xd3, 0xe3, 0xf4, 0xa1, 0xcb, 0x92, 0x90, 0x46, 0x9f, 0xa9, 0x81, 0x09, 0xf5, 0x03, 0xa8, 0x20,
+	0x3d, 0xf7, 0x27, 0xa5, 0x8c, 0x97, 0x55, 0x71, 0x8a, 0x67, 0x28, 0x86, 0x48, 0xab, 0x1f, 0xa7,
+	0xdd, 0x57, 0x5c, 0xd6, 0x5d, 0x5f, 0x29, 0x7a, 0x9e, 0x23, 0xeb, 0xf7, 0x81, 0xfb, 0x33, 0x62,
+	0x4e, 0x51, 0x90, 0xfd, 0xfb, 0x2b, 0x7c, 0x05, 0x9d, 0x27, 0x06, 0x8f, 0xb5, 0xa0, 0x3c, 0x3e,
+	0x99, 0xc1, 0xcb, 0xc7, 0x6f, 0x19, 0xaf, 0x19, 0x19, 0xdc, 0xe7, 0xb1, 0x7f, 0x09, 0x33, 0x54,
+	0xcc, 0x34, 0xa3, 0xd8, 0x85, 0xd2, 0x4a, 0xb1, 0x98, 0x9b, 0x50, 0xb1, 0x28, 0xa3, 0x3e, 0x80,
+	0x2a, 0x79, 0x79, 0x44, 0xb9, 0xf2, 0xca, 0xd0, 0x26, 0xcc, 0x80, 0x7c, 0xd7, 0x71, 0x4e, 0x6d,
+	0x47, 0xfd, 0xf4, 0x2d, 0xd9, 0x3d, 0x78, 0x63, 0xed, 0x40, 0xb1, 0xd8, 0x53, 0xc8, 0x3b, 0xcb,
+	0x78, 0x19, 0x75, 0x07, 0x6a, 0x86, 0x24, 0x25, 0x9a, 0x70, 0x4e, 0x1d, 0x12, 0x0d, 0xd5, 0x21,
+	0xe5, 0x93, 0x33, 0xac, 0xeb, 0x0c, 0xae, 0xac, 0x5f, 0xca, 0xf2, 0xa1, 0x7d, 0x9e, 0x1f, 0xda,
+	0x6b, 0xe9, 0xbb, 0xa9, 0xe9, 0xdb, 0x44, 0xd2, 0x11, 0xfe, 0xa7, 0x68, 0xb0, 0xca, 0x9b, 0xb7,
+	0x0a, 0xa5, 0xe8, 0xf1, 0x37, 0x8a, 0x3a, 0x6b, 0x0f, 0xf6, 0xf1, 0x18, 0xab, 0x0a, 0xa5, 0x6e,
+	0x7f, 0x38, 0x6a, 0xf5, 0xc5, 0x09, 0x65, 0xb7, 0x2f, 0x4e, 0x28, 0xb5, 0xff, 0x88, 0x41, 0x00,
+	0xb1, 0x53, 0xf5, 0x47, 0x5b, 0xa9, 0xb1, 0xf9, 0x97, 0x93, 0xcd, 0xbf, 0x25, 0x2d, 0x8b, 0x9f,
+	0xb2, 0xf3, 0x3b, 0xcb, 0x1b, 0x69, 0x5d, 0x26, 0x58, 0xbd, 0xde, 0x50, 0xf8, 0x9e, 0xd7, 0x1b,
+	0xe4, 0x38, 0xa7, 0x62, 0x3a, 0xce, 0x69, 0xe9, 0x01, 0xc0, 0x12, 0x45, 0x04, 0xc8, 0x0f, 0x00,
+	0x9e, 0x1b, 0x0a, 0x50, 0x3e, 0x3f, 0x14, 0x80, 0x7e, 0x06, 0x02, 0xdd, 0x7a, 0x22, 0xdc, 0x47,
+	0xe4, 0xd2, 0xe2, 0x03, 0x5e, 0x20, 0x3e, 0xbe, 0x07, 0x2b, 0x52, 0xb7, 0xe1, 0xd2, 0xf4, 0x38,
+	0x7e, 0xec, 0x28, 0xb1, 0x76, 0x6a, 0xd4, 0x8d, 0xb5, 0x38, 0xed, 0x8f, 0x32, 0x00, 0x89, 0x1b,
+	0xf2, 0xb7, 0xf6, 0xb6, 0x48, 0x06, 0x6d, 0xee, 0x3b, 0x0c, 0xda, 0x17, 0x5c, 0xa9, 0xd5, 0xbe,
+	0x86, 0x4a, 0xec, 0x78, 0xfe, 0xf1, 0x6b, 0xec, 0x07, 0x7d, 0xf2, 0x0f, 0x23, 0xcf, 0x53, 0xec,
+	0xb9, 0xfd, 0x6d, 0xc7, 0x22, 0xf5, 0xf9, 0xdc, 0x0b, 0x3e, 0x7f, 0xca, 0xdd, 0x3f, 0xf1, 0xc7,
+	0x7f, 0xc7, 0x1b, 0x4b, 0x5e, 0xf3, 0xf9, 0xd4, 0x9a, 0xd7, 0x16, 0xc2, 0x87, 0xf5, 0xdb, 0x7f,
+	0xfa, 0x07, 0x75, 0xf8, 0x2f, 0x32, 0x91, 0xa3, 0x25, 0x7e, 0x42, 0xea, 0x5c, 0x45, 0x6b, 0xbd,
+	0xaf, 0xe8, 0x87, 0x7c, 0xee, 0x3b, 0x2d, 0xc5, 0xfc, 0x77, 0x59, 0x8a, 0x6f, 0x40, 0x81, 0x0b,
+	0x84, 0xc2, 0x79, 0x56, 0x22, 0xc7, 0xbf, 0xf0, 0xd1, 0x55, 0x4d, 0x13, 0x8a, 0x25, 0xef, 0xef,
+	0xa5, 0xa8, 0xde, 0xe8, 0xc1, 0x58, 0xcc, 0xa0, 0xa1, 0x5e, 0x49, 0x0c, 0xc6, 0x1f, 0x3e, 0x26,
+	0xbf, 0x33, 0x53, 0xf1, 0x5f, 0x64, 0xa1, 0x9e, 0x3a, 0x73, 0xfa, 0x11, 0x8d, 0x59, 0xcb, 0xcd,
+	0x73, 0xeb, 0xb9, 0xf9, 0xb9, 0x8c, 0x35, 0x7f, 0x3e, 0x63, 0xfd, 0xbf, 0x22, 0x01, 0x78, 0xf0,
+	0xa0, 0x78, 0xdf, 0xb5, 0x1c, 0x05, 0x0f, 0xf2, 0xb0, 0x38, 0xe4, 0xa6, 0x35, 0xf9, 0xbb, 0x6b,
+	0xf5, 0xf7, 0xcc, 0x5a, 0xfd, 0xfd, 0x66, 0xfc, 0xa3, 0x07, 0xdd, 0x5d, 0x6e, 0x14, 0xd6, 0x99,
+	0x04, 0xc1, 0x5b, 0xd5, 0x5c, 0xab, 0xe1, 0x8a, 0x9c, 0xee, 0x4d, 0xf5, 0x08, 0x6b, 0x8a, 0xb8,
+	0xb9, 0x2b, 0x9c, 0x80, 0xbf, 0xc8, 0x3b, 0x6d, 0x45, 0x58, 0xad, 0x0b, 0xf5, 0xd4, 0x01, 0xa0,
+	0xf4, 0xf3, 0x2a, 0x19, 0xf9, 0xe7, 0x55, 0x30, 0x4c, 0xeb, 0xe4, 0xc8, 0xf2, 0xad, 0x35, 0xcf,
+	0xea, 0x70, 0x04, 0xbe, 0x9d, 0x2e, 0x07, 0x23, 0xa8, 0x6f, 0x41, 0xc1, 0x0e, 0xad, 0x59, 0x64,
+	0x01, 0x5f, 0x59, 0x8d, 0x57, 0x20, 0x23, 0x98, 0x13, 0xe1, 0xc1, 0xbf, 0xb2, 0x8c, 0x93, 0x7e,
+	0x03, 0x26, 0x73, 0xce, 0x6f, 0xc0, 0x64, 0x53, 0x8d, 0x5c, 0xf7, 0x33, 0x2e, 0xf1, 0xd3, 0x1c,
+	0xf9, 0x73, 0x9e, 0xe6, 0xc0, 0x9b, 0x51, 0xbe, 0x45, 0x3f, 0xb0, 0x61, 0x36, 0x0b, 0x2b, 0x44,
+	0x31, 0x0e, 0xc3, 0x3f, 0x4b, 0x22, 0x72, 0x62, 0xad, 0xa1, 0xfa, 0x26, 0x94, 0xf8, 0x8f, 0x6d,
+	0x44, 0x86, 0xfb, 0x4a, 0x58, 0x63, 0x84, 0xc7, 0xe8, 0x4e, 0x44, 0xa5, 0x0d, 0x57, 0x8c, 0xa7,
+	0x61, 0x04, 0xc7, 0xa5, 0xc6, 0xdd, 0x10, 0x68, 0x7a, 0x05, 0xe2, 0x7a, 0x35, 0x10, 0x08, 0x55,
+	0xb3, 0x40, 0xfb, 0x18, 0x4a, 0x22, 0x32, 0x63, 0x6d, 0x53, 0x5e, 0xf4, 0x33, 0x13, 0x5b, 0x00,
+	0x49, 0xa8, 0xc6, 0xba, 0x1a, 0xf0, 0x87, 0x63, 0xa2, 0xe8, 0x0c, 0x5c, 0x7f, 0xc9, 0xa7, 0x45,
+	0xc0, 0xae, 0xdc, 0x18, 0x47, 0xbc, 0x3d, 0x87, 0x87, 0xb4, 0xe4, 0x11, 0xbb, 0x87, 0xaf, 0xbc,
+	0x8b, 0x27, 0xfd, 0x32, 0xe7, 0x3f, 0xe9, 0x17, 0x13, 0xa9, 0x77, 0x20, 0x66, 0xc7, 0x2f, 0xb2,
+	0x96, 0xb5, 0x56, 0x14, 0x99, 0x4e, 0xab, 0xec, 0xbe, 0xf0, 0xfc, 0xf4, 0xe8, 0x51, 0x80, 0x94,
+	0xb3, 0x25, 0xd5, 0x26, 0x26, 0x91, 0x69, 0x0d, 0xa8, 0xc9, 0x47, 0xca, 0x5a, 0x0b, 0x36, 0xf1,
+	0x17, 0x47, 0x90, 0x67, 0x61, 0x90, 0x3d, 0xd2, 0xf3, 0xf5, 0x8b, 0x89, 0xf4, 0xfa, 0x5d, 0xa6,
+	0x63, 0x9c, 0x48, 0xfb, 0x65, 0x1e, 0x94, 0x65, 0x1c, 0x32, 0x93, 0xf8, 0xb9, 0xf1, 0x4c, 0xf4,
+	0x5c, 0xa9, 0x13, 0xbf, 0x10, 0x4f, 0xeb, 0x42, 0x76, 0x6c, 0x00, 0x07, 0xf5, 0xc5, 0xa3, 0x51,
+	0x76, 0xa0, 0xa7, 0xde, 0xfd, 0x2c, 0xdb, 0xc1, 0x63, 0xca, 0xa3, 0x23, 0x0c, 0x6f, 0x42, 0x3b,
+	0xde, 0x84, 0x96, 0x75, 0x8d, 0x6e, 0x4a, 0xf7, 0xbc, 0x09, 0x96, 0x8a, 0x0c, 0xee, 0x40, 0xdc,
+	0x61, 0x28, 0x73, 0xc0, 0x88, 0x3c, 0xf8, 0xe2, 0x3e, 0x6c, 0xc8, 0x7f, 0xc9, 0xa4, 0xc6, 0xca,
+	0x1c, 0x30, 0x0a, 0xa2, 0x27, 0xd2, 0x26, 0xe2, 0xdd, 0xef, 0x1c, 0x3d, 0x91, 0x86, 0x6f, 0xb8,
+	0xa1, 0x03, 0x07, 0x9f, 0x96, 0x9f, 0x88, 0xa7, 0xff, 0xc5, 0x03, 0x74, 0x88, 0x7a, 0x8d, 0xbf,
+	0x8c, 0xee, 0x5b, 0x41, 0xc0, 0xdf, 0xcf, 0xe0, 0x4f, 0x5b, 0xd4, 0x22, 0x60, 0xfc, 0x50, 0x87,
+	0x78, 0x4b, 0x1e, 0x49, 0x40, 0x3c, 0xd4, 0x41, 0x20, 0x22, 0xb8, 0x06, 0xe5, 0x6f, 0x3c, 0xd7,
+	0x22, 0xc3, 0xbd, 0x4a, 0xad, 0x2a, 0x61, 0x7e, 0xcf, 0x98, 0x6b, 0xff, 0x21, 0x03, 0x97, 0x96,
+	0x47, 0x95, 0x16, 0x4c, 0x0d, 0xca, 0xed, 0x41, 0x4f, 0xef, 0xb7, 0xf6, 0xf0, 0xc8, 0x7b, 0x03,
+	0xaa, 0x83, 0x1d, 0xbc, 0xef, 0xc5, 0x01, 0x19, 0xba, 0xb6, 0x34, 0xd4, 0x1f, 0x77, 0x77, 0x77,
+	0x3b, 0x7d, 0x6e, 0xa5, 0x0c, 0x76, 0x3e, 0xd3, 0x7b, 0x83, 0x36, 0x7f, 0xc6, 0x3a, 0x3a, 0xf8,
+	0x1e, 0x2a, 0x79, 0xcc, 0xf2, 0xb0, 0x4a, 0xcc, 0x16, 0x78, 0xd4, 0xe0, 0xb3, 0xa1, 0xde, 0xee,
+	0x8f, 0x94, 0x22, 0xe6, 0xf0, 0x5e, 0x8d, 0xde, 0x8e, 0xc2, 0x83, 0xda, 0x83, 0xbd, 0x7d, 0xd6,
+	0x19, 0x0e, 0xf5, 0x61, 0xf7, 0xcb, 0x8e, 0x52, 0xa6, 0x2f, 0xb3, 0xee, 0xa3, 0x6e, 0x9f, 0x03,
+	0x2a, 0xe8, 0x79, 0xdf, 0xeb, 0xf6, 0x15, 0xa0, 0x44, 0xeb, 0x73, 0xa5, 0x8a, 0x89, 0xe1, 0xc1,
+	0x9e, 0x52, 0xbb, 0xf3, 0x2a, 0xd4, 0xe4, 0xdf, 0x6f, 0xa0, 0x40, 0x41, 0xcf, 0xb5, 0xf8, 0xb3,
+	0x69, 0xbd, 0x6f, 0xde, 0x55, 0x32, 0x77, 0xfe, 0x50, 0x7a, 0x63, 0x97, 0x68, 0x84, 0x23, 0x9f,
+	0x6e, 0xcf, 0xf1, 0xcb, 0x3c, 0xe4, 0xb6, 0xa7, 0xbb, 0x3f, 0x8f, 0x5b, 0xc3, 0xc7, 0xdc, 0xc5,
+	0x2f, 0x30, 0x04, 0xc8, 0x25, 0xcf, 0x65, 0xd1, 0x6d, 0x39, 0x4a, 0xc6, 0xe7, 0xdc, 0x05, 0x2c,
+	0x48, 0x47, 0xd0, 0x45, 0x3c, 0xbd, 0xc5, 0x54, 0x8c, 0x2b, 0xdd, 0xd1, 0xa0, 0x2a, 0xbd, 0x90,
+	0x48, 0xdf, 0x30, 0x82, 0x23, 0xf1, 0x02, 0x17, 0x9a, 0x9b, 0x4a, 0xe6, 0xce, 0xeb, 0x50, 0x17,
+	0x34, 0xe2, 0x7d, 0x42, 0xfc, 0xb9, 0x24, 0xbc, 0x67, 0xe3, 0x08, 0x3a, 0x6b, 0x11, 0x20, 0xdd,
+	0x3d, 0xb8, 0xbc, 0xf6, 0xb5, 0x45, 0xa4, 0x1f, 0xda, 0x18, 0x4c, 0xc8, 0xe3, 0x35, 0x1f, 0x9f,
+	0x8d, 0x7d, 0xdb, 0x54, 0x32, 0x77, 0x3e, 0x85, 0xe6, 0x79, 0xe1, 0x87, 0x58, 0x6f, 0xfb, 0x71,
+	0x8b, 0x42, 0x3c, 0x71, 0x4a, 0x06, 0x3a, 0xcf, 0x65, 0x78, 0x84, 0x6c, 0xaf, 0x43, 0x21, 0x0d,
+	0x77, 0xbe, 0xcd, 0x48, 0x8c, 0x28, 0x0a, 0x21, 0x8b, 0x01, 0x62, 0xac, 0x65, 0x10, 0xb3, 0x0c,
+	0x53, 0xc9, 0xa8, 0x57, 0x40, 0x4d, 0x81, 0x7a, 0xde, 0xc4, 0x70, 0x94, 0x2c, 0x05, 0x2f, 0x44,
+	0x70, 0x0a, 0x08, 0x56, 0x72, 0xea, 0xcb, 0x70, 0x2d, 0x86, 0xf5, 0xbc, 0x93, 0x7d, 0xdf, 0x46,
+	0x8b, 0xf9, 0x8c, 0xa3, 0xf3, 0x3b, 0x9f, 0xfc, 0xfa, 0x37, 0x37, 0x33, 0xff, 0xf9, 0x37, 0x37,
+	0x33, 0xff, 0xf3, 0x37, 0x37, 0x2f, 0xfc, 0xf2, 0x7f, 0xdd, 0xcc, 0x7c, 0x29, 0xff, 0x78, 0xe2,
+	0xcc, 0x08, 0x7d, 0xfb, 0x94, 0x2f, 0xfd, 0x28, 0xe3, 0x5a, 0xf7, 0xe6, 0xc7, 0x87, 0xf7, 0xe6,
+	0xe3, 0x7b, 0xc8, 0x5f, 0xc6, 0x45, 0xfa, 0x99, 0xc4, 0xfb, 0xff, 0x67, 0x00, 0xea, 0xee, 0x2a,
+	0x96, 0x86, 0x71, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -15935,6 +15972,26 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IgnoreNulls {
+		i--
+		if m.IgnoreNulls {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FromLast {
+		i--
+		if m.FromLast {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -16781,6 +16838,26 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.GroupingIds) > 0 {
+		dAtA74 := make([]byte, len(m.GroupingIds)*10)
+		var j73 int
+		for _, num := range m.GroupingIds {
+			for num >= 1<<7 {
+				dAtA74[j73] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j73++
+			}
+			dAtA74[j73] = uint8(num)
+			j73++
+		}
+		i -= j73
+		copy(dAtA[i:], dAtA74[:j73])
+		i = encodeVarintPlan(dAtA, i, uint64(j73))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xda
+	}
 	if m.ScanSnapshot != nil {
 		{
 			size, err := m.ScanSnapshot.MarshalToSizedBuffer(dAtA[:i])
@@ -17137,21 +17214,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if len(m.SourceStep) > 0 {
-		dAtA86 := make([]byte, len(m.SourceStep)*10)
-		var j85 int
+		dAtA88 := make([]byte, len(m.SourceStep)*10)
+		var j87 int
 		for _, num1 := range m.SourceStep {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA86[j85] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA88[j87] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j85++
+				j87++
 			}
-			dAtA86[j85] = uint8(num)
-			j85++
+			dAtA88[j87] = uint8(num)
+			j87++
 		}
-		i -= j85
-		copy(dAtA[i:], dAtA86[:j85])
-		i = encodeVarintPlan(dAtA, i, uint64(j85))
+		i -= j87
+		copy(dAtA[i:], dAtA88[:j87])
+		i = encodeVarintPlan(dAtA, i, uint64(j87))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -17242,21 +17319,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA92 := make([]byte, len(m.BindingTags)*10)
-		var j91 int
+		dAtA94 := make([]byte, len(m.BindingTags)*10)
+		var j93 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA94[j93] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j91++
+				j93++
 			}
-			dAtA92[j91] = uint8(num)
-			j91++
+			dAtA94[j93] = uint8(num)
+			j93++
 		}
-		i -= j91
-		copy(dAtA[i:], dAtA92[:j91])
-		i = encodeVarintPlan(dAtA, i, uint64(j91))
+		i -= j93
+		copy(dAtA[i:], dAtA94[:j93])
+		i = encodeVarintPlan(dAtA, i, uint64(j93))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -17495,21 +17572,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA101 := make([]byte, len(m.Children)*10)
-		var j100 int
+		dAtA103 := make([]byte, len(m.Children)*10)
+		var j102 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA101[j100] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA103[j102] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j100++
+				j102++
 			}
-			dAtA101[j100] = uint8(num)
-			j100++
+			dAtA103[j102] = uint8(num)
+			j102++
 		}
-		i -= j100
-		copy(dAtA[i:], dAtA101[:j100])
-		i = encodeVarintPlan(dAtA, i, uint64(j100))
+		i -= j102
+		copy(dAtA[i:], dAtA103[:j102])
+		i = encodeVarintPlan(dAtA, i, uint64(j102))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -17764,20 +17841,20 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA106 := make([]byte, len(m.PartitionTableIds)*10)
-		var j105 int
+		dAtA108 := make([]byte, len(m.PartitionTableIds)*10)
+		var j107 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA106[j105] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA108[j107] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j105++
+				j107++
 			}
-			dAtA106[j105] = uint8(num)
-			j105++
+			dAtA108[j107] = uint8(num)
+			j107++
 		}
-		i -= j105
-		copy(dAtA[i:], dAtA106[:j105])
-		i = encodeVarintPlan(dAtA, i, uint64(j105))
+		i -= j107
+		copy(dAtA[i:], dAtA108[:j107])
+		i = encodeVarintPlan(dAtA, i, uint64(j107))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -17884,21 +17961,21 @@ func (m *PreInsertUkCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Columns) > 0 {
-		dAtA111 := make([]byte, len(m.Columns)*10)
-		var j110 int
+		dAtA113 := make([]byte, len(m.Columns)*10)
+		var j112 int
 		for _, num1 := range m.Columns {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA111[j110] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA113[j112] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j110++
+				j112++
 			}
-			dAtA111[j110] = uint8(num)
-			j110++
+			dAtA113[j112] = uint8(num)
+			j112++
 		}
-		i -= j110
-		copy(dAtA[i:], dAtA111[:j110])
-		i = encodeVarintPlan(dAtA, i, uint64(j110))
+		i -= j112
+		copy(dAtA[i:], dAtA113[:j112])
+		i = encodeVarintPlan(dAtA, i, uint64(j112))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -17930,21 +18007,21 @@ func (m *PreDeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Idx) > 0 {
-		dAtA113 := make([]byte, len(m.Idx)*10)
-		var j112 int
+		dAtA115 := make([]byte, len(m.Idx)*10)
+		var j114 int
 		for _, num1 := range m.Idx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA113[j112] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA115[j114] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j112++
+				j114++
 			}
-			dAtA113[j112] = uint8(num)
-			j112++
+			dAtA115[j114] = uint8(num)
+			j114++
 		}
-		i -= j112
-		copy(dAtA[i:], dAtA113[:j112])
-		i = encodeVarintPlan(dAtA, i, uint64(j112))
+		i -= j114
+		copy(dAtA[i:], dAtA115[:j114])
+		i = encodeVarintPlan(dAtA, i, uint64(j114))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -18106,21 +18183,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA118 := make([]byte, len(m.List)*10)
-		var j117 int
+		dAtA120 := make([]byte, len(m.List)*10)
+		var j119 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA118[j117] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA120[j119] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j117++
+				j119++
 			}
-			dAtA118[j117] = uint8(num)
-			j117++
+			dAtA120[j119] = uint8(num)
+			j119++
 		}
-		i -= j117
-		copy(dAtA[i:], dAtA118[:j117])
-		i = encodeVarintPlan(dAtA, i, uint64(j117))
+		i -= j119
+		copy(dAtA[i:], dAtA120[:j119])
+		i = encodeVarintPlan(dAtA, i, uint64(j119))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -18239,20 +18316,20 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA122 := make([]byte, len(m.PartitionTableIds)*10)
-		var j121 int
+		dAtA124 := make([]byte, len(m.PartitionTableIds)*10)
+		var j123 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA122[j121] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA124[j123] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j121++
+				j123++
 			}
-			dAtA122[j121] = uint8(num)
-			j121++
+			dAtA124[j123] = uint8(num)
+			j123++
 		}
-		i -= j121
-		copy(dAtA[i:], dAtA122[:j121])
-		i = encodeVarintPlan(dAtA, i, uint64(j121))
+		i -= j123
+		copy(dAtA[i:], dAtA124[:j123])
+		i = encodeVarintPlan(dAtA, i, uint64(j123))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -18330,6 +18407,20 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Dop != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Dop))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Hints) > 0 {
+		for iNdEx := len(m.Hints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hints[iNdEx])
+			copy(dAtA[i:], m.Hints[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Hints[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.DetectSqls) > 0 {
 		for iNdEx := len(m.DetectSqls) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.DetectSqls[iNdEx])
@@ -18397,21 +18488,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA125 := make([]byte, len(m.Steps)*10)
-		var j124 int
+		dAtA127 := make([]byte, len(m.Steps)*10)
+		var j126 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA125[j124] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA127[j126] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j124++
+				j126++
 			}
-			dAtA125[j124] = uint8(num)
-			j124++
+			dAtA127[j126] = uint8(num)
+			j126++
 		}
-		i -= j124
-		copy(dAtA[i:], dAtA125[:j124])
-		i = encodeVarintPlan(dAtA, i, uint64(j124))
+		i -= j126
+		copy(dAtA[i:], dAtA127[:j126])
+		i = encodeVarintPlan(dAtA, i, uint64(j126))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -20869,20 +20960,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.FkChildTblsReferToMe) > 0 {
-		dAtA180 := make([]byte, len(m.FkChildTblsReferToMe)*10)
-		var j179 int
+		dAtA182 := make([]byte, len(m.FkChildTblsReferToMe)*10)
+		var j181 int
 		for _, num := range m.FkChildTblsReferToMe {
 			for num >= 1<<7 {
-				dAtA180[j179] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA182[j181] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j179++
+				j181++
 			}
-			dAtA180[j179] = uint8(num)
-			j179++
+			dAtA182[j181] = uint8(num)
+			j181++
 		}
-		i -= j179
-		copy(dAtA[i:], dAtA180[:j179])
-		i = encodeVarintPlan(dAtA, i, uint64(j179))
+		i -= j181
+		copy(dAtA[i:], dAtA182[:j181])
+		i = encodeVarintPlan(dAtA, i, uint64(j181))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -20927,20 +21018,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA183 := make([]byte, len(m.ForeignTbl)*10)
-		var j182 int
+		dAtA185 := make([]byte, len(m.ForeignTbl)*10)
+		var j184 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA183[j182] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA185[j184] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j182++
+				j184++
 			}
-			dAtA183[j182] = uint8(num)
-			j182++
+			dAtA185[j184] = uint8(num)
+			j184++
 		}
-		i -= j182
-		copy(dAtA[i:], dAtA183[:j182])
-		i = encodeVarintPlan(dAtA, i, uint64(j182))
+		i -= j184
+		copy(dAtA[i:], dAtA185[:j184])
+		i = encodeVarintPlan(dAtA, i, uint64(j184))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -21493,20 +21584,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x40
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA192 := make([]byte, len(m.ForeignTbl)*10)
-		var j191 int
+		dAtA194 := make([]byte, len(m.ForeignTbl)*10)
+		var j193 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA192[j191] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA194[j193] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j191++
+				j193++
 			}
-			dAtA192[j191] = uint8(num)
-			j191++
+			dAtA194[j193] = uint8(num)
+			j193++
 		}
-		i -= j191
-		copy(dAtA[i:], dAtA192[:j191])
-		i = encodeVarintPlan(dAtA, i, uint64(j191))
+		i -= j193
+		copy(dAtA[i:], dAtA194[:j193])
+		i = encodeVarintPlan(dAtA, i, uint64(j193))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -21592,20 +21683,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA195 := make([]byte, len(m.AccountIDs)*10)
-		var j194 int
+		dAtA197 := make([]byte, len(m.AccountIDs)*10)
+		var j196 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA195[j194] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA197[j196] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j194++
+				j196++
 			}
-			dAtA195[j194] = uint8(num)
-			j194++
+			dAtA197[j196] = uint8(num)
+			j196++
 		}
-		i -= j194
-		copy(dAtA[i:], dAtA195[:j194])
-		i = encodeVarintPlan(dAtA, i, uint64(j194))
+		i -= j196
+		copy(dAtA[i:], dAtA197[:j196])
+		i = encodeVarintPlan(dAtA, i, uint64(j196))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -21817,21 +21908,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA199 := make([]byte, len(m.ParamTypes)*10)
-		var j198 int
+		dAtA201 := make([]byte, len(m.ParamTypes)*10)
+		var j200 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA199[j198] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA201[j200] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j198++
+				j200++
 			}
-			dAtA199[j198] = uint8(num)
-			j198++
+			dAtA201[j200] = uint8(num)
+			j200++
 		}
-		i -= j198
-		copy(dAtA[i:], dAtA199[:j198])
-		i = encodeVarintPlan(dAtA, i, uint64(j198))
+		i -= j200
+		copy(dAtA[i:], dAtA201[:j200])
+		i = encodeVarintPlan(dAtA, i, uint64(j200))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -21978,21 +22069,21 @@ func (m *OtherDCL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA202 := make([]byte, len(m.ParamTypes)*10)
-		var j201 int
+		dAtA204 := make([]byte, len(m.ParamTypes)*10)
+		var j203 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA202[j201] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA204[j203] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j201++
+				j203++
 			}
-			dAtA202[j201] = uint8(num)
-			j201++
+			dAtA204[j203] = uint8(num)
+			j203++
 		}
-		i -= j201
-		copy(dAtA[i:], dAtA202[:j201])
-		i = encodeVarintPlan(dAtA, i, uint64(j201))
+		i -= j203
+		copy(dAtA[i:], dAtA204[:j203])
+		i = encodeVarintPlan(dAtA, i, uint64(j203))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -23990,6 +24081,12 @@ func (m *WindowSpec) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.FromLast {
+		n += 2
+	}
+	if m.IgnoreNulls {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -24596,6 +24693,13 @@ func (m *Node) ProtoSize() (n int) {
 		l = m.ScanSnapshot.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if len(m.GroupingIds) > 0 {
+		l = 0
+		for _, e := range m.GroupingIds {
+			l += sovPlan(uint64(e))
+		}
+		n += 2 + sovPlan(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -24972,6 +25076,15 @@ func (m *Query) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if len(m.Hints) > 0 {
+		for _, s := range m.Hints {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.Dop != 0 {
+		n += 1 + sovPlan(uint64(m.Dop))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -35909,6 +36022,46 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromLast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromLast = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreNulls", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreNulls = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -40065,6 +40218,82 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 59:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GroupingIds = append(m.GroupingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GroupingIds) == 0 {
+					m.GroupingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GroupingIds = append(m.GroupingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupingIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -42643,6 +42872,57 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			}
 			m.DetectSqls = append(m.DetectSqls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hints = append(m.Hints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dop", wireType)
+			}
+			m.Dop = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dop |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -273,6 +273,97 @@ func (m *ShuffleRange) GetResult() []float64 {
 	return nil
 }
 
+// Histogram is the distribution of the non-null values of a column, built by
+// ANALYZE TABLE from a sample. The values are in the float64 domain of
+// MinValMap and MaxValMap.
+type Histogram struct {
+	// Most common values and their fractions of the non-null values.
+	McvValues []float64 `protobuf:"fixed64,1,rep,packed,name=McvValues,proto3" json:"McvValues,omitempty"`
+	McvFreqs  []float64 `protobuf:"fixed64,2,rep,packed,name=McvFreqs,proto3" json:"McvFreqs,omitempty"`
+	// Equi-depth buckets of the other values, bucket i covers
+	// (Bounds[i], Bounds[i+1]], the first one includes Bounds[0].
+	Bounds []float64 `protobuf:"fixed64,3,rep,packed,name=Bounds,proto3" json:"Bounds,omitempty"`
+	// Fractions of the non-null values and distinct values of the buckets.
+	Freqs      []float64 `protobuf:"fixed64,4,rep,packed,name=Freqs,proto3" json:"Freqs,omitempty"`
+	Ndvs       []float64 `protobuf:"fixed64,5,rep,packed,name=Ndvs,proto3" json:"Ndvs,omitempty"`
+	SampleRows int64     `protobuf:"varint,6,opt,name=SampleRows,proto3" json:"SampleRows,omitempty"`
+}
+
+func (m *Histogram) Reset()         { *m = Histogram{} }
+func (m *Histogram) String() string { return proto.CompactTextString(m) }
+func (*Histogram) ProtoMessage()    {}
+func (*Histogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3f8e561c9795adb, []int{2}
+}
+func (m *Histogram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Histogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Histogram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Histogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Histogram.Merge(m, src)
+}
+func (m *Histogram) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Histogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_Histogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Histogram proto.InternalMessageInfo
+
+func (m *Histogram) GetMcvValues() []float64 {
+	if m != nil {
+		return m.McvValues
+	}
+	return nil
+}
+
+func (m *Histogram) GetMcvFreqs() []float64 {
+	if m != nil {
+		return m.McvFreqs
+	}
+	return nil
+}
+
+func (m *Histogram) GetBounds() []float64 {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+func (m *Histogram) GetFreqs() []float64 {
+	if m != nil {
+		return m.Freqs
+	}
+	return nil
+}
+
+func (m *Histogram) GetNdvs() []float64 {
+	if m != nil {
+		return m.Ndvs
+	}
+	return nil
+}
+
+func (m *Histogram) GetSampleRows() int64 {
+	if m != nil {
+		return m.SampleRows
+	}
+	return 0
+}
+
 type StatsInfo struct {
 	NdvMap               map[string]float64       `protobuf:"bytes,1,rep,name=NdvMap,proto3" json:"NdvMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 	MinValMap            map[string]float64       `protobuf:"bytes,2,rep,name=MinValMap,proto3" json:"MinValMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
@@ -286,13 +377,14 @@ type StatsInfo struct {
 	ApproxObjectNumber   int64                    `protobuf:"varint,10,opt,name=ApproxObjectNumber,proto3" json:"ApproxObjectNumber,omitempty"`
 	TableCnt             float64                  `protobuf:"fixed64,11,opt,name=TableCnt,proto3" json:"TableCnt,omitempty"`
 	TableName            string                   `protobuf:"bytes,12,opt,name=TableName,proto3" json:"TableName,omitempty"`
+	HistogramMap         map[string]*Histogram    `protobuf:"bytes,13,rep,name=HistogramMap,proto3" json:"HistogramMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *StatsInfo) Reset()         { *m = StatsInfo{} }
 func (m *StatsInfo) String() string { return proto.CompactTextString(m) }
 func (*StatsInfo) ProtoMessage()    {}
 func (*StatsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3f8e561c9795adb, []int{2}
+	return fileDescriptor_a3f8e561c9795adb, []int{3}
 }
 func (m *StatsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,6 +497,13 @@ func (m *StatsInfo) GetTableName() string {
 	return ""
 }
 
+func (m *StatsInfo) GetHistogramMap() map[string]*Histogram {
+	if m != nil {
+		return m.HistogramMap
+	}
+	return nil
+}
+
 type StatsInfoKey struct {
 	DatabaseID uint64 `protobuf:"varint,1,opt,name=DatabaseID,proto3" json:"DatabaseID,omitempty"`
 	TableID    uint64 `protobuf:"varint,2,opt,name=TableID,proto3" json:"TableID,omitempty"`
@@ -415,7 +514,7 @@ func (m *StatsInfoKey) Reset()         { *m = StatsInfoKey{} }
 func (m *StatsInfoKey) String() string { return proto.CompactTextString(m) }
 func (*StatsInfoKey) ProtoMessage()    {}
 func (*StatsInfoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3f8e561c9795adb, []int{3}
+	return fileDescriptor_a3f8e561c9795adb, []int{4}
 }
 func (m *StatsInfoKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,7 +572,7 @@ func (m *StatsInfoKeys) Reset()         { *m = StatsInfoKeys{} }
 func (m *StatsInfoKeys) String() string { return proto.CompactTextString(m) }
 func (*StatsInfoKeys) ProtoMessage()    {}
 func (*StatsInfoKeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3f8e561c9795adb, []int{4}
+	return fileDescriptor_a3f8e561c9795adb, []int{5}
 }
 func (m *StatsInfoKeys) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,8 +611,10 @@ func (m *StatsInfoKeys) GetKeys() []StatsInfoKey {
 func init() {
 	proto.RegisterType((*ShuffleHeap)(nil), "statsinfo.ShuffleHeap")
 	proto.RegisterType((*ShuffleRange)(nil), "statsinfo.ShuffleRange")
+	proto.RegisterType((*Histogram)(nil), "statsinfo.Histogram")
 	proto.RegisterType((*StatsInfo)(nil), "statsinfo.StatsInfo")
 	proto.RegisterMapType((map[string]uint64)(nil), "statsinfo.StatsInfo.DataTypeMapEntry")
+	proto.RegisterMapType((map[string]*Histogram)(nil), "statsinfo.StatsInfo.HistogramMapEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "statsinfo.StatsInfo.MaxValMapEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "statsinfo.StatsInfo.MinValMapEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "statsinfo.StatsInfo.NdvMapEntry")
@@ -527,61 +628,68 @@ func init() {
 func init() { proto.RegisterFile("statsinfo.proto", fileDescriptor_a3f8e561c9795adb) }
 
 var fileDescriptor_a3f8e561c9795adb = []byte{
-	// 862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0xe3, 0x44,
-	0x18, 0x8d, 0x63, 0x37, 0x4d, 0x3e, 0xa7, 0xed, 0x6a, 0x54, 0x75, 0x47, 0x15, 0x0a, 0x26, 0x80,
-	0x64, 0x56, 0x6c, 0x22, 0xc2, 0xcd, 0xb2, 0xfc, 0x48, 0xed, 0x16, 0xd8, 0x68, 0x37, 0x5d, 0x34,
-	0x29, 0x7b, 0x01, 0x12, 0xd2, 0x38, 0x3b, 0x71, 0x4d, 0x1d, 0xdb, 0xf2, 0x4f, 0x71, 0xfa, 0x14,
-	0x3c, 0x02, 0x8f, 0xb3, 0x97, 0x7b, 0xd9, 0x2b, 0x84, 0xda, 0x0b, 0x1e, 0x82, 0x1b, 0x34, 0xdf,
-	0xd8, 0x89, 0x5b, 0xac, 0xa2, 0x5e, 0x65, 0xce, 0x37, 0xe7, 0x9c, 0x99, 0x6f, 0x72, 0x66, 0x0c,
-	0x3b, 0x49, 0xca, 0xd3, 0xc4, 0x0b, 0xe6, 0xe1, 0x20, 0x8a, 0xc3, 0x34, 0x24, 0x9d, 0x55, 0x61,
-	0xff, 0xb1, 0xeb, 0xa5, 0xa7, 0x99, 0x33, 0x98, 0x85, 0x8b, 0xa1, 0x1b, 0xba, 0xe1, 0x10, 0x19,
-	0x4e, 0x36, 0x47, 0x84, 0x00, 0x47, 0x4a, 0xd9, 0xff, 0x5b, 0x03, 0x73, 0x7a, 0x9a, 0xcd, 0xe7,
-	0xbe, 0x78, 0x2e, 0x78, 0x44, 0x1e, 0x81, 0xf1, 0x52, 0xcc, 0x53, 0xaa, 0x59, 0x9a, 0x6d, 0x8e,
-	0xf6, 0x06, 0xeb, 0x95, 0x2a, 0x2c, 0x86, 0x1c, 0xf2, 0x29, 0x6c, 0x30, 0xcf, 0x3d, 0x4d, 0x69,
-	0xf3, 0x4e, 0xb2, 0x22, 0x91, 0x07, 0xa0, 0xbf, 0x10, 0x4b, 0xaa, 0x5b, 0x9a, 0xad, 0x31, 0x39,
-	0x24, 0xbb, 0xb0, 0xf1, 0x9a, 0xfb, 0x99, 0xa0, 0x06, 0xd6, 0x14, 0x20, 0x7b, 0xd0, 0x7a, 0x2e,
-	0xd0, 0x76, 0xc3, 0xd2, 0x6c, 0x9d, 0x15, 0x88, 0x6c, 0x43, 0x73, 0x7a, 0x41, 0x5b, 0x58, 0x6b,
-	0x4e, 0x2f, 0xa4, 0xfa, 0x38, 0xf3, 0xfd, 0x84, 0x6e, 0x62, 0x49, 0x01, 0x42, 0x61, 0x93, 0x89,
-	0x73, 0x11, 0x27, 0x82, 0xb6, 0x2d, 0xcd, 0x6e, 0xb3, 0x12, 0xf6, 0x2f, 0x9b, 0xd0, 0x2d, 0xb6,
-	0xc5, 0x78, 0xe0, 0x0a, 0xf2, 0x1e, 0x74, 0xc6, 0xc9, 0x34, 0x8d, 0x4f, 0x96, 0x91, 0xc0, 0x7e,
-	0xdb, 0x6c, 0x5d, 0x28, 0x96, 0x6b, 0xae, 0x96, 0x7b, 0x04, 0xc6, 0x49, 0x2c, 0x04, 0xee, 0xff,
-	0x8e, 0x83, 0x91, 0x1c, 0xd9, 0xea, 0xc4, 0x0b, 0x8a, 0xb6, 0xe4, 0x10, 0x2b, 0x3c, 0xc7, 0x8e,
-	0x64, 0x85, 0xe7, 0x84, 0x80, 0x31, 0xf1, 0x82, 0x84, 0xb6, 0x2c, 0xdd, 0xee, 0x32, 0x1c, 0x63,
-	0x8d, 0xe7, 0xb2, 0x23, 0x55, 0xe3, 0x39, 0xd6, 0x58, 0xf8, 0x5b, 0x42, 0xdb, 0x96, 0x6e, 0xeb,
-	0x0c, 0xc7, 0xeb, 0xd6, 0x3b, 0x58, 0x2c, 0x5a, 0xdf, 0x83, 0xd6, 0x84, 0xe7, 0x2f, 0x45, 0x40,
-	0x41, 0x1d, 0x9c, 0x42, 0x92, 0xfd, 0x9d, 0xcf, 0xdd, 0x84, 0x9a, 0x96, 0x6e, 0xb7, 0x99, 0x02,
-	0xf2, 0xa0, 0x5e, 0x9d, 0x8b, 0xd8, 0xe7, 0x11, 0xed, 0xe2, 0xae, 0x4a, 0x28, 0x67, 0x7e, 0x0c,
-	0xbc, 0x79, 0x18, 0x2f, 0xe8, 0x96, 0x9a, 0x29, 0xa0, 0x5c, 0x81, 0x89, 0x24, 0xf3, 0x53, 0xba,
-	0x6d, 0xe9, 0xb6, 0xc6, 0x0a, 0xd4, 0xff, 0xa7, 0x0d, 0x9d, 0xa9, 0x3c, 0x8f, 0x71, 0x30, 0x0f,
-	0xc9, 0x13, 0x68, 0x1d, 0xbf, 0x39, 0x9f, 0xf0, 0x88, 0x6a, 0x96, 0x6e, 0x9b, 0x23, 0xab, 0x7a,
-	0x56, 0x25, 0x6b, 0xa0, 0x28, 0xdf, 0x06, 0x69, 0xbc, 0x64, 0x05, 0x9f, 0x1c, 0x40, 0x67, 0xe2,
-	0x05, 0xaf, 0xb9, 0x2f, 0xc5, 0x4d, 0x14, 0x7f, 0x58, 0x2b, 0x5e, 0xb1, 0x94, 0x7e, 0xad, 0x42,
-	0x0b, 0x9e, 0x17, 0x16, 0xfa, 0x5d, 0x16, 0x25, 0xab, 0xb4, 0x28, 0x31, 0xf9, 0x1e, 0xcc, 0x23,
-	0x9e, 0x72, 0x99, 0x02, 0x69, 0x62, 0xa0, 0xc9, 0xc7, 0xb5, 0x26, 0x15, 0x9e, 0xb2, 0xa9, 0x2a,
-	0xc9, 0x11, 0x80, 0xfc, 0x67, 0x9e, 0x05, 0xa9, 0xf4, 0xd9, 0x40, 0x9f, 0x8f, 0xea, 0x0f, 0x63,
-	0x45, 0x53, 0x36, 0x15, 0x1d, 0xf9, 0x12, 0x36, 0xa7, 0xde, 0x05, 0x6e, 0xa5, 0x85, 0x16, 0x1f,
-	0xd4, 0x5a, 0x14, 0x1c, 0xa5, 0x2f, 0x15, 0x64, 0x0a, 0x3b, 0xd5, 0xcc, 0x4b, 0x93, 0x4d, 0x34,
-	0xf9, 0xa4, 0xde, 0xe4, 0x26, 0x57, 0x99, 0xdd, 0x76, 0x20, 0x16, 0x98, 0x87, 0x7e, 0x38, 0x3b,
-	0x3b, 0xce, 0x16, 0x8e, 0x88, 0xf1, 0x9e, 0xe9, 0xac, 0x5a, 0x22, 0x23, 0xd8, 0x3d, 0x98, 0xcd,
-	0xb2, 0x98, 0xa7, 0xe2, 0x95, 0xf3, 0xab, 0x98, 0xa5, 0x05, 0xb5, 0x83, 0xd4, 0xda, 0x39, 0x32,
-	0x00, 0x72, 0x10, 0x45, 0x71, 0x98, 0xdf, 0x50, 0xa8, 0x28, 0xd7, 0xcc, 0x90, 0x7d, 0x68, 0x9f,
-	0x70, 0xc7, 0x17, 0xcf, 0x82, 0x94, 0x9a, 0x98, 0xd3, 0x15, 0x96, 0x57, 0x1b, 0xc7, 0xc7, 0x7c,
-	0x21, 0x30, 0xde, 0x1d, 0xb6, 0x2e, 0xec, 0x7f, 0x01, 0x66, 0x25, 0x7d, 0xf2, 0x6e, 0x9e, 0x89,
-	0x25, 0xbe, 0x00, 0x1d, 0x26, 0x87, 0xf2, 0xc6, 0x9c, 0xe3, 0xc3, 0xd4, 0x54, 0x0f, 0x13, 0x82,
-	0xa7, 0xcd, 0x27, 0xda, 0xfe, 0x57, 0xb0, 0x7d, 0x33, 0x7b, 0xf7, 0x56, 0xdf, 0x88, 0xdd, 0xbd,
-	0xd4, 0xdf, 0xc0, 0x83, 0xdb, 0x79, 0xfb, 0x3f, 0xbd, 0x51, 0xd5, 0x7f, 0x0d, 0x3b, 0xb7, 0x72,
-	0x76, 0x2f, 0xf9, 0x53, 0xe8, 0x56, 0x33, 0x76, 0x2f, 0xed, 0xcf, 0xb0, 0x5b, 0x17, 0xad, 0x1a,
-	0x8f, 0xc7, 0x55, 0x0f, 0x73, 0xf4, 0xf0, 0xbf, 0xef, 0x2c, 0x3a, 0x54, 0xcc, 0xfb, 0xbf, 0x40,
-	0x77, 0x95, 0x60, 0xf9, 0x59, 0xe9, 0x01, 0xc8, 0x73, 0x72, 0x78, 0x22, 0xc6, 0x47, 0xe8, 0x6d,
-	0xb0, 0x4a, 0x45, 0xbe, 0x6f, 0x98, 0x85, 0xf1, 0x51, 0xb1, 0xd1, 0x12, 0xca, 0x06, 0x0e, 0x66,
-	0xb3, 0xf1, 0x1b, 0x7c, 0xe4, 0xb7, 0x98, 0x02, 0xfd, 0x43, 0xd8, 0xaa, 0xfa, 0x27, 0xe4, 0x33,
-	0x30, 0xe4, 0x6f, 0xf1, 0xbc, 0x3d, 0xac, 0xbb, 0x49, 0x2f, 0xc4, 0xf2, 0xd0, 0x78, 0xfb, 0xe7,
-	0xfb, 0x0d, 0x86, 0xd4, 0xc3, 0x1f, 0xde, 0x5e, 0xf5, 0xb4, 0x77, 0x57, 0x3d, 0xed, 0xaf, 0xab,
-	0x5e, 0xe3, 0xf7, 0xeb, 0x5e, 0xe3, 0x8f, 0xeb, 0x9e, 0xf6, 0xee, 0xba, 0xd7, 0xb8, 0xbc, 0xee,
-	0x35, 0x7e, 0x1a, 0x55, 0xbe, 0xd9, 0x0b, 0x9e, 0xc6, 0x5e, 0x1e, 0xc6, 0x9e, 0xeb, 0x05, 0x25,
-	0x08, 0xc4, 0x30, 0x3a, 0x73, 0x87, 0x91, 0x33, 0x5c, 0x2d, 0xe5, 0xb4, 0xf0, 0xfb, 0xfd, 0xf9,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x75, 0xe5, 0xbd, 0xde, 0x0c, 0x08, 0x00, 0x00,
+	// 974 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x35, 0x45, 0x4a, 0x96, 0xae, 0x64, 0x3b, 0xdf, 0xc0, 0x70, 0x06, 0xc6, 0x07, 0x95, 0x55,
+	0x7f, 0xa0, 0x1a, 0x8d, 0x85, 0xaa, 0x9b, 0x34, 0xfd, 0x01, 0xac, 0xb8, 0xa9, 0xd5, 0x44, 0x4a,
+	0x31, 0x72, 0xb2, 0x68, 0x81, 0x02, 0x23, 0x79, 0x24, 0xb3, 0xa6, 0x48, 0x96, 0xa4, 0x54, 0xca,
+	0x4f, 0xd1, 0x27, 0x28, 0xba, 0xeb, 0xab, 0x64, 0x99, 0x65, 0x56, 0x45, 0x61, 0x2f, 0xfa, 0x1a,
+	0xc5, 0xdc, 0xa1, 0xa8, 0x91, 0x43, 0xb8, 0x70, 0x57, 0x9a, 0x73, 0xe6, 0x9c, 0x33, 0x9c, 0xcb,
+	0x3b, 0x43, 0xc1, 0x4e, 0x14, 0xf3, 0x38, 0x72, 0xbc, 0xb1, 0x7f, 0x18, 0x84, 0x7e, 0xec, 0x93,
+	0x4a, 0x46, 0xec, 0x3f, 0x98, 0x38, 0xf1, 0xf9, 0x6c, 0x78, 0x38, 0xf2, 0xa7, 0xad, 0x89, 0x3f,
+	0xf1, 0x5b, 0xa8, 0x18, 0xce, 0xc6, 0x88, 0x10, 0xe0, 0x48, 0x39, 0x1b, 0x7f, 0x1b, 0x50, 0x1d,
+	0x9c, 0xcf, 0xc6, 0x63, 0x57, 0x9c, 0x08, 0x1e, 0x90, 0x03, 0xb0, 0x9e, 0x89, 0x71, 0x4c, 0x0d,
+	0xdb, 0x68, 0x56, 0xdb, 0x7b, 0x87, 0xab, 0x95, 0x34, 0x15, 0x43, 0x0d, 0xf9, 0x18, 0x8a, 0xcc,
+	0x99, 0x9c, 0xc7, 0xb4, 0x70, 0xab, 0x58, 0x89, 0xc8, 0x3d, 0x30, 0x9f, 0x8a, 0x05, 0x35, 0x6d,
+	0xa3, 0x69, 0x30, 0x39, 0x24, 0xbb, 0x50, 0x7c, 0xc9, 0xdd, 0x99, 0xa0, 0x16, 0x72, 0x0a, 0x90,
+	0x3d, 0x28, 0x9d, 0x08, 0x8c, 0x2d, 0xda, 0x46, 0xd3, 0x64, 0x29, 0x22, 0xdb, 0x50, 0x18, 0x5c,
+	0xd2, 0x12, 0x72, 0x85, 0xc1, 0xa5, 0x74, 0xf7, 0x67, 0xae, 0x1b, 0xd1, 0x4d, 0xa4, 0x14, 0x20,
+	0x14, 0x36, 0x99, 0x98, 0x8b, 0x30, 0x12, 0xb4, 0x6c, 0x1b, 0xcd, 0x32, 0x5b, 0xc2, 0xc6, 0x9b,
+	0x02, 0xd4, 0xd2, 0xc7, 0x62, 0xdc, 0x9b, 0x08, 0xf2, 0x7f, 0xa8, 0x74, 0xa3, 0x41, 0x1c, 0x9e,
+	0x2e, 0x02, 0x81, 0xfb, 0x2d, 0xb3, 0x15, 0x91, 0x2e, 0x57, 0xc8, 0x96, 0x3b, 0x00, 0xeb, 0x34,
+	0x14, 0x82, 0x9a, 0xb7, 0xee, 0x15, 0x35, 0x72, 0xab, 0x3d, 0xc7, 0x4b, 0xb7, 0x25, 0x87, 0xc8,
+	0xf0, 0x84, 0x16, 0x53, 0x86, 0x27, 0x84, 0x80, 0xd5, 0x73, 0xbc, 0x88, 0x96, 0x6c, 0xb3, 0x59,
+	0x63, 0x38, 0x46, 0x8e, 0x27, 0x72, 0x47, 0x8a, 0xe3, 0x09, 0x72, 0xcc, 0xff, 0x25, 0xa2, 0x65,
+	0xdb, 0x6c, 0x9a, 0x0c, 0xc7, 0xab, 0xad, 0x57, 0x90, 0x4c, 0xb7, 0xbe, 0x07, 0xa5, 0x1e, 0x4f,
+	0x9e, 0x09, 0x8f, 0x82, 0x2a, 0x9c, 0x42, 0x52, 0xfd, 0xc4, 0xe5, 0x93, 0x88, 0x56, 0x6d, 0xb3,
+	0x59, 0x66, 0x0a, 0xc8, 0x42, 0x3d, 0x9f, 0x8b, 0xd0, 0xe5, 0x01, 0xad, 0xe1, 0x53, 0x2d, 0xa1,
+	0x9c, 0x79, 0xe1, 0x39, 0x63, 0x3f, 0x9c, 0xd2, 0x2d, 0x35, 0x93, 0x42, 0xb9, 0x02, 0x13, 0xd1,
+	0xcc, 0x8d, 0xe9, 0xb6, 0x6d, 0x36, 0x0d, 0x96, 0xa2, 0xc6, 0x1f, 0x06, 0x54, 0x4e, 0x9c, 0x28,
+	0xf6, 0x27, 0x21, 0x9f, 0xca, 0xba, 0xf6, 0x46, 0x73, 0x7c, 0x99, 0x11, 0x35, 0x50, 0xb8, 0x22,
+	0xc8, 0x3e, 0x94, 0x7b, 0xa3, 0xf9, 0x93, 0x50, 0xfc, 0x1c, 0xd1, 0x02, 0x4e, 0x66, 0x58, 0xe6,
+	0x77, 0xfc, 0x99, 0x77, 0x16, 0x51, 0x53, 0xe5, 0x2b, 0x84, 0x3b, 0x40, 0x83, 0x85, 0xb4, 0x02,
+	0xb2, 0x32, 0xfd, 0xb3, 0x79, 0x44, 0x8b, 0x48, 0xe2, 0x98, 0xd4, 0x01, 0x06, 0x7c, 0x1a, 0xb8,
+	0x02, 0x6b, 0xa6, 0x9a, 0x45, 0x63, 0x1a, 0xbf, 0x01, 0x54, 0x06, 0xf2, 0xcd, 0x75, 0xbd, 0xb1,
+	0x4f, 0x1e, 0x42, 0xa9, 0x7f, 0x36, 0xef, 0xf1, 0x00, 0x1f, 0xb3, 0xda, 0xb6, 0xf5, 0xb7, 0xba,
+	0x54, 0x1d, 0x2a, 0xc9, 0xd7, 0x5e, 0x1c, 0x2e, 0x58, 0xaa, 0x27, 0x47, 0x50, 0xe9, 0x39, 0xde,
+	0x4b, 0xee, 0x4a, 0x73, 0x01, 0xcd, 0xef, 0xe5, 0x9a, 0x33, 0x95, 0xf2, 0xaf, 0x5c, 0x18, 0xc1,
+	0x93, 0x34, 0xc2, 0xbc, 0x2d, 0x82, 0x27, 0xeb, 0x11, 0x4b, 0x4c, 0xbe, 0x81, 0xea, 0x31, 0x8f,
+	0xb9, 0xec, 0x57, 0x19, 0x62, 0x61, 0xc8, 0x07, 0xb9, 0x21, 0x9a, 0x4e, 0xc5, 0xe8, 0x4e, 0x72,
+	0x0c, 0x20, 0x7b, 0xe8, 0xb1, 0x17, 0xcb, 0x9c, 0x22, 0xe6, 0xbc, 0x9f, 0x5f, 0x8c, 0x4c, 0xa6,
+	0x62, 0x34, 0x1f, 0xf9, 0x1c, 0x36, 0x07, 0xce, 0x25, 0x3e, 0x4a, 0x09, 0x23, 0xde, 0xcd, 0x8d,
+	0x48, 0x35, 0xca, 0xbf, 0x74, 0x90, 0x01, 0xec, 0xe8, 0xa7, 0x53, 0x86, 0x6c, 0x62, 0xc8, 0x47,
+	0xf9, 0x21, 0xeb, 0x5a, 0x15, 0x76, 0x33, 0x81, 0xd8, 0x50, 0xed, 0xb8, 0xfe, 0xe8, 0xa2, 0x3f,
+	0x9b, 0x0e, 0x45, 0x88, 0x37, 0x82, 0xc9, 0x74, 0x8a, 0xb4, 0x61, 0xf7, 0x68, 0x34, 0x9a, 0x85,
+	0x3c, 0x16, 0xcf, 0x87, 0x3f, 0x89, 0x51, 0x9c, 0x4a, 0x2b, 0x28, 0xcd, 0x9d, 0x23, 0x87, 0x40,
+	0x8e, 0x82, 0x20, 0xf4, 0x93, 0x35, 0x87, 0x3a, 0x74, 0x39, 0x33, 0xb2, 0xe5, 0x4f, 0xf9, 0xd0,
+	0x15, 0x8f, 0xbd, 0x98, 0x56, 0xf1, 0x44, 0x65, 0x58, 0x1e, 0x16, 0x1c, 0xf7, 0xf9, 0x54, 0xe0,
+	0x41, 0xac, 0xb0, 0x15, 0x41, 0xbe, 0x85, 0x5a, 0x76, 0xae, 0x64, 0x45, 0xb6, 0xb0, 0x22, 0x1f,
+	0xe6, 0x56, 0x44, 0x17, 0xaa, 0x72, 0xac, 0x79, 0xf7, 0x3f, 0x83, 0xaa, 0xd6, 0xc9, 0xf2, 0x46,
+	0xba, 0x10, 0x0b, 0xbc, 0xf7, 0x2a, 0x4c, 0x0e, 0xe5, 0x29, 0x9b, 0xe3, 0x75, 0x5c, 0x50, 0xd7,
+	0x31, 0x82, 0x47, 0x85, 0x87, 0xc6, 0xfe, 0x17, 0xb0, 0xbd, 0xde, 0xc7, 0x77, 0x76, 0xf3, 0xe4,
+	0xbf, 0xba, 0xbf, 0x82, 0x7b, 0x37, 0x7b, 0xf7, 0xdf, 0xfc, 0x96, 0xee, 0xff, 0x12, 0x76, 0x6e,
+	0xf4, 0xec, 0x9d, 0xec, 0x8f, 0xa0, 0xa6, 0xf7, 0xeb, 0x9d, 0xbc, 0x3f, 0xc0, 0x6e, 0x5e, 0x9b,
+	0xe6, 0x64, 0x3c, 0xd0, 0x33, 0xaa, 0xed, 0xfb, 0x6f, 0x7f, 0x5d, 0x30, 0x41, 0x0f, 0x7f, 0x01,
+	0xff, 0x7b, 0xeb, 0x8d, 0xe7, 0x24, 0x1f, 0xac, 0x27, 0xef, 0x6a, 0xc9, 0x99, 0x5d, 0x8b, 0x6d,
+	0xfc, 0x08, 0xb5, 0xac, 0xa5, 0xe4, 0x37, 0xba, 0x0e, 0x20, 0xcb, 0x3f, 0xe4, 0x91, 0xe8, 0x1e,
+	0x63, 0xb0, 0xc5, 0x34, 0x46, 0x7e, 0x2c, 0xb0, 0x5d, 0xbb, 0xc7, 0xe9, 0xfe, 0x97, 0x50, 0xd6,
+	0xe5, 0x68, 0x34, 0xea, 0x9e, 0xe1, 0x17, 0x73, 0x8b, 0x29, 0xd0, 0xe8, 0xc0, 0x96, 0x9e, 0x1f,
+	0x91, 0x4f, 0xc0, 0x92, 0xbf, 0xe9, 0x0d, 0x7c, 0x3f, 0xaf, 0xb5, 0x9f, 0x8a, 0x45, 0xc7, 0x7a,
+	0xf5, 0xe7, 0x3b, 0x1b, 0x0c, 0xa5, 0x9d, 0xef, 0x5e, 0x5d, 0xd5, 0x8d, 0xd7, 0x57, 0x75, 0xe3,
+	0xaf, 0xab, 0xfa, 0xc6, 0xaf, 0xd7, 0xf5, 0x8d, 0xdf, 0xaf, 0xeb, 0xc6, 0xeb, 0xeb, 0xfa, 0xc6,
+	0x9b, 0xeb, 0xfa, 0xc6, 0xf7, 0x6d, 0xed, 0x0f, 0xd0, 0x94, 0xc7, 0xa1, 0x93, 0xf8, 0xa1, 0x33,
+	0x71, 0xbc, 0x25, 0xf0, 0x44, 0x2b, 0xb8, 0x98, 0xb4, 0x82, 0x61, 0x2b, 0x5b, 0x6a, 0x58, 0xc2,
+	0x3f, 0x43, 0x9f, 0xfe, 0x33, 0x00, 0x0a, 0x05, 0x45, 0x95, 0x59, 0x09, 0x00, 0x00,
 }
 
 func (m *ShuffleHeap) Marshal() (dAtA []byte, err error) {
@@ -826,6 +934,84 @@ func (m *ShuffleRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Histogram) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Histogram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Histogram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SampleRows != 0 {
+		i = encodeVarintStatsinfo(dAtA, i, uint64(m.SampleRows))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Ndvs) > 0 {
+		for iNdEx := len(m.Ndvs) - 1; iNdEx >= 0; iNdEx-- {
+			f9 := math.Float64bits(float64(m.Ndvs[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f9))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.Ndvs)*8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Freqs) > 0 {
+		for iNdEx := len(m.Freqs) - 1; iNdEx >= 0; iNdEx-- {
+			f10 := math.Float64bits(float64(m.Freqs[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f10))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.Freqs)*8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bounds) > 0 {
+		for iNdEx := len(m.Bounds) - 1; iNdEx >= 0; iNdEx-- {
+			f11 := math.Float64bits(float64(m.Bounds[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f11))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.Bounds)*8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.McvFreqs) > 0 {
+		for iNdEx := len(m.McvFreqs) - 1; iNdEx >= 0; iNdEx-- {
+			f12 := math.Float64bits(float64(m.McvFreqs[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f12))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.McvFreqs)*8))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.McvValues) > 0 {
+		for iNdEx := len(m.McvValues) - 1; iNdEx >= 0; iNdEx-- {
+			f13 := math.Float64bits(float64(m.McvValues[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f13))
+		}
+		i = encodeVarintStatsinfo(dAtA, i, uint64(len(m.McvValues)*8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *StatsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -846,6 +1032,32 @@ func (m *StatsInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HistogramMap) > 0 {
+		for k := range m.HistogramMap {
+			v := m.HistogramMap[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintStatsinfo(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintStatsinfo(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintStatsinfo(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if len(m.TableName) > 0 {
 		i -= len(m.TableName)
 		copy(dAtA[i:], m.TableName)
@@ -1195,6 +1407,33 @@ func (m *ShuffleRange) ProtoSize() (n int) {
 	return n
 }
 
+func (m *Histogram) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.McvValues) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.McvValues)*8)) + len(m.McvValues)*8
+	}
+	if len(m.McvFreqs) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.McvFreqs)*8)) + len(m.McvFreqs)*8
+	}
+	if len(m.Bounds) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.Bounds)*8)) + len(m.Bounds)*8
+	}
+	if len(m.Freqs) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.Freqs)*8)) + len(m.Freqs)*8
+	}
+	if len(m.Ndvs) > 0 {
+		n += 1 + sovStatsinfo(uint64(len(m.Ndvs)*8)) + len(m.Ndvs)*8
+	}
+	if m.SampleRows != 0 {
+		n += 1 + sovStatsinfo(uint64(m.SampleRows))
+	}
+	return n
+}
+
 func (m *StatsInfo) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -1278,6 +1517,19 @@ func (m *StatsInfo) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStatsinfo(uint64(l))
 	}
+	if len(m.HistogramMap) > 0 {
+		for k, v := range m.HistogramMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.ProtoSize()
+				l += 1 + sovStatsinfo(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovStatsinfo(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovStatsinfo(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -2069,7 +2321,7 @@ func (m *ShuffleRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatsInfo) Unmarshal(dAtA []byte) error {
+func (m *Histogram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2092,49 +2344,24 @@ func (m *StatsInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatsInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NdvMap", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStatsinfo
-				}
-				if iNdEx >= l {
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStatsinfo
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStatsinfo
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NdvMap == nil {
-				m.NdvMap = make(map[string]float64)
-			}
-			var mapkey string
-			var mapvalue float64
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.McvValues = append(m.McvValues, v2)
+			} else if wireType == 2 {
+				var packedLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowStatsinfo
@@ -2144,55 +2371,419 @@ func (m *StatsInfo) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					packedLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStatsinfo
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthStatsinfo
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthStatsinfo
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp uint64
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.McvValues) == 0 {
+					m.McvValues = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
 					if (iNdEx + 8) > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 					iNdEx += 8
-					mapvalue = math.Float64frombits(mapvaluetemp)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipStatsinfo(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
+					v2 := float64(math.Float64frombits(v))
+					m.McvValues = append(m.McvValues, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field McvValues", wireType)
+			}
+		case 2:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.McvFreqs = append(m.McvFreqs, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.McvFreqs) == 0 {
+					m.McvFreqs = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.McvFreqs = append(m.McvFreqs, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field McvFreqs", wireType)
+			}
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Bounds = append(m.Bounds, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Bounds) == 0 {
+					m.Bounds = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Bounds = append(m.Bounds, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Freqs = append(m.Freqs, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Freqs) == 0 {
+					m.Freqs = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Freqs = append(m.Freqs, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freqs", wireType)
+			}
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Ndvs = append(m.Ndvs, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStatsinfo
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Ndvs) == 0 {
+					m.Ndvs = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Ndvs = append(m.Ndvs, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ndvs", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleRows", wireType)
+			}
+			m.SampleRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatsinfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStatsinfo(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatsInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStatsinfo
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatsInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatsInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NdvMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatsinfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NdvMap == nil {
+				m.NdvMap = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatsinfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipStatsinfo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
 					if (skippy < 0) || (iNdEx+skippy) < 0 {
 						return ErrInvalidLengthStatsinfo
 					}
@@ -2984,6 +3575,135 @@ func (m *StatsInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistogramMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatsinfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStatsinfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HistogramMap == nil {
+				m.HistogramMap = make(map[string]*Histogram)
+			}
+			var mapkey string
+			var mapvalue *Histogram
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStatsinfo
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatsinfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStatsinfo
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Histogram{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipStatsinfo(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthStatsinfo
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.HistogramMap[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatsinfo(dAtA[iNdEx:])
@@ -145,7 +145,13 @@ type Session struct {
 	// FromProxy denotes whether the session is dispatched from proxy
 	FromProxy bool `protobuf:"varint,19,opt,name=FromProxy,proto3" json:"FromProxy,omitempty"`
 	// ProxyHost is the host address of proxy connection.
-	ProxyHost            string   `protobuf:"bytes,20,opt,name=ProxyHost,proto3" json:"ProxyHost,omitempty"`
+	ProxyHost string `protobuf:"bytes,20,opt,name=ProxyHost,proto3" json:"ProxyHost,omitempty"`
+	// MemoryUsed is the bytes held by the running query in the session mpool.
+	MemoryUsed int64 `protobuf:"varint,21,opt,name=MemoryUsed,proto3" json:"MemoryUsed,omitempty"`
+	// MemoryPeak is the max bytes held by the running query.
+	MemoryPeak int64 `protobuf:"varint,22,opt,name=MemoryPeak,proto3" json:"MemoryPeak,omitempty"`
+	// MemoryQuota is the memory limit of the running query, 0 means no limit.
+	MemoryQuota          int64    `protobuf:"varint,23,opt,name=MemoryQuota,proto3" json:"MemoryQuota,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -324,6 +330,27 @@ func (m *Session) GetProxyHost() string {
 	return ""
 }
 
+func (m *Session) GetMemoryUsed() int64 {
+	if m != nil {
+		return m.MemoryUsed
+	}
+	return 0
+}
+
+func (m *Session) GetMemoryPeak() int64 {
+	if m != nil {
+		return m.MemoryPeak
+	}
+	return 0
+}
+
+func (m *Session) GetMemoryQuota() int64 {
+	if m != nil {
+		return m.MemoryQuota
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("status.SessionField", SessionField_name, SessionField_value)
 	proto.RegisterType((*Session)(nil), "status.Session")
@@ -332,47 +359,49 @@ func init() {
 func init() { proto.RegisterFile("status.proto", fileDescriptor_dfe4fce6682daf5b) }
 
 var fileDescriptor_dfe4fce6682daf5b = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4d, 0x6e, 0xda, 0x40,
-	0x14, 0xc7, 0x63, 0x42, 0x0c, 0x3c, 0xbe, 0x9c, 0x97, 0xa8, 0x1a, 0x45, 0x15, 0x41, 0x55, 0x17,
-	0xa8, 0x52, 0x41, 0x6a, 0x4f, 0x00, 0xb6, 0xa3, 0x58, 0x22, 0x76, 0xb0, 0x8d, 0x94, 0x74, 0x13,
-	0x01, 0x99, 0xb8, 0x56, 0xb1, 0x07, 0xd9, 0x83, 0x94, 0xdc, 0xa2, 0x27, 0xa8, 0x7a, 0x9c, 0x2c,
-	0x7b, 0x82, 0xb6, 0xa2, 0xa7, 0xe8, 0xae, 0x9a, 0x19, 0xbe, 0xb2, 0xec, 0xee, 0xfd, 0x7f, 0xef,
-	0xc3, 0xff, 0xf7, 0x26, 0x01, 0x6a, 0x39, 0x9f, 0xf0, 0x65, 0xde, 0x5d, 0x64, 0x8c, 0x33, 0xd4,
-	0x95, 0x3a, 0x7b, 0x1f, 0xc5, 0xfc, 0xf3, 0x72, 0xda, 0x9d, 0xb1, 0xa4, 0x17, 0xb1, 0x88, 0xf5,
-	0x64, 0x7a, 0xba, 0x7c, 0x90, 0x4a, 0x0a, 0x19, 0xa9, 0xb6, 0xb3, 0xf3, 0x88, 0xb1, 0x68, 0x4e,
-	0x77, 0x55, 0x3c, 0x4e, 0x68, 0xce, 0x27, 0xc9, 0x42, 0x15, 0xbc, 0xf9, 0x5b, 0x84, 0x52, 0x40,
-	0xf3, 0x3c, 0x66, 0x29, 0xbe, 0x02, 0xdd, 0x65, 0xf7, 0xd4, 0xb1, 0x88, 0xd6, 0xd6, 0x3a, 0x15,
-	0x7f, 0xad, 0x04, 0x37, 0x59, 0x9a, 0x3a, 0x16, 0x29, 0xb4, 0xb5, 0x4e, 0xdd, 0x5f, 0x2b, 0x7c,
-	0x0d, 0x95, 0x75, 0xab, 0x63, 0x91, 0x43, 0xd9, 0xb2, 0x03, 0x48, 0xa0, 0xd4, 0x9f, 0xcd, 0xd8,
-	0x32, 0xe5, 0xa4, 0x28, 0x73, 0x1b, 0x89, 0x08, 0xc5, 0x71, 0x4e, 0x33, 0x72, 0x24, 0xb1, 0x8c,
-	0x05, 0xbb, 0x64, 0x39, 0x27, 0xba, 0x62, 0x22, 0xc6, 0x06, 0x14, 0xac, 0x01, 0x29, 0x49, 0x52,
-	0xb0, 0x06, 0x78, 0x09, 0xb5, 0xf5, 0xf8, 0x80, 0x4f, 0x32, 0x4e, 0xca, 0x6d, 0xad, 0x53, 0xfd,
-	0x70, 0xd6, 0x55, 0x3b, 0x76, 0x37, 0x3b, 0x76, 0xc3, 0xcd, 0x8e, 0x83, 0xf2, 0xf3, 0xcf, 0xf3,
-	0x83, 0xaf, 0xbf, 0xce, 0x35, 0xff, 0x45, 0xa7, 0xf0, 0x66, 0xb2, 0x24, 0x99, 0xa4, 0xf7, 0xa4,
-	0xa2, 0xbc, 0xad, 0xa5, 0xf0, 0xe1, 0xa4, 0x0f, 0x8c, 0x80, 0xf2, 0x21, 0x62, 0x3c, 0x85, 0xa3,
-	0xf0, 0x51, 0xec, 0x58, 0x95, 0x50, 0x09, 0x6c, 0x43, 0x35, 0xe0, 0x13, 0x4e, 0x13, 0x9a, 0x72,
-	0xc7, 0x22, 0x35, 0x99, 0xdb, 0x47, 0xf8, 0x16, 0xea, 0x5b, 0x19, 0x3e, 0x2d, 0x28, 0xa9, 0xcb,
-	0x9a, 0x97, 0x50, 0x5c, 0x71, 0xb4, 0xa4, 0xd9, 0x93, 0xac, 0x68, 0xa8, 0x2b, 0x6e, 0x81, 0x9c,
-	0x31, 0x1a, 0x06, 0x6c, 0x99, 0xcd, 0xa8, 0xac, 0x68, 0xae, 0x67, 0xec, 0x43, 0xb4, 0x00, 0x64,
-	0x8b, 0xba, 0x8b, 0xf1, 0x1f, 0x77, 0xd9, 0xeb, 0xc3, 0x16, 0x80, 0x39, 0x8f, 0x69, 0xca, 0xe5,
-	0x4b, 0x1c, 0xcb, 0x0f, 0xed, 0x11, 0x71, 0x1b, 0x9f, 0xcd, 0x29, 0x41, 0x75, 0x1b, 0x11, 0x0b,
-	0xf7, 0x17, 0x19, 0x4b, 0xae, 0x33, 0xf6, 0xf8, 0x44, 0x4e, 0xda, 0x5a, 0xa7, 0xec, 0xef, 0x80,
-	0xc8, 0xca, 0x40, 0x0e, 0x3c, 0x55, 0xbb, 0x6d, 0xc1, 0xbb, 0x6f, 0x85, 0xed, 0x83, 0x5e, 0xc4,
-	0x74, 0x7e, 0x8f, 0x55, 0x28, 0xb9, 0x9e, 0x65, 0xdf, 0x39, 0x96, 0x71, 0x20, 0x84, 0xe9, 0xb9,
-	0xae, 0x10, 0x1a, 0x36, 0x00, 0x02, 0x3b, 0x08, 0x1c, 0x4f, 0xea, 0x82, 0x48, 0xf6, 0x4d, 0xd3,
-	0x1b, 0xbb, 0xa1, 0x71, 0x88, 0x65, 0x28, 0x8e, 0x03, 0xdb, 0x37, 0x8a, 0x22, 0xba, 0xf4, 0x82,
-	0xd0, 0x38, 0x42, 0x5d, 0xfc, 0xed, 0x18, 0x3a, 0x1e, 0x43, 0x7d, 0xd3, 0x18, 0x84, 0x7d, 0x3f,
-	0x34, 0x4a, 0x6a, 0xf0, 0xd5, 0x55, 0xdf, 0xb5, 0x8c, 0xb2, 0xe8, 0x70, 0xdc, 0x0b, 0xcf, 0xa8,
-	0x20, 0x80, 0x1e, 0xde, 0xc8, 0xf1, 0x80, 0x06, 0xd4, 0x82, 0xb0, 0x1f, 0xda, 0x57, 0xb6, 0x1b,
-	0x0a, 0x52, 0x45, 0x84, 0xc6, 0x8e, 0x84, 0xb7, 0xd7, 0xb6, 0x51, 0x13, 0xa6, 0x46, 0x63, 0xdb,
-	0xbf, 0x55, 0xba, 0x8e, 0x27, 0xd0, 0x0c, 0x46, 0xc3, 0xbb, 0xc0, 0x1b, 0xfb, 0xa6, 0xad, 0x60,
-	0x03, 0x9b, 0x50, 0x55, 0x45, 0xea, 0xf3, 0x4d, 0x01, 0xcc, 0xa1, 0x23, 0xc6, 0x48, 0xab, 0x86,
-	0xb0, 0xe0, 0x7b, 0x43, 0xdb, 0x38, 0x16, 0x03, 0xaf, 0x7d, 0xef, 0xe6, 0x56, 0x65, 0x70, 0xd0,
-	0x7f, 0x5e, 0xb5, 0xb4, 0x1f, 0xab, 0x96, 0xf6, 0x7b, 0xd5, 0x3a, 0xf8, 0xfe, 0xa7, 0xa5, 0x7d,
-	0xea, 0xed, 0xfd, 0xfb, 0x27, 0x13, 0x9e, 0xc5, 0x8f, 0x2c, 0x8b, 0xa3, 0x38, 0xdd, 0x88, 0x94,
-	0xf6, 0x16, 0x5f, 0xa2, 0xde, 0x62, 0xda, 0x53, 0xbf, 0x17, 0x53, 0x5d, 0xbe, 0xfe, 0xc7, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xea, 0x8c, 0x6e, 0xcd, 0x4e, 0x04, 0x00, 0x00,
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdd, 0x6a, 0xdb, 0x30,
+	0x14, 0xc7, 0xeb, 0x34, 0x9f, 0x27, 0x5f, 0xae, 0xda, 0x75, 0xa2, 0x8c, 0x34, 0x8c, 0x5d, 0x84,
+	0xc1, 0x12, 0xd8, 0x9e, 0x20, 0xb1, 0x5d, 0x6a, 0x48, 0xec, 0xc4, 0x76, 0xa0, 0xdd, 0x4d, 0x71,
+	0x12, 0x35, 0x33, 0x8d, 0xad, 0x60, 0xcb, 0xd0, 0xbc, 0xc5, 0x9e, 0x60, 0xec, 0x71, 0x7a, 0xb9,
+	0xfb, 0xc1, 0x36, 0xba, 0x17, 0x19, 0x92, 0xf2, 0xe1, 0x5e, 0xee, 0xee, 0xfc, 0x7f, 0xe7, 0x43,
+	0xe7, 0x1c, 0x09, 0x41, 0x2d, 0x61, 0x3e, 0x4b, 0x93, 0xee, 0x3a, 0xa6, 0x8c, 0xa2, 0xa2, 0x54,
+	0x17, 0x1f, 0x96, 0x01, 0xfb, 0x92, 0xce, 0xba, 0x73, 0x1a, 0xf6, 0x96, 0x74, 0x49, 0x7b, 0xc2,
+	0x3d, 0x4b, 0xef, 0x85, 0x12, 0x42, 0x58, 0x32, 0xed, 0xe2, 0x72, 0x49, 0xe9, 0x72, 0x45, 0x0e,
+	0x51, 0x2c, 0x08, 0x49, 0xc2, 0xfc, 0x70, 0x2d, 0x03, 0xde, 0xfe, 0x2c, 0x40, 0xc9, 0x25, 0x49,
+	0x12, 0xd0, 0x08, 0x9d, 0x43, 0xd1, 0xa2, 0x0b, 0x62, 0xea, 0x58, 0x69, 0x2b, 0x9d, 0x8a, 0xb3,
+	0x55, 0x9c, 0x6b, 0x34, 0x8a, 0x4c, 0x1d, 0xe7, 0xda, 0x4a, 0xa7, 0xee, 0x6c, 0x15, 0x7a, 0x03,
+	0x95, 0x6d, 0xaa, 0xa9, 0xe3, 0x63, 0x91, 0x72, 0x00, 0x08, 0x43, 0xa9, 0x3f, 0x9f, 0xd3, 0x34,
+	0x62, 0x38, 0x2f, 0x7c, 0x3b, 0x89, 0x10, 0xe4, 0xa7, 0x09, 0x89, 0x71, 0x41, 0x60, 0x61, 0x73,
+	0x76, 0x4d, 0x13, 0x86, 0x8b, 0x92, 0x71, 0x1b, 0x35, 0x20, 0xa7, 0x0f, 0x70, 0x49, 0x90, 0x9c,
+	0x3e, 0x40, 0xd7, 0x50, 0xdb, 0x96, 0x77, 0x99, 0x1f, 0x33, 0x5c, 0x6e, 0x2b, 0x9d, 0xea, 0xc7,
+	0x8b, 0xae, 0x9c, 0xb1, 0xbb, 0x9b, 0xb1, 0xeb, 0xed, 0x66, 0x1c, 0x94, 0x9f, 0x7e, 0x5d, 0x1e,
+	0x7d, 0xfd, 0x7d, 0xa9, 0x38, 0x2f, 0x32, 0x79, 0x6f, 0x1a, 0x0d, 0x43, 0x3f, 0x5a, 0xe0, 0x8a,
+	0xec, 0x6d, 0x2b, 0x79, 0x1f, 0x66, 0x74, 0x4f, 0x31, 0xc8, 0x3e, 0xb8, 0x8d, 0xce, 0xa0, 0xe0,
+	0x3d, 0xf2, 0x19, 0xab, 0x02, 0x4a, 0x81, 0xda, 0x50, 0x75, 0x99, 0xcf, 0x48, 0x48, 0x22, 0x66,
+	0xea, 0xb8, 0x26, 0x7c, 0x59, 0x84, 0xde, 0x41, 0x7d, 0x2f, 0xbd, 0xcd, 0x9a, 0xe0, 0xba, 0x88,
+	0x79, 0x09, 0xf9, 0x16, 0x27, 0x29, 0x89, 0x37, 0x22, 0xa2, 0x21, 0xb7, 0xb8, 0x07, 0xa2, 0xc6,
+	0x64, 0xe8, 0xd2, 0x34, 0x9e, 0x13, 0x11, 0xd1, 0xdc, 0xd6, 0xc8, 0x42, 0xa4, 0x03, 0x88, 0x14,
+	0xb9, 0x17, 0xf5, 0x3f, 0xf6, 0x92, 0xc9, 0x43, 0x2d, 0x00, 0x6d, 0x15, 0x90, 0x88, 0x89, 0x9b,
+	0x38, 0x11, 0x07, 0x65, 0x08, 0xdf, 0x8d, 0x43, 0x57, 0x04, 0x23, 0xb9, 0x1b, 0x6e, 0xf3, 0xee,
+	0xaf, 0x62, 0x1a, 0x8e, 0x63, 0xfa, 0xb8, 0xc1, 0xa7, 0x6d, 0xa5, 0x53, 0x76, 0x0e, 0x80, 0x7b,
+	0x85, 0x21, 0x0a, 0x9e, 0xc9, 0xd9, 0xf6, 0x80, 0x9f, 0x37, 0x22, 0x21, 0x8d, 0x37, 0xd3, 0x84,
+	0x2c, 0xf0, 0xab, 0xb6, 0xd2, 0x39, 0x76, 0x32, 0xe4, 0xe0, 0x1f, 0x13, 0xff, 0x01, 0x9f, 0x67,
+	0xfd, 0x9c, 0xf0, 0x1b, 0x90, 0x6a, 0x92, 0x52, 0xe6, 0xe3, 0xd7, 0x22, 0x20, 0x8b, 0xde, 0x7f,
+	0xcb, 0xed, 0x9f, 0xcc, 0x55, 0x40, 0x56, 0x0b, 0x54, 0x85, 0x92, 0x65, 0xeb, 0xc6, 0x9d, 0xa9,
+	0xab, 0x47, 0x5c, 0x68, 0xb6, 0x65, 0x71, 0xa1, 0xa0, 0x06, 0x80, 0x6b, 0xb8, 0xae, 0x69, 0x0b,
+	0x9d, 0xe3, 0xce, 0xbe, 0xa6, 0xd9, 0x53, 0xcb, 0x53, 0x8f, 0x51, 0x19, 0xf2, 0x53, 0xd7, 0x70,
+	0xd4, 0x3c, 0xb7, 0xae, 0x6d, 0xd7, 0x53, 0x0b, 0xa8, 0xc8, 0x5f, 0xa7, 0x5a, 0x44, 0x27, 0x50,
+	0xdf, 0x25, 0xba, 0x5e, 0xdf, 0xf1, 0xd4, 0x92, 0x2c, 0x3c, 0x1a, 0xf5, 0x2d, 0x5d, 0x2d, 0xf3,
+	0x0c, 0xd3, 0xba, 0xb2, 0xd5, 0x0a, 0x02, 0x28, 0x7a, 0x37, 0xa2, 0x3c, 0x20, 0x15, 0x6a, 0xae,
+	0xd7, 0xf7, 0x8c, 0x91, 0x61, 0x79, 0x9c, 0x54, 0x11, 0x82, 0xc6, 0x81, 0x78, 0xb7, 0x63, 0x43,
+	0xad, 0xf1, 0xa6, 0x26, 0x53, 0xc3, 0xb9, 0x95, 0xba, 0x8e, 0x4e, 0xa1, 0xe9, 0x4e, 0x86, 0x77,
+	0xae, 0x3d, 0x75, 0x34, 0x43, 0xc2, 0x06, 0x6a, 0x42, 0x55, 0x06, 0xc9, 0xe3, 0x9b, 0x1c, 0x68,
+	0x43, 0x93, 0x97, 0x11, 0xad, 0xaa, 0xbc, 0x05, 0xc7, 0x1e, 0x1a, 0xea, 0x09, 0x2f, 0x38, 0x76,
+	0xec, 0x9b, 0x5b, 0xe9, 0x41, 0x83, 0xfe, 0xd3, 0x73, 0x4b, 0xf9, 0xf1, 0xdc, 0x52, 0xfe, 0x3c,
+	0xb7, 0x8e, 0xbe, 0xff, 0x6d, 0x29, 0x9f, 0x7b, 0x99, 0x0f, 0x26, 0xf4, 0x59, 0x1c, 0x3c, 0xd2,
+	0x38, 0x58, 0x06, 0xd1, 0x4e, 0x44, 0xa4, 0xb7, 0x7e, 0x58, 0xf6, 0xd6, 0xb3, 0x9e, 0xfc, 0x91,
+	0x66, 0x45, 0xf1, 0xbe, 0x3e, 0xfd, 0x1b, 0x00, 0x39, 0x23, 0x06, 0xc4, 0xb0, 0x04, 0x00, 0x00,
 }
 
 func (m *Session) Marshal() (dAtA []byte, err error) {
@@ -399,6 +428,27 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MemoryQuota != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.MemoryQuota))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.MemoryPeak != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.MemoryPeak))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.MemoryUsed != 0 {
+		i = encodeVarintStatus(dAtA, i, uint64(m.MemoryUsed))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.ProxyHost) > 0 {
 		i -= len(m.ProxyHost)
 		copy(dAtA[i:], m.ProxyHost)
@@ -646,6 +696,15 @@ func (m *Session) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovStatus(uint64(l))
 	}
+	if m.MemoryUsed != 0 {
+		n += 2 + sovStatus(uint64(m.MemoryUsed))
+	}
+	if m.MemoryPeak != 0 {
+		n += 2 + sovStatus(uint64(m.MemoryPeak))
+	}
+	if m.MemoryQuota != 0 {
+		n += 2 + sovStatus(uint64(m.MemoryQuota))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1304,6 +1363,63 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			}
 			m.ProxyHost = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryUsed", wireType)
+			}
+			m.MemoryUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryUsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryPeak", wireType)
+			}
+			m.MemoryPeak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryPeak |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryQuota", wireType)
+			}
+			m.MemoryQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryQuota |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatus(dAtA[iNdEx:])
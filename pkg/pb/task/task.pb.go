@@ -104,6 +104,8 @@ const (
 	TaskCode_ConnectorKafkaSink TaskCode = 4
 	// MergeObject is for the merge object task.
 	TaskCode_MergeObject TaskCode = 5
+	// InitCdc is for the cdc task.
+	TaskCode_InitCdc TaskCode = 6
 )
 
 var TaskCode_name = map[int32]string{
@@ -113,6 +115,7 @@ var TaskCode_name = map[int32]string{
 	3: "MetricStorageUsage",
 	4: "ConnectorKafkaSink",
 	5: "MergeObject",
+	6: "InitCdc",
 }
 
 var TaskCode_value = map[string]int32{
@@ -122,6 +125,7 @@ var TaskCode_value = map[string]int32{
 	"MetricStorageUsage": 3,
 	"ConnectorKafkaSink": 4,
 	"MergeObject":        5,
+	"InitCdc":            6,
 }
 
 func (x TaskCode) String() string {
@@ -165,16 +169,19 @@ type TaskType int32
 const (
 	TaskType_TypeUnknown            TaskType = 0
 	TaskType_TypeKafkaSinkConnector TaskType = 1
+	TaskType_CreateCdc              TaskType = 2
 )
 
 var TaskType_name = map[int32]string{
 	0: "Unknown",
 	1: "KafkaSinkConnector",
+	2: "CreateCdc",
 }
 
 var TaskType_value = map[string]int32{
 	"Unknown":            0,
 	"KafkaSinkConnector": 1,
+	"CreateCdc":          2,
 }
 
 func (x TaskType) String() string {
@@ -759,6 +766,109 @@ func (m *ConnectorDetails) GetOptions() map[string]string {
 	return nil
 }
 
+// CreateCdcDetails is the definition of a cdc task.
+type CreateCdcDetails struct {
+	// TaskId is the uuid of the cdc task.
+	TaskId string `protobuf:"bytes,1,opt,name=TaskId,proto3" json:"TaskId,omitempty"`
+	// TaskName is the name of the cdc task, unique in the account.
+	TaskName string `protobuf:"bytes,2,opt,name=TaskName,proto3" json:"TaskName,omitempty"`
+	// SourceUri is the uri of the source cluster.
+	SourceUri string `protobuf:"bytes,3,opt,name=SourceUri,proto3" json:"SourceUri,omitempty"`
+	// SinkType is the type of the sink, mysql, matrixone or file.
+	SinkType string `protobuf:"bytes,4,opt,name=SinkType,proto3" json:"SinkType,omitempty"`
+	// SinkUri is the uri of the sink.
+	SinkUri string `protobuf:"bytes,5,opt,name=SinkUri,proto3" json:"SinkUri,omitempty"`
+	// Tables is the table mapping of the task.
+	Tables string `protobuf:"bytes,6,opt,name=Tables,proto3" json:"Tables,omitempty"`
+	// Options are the extra options of the task.
+	Options              map[string]string `protobuf:"bytes,7,rep,name=Options,proto3" json:"Options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateCdcDetails) Reset()         { *m = CreateCdcDetails{} }
+func (m *CreateCdcDetails) String() string { return proto.CompactTextString(m) }
+func (*CreateCdcDetails) ProtoMessage()    {}
+func (*CreateCdcDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{7}
+}
+func (m *CreateCdcDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateCdcDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateCdcDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateCdcDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCdcDetails.Merge(m, src)
+}
+func (m *CreateCdcDetails) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CreateCdcDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCdcDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCdcDetails proto.InternalMessageInfo
+
+func (m *CreateCdcDetails) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *CreateCdcDetails) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
+	}
+	return ""
+}
+
+func (m *CreateCdcDetails) GetSourceUri() string {
+	if m != nil {
+		return m.SourceUri
+	}
+	return ""
+}
+
+func (m *CreateCdcDetails) GetSinkType() string {
+	if m != nil {
+		return m.SinkType
+	}
+	return ""
+}
+
+func (m *CreateCdcDetails) GetSinkUri() string {
+	if m != nil {
+		return m.SinkUri
+	}
+	return ""
+}
+
+func (m *CreateCdcDetails) GetTables() string {
+	if m != nil {
+		return m.Tables
+	}
+	return ""
+}
+
+func (m *CreateCdcDetails) GetOptions() map[string]string {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
 type Details struct {
 	Description string `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
 	AccountID   uint32 `protobuf:"varint,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
@@ -766,8 +876,8 @@ type Details struct {
 	Username    string `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
 	Error       string `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`
 	// Types that are valid to be assigned to Details:
-	//
 	//	*Details_Connector
+	//	*Details_CreateCdc
 	Details              isDetails_Details `protobuf_oneof:"Details"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -778,7 +888,7 @@ func (m *Details) Reset()         { *m = Details{} }
 func (m *Details) String() string { return proto.CompactTextString(m) }
 func (*Details) ProtoMessage()    {}
 func (*Details) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{7}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{8}
 }
 func (m *Details) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,8 +926,12 @@ type isDetails_Details interface {
 type Details_Connector struct {
 	Connector *ConnectorDetails `protobuf:"bytes,10,opt,name=Connector,proto3,oneof" json:"Connector,omitempty"`
 }
+type Details_CreateCdc struct {
+	CreateCdc *CreateCdcDetails `protobuf:"bytes,11,opt,name=CreateCdc,proto3,oneof" json:"CreateCdc,omitempty"`
+}
 
 func (*Details_Connector) isDetails_Details() {}
+func (*Details_CreateCdc) isDetails_Details() {}
 
 func (m *Details) GetDetails() isDetails_Details {
 	if m != nil {
@@ -868,10 +982,18 @@ func (m *Details) GetConnector() *ConnectorDetails {
 	return nil
 }
 
+func (m *Details) GetCreateCdc() *CreateCdcDetails {
+	if x, ok := m.GetDetails().(*Details_CreateCdc); ok {
+		return x.CreateCdc
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Details) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Details_Connector)(nil),
+		(*Details_CreateCdc)(nil),
 	}
 }
 
@@ -898,7 +1020,7 @@ func (m *DaemonTask) Reset()         { *m = DaemonTask{} }
 func (m *DaemonTask) String() string { return proto.CompactTextString(m) }
 func (*DaemonTask) ProtoMessage()    {}
 func (*DaemonTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{8}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{9}
 }
 func (m *DaemonTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,6 +1154,8 @@ func init() {
 	proto.RegisterType((*CronTask)(nil), "task.CronTask")
 	proto.RegisterType((*ConnectorDetails)(nil), "task.ConnectorDetails")
 	proto.RegisterMapType((map[string]string)(nil), "task.ConnectorDetails.OptionsEntry")
+	proto.RegisterType((*CreateCdcDetails)(nil), "task.CreateCdcDetails")
+	proto.RegisterMapType((map[string]string)(nil), "task.CreateCdcDetails.OptionsEntry")
 	proto.RegisterType((*Details)(nil), "task.Details")
 	proto.RegisterType((*DaemonTask)(nil), "task.DaemonTask")
 }
@@ -1039,83 +1163,90 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 1215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x8f, 0x1b, 0x45,
-	0x10, 0xf6, 0xf8, 0xed, 0xf2, 0x23, 0x43, 0x07, 0xad, 0x46, 0x56, 0xd8, 0x58, 0x26, 0x88, 0xd5,
-	0x4a, 0x78, 0xc1, 0x04, 0x44, 0x22, 0x81, 0xb2, 0x6b, 0x2f, 0xca, 0x92, 0x6c, 0x12, 0xf5, 0x7a,
-	0x2f, 0x88, 0x4b, 0x7b, 0x5c, 0x99, 0x0c, 0xb6, 0x7b, 0x4c, 0x4f, 0x4f, 0x58, 0x1f, 0xf8, 0x03,
-	0x39, 0x71, 0x83, 0x4b, 0x24, 0xee, 0xfc, 0x08, 0xae, 0x39, 0xe6, 0xc8, 0x89, 0x47, 0xe0, 0x27,
-	0x70, 0x45, 0x42, 0xdd, 0x3d, 0x2f, 0x6f, 0x00, 0x69, 0xa5, 0xdc, 0x5c, 0x5f, 0x3d, 0xa6, 0xea,
-	0xab, 0x47, 0x1b, 0x40, 0xb2, 0x70, 0x3e, 0x58, 0x89, 0x40, 0x06, 0xa4, 0xac, 0x7e, 0x77, 0xdf,
-	0xf1, 0x7c, 0xf9, 0x28, 0x9a, 0x0e, 0xdc, 0x60, 0xb9, 0xe7, 0x05, 0x5e, 0xb0, 0xa7, 0x95, 0xd3,
-	0xe8, 0xa1, 0x96, 0xb4, 0xa0, 0x7f, 0x19, 0xa7, 0xee, 0x55, 0x2f, 0x08, 0xbc, 0x05, 0x66, 0x56,
-	0xd2, 0x5f, 0x62, 0x28, 0xd9, 0x72, 0x15, 0x1b, 0x74, 0x96, 0x28, 0xd9, 0x8c, 0x49, 0x66, 0xe4,
-	0xfe, 0x77, 0x16, 0xb4, 0x26, 0x2c, 0x9c, 0x1f, 0xc7, 0x30, 0xe9, 0x40, 0xf1, 0x68, 0xec, 0x58,
-	0x3d, 0x6b, 0xa7, 0x41, 0x8b, 0x47, 0x63, 0xb2, 0x0b, 0xf5, 0xc3, 0x33, 0x74, 0x23, 0x19, 0x08,
-	0xa7, 0xd8, 0xb3, 0x76, 0x3a, 0xc3, 0xce, 0x40, 0x67, 0xa9, 0xbc, 0x46, 0xc1, 0x0c, 0x69, 0xaa,
-	0x27, 0x0e, 0xd4, 0x46, 0x01, 0x97, 0x78, 0x26, 0x9d, 0x52, 0xcf, 0xda, 0x69, 0xd1, 0x44, 0x24,
-	0xef, 0x41, 0xed, 0xfe, 0x4a, 0xfa, 0x01, 0x0f, 0x9d, 0x72, 0xcf, 0xda, 0x69, 0x0e, 0x5f, 0xcb,
-	0x82, 0xc4, 0x8a, 0x83, 0xf2, 0xb3, 0x5f, 0xae, 0x16, 0x68, 0x62, 0xd7, 0xff, 0xa9, 0x08, 0xcd,
-	0x9c, 0x9a, 0x5c, 0x83, 0xf6, 0x31, 0x3b, 0xa3, 0x28, 0xc5, 0x7a, 0xa2, 0x8a, 0xd2, 0x39, 0xb6,
-	0xe9, 0x26, 0xa8, 0xac, 0xb4, 0x74, 0xc4, 0x25, 0x8a, 0xc7, 0x6c, 0xa1, 0x73, 0x2e, 0xd1, 0x4d,
-	0x50, 0x59, 0x8d, 0x71, 0xc1, 0xd6, 0xe3, 0x48, 0x30, 0x15, 0x5d, 0xa7, 0x5b, 0xa2, 0x9b, 0x20,
-	0xe9, 0x41, 0x73, 0x14, 0x70, 0x37, 0x12, 0x02, 0xb9, 0xbb, 0xd6, 0x89, 0xb7, 0x69, 0x1e, 0x22,
-	0x1f, 0x40, 0xf5, 0x2e, 0x9b, 0xe2, 0x22, 0x74, 0x2a, 0xbd, 0xd2, 0x4e, 0x73, 0xf8, 0xc6, 0x4b,
-	0x55, 0x0d, 0x8c, 0xfe, 0x90, 0x4b, 0xb1, 0xa6, 0xb1, 0xb1, 0xe2, 0x94, 0x62, 0x18, 0x44, 0xc2,
-	0x45, 0xa7, 0xaa, 0xe9, 0x88, 0x39, 0x4d, 0x50, 0x9a, 0xea, 0xbb, 0x37, 0xa0, 0x99, 0x0b, 0x41,
-	0x6c, 0x28, 0xcd, 0x71, 0x1d, 0xf7, 0x47, 0xfd, 0x24, 0xaf, 0x43, 0xe5, 0x31, 0x5b, 0x44, 0xa8,
-	0x2b, 0x6d, 0x50, 0x23, 0xdc, 0x2c, 0x7e, 0x64, 0xf5, 0xaf, 0x67, 0x9f, 0x51, 0x7e, 0xa3, 0x07,
-	0xa7, 0xda, 0xaf, 0x4c, 0xd5, 0x4f, 0xb2, 0x05, 0xd5, 0x63, 0x5c, 0x06, 0x62, 0xad, 0x1d, 0xcb,
-	0x34, 0x96, 0xfa, 0x77, 0xa0, 0x6d, 0x1a, 0x8a, 0x14, 0xc3, 0x68, 0x21, 0xc9, 0x35, 0x28, 0xab,
-	0x3e, 0x6b, 0xdf, 0xce, 0xd0, 0x4e, 0x33, 0x8d, 0x16, 0x52, 0xf7, 0x5f, 0x6b, 0x55, 0x1a, 0x87,
-	0x42, 0xc4, 0x43, 0xd2, 0xa0, 0x46, 0xe8, 0xff, 0x55, 0x84, 0xc6, 0x7e, 0xb8, 0xe6, 0xae, 0xa2,
-	0x24, 0x37, 0x5b, 0x65, 0x3d, 0x5b, 0xd7, 0xa1, 0x9e, 0xcc, 0x9d, 0x76, 0x6b, 0x0e, 0x49, 0x46,
-	0x60, 0xa2, 0x89, 0xe7, 0x22, 0xb5, 0x24, 0x7d, 0x68, 0x3d, 0x60, 0x02, 0xb9, 0x54, 0x56, 0x47,
-	0x63, 0xdd, 0xbb, 0x06, 0xdd, 0xc0, 0xc8, 0x0e, 0x54, 0x4f, 0x24, 0x93, 0x91, 0x19, 0xb7, 0x34,
-	0x6b, 0xa5, 0x35, 0x38, 0x8d, 0xf5, 0x64, 0x1b, 0x40, 0xa1, 0x34, 0xe2, 0x1c, 0x85, 0x53, 0xd1,
-	0xb1, 0x72, 0x88, 0xae, 0x6b, 0x15, 0xb8, 0x8f, 0x74, 0xa3, 0xda, 0xd4, 0x08, 0x6a, 0x80, 0xee,
-	0xb2, 0x50, 0xde, 0x46, 0x26, 0xe4, 0x14, 0x99, 0x74, 0x6a, 0x66, 0x80, 0x36, 0x40, 0xd2, 0x85,
-	0xfa, 0x48, 0x20, 0x93, 0xb8, 0x2f, 0x9d, 0xba, 0x36, 0x48, 0x65, 0x33, 0x5c, 0xcb, 0xd5, 0x02,
-	0x25, 0xce, 0xf6, 0xa5, 0xd3, 0xd0, 0xea, 0x3c, 0x44, 0x6e, 0x9c, 0x6b, 0x84, 0x03, 0x9a, 0xa2,
-	0xcb, 0xa6, 0x94, 0x0d, 0x15, 0xdd, 0xb4, 0xec, 0xff, 0x69, 0xa9, 0x2f, 0x07, 0xfc, 0x15, 0xb2,
-	0xde, 0x35, 0x11, 0x0f, 0xcf, 0x56, 0x22, 0x66, 0x3c, 0x95, 0x95, 0xee, 0x1e, 0x9e, 0x49, 0xb5,
-	0x81, 0x9a, 0xef, 0x12, 0x4d, 0x65, 0xd5, 0xad, 0x89, 0xf0, 0x3d, 0x0f, 0x85, 0xd9, 0xda, 0x8a,
-	0xce, 0x63, 0x03, 0xdb, 0xe0, 0xa9, 0x7a, 0x8e, 0xa7, 0x2e, 0xd4, 0x4f, 0x57, 0x33, 0xa3, 0x33,
-	0x24, 0xa7, 0x72, 0xff, 0x47, 0x0b, 0xec, 0x51, 0xc0, 0x39, 0xba, 0x32, 0x10, 0x63, 0x94, 0xcc,
-	0x5f, 0x84, 0xe4, 0x0a, 0x34, 0x26, 0x6c, 0xba, 0xc0, 0x7b, 0x6c, 0x89, 0xf1, 0x9e, 0x64, 0x00,
-	0xf9, 0x38, 0x3b, 0x44, 0x45, 0xbd, 0xb2, 0x6f, 0x9a, 0xda, 0xcf, 0x87, 0x19, 0xc4, 0x56, 0x66,
-	0x71, 0x13, 0x9f, 0xee, 0x4d, 0x68, 0xe5, 0x15, 0x17, 0x5a, 0xc7, 0x9f, 0x2d, 0xa8, 0x25, 0x49,
-	0xf6, 0xa0, 0x39, 0xc6, 0xd0, 0x15, 0xbe, 0x0e, 0x16, 0xfb, 0xe7, 0x21, 0x55, 0xc6, 0xbe, 0xeb,
-	0x06, 0x11, 0x97, 0x47, 0x63, 0x1d, 0xab, 0x4d, 0x33, 0x40, 0x5d, 0xda, 0x58, 0x88, 0x9b, 0x91,
-	0x88, 0x9a, 0xaf, 0x10, 0x05, 0x67, 0x71, 0x2f, 0x1a, 0x34, 0x95, 0xb3, 0x1d, 0xad, 0xe4, 0x76,
-	0x94, 0x7c, 0x08, 0x8d, 0xb4, 0xfa, 0x78, 0xc6, 0xb6, 0xfe, 0x9d, 0x94, 0xdb, 0x05, 0x9a, 0x99,
-	0x1e, 0x34, 0xd2, 0x72, 0xfa, 0x7f, 0x97, 0x01, 0xc6, 0x0c, 0x97, 0xaf, 0x74, 0xe2, 0x36, 0x18,
-	0x28, 0xfd, 0x0f, 0x03, 0xe5, 0x4d, 0x06, 0x76, 0xa1, 0xae, 0xe2, 0x4e, 0xd6, 0x2b, 0xd4, 0x85,
-	0x6e, 0xbc, 0x58, 0x0a, 0xa5, 0xa9, 0xfe, 0xdc, 0xf6, 0x57, 0x5f, 0xda, 0xfe, 0x77, 0x8d, 0x3e,
-	0xbe, 0x25, 0xb5, 0xff, 0xb8, 0x25, 0x39, 0x1b, 0xf2, 0xd9, 0xf9, 0xcb, 0x50, 0xd7, 0x05, 0x77,
-	0x07, 0xe6, 0x65, 0x1e, 0x24, 0x2f, 0xf3, 0x60, 0x92, 0xbc, 0xcc, 0x07, 0x75, 0x55, 0xf8, 0xb7,
-	0xbf, 0x5e, 0xb5, 0xce, 0xdf, 0x8f, 0xb7, 0x53, 0x86, 0xf5, 0x7d, 0x68, 0x0e, 0xdb, 0xe6, 0xd3,
-	0x31, 0x48, 0xd3, 0x71, 0xba, 0x95, 0x5b, 0x20, 0xb8, 0xc0, 0xf7, 0xb2, 0x35, 0xbb, 0x95, 0x5b,
-	0xb3, 0xe6, 0x45, 0x22, 0x24, 0x5e, 0xe4, 0x26, 0x54, 0x0e, 0xb9, 0x3a, 0x65, 0xad, 0x0b, 0xb8,
-	0x1b, 0x17, 0xf2, 0x09, 0xd4, 0x54, 0xe5, 0x34, 0xe2, 0x4e, 0xfb, 0x02, 0xde, 0x89, 0xd3, 0xee,
-	0xf7, 0x56, 0xbe, 0x4f, 0xa4, 0x09, 0x35, 0x53, 0xd8, 0xcc, 0x2e, 0x28, 0x41, 0x35, 0xd3, 0xe7,
-	0x9e, 0x6d, 0x91, 0xb6, 0x9a, 0xf5, 0xf8, 0xc4, 0xda, 0x45, 0x02, 0x50, 0x7d, 0xc0, 0xa2, 0x10,
-	0x67, 0x76, 0x89, 0x34, 0xe2, 0xe5, 0xb0, 0xcb, 0xa4, 0x05, 0xf5, 0x11, 0xe3, 0x2e, 0x2e, 0x70,
-	0x66, 0x57, 0xc8, 0x65, 0xb8, 0xa4, 0xce, 0xea, 0x12, 0x29, 0x7e, 0x15, 0x61, 0xa8, 0x3c, 0xab,
-	0x84, 0x40, 0x47, 0x7b, 0x66, 0x58, 0x4d, 0x19, 0x1a, 0xb7, 0x0c, 0xac, 0xef, 0x7e, 0x63, 0xa6,
-	0x51, 0xbf, 0x91, 0x2d, 0xa8, 0x4f, 0x30, 0x94, 0xf7, 0xf9, 0x62, 0x6d, 0x17, 0x48, 0x07, 0xe0,
-	0x64, 0x1d, 0x4a, 0x5c, 0x1e, 0x71, 0x5f, 0xda, 0x96, 0x0a, 0x79, 0x8c, 0x52, 0xf8, 0xee, 0xdd,
-	0xc0, 0x3b, 0x46, 0xe1, 0xa1, 0x5d, 0x24, 0x5b, 0x40, 0x0c, 0x76, 0x22, 0x03, 0xc1, 0x3c, 0x3c,
-	0x0d, 0x99, 0x87, 0x76, 0x49, 0xe1, 0xe9, 0x22, 0xde, 0x61, 0x0f, 0xe7, 0xec, 0xc4, 0xe7, 0x73,
-	0xbb, 0x4c, 0x2e, 0x41, 0x53, 0xbb, 0xde, 0x9f, 0x7e, 0x89, 0xae, 0xb4, 0x2b, 0xbb, 0x6f, 0x01,
-	0x64, 0x4f, 0xb5, 0xe2, 0xe2, 0x24, 0x72, 0x5d, 0x0c, 0x43, 0xbb, 0xa0, 0x8a, 0xff, 0x94, 0xf9,
-	0xaa, 0x46, 0x6b, 0xf7, 0x8b, 0x6c, 0x67, 0xc8, 0x15, 0xa8, 0x9d, 0xf2, 0x39, 0x0f, 0xbe, 0xe6,
-	0x76, 0xa1, 0x7b, 0xe9, 0xc9, 0xd3, 0x5e, 0x53, 0xc1, 0x31, 0x44, 0x86, 0x40, 0xd2, 0x0f, 0xa6,
-	0x29, 0xd8, 0x56, 0xb7, 0xfb, 0xe4, 0x69, 0x6f, 0x4b, 0x19, 0xbe, 0xac, 0x3d, 0x18, 0x3d, 0xff,
-	0x7d, 0xdb, 0x7a, 0xf6, 0x62, 0xdb, 0x7a, 0xfe, 0x62, 0xdb, 0xfa, 0xed, 0xc5, 0x76, 0xe1, 0x87,
-	0x3f, 0xb6, 0xad, 0xcf, 0xf3, 0x7f, 0x6d, 0x97, 0x4c, 0x0a, 0xff, 0x2c, 0x10, 0xbe, 0xe7, 0xf3,
-	0x44, 0xe0, 0xb8, 0xb7, 0x9a, 0x7b, 0x7b, 0xab, 0xe9, 0x9e, 0x1a, 0xfa, 0x69, 0x55, 0x8f, 0xc2,
-	0xfb, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xe6, 0xd5, 0x09, 0x24, 0x0b, 0x00, 0x00,
+	// 1323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x8f, 0x1b, 0x45,
+	0x10, 0xde, 0xf1, 0xdb, 0xe5, 0x47, 0x86, 0x0e, 0x5a, 0x8d, 0xac, 0xb0, 0xb1, 0x4c, 0x10, 0xab,
+	0x95, 0xf0, 0x82, 0x09, 0x88, 0x44, 0x02, 0xb1, 0xb1, 0x17, 0x65, 0x49, 0x36, 0x89, 0x7a, 0xbd,
+	0x17, 0x6e, 0xed, 0x71, 0x67, 0x32, 0xd8, 0xee, 0x31, 0x3d, 0x3d, 0x61, 0xcd, 0x4f, 0xd8, 0x53,
+	0x6e, 0x70, 0x89, 0xc4, 0x1d, 0xf1, 0x1b, 0xb8, 0xe6, 0x98, 0x5f, 0xc0, 0x23, 0xf0, 0x13, 0xb8,
+	0x22, 0xa1, 0xea, 0x9e, 0x97, 0x1d, 0x40, 0x5a, 0x29, 0xdc, 0xba, 0xbe, 0x7a, 0x4c, 0xd5, 0xd7,
+	0x55, 0xe5, 0x36, 0x80, 0x62, 0xe1, 0xac, 0xbf, 0x94, 0x81, 0x0a, 0x48, 0x09, 0xcf, 0x9d, 0x77,
+	0x3c, 0x5f, 0x3d, 0x8a, 0x26, 0x7d, 0x37, 0x58, 0xec, 0x7b, 0x81, 0x17, 0xec, 0x6b, 0xe5, 0x24,
+	0x7a, 0xa8, 0x25, 0x2d, 0xe8, 0x93, 0x71, 0xea, 0x5c, 0xf5, 0x82, 0xc0, 0x9b, 0xf3, 0xcc, 0x4a,
+	0xf9, 0x0b, 0x1e, 0x2a, 0xb6, 0x58, 0xc6, 0x06, 0xed, 0x05, 0x57, 0x6c, 0xca, 0x14, 0x33, 0x72,
+	0xef, 0x5b, 0x0b, 0x9a, 0x63, 0x16, 0xce, 0x8e, 0x63, 0x98, 0xb4, 0xa1, 0x70, 0x34, 0x72, 0xac,
+	0xae, 0xb5, 0x5b, 0xa7, 0x85, 0xa3, 0x11, 0xd9, 0x83, 0xda, 0xe1, 0x19, 0x77, 0x23, 0x15, 0x48,
+	0xa7, 0xd0, 0xb5, 0x76, 0xdb, 0x83, 0x76, 0x5f, 0x67, 0x89, 0x5e, 0xc3, 0x60, 0xca, 0x69, 0xaa,
+	0x27, 0x0e, 0x54, 0x87, 0x81, 0x50, 0xfc, 0x4c, 0x39, 0xc5, 0xae, 0xb5, 0xdb, 0xa4, 0x89, 0x48,
+	0xde, 0x83, 0xea, 0xfd, 0xa5, 0xf2, 0x03, 0x11, 0x3a, 0xa5, 0xae, 0xb5, 0xdb, 0x18, 0xbc, 0x96,
+	0x05, 0x89, 0x15, 0xb7, 0x4a, 0xcf, 0x7e, 0xbe, 0xba, 0x45, 0x13, 0xbb, 0xde, 0x4f, 0x05, 0x68,
+	0xe4, 0xd4, 0xe4, 0x1a, 0xb4, 0x8e, 0xd9, 0x19, 0xe5, 0x4a, 0xae, 0xc6, 0x58, 0x94, 0xce, 0xb1,
+	0x45, 0xd7, 0x41, 0xb4, 0xd2, 0xd2, 0x91, 0x50, 0x5c, 0x3e, 0x66, 0x73, 0x9d, 0x73, 0x91, 0xae,
+	0x83, 0x68, 0x35, 0xe2, 0x73, 0xb6, 0x1a, 0x45, 0x92, 0x61, 0x74, 0x9d, 0x6e, 0x91, 0xae, 0x83,
+	0xa4, 0x0b, 0x8d, 0x61, 0x20, 0xdc, 0x48, 0x4a, 0x2e, 0xdc, 0x95, 0x4e, 0xbc, 0x45, 0xf3, 0x10,
+	0xf9, 0x00, 0x2a, 0x77, 0xd9, 0x84, 0xcf, 0x43, 0xa7, 0xdc, 0x2d, 0xee, 0x36, 0x06, 0x6f, 0xbc,
+	0x54, 0x55, 0xdf, 0xe8, 0x0f, 0x85, 0x92, 0x2b, 0x1a, 0x1b, 0x23, 0xa7, 0x94, 0x87, 0x41, 0x24,
+	0x5d, 0xee, 0x54, 0x34, 0x1d, 0x31, 0xa7, 0x09, 0x4a, 0x53, 0x7d, 0xe7, 0x06, 0x34, 0x72, 0x21,
+	0x88, 0x0d, 0xc5, 0x19, 0x5f, 0xc5, 0xf7, 0x83, 0x47, 0xf2, 0x3a, 0x94, 0x1f, 0xb3, 0x79, 0xc4,
+	0x75, 0xa5, 0x75, 0x6a, 0x84, 0x9b, 0x85, 0x8f, 0xac, 0xde, 0xf5, 0xec, 0x33, 0xe8, 0x37, 0x7c,
+	0x70, 0xaa, 0xfd, 0x4a, 0x14, 0x8f, 0x64, 0x1b, 0x2a, 0xc7, 0x7c, 0x11, 0xc8, 0x95, 0x76, 0x2c,
+	0xd1, 0x58, 0xea, 0xdd, 0x81, 0x96, 0xb9, 0x50, 0x4e, 0x79, 0x18, 0xcd, 0x15, 0xb9, 0x06, 0x25,
+	0xbc, 0x67, 0xed, 0xdb, 0x1e, 0xd8, 0x69, 0xa6, 0xd1, 0x5c, 0x21, 0x4e, 0xb5, 0x16, 0xd3, 0x38,
+	0x94, 0x32, 0x6e, 0x92, 0x3a, 0x35, 0x42, 0xef, 0xcf, 0x02, 0xd4, 0x0f, 0xc2, 0x95, 0x70, 0x91,
+	0x92, 0x5c, 0x6f, 0x95, 0x74, 0x6f, 0x5d, 0x87, 0x5a, 0xd2, 0x77, 0xda, 0xad, 0x31, 0x20, 0x19,
+	0x81, 0x89, 0x26, 0xee, 0x8b, 0xd4, 0x92, 0xf4, 0xa0, 0xf9, 0x80, 0x49, 0x2e, 0x14, 0x5a, 0x1d,
+	0x8d, 0xf4, 0xdd, 0xd5, 0xe9, 0x1a, 0x46, 0x76, 0xa1, 0x72, 0xa2, 0x98, 0x8a, 0x4c, 0xbb, 0xa5,
+	0x59, 0xa3, 0xd6, 0xe0, 0x34, 0xd6, 0x93, 0x1d, 0x00, 0x44, 0x69, 0x24, 0x04, 0x97, 0x4e, 0x59,
+	0xc7, 0xca, 0x21, 0xba, 0xae, 0x65, 0xe0, 0x3e, 0xd2, 0x17, 0xd5, 0xa2, 0x46, 0xc0, 0x06, 0xba,
+	0xcb, 0x42, 0x75, 0x9b, 0x33, 0xa9, 0x26, 0x9c, 0x29, 0xa7, 0x6a, 0x1a, 0x68, 0x0d, 0x24, 0x1d,
+	0xa8, 0x0d, 0x25, 0x67, 0x8a, 0x1f, 0x28, 0xa7, 0xa6, 0x0d, 0x52, 0xd9, 0x34, 0xd7, 0x62, 0x39,
+	0xe7, 0x8a, 0x4f, 0x0f, 0x94, 0x53, 0xd7, 0xea, 0x3c, 0x44, 0x6e, 0x6c, 0x5c, 0x84, 0x03, 0x9a,
+	0xa2, 0xcb, 0xa6, 0x94, 0x35, 0x15, 0x5d, 0xb7, 0xec, 0xfd, 0x61, 0xe1, 0x97, 0x03, 0xf1, 0x0a,
+	0x59, 0xef, 0x98, 0x88, 0x87, 0x67, 0x4b, 0x19, 0x33, 0x9e, 0xca, 0xa8, 0xbb, 0xc7, 0xcf, 0x14,
+	0x4e, 0xa0, 0xe6, 0xbb, 0x48, 0x53, 0x19, 0x6f, 0x6b, 0x2c, 0x7d, 0xcf, 0xe3, 0xd2, 0x4c, 0x6d,
+	0x59, 0xe7, 0xb1, 0x86, 0xad, 0xf1, 0x54, 0xd9, 0xe0, 0xa9, 0x03, 0xb5, 0xd3, 0xe5, 0xd4, 0xe8,
+	0x0c, 0xc9, 0xa9, 0xdc, 0xfb, 0xc1, 0x02, 0x7b, 0x18, 0x08, 0xc1, 0x5d, 0x15, 0xc8, 0x11, 0x57,
+	0xcc, 0x9f, 0x87, 0xe4, 0x0a, 0xd4, 0xc7, 0x6c, 0x32, 0xe7, 0xf7, 0xd8, 0x82, 0xc7, 0x73, 0x92,
+	0x01, 0xe4, 0xe3, 0x6c, 0x11, 0x15, 0xf4, 0xc8, 0xbe, 0x69, 0x6a, 0xdf, 0x0c, 0xd3, 0x8f, 0xad,
+	0xcc, 0xe0, 0x26, 0x3e, 0x9d, 0x9b, 0xd0, 0xcc, 0x2b, 0x2e, 0x34, 0x8e, 0x3f, 0x16, 0xc0, 0x36,
+	0x65, 0x0d, 0xa7, 0x6e, 0x92, 0xed, 0x36, 0x54, 0x74, 0xcb, 0x4e, 0xe3, 0x18, 0xb1, 0x84, 0x65,
+	0xe3, 0x49, 0x17, 0x61, 0x22, 0xa5, 0x32, 0x56, 0x78, 0xa2, 0xa7, 0xfa, 0x54, 0xfa, 0xf1, 0x5d,
+	0x64, 0x00, 0x7a, 0x9e, 0xf8, 0x62, 0x36, 0x5e, 0x2d, 0xcd, 0x65, 0xd4, 0x69, 0x2a, 0xe3, 0x82,
+	0xc6, 0x33, 0xfa, 0x99, 0x4e, 0x4f, 0x44, 0x93, 0xc7, 0x64, 0xce, 0x43, 0xa7, 0x92, 0xe4, 0x81,
+	0x52, 0x9e, 0xaf, 0xea, 0x1a, 0x5f, 0x1b, 0x85, 0xfc, 0x0f, 0x7c, 0x3d, 0x29, 0x40, 0x35, 0xa1,
+	0xa9, 0x0b, 0x8d, 0x11, 0x0f, 0x5d, 0xe9, 0xeb, 0x60, 0xb1, 0x7f, 0x1e, 0x42, 0x52, 0x0e, 0x5c,
+	0x37, 0x88, 0x84, 0x3a, 0x1a, 0xe9, 0x58, 0x2d, 0x9a, 0x01, 0x58, 0x78, 0x2c, 0xc4, 0x84, 0x25,
+	0xa2, 0xee, 0xaf, 0x90, 0x4b, 0xc1, 0x16, 0x29, 0x5d, 0x89, 0x9c, 0xed, 0xb4, 0x72, 0x6e, 0xa7,
+	0x91, 0x0f, 0xa1, 0x9e, 0x76, 0x4b, 0x3c, 0x93, 0xdb, 0xff, 0xdc, 0x44, 0xb7, 0xb7, 0x68, 0x66,
+	0xaa, 0xfd, 0x12, 0xd6, 0x9c, 0xc6, 0x9a, 0xdf, 0x06, 0x99, 0xda, 0x2f, 0xc1, 0x6e, 0xd5, 0x53,
+	0x1a, 0x7a, 0x7f, 0x95, 0x00, 0x46, 0x8c, 0x2f, 0x5e, 0xe9, 0x64, 0xaf, 0x31, 0x57, 0xfc, 0x0f,
+	0xe6, 0x4a, 0xeb, 0xcc, 0xed, 0x99, 0x16, 0xd5, 0x8d, 0x56, 0xde, 0x7c, 0x19, 0x20, 0x4a, 0x53,
+	0xfd, 0xc6, 0x96, 0xad, 0xbc, 0xb4, 0x65, 0xdf, 0x35, 0xfa, 0x78, 0x67, 0x57, 0xff, 0x65, 0x67,
+	0xe7, 0x6c, 0xc8, 0xe7, 0x9b, 0x1b, 0xb8, 0xa6, 0x0b, 0xee, 0xf4, 0xcd, 0x0b, 0xa8, 0x9f, 0xbc,
+	0x80, 0xfa, 0xe3, 0xe4, 0x05, 0x74, 0xab, 0x86, 0x85, 0x3f, 0xf9, 0xe5, 0xaa, 0xb5, 0xb9, 0xa7,
+	0xdf, 0x4e, 0x19, 0xd6, 0x7b, 0xb8, 0x31, 0x68, 0x99, 0x4f, 0xc7, 0x20, 0x4d, 0xb4, 0xe4, 0xd3,
+	0xdc, 0xa2, 0x82, 0x0b, 0x7c, 0x2f, 0xf5, 0xc2, 0x08, 0xe9, 0x3a, 0x6b, 0x5c, 0x24, 0x42, 0xe2,
+	0x45, 0x6e, 0x42, 0xf9, 0x50, 0xe0, 0x4f, 0x46, 0xf3, 0x02, 0xee, 0xc6, 0x85, 0x7c, 0x02, 0x55,
+	0xac, 0x9c, 0x46, 0xc2, 0x69, 0x5d, 0xc0, 0x3b, 0x71, 0xda, 0xfb, 0xce, 0xca, 0xdf, 0x13, 0x69,
+	0x40, 0xd5, 0x14, 0x36, 0xb5, 0xb7, 0x50, 0xc0, 0xcb, 0xf4, 0x85, 0x67, 0x5b, 0xa4, 0x05, 0xf5,
+	0xf4, 0xa7, 0xcc, 0x2e, 0x10, 0x80, 0xca, 0x03, 0x16, 0x85, 0x7c, 0x6a, 0x17, 0x49, 0x3d, 0x1e,
+	0x2a, 0xbb, 0x44, 0x9a, 0x50, 0x1b, 0x32, 0xe1, 0xf2, 0x39, 0x9f, 0xda, 0x65, 0x72, 0x19, 0x2e,
+	0xe1, 0xcf, 0xd7, 0x82, 0x53, 0xfe, 0x55, 0xc4, 0x43, 0xf4, 0xac, 0x10, 0x02, 0x6d, 0xed, 0x99,
+	0x61, 0x55, 0x34, 0x34, 0x6e, 0x19, 0x58, 0xdb, 0x3b, 0xb7, 0x4c, 0x3b, 0xea, 0xc7, 0x48, 0x13,
+	0x6a, 0x63, 0x1e, 0xaa, 0xfb, 0x62, 0xbe, 0xb2, 0xb7, 0x48, 0x1b, 0xe0, 0x64, 0x15, 0x2a, 0xbe,
+	0x38, 0x12, 0xbe, 0xb2, 0x2d, 0x8c, 0x79, 0xcc, 0x95, 0xf4, 0xdd, 0xbb, 0x81, 0x77, 0xcc, 0xa5,
+	0xc7, 0xed, 0x02, 0xd9, 0x06, 0x62, 0xb0, 0x13, 0x15, 0x48, 0xe6, 0xf1, 0xd3, 0x90, 0x79, 0xdc,
+	0x2e, 0x22, 0x9e, 0x4e, 0xf0, 0x1d, 0xf6, 0x70, 0xc6, 0x70, 0x61, 0xda, 0x25, 0x72, 0x09, 0x1a,
+	0xda, 0xf5, 0xfe, 0xe4, 0x4b, 0xee, 0x2a, 0xbb, 0x8c, 0xe5, 0x63, 0xf8, 0xe1, 0xd4, 0xb5, 0x2b,
+	0x7b, 0x6f, 0x01, 0x64, 0x0f, 0x24, 0x54, 0x9d, 0x44, 0xae, 0xcb, 0xc3, 0xd0, 0xde, 0x42, 0x2a,
+	0x3e, 0x63, 0x3e, 0x56, 0x6c, 0xed, 0x7d, 0x93, 0x4d, 0x10, 0xb9, 0x02, 0xd5, 0x53, 0x31, 0x13,
+	0xc1, 0xd7, 0xc2, 0xde, 0xea, 0x5c, 0x3a, 0x7f, 0xda, 0x6d, 0x20, 0x1c, 0x43, 0x64, 0x00, 0x24,
+	0xfd, 0x7a, 0x9a, 0x8f, 0x6d, 0x75, 0x3a, 0xe7, 0x4f, 0xbb, 0xdb, 0x68, 0xf8, 0xb2, 0x16, 0xe7,
+	0x3a, 0x5d, 0x22, 0x76, 0xa1, 0xd3, 0x3a, 0x7f, 0xda, 0xcd, 0x6d, 0x95, 0xe1, 0xf3, 0xdf, 0x76,
+	0xac, 0x67, 0x2f, 0x76, 0xac, 0xe7, 0x2f, 0x76, 0xac, 0x5f, 0x5f, 0xec, 0x6c, 0x7d, 0xff, 0xfb,
+	0x8e, 0xf5, 0x45, 0xfe, 0xef, 0xc6, 0x82, 0x29, 0xe9, 0x9f, 0x05, 0xd2, 0xf7, 0x7c, 0x91, 0x08,
+	0x82, 0xef, 0x2f, 0x67, 0xde, 0xfe, 0x72, 0xb2, 0x8f, 0x03, 0x32, 0xa9, 0xe8, 0xb6, 0x79, 0xff,
+	0xef, 0x01, 0x00, 0xb1, 0xc0, 0x39, 0xfb, 0xb8, 0x0c, 0x00, 0x00,
 }
 
 func (m *TaskMetadata) Marshal() (dAtA []byte, err error) {
@@ -1543,6 +1674,94 @@ func (m *ConnectorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateCdcDetails) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateCdcDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateCdcDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Options) > 0 {
+		for k := range m.Options {
+			v := m.Options[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTask(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTask(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTask(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Tables) > 0 {
+		i -= len(m.Tables)
+		copy(dAtA[i:], m.Tables)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Tables)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SinkUri) > 0 {
+		i -= len(m.SinkUri)
+		copy(dAtA[i:], m.SinkUri)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.SinkUri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SinkType) > 0 {
+		i -= len(m.SinkType)
+		copy(dAtA[i:], m.SinkType)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.SinkType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceUri) > 0 {
+		i -= len(m.SourceUri)
+		copy(dAtA[i:], m.SourceUri)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.SourceUri)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskName) > 0 {
+		i -= len(m.TaskName)
+		copy(dAtA[i:], m.TaskName)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.TaskName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Details) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1633,6 +1852,27 @@ func (m *Details_Connector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Details_CreateCdc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Details_CreateCdc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CreateCdc != nil {
+		{
+			size, err := m.CreateCdc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DaemonTask) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1657,37 +1897,37 @@ func (m *DaemonTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRun, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRun):])
-	if err7 != nil {
-		return 0, err7
-	}
-	i -= n7
-	i = encodeVarintTask(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x6a
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndAt):])
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRun, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRun):])
 	if err8 != nil {
 		return 0, err8
 	}
 	i -= n8
 	i = encodeVarintTask(dAtA, i, uint64(n8))
 	i--
-	dAtA[i] = 0x62
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateAt):])
+	dAtA[i] = 0x6a
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndAt):])
 	if err9 != nil {
 		return 0, err9
 	}
 	i -= n9
 	i = encodeVarintTask(dAtA, i, uint64(n9))
 	i--
-	dAtA[i] = 0x5a
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateAt):])
+	dAtA[i] = 0x62
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateAt):])
 	if err10 != nil {
 		return 0, err10
 	}
 	i -= n10
 	i = encodeVarintTask(dAtA, i, uint64(n10))
 	i--
+	dAtA[i] = 0x5a
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreateAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreateAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTask(dAtA, i, uint64(n11))
+	i--
 	dAtA[i] = 0x52
 	if m.Details != nil {
 		{
@@ -1701,12 +1941,12 @@ func (m *DaemonTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4a
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeat, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeat):])
-	if err12 != nil {
-		return 0, err12
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastHeartbeat, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastHeartbeat):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n12
-	i = encodeVarintTask(dAtA, i, uint64(n12))
+	i -= n13
+	i = encodeVarintTask(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x42
 	if m.TaskStatus != 0 {
@@ -1966,6 +2206,50 @@ func (m *ConnectorDetails) ProtoSize() (n int) {
 	return n
 }
 
+func (m *CreateCdcDetails) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.TaskName)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.SourceUri)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.SinkType)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.SinkUri)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Tables)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for k, v := range m.Options {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTask(uint64(len(k))) + 1 + len(v) + sovTask(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTask(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *Details) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2012,6 +2296,18 @@ func (m *Details_Connector) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *Details_CreateCdc) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateCdc != nil {
+		l = m.CreateCdc.ProtoSize()
+		n += 1 + l + sovTask(uint64(l))
+	}
+	return n
+}
 func (m *DaemonTask) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3435,7 +3731,7 @@ func (m *ConnectorDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Details) Unmarshal(dAtA []byte) error {
+func (m *CreateCdcDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3458,15 +3754,15 @@ func (m *Details) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateCdcDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateCdcDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3494,13 +3790,13 @@ func (m *Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
 			}
-			m.AccountID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -3510,14 +3806,27 @@ func (m *Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountID |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceUri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3545,11 +3854,11 @@ func (m *Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.SourceUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3577,11 +3886,11 @@ func (m *Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.SinkType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinkUri", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3609,13 +3918,13 @@ func (m *Details) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.SinkUri = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tables", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTask
@@ -3625,26 +3934,418 @@ func (m *Details) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTask
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ConnectorDetails{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Tables = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			m.Details = &Details_Connector{v}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTask
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTask
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTask
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTask
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTask
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTask
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTask(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTask
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Options[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Details) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountID", wireType)
+			}
+			m.AccountID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConnectorDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &Details_Connector{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateCdc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CreateCdcDetails{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Details = &Details_CreateCdc{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
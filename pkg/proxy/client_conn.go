@@ -106,6 +106,8 @@ type ClientConn interface {
 
 type migration struct {
 	setVarStmts []string
+	// userChanged is true if the user is changed by the change user cmd.
+	userChanged bool
 }
 
 // clientConn is the connection between proxy and client.
@@ -312,6 +314,8 @@ func (c *clientConn) HandleEvent(ctx context.Context, e IEvent, resp chan<- []by
 		return c.handleKillQuery(ev, resp)
 	case *setVarEvent:
 		return c.handleSetVar(ev)
+	case *resetConnEvent:
+		return c.handleResetConn(ev)
 	case *quitEvent:
 		// Notify/finish the event immediately.
 		ev.notify()
@@ -406,6 +410,48 @@ func (c *clientConn) handleSetVar(e *setVarEvent) error {
 	return nil
 }
 
+// handleResetConn handles the reset connection event.
+func (c *clientConn) handleResetConn(e *resetConnEvent) error {
+	defer e.notify()
+	// The session variables have been reset in the CN server, so they
+	// should not be set again when the connection is transferred.
+	c.migration.setVarStmts = nil
+	if len(e.changeUser) == 0 {
+		return nil
+	}
+
+	// Rebuild the handshake response with the new user, so the connection
+	// logins as the new user after it is transferred.
+	payload, username, db, err := c.mysqlProto.MakeChangeUserHandshakeResp(
+		c.ctx, c.handshakePack.Payload, e.changeUser)
+	if err != nil {
+		return err
+	}
+	ci := clientInfo{
+		originIP:   c.clientInfo.originIP,
+		originPort: c.clientInfo.originPort,
+	}
+	if err = ci.parse(username); err != nil {
+		return err
+	}
+	ci.labelInfo = newLabelInfo(ci.Tenant, ci.Labels)
+	if ci.hash, err = ci.getHash(); err != nil {
+		return err
+	}
+	c.mysqlProto.SetUserName(username)
+	c.mysqlProto.SetDatabaseName(db)
+	c.clientInfo = ci
+	c.handshakePack = &frontend.Packet{
+		Length:     int32(len(payload)),
+		SequenceID: c.handshakePack.SequenceID,
+		Payload:    payload,
+	}
+	// The authentication info of the server connection is of the old
+	// user, so it cannot be put into the cache.
+	c.migration.userChanged = true
+	return nil
+}
+
 func (c *clientConn) handleQuitEvent(ctx context.Context) error {
 	// Get server->client pipe and set it to pause.
 	_, scp := c.tun.getPipes()
@@ -417,7 +463,7 @@ func (c *clientConn) handleQuitEvent(ctx context.Context) error {
 	}
 	// After the server->client pipe is paused, push the
 	// connection to cache.
-	if !c.connCache.Push(c.clientInfo.hash, c.sc) {
+	if c.migration.userChanged || !c.connCache.Push(c.clientInfo.hash, c.sc) {
 		if err := c.sc.Quit(); err != nil {
 			c.log.Error("failed to quit from cn server", zap.Error(err))
 		}
@@ -455,8 +501,9 @@ func (c *clientConn) connectToBackend(prevAdd string) (ServerConn, error) {
 	}
 
 	var sc ServerConn
-	// If connCache is enabled, try to get connection from the cache.
-	if c.connCache != nil {
+	// If connCache is enabled, try to get connection from the cache. The
+	// cached connections do not use the compressed protocol.
+	if c.connCache != nil && !isCompressedProtocol(c.handshakePack) {
 		sc = c.connCache.Pop(c.clientInfo.hash, c.connID, c.mysqlProto.GetSalt(), c.mysqlProto.GetAuthResponse())
 		if sc != nil {
 			// get the response from the cn server.
@@ -536,3 +536,58 @@ func TestClientConn_SendErrToClient(t *testing.T) {
 	cc.SendErrToClient(moerr.NewInternalErrorNoCtx("msg1"))
 	wg.Wait()
 }
+
+func TestClientConn_HandleResetConn(t *testing.T) {
+	runtime.SetupServiceBasedRuntime("", runtime.DefaultRuntime())
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	c := cc.(*clientConn)
+	c.handshakePack = bytesToPacket(makeClientHandshakeResp())
+	_, err := c.mysqlProto.HandleHandshake(c.ctx, c.handshakePack.Payload)
+	require.NoError(t, err)
+	require.NoError(t, c.clientInfo.parse(c.mysqlProto.GetUserName()))
+	c.migration.setVarStmts = []string{"set @a=1"}
+
+	handleEvent := func(e IEvent) error {
+		errC := make(chan error, 1)
+		go func() {
+			errC <- cc.HandleEvent(c.ctx, e, nil)
+		}()
+		e.wait()
+		return <-errC
+	}
+
+	// reset connection drops the set variable statements only.
+	pack := c.handshakePack
+	require.NoError(t, handleEvent(makeResetConnEvent(nil)))
+	require.Empty(t, c.migration.setVarStmts)
+	require.Equal(t, pack, c.handshakePack)
+	require.False(t, c.migration.userChanged)
+
+	// change user rebuilds the handshake response with the new user.
+	auth := []byte("01234567890123456789")
+	changeUser := append([]byte("tenant2:user2\x00"), byte(len(auth)))
+	changeUser = append(changeUser, auth...)
+	changeUser = append(changeUser, "db2\x00"...)
+	changeUser = append(changeUser, 45, 0)
+	changeUser = append(changeUser, "mysql_native_password\x00"...)
+	c.migration.setVarStmts = []string{"set @a=1"}
+	require.NoError(t, handleEvent(makeResetConnEvent(changeUser)))
+	require.Empty(t, c.migration.setVarStmts)
+	require.True(t, c.migration.userChanged)
+	require.Equal(t, Tenant("tenant2"), c.clientInfo.Tenant)
+	require.Equal(t, "user2", c.clientInfo.username)
+	require.Equal(t, "tenant2:user2", c.mysqlProto.GetUserName())
+	require.Equal(t, "db2", c.mysqlProto.GetDatabaseName())
+
+	cc2, cleanup2 := createNewClientConn(t)
+	defer cleanup2()
+	mp := cc2.(*clientConn).mysqlProto
+	_, err = mp.HandleHandshake(context.TODO(), c.handshakePack.Payload)
+	require.NoError(t, err)
+	require.Equal(t, "tenant2:user2", mp.GetUserName())
+	require.Equal(t, "db2", mp.GetDatabaseName())
+	require.Equal(t, auth, mp.GetAuthResponse())
+
+	require.Error(t, handleEvent(makeResetConnEvent([]byte("user"))))
+}
@@ -55,6 +55,11 @@ the migration cannot be done.
 (3) If you are in a transaction, you cannot do migration. Tracking of transaction state is
 recorded as data is interacted.
 
+The set variable statements are recorded and executed again on the new CN server after the
+migration. COM_RESET_CONNECTION and COM_CHANGE_USER reset the session, so the recorded
+statements are dropped. For COM_CHANGE_USER, the handshake response is rebuilt with the new
+user, so the connection logins as the new user on the new CN server.
+
 5. Scaling:
 The proxy module regularly checks the work state of the cn service. If the state is draining,
 then migrate the tunnels on the cn to appropriate cns. If no suitable cn is found, an error
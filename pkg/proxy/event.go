@@ -34,6 +34,8 @@ func (t eventType) String() string {
 		return "SetVar"
 	case TypeQuit:
 		return "Quit"
+	case TypeResetConn:
+		return "ResetConn"
 	}
 	return "Unknown"
 }
@@ -45,6 +47,8 @@ const (
 	TypeSetVar eventType = 2
 	// TypeQuit indicates the exit cmd.
 	TypeQuit eventType = 3
+	// TypeResetConn indicates the reset connection cmd or the change user cmd.
+	TypeResetConn eventType = 4
 )
 
 // IEvent is the event interface.
@@ -114,6 +118,12 @@ func makeEvent(msg []byte, b *msgBuf) (IEvent, bool) {
 		// handled in the event handler. According to the config,
 		// the quit command will be sent to server or not.
 		return makeQuitEvent(), true
+	} else if isCmdResetConnection(msg) {
+		// This event should be sent to dst, so return false,
+		return makeResetConnEvent(nil), false
+	} else if isCmdChangeUser(msg) {
+		// The message is copied as the buffer is reused.
+		return makeResetConnEvent(append([]byte(nil), msg[5:]...)), false
 	}
 	return nil, false
 }
@@ -180,3 +190,26 @@ func makeQuitEvent() IEvent {
 	e.typ = TypeQuit
 	return e
 }
+
+// resetConnEvent is the event that the session is reset by the reset
+// connection cmd or the change user cmd. The set variable statements kept
+// in clientConn are dropped, and for the change user cmd, the handshake
+// response is rebuilt with the new user for the connection migration.
+type resetConnEvent struct {
+	baseEvent
+	// changeUser is the payload of the change user cmd. It is empty for
+	// the reset connection cmd.
+	changeUser []byte
+}
+
+// makeResetConnEvent creates an event with TypeResetConn type.
+func makeResetConnEvent(changeUser []byte) IEvent {
+	e := &resetConnEvent{
+		baseEvent: baseEvent{
+			waitC: make(chan struct{}),
+		},
+		changeUser: changeUser,
+	}
+	e.typ = TypeResetConn
+	return e
+}
@@ -102,6 +102,22 @@ func TestMakeEvent(t *testing.T) {
 			require.False(t, r)
 		}
 	})
+
+	t.Run("reset conn", func(t *testing.T) {
+		msg := makeSimplePacket("")
+		msg[4] = byte(cmdResetConnection)
+		e, r = makeEvent(msg, &msgBuf{})
+		require.NotNil(t, e)
+		require.False(t, r)
+		require.Empty(t, e.(*resetConnEvent).changeUser)
+
+		msg = makeSimplePacket("user1\x00")
+		msg[4] = byte(cmdChangeUser)
+		e, r = makeEvent(msg, &msgBuf{})
+		require.NotNil(t, e)
+		require.False(t, r)
+		require.Equal(t, []byte("user1\x00"), e.(*resetConnEvent).changeUser)
+	})
 }
 
 func TestKillQueryEvent(t *testing.T) {
@@ -37,6 +37,9 @@ const (
 	cmdLen = 1
 	// The header and cmd must be received first.
 	preRecvLen = mysqlHeadLen + cmdLen
+	// The header of the compressed MySQL packet is 7 bytes, with 3 bytes
+	// compressed length, 1 byte sequence number and 3 bytes uncompressed length.
+	compressedHeadLen = 7
 )
 
 // MySQLCmd is the type indicate the cmd of statement.
@@ -50,6 +53,9 @@ const (
 	// For stmt prepare and execute cmd from JDBC.
 	cmdStmtPrepare MySQLCmd = 0x16
 	cmdStmtClose   MySQLCmd = 0x19
+	// For the connection pools to reuse the connection.
+	cmdChangeUser      MySQLCmd = 0x11
+	cmdResetConnection MySQLCmd = 0x1f
 )
 
 // MySQLConn contains a buffer to save data which may be only part
@@ -105,6 +111,10 @@ type msgBuf struct {
 	respC chan []byte
 	// connCacheEnabled is a function returns if the connection cache is enabled.
 	connCacheEnabled bool
+	// compressed indicates that the packets are in the compressed protocol. The
+	// compressed packets are sent through the tunnel as they are, and no events
+	// are handled as the statements cannot be parsed.
+	compressed bool
 }
 
 // newMsgBuf creates a new message buffer.
@@ -157,6 +167,9 @@ func (b *msgBuf) writeAvail() int {
 // a packet header at least, and it blocks when there are nothing to
 // receive.
 func (b *msgBuf) preRecv() (int, error) {
+	if b.compressed {
+		return b.preRecvCompressed()
+	}
 	// First we try to receive at least preRecvLen data and put it into
 	// the buffer.
 	if err := b.receiveAtLeast(mysqlHeadLen); err != nil {
@@ -178,6 +191,16 @@ func (b *msgBuf) preRecv() (int, error) {
 	return bodyLen + mysqlHeadLen, nil
 }
 
+// preRecvCompressed tries to receive a compressed MySQL packet from remote.
+// It receives the header of the compressed packet at least.
+func (b *msgBuf) preRecvCompressed() (int, error) {
+	if err := b.receiveAtLeast(compressedHeadLen); err != nil {
+		return 0, err
+	}
+	bodyLen := int(uint32(b.buf[b.begin]) | uint32(b.buf[b.begin+1])<<8 | uint32(b.buf[b.begin+2])<<16)
+	return bodyLen + compressedHeadLen, nil
+}
+
 // consumeMsg consumes the MySQL packet in the buffer, handles it by event
 // mechanism.
 // The first return value is true if the command is handled, means it does not need
@@ -256,7 +279,7 @@ func (b *msgBuf) sendTo(dst io.Writer) error {
 
 	var handled bool
 
-	if dataLeft == 0 && b.name == connClientName {
+	if dataLeft == 0 && b.name == connClientName && !b.compressed {
 		handled = b.consumeClient(b.buf[readPos:writePos])
 		// means the query has been handled
 		if handled {
@@ -212,6 +212,37 @@ func TestMySQLConnSend(t *testing.T) {
 	})
 }
 
+func TestMySQLConnSendCompressed(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	// the compressed packet carries an uncompressed MySQL packet.
+	inner := makeSimplePacket("select 1")
+	data := make([]byte, compressedHeadLen, compressedHeadLen+len(inner))
+	data[0] = byte(len(inner))
+	data = append(data, inner...)
+	src1, dst1 := net.Pipe()
+	src2, dst2 := net.Pipe()
+
+	go func() {
+		n, err := src1.Write(data)
+		require.NoError(t, err)
+		require.Equal(t, len(data), n)
+	}()
+	go func() {
+		var res [30]byte
+		n, err := dst2.Read(res[:])
+		require.NoError(t, err)
+		require.Equal(t, data, res[:n])
+	}()
+	d1 := newMySQLConn(connClientName, dst1, 30, nil, nil, false, 0)
+	d1.compressed = true
+	size, err := d1.preRecv()
+	require.NoError(t, err)
+	require.Equal(t, len(data), size)
+	err = d1.sendTo(src2)
+	require.NoError(t, err)
+}
+
 func TestMySQLConnSize(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 
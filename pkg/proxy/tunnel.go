@@ -128,6 +128,9 @@ type tunnel struct {
 		started bool
 		// inTransfer means a transfer of server connection is in progress.
 		inTransfer bool
+		// compressed means the client uses the compressed protocol. The
+		// connection cannot be transferred as the packets are opaque to proxy.
+		compressed bool
 
 		// sc is the server connection which this tunnel holds. when the connection transfer,
 		// close the old one.
@@ -197,6 +200,9 @@ func (t *tunnel) run(cc ClientConn, sc ServerConn) error {
 			t.connCacheEnabled,
 			sc.ConnID(),
 		)
+		t.mu.compressed = isCompressedProtocol(cc.GetHandshakePack())
+		t.mu.clientConn.compressed = t.mu.compressed
+		t.mu.serverConn.compressed = t.mu.compressed
 
 		// Create the pipes from client to server and server to client.
 		t.mu.csp = t.newPipe(pipeClientToServer, t.mu.clientConn, t.mu.serverConn)
@@ -300,6 +306,12 @@ func (t *tunnel) canStartTransfer(sync bool) bool {
 		return false
 	}
 
+	// The packets in compressed protocol cannot be inspected.
+	if t.mu.compressed {
+		t.logger.Info("reason: compressed protocol")
+		return false
+	}
+
 	csp, scp := t.mu.csp, t.mu.scp
 	csp.mu.Lock()
 	scp.mu.Lock()
@@ -611,7 +623,7 @@ func (p *pipe) kickoff(ctx context.Context, peer *pipe) (e error) {
 		}
 		// set txn status and cmd time within the mutex together.
 		// only server->client pipe need to set the txn status.
-		if p.name == pipeServerToClient {
+		if p.name == pipeServerToClient && !p.src.compressed {
 			var currSeq int16
 			buf := p.src.readAvailBuf()
 
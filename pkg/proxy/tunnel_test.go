@@ -634,6 +634,19 @@ func TestCanStartTransfer(t *testing.T) {
 		require.False(t, can)
 	})
 
+	t.Run("compressed", func(t *testing.T) {
+		tu := &tunnel{
+			logger: logger,
+		}
+		tu.mu.csp = &pipe{}
+		tu.mu.scp = &pipe{}
+		tu.mu.scp.src = newMySQLConn("", nil, 0, nil, nil, false, 0)
+		tu.mu.started = true
+		tu.mu.compressed = true
+		can := tu.canStartTransfer(false)
+		require.False(t, can)
+	})
+
 	t.Run("ok", func(t *testing.T) {
 		tu := &tunnel{
 			logger: logger,
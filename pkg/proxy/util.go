@@ -16,6 +16,7 @@ package proxy
 
 import (
 	"crypto/md5"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"net"
@@ -26,6 +27,20 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 )
 
+// isCompressedProtocol returns true if the client and server use the
+// compressed protocol, which is negotiated by the handshake response.
+func isCompressedProtocol(pack *frontend.Packet) bool {
+	if pack == nil || len(pack.Payload) < 2 {
+		return false
+	}
+	capability := uint32(binary.LittleEndian.Uint16(pack.Payload))
+	if capability&frontend.CLIENT_PROTOCOL_41 != 0 && len(pack.Payload) >= 4 {
+		capability = binary.LittleEndian.Uint32(pack.Payload)
+	}
+	capability &= frontend.DefaultCapability
+	return capability&(frontend.CLIENT_COMPRESS|frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM) != 0
+}
+
 // makeOKPacket returns an OK packet
 func makeOKPacket(l int) []byte {
 	data := make([]byte, l+4)
@@ -83,6 +98,20 @@ func isCmdStmtClose(p []byte) bool {
 	return false
 }
 
+func isCmdChangeUser(p []byte) bool {
+	if len(p) > 4 && p[4] == byte(cmdChangeUser) {
+		return true
+	}
+	return false
+}
+
+func isCmdResetConnection(p []byte) bool {
+	if len(p) > 4 && p[4] == byte(cmdResetConnection) {
+		return true
+	}
+	return false
+}
+
 // isOKPacket returns true if []byte is a MySQL OK packet.
 func isOKPacket(p []byte) bool {
 	if len(p) > 4 && p[4] == 0 {
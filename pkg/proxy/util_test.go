@@ -116,6 +116,23 @@ func TestIsCmdQuery(t *testing.T) {
 	require.True(t, ret)
 }
 
+func TestIsCompressedProtocol(t *testing.T) {
+	require.False(t, isCompressedProtocol(nil))
+	require.False(t, isCompressedProtocol(&frontend.Packet{Payload: []byte{0}}))
+
+	capability := frontend.CLIENT_PROTOCOL_41
+	payload := []byte{byte(capability), byte(capability >> 8), byte(capability >> 16), byte(capability >> 24)}
+	require.False(t, isCompressedProtocol(&frontend.Packet{Payload: payload}))
+
+	capability |= frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	payload = []byte{byte(capability), byte(capability >> 8), byte(capability >> 16), byte(capability >> 24)}
+	require.True(t, isCompressedProtocol(&frontend.Packet{Payload: payload}))
+
+	capability = frontend.CLIENT_COMPRESS
+	payload = []byte{byte(capability), byte(capability >> 8)}
+	require.True(t, isCompressedProtocol(&frontend.Packet{Payload: payload}))
+}
+
 func TestIsCmdInitDB(t *testing.T) {
 	var data []byte
 	ret := isCmdInitDB(data)
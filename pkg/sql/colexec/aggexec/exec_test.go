@@ -15,6 +15,7 @@
 package aggexec
 
 import (
+	"math"
 	"sync"
 	"testing"
 
@@ -456,3 +457,385 @@ func TestEmptyNullFlag(t *testing.T) {
 	}
 	require.Equal(t, int64(0), mg.Mp().CurrNB())
 }
+
+func TestStatisticsExec(t *testing.T) {
+	mg := newTestAggMemoryManager()
+
+	// (y, x) are (1, 2), (2, 4), (3, 6), (4, 9) and (null, 1),
+	// n = 4, Syy = 5, Sxx = 26.75, Sxy = 11.5, mean(y) = 2.5, mean(x) = 5.25.
+	ys := vector.NewVec(types.T_float64.ToType())
+	xs := vector.NewVec(types.T_float64.ToType())
+	require.NoError(t, vector.AppendFixedList(ys, []float64{1, 2, 3, 4, 0}, []bool{false, false, false, false, true}, mg.Mp()))
+	require.NoError(t, vector.AppendFixedList(xs, []float64{2, 4, 6, 9, 1}, nil, mg.Mp()))
+	inputs := []*vector.Vector{ys, xs}
+
+	slope := 11.5 / 26.75
+	cases := []struct {
+		register func(int64)
+		args     int
+		expected float64
+		// the result of a group of the single row (1, 2), nil for null.
+		single any
+	}{
+		{register: RegisterVarSampAgg, args: 1, expected: 5.0 / 3, single: nil},
+		{register: RegisterStdDevSampAgg, args: 1, expected: math.Sqrt(5.0 / 3), single: nil},
+		{register: RegisterCovarPopAgg, args: 2, expected: 11.5 / 4, single: 0.0},
+		{register: RegisterCovarSampAgg, args: 2, expected: 11.5 / 3, single: nil},
+		{register: RegisterCorrAgg, args: 2, expected: 11.5 / math.Sqrt(26.75*5), single: nil},
+		{register: RegisterRegrSlopeAgg, args: 2, expected: slope, single: nil},
+		{register: RegisterRegrInterceptAgg, args: 2, expected: 2.5 - slope*5.25, single: nil},
+		{register: RegisterRegrR2Agg, args: 2, expected: 11.5 * 11.5 / (26.75 * 5), single: nil},
+		{register: RegisterRegrCountAgg, args: 2, expected: 4, single: int64(1)},
+	}
+
+	for _, c := range cases {
+		id := gUniqueAggIdForTest()
+		c.register(id)
+		argTypes := []types.Type{types.T_float64.ToType(), types.T_float64.ToType()}[:c.args]
+
+		// the group 0 has the rows 0 and 1, the group 1 has the row 0 and the
+		// group 2 is empty, the rows 2, 3 and 4 are filled into another
+		// executor which is serialized and merged into the group 0.
+		executor := MakeAgg(mg, id, false, argTypes...)
+		require.NoError(t, executor.GroupGrow(3))
+		require.NoError(t, executor.Fill(0, 0, inputs))
+		require.NoError(t, executor.BatchFill(0, []uint64{2, 1}, inputs))
+
+		other := MakeAgg(mg, id, false, argTypes...)
+		require.NoError(t, other.GroupGrow(1))
+		require.NoError(t, other.BatchFill(2, []uint64{1, 1, 1}, inputs))
+		data, err := MarshalAggFuncExec(other)
+		require.NoError(t, err)
+		other.Free()
+		other, err = UnmarshalAggFuncExec(mg, data)
+		require.NoError(t, err)
+		require.NoError(t, executor.BatchMerge(other, 0, []uint64{1}))
+		other.Free()
+
+		v, err := executor.Flush()
+		require.NoError(t, err)
+		require.Equal(t, 3, v.Length())
+		if c.single == nil {
+			require.True(t, v.IsNull(1))
+		} else {
+			require.False(t, v.IsNull(1))
+		}
+		if _, ok := c.single.(int64); ok {
+			vs := vector.MustFixedCol[int64](v)
+			require.Equal(t, int64(c.expected), vs[0])
+			require.Equal(t, c.single, vs[1])
+			require.False(t, v.IsNull(2))
+			require.Equal(t, int64(0), vs[2])
+		} else {
+			vs := vector.MustFixedCol[float64](v)
+			require.InDelta(t, c.expected, vs[0], 1e-9)
+			if c.single != nil {
+				require.Equal(t, c.single, vs[1])
+			}
+			require.True(t, v.IsNull(2))
+		}
+		v.Free(mg.Mp())
+		executor.Free()
+	}
+
+	ys.Free(mg.Mp())
+	xs.Free(mg.Mp())
+	require.Equal(t, int64(0), mg.Mp().CurrNB())
+}
+
+func TestPercentileExec(t *testing.T) {
+	mg := newTestAggMemoryManager()
+
+	// the values are 4, 1, 3, 2 and null.
+	xs := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(xs, []int64{4, 1, 3, 2, 0}, []bool{false, false, false, false, true}, mg.Mp()))
+	fxs := vector.NewVec(types.T_float64.ToType())
+	require.NoError(t, vector.AppendFixedList(fxs, []float64{4, 1, 3, 2, 0}, []bool{false, false, false, false, true}, mg.Mp()))
+
+	cases := []struct {
+		register func(int64)
+		x        *vector.Vector
+		fraction float64
+		desc     bool
+		expected any
+	}{
+		{register: RegisterPercentileCont, x: fxs, fraction: 0.5, desc: false, expected: 2.5},
+		{register: RegisterPercentileCont, x: fxs, fraction: 0.25, desc: true, expected: 3.25},
+		{register: RegisterPercentileCont, x: fxs, fraction: 1, desc: false, expected: 4.0},
+		{register: RegisterPercentileDisc, x: xs, fraction: 0.5, desc: false, expected: int64(2)},
+		{register: RegisterPercentileDisc, x: xs, fraction: 0.5, desc: true, expected: int64(3)},
+		{register: RegisterPercentileDisc, x: xs, fraction: 0, desc: false, expected: int64(1)},
+	}
+
+	for _, c := range cases {
+		id := gUniqueAggIdForTest()
+		c.register(id)
+		fraction, err := vector.NewConstFixed(types.T_float64.ToType(), c.fraction, 5, mg.Mp())
+		require.NoError(t, err)
+		desc, err := vector.NewConstFixed(types.T_bool.ToType(), c.desc, 5, mg.Mp())
+		require.NoError(t, err)
+		inputs := []*vector.Vector{c.x, fraction, desc}
+		argTypes := []types.Type{*c.x.GetType(), types.T_float64.ToType(), types.T_bool.ToType()}
+
+		// the group 0 has all the rows, half of them are filled into another
+		// executor which is serialized and merged into the group 0.
+		// the group 1 has the row 4 only, which is null.
+		executor := MakeAgg(mg, id, false, argTypes...)
+		require.NoError(t, executor.GroupGrow(2))
+		require.NoError(t, executor.BatchFill(0, []uint64{1, 1}, inputs))
+		require.NoError(t, executor.Fill(1, 4, inputs))
+
+		other := MakeAgg(mg, id, false, argTypes...)
+		require.NoError(t, other.GroupGrow(1))
+		require.NoError(t, other.BatchFill(2, []uint64{1, 1, 1}, inputs))
+		data, err := MarshalAggFuncExec(other)
+		require.NoError(t, err)
+		other.Free()
+		other, err = UnmarshalAggFuncExec(mg, data)
+		require.NoError(t, err)
+		require.NoError(t, executor.BatchMerge(other, 0, []uint64{1}))
+		other.Free()
+
+		v, err := executor.Flush()
+		require.NoError(t, err)
+		require.Equal(t, 2, v.Length())
+		require.False(t, v.IsNull(0))
+		require.True(t, v.IsNull(1))
+		if f, ok := c.expected.(float64); ok {
+			require.InDelta(t, f, vector.MustFixedCol[float64](v)[0], 1e-9)
+		} else {
+			require.Equal(t, c.expected, vector.MustFixedCol[int64](v)[0])
+		}
+		v.Free(mg.Mp())
+		executor.Free()
+		fraction.Free(mg.Mp())
+		desc.Free(mg.Mp())
+	}
+
+	// the fraction out of [0, 1] is an error.
+	id := gUniqueAggIdForTest()
+	RegisterPercentileCont(id)
+	fraction, err := vector.NewConstFixed(types.T_float64.ToType(), 1.5, 5, mg.Mp())
+	require.NoError(t, err)
+	executor := MakeAgg(mg, id, false, types.T_float64.ToType(), types.T_float64.ToType(), types.T_bool.ToType())
+	require.NoError(t, executor.GroupGrow(1))
+	require.Error(t, executor.Fill(0, 0, []*vector.Vector{fxs, fraction, vector.NewConstNull(types.T_bool.ToType(), 5, mg.Mp())}))
+	executor.Free()
+	fraction.Free(mg.Mp())
+
+	xs.Free(mg.Mp())
+	fxs.Free(mg.Mp())
+	require.Equal(t, int64(0), mg.Mp().CurrNB())
+}
+
+func TestTDigest(t *testing.T) {
+	// a single value and two values are exact.
+	d := newTDigest(defaultApproxPercentileAccuracy)
+	_, ok := d.quantile(0.5)
+	require.False(t, ok)
+	d.add(3)
+	v, ok := d.quantile(0.99)
+	require.True(t, ok)
+	require.Equal(t, 3.0, v)
+	d.add(5)
+	v, _ = d.quantile(0)
+	require.Equal(t, 3.0, v)
+	v, _ = d.quantile(1)
+	require.Equal(t, 5.0, v)
+
+	// the values 1..100000 are added into two t-digests in turn, the merged
+	// and the serialized one estimate the quantiles with a small error.
+	n := 100000
+	d1 := newTDigest(defaultApproxPercentileAccuracy)
+	d2 := newTDigest(defaultApproxPercentileAccuracy)
+	for i := 1; i <= n; i++ {
+		if i%2 == 0 {
+			d1.add(float64(i))
+		} else {
+			d2.add(float64(i))
+		}
+	}
+	d1.merge(d2)
+	require.Less(t, len(d1.means), 2*defaultApproxPercentileAccuracy)
+
+	data, err := d1.marshal()
+	require.NoError(t, err)
+	d3 := &tDigest{}
+	require.NoError(t, d3.unmarshal(data))
+
+	for _, q := range []float64{0.01, 0.5, 0.95, 0.99, 0.999} {
+		for _, digest := range []*tDigest{d1, d3} {
+			v, ok := digest.quantile(q)
+			require.True(t, ok)
+			require.InDelta(t, q*float64(n), v, 0.005*float64(n))
+		}
+	}
+	v, _ = d3.quantile(0)
+	require.Equal(t, 1.0, v)
+	v, _ = d3.quantile(1)
+	require.Equal(t, float64(n), v)
+}
+
+func TestApproxPercentileExec(t *testing.T) {
+	mg := newTestAggMemoryManager()
+	id := gUniqueAggIdForTest()
+	RegisterApproxPercentile(id)
+
+	xs := vector.NewVec(types.T_float64.ToType())
+	require.NoError(t, vector.AppendFixedList(xs, []float64{4, 1, 3, 2, 0}, []bool{false, false, false, false, true}, mg.Mp()))
+	fraction, err := vector.NewConstFixed(types.T_float64.ToType(), 0.5, 5, mg.Mp())
+	require.NoError(t, err)
+	accuracy, err := vector.NewConstFixed(types.T_int64.ToType(), int64(200), 5, mg.Mp())
+	require.NoError(t, err)
+	inputs := []*vector.Vector{xs, fraction, accuracy}
+	argTypes := []types.Type{types.T_float64.ToType(), types.T_float64.ToType(), types.T_int64.ToType()}
+
+	executor := MakeAgg(mg, id, false, argTypes...)
+	require.NoError(t, executor.GroupGrow(2))
+	require.NoError(t, executor.BatchFill(0, []uint64{1, 1}, inputs))
+	require.NoError(t, executor.Fill(1, 4, inputs))
+
+	other := MakeAgg(mg, id, false, argTypes...)
+	require.NoError(t, other.GroupGrow(1))
+	require.NoError(t, other.BatchFill(2, []uint64{1, 1, 1}, inputs))
+	data, err := MarshalAggFuncExec(other)
+	require.NoError(t, err)
+	other.Free()
+	other, err = UnmarshalAggFuncExec(mg, data)
+	require.NoError(t, err)
+	require.NoError(t, executor.BatchMerge(other, 0, []uint64{1}))
+	other.Free()
+
+	v, err := executor.Flush()
+	require.NoError(t, err)
+	require.Equal(t, 2, v.Length())
+	require.Equal(t, 2.5, vector.MustFixedCol[float64](v)[0])
+	require.True(t, v.IsNull(1))
+	v.Free(mg.Mp())
+	executor.Free()
+
+	// the accuracy out of the range is an error.
+	bad, err := vector.NewConstFixed(types.T_int64.ToType(), int64(1), 5, mg.Mp())
+	require.NoError(t, err)
+	executor = MakeAgg(mg, id, false, argTypes...)
+	require.NoError(t, executor.GroupGrow(1))
+	require.Error(t, executor.Fill(0, 0, []*vector.Vector{xs, fraction, bad}))
+	executor.Free()
+
+	bad.Free(mg.Mp())
+	xs.Free(mg.Mp())
+	fraction.Free(mg.Mp())
+	accuracy.Free(mg.Mp())
+	require.Equal(t, int64(0), mg.Mp().CurrNB())
+}
+
+func TestJsonAggExec(t *testing.T) {
+	mg := newTestAggMemoryManager()
+
+	keys := vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vector.AppendStringList(keys, []string{"b", "a", "b", "c"}, nil, mg.Mp()))
+	values := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(values, []int64{1, 2, 3, 0}, []bool{false, false, false, true}, mg.Mp()))
+	inputs := []*vector.Vector{keys, values}
+
+	cases := []struct {
+		register func(int64)
+		args     []*vector.Vector
+		expected string
+	}{
+		{register: RegisterJsonArrayAgg, args: inputs[1:], expected: `[1, 2, 3, null]`},
+		{register: RegisterJsonObjectAgg, args: inputs, expected: `{"a": 2, "b": 3, "c": null}`},
+	}
+	for _, c := range cases {
+		id := gUniqueAggIdForTest()
+		c.register(id)
+		argTypes := make([]types.Type, len(c.args))
+		for i, arg := range c.args {
+			argTypes[i] = *arg.GetType()
+		}
+
+		// the group 0 has all the rows, the rows 2 and 3 are filled into
+		// another executor which is serialized and merged into the group 0.
+		// the group 1 is empty.
+		executor := MakeAgg(mg, id, false, argTypes...)
+		require.NoError(t, executor.GroupGrow(2))
+		require.NoError(t, executor.BatchFill(0, []uint64{1, 1}, c.args))
+
+		other := MakeAgg(mg, id, false, argTypes...)
+		require.NoError(t, other.GroupGrow(1))
+		require.NoError(t, other.BatchFill(2, []uint64{1, 1}, c.args))
+		data, err := MarshalAggFuncExec(other)
+		require.NoError(t, err)
+		other.Free()
+		other, err = UnmarshalAggFuncExec(mg, data)
+		require.NoError(t, err)
+		require.NoError(t, executor.BatchMerge(other, 0, []uint64{1}))
+		other.Free()
+
+		v, err := executor.Flush()
+		require.NoError(t, err)
+		require.Equal(t, 2, v.Length())
+		require.Equal(t, c.expected, types.DecodeJson(v.GetBytesAt(0)).String())
+		require.True(t, v.IsNull(1))
+		v.Free(mg.Mp())
+		executor.Free()
+	}
+
+	// a null key is an error.
+	id := gUniqueAggIdForTest()
+	RegisterJsonObjectAgg(id)
+	nullKey := vector.NewConstNull(types.T_varchar.ToType(), 4, mg.Mp())
+	executor := MakeAgg(mg, id, false, types.T_varchar.ToType(), types.T_int64.ToType())
+	require.NoError(t, executor.GroupGrow(1))
+	require.Error(t, executor.Fill(0, 0, []*vector.Vector{nullKey, values}))
+	executor.Free()
+
+	keys.Free(mg.Mp())
+	values.Free(mg.Mp())
+	require.Equal(t, int64(0), mg.Mp().CurrNB())
+}
+
+func TestJsonValueOf(t *testing.T) {
+	mp := mpool.MustNewZero()
+
+	d, err := types.ParseDecimal64("1.50", 10, 2)
+	require.NoError(t, err)
+	bj, err := types.ParseStringToByteJson(`{"k": [1, "v"]}`)
+	require.NoError(t, err)
+	data, err := bj.Marshal()
+	require.NoError(t, err)
+
+	vecs := []*vector.Vector{
+		vector.NewConstNull(types.T_int32.ToType(), 1, mp),
+	}
+	expected := []string{`null`, `true`, `-3`, `4`, `1.1`, `1.5`, `"s"`, `"2024-01-02"`, `{"k": [1, "v"]}`}
+	for _, v := range []func() (*vector.Vector, error){
+		func() (*vector.Vector, error) { return vector.NewConstFixed(types.T_bool.ToType(), true, 1, mp) },
+		func() (*vector.Vector, error) { return vector.NewConstFixed(types.T_int8.ToType(), int8(-3), 1, mp) },
+		func() (*vector.Vector, error) { return vector.NewConstFixed(types.T_uint16.ToType(), uint16(4), 1, mp) },
+		func() (*vector.Vector, error) {
+			return vector.NewConstFixed(types.T_float32.ToType(), float32(1.1), 1, mp)
+		},
+		func() (*vector.Vector, error) {
+			return vector.NewConstFixed(types.New(types.T_decimal64, 10, 2), d, 1, mp)
+		},
+		func() (*vector.Vector, error) {
+			return vector.NewConstBytes(types.T_varchar.ToType(), []byte("s"), 1, mp)
+		},
+		func() (*vector.Vector, error) {
+			return vector.NewConstFixed(types.T_date.ToType(), types.DateFromCalendar(2024, 1, 2), 1, mp)
+		},
+		func() (*vector.Vector, error) { return vector.NewConstBytes(types.T_json.ToType(), data, 1, mp) },
+	} {
+		vec, err := v()
+		require.NoError(t, err)
+		vecs = append(vecs, vec)
+	}
+
+	for i, vec := range vecs {
+		v, err := JsonValueOf(vec, 0)
+		require.NoError(t, err)
+		require.Equal(t, expected[i], v.String())
+		vec.Free(mp)
+	}
+}
@@ -0,0 +1,466 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+var JsonAggSupportedTypes = []types.T{
+	types.T_bool, types.T_bit,
+	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+	types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+	types.T_char, types.T_varchar, types.T_text, types.T_json, types.T_uuid,
+}
+
+func JsonAggReturnType(_ []types.Type) types.Type {
+	return types.T_json.ToType()
+}
+
+// JsonValueOf converts the value of the row to a json value, a null is the
+// json null. The json value does not refer to the memory of the vector.
+func JsonValueOf(vec *vector.Vector, row int) (bytejson.ByteJson, error) {
+	if vec.IsConst() {
+		row = 0
+	}
+	if vec.IsNull(uint64(row)) {
+		return bytejson.Null, nil
+	}
+
+	switch vec.GetType().Oid {
+	case types.T_bool:
+		return bytejson.FromBool(vector.GetFixedAt[bool](vec, row)), nil
+	case types.T_int8:
+		return bytejson.FromInt64(int64(vector.GetFixedAt[int8](vec, row))), nil
+	case types.T_int16:
+		return bytejson.FromInt64(int64(vector.GetFixedAt[int16](vec, row))), nil
+	case types.T_int32:
+		return bytejson.FromInt64(int64(vector.GetFixedAt[int32](vec, row))), nil
+	case types.T_int64:
+		return bytejson.FromInt64(vector.GetFixedAt[int64](vec, row)), nil
+	case types.T_uint8:
+		return bytejson.FromUint64(uint64(vector.GetFixedAt[uint8](vec, row))), nil
+	case types.T_uint16:
+		return bytejson.FromUint64(uint64(vector.GetFixedAt[uint16](vec, row))), nil
+	case types.T_uint32:
+		return bytejson.FromUint64(uint64(vector.GetFixedAt[uint32](vec, row))), nil
+	case types.T_uint64, types.T_bit:
+		return bytejson.FromUint64(vector.GetFixedAt[uint64](vec, row)), nil
+	case types.T_float32:
+		// keep the shortest decimal form of the float32, 1.1 rather than 1.100000023841858.
+		v := strconv.FormatFloat(float64(vector.GetFixedAt[float32](vec, row)), 'g', -1, 32)
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return bytejson.ByteJson{}, err
+		}
+		return bytejson.FromFloat64(f)
+	case types.T_float64:
+		return bytejson.FromFloat64(vector.GetFixedAt[float64](vec, row))
+	case types.T_decimal64:
+		return bytejson.ParseFromString(vector.GetFixedAt[types.Decimal64](vec, row).Format(vec.GetType().Scale))
+	case types.T_decimal128:
+		return bytejson.ParseFromString(vector.GetFixedAt[types.Decimal128](vec, row).Format(vec.GetType().Scale))
+	case types.T_date:
+		return bytejson.FromString(vector.GetFixedAt[types.Date](vec, row).String()), nil
+	case types.T_time:
+		return bytejson.FromString(vector.GetFixedAt[types.Time](vec, row).String()), nil
+	case types.T_datetime:
+		return bytejson.FromString(vector.GetFixedAt[types.Datetime](vec, row).String()), nil
+	case types.T_timestamp:
+		return bytejson.FromString(vector.GetFixedAt[types.Timestamp](vec, row).String()), nil
+	case types.T_uuid:
+		return bytejson.FromString(vector.GetFixedAt[types.Uuid](vec, row).String()), nil
+	case types.T_char, types.T_varchar, types.T_text:
+		return bytejson.FromString(string(vec.GetBytesAt(row))), nil
+	case types.T_json:
+		bj := bytejson.ByteJson{}
+		if err := bj.Unmarshal(bytes.Clone(vec.GetBytesAt(row))); err != nil {
+			return bytejson.ByteJson{}, err
+		}
+		return bj, nil
+	}
+	return bytejson.ByteJson{}, moerr.NewInternalErrorNoCtx("unsupported type %s for json aggregation", vec.GetType().String())
+}
+
+// unmarshalJsonGroup decodes a group marshaled as a json document.
+func unmarshalJsonGroup(data []byte) (bytejson.ByteJson, error) {
+	bj := bytejson.ByteJson{}
+	err := bj.Unmarshal(bytes.Clone(data))
+	return bj, err
+}
+
+// jsonArrayAggExec is the executor of the JSON_ARRAYAGG(x), which returns a
+// json array of all the values of a group, a null value is the json null.
+// The ORDER BY of the JSON_ARRAYAGG is done by the window operator as the
+// GROUP_CONCAT does, the values are appended in the order they come.
+type jsonArrayAggExec struct {
+	multiAggInfo
+	ret aggFuncBytesResult
+
+	groups [][]bytejson.ByteJson
+}
+
+func makeJsonArrayAgg(
+	mg AggMemoryManager,
+	aggID int64, isDistinct bool,
+	param []types.Type) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewNotSupportedNoCtx("json_arrayagg in distinct mode")
+	}
+	if len(param) != 1 {
+		return nil, moerr.NewInternalErrorNoCtx("json_arrayagg expects 1 argument but got %d", len(param))
+	}
+
+	info := multiAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argTypes:  param,
+		retType:   JsonAggReturnType(param),
+		emptyNull: true,
+	}
+	return &jsonArrayAggExec{
+		multiAggInfo: info,
+		ret:          initBytesAggFuncResult(mg, info.retType, info.emptyNull),
+	}, nil
+}
+
+func (exec *jsonArrayAggExec) marshal() ([]byte, error) {
+	d := exec.multiAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	// each group is encoded as the json array of its values.
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: make([][]byte, len(exec.groups)),
+	}
+	for i := range exec.groups {
+		if encoded.Groups[i], err = bytejson.BuildArray(exec.groups[i]).Marshal(); err != nil {
+			return nil, err
+		}
+	}
+	return encoded.Marshal()
+}
+
+func (exec *jsonArrayAggExec) unmarshal(_ *mpool.MPool, result []byte, groups [][]byte) error {
+	exec.groups = make([][]bytejson.ByteJson, len(groups))
+	for i := range groups {
+		arr, err := unmarshalJsonGroup(groups[i])
+		if err != nil {
+			return err
+		}
+		exec.groups[i] = make([]bytejson.ByteJson, arr.GetElemCnt())
+		for j := range exec.groups[i] {
+			exec.groups[i][j] = arr.GetArrayElem(j)
+		}
+	}
+	return exec.ret.unmarshal(result)
+}
+
+func (exec *jsonArrayAggExec) GroupGrow(more int) error {
+	exec.groups = append(exec.groups, make([][]bytejson.ByteJson, more)...)
+	return exec.ret.grows(more)
+}
+
+func (exec *jsonArrayAggExec) PreAllocateGroups(more int) error {
+	if cap(exec.groups)-len(exec.groups) < more {
+		groups := make([][]bytejson.ByteJson, len(exec.groups), len(exec.groups)+more)
+		copy(groups, exec.groups)
+		exec.groups = groups
+	}
+	return exec.ret.preAllocate(more)
+}
+
+func (exec *jsonArrayAggExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	v, err := JsonValueOf(vectors[0], row)
+	if err != nil {
+		return err
+	}
+	exec.groups[groupIndex] = append(exec.groups[groupIndex], v)
+	return nil
+}
+
+func (exec *jsonArrayAggExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	for i, j := 0, vectors[0].Length(); i < j; i++ {
+		if err := exec.Fill(groupIndex, i, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *jsonArrayAggExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	for i, group := range groups {
+		if group == GroupNotMatched {
+			continue
+		}
+		if err := exec.Fill(int(group-1), offset+i, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *jsonArrayAggExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	other := next.(*jsonArrayAggExec)
+	exec.groups[groupIdx1] = append(exec.groups[groupIdx1], other.groups[groupIdx2]...)
+	return nil
+}
+
+func (exec *jsonArrayAggExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	for i, group := range groups {
+		if group != GroupNotMatched {
+			if err := exec.Merge(next, int(group-1), offset+i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (exec *jsonArrayAggExec) SetExtraInformation(partialResult any, groupIndex int) error {
+	return moerr.NewInternalErrorNoCtx("json_arrayagg do not support the extra information")
+}
+
+func (exec *jsonArrayAggExec) Flush() (*vector.Vector, error) {
+	for i, values := range exec.groups {
+		if len(values) == 0 {
+			continue
+		}
+		v, err := bytejson.BuildArray(values).Marshal()
+		if err != nil {
+			return nil, err
+		}
+		exec.ret.groupToSet = i
+		if err = exec.ret.aggSet(v); err != nil {
+			return nil, err
+		}
+		exec.ret.setGroupNotEmpty(i)
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *jsonArrayAggExec) Free() {
+	exec.ret.free()
+}
+
+// jsonObjectGroup is the members of a group of the JSON_OBJECTAGG, the last
+// value of a duplicate key wins.
+type jsonObjectGroup struct {
+	keys   []string
+	values []bytejson.ByteJson
+	index  map[string]int
+}
+
+func (g *jsonObjectGroup) set(key string, value bytejson.ByteJson) {
+	if i, ok := g.index[key]; ok {
+		g.values[i] = value
+		return
+	}
+	if g.index == nil {
+		g.index = make(map[string]int)
+	}
+	g.index[key] = len(g.keys)
+	g.keys = append(g.keys, key)
+	g.values = append(g.values, value)
+}
+
+// jsonObjectAggExec is the executor of the JSON_OBJECTAGG(key, value), which
+// returns a json object of all the key-value pairs of a group. The key was
+// cast to string by the planner and must not be null, a null value is the
+// json null.
+type jsonObjectAggExec struct {
+	multiAggInfo
+	ret aggFuncBytesResult
+
+	groups []jsonObjectGroup
+}
+
+func makeJsonObjectAgg(
+	mg AggMemoryManager,
+	aggID int64, isDistinct bool,
+	param []types.Type) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewNotSupportedNoCtx("json_objectagg in distinct mode")
+	}
+	if len(param) != 2 || !param[0].Oid.IsMySQLString() {
+		return nil, moerr.NewInternalErrorNoCtx("unsupported arguments %v for json_objectagg", param)
+	}
+
+	info := multiAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argTypes:  param,
+		retType:   JsonAggReturnType(param),
+		emptyNull: true,
+	}
+	return &jsonObjectAggExec{
+		multiAggInfo: info,
+		ret:          initBytesAggFuncResult(mg, info.retType, info.emptyNull),
+	}, nil
+}
+
+func (exec *jsonObjectAggExec) marshal() ([]byte, error) {
+	d := exec.multiAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	// each group is encoded as the json object of its members.
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: make([][]byte, len(exec.groups)),
+	}
+	for i := range exec.groups {
+		obj, err := bytejson.BuildObject(exec.groups[i].keys, exec.groups[i].values)
+		if err != nil {
+			return nil, err
+		}
+		if encoded.Groups[i], err = obj.Marshal(); err != nil {
+			return nil, err
+		}
+	}
+	return encoded.Marshal()
+}
+
+func (exec *jsonObjectAggExec) unmarshal(_ *mpool.MPool, result []byte, groups [][]byte) error {
+	exec.groups = make([]jsonObjectGroup, len(groups))
+	for i := range groups {
+		obj, err := unmarshalJsonGroup(groups[i])
+		if err != nil {
+			return err
+		}
+		for j, n := 0, obj.GetElemCnt(); j < n; j++ {
+			exec.groups[i].set(string(obj.GetObjectKey(j)), obj.GetObjectVal(j))
+		}
+	}
+	return exec.ret.unmarshal(result)
+}
+
+func (exec *jsonObjectAggExec) GroupGrow(more int) error {
+	exec.groups = append(exec.groups, make([]jsonObjectGroup, more)...)
+	return exec.ret.grows(more)
+}
+
+func (exec *jsonObjectAggExec) PreAllocateGroups(more int) error {
+	if cap(exec.groups)-len(exec.groups) < more {
+		groups := make([]jsonObjectGroup, len(exec.groups), len(exec.groups)+more)
+		copy(groups, exec.groups)
+		exec.groups = groups
+	}
+	return exec.ret.preAllocate(more)
+}
+
+func (exec *jsonObjectAggExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	keys := vectors[0]
+	keyRow := row
+	if keys.IsConst() {
+		keyRow = 0
+	}
+	if keys.IsNull(uint64(keyRow)) {
+		return moerr.NewInvalidInputNoCtx("JSON documents may not contain NULL member names")
+	}
+	v, err := JsonValueOf(vectors[1], row)
+	if err != nil {
+		return err
+	}
+	exec.groups[groupIndex].set(string(keys.GetBytesAt(keyRow)), v)
+	return nil
+}
+
+func (exec *jsonObjectAggExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	for i, j := 0, vectors[0].Length(); i < j; i++ {
+		if err := exec.Fill(groupIndex, i, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *jsonObjectAggExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	for i, group := range groups {
+		if group == GroupNotMatched {
+			continue
+		}
+		if err := exec.Fill(int(group-1), offset+i, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *jsonObjectAggExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	other := &next.(*jsonObjectAggExec).groups[groupIdx2]
+	for i, key := range other.keys {
+		exec.groups[groupIdx1].set(key, other.values[i])
+	}
+	return nil
+}
+
+func (exec *jsonObjectAggExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	for i, group := range groups {
+		if group != GroupNotMatched {
+			if err := exec.Merge(next, int(group-1), offset+i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (exec *jsonObjectAggExec) SetExtraInformation(partialResult any, groupIndex int) error {
+	return moerr.NewInternalErrorNoCtx("json_objectagg do not support the extra information")
+}
+
+func (exec *jsonObjectAggExec) Flush() (*vector.Vector, error) {
+	for i := range exec.groups {
+		g := &exec.groups[i]
+		if len(g.keys) == 0 {
+			continue
+		}
+		obj, err := bytejson.BuildObject(g.keys, g.values)
+		if err != nil {
+			return nil, err
+		}
+		v, err := obj.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		exec.ret.groupToSet = i
+		if err = exec.ret.aggSet(v); err != nil {
+			return nil, err
+		}
+		exec.ret.setGroupNotEmpty(i)
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *jsonObjectAggExec) Free() {
+	exec.ret.free()
+}
@@ -0,0 +1,330 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+var PercentileDiscSupportedTypes = []types.T{
+	types.T_bit, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+	types.T_date, types.T_time, types.T_datetime, types.T_timestamp,
+}
+
+func PercentileContReturnType(_ []types.Type) types.Type {
+	return types.T_float64.ToType()
+}
+
+func PercentileDiscReturnType(args []types.Type) types.Type {
+	return args[0]
+}
+
+// preparePercentileArgs reads the constant arguments of a percentile
+// aggregation from its input, the arguments are (x, fraction, desc) for the
+// PERCENTILE_CONT and PERCENTILE_DISC, and (x, fraction[, accuracy]) for the
+// APPROX_PERCENTILE.
+func preparePercentileArgs(args *EncodedPercentile, vectors []*vector.Vector, approx bool) error {
+	if args.Prepared {
+		return nil
+	}
+
+	fraction := vectors[1]
+	if !fraction.IsConst() || fraction.IsConstNull() {
+		return moerr.NewInvalidInputNoCtx("the percentile must be a constant between 0 and 1")
+	}
+	args.Fraction = vector.MustFixedCol[float64](fraction)[0]
+	if math.IsNaN(args.Fraction) || args.Fraction < 0 || args.Fraction > 1 {
+		return moerr.NewInvalidInputNoCtx("the percentile must be a constant between 0 and 1")
+	}
+
+	if approx {
+		args.Accuracy = defaultApproxPercentileAccuracy
+		if len(vectors) > 2 {
+			accuracy := vectors[2]
+			if !accuracy.IsConst() || accuracy.IsConstNull() {
+				return moerr.NewInvalidInputNoCtx("the accuracy of approx_percentile must be a constant between %d and %d",
+					minApproxPercentileAccuracy, maxApproxPercentileAccuracy)
+			}
+			args.Accuracy = vector.MustFixedCol[int64](accuracy)[0]
+			if args.Accuracy < minApproxPercentileAccuracy || args.Accuracy > maxApproxPercentileAccuracy {
+				return moerr.NewInvalidInputNoCtx("the accuracy of approx_percentile must be a constant between %d and %d",
+					minApproxPercentileAccuracy, maxApproxPercentileAccuracy)
+			}
+		}
+	} else if len(vectors) > 2 && !vectors[2].IsConstNull() {
+		args.Desc = vector.MustFixedCol[bool](vectors[2])[0]
+	}
+	args.Prepared = true
+	return nil
+}
+
+// percentileExec is the executor of the PERCENTILE_CONT and PERCENTILE_DISC,
+// it keeps all the values of each group and sorts them when flushing.
+type percentileExec[T types.FixedSizeTExceptStrType] struct {
+	multiAggInfo
+	args EncodedPercentile
+	ret  aggFuncResult[T]
+
+	// less is the order of the values, and pick returns the result from the
+	// sorted values of a group.
+	less func(a, b T) bool
+	pick func(vs []T, fraction float64, desc bool) T
+
+	groups [][]T
+}
+
+func makePercentile(
+	mg AggMemoryManager,
+	aggID int64, isDistinct bool,
+	param []types.Type, cont bool) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewNotSupportedNoCtx("percentile in distinct mode")
+	}
+	if len(param) != 3 {
+		return nil, moerr.NewInternalErrorNoCtx("percentile expects 3 arguments but got %d", len(param))
+	}
+
+	info := multiAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argTypes:  param,
+		retType:   PercentileDiscReturnType(param),
+		emptyNull: true,
+	}
+	if cont {
+		if param[0].Oid != types.T_float64 {
+			return nil, moerr.NewInternalErrorNoCtx("unsupported type %s for percentile_cont", param[0].String())
+		}
+		info.retType = PercentileContReturnType(param)
+		return newPercentileExec[float64](mg, info, lessOf[float64], percentileContOf), nil
+	}
+
+	switch param[0].Oid {
+	case types.T_bit:
+		return newPercentileExec[uint64](mg, info, lessOf[uint64], percentileDiscOf[uint64]), nil
+	case types.T_int8:
+		return newPercentileExec[int8](mg, info, lessOf[int8], percentileDiscOf[int8]), nil
+	case types.T_int16:
+		return newPercentileExec[int16](mg, info, lessOf[int16], percentileDiscOf[int16]), nil
+	case types.T_int32:
+		return newPercentileExec[int32](mg, info, lessOf[int32], percentileDiscOf[int32]), nil
+	case types.T_int64:
+		return newPercentileExec[int64](mg, info, lessOf[int64], percentileDiscOf[int64]), nil
+	case types.T_uint8:
+		return newPercentileExec[uint8](mg, info, lessOf[uint8], percentileDiscOf[uint8]), nil
+	case types.T_uint16:
+		return newPercentileExec[uint16](mg, info, lessOf[uint16], percentileDiscOf[uint16]), nil
+	case types.T_uint32:
+		return newPercentileExec[uint32](mg, info, lessOf[uint32], percentileDiscOf[uint32]), nil
+	case types.T_uint64:
+		return newPercentileExec[uint64](mg, info, lessOf[uint64], percentileDiscOf[uint64]), nil
+	case types.T_float32:
+		return newPercentileExec[float32](mg, info, lessOf[float32], percentileDiscOf[float32]), nil
+	case types.T_float64:
+		return newPercentileExec[float64](mg, info, lessOf[float64], percentileDiscOf[float64]), nil
+	case types.T_decimal64:
+		return newPercentileExec[types.Decimal64](mg, info, func(a, b types.Decimal64) bool {
+			return a.Compare(b) < 0
+		}, percentileDiscOf[types.Decimal64]), nil
+	case types.T_decimal128:
+		return newPercentileExec[types.Decimal128](mg, info, func(a, b types.Decimal128) bool {
+			return a.Compare(b) < 0
+		}, percentileDiscOf[types.Decimal128]), nil
+	case types.T_date:
+		return newPercentileExec[types.Date](mg, info, lessOf[types.Date], percentileDiscOf[types.Date]), nil
+	case types.T_time:
+		return newPercentileExec[types.Time](mg, info, lessOf[types.Time], percentileDiscOf[types.Time]), nil
+	case types.T_datetime:
+		return newPercentileExec[types.Datetime](mg, info, lessOf[types.Datetime], percentileDiscOf[types.Datetime]), nil
+	case types.T_timestamp:
+		return newPercentileExec[types.Timestamp](mg, info, lessOf[types.Timestamp], percentileDiscOf[types.Timestamp]), nil
+	}
+	return nil, moerr.NewInternalErrorNoCtx("unsupported type %s for percentile_disc", param[0].String())
+}
+
+func newPercentileExec[T types.FixedSizeTExceptStrType](
+	mg AggMemoryManager, info multiAggInfo,
+	less func(a, b T) bool, pick func(vs []T, fraction float64, desc bool) T) AggFuncExec {
+	return &percentileExec[T]{
+		multiAggInfo: info,
+		ret:          initFixedAggFuncResult[T](mg, info.retType, info.emptyNull),
+		less:         less,
+		pick:         pick,
+	}
+}
+
+func lessOf[T types.OrderedT](a, b T) bool {
+	return a < b
+}
+
+// percentileContOf returns the value at the fraction of the sorted values,
+// interpolated linearly between the two nearest values.
+func percentileContOf(vs []float64, fraction float64, desc bool) float64 {
+	if desc {
+		fraction = 1 - fraction
+	}
+	pos := fraction * float64(len(vs)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return vs[lo] + (pos-float64(lo))*(vs[hi]-vs[lo])
+}
+
+// percentileDiscOf returns the first value whose cumulative distribution is
+// not less than the fraction in the order of the WITHIN GROUP clause.
+func percentileDiscOf[T types.FixedSizeTExceptStrType](vs []T, fraction float64, desc bool) T {
+	idx := int(math.Ceil(fraction*float64(len(vs)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if desc {
+		idx = len(vs) - 1 - idx
+	}
+	return vs[idx]
+}
+
+func (exec *percentileExec[T]) marshal() ([]byte, error) {
+	d := exec.multiAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	// the first group is the arguments.
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: make([][]byte, len(exec.groups)+1),
+	}
+	if encoded.Groups[0], err = exec.args.Marshal(); err != nil {
+		return nil, err
+	}
+	for i := range exec.groups {
+		encoded.Groups[i+1] = types.EncodeSlice[T](exec.groups[i])
+	}
+	return encoded.Marshal()
+}
+
+func (exec *percentileExec[T]) unmarshal(_ *mpool.MPool, result []byte, groups [][]byte) error {
+	if len(groups) > 0 {
+		if err := exec.args.Unmarshal(groups[0]); err != nil {
+			return err
+		}
+		exec.groups = make([][]T, len(groups)-1)
+		for i := range exec.groups {
+			exec.groups[i] = append([]T(nil), types.DecodeSlice[T](groups[i+1])...)
+		}
+	}
+	return exec.ret.unmarshal(result)
+}
+
+func (exec *percentileExec[T]) GroupGrow(more int) error {
+	exec.groups = append(exec.groups, make([][]T, more)...)
+	return exec.ret.grows(more)
+}
+
+func (exec *percentileExec[T]) PreAllocateGroups(more int) error {
+	if cap(exec.groups)-len(exec.groups) < more {
+		groups := make([][]T, len(exec.groups), len(exec.groups)+more)
+		copy(groups, exec.groups)
+		exec.groups = groups
+	}
+	return exec.ret.preAllocate(more)
+}
+
+func (exec *percentileExec[T]) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	if err := preparePercentileArgs(&exec.args, vectors, false); err != nil {
+		return err
+	}
+	if vectors[0].IsNull(uint64(row)) {
+		return nil
+	}
+	if vectors[0].IsConst() {
+		row = 0
+	}
+	exec.groups[groupIndex] = append(exec.groups[groupIndex], vector.MustFixedCol[T](vectors[0])[row])
+	return nil
+}
+
+func (exec *percentileExec[T]) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	for i, j := 0, vectors[0].Length(); i < j; i++ {
+		if err := exec.Fill(groupIndex, i, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *percentileExec[T]) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	for i, group := range groups {
+		if group == GroupNotMatched {
+			continue
+		}
+		if err := exec.Fill(int(group-1), offset+i, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *percentileExec[T]) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	other := next.(*percentileExec[T])
+	if !exec.args.Prepared {
+		exec.args = other.args
+	}
+	exec.groups[groupIdx1] = append(exec.groups[groupIdx1], other.groups[groupIdx2]...)
+	return nil
+}
+
+func (exec *percentileExec[T]) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	for i, group := range groups {
+		if group != GroupNotMatched {
+			if err := exec.Merge(next, int(group-1), offset+i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (exec *percentileExec[T]) SetExtraInformation(partialResult any, groupIndex int) error {
+	return moerr.NewInternalErrorNoCtx("percentile do not support the extra information")
+}
+
+func (exec *percentileExec[T]) Flush() (*vector.Vector, error) {
+	vs := exec.ret.values
+	for i, values := range exec.groups {
+		if len(values) == 0 {
+			continue
+		}
+		exec.ret.empty[i] = false
+		sort.Slice(values, func(a, b int) bool {
+			return exec.less(values[a], values[b])
+		})
+		vs[i] = exec.pick(values, exec.args.Fraction, exec.args.Desc)
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *percentileExec[T]) Free() {
+	exec.ret.free()
+}
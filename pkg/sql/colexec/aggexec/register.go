@@ -52,11 +52,77 @@ func RegisterMedian(id int64) {
 	aggIdOfMedian = id
 }
 
+func RegisterPercentileCont(id int64) {
+	specialAgg[id] = true
+	aggIdOfPercentileCont = id
+}
+
+func RegisterPercentileDisc(id int64) {
+	specialAgg[id] = true
+	aggIdOfPercentileDisc = id
+}
+
+func RegisterApproxPercentile(id int64) {
+	specialAgg[id] = true
+	aggIdOfApproxPercentile = id
+}
+
+func RegisterJsonArrayAgg(id int64) {
+	specialAgg[id] = true
+	aggIdOfJsonArrayAgg = id
+}
+
+func RegisterJsonObjectAgg(id int64) {
+	specialAgg[id] = true
+	aggIdOfJsonObjectAgg = id
+}
+
 func RegisterClusterCenters(id int64) {
 	specialAgg[id] = true
 	aggIdOfClusterCenters = id
 }
 
+func RegisterVarSampAgg(id int64) {
+	registerStatisticsAgg(id, statisticsVarSamp)
+}
+
+func RegisterStdDevSampAgg(id int64) {
+	registerStatisticsAgg(id, statisticsStdDevSamp)
+}
+
+func RegisterCovarPopAgg(id int64) {
+	registerStatisticsAgg(id, statisticsCovarPop)
+}
+
+func RegisterCovarSampAgg(id int64) {
+	registerStatisticsAgg(id, statisticsCovarSamp)
+}
+
+func RegisterCorrAgg(id int64) {
+	registerStatisticsAgg(id, statisticsCorr)
+}
+
+func RegisterRegrSlopeAgg(id int64) {
+	registerStatisticsAgg(id, statisticsRegrSlope)
+}
+
+func RegisterRegrInterceptAgg(id int64) {
+	registerStatisticsAgg(id, statisticsRegrIntercept)
+}
+
+func RegisterRegrR2Agg(id int64) {
+	registerStatisticsAgg(id, statisticsRegrR2)
+}
+
+func RegisterRegrCountAgg(id int64) {
+	registerStatisticsAgg(id, statisticsRegrCount)
+}
+
+func registerStatisticsAgg(id int64, kind statisticsKind) {
+	specialAgg[id] = true
+	statisticsAgg[id] = kind
+}
+
 func RegisterRowNumberWin(id int64) {
 	specialAgg[id] = true
 	winIdOfRowNumber = id
@@ -98,22 +164,30 @@ var (
 	multiAgg   = make(map[int64]bool)
 	specialAgg = make(map[int64]bool)
 
+	// the kind of the statistics aggregations, they are special aggregations too.
+	statisticsAgg = make(map[int64]statisticsKind)
+
 	// agg implementation map.
 	registeredAggFunctions            = make(map[aggKey]aggImplementation)
 	registeredMultiColumnAggFunctions = make(map[aggKey]multiColumnAggImplementation)
 
 	// list of special aggregation function IDs.
-	aggIdOfCountColumn    = int64(-1)
-	aggIdOfCountStar      = int64(-2)
-	aggIdOfGroupConcat    = int64(-3)
-	aggIdOfApproxCount    = int64(-4)
-	aggIdOfMedian         = int64(-5)
-	aggIdOfClusterCenters = int64(-6)
-	winIdOfRowNumber      = int64(-7)
-	winIdOfRank           = int64(-8)
-	winIdOfDenseRank      = int64(-9)
-	groupConcatSep        = ","
-	getCroupConcatRet     = func(args ...types.Type) types.Type {
+	aggIdOfCountColumn      = int64(-1)
+	aggIdOfCountStar        = int64(-2)
+	aggIdOfGroupConcat      = int64(-3)
+	aggIdOfApproxCount      = int64(-4)
+	aggIdOfMedian           = int64(-5)
+	aggIdOfClusterCenters   = int64(-6)
+	winIdOfRowNumber        = int64(-7)
+	winIdOfRank             = int64(-8)
+	winIdOfDenseRank        = int64(-9)
+	aggIdOfPercentileCont   = int64(-10)
+	aggIdOfPercentileDisc   = int64(-11)
+	aggIdOfApproxPercentile = int64(-12)
+	aggIdOfJsonArrayAgg     = int64(-13)
+	aggIdOfJsonObjectAgg    = int64(-14)
+	groupConcatSep          = ","
+	getCroupConcatRet       = func(args ...types.Type) types.Type {
 		for _, p := range args {
 			if p.Oid == types.T_binary || p.Oid == types.T_varbinary || p.Oid == types.T_blob {
 				return types.T_blob.ToType()
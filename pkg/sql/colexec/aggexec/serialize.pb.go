@@ -4,6 +4,7 @@
 package aggexec
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -153,36 +154,199 @@ func (m *EncodedAgg) GetGroups() [][]byte {
 	return nil
 }
 
+// EncodedPercentile is the arguments of a percentile aggregation.
+type EncodedPercentile struct {
+	// prepared indicates that the arguments were read from the input.
+	Prepared             bool     `protobuf:"varint,1,opt,name=prepared,proto3" json:"prepared,omitempty"`
+	Fraction             float64  `protobuf:"fixed64,2,opt,name=fraction,proto3" json:"fraction,omitempty"`
+	Desc                 bool     `protobuf:"varint,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Accuracy             int64    `protobuf:"varint,4,opt,name=accuracy,proto3" json:"accuracy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EncodedPercentile) Reset()         { *m = EncodedPercentile{} }
+func (m *EncodedPercentile) String() string { return proto.CompactTextString(m) }
+func (*EncodedPercentile) ProtoMessage()    {}
+func (*EncodedPercentile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a7c2bf0e2dbbf4, []int{2}
+}
+func (m *EncodedPercentile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodedPercentile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodedPercentile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncodedPercentile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodedPercentile.Merge(m, src)
+}
+func (m *EncodedPercentile) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *EncodedPercentile) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodedPercentile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodedPercentile proto.InternalMessageInfo
+
+func (m *EncodedPercentile) GetPrepared() bool {
+	if m != nil {
+		return m.Prepared
+	}
+	return false
+}
+
+func (m *EncodedPercentile) GetFraction() float64 {
+	if m != nil {
+		return m.Fraction
+	}
+	return 0
+}
+
+func (m *EncodedPercentile) GetDesc() bool {
+	if m != nil {
+		return m.Desc
+	}
+	return false
+}
+
+func (m *EncodedPercentile) GetAccuracy() int64 {
+	if m != nil {
+		return m.Accuracy
+	}
+	return 0
+}
+
+// EncodedTDigest is the t-digest of a group of the approx_percentile.
+type EncodedTDigest struct {
+	Compression          float64   `protobuf:"fixed64,1,opt,name=compression,proto3" json:"compression,omitempty"`
+	Min                  float64   `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  float64   `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Means                []float64 `protobuf:"fixed64,4,rep,packed,name=means,proto3" json:"means,omitempty"`
+	Weights              []float64 `protobuf:"fixed64,5,rep,packed,name=weights,proto3" json:"weights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *EncodedTDigest) Reset()         { *m = EncodedTDigest{} }
+func (m *EncodedTDigest) String() string { return proto.CompactTextString(m) }
+func (*EncodedTDigest) ProtoMessage()    {}
+func (*EncodedTDigest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a7c2bf0e2dbbf4, []int{3}
+}
+func (m *EncodedTDigest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodedTDigest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodedTDigest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EncodedTDigest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodedTDigest.Merge(m, src)
+}
+func (m *EncodedTDigest) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *EncodedTDigest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodedTDigest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodedTDigest proto.InternalMessageInfo
+
+func (m *EncodedTDigest) GetCompression() float64 {
+	if m != nil {
+		return m.Compression
+	}
+	return 0
+}
+
+func (m *EncodedTDigest) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *EncodedTDigest) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *EncodedTDigest) GetMeans() []float64 {
+	if m != nil {
+		return m.Means
+	}
+	return nil
+}
+
+func (m *EncodedTDigest) GetWeights() []float64 {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EncodedBasicInfo)(nil), "aggexec.EncodedBasicInfo")
 	proto.RegisterType((*EncodedAgg)(nil), "aggexec.EncodedAgg")
+	proto.RegisterType((*EncodedPercentile)(nil), "aggexec.EncodedPercentile")
+	proto.RegisterType((*EncodedTDigest)(nil), "aggexec.EncodedTDigest")
 }
 
 func init() { proto.RegisterFile("serialize.proto", fileDescriptor_f1a7c2bf0e2dbbf4) }
 
 var fileDescriptor_f1a7c2bf0e2dbbf4 = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0xeb, 0xa4, 0x14, 0x78, 0xad, 0xa0, 0xf2, 0x80, 0x02, 0x12, 0x69, 0xd4, 0xa9, 0x4b,
-	0x13, 0x09, 0x36, 0x36, 0x22, 0x3a, 0xb0, 0x46, 0x15, 0x6b, 0x95, 0x26, 0xae, 0x79, 0x6a, 0x6a,
-	0x47, 0xb6, 0x23, 0xb5, 0x9c, 0x82, 0x23, 0x70, 0x9c, 0x8e, 0xcc, 0x0c, 0x15, 0x2a, 0x17, 0x41,
-	0x71, 0x83, 0x84, 0x98, 0xd9, 0xfc, 0xfd, 0xcf, 0xfe, 0x65, 0x7d, 0x0f, 0xce, 0x35, 0x53, 0x98,
-	0x16, 0xf8, 0xc2, 0xc2, 0x52, 0x49, 0x23, 0xe9, 0x71, 0xca, 0x39, 0x5b, 0xb3, 0xec, 0x6a, 0xcc,
-	0xd1, 0x3c, 0x57, 0xf3, 0x30, 0x93, 0xab, 0x88, 0x4b, 0x2e, 0x23, 0x3b, 0x9f, 0x57, 0x0b, 0x4b,
-	0x16, 0xec, 0xe9, 0xf0, 0x6e, 0xf8, 0xea, 0x40, 0x7f, 0x22, 0x32, 0x99, 0xb3, 0x3c, 0x4e, 0x35,
-	0x66, 0x8f, 0x62, 0x21, 0xe9, 0x19, 0x38, 0x98, 0x7b, 0x24, 0x20, 0x23, 0x37, 0x71, 0x30, 0xa7,
-	0x03, 0xe8, 0xa2, 0x9e, 0xe5, 0xa8, 0x0d, 0x8a, 0xcc, 0x78, 0x4e, 0x40, 0x46, 0x27, 0x09, 0xa0,
-	0x7e, 0x68, 0x12, 0x7a, 0x0d, 0x20, 0xaa, 0xa2, 0x98, 0xb1, 0x55, 0x69, 0x36, 0x9e, 0x6b, 0xe7,
-	0xa7, 0x75, 0x32, 0xa9, 0x03, 0xfa, 0x04, 0xed, 0x54, 0x71, 0xed, 0xb5, 0x03, 0x77, 0xd4, 0x8b,
-	0xe3, 0xed, 0x6e, 0xd0, 0xfa, 0xd8, 0x0d, 0xee, 0x7e, 0xfd, 0x74, 0x95, 0x1a, 0x85, 0x6b, 0xa9,
-	0x90, 0xa3, 0xf8, 0x01, 0xc1, 0xa2, 0x72, 0xc9, 0xa3, 0x4c, 0x0a, 0x93, 0xa2, 0x60, 0x2a, 0x32,
-	0x9b, 0x92, 0xe9, 0x70, 0xba, 0x29, 0x59, 0x62, 0xfb, 0xe8, 0x14, 0x5c, 0xc5, 0x8c, 0x77, 0x14,
-	0x90, 0x7f, 0xaa, 0xad, 0xeb, 0x86, 0x4b, 0x80, 0xc6, 0xc8, 0x3d, 0xe7, 0x74, 0x0c, 0x6d, 0x14,
-	0x0b, 0x69, 0x6d, 0x74, 0x6f, 0x2e, 0xc3, 0xc6, 0x73, 0xf8, 0x57, 0x5a, 0x62, 0xaf, 0xd1, 0x0b,
-	0xe8, 0x28, 0xa6, 0xab, 0xe2, 0x60, 0xa9, 0x97, 0x34, 0x54, 0xe7, 0x5c, 0xc9, 0xaa, 0xd4, 0x9e,
-	0x5b, 0x4b, 0x48, 0x1a, 0x8a, 0xfb, 0xdb, 0xbd, 0x4f, 0xde, 0xf7, 0x3e, 0xf9, 0xdc, 0xfb, 0xad,
-	0xb7, 0x2f, 0x9f, 0xcc, 0x3b, 0x76, 0x31, 0xb7, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x52, 0x21,
-	0x78, 0x20, 0xe3, 0x01, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xb1, 0x6e, 0x13, 0x4f,
+	0x10, 0xc6, 0xb3, 0x3e, 0x3b, 0xf1, 0x7f, 0x1c, 0xe5, 0x6f, 0x56, 0x08, 0x2d, 0x91, 0xb0, 0x2d,
+	0x57, 0x6e, 0x62, 0x4b, 0xd0, 0xd1, 0x61, 0x25, 0x05, 0x1d, 0x3a, 0x59, 0xb4, 0xd1, 0x7a, 0x6f,
+	0xbc, 0x19, 0xe5, 0x6e, 0xf7, 0xb4, 0xbb, 0x27, 0x6c, 0xe8, 0xa9, 0x79, 0x04, 0x1e, 0x27, 0x25,
+	0x35, 0x45, 0x84, 0xcc, 0x8b, 0xa0, 0xdb, 0x3b, 0x5b, 0x11, 0x35, 0xdd, 0xfc, 0xbe, 0x99, 0x9b,
+	0xef, 0xf4, 0xcd, 0xc2, 0xff, 0x1e, 0x1d, 0xc9, 0x9c, 0x3e, 0xe3, 0xbc, 0x74, 0x36, 0x58, 0x7e,
+	0x26, 0xb5, 0xc6, 0x2d, 0xaa, 0xcb, 0x2b, 0x4d, 0xe1, 0xae, 0x5a, 0xcf, 0x95, 0x2d, 0x16, 0xda,
+	0x6a, 0xbb, 0x88, 0xfd, 0x75, 0xb5, 0x89, 0x14, 0x21, 0x56, 0xcd, 0x77, 0xd3, 0x6f, 0x1d, 0x18,
+	0xde, 0x18, 0x65, 0x33, 0xcc, 0x96, 0xd2, 0x93, 0x7a, 0x6f, 0x36, 0x96, 0x5f, 0x40, 0x87, 0x32,
+	0xc1, 0x26, 0x6c, 0x96, 0xa4, 0x1d, 0xca, 0xf8, 0x18, 0x06, 0xe4, 0x6f, 0x33, 0xf2, 0x81, 0x8c,
+	0x0a, 0xa2, 0x33, 0x61, 0xb3, 0x7e, 0x0a, 0xe4, 0xaf, 0x5b, 0x85, 0xbf, 0x02, 0x30, 0x55, 0x9e,
+	0xdf, 0x62, 0x51, 0x86, 0x9d, 0x48, 0x62, 0xff, 0xbf, 0x5a, 0xb9, 0xa9, 0x05, 0xfe, 0x11, 0xba,
+	0xd2, 0x69, 0x2f, 0xba, 0x93, 0x64, 0x76, 0xbe, 0x5c, 0x3e, 0x3c, 0x8e, 0x4f, 0x7e, 0x3e, 0x8e,
+	0xdf, 0x3e, 0xf9, 0xd3, 0x42, 0x06, 0x47, 0x5b, 0xeb, 0x48, 0x93, 0x39, 0x80, 0xc1, 0x45, 0x79,
+	0xaf, 0x17, 0xca, 0x9a, 0x20, 0xc9, 0xa0, 0x5b, 0x84, 0x5d, 0x89, 0x7e, 0xbe, 0xda, 0x95, 0x98,
+	0xc6, 0x7d, 0x7c, 0x05, 0x89, 0xc3, 0x20, 0x7a, 0x13, 0xf6, 0x8f, 0xd6, 0xd6, 0xeb, 0xa6, 0xf7,
+	0x00, 0x6d, 0x22, 0xef, 0xb4, 0xe6, 0x57, 0xd0, 0x25, 0xb3, 0xb1, 0x31, 0x8d, 0xc1, 0xeb, 0x97,
+	0xf3, 0x36, 0xe7, 0xf9, 0xdf, 0xa1, 0xa5, 0x71, 0x8c, 0xbf, 0x80, 0x53, 0x87, 0xbe, 0xca, 0x9b,
+	0x94, 0xce, 0xd3, 0x96, 0x6a, 0x5d, 0x3b, 0x5b, 0x95, 0x5e, 0x24, 0x75, 0x08, 0x69, 0x4b, 0xd3,
+	0x2f, 0xf0, 0xac, 0xdd, 0xf4, 0x01, 0x9d, 0x42, 0x13, 0x28, 0x47, 0x7e, 0x09, 0xfd, 0xd2, 0x61,
+	0x29, 0x1d, 0x36, 0x57, 0xe8, 0xa7, 0x47, 0xae, 0x7b, 0x1b, 0x27, 0x55, 0x20, 0x6b, 0xa2, 0x05,
+	0x4b, 0x8f, 0xcc, 0x39, 0x74, 0x33, 0xf4, 0xaa, 0x3d, 0x40, 0xac, 0xeb, 0x79, 0xa9, 0x54, 0xe5,
+	0xa4, 0xda, 0x89, 0x6e, 0xbc, 0xe8, 0x91, 0xa7, 0x5f, 0x19, 0x5c, 0xb4, 0xee, 0xab, 0x6b, 0xd2,
+	0xe8, 0x03, 0x9f, 0xc0, 0x40, 0xd9, 0xa2, 0x74, 0xe8, 0x7d, 0xed, 0xc0, 0xa2, 0xc3, 0x53, 0x89,
+	0x0f, 0x21, 0x29, 0xe8, 0xe0, 0x5d, 0x97, 0x51, 0x91, 0x5b, 0x91, 0xb4, 0x8a, 0xdc, 0xf2, 0xe7,
+	0xd0, 0x2b, 0x50, 0x9a, 0xe6, 0xe2, 0x2c, 0x6d, 0x80, 0x0b, 0x38, 0xfb, 0x84, 0xa4, 0xef, 0x82,
+	0x17, 0xbd, 0xa8, 0x1f, 0x70, 0x39, 0x7c, 0xd8, 0x8f, 0xd8, 0x8f, 0xfd, 0x88, 0xfd, 0xda, 0x8f,
+	0x4e, 0xbe, 0xff, 0x1e, 0xb1, 0xf5, 0x69, 0x7c, 0x9e, 0x6f, 0xfe, 0x0c, 0x00, 0xbf, 0xe5, 0x54,
+	0xb5, 0xe9, 0x02, 0x00, 0x00,
 }
 
 func (m *EncodedBasicInfo) Marshal() (dAtA []byte, err error) {
@@ -316,6 +480,129 @@ func (m *EncodedAgg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EncodedPercentile) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodedPercentile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodedPercentile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Accuracy != 0 {
+		i = encodeVarintSerialize(dAtA, i, uint64(m.Accuracy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Desc {
+		i--
+		if m.Desc {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Fraction != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Fraction))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Prepared {
+		i--
+		if m.Prepared {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncodedTDigest) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodedTDigest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodedTDigest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.Weights[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
+		}
+		i = encodeVarintSerialize(dAtA, i, uint64(len(m.Weights)*8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Means) > 0 {
+		for iNdEx := len(m.Means) - 1; iNdEx >= 0; iNdEx-- {
+			f3 := math.Float64bits(float64(m.Means[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f3))
+		}
+		i = encodeVarintSerialize(dAtA, i, uint64(len(m.Means)*8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Max != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Max))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Min != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Min))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.Compression != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Compression))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSerialize(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSerialize(v)
 	base := offset
@@ -382,6 +669,57 @@ func (m *EncodedAgg) ProtoSize() (n int) {
 	return n
 }
 
+func (m *EncodedPercentile) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Prepared {
+		n += 2
+	}
+	if m.Fraction != 0 {
+		n += 9
+	}
+	if m.Desc {
+		n += 2
+	}
+	if m.Accuracy != 0 {
+		n += 1 + sovSerialize(uint64(m.Accuracy))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EncodedTDigest) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compression != 0 {
+		n += 9
+	}
+	if m.Min != 0 {
+		n += 9
+	}
+	if m.Max != 0 {
+		n += 9
+	}
+	if len(m.Means) > 0 {
+		n += 1 + sovSerialize(uint64(len(m.Means)*8)) + len(m.Means)*8
+	}
+	if len(m.Weights) > 0 {
+		n += 1 + sovSerialize(uint64(len(m.Weights)*8)) + len(m.Weights)*8
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSerialize(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -719,6 +1057,319 @@ func (m *EncodedAgg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EncodedPercentile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerialize
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncodedPercentile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncodedPercentile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepared", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prepared = bool(v != 0)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Fraction = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Desc = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accuracy", wireType)
+			}
+			m.Accuracy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSerialize
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Accuracy |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerialize(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSerialize
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncodedTDigest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSerialize
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncodedTDigest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncodedTDigest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Compression = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Min = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Max = float64(math.Float64frombits(v))
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Means = append(m.Means, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSerialize
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSerialize
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSerialize
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Means) == 0 {
+					m.Means = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Means = append(m.Means, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Means", wireType)
+			}
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Weights = append(m.Weights, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSerialize
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSerialize
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSerialize
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Weights = append(m.Weights, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSerialize(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSerialize
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSerialize(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
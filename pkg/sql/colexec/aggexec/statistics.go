@@ -0,0 +1,333 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// statisticsKind is the result computed by a statistics aggregation from the
+// moments of its arguments.
+type statisticsKind int
+
+const (
+	statisticsVarSamp statisticsKind = iota
+	statisticsStdDevSamp
+	statisticsCovarPop
+	statisticsCovarSamp
+	statisticsCorr
+	statisticsRegrSlope
+	statisticsRegrIntercept
+	statisticsRegrR2
+	statisticsRegrCount
+)
+
+// argCount returns the number of arguments of the statistics aggregation.
+func (kind statisticsKind) argCount() int {
+	if kind == statisticsVarSamp || kind == statisticsStdDevSamp {
+		return 1
+	}
+	return 2
+}
+
+var StatisticsSupportedTypes = []types.T{
+	types.T_bit, types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64, types.T_decimal64, types.T_decimal128,
+}
+
+func StatisticsReturnType(_ []types.Type) types.Type {
+	return types.T_float64.ToType()
+}
+
+func RegrCountReturnType(_ []types.Type) types.Type {
+	return types.T_int64.ToType()
+}
+
+// statisticsState is the moments of the rows of a group, it is updated row by
+// row with the Welford's algorithm and two states are merged with the Chan's
+// formula, which are both numerically stable.
+// y is the first argument and x the second one, as in REGR_SLOPE(y, x).
+// x is unused by the aggregations of one argument.
+type statisticsState struct {
+	n     float64
+	meanY float64
+	meanX float64
+	m2Y   float64 // sum of (y - meanY)^2
+	m2X   float64 // sum of (x - meanX)^2
+	cXY   float64 // sum of (y - meanY) * (x - meanX)
+}
+
+func (s *statisticsState) add(y, x float64) {
+	s.n++
+	dy, dx := y-s.meanY, x-s.meanX
+	s.meanY += dy / s.n
+	s.meanX += dx / s.n
+	s.m2Y += dy * (y - s.meanY)
+	s.m2X += dx * (x - s.meanX)
+	s.cXY += dy * (x - s.meanX)
+}
+
+func (s *statisticsState) merge(other *statisticsState) {
+	if other.n == 0 {
+		return
+	}
+	if s.n == 0 {
+		*s = *other
+		return
+	}
+	n := s.n + other.n
+	dy, dx := other.meanY-s.meanY, other.meanX-s.meanX
+	f := s.n * other.n / n
+	s.meanY += dy * other.n / n
+	s.meanX += dx * other.n / n
+	s.m2Y += other.m2Y + dy*dy*f
+	s.m2X += other.m2X + dx*dx*f
+	s.cXY += other.cXY + dy*dx*f
+	s.n = n
+}
+
+// result returns the result of the aggregation, and false if it is null.
+func (s *statisticsState) result(kind statisticsKind) (float64, bool) {
+	switch kind {
+	case statisticsRegrCount:
+		return s.n, true
+	case statisticsVarSamp, statisticsStdDevSamp, statisticsCovarSamp:
+		if s.n < 2 {
+			return 0, false
+		}
+	default:
+		if s.n < 1 {
+			return 0, false
+		}
+	}
+
+	switch kind {
+	case statisticsVarSamp:
+		return s.m2Y / (s.n - 1), true
+	case statisticsStdDevSamp:
+		return math.Sqrt(s.m2Y / (s.n - 1)), true
+	case statisticsCovarPop:
+		return s.cXY / s.n, true
+	case statisticsCovarSamp:
+		return s.cXY / (s.n - 1), true
+	case statisticsCorr:
+		if s.m2X == 0 || s.m2Y == 0 {
+			return 0, false
+		}
+		return s.cXY / math.Sqrt(s.m2X*s.m2Y), true
+	case statisticsRegrSlope:
+		if s.m2X == 0 {
+			return 0, false
+		}
+		return s.cXY / s.m2X, true
+	case statisticsRegrIntercept:
+		if s.m2X == 0 {
+			return 0, false
+		}
+		return s.meanY - s.cXY/s.m2X*s.meanX, true
+	case statisticsRegrR2:
+		if s.m2X == 0 {
+			return 0, false
+		}
+		// y is a horizontal line, which is fitted perfectly.
+		if s.m2Y == 0 {
+			return 1, true
+		}
+		return s.cXY * s.cXY / (s.m2X * s.m2Y), true
+	}
+	return 0, false
+}
+
+// statisticsExec is the executor of the aggregations computed from the
+// moments of their arguments: VAR_SAMP, STDDEV_SAMP, COVAR_POP, COVAR_SAMP,
+// CORR, REGR_SLOPE, REGR_INTERCEPT, REGR_R2 and REGR_COUNT.
+// The arguments were cast to float64 by the planner, and a row is ignored if
+// any of its arguments is null.
+type statisticsExec[R float64 | int64] struct {
+	multiAggInfo
+	kind statisticsKind
+	ret  aggFuncResult[R]
+
+	groups []statisticsState
+}
+
+func makeStatistics(
+	mg AggMemoryManager,
+	aggID int64, isDistinct bool,
+	param []types.Type, kind statisticsKind) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewNotSupportedNoCtx("statistics aggregation in distinct mode")
+	}
+	if len(param) != kind.argCount() {
+		return nil, moerr.NewInternalErrorNoCtx("statistics aggregation expects %d arguments but got %d", kind.argCount(), len(param))
+	}
+	for _, p := range param {
+		if p.Oid != types.T_float64 {
+			return nil, moerr.NewInternalErrorNoCtx("unsupported type %s for statistics aggregation", p.String())
+		}
+	}
+
+	info := multiAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argTypes:  param,
+		retType:   StatisticsReturnType(param),
+		emptyNull: true,
+	}
+	if kind == statisticsRegrCount {
+		info.retType = RegrCountReturnType(param)
+		info.emptyNull = false
+		return &statisticsExec[int64]{
+			multiAggInfo: info,
+			kind:         kind,
+			ret:          initFixedAggFuncResult[int64](mg, info.retType, info.emptyNull),
+		}, nil
+	}
+	return &statisticsExec[float64]{
+		multiAggInfo: info,
+		kind:         kind,
+		ret:          initFixedAggFuncResult[float64](mg, info.retType, info.emptyNull),
+	}, nil
+}
+
+func (exec *statisticsExec[R]) marshal() ([]byte, error) {
+	d := exec.multiAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: nil,
+	}
+	if len(exec.groups) > 0 {
+		encoded.Groups = make([][]byte, len(exec.groups))
+		for i := range encoded.Groups {
+			encoded.Groups[i] = types.EncodeSlice[statisticsState](exec.groups[i : i+1])
+		}
+	}
+	return encoded.Marshal()
+}
+
+func (exec *statisticsExec[R]) unmarshal(_ *mpool.MPool, result []byte, groups [][]byte) error {
+	exec.groups = make([]statisticsState, len(groups))
+	for i := range groups {
+		exec.groups[i] = types.DecodeSlice[statisticsState](groups[i])[0]
+	}
+	return exec.ret.unmarshal(result)
+}
+
+func (exec *statisticsExec[R]) GroupGrow(more int) error {
+	exec.groups = append(exec.groups, make([]statisticsState, more)...)
+	return exec.ret.grows(more)
+}
+
+func (exec *statisticsExec[R]) PreAllocateGroups(more int) error {
+	if cap(exec.groups)-len(exec.groups) < more {
+		groups := make([]statisticsState, len(exec.groups), len(exec.groups)+more)
+		copy(groups, exec.groups)
+		exec.groups = groups
+	}
+	return exec.ret.preAllocate(more)
+}
+
+// valueAt returns the arguments of the row, and false if any of them is null.
+func (exec *statisticsExec[R]) valueAt(row int, vectors []*vector.Vector) (y, x float64, ok bool) {
+	for i, vec := range vectors[:exec.kind.argCount()] {
+		r := row
+		if vec.IsConst() {
+			r = 0
+		}
+		if vec.IsNull(uint64(r)) {
+			return 0, 0, false
+		}
+		if i == 0 {
+			y = vector.MustFixedCol[float64](vec)[r]
+		} else {
+			x = vector.MustFixedCol[float64](vec)[r]
+		}
+	}
+	return y, x, true
+}
+
+func (exec *statisticsExec[R]) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	if y, x, ok := exec.valueAt(row, vectors); ok {
+		exec.groups[groupIndex].add(y, x)
+	}
+	return nil
+}
+
+func (exec *statisticsExec[R]) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	for i, j := 0, vectors[0].Length(); i < j; i++ {
+		if y, x, ok := exec.valueAt(i, vectors); ok {
+			exec.groups[groupIndex].add(y, x)
+		}
+	}
+	return nil
+}
+
+func (exec *statisticsExec[R]) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	for i, group := range groups {
+		if group == GroupNotMatched {
+			continue
+		}
+		if y, x, ok := exec.valueAt(offset+i, vectors); ok {
+			exec.groups[group-1].add(y, x)
+		}
+	}
+	return nil
+}
+
+func (exec *statisticsExec[R]) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	other := next.(*statisticsExec[R])
+	exec.groups[groupIdx1].merge(&other.groups[groupIdx2])
+	return nil
+}
+
+func (exec *statisticsExec[R]) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	other := next.(*statisticsExec[R])
+	for i, group := range groups {
+		if group != GroupNotMatched {
+			exec.groups[group-1].merge(&other.groups[offset+i])
+		}
+	}
+	return nil
+}
+
+func (exec *statisticsExec[R]) SetExtraInformation(partialResult any, groupIndex int) error {
+	return moerr.NewInternalErrorNoCtx("statistics aggregation do not support the extra information")
+}
+
+func (exec *statisticsExec[R]) Flush() (*vector.Vector, error) {
+	vs := exec.ret.values
+	for i := range exec.groups {
+		v, ok := exec.groups[i].result(exec.kind)
+		vs[i] = R(v)
+		exec.ret.empty[i] = !ok
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *statisticsExec[R]) Free() {
+	exec.ret.free()
+}
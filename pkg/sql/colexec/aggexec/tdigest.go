@@ -0,0 +1,386 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggexec
+
+import (
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const (
+	// the accuracy of the approx_percentile is the compression of its t-digest,
+	// a t-digest keeps about the compression centroids, a larger one is more
+	// accurate and uses more memory.
+	defaultApproxPercentileAccuracy = 100
+	minApproxPercentileAccuracy     = 10
+	maxApproxPercentileAccuracy     = 10000
+)
+
+func ApproxPercentileReturnType(_ []types.Type) types.Type {
+	return types.T_float64.ToType()
+}
+
+// tDigest is the merging t-digest of Ted Dunning, a sketch of a distribution
+// that estimates its quantiles with a small error, especially near the
+// tails. It is a list of centroids sorted by mean, the weight of a centroid
+// is limited by the k1 scale function so that the centroids near the tails
+// are small. Two t-digests are merged by merging their centroids.
+type tDigest struct {
+	compression float64
+	min         float64
+	max         float64
+	count       float64
+
+	means   []float64
+	weights []float64
+
+	// the centroids added but not merged yet.
+	bufMeans   []float64
+	bufWeights []float64
+}
+
+func newTDigest(compression float64) *tDigest {
+	return &tDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+func (d *tDigest) add(v float64) {
+	d.addCentroid(v, 1)
+}
+
+func (d *tDigest) addCentroid(mean, weight float64) {
+	d.bufMeans = append(d.bufMeans, mean)
+	d.bufWeights = append(d.bufWeights, weight)
+	d.count += weight
+	d.min = math.Min(d.min, mean)
+	d.max = math.Max(d.max, mean)
+	if len(d.bufMeans) >= int(5*d.compression) {
+		d.compress()
+	}
+}
+
+func (d *tDigest) merge(other *tDigest) {
+	if other.count == 0 {
+		return
+	}
+	other.compress()
+	for i := range other.means {
+		d.addCentroid(other.means[i], other.weights[i])
+	}
+	d.min = math.Min(d.min, other.min)
+	d.max = math.Max(d.max, other.max)
+}
+
+// compress merges the buffered centroids into the sorted centroids.
+func (d *tDigest) compress() {
+	if len(d.bufMeans) == 0 {
+		return
+	}
+	means := append(d.means, d.bufMeans...)
+	weights := append(d.weights, d.bufWeights...)
+	idx := make([]int, len(means))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(a, b int) bool {
+		return means[idx[a]] < means[idx[b]]
+	})
+
+	newMeans := make([]float64, 0, len(means))
+	newWeights := make([]float64, 0, len(means))
+	curMean, curWeight := means[idx[0]], weights[idx[0]]
+	weightSoFar := 0.0
+	qLimit := d.qLimit(0)
+	for _, j := range idx[1:] {
+		if (weightSoFar+curWeight+weights[j])/d.count <= qLimit {
+			curWeight += weights[j]
+			curMean += (means[j] - curMean) * weights[j] / curWeight
+			continue
+		}
+		weightSoFar += curWeight
+		newMeans = append(newMeans, curMean)
+		newWeights = append(newWeights, curWeight)
+		curMean, curWeight = means[j], weights[j]
+		qLimit = d.qLimit(weightSoFar / d.count)
+	}
+	d.means = append(newMeans, curMean)
+	d.weights = append(newWeights, curWeight)
+	d.bufMeans = d.bufMeans[:0]
+	d.bufWeights = d.bufWeights[:0]
+}
+
+// qLimit returns the largest quantile a centroid starting at the quantile q
+// can reach, the k1 scale k(q) = compression / 2π * asin(2q - 1) of the
+// centroid grows by 1 at most.
+func (d *tDigest) qLimit(q float64) float64 {
+	k := math.Asin(2*math.Min(q, 1)-1) + 2*math.Pi/d.compression
+	if k >= math.Pi/2 {
+		return 1
+	}
+	return (math.Sin(k) + 1) / 2
+}
+
+// quantile returns the estimated value at the quantile q, and false if the
+// t-digest is empty. The values between the centers of two adjacent
+// centroids are interpolated linearly.
+func (d *tDigest) quantile(q float64) (float64, bool) {
+	if d.count == 0 {
+		return 0, false
+	}
+	d.compress()
+	if len(d.means) == 1 {
+		return d.means[0], true
+	}
+
+	target := q * d.count
+	n := len(d.means)
+	// before the center of the first centroid
+	cum := d.weights[0] / 2
+	if target < cum {
+		return d.min + (d.means[0]-d.min)*target/cum, true
+	}
+	for i := 0; i < n-1; i++ {
+		next := cum + (d.weights[i]+d.weights[i+1])/2
+		if target <= next {
+			return d.means[i] + (d.means[i+1]-d.means[i])*(target-cum)/(next-cum), true
+		}
+		cum = next
+	}
+	// after the center of the last centroid
+	if d.count == cum {
+		return d.max, true
+	}
+	return d.means[n-1] + (d.max-d.means[n-1])*(target-cum)/(d.count-cum), true
+}
+
+func (d *tDigest) marshal() ([]byte, error) {
+	d.compress()
+	encoded := EncodedTDigest{
+		Compression: d.compression,
+		Min:         d.min,
+		Max:         d.max,
+		Means:       d.means,
+		Weights:     d.weights,
+	}
+	return encoded.Marshal()
+}
+
+func (d *tDigest) unmarshal(data []byte) error {
+	encoded := EncodedTDigest{}
+	if err := encoded.Unmarshal(data); err != nil {
+		return err
+	}
+	d.compression = encoded.Compression
+	d.min = encoded.Min
+	d.max = encoded.Max
+	d.means = encoded.Means
+	d.weights = encoded.Weights
+	d.count = 0
+	for _, w := range d.weights {
+		d.count += w
+	}
+	return nil
+}
+
+// approxPercentileExec is the executor of the APPROX_PERCENTILE(x, p[, accuracy]),
+// which estimates the percentile with a t-digest per group.
+type approxPercentileExec struct {
+	multiAggInfo
+	args EncodedPercentile
+	ret  aggFuncResult[float64]
+
+	groups []*tDigest
+}
+
+func makeApproxPercentile(
+	mg AggMemoryManager,
+	aggID int64, isDistinct bool,
+	param []types.Type) (AggFuncExec, error) {
+	if isDistinct {
+		return nil, moerr.NewNotSupportedNoCtx("approx_percentile in distinct mode")
+	}
+	if len(param) < 2 || len(param) > 3 || param[0].Oid != types.T_float64 {
+		return nil, moerr.NewInternalErrorNoCtx("unsupported arguments %v for approx_percentile", param)
+	}
+
+	info := multiAggInfo{
+		aggID:     aggID,
+		distinct:  false,
+		argTypes:  param,
+		retType:   ApproxPercentileReturnType(param),
+		emptyNull: true,
+	}
+	return &approxPercentileExec{
+		multiAggInfo: info,
+		ret:          initFixedAggFuncResult[float64](mg, info.retType, info.emptyNull),
+	}, nil
+}
+
+func (exec *approxPercentileExec) digestOf(groupIndex int) *tDigest {
+	if exec.groups[groupIndex] == nil {
+		exec.groups[groupIndex] = newTDigest(float64(exec.args.Accuracy))
+	}
+	return exec.groups[groupIndex]
+}
+
+func (exec *approxPercentileExec) marshal() ([]byte, error) {
+	d := exec.multiAggInfo.getEncoded()
+	r, err := exec.ret.marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	// the first group is the arguments, and an empty group is nil.
+	encoded := &EncodedAgg{
+		Info:   d,
+		Result: r,
+		Groups: make([][]byte, len(exec.groups)+1),
+	}
+	if encoded.Groups[0], err = exec.args.Marshal(); err != nil {
+		return nil, err
+	}
+	for i, digest := range exec.groups {
+		if digest == nil {
+			continue
+		}
+		if encoded.Groups[i+1], err = digest.marshal(); err != nil {
+			return nil, err
+		}
+	}
+	return encoded.Marshal()
+}
+
+func (exec *approxPercentileExec) unmarshal(_ *mpool.MPool, result []byte, groups [][]byte) error {
+	if len(groups) > 0 {
+		if err := exec.args.Unmarshal(groups[0]); err != nil {
+			return err
+		}
+		exec.groups = make([]*tDigest, len(groups)-1)
+		for i := range exec.groups {
+			if len(groups[i+1]) == 0 {
+				continue
+			}
+			exec.groups[i] = &tDigest{}
+			if err := exec.groups[i].unmarshal(groups[i+1]); err != nil {
+				return err
+			}
+		}
+	}
+	return exec.ret.unmarshal(result)
+}
+
+func (exec *approxPercentileExec) GroupGrow(more int) error {
+	exec.groups = append(exec.groups, make([]*tDigest, more)...)
+	return exec.ret.grows(more)
+}
+
+func (exec *approxPercentileExec) PreAllocateGroups(more int) error {
+	if cap(exec.groups)-len(exec.groups) < more {
+		groups := make([]*tDigest, len(exec.groups), len(exec.groups)+more)
+		copy(groups, exec.groups)
+		exec.groups = groups
+	}
+	return exec.ret.preAllocate(more)
+}
+
+func (exec *approxPercentileExec) Fill(groupIndex int, row int, vectors []*vector.Vector) error {
+	if err := preparePercentileArgs(&exec.args, vectors, true); err != nil {
+		return err
+	}
+	if vectors[0].IsNull(uint64(row)) {
+		return nil
+	}
+	if vectors[0].IsConst() {
+		row = 0
+	}
+	exec.digestOf(groupIndex).add(vector.MustFixedCol[float64](vectors[0])[row])
+	return nil
+}
+
+func (exec *approxPercentileExec) BulkFill(groupIndex int, vectors []*vector.Vector) error {
+	for i, j := 0, vectors[0].Length(); i < j; i++ {
+		if err := exec.Fill(groupIndex, i, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *approxPercentileExec) BatchFill(offset int, groups []uint64, vectors []*vector.Vector) error {
+	for i, group := range groups {
+		if group == GroupNotMatched {
+			continue
+		}
+		if err := exec.Fill(int(group-1), offset+i, vectors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exec *approxPercentileExec) Merge(next AggFuncExec, groupIdx1, groupIdx2 int) error {
+	other := next.(*approxPercentileExec)
+	if !exec.args.Prepared {
+		exec.args = other.args
+	}
+	if digest := other.groups[groupIdx2]; digest != nil {
+		if exec.groups[groupIdx1] == nil {
+			exec.groups[groupIdx1] = newTDigest(digest.compression)
+		}
+		exec.groups[groupIdx1].merge(digest)
+	}
+	return nil
+}
+
+func (exec *approxPercentileExec) BatchMerge(next AggFuncExec, offset int, groups []uint64) error {
+	for i, group := range groups {
+		if group != GroupNotMatched {
+			if err := exec.Merge(next, int(group-1), offset+i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (exec *approxPercentileExec) SetExtraInformation(partialResult any, groupIndex int) error {
+	return moerr.NewInternalErrorNoCtx("approx_percentile do not support the extra information")
+}
+
+func (exec *approxPercentileExec) Flush() (*vector.Vector, error) {
+	vs := exec.ret.values
+	for i, digest := range exec.groups {
+		if digest == nil {
+			continue
+		}
+		if v, ok := digest.quantile(exec.args.Fraction); ok {
+			vs[i] = v
+			exec.ret.empty[i] = false
+		}
+	}
+	return exec.ret.flush(), nil
+}
+
+func (exec *approxPercentileExec) Free() {
+	exec.ret.free()
+}
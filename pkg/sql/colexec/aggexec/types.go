@@ -119,6 +119,11 @@ var (
 	_ AggFuncExec = (*multiAggFuncExec1[int8])(nil)
 	_ AggFuncExec = (*multiAggFuncExec2)(nil)
 	_ AggFuncExec = &groupConcatExec{}
+	_ AggFuncExec = (*statisticsExec[float64])(nil)
+	_ AggFuncExec = (*percentileExec[float64])(nil)
+	_ AggFuncExec = (*approxPercentileExec)(nil)
+	_ AggFuncExec = (*jsonArrayAggExec)(nil)
+	_ AggFuncExec = (*jsonObjectAggExec)(nil)
 )
 
 var (
@@ -252,6 +257,18 @@ func makeSpecialAggExec(
 		case aggIdOfMedian:
 			exec, err := makeMedian(mg, id, isDistinct, params[0])
 			return exec, true, err
+		case aggIdOfPercentileCont, aggIdOfPercentileDisc:
+			exec, err := makePercentile(mg, id, isDistinct, params, id == aggIdOfPercentileCont)
+			return exec, true, err
+		case aggIdOfApproxPercentile:
+			exec, err := makeApproxPercentile(mg, id, isDistinct, params)
+			return exec, true, err
+		case aggIdOfJsonArrayAgg:
+			exec, err := makeJsonArrayAgg(mg, id, isDistinct, params)
+			return exec, true, err
+		case aggIdOfJsonObjectAgg:
+			exec, err := makeJsonObjectAgg(mg, id, isDistinct, params)
+			return exec, true, err
 		case aggIdOfGroupConcat:
 			return makeGroupConcat(mg, id, isDistinct, params, getCroupConcatRet(params...), groupConcatSep), true, nil
 		case aggIdOfApproxCount:
@@ -263,6 +280,10 @@ func makeSpecialAggExec(
 			exec, err := makeWindowExec(mg, id, isDistinct)
 			return exec, true, err
 		}
+		if kind, ok := statisticsAgg[id]; ok {
+			exec, err := makeStatistics(mg, id, isDistinct, params, kind)
+			return exec, true, err
+		}
 	}
 	return nil, false, nil
 }
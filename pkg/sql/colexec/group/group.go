@@ -87,6 +87,10 @@ func (group *Group) Prepare(proc *process.Process) (err error) {
 		for _, gv := range group.Exprs {
 			ctr.groupVecsNullable = ctr.groupVecsNullable || (!gv.Typ.NotNullable)
 		}
+		// a column is null in the grouping sets without it.
+		if len(group.GroupingIds) > 0 {
+			ctr.groupVecsNullable = true
+		}
 
 		for _, expr := range group.Exprs {
 			typ := expr.Typ
@@ -177,6 +181,13 @@ func (ctr *container) processGroupByAndAgg(ap *Group, proc *process.Process, ana
 					if err = ctr.aggWithoutGroupByCannotEmptySet(proc, ap); err != nil {
 						return result, err
 					}
+					// some groups were spilled, spill the rest too and merge
+					// them back one partition by one.
+					if ctr.spilling != nil {
+						if err = ctr.finishSpill(proc, ap); err != nil {
+							return result, err
+						}
+					}
 					ctr.state = vm.Eval
 					break
 				}
@@ -195,26 +206,34 @@ func (ctr *container) processGroupByAndAgg(ap *Group, proc *process.Process, ana
 					// no group-by clause.
 					err = ctr.processH0()
 
+				} else if len(ap.GroupingIds) > 0 {
+					// with grouping sets
+					err = ctr.processGroupingSets(bat, proc, ap)
+
 				} else {
 					// with group-by clause
-					switch ctr.typ {
-					case H8:
-						err = ctr.processH8(bat, proc)
-					case HStr:
-						err = ctr.processHStr(bat, proc)
-					default:
-						err = moerr.NewInternalError(proc.Ctx, "unexpected hashmap typ for group-operator.")
-					}
+					err = ctr.processGroupBy(bat, proc)
 				}
 
 				if err != nil {
 					return result, err
 				}
+
+				if ctr.canSpill(ap) && proc.NeedSpill(ctr.size()) {
+					if err = ctr.spillGroups(proc, ap); err != nil {
+						return result, err
+					}
+				}
 			}
 
 		// return the result one by one. todo: I have not modify that, we send result as a big batch now.
 		// if NeedEval the agg, we should flush the agg first.
 		case vm.Eval:
+			if len(ctr.spilled) > 0 {
+				if err := ctr.mergePartition(proc, ap); err != nil {
+					return vm.NewCallResult(), err
+				}
+			}
 			// the result was empty.
 			if ctr.bat == nil || ctr.bat.IsEmpty() {
 				ctr.state = vm.End
@@ -238,7 +257,9 @@ func (ctr *container) processGroupByAndAgg(ap *Group, proc *process.Process, ana
 			result.Batch = ctr.bat
 
 			ctr.bat = nil
-			ctr.state = vm.End
+			if len(ctr.spilled) == 0 {
+				ctr.state = vm.End
+			}
 			return result, nil
 
 		// send an End-Message to tell the next operator all were done.
@@ -253,18 +274,11 @@ func (ctr *container) processGroupByAndAgg(ap *Group, proc *process.Process, ana
 }
 
 func (ctr *container) generateAggStructures(proc *process.Process, group *Group) error {
-
-	for i, ag := range group.Aggs {
-		ctr.bat.Aggs[i] = aggexec.MakeAgg(
-			proc,
-			ag.GetAggID(), ag.IsDistinct(), ctr.aggVecs[i].Typ...)
-
-		if config := ag.GetExtraConfig(); config != nil {
-			if err := ctr.bat.Aggs[i].SetExtraInformation(config, 0); err != nil {
-				return err
-			}
-		}
+	aggs, err := ctr.newAggs(proc, group)
+	if err != nil {
+		return err
 	}
+	copy(ctr.bat.Aggs, aggs)
 
 	if preAllocate := int(group.PreAllocSize); preAllocate > 0 {
 		for _, ag := range ctr.bat.Aggs {
@@ -276,6 +290,26 @@ func (ctr *container) generateAggStructures(proc *process.Process, group *Group)
 	return nil
 }
 
+// newAggs makes a new set of the aggregation executors of the operator.
+func (ctr *container) newAggs(proc *process.Process, group *Group) ([]aggexec.AggFuncExec, error) {
+	aggs := make([]aggexec.AggFuncExec, len(group.Aggs))
+	for i, ag := range group.Aggs {
+		aggs[i] = aggexec.MakeAgg(
+			proc,
+			ag.GetAggID(), ag.IsDistinct(), ctr.aggVecs[i].Typ...)
+
+		if config := ag.GetExtraConfig(); config != nil {
+			if err := aggs[i].SetExtraInformation(config, 0); err != nil {
+				for _, agg := range aggs[:i+1] {
+					agg.Free()
+				}
+				return nil, err
+			}
+		}
+	}
+	return aggs, nil
+}
+
 // processH8 use whole batch to fill the aggregation.
 func (ctr *container) processH0() error {
 	ctr.bat.SetRowCount(1)
@@ -290,6 +324,63 @@ func (ctr *container) processH0() error {
 	return nil
 }
 
+func (ctr *container) processGroupBy(bat *batch.Batch, proc *process.Process) error {
+	switch ctr.typ {
+	case H8:
+		return ctr.processH8(bat, proc)
+	case HStr:
+		return ctr.processHStr(bat, proc)
+	default:
+		return moerr.NewInternalError(proc.Ctx, "unexpected hashmap typ for group-operator.")
+	}
+}
+
+// processGroupingSets groups the batch once per grouping set, the columns
+// absent from a set are replaced by nulls and the last column by the
+// grouping id of the set.
+func (ctr *container) processGroupingSets(bat *batch.Batch, proc *process.Process, ap *Group) error {
+	vecs := ctr.groupVecs.Vec
+	last := len(vecs) - 1
+	if ctr.setVecs == nil {
+		ctr.setVecs = make([]*vector.Vector, len(vecs))
+		ctr.nullVecs = make([]*vector.Vector, last)
+		for i := range ctr.nullVecs {
+			ctr.nullVecs[i] = vector.NewConstNull(*vecs[i].GetType(), 0, proc.Mp())
+		}
+		ctr.gidVecs = make([]*vector.Vector, len(ap.GroupingIds))
+		for i, gid := range ap.GroupingIds {
+			vec, err := vector.NewConstFixed(types.T_int64.ToType(), int64(gid), 1, proc.Mp())
+			if err != nil {
+				return err
+			}
+			ctr.gidVecs[i] = vec
+		}
+	}
+
+	defer func() {
+		ctr.groupVecs.Vec = vecs
+	}()
+	count := bat.RowCount()
+	for i, gid := range ap.GroupingIds {
+		for j := range ctr.nullVecs {
+			if gid&(1<<j) != 0 {
+				ctr.nullVecs[j].SetLength(count)
+				ctr.setVecs[j] = ctr.nullVecs[j]
+			} else {
+				ctr.setVecs[j] = vecs[j]
+			}
+		}
+		ctr.gidVecs[i].SetLength(count)
+		ctr.setVecs[last] = ctr.gidVecs[i]
+
+		ctr.groupVecs.Vec = ctr.setVecs
+		if err := ctr.processGroupBy(bat, proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // processH8 do group by aggregation with int hashmap.
 func (ctr *container) processH8(bat *batch.Batch, proc *process.Process) error {
 	count := bat.RowCount()
@@ -432,7 +523,11 @@ func (ctr *container) initResultAndHashTable(proc *process.Process, config *Grou
 	// init the batch.
 	ctr.bat = batch.NewWithSize(len(ctr.groupVecs.Vec))
 	for i, vec := range ctr.groupVecs.Vec {
-		ctr.bat.Vecs[i] = proc.GetVector(*vec.GetType())
+		typ := *vec.GetType()
+		if len(config.GroupingIds) > 0 {
+			typ.SetNotNull(false)
+		}
+		ctr.bat.Vecs[i] = proc.GetVector(typ)
 	}
 	if config.PreAllocSize > 0 {
 		if err = ctr.bat.PreExtend(proc.Mp(), int(config.PreAllocSize)); err != nil {
@@ -453,6 +548,10 @@ func (ctr *container) initResultAndHashTable(proc *process.Process, config *Grou
 	}
 
 	// init the hashmap.
+	return ctr.initHashTable(proc, config)
+}
+
+func (ctr *container) initHashTable(proc *process.Process, config *Group) (err error) {
 	switch {
 	case ctr.keyWidth <= 8:
 		if ctr.intHashMap, err = hashmap.NewIntHashMap(ctr.groupVecsNullable, proc.Mp()); err != nil {
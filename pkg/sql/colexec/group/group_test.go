@@ -28,6 +28,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -147,6 +148,109 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupSpill(t *testing.T) {
+	const groups = 1000
+	ts := []types.Type{types.T_int64.ToType(), types.T_int64.ToType()}
+	tc := newTestCase([]bool{false, false}, ts, []int{0}, 1)
+	tc.arg.NeedEval = true
+	// the groups are over the memory threshold after every batch.
+	tc.proc.Base.Lim.Size = 1
+	require.NoError(t, tc.arg.Prepare(tc.proc))
+
+	newSpillBatch := func() *batch.Batch {
+		keys, vals := make([]int64, groups), make([]int64, groups)
+		for i := range keys {
+			keys[i], vals[i] = int64(i), 1
+		}
+		return testutil.NewBatchWithVectors([]*vector.Vector{
+			testutil.NewVector(groups, ts[0], tc.proc.Mp(), false, keys),
+			testutil.NewVector(groups, ts[1], tc.proc.Mp(), false, vals),
+		}, nil)
+	}
+	resetChildren(tc.arg, []*batch.Batch{newSpillBatch(), newSpillBatch(), newSpillBatch(), nil})
+
+	seen := make(map[int64]bool, groups)
+	for {
+		result, err := tc.arg.Call(tc.proc)
+		require.NoError(t, err)
+		if result.Batch == nil {
+			break
+		}
+		keys := vector.MustFixedCol[int64](result.Batch.Vecs[0])
+		sums := vector.MustFixedCol[int64](result.Batch.Vecs[1])
+		for i, key := range keys {
+			require.False(t, seen[key])
+			require.Equal(t, int64(3), sums[i])
+			seen[key] = true
+		}
+		result.Batch.Clean(tc.proc.Mp())
+	}
+	require.Equal(t, groups, len(seen))
+	// every partition was over the threshold too, and was split again until
+	// the last level.
+	require.Equal(t, maxSpillLevel, tc.arg.ctr.spillLevel)
+	require.Empty(t, tc.arg.ctr.spilled)
+
+	tc.arg.Free(tc.proc, false, nil)
+	tc.arg.GetChildren(0).Free(tc.proc, false, nil)
+	tc.proc.Free()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
+func TestGroupingSets(t *testing.T) {
+	ts := []types.Type{types.T_int64.ToType(), types.T_int64.ToType(), types.T_int64.ToType()}
+	tc := newTestCase([]bool{false, false, false}, ts, []int{0, 1}, 2)
+	tc.arg.NeedEval = true
+	// rollup(a, b), the last group by column is the grouping id.
+	tc.arg.Exprs = append(tc.arg.Exprs, &plan.Expr{
+		Typ:  plan.Type{Id: int32(types.T_int64), NotNullable: true},
+		Expr: &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: 0}}},
+	})
+	tc.arg.GroupingIds = []uint64{0, 2, 3}
+	require.NoError(t, tc.arg.Prepare(tc.proc))
+
+	newSetsBatch := func() *batch.Batch {
+		as, bs, vs := make([]int64, 6), make([]int64, 6), make([]int64, 6)
+		for i := range as {
+			as[i], bs[i], vs[i] = int64(i%2), int64(i%3), 1
+		}
+		return testutil.NewBatchWithVectors([]*vector.Vector{
+			testutil.NewVector(6, ts[0], tc.proc.Mp(), false, as),
+			testutil.NewVector(6, ts[1], tc.proc.Mp(), false, bs),
+			testutil.NewVector(6, ts[2], tc.proc.Mp(), false, vs),
+		}, nil)
+	}
+	resetChildren(tc.arg, []*batch.Batch{newSetsBatch(), newSetsBatch(), nil})
+
+	// the number of groups and the sum of each group by grouping id
+	groups := make(map[int64]int)
+	sums := make(map[int64]int64)
+	for {
+		result, err := tc.arg.Call(tc.proc)
+		require.NoError(t, err)
+		if result.Batch == nil {
+			break
+		}
+		bat := result.Batch
+		gids := vector.MustFixedCol[int64](bat.Vecs[2])
+		vals := vector.MustFixedCol[int64](bat.Vecs[3])
+		for i, gid := range gids {
+			require.Equal(t, gid&1 != 0, bat.Vecs[0].IsNull(uint64(i)))
+			require.Equal(t, gid&2 != 0, bat.Vecs[1].IsNull(uint64(i)))
+			groups[gid]++
+			sums[gid] += vals[i]
+		}
+		result.Batch.Clean(tc.proc.Mp())
+	}
+	require.Equal(t, map[int64]int{0: 6, 2: 2, 3: 1}, groups)
+	require.Equal(t, map[int64]int64{0: 12, 2: 12, 3: 12}, sums)
+
+	tc.arg.Free(tc.proc, false, nil)
+	tc.arg.GetChildren(0).Free(tc.proc, false, nil)
+	tc.proc.Free()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []groupTestCase{
@@ -0,0 +1,262 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"runtime"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
+)
+
+// maxSpillLevel is the number of times the groups can be split, a partition
+// at the last level is merged in memory however large it is.
+const maxSpillLevel = 3
+
+// canSpill returns true if the groups can be partitioned and spilled to disk.
+// the distinct aggregations keep one distinct hash set for all the groups, so
+// they can not be split into partitions.
+func (ctr *container) canSpill(ap *Group) bool {
+	return len(ap.Exprs) > 0 && !ap.AnyDistinctAgg()
+}
+
+// size returns the bytes held by the group-by columns and the hash table.
+func (ctr *container) size() int64 {
+	var size int64
+	if ctr.bat != nil {
+		size += int64(ctr.bat.Size())
+	}
+	if ctr.intHashMap != nil {
+		size += ctr.intHashMap.Size()
+	}
+	if ctr.strHashMap != nil {
+		size += ctr.strHashMap.Size()
+	}
+	return size
+}
+
+// spillGroups splits the groups built so far into partitions by the hash of
+// the group-by columns at spillLevel, writes every partition with its partial
+// aggregation results to disk, and starts over with an empty hash table.
+func (ctr *container) spillGroups(proc *process.Process, ap *Group) error {
+	if ctr.spilling == nil {
+		ctr.spilling = make([][]*spill.File, spill.Partitions)
+	}
+	if ctr.bat == nil || ctr.bat.RowCount() == 0 {
+		return nil
+	}
+
+	rows := ctr.bat.RowCount()
+	sels := spill.PartitionSels(ctr.bat.Vecs, rows, make([][]int32, spill.Partitions), ctr.spillLevel, false)
+	groups := make([]uint64, rows)
+	for i, sel := range sels {
+		if len(sel) == 0 {
+			continue
+		}
+		bat, err := ctr.partitionBatch(proc, ap, sel, groups)
+		if err != nil {
+			return err
+		}
+		f, err := proc.Spill([]*batch.Batch{bat})
+		bat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+		ctr.spilling[i] = append(ctr.spilling[i], f)
+	}
+
+	ctr.cleanBatch(proc.Mp())
+	ctr.cleanHashMap()
+	return nil
+}
+
+// finishSpill spills the rest of the groups, and queues the partitions to be
+// merged one by one.
+func (ctr *container) finishSpill(proc *process.Process, ap *Group) error {
+	if err := ctr.spillGroups(proc, ap); err != nil {
+		return err
+	}
+	for i, files := range ctr.spilling {
+		if len(files) > 0 {
+			ctr.spilled = append(ctr.spilled, spilledPartition{level: ctr.spillLevel, files: files})
+		}
+		ctr.spilling[i] = nil
+	}
+	ctr.spilling = nil
+	return nil
+}
+
+// partitionBatch copies the groups at sel into a new batch with the partial
+// aggregation results.
+func (ctr *container) partitionBatch(proc *process.Process, ap *Group, sel []int32, groups []uint64) (*batch.Batch, error) {
+	var err error
+
+	bat := batch.NewWithSize(len(ctr.bat.Vecs))
+	for i, vec := range ctr.bat.Vecs {
+		bat.Vecs[i] = vector.NewVec(*vec.GetType())
+		if err = bat.Vecs[i].UnionInt32(vec, sel, proc.Mp()); err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	bat.SetRowCount(len(sel))
+
+	clear(groups)
+	for i, row := range sel {
+		groups[row] = uint64(i + 1)
+	}
+	if bat.Aggs, err = ctr.newAggs(proc, ap); err != nil {
+		bat.Clean(proc.Mp())
+		return nil, err
+	}
+	for i, agg := range bat.Aggs {
+		if err = agg.GroupGrow(len(sel)); err == nil {
+			err = agg.BatchMerge(ctr.bat.Aggs[i], 0, groups)
+		}
+		if err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
+// mergePartition merges the spilled batches of the next non-empty partition
+// into ctr.bat, ctr.bat is nil if all the partitions were done. A partition
+// over the memory budget is split again at the next level and its
+// sub-partitions are queued, until maxSpillLevel.
+func (ctr *container) mergePartition(proc *process.Process, ap *Group) error {
+	for len(ctr.spilled) > 0 {
+		p := ctr.spilled[0]
+		ctr.spillLevel = p.level + 1
+		repartition := ctr.canSpill(ap) && ctr.spillLevel < maxSpillLevel
+
+		ctr.cleanHashMap()
+		if err := ctr.initHashTable(proc, ap); err != nil {
+			return err
+		}
+		for _, f := range p.files {
+			for i := 0; i < f.Len(); i++ {
+				bat, err := f.Read(proc.Ctx, i, proc.Mp())
+				if err != nil {
+					return err
+				}
+				if err = ctr.mergeBatch(proc, bat); err != nil {
+					bat.Clean(proc.Mp())
+					return err
+				}
+				if repartition && proc.NeedSpill(ctr.size()) {
+					if err = ctr.spillGroups(proc, ap); err != nil {
+						return err
+					}
+					if err = ctr.initHashTable(proc, ap); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		if ctr.spilling != nil {
+			if err := ctr.finishSpill(proc, ap); err != nil {
+				return err
+			}
+		}
+		// the partition is done, its files are deleted only now so that they
+		// are cleaned with the container on any error above.
+		ctr.spilled = ctr.spilled[1:]
+		if err := spill.DeleteAll(proc.Ctx, p.files); err != nil {
+			return err
+		}
+		if ctr.bat != nil && ctr.bat.RowCount() > 0 {
+			return nil
+		}
+	}
+	return nil
+}
+
+// mergeBatch merges a spilled batch of partial results into ctr.bat.
+// the first batch of a partition is taken as ctr.bat directly.
+func (ctr *container) mergeBatch(proc *process.Process, bat *batch.Batch) error {
+	first := ctr.bat == nil
+	count := bat.RowCount()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		if i%(hashmap.UnitLimit*32) == 0 {
+			runtime.Gosched()
+		}
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+
+		var rows uint64
+		var vals []uint64
+		var err error
+		if ctr.intHashMap != nil {
+			rows = ctr.intHashMap.GroupCount()
+			vals, _, err = ctr.intHashMap.NewIterator().Insert(i, n, bat.Vecs)
+		} else {
+			rows = ctr.strHashMap.GroupCount()
+			vals, _, err = ctr.strHashMap.NewIterator().Insert(i, n, bat.Vecs)
+		}
+		if err != nil {
+			return err
+		}
+		if !first {
+			if err = ctr.mergeFill(i, n, bat, vals, rows, proc); err != nil {
+				return err
+			}
+		}
+	}
+	if first {
+		ctr.bat = bat
+	} else {
+		bat.Clean(proc.Mp())
+	}
+	return nil
+}
+
+func (ctr *container) mergeFill(i int, n int, bat *batch.Batch, vals []uint64, hashRows uint64, proc *process.Process) error {
+	cnt := 0
+	copy(ctr.inserted[:n], ctr.zInserted[:n])
+	for k, v := range vals[:n] {
+		if v > hashRows {
+			ctr.inserted[k] = 1
+			hashRows++
+			cnt++
+		}
+	}
+	ctr.bat.AddRowCount(cnt)
+
+	if cnt > 0 {
+		for j, vec := range ctr.bat.Vecs {
+			if err := vec.UnionBatch(bat.Vecs[j], int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
+				return err
+			}
+		}
+		for _, agg := range ctr.bat.Aggs {
+			if err := agg.GroupGrow(cnt); err != nil {
+				return err
+			}
+		}
+	}
+	for j, agg := range ctr.bat.Aggs {
+		if err := agg.BatchMerge(bat.Aggs[j], i, vals[:n]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggexec"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 var _ vm.Operator = new(Group)
@@ -86,7 +87,28 @@ type container struct {
 	keyWidth          int
 	groupVecsNullable bool
 
+	// setVecs are the group by columns of a grouping set, nullVecs and
+	// gidVecs are the columns absent from the set and the grouping ids.
+	setVecs  []*vector.Vector
+	nullVecs []*vector.Vector
+	gidVecs  []*vector.Vector
+
 	bat *batch.Batch
+
+	// spilling[i] are the files of the i-th partition of the groups being
+	// spilled at spillLevel, they are written once the hash table is over
+	// the memory budget.
+	spilling   [][]*spill.File
+	spillLevel int
+	// spilled are the partitions waiting to be merged and sent.
+	spilled []spilledPartition
+}
+
+// spilledPartition is a partition of the groups spilled at level, it is split
+// again at the next level if it does not fit in memory either.
+type spilledPartition struct {
+	level int
+	files []*spill.File
 }
 
 type Group struct {
@@ -98,6 +120,10 @@ type Group struct {
 	Exprs []*plan.Expr // group Expressions
 	Types []types.Type
 	Aggs  []aggexec.AggFuncExecExpression
+	// GroupingIds are the grouping sets, the bit i of an id is set if the
+	// group by column i is absent from the set. The last group by column is
+	// the grouping id.
+	GroupingIds []uint64
 
 	vm.OperatorBase
 	colexec.Projection
@@ -147,6 +173,11 @@ func (group *Group) WithTypes(types []types.Type) *Group {
 	return group
 }
 
+func (group *Group) WithGroupingIds(groupingIds []uint64) *Group {
+	group.GroupingIds = groupingIds
+	return group
+}
+
 func (group *Group) WithAggsNew(aggs []aggexec.AggFuncExecExpression) *Group {
 	group.Aggs = aggs
 	return group
@@ -170,6 +201,8 @@ func (group *Group) Free(proc *process.Process, pipelineFailed bool, err error)
 		ctr.cleanHashMap()
 		ctr.cleanAggVectors()
 		ctr.cleanGroupVectors()
+		ctr.cleanGroupingVectors(mp)
+		ctr.cleanSpilled()
 		group.ctr = nil
 	}
 	if group.ProjectList != nil {
@@ -197,6 +230,26 @@ func (ctr *container) cleanGroupVectors() {
 	ctr.groupVecs.Free()
 }
 
+func (ctr *container) cleanGroupingVectors(mp *mpool.MPool) {
+	for _, vec := range ctr.nullVecs {
+		vec.Free(mp)
+	}
+	for _, vec := range ctr.gidVecs {
+		vec.Free(mp)
+	}
+	ctr.setVecs, ctr.nullVecs, ctr.gidVecs = nil, nil, nil
+}
+
+func (ctr *container) cleanSpilled() {
+	for _, files := range ctr.spilling {
+		spill.Cleanup(files...)
+	}
+	for _, p := range ctr.spilled {
+		spill.Cleanup(p.files...)
+	}
+	ctr.spilling, ctr.spilled = nil, nil
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.intHashMap != nil {
 		ctr.intHashMap.Free()
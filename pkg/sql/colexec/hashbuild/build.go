@@ -113,7 +113,12 @@ func (hashBuild *HashBuild) Call(proc *process.Process) (vm.CallResult, error) {
 
 		case SendJoinMap:
 			var jm *message.JoinMap
-			if ctr.inputBatchRowCount > 0 {
+			if ctr.spilled != nil {
+				jm = message.NewSpilledJoinMap(ctr.spilled, proc.Mp())
+				jm.SetRowCount(int64(ctr.inputBatchRowCount))
+				jm.IncRef(ap.JoinMapRefCnt)
+				ctr.spilled = nil
+			} else if ctr.inputBatchRowCount > 0 {
 				jm = message.NewJoinMap(ctr.multiSels, ctr.intHashMap, ctr.strHashMap, ctr.batches, proc.Mp())
 				jm.SetPushedRuntimeFilterIn(ctr.runtimeFilterIn)
 				if ap.NeedBatches {
@@ -178,15 +183,26 @@ func (ctr *container) collectBuildBatches(hashBuild *HashBuild, proc *process.Pr
 		anal.Input(currentBatch, isFirst)
 		anal.Alloc(int64(currentBatch.Size()))
 		ctr.inputBatchRowCount += currentBatch.RowCount()
+		ctr.size += int64(currentBatch.Size())
 		err = ctr.mergeIntoBatches(currentBatch, proc)
 		if err != nil {
 			return err
 		}
+		// the build side is too big to hold, write it to disk as partitions
+		// and let the join work on them one by one.
+		if hashBuild.CanSpill && proc.NeedSpill(ctr.size) {
+			if err = ctr.spillBatches(proc); err != nil {
+				return err
+			}
+		}
 	}
 	if ctr.tmpBatch != nil && ctr.tmpBatch.RowCount() > 0 {
 		ctr.batches = append(ctr.batches, ctr.tmpBatch)
 		ctr.tmpBatch = nil
 	}
+	if ctr.spilled != nil {
+		return ctr.spillBatches(proc)
+	}
 	return nil
 }
 
@@ -328,6 +344,9 @@ func (ctr *container) build(ap *HashBuild, proc *process.Process, anal process.A
 	if err != nil {
 		return err
 	}
+	if ctr.spilled != nil {
+		return nil
+	}
 	err = ctr.buildHashmap(ap, proc)
 	if err != nil {
 		return err
@@ -350,7 +369,8 @@ func (ctr *container) handleRuntimeFilter(ap *HashBuild, proc *process.Process)
 	var runtimeFilter message.RuntimeFilterMessage
 	runtimeFilter.Tag = ap.RuntimeFilterSpec.Tag
 
-	if ap.RuntimeFilterSpec.Expr == nil {
+	// there is no hash table to build the filter from if the build side was spilled.
+	if ap.RuntimeFilterSpec.Expr == nil || ctr.spilled != nil {
 		runtimeFilter.Typ = message.RuntimeFilter_PASS
 		message.SendRuntimeFilter(runtimeFilter, ap.RuntimeFilterSpec, proc.GetMessageBoard())
 		return nil
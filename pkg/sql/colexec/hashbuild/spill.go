@@ -0,0 +1,60 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashbuild
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
+)
+
+// spillBatches splits the build batches collected so far into partitions by
+// the hash of the join keys and writes every partition to disk. the rows with
+// a null key are dropped because they never match.
+func (ctr *container) spillBatches(proc *process.Process) error {
+	if ctr.spilled == nil {
+		ctr.spilled = make([][]*spill.File, spill.Partitions)
+	}
+	if ctr.tmpBatch != nil {
+		ctr.batches = append(ctr.batches, ctr.tmpBatch)
+		ctr.tmpBatch = nil
+	}
+
+	keys := make([]*vector.Vector, len(ctr.executor))
+	files, err := proc.SpillPartitions(ctr.batches, func(bat *batch.Batch) ([]*vector.Vector, error) {
+		for i := range ctr.executor {
+			vec, err := ctr.executor[i].Eval(proc, []*batch.Batch{bat}, nil)
+			if err != nil {
+				return nil, err
+			}
+			keys[i] = vec
+		}
+		return keys, nil
+	}, true)
+	if err != nil {
+		return err
+	}
+	for i, f := range files {
+		ctr.spilled[i] = append(ctr.spilled[i], f)
+	}
+
+	for _, bat := range ctr.batches {
+		proc.PutBatch(bat)
+	}
+	ctr.batches = ctr.batches[:0]
+	ctr.size = 0
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 var _ vm.Operator = new(HashBuild)
@@ -44,6 +45,11 @@ type container struct {
 	batches            []*batch.Batch
 	inputBatchRowCount int
 	tmpBatch           *batch.Batch
+	size               int64 // bytes of the build batches held in memory
+
+	// spilled[i] are the files of the i-th partition of the build batches,
+	// they are handed over to the join map once sent.
+	spilled [][]*spill.File
 
 	executor []colexec.ExpressionExecutor
 	vecs     [][]*vector.Vector
@@ -64,6 +70,9 @@ type HashBuild struct {
 	JoinMapTag        int32
 	JoinMapRefCnt     int32
 	RuntimeFilterSpec *pbplan.RuntimeFilterSpec
+	// CanSpill is true if the join can work on a spilled join map, then the
+	// build batches are written to disk as partitions once over the memory budget.
+	CanSpill bool
 	vm.OperatorBase
 }
 
@@ -111,6 +120,10 @@ func (hashBuild *HashBuild) Free(proc *process.Process, pipelineFailed bool, err
 		ctr.intHashMap = nil
 		ctr.strHashMap = nil
 		ctr.multiSels = nil
+		for _, files := range ctr.spilled {
+			spill.Cleanup(files...)
+		}
+		ctr.spilled = nil
 		ctr.cleanEvalVectors()
 		hashBuild.ctr = nil
 	}
@@ -81,9 +81,55 @@ func (innerJoin *InnerJoin) Call(proc *process.Process) (vm.CallResult, error) {
 				// for inner ,right and semi join, if hashmap is empty, we can finish this pipeline
 				// shuffle join can't stop early for this moment
 				ctr.state = End
+			} else if ctr.mp.Spilled() != nil {
+				if err = innerJoin.prepareSpill(proc); err != nil {
+					return result, err
+				}
+				ctr.state = SpillProbe
 			} else {
 				ctr.state = Probe
 			}
+
+		case SpillProbe:
+			// partition the probe batches the same way as the build side.
+			result, err = innerJoin.Children[0].Call(proc)
+			if err != nil {
+				return result, err
+			}
+			bat := result.Batch
+			if bat == nil {
+				if err = ctr.spillProbeBatches(proc); err != nil {
+					return result, err
+				}
+				ctr.state = SpillJoin
+				continue
+			}
+			if bat.Last() {
+				result.Batch = bat
+				return result, nil
+			}
+			if bat.IsEmpty() {
+				continue
+			}
+			if err = ctr.appendProbeBatch(proc, bat); err != nil {
+				return result, err
+			}
+
+		case SpillJoin:
+			if innerJoin.ctr.bat == nil {
+				bat, err := innerJoin.nextProbeBatch(proc)
+				if err != nil {
+					return result, err
+				}
+				if bat == nil {
+					ctr.state = End
+					continue
+				}
+				innerJoin.ctr.bat = bat
+				innerJoin.ctr.lastrow = 0
+			}
+			fallthrough
+
 		case Probe:
 			if innerJoin.ctr.bat == nil {
 				result, err = innerJoin.Children[0].Call(proc)
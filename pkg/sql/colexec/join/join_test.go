@@ -24,12 +24,15 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/value_scan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
@@ -211,6 +214,74 @@ func TestLowCardinalityIndexesJoin(t *testing.T) {
 }
 */
 
+func TestJoinSpill(t *testing.T) {
+	ts := []types.Type{types.T_int64.ToType()}
+	tc := newTestCase([]bool{false}, ts, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+		[][]*plan.Expr{
+			{newExpr(0, ts[0])},
+			{newExpr(0, ts[0])},
+		})
+	// the build batches are over the memory threshold at once.
+	tc.proc.Base.Lim.Size = 1
+	tc.proc.SetMessageBoard(message.NewMessageBoard())
+	tc.arg.Cond = nil
+	tc.arg.JoinMapTag = 1
+	tc.barg.JoinMapTag = 1
+	tc.barg.JoinMapRefCnt = 1
+	tc.barg.CanSpill = true
+
+	newKeyBatch := func(start, end int64) *batch.Batch {
+		keys := make([]int64, 0, end-start)
+		for i := start; i < end; i++ {
+			keys = append(keys, i)
+		}
+		return testutil.NewBatchWithVectors([]*vector.Vector{
+			testutil.NewVector(len(keys), ts[0], tc.proc.Mp(), false, keys),
+		}, nil)
+	}
+
+	// build with keys [0, 1000), and probe with keys [500, 2500).
+	build := &value_scan.ValueScan{Batchs: []*batch.Batch{newKeyBatch(0, 600), newKeyBatch(600, 1000)}}
+	require.NoError(t, build.Prepare(tc.proc))
+	tc.barg.SetChildren([]vm.Operator{build})
+	require.NoError(t, tc.barg.Prepare(tc.proc))
+	for {
+		result, err := tc.barg.Call(tc.proc)
+		require.NoError(t, err)
+		if result.Status == vm.ExecStop {
+			break
+		}
+	}
+
+	probe := &value_scan.ValueScan{Batchs: []*batch.Batch{newKeyBatch(500, 1500), newKeyBatch(1500, 2500)}}
+	require.NoError(t, probe.Prepare(tc.proc))
+	tc.arg.SetChildren([]vm.Operator{probe})
+	require.NoError(t, tc.arg.Prepare(tc.proc))
+	seen := make(map[int64]bool)
+	for {
+		result, err := tc.arg.Call(tc.proc)
+		require.NoError(t, err)
+		if result.Batch == nil {
+			break
+		}
+		left := vector.MustFixedCol[int64](result.Batch.Vecs[0])
+		right := vector.MustFixedCol[int64](result.Batch.Vecs[1])
+		for i := range left {
+			require.Equal(t, left[i], right[i])
+			require.False(t, seen[left[i]])
+			seen[left[i]] = true
+		}
+	}
+	require.Equal(t, 500, len(seen))
+	require.NotNil(t, tc.arg.ctr.spilledMap)
+
+	tc.arg.Free(tc.proc, false, nil)
+	tc.barg.Free(tc.proc, false, nil)
+	build.Free(tc.proc, false, nil)
+	probe.Free(tc.proc, false, nil)
+	tc.proc.Free()
+}
+
 func BenchmarkJoin(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []joinTestCase{
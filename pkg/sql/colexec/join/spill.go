@@ -0,0 +1,245 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/message"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
+)
+
+// prepareSpill is called if the build side was spilled to disk as partitions.
+// the probe batches will be partitioned the same way, and then the partitions
+// are joined one by one with a hash table built for each of them.
+func (innerJoin *InnerJoin) prepareSpill(proc *process.Process) (err error) {
+	ctr := innerJoin.ctr
+	ctr.spilledMap = ctr.mp
+	ctr.mp = nil
+	ctr.batches = nil
+	ctr.batchRowCount = 0
+	ctr.partition = -1
+	ctr.probeSpilled = make([][]*spill.File, spill.Partitions)
+
+	ctr.keyWidth = 0
+	ctr.buildExecutors = make([]colexec.ExpressionExecutor, len(innerJoin.Conditions[1]))
+	for i, expr := range innerJoin.Conditions[1] {
+		width := types.T(expr.Typ.Id).TypeLen()
+		if types.T(expr.Typ.Id).FixedLength() < 0 {
+			width = 128
+		}
+		ctr.keyWidth += width
+		if ctr.buildExecutors[i], err = colexec.NewExpressionExecutor(proc, expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendProbeBatch keeps a copy of the probe batch, and writes the kept
+// batches to disk as partitions once they are over the memory budget.
+func (ctr *container) appendProbeBatch(proc *process.Process, bat *batch.Batch) error {
+	bat, err := bat.Dup(proc.Mp())
+	if err != nil {
+		return err
+	}
+	ctr.probeBatches = append(ctr.probeBatches, bat)
+	ctr.probeSize += int64(bat.Size())
+	if proc.NeedSpill(ctr.probeSize) {
+		return ctr.spillProbeBatches(proc)
+	}
+	return nil
+}
+
+func (ctr *container) spillProbeBatches(proc *process.Process) error {
+	files, err := proc.SpillPartitions(ctr.probeBatches, func(bat *batch.Batch) ([]*vector.Vector, error) {
+		if err := ctr.evalJoinCondition(bat, proc); err != nil {
+			return nil, err
+		}
+		return ctr.vecs, nil
+	}, true)
+	if err != nil {
+		return err
+	}
+	for i, f := range files {
+		ctr.probeSpilled[i] = append(ctr.probeSpilled[i], f)
+	}
+	ctr.cleanProbeBatches(proc)
+	return nil
+}
+
+// nextProbeBatch returns the next probe batch of the spilled partitions, the
+// hash table of a partition is built when moving to it. it returns nil if all
+// the partitions were done.
+func (innerJoin *InnerJoin) nextProbeBatch(proc *process.Process) (*batch.Batch, error) {
+	ctr := innerJoin.ctr
+	if ctr.probeBat != nil {
+		ctr.probeBat.Clean(proc.Mp())
+		ctr.probeBat = nil
+	}
+	for {
+		for ctr.fileIdx < len(ctr.probeFiles) {
+			f := ctr.probeFiles[ctr.fileIdx]
+			if ctr.batIdx < f.Len() {
+				bat, err := f.Read(proc.Ctx, ctr.batIdx, proc.Mp())
+				if err != nil {
+					return nil, err
+				}
+				ctr.batIdx++
+				ctr.probeBat = bat
+				return bat, nil
+			}
+			ctr.fileIdx++
+			ctr.batIdx = 0
+		}
+		if ctr.probeFiles != nil {
+			if err := spill.DeleteAll(proc.Ctx, ctr.probeFiles); err != nil {
+				return nil, err
+			}
+		}
+
+		ctr.partition++
+		if ctr.partition >= len(ctr.probeSpilled) {
+			return nil, nil
+		}
+		ctr.probeFiles = ctr.probeSpilled[ctr.partition]
+		ctr.probeSpilled[ctr.partition] = nil
+		ctr.fileIdx, ctr.batIdx = 0, 0
+		if err := innerJoin.buildPartition(proc); err != nil {
+			return nil, err
+		}
+		if ctr.mp == nil {
+			// nothing to match in this partition.
+			ctr.fileIdx = len(ctr.probeFiles)
+		}
+	}
+}
+
+// buildPartition reads the build batches of the current partition back and
+// builds the hash table of them, just like the hash build operator does.
+func (innerJoin *InnerJoin) buildPartition(proc *process.Process) error {
+	ctr := innerJoin.ctr
+	ctr.cleanHashMap()
+	ctr.batches = nil
+	ctr.batchRowCount = 0
+
+	// the probe looks the build rows up by their row numbers, so the batches
+	// must be in the size of colexec.DefaultBatchSize.
+	var (
+		bats []*batch.Batch
+		tmp  *batch.Batch
+		rows int
+	)
+	clean := func() {
+		for _, bat := range bats {
+			proc.PutBatch(bat)
+		}
+		if tmp != nil {
+			proc.PutBatch(tmp)
+		}
+	}
+	for _, f := range ctr.spilledMap.Spilled()[ctr.partition] {
+		for i := 0; i < f.Len(); i++ {
+			bat, err := f.Read(proc.Ctx, i, proc.Mp())
+			if err != nil {
+				clean()
+				return err
+			}
+			for offset := 0; offset < bat.RowCount(); {
+				var n int
+				if tmp, n, err = proc.AppendToFixedSizeFromOffset(tmp, bat, offset); err != nil {
+					bat.Clean(proc.Mp())
+					clean()
+					return err
+				}
+				if tmp.RowCount() == colexec.DefaultBatchSize {
+					bats = append(bats, tmp)
+					tmp = nil
+				}
+				offset += n
+			}
+			rows += bat.RowCount()
+			bat.Clean(proc.Mp())
+		}
+	}
+	if tmp != nil {
+		bats = append(bats, tmp)
+		tmp = nil
+	}
+	if rows == 0 {
+		return nil
+	}
+
+	var (
+		err error
+		ihm *hashmap.IntHashMap
+		shm *hashmap.StrHashMap
+		itr hashmap.Iterator
+	)
+	if ctr.keyWidth <= 8 {
+		if ihm, err = hashmap.NewIntHashMap(false, proc.Mp()); err != nil {
+			clean()
+			return err
+		}
+		itr = ihm.NewIterator()
+	} else {
+		if shm, err = hashmap.NewStrMap(false, proc.Mp()); err != nil {
+			clean()
+			return err
+		}
+		itr = shm.NewIterator()
+	}
+	// the join map owns the batches and the hash table from now on.
+	ctr.mp = message.NewJoinMap(nil, ihm, shm, bats, proc.Mp())
+	ctr.mp.IncRef(1)
+
+	var multiSels [][]int32
+	if !innerJoin.HashOnPK {
+		multiSels = make([][]int32, rows)
+	}
+	keys := make([]*vector.Vector, len(ctr.buildExecutors))
+	for i, bat := range bats {
+		for j := range ctr.buildExecutors {
+			if keys[j], err = ctr.buildExecutors[j].Eval(proc, []*batch.Batch{bat}, nil); err != nil {
+				return err
+			}
+		}
+		count := bat.RowCount()
+		for k := 0; k < count; k += hashmap.UnitLimit {
+			n := min(count-k, hashmap.UnitLimit)
+			vals, zvals, err := itr.Insert(k, n, keys)
+			if err != nil {
+				return err
+			}
+			if multiSels == nil {
+				continue
+			}
+			for m, v := range vals[:n] {
+				if zvals[m] == 0 || v == 0 {
+					continue
+				}
+				multiSels[v-1] = append(multiSels[v-1], int32(i*colexec.DefaultBatchSize+k+m))
+			}
+		}
+	}
+	ctr.mp.SetSels(multiSels)
+	ctr.batches = bats
+	ctr.batchRowCount = int64(rows)
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/message"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 var _ vm.Operator = new(InnerJoin)
@@ -31,6 +32,8 @@ var _ vm.Operator = new(InnerJoin)
 const (
 	Build = iota
 	Probe
+	SpillProbe
+	SpillJoin
 	End
 )
 
@@ -62,6 +65,23 @@ type container struct {
 	bat *batch.Batch
 
 	maxAllocSize int64
+
+	// spilledMap is the join map received if the build side was spilled to
+	// disk, the probe batches are kept in probeBatches and then spilled as
+	// partitions into probeSpilled, and the partitions are joined one by one.
+	spilledMap     *message.JoinMap
+	buildExecutors []colexec.ExpressionExecutor
+	keyWidth       int
+	probeBatches   []*batch.Batch
+	probeSize      int64
+	probeSpilled   [][]*spill.File
+	// partition is the partition being joined, probeFiles are its probe files,
+	// and probeBat is the probe batch read from probeFiles[fileIdx] at batIdx-1.
+	partition  int
+	probeFiles []*spill.File
+	fileIdx    int
+	batIdx     int
+	probeBat   *batch.Batch
 }
 
 type InnerJoin struct {
@@ -124,6 +144,7 @@ func (innerJoin *InnerJoin) Free(proc *process.Process, pipelineFailed bool, err
 		ctr.cleanEvalVectors()
 		ctr.cleanHashMap()
 		ctr.cleanExprExecutor()
+		ctr.cleanSpill(proc)
 
 		anal.Alloc(ctr.maxAllocSize)
 
@@ -162,6 +183,38 @@ func (ctr *container) cleanBatch(proc *process.Process) {
 	}
 }
 
+func (ctr *container) cleanProbeBatches(proc *process.Process) {
+	for _, bat := range ctr.probeBatches {
+		bat.Clean(proc.Mp())
+	}
+	ctr.probeBatches = nil
+	ctr.probeSize = 0
+}
+
+func (ctr *container) cleanSpill(proc *process.Process) {
+	ctr.cleanProbeBatches(proc)
+	if ctr.probeBat != nil {
+		ctr.probeBat.Clean(proc.Mp())
+		ctr.probeBat = nil
+	}
+	spill.Cleanup(ctr.probeFiles...)
+	ctr.probeFiles = nil
+	for _, files := range ctr.probeSpilled {
+		spill.Cleanup(files...)
+	}
+	ctr.probeSpilled = nil
+	for i := range ctr.buildExecutors {
+		if ctr.buildExecutors[i] != nil {
+			ctr.buildExecutors[i].Free()
+		}
+	}
+	ctr.buildExecutors = nil
+	if ctr.spilledMap != nil {
+		ctr.spilledMap.Free()
+		ctr.spilledMap = nil
+	}
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.mp != nil {
 		ctr.mp.Free()
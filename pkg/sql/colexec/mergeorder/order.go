@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const opName = "merge_order"
@@ -102,7 +103,9 @@ func (ctr *container) pickAndSend(proc *process.Process, result *vm.CallResult)
 		wholeLength++
 		ctr.indexList[choice]++
 		if ctr.indexList[choice] == int64(ctr.batchList[choice].RowCount()) {
-			ctr.removeBatch(proc, choice)
+			if err = ctr.nextBatch(proc, choice); err != nil {
+				return false, err
+			}
 		}
 
 		if len(ctr.indexList) == 0 {
@@ -145,24 +148,122 @@ func (ctr *container) pickFirstRow() (batIndex int) {
 }
 
 func (ctr *container) removeBatch(proc *process.Process, index int) {
-	bat := ctr.batchList[index]
-	cols := ctr.orderCols[index]
+	freeBatch(proc, ctr.batchList[index], ctr.orderCols[index])
+	ctr.batchList = append(ctr.batchList[:index], ctr.batchList[index+1:]...)
+	ctr.indexList = append(ctr.indexList[:index], ctr.indexList[index+1:]...)
+	ctr.orderCols = append(ctr.orderCols[:index], ctr.orderCols[index+1:]...)
+	if ctr.sources != nil {
+		ctr.sources = append(ctr.sources[:index], ctr.sources[index+1:]...)
+		ctr.next = append(ctr.next[:index], ctr.next[index+1:]...)
+	}
+}
+
+// nextBatch replaces the fully merged batch at index with the next batch of
+// its spilled run, or removes it if there is nothing left.
+func (ctr *container) nextBatch(proc *process.Process, index int) error {
+	if ctr.sources != nil {
+		if f := ctr.sources[index]; f != nil && ctr.next[index] < f.Len() {
+			bat, err := f.Read(proc.Ctx, ctr.next[index], proc.Mp())
+			if err != nil {
+				return err
+			}
+			ctr.next[index]++
+			freeBatch(proc, ctr.batchList[index], ctr.orderCols[index])
+			ctr.batchList[index] = bat
+			ctr.orderCols[index] = nil
+			ctr.indexList[index] = 0
+			return ctr.evaluateOrderColumn(proc, index)
+		}
+	}
+	ctr.removeBatch(proc, index)
+	return nil
+}
+
+// spill writes all the sorted runs held in memory into temporary files, a run
+// is written as batches of spillBatchRows rows so that it can be merged back
+// with only one of them in memory.
+func (ctr *container) spill(proc *process.Process) error {
+	for i, bat := range ctr.batchList {
+		w := proc.NewSpillWriter()
+		err := appendRun(proc, w, bat)
+		if err != nil {
+			_ = w.Abort(proc.Ctx)
+			return err
+		}
+		f, err := w.Flush(proc.Ctx)
+		if err != nil {
+			_ = w.Abort(proc.Ctx)
+			return err
+		}
+		ctr.runs = append(ctr.runs, f)
+		freeBatch(proc, bat, ctr.orderCols[i])
+		ctr.batchList[i] = nil
+		ctr.orderCols[i] = nil
+	}
+	ctr.batchList = ctr.batchList[:0]
+	ctr.orderCols = ctr.orderCols[:0]
+	ctr.size = 0
+	return nil
+}
+
+// appendRun appends a sorted run to w in batches of spillBatchRows rows.
+func appendRun(proc *process.Process, w *spill.Writer, bat *batch.Batch) error {
+	for start := 0; start < bat.RowCount(); start += spillBatchRows {
+		end := min(start+spillBatchRows, bat.RowCount())
+		if start == 0 && end == bat.RowCount() {
+			return w.Append(proc.Ctx, bat)
+		}
+		chunk := batch.NewWithSize(bat.VectorCount())
+		for j, vec := range bat.Vecs {
+			v, err := vec.CloneWindow(start, end, proc.Mp())
+			if err != nil {
+				chunk.Clean(proc.Mp())
+				return err
+			}
+			chunk.Vecs[j] = v
+		}
+		chunk.SetRowCount(end - start)
+		err := w.Append(proc.Ctx, chunk)
+		chunk.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadRuns puts the first batch of every spilled run into the batch list.
+func (ctr *container) loadRuns(proc *process.Process) error {
+	ctr.sources = make([]*spill.File, len(ctr.batchList), len(ctr.batchList)+len(ctr.runs))
+	ctr.next = make([]int, len(ctr.batchList), len(ctr.batchList)+len(ctr.runs))
+	for _, f := range ctr.runs {
+		if f.Len() == 0 {
+			continue
+		}
+		bat, err := f.Read(proc.Ctx, 0, proc.Mp())
+		if err != nil {
+			return err
+		}
+		ctr.batchList = append(ctr.batchList, bat)
+		ctr.orderCols = append(ctr.orderCols, nil)
+		ctr.sources = append(ctr.sources, f)
+		ctr.next = append(ctr.next, 1)
+	}
+	return nil
+}
 
+func freeBatch(proc *process.Process, bat *batch.Batch, cols []*vector.Vector) {
 	alreadyPut := make(map[*vector.Vector]bool, len(bat.Vecs))
 	for i := range bat.Vecs {
 		proc.PutVector(bat.Vecs[i])
 		alreadyPut[bat.Vecs[i]] = true
 	}
-	ctr.batchList = append(ctr.batchList[:index], ctr.batchList[index+1:]...)
-	ctr.indexList = append(ctr.indexList[:index], ctr.indexList[index+1:]...)
-
 	for i := range cols {
 		if _, ok := alreadyPut[cols[i]]; ok {
 			continue
 		}
 		proc.PutVector(cols[i])
 	}
-	ctr.orderCols = append(ctr.orderCols[:index], ctr.orderCols[index+1:]...)
 }
 
 func (mergeOrder *MergeOrder) String(buf *bytes.Buffer) {
@@ -222,13 +323,26 @@ func (mergeOrder *MergeOrder) Call(proc *process.Process) (vm.CallResult, error)
 				// if number of block is less than 2, no need to do merge sort.
 				ctr.status = normalSending
 
-				if len(ctr.batchList) > 1 {
+				if len(ctr.batchList) > 1 || len(ctr.runs) > 0 {
 					ctr.status = pickUpSending
 
-					// evaluate the first batch's order column.
-					if err = ctr.evaluateOrderColumn(proc, 0); err != nil {
+					if err = ctr.loadRuns(proc); err != nil {
 						return result, err
 					}
+					if len(ctr.batchList) == 0 {
+						ctr.status = normalSending
+						continue
+					}
+					// evaluate the order columns not evaluated yet, the first batch's
+					// and the spilled runs'.
+					for i := range ctr.batchList {
+						if ctr.orderCols[i] != nil {
+							continue
+						}
+						if err = ctr.evaluateOrderColumn(proc, i); err != nil {
+							return result, err
+						}
+					}
 					ctr.generateCompares(mergeOrder.OrderBySpecs)
 					ctr.indexList = make([]int64, len(ctr.batchList))
 				}
@@ -240,6 +354,14 @@ func (mergeOrder *MergeOrder) Call(proc *process.Process) (vm.CallResult, error)
 			if err = ctr.mergeAndEvaluateOrderColumn(proc, bat); err != nil {
 				return result, err
 			}
+			// too many sorted runs to hold, write them to disk and merge them
+			// back from there.
+			ctr.size += int64(bat.Size())
+			if proc.NeedSpill(ctr.size) {
+				if err = ctr.spill(proc); err != nil {
+					return result, err
+				}
+			}
 
 		case normalSending:
 			if len(ctr.batchList) == 0 {
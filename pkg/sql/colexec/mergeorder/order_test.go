@@ -155,6 +155,42 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	rows := int64(spillBatchRows*2 + 100)
+	tc := newTestCase([]types.Type{types.T_int64.ToType()}, []*plan.OrderBySpec{{Expr: newExpression(0, types.T_int64), Flag: 0}})
+	// every received run is over the memory threshold.
+	tc.proc.Base.Lim.Size = 1
+	require.NoError(t, tc.marg.Prepare(tc.proc))
+	require.NoError(t, tc.arg.Prepare(tc.proc))
+	tc.arg.SetChildren([]vm.Operator{tc.marg})
+	tc.proc.Reg.MergeReceivers[0].Ch <- testutil.NewRegMsg(newIntBatch(tc.types, tc.proc, rows, tc.arg.OrderBySpecs))
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- testutil.NewRegMsg(newIntBatch(tc.types, tc.proc, rows, tc.arg.OrderBySpecs))
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+
+	count, last := 0, int64(0)
+	for {
+		result, err := tc.arg.Call(tc.proc)
+		require.NoError(t, err)
+		if result.Batch != nil {
+			for _, v := range vector.MustFixedCol[int64](result.Batch.Vecs[0]) {
+				require.True(t, v >= last)
+				last = v
+			}
+			count += result.Batch.RowCount()
+		}
+		if result.Status == vm.ExecStop {
+			break
+		}
+	}
+	require.Equal(t, int(rows*2), count)
+	require.Equal(t, 2, len(tc.arg.ctr.runs))
+
+	tc.proc.Free()
+	tc.arg.Free(tc.proc, false, nil)
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs := []orderTestCase{
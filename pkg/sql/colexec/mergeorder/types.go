@@ -24,10 +24,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const maxBatchSizeToSend = 64 * mpool.MB
 
+// spillBatchRows is the rows of the batches a spilled run is written as.
+const spillBatchRows = 8192
+
 var _ vm.Operator = new(MergeOrder)
 
 const (
@@ -84,6 +88,16 @@ type container struct {
 	orderCols [][]*vector.Vector
 	// indexList[i] = k means the number of rows before k in batchList[i] has been merged and send.
 	indexList []int64
+	// size is the bytes of the received batches held in batchList.
+	size int64
+
+	// runs are the sorted runs spilled to disk once the received batches are
+	// over the memory budget.
+	runs []*spill.File
+	// sources[i] is the spilled run batchList[i] was read from, nil if it was
+	// never spilled, and next[i] is the next batch to read from that run.
+	sources []*spill.File
+	next    []int
 
 	// expression executors for order columns.
 	executors []colexec.ExpressionExecutor
@@ -124,6 +138,8 @@ func (mergeOrder *MergeOrder) Free(proc *process.Process, pipelineFailed bool, e
 			ctr.buf.Clean(proc.Mp())
 			ctr.buf = nil
 		}
+		spill.Cleanup(ctr.runs...)
+		ctr.runs = nil
 
 		mergeOrder.ctr = nil
 	}
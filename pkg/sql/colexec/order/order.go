@@ -84,7 +84,9 @@ func (ctr *container) appendBatch(proc *process.Process, bat *batch.Batch) (enou
 			return false, err
 		}
 	}
-	return all >= maxBatchSizeToSort, nil
+	// send a sorted run once it is too big, or the memory budget is running out,
+	// merge order will spill the runs to disk if needed.
+	return all >= maxBatchSizeToSort || proc.NeedSpill(int64(all)), nil
 }
 
 func (ctr *container) sortAndSend(proc *process.Process, result *vm.CallResult) (err error) {
@@ -26,6 +26,9 @@ var _ vm.Operator = new(Output)
 type Output struct {
 	Data interface{}
 	Func func(*batch.Batch) error
+	// Parallel is true if the Func can be called by the parallel pipelines
+	// at the same time, and the order of the batches does not matter.
+	Parallel bool
 
 	vm.OperatorBase
 }
@@ -65,6 +68,11 @@ func (output *Output) WithFunc(Func func(*batch.Batch) error) *Output {
 	return output
 }
 
+func (output *Output) WithParallel(parallel bool) *Output {
+	output.Parallel = parallel
+	return output
+}
+
 func (output *Output) Release() {
 	if output != nil {
 		reuse.Free[Output](output, nil)
@@ -0,0 +1,500 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// json_table produces the rows of the document of each input row, and the
+// columns of the input row follow its own columns, so the input row is
+// correlated to its rows as a lateral join.
+
+type jsonTableArg struct {
+	root *jsonTableColumn
+	// cells are the values of all the columns in the order of definition,
+	// they are reused for each row.
+	cells []jsonTableCell
+	// outs are the cell indexes of the output columns.
+	outs []int
+}
+
+type jsonTableColumn struct {
+	*plan2.JsonTableColumn
+	path *bytejson.Path
+	// idx is the cell index of a column, and [lo, hi) are the cell indexes of
+	// the columns of a NESTED PATH.
+	idx, lo, hi int
+	columns     []*jsonTableColumn
+}
+
+type jsonTableCell struct {
+	col    *jsonTableColumn
+	val    bytejson.ByteJson
+	isNull bool
+	// isDefault is true if val is the DEFAULT string of ON EMPTY or ON ERROR.
+	isDefault bool
+}
+
+func jsonTablePrepare(proc *process.Process, arg *TableFunction) error {
+	var param plan2.JsonTableColumn
+	if err := json.Unmarshal(arg.Params, &param); err != nil {
+		return err
+	}
+	if len(arg.Args) != 1 {
+		return moerr.NewInvalidInput(proc.Ctx, "json_table: argument number must be 1")
+	}
+
+	jt := &jsonTableArg{}
+	root, err := jt.newColumn(&param)
+	if err != nil {
+		return err
+	}
+	jt.root = root
+
+	jt.outs = make([]int, len(arg.Rets))
+	for i, ret := range arg.Rets {
+		jt.outs[i] = -1
+		for j := range jt.cells {
+			if jt.cells[j].col.Name == ret.Name {
+				jt.outs[i] = j
+				break
+			}
+		}
+		if jt.outs[i] < 0 {
+			return moerr.NewInvalidArg(proc.Ctx, "json_table column name", ret.Name)
+		}
+	}
+	arg.ctr.jsonTable = jt
+	arg.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, arg.Args)
+	return err
+}
+
+func (jt *jsonTableArg) newColumn(param *plan2.JsonTableColumn) (*jsonTableColumn, error) {
+	col := &jsonTableColumn{JsonTableColumn: param}
+	if param.Kind != tree.JsonTableColumnOrdinality {
+		path, err := types.ParseStringToPath(param.Path)
+		if err != nil {
+			return nil, err
+		}
+		col.path = &path
+	}
+	if param.Kind != tree.JsonTableColumnNested {
+		col.idx = len(jt.cells)
+		jt.cells = append(jt.cells, jsonTableCell{col: col})
+		return col, nil
+	}
+
+	col.lo = len(jt.cells)
+	for _, p := range param.Columns {
+		child, err := jt.newColumn(p)
+		if err != nil {
+			return nil, err
+		}
+		col.columns = append(col.columns, child)
+	}
+	col.hi = len(jt.cells)
+	return col, nil
+}
+
+func jsonTableCall(_ int, proc *process.Process, arg *TableFunction, result *vm.CallResult) (bool, error) {
+	var (
+		err  error
+		rbat *batch.Batch
+	)
+	bat := result.Batch
+	defer func() {
+		if err != nil && rbat != nil {
+			rbat.Clean(proc.Mp())
+		}
+	}()
+	if bat == nil {
+		return true, nil
+	}
+	if bat.IsEmpty() {
+		proc.PutBatch(bat)
+		result.Batch = batch.EmptyBatch
+		return false, nil
+	}
+
+	docVec, err := arg.ctr.executorsForArgs[0].Eval(proc, []*batch.Batch{bat}, nil)
+	if err != nil {
+		return false, err
+	}
+	docTyp := docVec.GetType().Oid
+	if docTyp != types.T_json && !docTyp.IsMySQLString() && !docVec.IsConstNull() {
+		err = moerr.NewInvalidInput(proc.Ctx, "json_table: the document must be json or string, but got %s", docVec.GetType().String())
+		return false, err
+	}
+
+	jt := arg.ctr.jsonTable
+	rbat = batch.NewWithSize(len(arg.Attrs) + len(bat.Vecs))
+	rbat.Attrs = make([]string, len(rbat.Vecs))
+	copy(rbat.Attrs, arg.Attrs)
+	for i := range arg.ctr.retSchema {
+		rbat.Vecs[i] = proc.GetVector(arg.ctr.retSchema[i])
+	}
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[len(arg.Attrs)+i] = proc.GetVector(*vec.GetType())
+	}
+
+	rows := 0
+	if docVec.IsConstNull() {
+		rbat.SetRowCount(rows)
+		result.Batch = rbat
+		return false, nil
+	}
+	docs := vector.GenerateFunctionStrParameter(docVec)
+	for i := 0; i < bat.RowCount(); i++ {
+		data, null := docs.GetStrValue(uint64(i))
+		if null {
+			continue
+		}
+		var doc bytejson.ByteJson
+		if docTyp == types.T_json {
+			doc = types.DecodeJson(data)
+		} else if doc, err = types.ParseSliceToByteJson(data); err != nil {
+			return false, err
+		}
+
+		_, err = jt.produce(proc, jt.root, doc, func() error {
+			for j, idx := range jt.outs {
+				if err := jt.appendCell(rbat.Vecs[j], &jt.cells[idx], proc); err != nil {
+					return err
+				}
+			}
+			for j, vec := range bat.Vecs {
+				if err := rbat.Vecs[len(arg.Attrs)+j].UnionOne(vec, int64(i), proc.Mp()); err != nil {
+					return err
+				}
+			}
+			rows++
+			return nil
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+	rbat.SetRowCount(rows)
+	result.Batch = rbat
+	return false, nil
+}
+
+// produce emits a row for each value matched by the path of the NESTED PATH
+// col. The sibling NESTED PATH produce their rows one after another with the
+// columns of the others being NULL, and a row with all of them being NULL is
+// emitted if none of them matches. It returns false if the path matches
+// nothing.
+func (jt *jsonTableArg) produce(proc *process.Process, col *jsonTableColumn, doc bytejson.ByteJson, emit func() error) (bool, error) {
+	vals := doc.Match(col.path)
+	for i, val := range vals {
+		var nested []*jsonTableColumn
+		for _, c := range col.columns {
+			cell := &jt.cells[c.idx]
+			switch c.Kind {
+			case tree.JsonTableColumnOrdinality:
+				*cell = jsonTableCell{col: c, val: bytejson.FromUint64(uint64(i + 1))}
+			case tree.JsonTableColumnExists:
+				exists := int64(0)
+				if val.Exists(c.path) {
+					exists = 1
+				}
+				*cell = jsonTableCell{col: c, val: bytejson.FromInt64(exists)}
+			case tree.JsonTableColumnPath:
+				if err := jt.lookup(proc, c, val, cell); err != nil {
+					return false, err
+				}
+			case tree.JsonTableColumnNested:
+				nested = append(nested, c)
+			}
+		}
+
+		produced := false
+		for _, c := range nested {
+			jt.clearNested(nested)
+			ok, err := jt.produce(proc, c, val, emit)
+			if err != nil {
+				return false, err
+			}
+			produced = produced || ok
+		}
+		if !produced {
+			jt.clearNested(nested)
+			if err := emit(); err != nil {
+				return false, err
+			}
+		}
+	}
+	return len(vals) > 0, nil
+}
+
+func (jt *jsonTableArg) clearNested(nested []*jsonTableColumn) {
+	for _, c := range nested {
+		for i := c.lo; i < c.hi; i++ {
+			jt.cells[i] = jsonTableCell{col: jt.cells[i].col, isNull: true}
+		}
+	}
+}
+
+func (jt *jsonTableArg) lookup(proc *process.Process, col *jsonTableColumn, doc bytejson.ByteJson, cell *jsonTableCell) error {
+	vals := doc.Match(col.path)
+	switch {
+	case len(vals) == 0:
+		return jt.respond(col, col.OnEmpty, cell, func() error {
+			return moerr.NewInvalidInput(proc.Ctx, "missing value for json_table column '%s'", col.Name)
+		})
+	case len(vals) > 1:
+		return jt.respond(col, col.OnError, cell, func() error {
+			return moerr.NewInvalidInput(proc.Ctx, "more than one value for json_table column '%s'", col.Name)
+		})
+	}
+	*cell = jsonTableCell{col: col, val: vals[0]}
+	return nil
+}
+
+// respond sets the cell as the ON EMPTY or ON ERROR clause says, NULL is the
+// default.
+func (jt *jsonTableArg) respond(col *jsonTableColumn, resp *tree.JsonTableOnResponse, cell *jsonTableCell, errFn func() error) error {
+	if resp == nil || resp.Type == tree.JsonTableOnResponseNull {
+		*cell = jsonTableCell{col: col, isNull: true}
+		return nil
+	}
+	if resp.Type == tree.JsonTableOnResponseError {
+		return errFn()
+	}
+	*cell = jsonTableCell{col: col, val: bytejson.FromString(resp.Default), isDefault: true}
+	return nil
+}
+
+func (jt *jsonTableArg) appendCell(vec *vector.Vector, cell *jsonTableCell, proc *process.Process) error {
+	if cell.isNull {
+		return vector.AppendAny(vec, nil, true, proc.Mp())
+	}
+
+	val := cell.val
+	if cell.isDefault && vec.GetType().Oid == types.T_json {
+		var err error
+		if val, err = bytejson.ParseFromString(string(val.GetString())); err != nil {
+			return err
+		}
+	}
+	v, ok := convertJsonTableValue(val, vec.GetType(), proc)
+	if ok {
+		return vector.AppendAny(vec, v, v == nil, proc.Mp())
+	}
+
+	col := cell.col
+	if cell.isDefault {
+		return moerr.NewInvalidInput(proc.Ctx, "invalid default value for json_table column '%s'", col.Name)
+	}
+	if err := jt.respond(col, col.OnError, cell, func() error {
+		return moerr.NewInvalidInput(proc.Ctx, "invalid value %s for json_table column '%s'", val.String(), col.Name)
+	}); err != nil {
+		return err
+	}
+	return jt.appendCell(vec, cell, proc)
+}
+
+// convertJsonTableValue converts the json value to the value of the type, a
+// nil value means NULL. It returns false if the value can't be converted.
+func convertJsonTableValue(bj bytejson.ByteJson, typ *types.Type, proc *process.Process) (any, bool) {
+	if typ.Oid == types.T_json {
+		data, err := types.EncodeJson(bj)
+		return data, err == nil
+	}
+	if bj.IsNull() {
+		return nil, true
+	}
+
+	switch typ.Oid {
+	case types.T_bool:
+		if bj.Type == bytejson.TpCodeLiteral {
+			return bj.Data[0] == bytejson.LiteralTrue, true
+		}
+		if bj.Type == bytejson.TpCodeString {
+			v, err := types.ParseBool(string(bj.GetString()))
+			return v, err == nil
+		}
+		v, ok := jsonToFloat64(bj)
+		return v != 0, ok
+	case types.T_int8:
+		return jsonToSigned[int8](bj, math.MinInt8, math.MaxInt8)
+	case types.T_int16:
+		return jsonToSigned[int16](bj, math.MinInt16, math.MaxInt16)
+	case types.T_int32:
+		return jsonToSigned[int32](bj, math.MinInt32, math.MaxInt32)
+	case types.T_int64:
+		return jsonToSigned[int64](bj, math.MinInt64, math.MaxInt64)
+	case types.T_uint8:
+		return jsonToUnsigned[uint8](bj, math.MaxUint8)
+	case types.T_uint16:
+		return jsonToUnsigned[uint16](bj, math.MaxUint16)
+	case types.T_uint32:
+		return jsonToUnsigned[uint32](bj, math.MaxUint32)
+	case types.T_uint64:
+		return jsonToUnsigned[uint64](bj, math.MaxUint64)
+	case types.T_float32:
+		v, ok := jsonToFloat64(bj)
+		return float32(v), ok && math.Abs(v) <= math.MaxFloat32
+	case types.T_float64:
+		return jsonToFloat64(bj)
+	}
+
+	s, ok := jsonScalarString(bj)
+	if !ok && typ.Oid != types.T_char && typ.Oid != types.T_varchar && typ.Oid != types.T_text {
+		return nil, false
+	}
+	var (
+		v   any
+		err error
+	)
+	switch typ.Oid {
+	case types.T_decimal64:
+		v, err = types.ParseDecimal64(s, typ.Width, typ.Scale)
+	case types.T_decimal128:
+		v, err = types.ParseDecimal128(s, typ.Width, typ.Scale)
+	case types.T_char, types.T_varchar, types.T_text:
+		if typ.Oid != types.T_text && utf8.RuneCountInString(s) > int(typ.Width) {
+			return nil, false
+		}
+		v = []byte(s)
+	case types.T_date:
+		v, err = types.ParseDateCast(s)
+	case types.T_datetime:
+		v, err = types.ParseDatetime(s, typ.Scale)
+	case types.T_timestamp:
+		v, err = types.ParseTimestamp(proc.GetSessionInfo().TimeZone, s, typ.Scale)
+	case types.T_time:
+		v, err = types.ParseTime(s, typ.Scale)
+	default:
+		return nil, false
+	}
+	return v, err == nil
+}
+
+// jsonScalarString returns the unquoted string of a scalar, and the json text
+// of an object or array with false.
+func jsonScalarString(bj bytejson.ByteJson) (string, bool) {
+	switch bj.Type {
+	case bytejson.TpCodeObject, bytejson.TpCodeArray:
+		return bj.String(), false
+	case bytejson.TpCodeString:
+		return string(bj.GetString()), true
+	}
+	return bj.String(), true
+}
+
+func jsonToSigned[T int8 | int16 | int32 | int64](bj bytejson.ByteJson, lo, hi int64) (any, bool) {
+	var v int64
+	switch bj.Type {
+	case bytejson.TpCodeInt64:
+		v = bj.GetInt64()
+	case bytejson.TpCodeUint64:
+		if bj.GetUint64() > math.MaxInt64 {
+			return nil, false
+		}
+		v = int64(bj.GetUint64())
+	case bytejson.TpCodeFloat64:
+		f := math.Round(bj.GetFloat64())
+		if f < math.MinInt64 || f >= math.MaxInt64 {
+			return nil, false
+		}
+		v = int64(f)
+	case bytejson.TpCodeString:
+		var err error
+		if v, err = strconv.ParseInt(strings.TrimSpace(string(bj.GetString())), 10, 64); err != nil {
+			return nil, false
+		}
+	case bytejson.TpCodeLiteral:
+		if bj.Data[0] == bytejson.LiteralTrue {
+			v = 1
+		}
+	default:
+		return nil, false
+	}
+	if v < lo || v > hi {
+		return nil, false
+	}
+	return T(v), true
+}
+
+func jsonToUnsigned[T uint8 | uint16 | uint32 | uint64](bj bytejson.ByteJson, hi uint64) (any, bool) {
+	var v uint64
+	switch bj.Type {
+	case bytejson.TpCodeInt64:
+		if bj.GetInt64() < 0 {
+			return nil, false
+		}
+		v = uint64(bj.GetInt64())
+	case bytejson.TpCodeUint64:
+		v = bj.GetUint64()
+	case bytejson.TpCodeFloat64:
+		f := math.Round(bj.GetFloat64())
+		if f < 0 || f >= math.MaxUint64 {
+			return nil, false
+		}
+		v = uint64(f)
+	case bytejson.TpCodeString:
+		var err error
+		if v, err = strconv.ParseUint(strings.TrimSpace(string(bj.GetString())), 10, 64); err != nil {
+			return nil, false
+		}
+	case bytejson.TpCodeLiteral:
+		if bj.Data[0] == bytejson.LiteralTrue {
+			v = 1
+		}
+	default:
+		return nil, false
+	}
+	if v > hi {
+		return nil, false
+	}
+	return T(v), true
+}
+
+func jsonToFloat64(bj bytejson.ByteJson) (float64, bool) {
+	switch bj.Type {
+	case bytejson.TpCodeInt64:
+		return float64(bj.GetInt64()), true
+	case bytejson.TpCodeUint64:
+		return float64(bj.GetUint64()), true
+	case bytejson.TpCodeFloat64:
+		return bj.GetFloat64(), true
+	case bytejson.TpCodeString:
+		v, err := strconv.ParseFloat(strings.TrimSpace(string(bj.GetString())), 64)
+		return v, err == nil
+	case bytejson.TpCodeLiteral:
+		if bj.Data[0] == bytejson.LiteralTrue {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
@@ -0,0 +1,263 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonTableTestCase struct {
+	path    string
+	columns []*plan2.JsonTableColumn
+	rets    []*plan.ColDef
+	docs    []string
+	rows    []string
+	success bool
+}
+
+func jtCol(kind tree.JsonTableColumnKind, name, path string, columns ...*plan2.JsonTableColumn) *plan2.JsonTableColumn {
+	return &plan2.JsonTableColumn{Kind: kind, Name: name, Path: path, Columns: columns}
+}
+
+func jtRet(name string, typ types.T, width int32) *plan.ColDef {
+	return &plan.ColDef{Name: name, Typ: plan.Type{Id: int32(typ), Width: width}}
+}
+
+func TestJsonTableCall(t *testing.T) {
+	onEmpty := func(col *plan2.JsonTableColumn, tp tree.JsonTableOnResponseType, def string) *plan2.JsonTableColumn {
+		col.OnEmpty = &tree.JsonTableOnResponse{Type: tp, Default: def}
+		return col
+	}
+	onError := func(col *plan2.JsonTableColumn, tp tree.JsonTableOnResponseType, def string) *plan2.JsonTableColumn {
+		col.OnError = &tree.JsonTableOnResponse{Type: tp, Default: def}
+		return col
+	}
+
+	kases := []jsonTableTestCase{
+		{
+			path: "$[*]",
+			columns: []*plan2.JsonTableColumn{
+				jtCol(tree.JsonTableColumnOrdinality, "id", ""),
+				jtCol(tree.JsonTableColumnPath, "a", "$.a"),
+				jtCol(tree.JsonTableColumnPath, "s", "$.a"),
+				jtCol(tree.JsonTableColumnExists, "e", "$.c"),
+				jtCol(tree.JsonTableColumnNested, "", "$.b[*]",
+					jtCol(tree.JsonTableColumnPath, "b", "$"),
+					jtCol(tree.JsonTableColumnOrdinality, "bid", "")),
+			},
+			rets: []*plan.ColDef{
+				jtRet("id", types.T_uint32, 32), jtRet("a", types.T_int32, 32), jtRet("s", types.T_varchar, 10),
+				jtRet("e", types.T_int64, 64), jtRet("b", types.T_json, 0), jtRet("bid", types.T_uint32, 32),
+			},
+			docs: []string{`[{"a": 1, "b": [10, {"x": 20}]}, {"a": "x", "c": null}]`, "", `[]`, `[{"a": 2.4}]`},
+			rows: []string{
+				`1 1 1 0 10 1 0`,
+				`1 1 1 0 {"x": 20} 2 0`,
+				`2 null x 1 null null 0`,
+				`1 2 2.4 0 null null 3`,
+			},
+			success: true,
+		},
+		{
+			// only the referenced columns are returned, and the sibling
+			// NESTED PATH produce their rows one after another.
+			path: "$",
+			columns: []*plan2.JsonTableColumn{
+				jtCol(tree.JsonTableColumnPath, "a", "$.a"),
+				jtCol(tree.JsonTableColumnNested, "", "$.b[*]", jtCol(tree.JsonTableColumnPath, "b", "$")),
+				jtCol(tree.JsonTableColumnNested, "", "$.c[*]", jtCol(tree.JsonTableColumnPath, "c", "$")),
+			},
+			rets: []*plan.ColDef{jtRet("c", types.T_varchar, 10), jtRet("b", types.T_int64, 64)},
+			docs: []string{`{"b": [1, 2], "c": ["x"]}`, `{"a": 1}`},
+			rows: []string{
+				`null 1 0`,
+				`null 2 0`,
+				`x null 0`,
+				`null null 1`,
+			},
+			success: true,
+		},
+		{
+			path: "$[*]",
+			columns: []*plan2.JsonTableColumn{
+				onEmpty(jtCol(tree.JsonTableColumnPath, "a", "$.a"), tree.JsonTableOnResponseDefault, "-1"),
+				onError(jtCol(tree.JsonTableColumnPath, "d", "$.d"), tree.JsonTableOnResponseDefault, "1999-01-01"),
+				onError(jtCol(tree.JsonTableColumnPath, "j", "$.*"), tree.JsonTableOnResponseDefault, `{"k": 1}`),
+			},
+			rets: []*plan.ColDef{jtRet("a", types.T_int8, 8), jtRet("d", types.T_date, 0), jtRet("j", types.T_json, 0)},
+			docs: []string{`[{"d": "2024-02-03"}, {"a": 300, "d": "x", "e": 1}]`},
+			rows: []string{
+				`-1 2024-02-03 "2024-02-03" 0`,
+				`null 1999-01-01 {"k": 1} 0`,
+			},
+			success: true,
+		},
+		{
+			// a NULL document and a document the path does not match have
+			// no rows, the rows of the left table are joined like an inner
+			// join.
+			path:    "$.a[*]",
+			columns: []*plan2.JsonTableColumn{jtCol(tree.JsonTableColumnPath, "a", "$")},
+			rets:    []*plan.ColDef{jtRet("a", types.T_int32, 32)},
+			docs:    []string{"", `{"b": [1]}`, `{"a": [5, 6]}`, `{"a": []}`},
+			rows: []string{
+				`5 2`,
+				`6 2`,
+			},
+			success: true,
+		},
+		{
+			path:    "$[*]",
+			columns: []*plan2.JsonTableColumn{onEmpty(jtCol(tree.JsonTableColumnPath, "a", "$.a"), tree.JsonTableOnResponseError, "")},
+			rets:    []*plan.ColDef{jtRet("a", types.T_int32, 32)},
+			docs:    []string{`[{"a": 1}, {"b": 2}]`},
+		},
+		{
+			path:    "$",
+			columns: []*plan2.JsonTableColumn{onError(jtCol(tree.JsonTableColumnPath, "a", "$.a"), tree.JsonTableOnResponseError, "")},
+			rets:    []*plan.ColDef{jtRet("a", types.T_varchar, 2)},
+			docs:    []string{`{"a": "abc"}`},
+		},
+		{
+			path:    "$",
+			columns: []*plan2.JsonTableColumn{onError(jtCol(tree.JsonTableColumnPath, "a", "$.a"), tree.JsonTableOnResponseDefault, "x")},
+			rets:    []*plan.ColDef{jtRet("a", types.T_int32, 32)},
+			docs:    []string{`{"a": [1]}`},
+		},
+	}
+
+	for i, k := range kases {
+		proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+		beforeMem := proc.Mp().CurrNB()
+
+		arg, err := newJsonTableArg(k)
+		require.NoError(t, err)
+		require.NoError(t, arg.Prepare(proc))
+
+		inputBat, err := makeJsonTableBatch(k.docs, proc)
+		require.NoError(t, err)
+		result := vm.NewCallResult()
+		result.Batch = inputBat
+		end, err := jsonTableCall(0, proc, arg, &result)
+		require.False(t, end)
+		if !k.success {
+			require.Error(t, err, i)
+		} else {
+			require.NoError(t, err, i)
+			require.Equal(t, len(k.rets)+2, result.Batch.VectorCount())
+			require.Equal(t, k.rows, jsonTableRows(result.Batch, len(k.rets)), i)
+			cleanResult(&result, proc)
+		}
+		inputBat.Clean(proc.Mp())
+		arg.Free(proc, false, nil)
+		require.Equal(t, beforeMem, proc.Mp().CurrNB(), i)
+	}
+}
+
+func newJsonTableArg(k jsonTableTestCase) (*TableFunction, error) {
+	param, err := json.Marshal(jtCol(tree.JsonTableColumnNested, "", k.path, k.columns...))
+	if err != nil {
+		return nil, err
+	}
+	attrs := make([]string, len(k.rets))
+	for i, ret := range k.rets {
+		attrs[i] = ret.Name
+	}
+	return &TableFunction{
+		Attrs:    attrs,
+		Rets:     k.rets,
+		Params:   param,
+		FuncName: "json_table",
+		Args: []*plan.Expr{
+			{
+				Typ: plan.Type{Id: int32(types.T_varchar)},
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{ColPos: 0},
+				},
+			},
+		},
+	}, nil
+}
+
+// makeJsonTableBatch makes a batch of the documents and their indexes, an
+// empty document is NULL.
+func makeJsonTableBatch(docs []string, proc *process.Process) (*batch.Batch, error) {
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = vector.NewVec(types.T_varchar.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_int64.ToType())
+	for i, doc := range docs {
+		if err := vector.AppendBytes(bat.Vecs[0], []byte(doc), doc == "", proc.Mp()); err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+		if err := vector.AppendFixed(bat.Vecs[1], int64(i), false, proc.Mp()); err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	bat.SetRowCount(len(docs))
+	return bat, nil
+}
+
+// jsonTableRows formats the rows of the columns of json_table and the index of
+// the document passed through.
+func jsonTableRows(bat *batch.Batch, colCnt int) []string {
+	rows := make([]string, bat.RowCount())
+	for i := range rows {
+		vals := make([]string, colCnt+1)
+		for j := 0; j < colCnt; j++ {
+			vals[j] = jsonTableValueString(bat.Vecs[j], i)
+		}
+		vals[colCnt] = jsonTableValueString(bat.Vecs[len(bat.Vecs)-1], i)
+		rows[i] = strings.Join(vals, " ")
+	}
+	return rows
+}
+
+func jsonTableValueString(vec *vector.Vector, i int) string {
+	if vec.IsNull(uint64(i)) {
+		return "null"
+	}
+	switch vec.GetType().Oid {
+	case types.T_int8:
+		return fmt.Sprint(vector.GetFixedAt[int8](vec, i))
+	case types.T_int32:
+		return fmt.Sprint(vector.GetFixedAt[int32](vec, i))
+	case types.T_int64:
+		return fmt.Sprint(vector.GetFixedAt[int64](vec, i))
+	case types.T_uint32:
+		return fmt.Sprint(vector.GetFixedAt[uint32](vec, i))
+	case types.T_date:
+		return vector.GetFixedAt[types.Date](vec, i).String()
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytesAt(i)).String()
+	}
+	return vec.GetStringAt(i)
+}
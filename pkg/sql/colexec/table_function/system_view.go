@@ -31,6 +31,7 @@ import (
 	logservicepb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/query"
+	"github.com/matrixorigin/matrixone/pkg/pb/status"
 	"github.com/matrixorigin/matrixone/pkg/queryservice"
 	qclient "github.com/matrixorigin/matrixone/pkg/queryservice/client"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -750,6 +751,102 @@ func getCacheStats(proc *process.Process) ([]*query.GetCacheInfoResponse, error)
 	return rsps, err
 }
 
+func moQueryMemoryPrepare(proc *process.Process, tableFunction *TableFunction) error {
+	tableFunction.ctr.state = dataProducing
+	if len(tableFunction.Args) > 0 {
+		return moerr.NewInvalidInput(proc.Ctx, "moQueryMemory: no argument is required")
+	}
+	for i := range tableFunction.Attrs {
+		tableFunction.Attrs[i] = strings.ToUpper(tableFunction.Attrs[i])
+	}
+	return nil
+}
+
+// moQueryMemoryCall lists the memory used by the running queries of the sessions,
+// which are visible to the tenant, in all CNs.
+func moQueryMemoryCall(_ int, proc *process.Process, tableFunction *TableFunction, result *vm.CallResult) (bool, error) {
+	switch tableFunction.ctr.state {
+	case dataProducing:
+		sessions, err := fetchSessions(proc.Ctx, proc.GetSessionInfo().Account, proc.Base.QueryClient)
+		if err != nil {
+			return false, err
+		}
+
+		//alloc batch
+		bat := batch.NewWithSize(len(tableFunction.Attrs))
+		for i, col := range tableFunction.Attrs {
+			col = strings.ToLower(col)
+			idx, ok := plan2.MoQueryMemoryColName2Index[col]
+			if !ok {
+				return false, moerr.NewInternalError(proc.Ctx, "bad input select columns name %v", col)
+			}
+
+			tp := plan2.MoQueryMemoryColTypes[idx]
+			bat.Vecs[i] = proc.GetVector(tp)
+		}
+		bat.Attrs = tableFunction.Attrs
+		for _, session := range sessions {
+			// no query is running, or it holds no memory.
+			if session.MemoryPeak == 0 && session.MemoryQuota == 0 {
+				continue
+			}
+			if err = fillQueryMemoryRecord(proc, tableFunction.Attrs, bat, session); err != nil {
+				return false, err
+			}
+		}
+
+		bat.SetRowCount(bat.Vecs[0].Length())
+		result.Batch = bat
+		tableFunction.ctr.state = dataFinished
+		return false, nil
+
+	case dataFinished:
+		result.Batch = nil
+		return true, nil
+	default:
+		return false, moerr.NewInternalError(proc.Ctx, "unknown state %v", tableFunction.ctr.state)
+	}
+}
+
+func fillQueryMemoryRecord(proc *process.Process, attrs []string, bat *batch.Batch, session *status.Session) error {
+	var err error
+	mp := proc.GetMPool()
+	for colIdx, attr := range attrs {
+		switch plan2.MoQueryMemoryColType(plan2.MoQueryMemoryColName2Index[strings.ToLower(attr)]) {
+		case plan2.MoQueryMemoryColTypeNodeId:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(session.NodeID), false, mp)
+		case plan2.MoQueryMemoryColTypeConnId:
+			err = vector.AppendFixed(bat.Vecs[colIdx], session.ConnID, false, mp)
+		case plan2.MoQueryMemoryColTypeSessionId:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(session.SessionID), false, mp)
+		case plan2.MoQueryMemoryColTypeAccount:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(session.Account), false, mp)
+		case plan2.MoQueryMemoryColTypeUser:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(session.User), false, mp)
+		case plan2.MoQueryMemoryColTypeStatementId:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(session.StatementID), false, mp)
+		case plan2.MoQueryMemoryColTypeQueryStart:
+			var queryStart string
+			if !session.QueryStart.IsZero() {
+				queryStart = session.QueryStart.Format("2006-01-02 15:04:05.000000")
+			}
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(queryStart), false, mp)
+		case plan2.MoQueryMemoryColTypeInfo:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(session.Info), false, mp)
+		case plan2.MoQueryMemoryColTypeMemoryUsed:
+			err = vector.AppendFixed(bat.Vecs[colIdx], session.MemoryUsed, false, mp)
+		case plan2.MoQueryMemoryColTypeMemoryPeak:
+			err = vector.AppendFixed(bat.Vecs[colIdx], session.MemoryPeak, false, mp)
+		case plan2.MoQueryMemoryColTypeMemoryQuota:
+			err = vector.AppendFixed(bat.Vecs[colIdx], session.MemoryQuota, false, mp)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 var selectSuperTenant = func(
 	sid string,
 	selector clusterservice.Selector,
@@ -71,6 +71,10 @@ func (tableFunction *TableFunction) Call(proc *process.Process) (vm.CallResult,
 		f, e = moTransactionsCall(idx, proc, tblArg, &result)
 	case "mo_cache":
 		f, e = moCacheCall(idx, proc, tblArg, &result)
+	case "mo_query_memory":
+		f, e = moQueryMemoryCall(idx, proc, tblArg, &result)
+	case "json_table":
+		f, e = jsonTableCall(idx, proc, tblArg, &result)
 	default:
 		result.Status = vm.ExecStop
 		return result, moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.FuncName))
@@ -96,7 +100,9 @@ func (tableFunction *TableFunction) Call(proc *process.Process) (vm.CallResult,
 		return result, e
 	}
 
-	if tableFunction.ctr.buf.VectorCount() != len(tblArg.ctr.retSchema) {
+	// json_table appends the columns of the input rows to its own columns.
+	if vecCnt := tableFunction.ctr.buf.VectorCount(); vecCnt != len(tblArg.ctr.retSchema) &&
+		(tblArg.FuncName != "json_table" || vecCnt < len(tblArg.ctr.retSchema)) {
 		result.Status = vm.ExecStop
 		return result, moerr.NewInternalError(proc.Ctx, "table function %s return length mismatch", tblArg.FuncName)
 	}
@@ -154,6 +160,10 @@ func (tableFunction *TableFunction) Prepare(proc *process.Process) error {
 		return moTransactionsPrepare(proc, tblArg)
 	case "mo_cache":
 		return moCachePrepare(proc, tblArg)
+	case "mo_query_memory":
+		return moQueryMemoryPrepare(proc, tblArg)
+	case "json_table":
+		return jsonTablePrepare(proc, tblArg)
 	default:
 		return moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.FuncName))
 	}
@@ -78,6 +78,7 @@ type container struct {
 	state          int
 	buf            *batch.Batch
 	generateSeries *generateSeriesArg
+	jsonTable      *jsonTableArg
 	retSchema      []types.Type
 
 	executorsForArgs []colexec.ExpressionExecutor
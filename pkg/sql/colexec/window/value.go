@@ -0,0 +1,241 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// evalValueFunc evaluates the window functions reading the rows of the
+// partition instead of aggregating them: LAG and LEAD read the rows at an
+// offset of the current row, FIRST_VALUE, LAST_VALUE and NTH_VALUE read the
+// rows of the frame, NTILE, PERCENT_RANK and CUME_DIST depend on the position
+// of the row and of its peers in the partition.
+func (ctr *container) evalValueFunc(idx int, w *plan.WindowSpec, proc *process.Process) (*vector.Vector, error) {
+	n := ctr.bat.Vecs[0].Length()
+	args := ctr.aggVecs[idx].Vec
+
+	switch w.Name {
+	case "percent_rank", "cume_dist":
+		vals := make([]float64, n)
+		for j := 0; j < n; j++ {
+			start, end := ctr.partitionOf(j, n)
+			first, last := ctr.peersOf(j, start, end)
+			if w.Name == "cume_dist" {
+				vals[j] = float64(last-start) / float64(end-start)
+			} else if end-start > 1 {
+				vals[j] = float64(first-start) / float64(end-start-1)
+			}
+		}
+		vec := vector.NewVec(types.T_float64.ToType())
+		if err := vector.AppendFixedList(vec, vals, nil, proc.Mp()); err != nil {
+			vec.Free(proc.Mp())
+			return nil, err
+		}
+		return vec, nil
+
+	case "ntile":
+		vals := make([]int64, n)
+		for j := 0; j < n; j++ {
+			start, end := ctr.partitionOf(j, n)
+			buckets, err := offsetAt(args[0], j, 1, w.Name)
+			if err != nil {
+				return nil, err
+			}
+			vals[j] = ntile(int64(j-start), int64(end-start), buckets)
+		}
+		vec := vector.NewVec(types.T_int64.ToType())
+		if err := vector.AppendFixedList(vec, vals, nil, proc.Mp()); err != nil {
+			vec.Free(proc.Mp())
+			return nil, err
+		}
+		return vec, nil
+	}
+
+	src := args[0]
+	var nonNulls []int
+	if w.IgnoreNulls {
+		for j := 0; j < n; j++ {
+			if !src.IsNull(uint64(j)) {
+				nonNulls = append(nonNulls, j)
+			}
+		}
+	}
+
+	nullVec := vector.NewConstNull(*src.GetType(), 1, proc.Mp())
+	defer nullVec.Free(proc.Mp())
+	vec := vector.NewVec(*src.GetType())
+	for j := 0; j < n; j++ {
+		row, from, err := ctr.valueRowOf(j, n, w, args, nonNulls)
+		if err == nil {
+			if row < 0 {
+				err = vec.UnionOne(nullVec, 0, proc.Mp())
+			} else {
+				err = vec.UnionOne(from, int64(row), proc.Mp())
+			}
+		}
+		if err != nil {
+			vec.Free(proc.Mp())
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+// valueRowOf returns the row read by a value function for the row j, and the
+// vector to read it from, the row is -1 for a null result. nonNulls are the
+// rows of non-null values with IGNORE NULLS.
+func (ctr *container) valueRowOf(j, n int, w *plan.WindowSpec, args []*vector.Vector, nonNulls []int) (int, *vector.Vector, error) {
+	start, end := ctr.partitionOf(j, n)
+
+	switch w.Name {
+	case "lag", "lead":
+		offset := int64(1)
+		if len(args) > 1 {
+			var err error
+			if offset, err = offsetAt(args[1], j, 0, w.Name); err != nil {
+				return -1, nil, err
+			}
+		}
+
+		row := -1
+		if offset == 0 {
+			row = j
+		} else if !w.IgnoreNulls {
+			if w.Name == "lag" && int64(j-start) >= offset {
+				row = j - int(offset)
+			} else if w.Name == "lead" && int64(end-j) > offset {
+				row = j + int(offset)
+			}
+		} else {
+			// the offset counts the non-null rows only
+			if w.Name == "lag" {
+				k := int64(sort.SearchInts(nonNulls, j)) - offset
+				if k >= 0 && nonNulls[k] >= start {
+					row = nonNulls[k]
+				}
+			} else {
+				k := int64(sort.SearchInts(nonNulls, j+1)) + offset - 1
+				if k < int64(len(nonNulls)) && nonNulls[k] < end {
+					row = nonNulls[k]
+				}
+			}
+		}
+
+		if row < 0 && len(args) > 2 {
+			return j, args[2], nil
+		}
+		return row, args[0], nil
+
+	default:
+		left, right, err := ctr.buildInterval(j, start, end, w.Frame)
+		if err != nil {
+			return -1, nil, err
+		}
+		if left < start {
+			left = start
+		}
+		if right > end {
+			right = end
+		}
+		if left >= right {
+			return -1, args[0], nil
+		}
+
+		nth := int64(1)
+		if w.Name == "nth_value" {
+			if nth, err = offsetAt(args[1], j, 1, w.Name); err != nil {
+				return -1, nil, err
+			}
+		}
+		fromLast := w.Name == "last_value" || w.FromLast
+
+		if w.IgnoreNulls {
+			// the frame of the non-null rows
+			lo, hi := sort.SearchInts(nonNulls, left), sort.SearchInts(nonNulls, right)
+			if int64(hi-lo) < nth {
+				return -1, args[0], nil
+			}
+			if fromLast {
+				return nonNulls[hi-int(nth)], args[0], nil
+			}
+			return nonNulls[lo+int(nth)-1], args[0], nil
+		}
+
+		if int64(right-left) < nth {
+			return -1, args[0], nil
+		}
+		if fromLast {
+			return right - int(nth), args[0], nil
+		}
+		return left + int(nth) - 1, args[0], nil
+	}
+}
+
+// partitionOf returns the rows [start, end) of the partition of the row j.
+func (ctr *container) partitionOf(j, n int) (int, int) {
+	if ctr.ps != nil {
+		return buildPartitionInterval(ctr.ps, j, n)
+	}
+	return 0, n
+}
+
+// peersOf returns the rows [first, last) of the peers of the row j, the rows
+// equal to it in the order by of the window. All the rows of the partition are
+// peers without order by.
+func (ctr *container) peersOf(j, start, end int) (int, int) {
+	if ctr.os == nil {
+		return start, end
+	}
+	k := sort.Search(len(ctr.os), func(i int) bool { return ctr.os[i] > int64(j) })
+	first, last := start, end
+	if k > 0 && int(ctr.os[k-1]) > first {
+		first = int(ctr.os[k-1])
+	}
+	if k < len(ctr.os) && int(ctr.os[k]) < last {
+		last = int(ctr.os[k])
+	}
+	return first, last
+}
+
+// offsetAt returns the integer argument of the row j, which must not be null
+// and not less than minVal.
+func offsetAt(vec *vector.Vector, j int, minVal int64, name string) (int64, error) {
+	if vec.IsConst() {
+		j = 0
+	}
+	if !vec.IsNull(uint64(j)) {
+		if v := vector.MustFixedCol[int64](vec)[j]; v >= minVal {
+			return v, nil
+		}
+	}
+	return 0, moerr.NewInvalidInputNoCtx("invalid argument of window function %s", name)
+}
+
+// ntile returns the bucket of the row-th row of a partition of n rows, the
+// first n % buckets buckets have a row more than the others.
+func ntile(row, n, buckets int64) int64 {
+	size, extra := n/buckets, n%buckets
+	if row < extra*(size+1) {
+		return row/(size+1) + 1
+	}
+	return (row-extra*(size+1))/size + extra + 1
+}
@@ -127,7 +127,15 @@ func (window *Window) Call(proc *process.Process) (vm.CallResult, error) {
 
 			ctr.bat.Aggs = make([]aggexec.AggFuncExec, len(window.Aggs))
 			for i, ag := range window.Aggs {
-				ctr.bat.Aggs[i] = aggexec.MakeAgg(proc, ag.GetAggID(), ag.IsDistinct(), window.Types[i])
+				if function.GetFunctionIsWinValueFunByName(window.WinSpecList[i].Expr.(*plan.Expr_W).W.Name) {
+					continue
+				}
+				// the aggregations of several arguments need the types of all of them
+				typs := ctr.aggVecs[i].Typ
+				if len(typs) == 0 {
+					typs = window.Types[i : i+1]
+				}
+				ctr.bat.Aggs[i] = aggexec.MakeAgg(proc, ag.GetAggID(), ag.IsDistinct(), typs...)
 				if config := ag.GetExtraConfig(); config != nil {
 					if err = ctr.bat.Aggs[i].SetExtraInformation(config, 0); err != nil {
 						return result, err
@@ -183,7 +191,20 @@ func (window *Window) Call(proc *process.Process) (vm.CallResult, error) {
 func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, anal process.Analyze) error {
 	var err error
 	n := ctr.bat.Vecs[0].Length()
-	isWinOrder := function.GetFunctionIsWinOrderFunByName(ap.WinSpecList[idx].Expr.(*plan.Expr_W).W.Name)
+	w := ap.WinSpecList[idx].Expr.(*plan.Expr_W).W
+	if function.GetFunctionIsWinValueFunByName(w.Name) {
+		vec, err := ctr.evalValueFunc(idx, w, proc)
+		if err != nil {
+			return err
+		}
+		ctr.bat.Vecs = append(ctr.bat.Vecs, vec)
+		anal.Alloc(int64(vec.Size()))
+		ctr.os = nil
+		ctr.ps = nil
+		return nil
+	}
+
+	isWinOrder := function.GetFunctionIsWinOrderFunByName(w.Name)
 	if isWinOrder {
 		if ctr.ps == nil {
 			ctr.ps = append(ctr.ps, 0)
@@ -233,7 +254,7 @@ func (ctr *container) processFunc(idx int, ap *Window, proc *process.Process, an
 				start, end = buildPartitionInterval(ctr.ps, j, n)
 			}
 
-			left, right, err := ctr.buildInterval(j, start, end, ap.WinSpecList[idx].Expr.(*plan.Expr_W).W.Frame)
+			left, right, err := ctr.buildInterval(j, start, end, w.Frame)
 			if err != nil {
 				return err
 			}
@@ -518,9 +539,11 @@ func (ctr *container) processOrder(idx int, ap *Window, bat *batch.Batch, proc *
 
 	// shuffle agg vector
 	for k := idx; k < len(ctr.aggVecs); k++ {
-		if len(ctr.aggVecs[k].Vec) > 0 && !ctr.aggVecs[k].Executor[0].IsColumnExpr() {
-			if err := ctr.aggVecs[k].Vec[0].Shuffle(ctr.sels, proc.Mp()); err != nil {
-				panic(err)
+		for j := range ctr.aggVecs[k].Vec {
+			if !ctr.aggVecs[k].Executor[j].IsColumnExpr() {
+				if err := ctr.aggVecs[k].Vec[j].Shuffle(ctr.sels, proc.Mp()); err != nil {
+					panic(err)
+				}
 			}
 		}
 	}
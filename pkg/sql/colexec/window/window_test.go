@@ -18,7 +18,11 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/value_scan"
+	"github.com/stretchr/testify/require"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/vm"
@@ -104,6 +108,195 @@ func TestString(t *testing.T) {
 	}
 }
 
+func TestValueFunctions(t *testing.T) {
+	proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+	i64 := plan.Type{Id: int32(types.T_int64)}
+	col := func(pos int32) *plan.Expr {
+		return &plan.Expr{Typ: i64, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos}}}
+	}
+	lit := func(v int64) *plan.Expr {
+		return &plan.Expr{Typ: i64, Expr: &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_I64Val{I64Val: v}}}}
+	}
+	rangeFrame := &plan.FrameClause{
+		Type:  plan.FrameClause_RANGE,
+		Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, UnBounded: true},
+		End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+	}
+	rowsFrame := func(pre, fol uint64) *plan.FrameClause {
+		bound := func(v uint64) *plan.Expr {
+			return &plan.Expr{Expr: &plan.Expr_Lit{Lit: &plan.Literal{Value: &plan.Literal_U64Val{U64Val: v}}}}
+		}
+		return &plan.FrameClause{
+			Type:  plan.FrameClause_ROWS,
+			Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: bound(pre)},
+			End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Val: bound(fol)},
+		}
+	}
+	unboundedFrame := &plan.FrameClause{
+		Type:  plan.FrameClause_ROWS,
+		Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, UnBounded: true},
+		End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, UnBounded: true},
+	}
+
+	// the rows ordered by c, v is null on the rows 1 and 3
+	c := []int64{1, 2, 2, 3, 4}
+	v := []int64{10, 0, 30, 0, 50}
+	nulls := []bool{false, true, false, true, false}
+
+	arg := &Window{}
+	win := func(name string, frame *plan.FrameClause, ignoreNulls, fromLast bool, args ...*plan.Expr) {
+		arg.WinSpecList = append(arg.WinSpecList, &plan.Expr{
+			Typ: i64,
+			Expr: &plan.Expr_W{W: &plan.WindowSpec{
+				WindowFunc:  &plan.Expr{Typ: i64, Expr: &plan.Expr_F{F: &plan.Function{Args: args}}},
+				OrderBy:     []*plan.OrderBySpec{{Expr: col(0), Flag: plan.OrderBySpec_ASC}},
+				Frame:       frame,
+				Name:        name,
+				IgnoreNulls: ignoreNulls,
+				FromLast:    fromLast,
+			}},
+		})
+		arg.Aggs = append(arg.Aggs, aggexec.MakeAggFunctionExpression(0, false, args, nil))
+		arg.Types = append(arg.Types, types.T_int64.ToType())
+	}
+	win("lag", rangeFrame, false, false, col(1))
+	win("lag", rangeFrame, true, false, col(1), lit(1))
+	win("lead", rangeFrame, false, false, col(1), lit(2), lit(0))
+	win("first_value", rowsFrame(1, 1), true, false, col(1))
+	win("last_value", rangeFrame, false, false, col(1))
+	win("nth_value", unboundedFrame, true, true, col(1), lit(2))
+	win("ntile", rangeFrame, false, false, lit(2))
+	win("percent_rank", rangeFrame, false, false)
+	win("cume_dist", rangeFrame, false, false)
+
+	cv := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(cv, c, nil, proc.Mp()))
+	vv := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(vv, v, nulls, proc.Mp()))
+	valueScanArg := &value_scan.ValueScan{
+		Batchs: []*batch.Batch{testutil.NewBatchWithVectors([]*vector.Vector{cv, vv}, nil), nil},
+	}
+	require.NoError(t, valueScanArg.Prepare(proc))
+	arg.AppendChild(valueScanArg)
+	require.NoError(t, arg.Prepare(proc))
+
+	result, err := arg.Call(proc)
+	require.NoError(t, err)
+	bat := result.Batch
+	require.Equal(t, 11, len(bat.Vecs))
+
+	// -1 is null
+	values := func(vec *vector.Vector) []int64 {
+		ret := make([]int64, vec.Length())
+		for i, x := range vector.MustFixedCol[int64](vec) {
+			if vec.IsNull(uint64(i)) {
+				x = -1
+			}
+			ret[i] = x
+		}
+		return ret
+	}
+	require.Equal(t, []int64{-1, 10, -1, 30, -1}, values(bat.Vecs[2]))
+	require.Equal(t, []int64{-1, 10, 10, 30, 30}, values(bat.Vecs[3]))
+	require.Equal(t, []int64{30, -1, 50, 0, 0}, values(bat.Vecs[4]))
+	require.Equal(t, []int64{10, 10, 30, 30, 50}, values(bat.Vecs[5]))
+	require.Equal(t, []int64{10, 30, 30, -1, 50}, values(bat.Vecs[6]))
+	require.Equal(t, []int64{30, 30, 30, 30, 30}, values(bat.Vecs[7]))
+	require.Equal(t, []int64{1, 1, 1, 2, 2}, values(bat.Vecs[8]))
+	require.Equal(t, []float64{0, 0.25, 0.25, 0.75, 1}, vector.MustFixedCol[float64](bat.Vecs[9]))
+	require.Equal(t, []float64{0.2, 0.6, 0.6, 0.8, 1}, vector.MustFixedCol[float64](bat.Vecs[10]))
+
+	arg.Free(proc, false, nil)
+	valueScanArg.Free(proc, false, nil)
+	proc.Free()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestStatisticsAggregates(t *testing.T) {
+	proc := testutil.NewProcessWithMPool("", mpool.MustNewZero())
+	f64 := plan.Type{Id: int32(types.T_float64)}
+	col := func(pos int32) *plan.Expr {
+		return &plan.Expr{Typ: f64, Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos}}}
+	}
+	rangeFrame := &plan.FrameClause{
+		Type:  plan.FrameClause_RANGE,
+		Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, UnBounded: true},
+		End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+	}
+	unboundedFrame := &plan.FrameClause{
+		Type:  plan.FrameClause_ROWS,
+		Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, UnBounded: true},
+		End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, UnBounded: true},
+	}
+
+	slopeID, covarID := int64(1<<40), int64(1<<40+1)
+	aggexec.RegisterRegrSlopeAgg(slopeID)
+	aggexec.RegisterCovarSampAgg(covarID)
+
+	arg := &Window{}
+	win := func(name string, id int64, frame *plan.FrameClause) {
+		args := []*plan.Expr{col(0), col(1)}
+		arg.WinSpecList = append(arg.WinSpecList, &plan.Expr{
+			Typ: f64,
+			Expr: &plan.Expr_W{W: &plan.WindowSpec{
+				WindowFunc: &plan.Expr{Typ: f64, Expr: &plan.Expr_F{F: &plan.Function{Args: args}}},
+				OrderBy:    []*plan.OrderBySpec{{Expr: col(1), Flag: plan.OrderBySpec_ASC}},
+				Frame:      frame,
+				Name:       name,
+			}},
+		})
+		arg.Aggs = append(arg.Aggs, aggexec.MakeAggFunctionExpression(id, false, args, nil))
+		arg.Types = append(arg.Types, types.T_float64.ToType())
+	}
+	win("regr_slope", slopeID, unboundedFrame)
+	win("covar_samp", covarID, rangeFrame)
+
+	// (y, x) ordered by x
+	yv := vector.NewVec(types.T_float64.ToType())
+	require.NoError(t, vector.AppendFixedList(yv, []float64{1, 2, 3, 4}, nil, proc.Mp()))
+	xv := vector.NewVec(types.T_float64.ToType())
+	require.NoError(t, vector.AppendFixedList(xv, []float64{2, 4, 6, 9}, nil, proc.Mp()))
+	valueScanArg := &value_scan.ValueScan{
+		Batchs: []*batch.Batch{testutil.NewBatchWithVectors([]*vector.Vector{yv, xv}, nil), nil},
+	}
+	require.NoError(t, valueScanArg.Prepare(proc))
+	arg.AppendChild(valueScanArg)
+	require.NoError(t, arg.Prepare(proc))
+
+	result, err := arg.Call(proc)
+	require.NoError(t, err)
+	bat := result.Batch
+	require.Equal(t, 4, len(bat.Vecs))
+
+	for _, slope := range vector.MustFixedCol[float64](bat.Vecs[2]) {
+		require.InDelta(t, 11.5/26.75, slope, 1e-9)
+	}
+	covars := vector.MustFixedCol[float64](bat.Vecs[3])
+	require.True(t, bat.Vecs[3].IsNull(0))
+	require.InDelta(t, 1.0, covars[1], 1e-9)
+	require.InDelta(t, 2.0, covars[2], 1e-9)
+	require.InDelta(t, 11.5/3, covars[3], 1e-9)
+
+	arg.Free(proc, false, nil)
+	valueScanArg.Free(proc, false, nil)
+	proc.Free()
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestNtile(t *testing.T) {
+	var buckets []int64
+	for i := int64(0); i < 7; i++ {
+		buckets = append(buckets, ntile(i, 7, 3))
+	}
+	require.Equal(t, []int64{1, 1, 1, 2, 2, 3, 3}, buckets)
+
+	buckets = buckets[:0]
+	for i := int64(0); i < 3; i++ {
+		buckets = append(buckets, ntile(i, 3, 5))
+	}
+	require.Equal(t, []int64{1, 2, 3}, buckets)
+}
+
 func newTestCase(flgs []bool, ts []types.Type, exprs []*plan.Expr, aggs []aggexec.AggFuncExecExpression) winTestCase {
 	for _, expr := range exprs {
 		if col, ok := expr.Expr.(*plan.Expr_Col); ok {
@@ -242,6 +242,9 @@ func (c *Compile) clear() {
 	c.isInternal = false
 	c.lastAllocID = 0
 	c.isPrepare = false
+	c.parallelOutput = false
+	c.maxExecutionTime = 0
+	c.maxDop = 0
 
 	for k := range c.metaTables {
 		delete(c.metaTables, k)
@@ -421,6 +424,18 @@ func (c *Compile) SetIsPrepare(isPrepare bool) {
 	c.isPrepare = isPrepare
 }
 
+// SetParallelOutput makes every parallel pipeline of the query sends its
+// result by the fill function, the fill function should be thread safe.
+func (c *Compile) SetParallelOutput(parallelOutput bool) {
+	c.parallelOutput = parallelOutput
+}
+
+// SetMaxExecutionTime sets the time limit of the query, the pipelines
+// are cancelled with ErrQueryTimeout once it runs out. 0 means no limit.
+func (c *Compile) SetMaxExecutionTime(d time.Duration) {
+	c.maxExecutionTime = d
+}
+
 /*
 func (c *Compile) printPipeline() {
 	if c.IsTpQuery() {
@@ -787,6 +802,7 @@ func (c *Compile) compileQuery(qry *plan.Query) ([]*Scope, error) {
 	}()
 
 	c.execType = plan2.GetExecType(c.pn.GetQuery(), c.getHaveDDL())
+	c.maxDop = int(qry.Dop)
 
 	n := getEngineNode(c)
 	if c.execType == plan2.ExecTypeTP || c.execType == plan2.ExecTypeAP_ONECN {
@@ -799,6 +815,11 @@ func (c *Compile) compileQuery(qry *plan.Query) ([]*Scope, error) {
 		c.removeUnavailableCN()
 		// sort by addr to get fixed order of CN list
 		sort.Slice(c.cnList, func(i, j int) bool { return c.cnList[i].Addr < c.cnList[j].Addr })
+		if c.maxDop > 0 {
+			for i := range c.cnList {
+				c.cnList[i].Mcpu = min(c.cnList[i].Mcpu, c.maxDop)
+			}
+		}
 	}
 
 	if c.isPrepare && !c.IsTpQuery() {
@@ -883,18 +904,32 @@ func (c *Compile) compileSteps(qry *plan.Query, ss []*Scope, step int32) (*Scope
 		return ss[0], nil
 	default:
 		var rs *Scope
+		var parallelOutput bool
 		if c.IsSingleScope(ss) {
 			rs = ss[0]
 		} else {
 			ss = c.mergeShuffleScopesIfNeeded(ss, false)
+			if parallelOutput = c.parallelOutput; parallelOutput {
+				// the pipelines send the result by themselves, and the merge
+				// scope only waits for them.
+				for i := range ss {
+					ss[i].setRootOperator(
+						output.NewArgument().
+							WithFunc(c.fill).
+							WithParallel(true),
+					)
+				}
+			}
 			rs = c.newMergeScope(ss)
 		}
 		updateScopesLastFlag([]*Scope{rs})
 		c.setAnalyzeCurrent([]*Scope{rs}, c.anal.curNodeIdx)
-		rs.setRootOperator(
-			output.NewArgument().
-				WithFunc(c.fill),
-		)
+		if !parallelOutput {
+			rs.setRootOperator(
+				output.NewArgument().
+					WithFunc(c.fill),
+			)
+		}
 		return rs, nil
 	}
 }
@@ -1365,7 +1400,7 @@ func (c *Compile) compileSourceScan(n *plan.Node) ([]*Scope, error) {
 	if err != nil {
 		return nil, err
 	}
-	ps := calculatePartitions(0, end, int64(ncpu))
+	ps := calculatePartitions(0, end, int64(c.cpuNum()))
 
 	ss := make([]*Scope, len(ps))
 
@@ -1666,7 +1701,7 @@ func (c *Compile) compileExternValueScan(n *plan.Node, param *tree.ExternParam,
 	s.setRootOperator(op)
 	c.anal.isFirst = false
 
-	parallelSize := c.getParallelSizeForExternalScan(n, ncpu)
+	parallelSize := c.getParallelSizeForExternalScan(n, c.cpuNum())
 	if parallelSize == 1 {
 		return []*Scope{s}, nil
 	}
@@ -2945,7 +2980,7 @@ func (c *Compile) compileInsert(ns []*plan.Node, n *plan.Node, ss []*Scope) ([]*
 				// reset the channel buffer of sink for load
 				dataScope.Proc.Reg.MergeReceivers[0].Ch = make(chan *process.RegisterMessage, dataScope.NodeInfo.Mcpu)
 			}
-			parallelSize := c.getParallelSizeForExternalScan(n, ncpu)
+			parallelSize := c.getParallelSizeForExternalScan(n, c.cpuNum())
 			scopes := make([]*Scope, 0, parallelSize)
 			regs := make([]*process.WaitRegister, 0, parallelSize)
 			for i := 0; i < parallelSize; i++ {
@@ -3460,7 +3495,7 @@ func (c *Compile) newBroadcastJoinScopeList(probeScopes []*Scope, buildScopes []
 	for i := range rs {
 		rs[i].Magic = Remote
 		rs[i].IsJoin = true
-		rs[i].NodeInfo.Mcpu = c.generateCPUNumber(ncpu, int(n.Stats.BlockNum))
+		rs[i].NodeInfo.Mcpu = c.generateCPUNumber(c.cpuNum(), int(n.Stats.BlockNum))
 		rs[i].BuildIdx = len(rs[i].Proc.Reg.MergeReceivers)
 	}
 
@@ -3512,7 +3547,7 @@ func (c *Compile) newShuffleJoinScopeList(left, right []*Scope, n *plan.Node) []
 	left = c.mergeShuffleScopesIfNeeded(left, true)
 	right = c.mergeShuffleScopesIfNeeded(right, true)
 
-	dop := plan2.GetShuffleDop(ncpu)
+	dop := plan2.GetShuffleDop(c.cpuNum())
 	shuffleJoins := make([]*Scope, 0, len(c.cnList)*dop)
 	lnum := len(left)
 	sum := lnum + len(right)
@@ -3637,6 +3672,15 @@ func regTransplant(source, target *Scope, sourceIdx, targetIdx int) {
 	}
 }
 
+// cpuNum returns the number of cpus a pipeline of the query may use on this
+// CN, which is limited by the DOP hint.
+func (c *Compile) cpuNum() int {
+	if c.maxDop > 0 && c.maxDop < ncpu {
+		return c.maxDop
+	}
+	return ncpu
+}
+
 func (c *Compile) generateCPUNumber(cpunum, blocks int) int {
 	if cpunum <= 0 || blocks <= 16 || c.IsTpQuery() {
 		return 1
@@ -3980,7 +4024,7 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, []any, []types.T, e
 		// add current CN
 		nodes = append(nodes, engine.Node{
 			Addr: c.addr,
-			Mcpu: c.generateCPUNumber(ncpu, int(n.Stats.BlockNum)),
+			Mcpu: c.generateCPUNumber(c.cpuNum(), int(n.Stats.BlockNum)),
 		})
 		nodes[0].NeedExpandRanges = true
 		return nodes, nil, nil, nil
@@ -4402,7 +4446,7 @@ func putBlocksInAverage(c *Compile, relData engine.RelData, n *plan.Node) engine
 				if len(nodes) == 0 {
 					nodes = append(nodes, engine.Node{
 						Addr: c.addr,
-						Mcpu: c.generateCPUNumber(ncpu, int(n.Stats.BlockNum)),
+						Mcpu: c.generateCPUNumber(c.cpuNum(), int(n.Stats.BlockNum)),
 						Data: relData.BuildEmptyRelData(),
 					})
 				}
@@ -4435,7 +4479,7 @@ func putBlocksInAverage(c *Compile, relData engine.RelData, n *plan.Node) engine
 				if len(nodes) == 0 {
 					nodes = append(nodes, engine.Node{
 						Addr: c.addr,
-						Mcpu: c.generateCPUNumber(ncpu, int(n.Stats.BlockNum)),
+						Mcpu: c.generateCPUNumber(c.cpuNum(), int(n.Stats.BlockNum)),
 						Data: relData.BuildEmptyRelData(),
 					})
 				}
@@ -4517,7 +4561,7 @@ func shuffleBlocksToMultiCN(c *Compile, rel engine.Relation, relData engine.RelD
 	// add current CN
 	nodes = append(nodes, engine.Node{
 		Addr: c.addr,
-		Mcpu: c.generateCPUNumber(ncpu, int(n.Stats.BlockNum)),
+		Mcpu: c.generateCPUNumber(c.cpuNum(), int(n.Stats.BlockNum)),
 	})
 	// add memory table block
 	nodes[0].Data = relData.BuildEmptyRelData()
@@ -4702,7 +4746,7 @@ func putBlocksInCurrentCN(c *Compile, relData engine.RelData, n *plan.Node) engi
 	// add current CN
 	nodes = append(nodes, engine.Node{
 		Addr: c.addr,
-		Mcpu: c.generateCPUNumber(ncpu, int(n.Stats.BlockNum)),
+		Mcpu: c.generateCPUNumber(c.cpuNum(), int(n.Stats.BlockNum)),
 	})
 	nodes[0].Data = relData
 	return nodes
@@ -5001,7 +5045,7 @@ func getEngineNode(c *Compile) engine.Node {
 	if c.IsTpQuery() {
 		return engine.Node{Addr: c.addr, Mcpu: 1}
 	} else {
-		return engine.Node{Addr: c.addr, Mcpu: ncpu}
+		return engine.Node{Addr: c.addr, Mcpu: c.cpuNum()}
 	}
 }
 
@@ -134,6 +134,17 @@ func (c *Compile) Run(_ uint64) (queryResult *util2.RunResult, err error) {
 	// clear the last query context to avoid process reuse.
 	c.proc.ResetQueryContext()
 
+	// the time limit covers all the runs of the query, including the retries.
+	var deadline time.Time
+	if c.maxExecutionTime > 0 {
+		deadline = time.Now().Add(c.maxExecutionTime)
+	}
+	c.proc.Base.GetContextBase().SetDeadline(deadline)
+
+	// so does the memory quota.
+	exitQuota := c.proc.EnterMemoryQuota()
+	defer exitQuota()
+
 	// the runC is the final object for executing the query, it's not always the same as c because of retry.
 	var runC = c
 
@@ -210,6 +221,14 @@ func (c *Compile) Run(_ uint64) (queryResult *util2.RunResult, err error) {
 		}
 
 		c.fatalLog(retryTimes, err)
+		// the pipelines fail with kinds of errors once the query runs out of time.
+		if e := runC.proc.GetQueryContextError(); moerr.IsMoErrCode(e, moerr.ErrQueryTimeout) {
+			return nil, e
+		}
+		// and so do they once the query runs out of its memory quota.
+		if runC.proc.Mp().QuotaExceeded() {
+			return nil, moerr.NewQueryMemoryLimit(c.proc.Ctx, runC.proc.Mp().Quota())
+		}
 		if !c.canRetry(err) {
 			if c.proc.GetTxnOperator().Txn().IsRCIsolation() &&
 				moerr.IsMoErrCode(err, moerr.ErrDuplicateEntry) {
@@ -80,6 +80,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/onduplicatekey"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/partition"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/preinsertsecondaryindex"
@@ -148,6 +149,7 @@ func dupOperator(sourceOp vm.Operator, regMap map[*process.WaitRegister]*process
 		op.Exprs = t.Exprs
 		op.Types = t.Types
 		op.Aggs = t.Aggs
+		op.GroupingIds = t.GroupingIds
 		op.ProjectList = t.ProjectList
 		op.SetInfo(&info)
 		return op
@@ -393,6 +395,14 @@ func dupOperator(sourceOp vm.Operator, regMap map[*process.WaitRegister]*process
 		op := intersectall.NewArgument()
 		op.SetInfo(&info)
 		return op
+	case vm.Output:
+		t := sourceOp.(*output.Output)
+		op := output.NewArgument()
+		op.Data = t.Data
+		op.Func = t.Func
+		op.Parallel = t.Parallel
+		op.SetInfo(&info)
+		return op
 	case vm.Merge:
 		t := sourceOp.(*merge.Merge)
 		op := merge.NewArgument()
@@ -1371,6 +1381,7 @@ func constructGroup(_ context.Context, n, cn *plan.Node, needEval bool, shuffleD
 	arg.Types = typs
 	arg.NeedEval = needEval
 	arg.Exprs = n.GroupBy
+	arg.GroupingIds = n.GroupingIds
 	arg.IsShuffle = shuffleGroup
 	arg.PreAllocSize = preAllocSize
 	return arg
@@ -1857,6 +1868,8 @@ func constructHashBuild(op vm.Operator, proc *process.Process, mcpu int32) *hash
 			ret.RuntimeFilterSpec = arg.RuntimeFilterSpecs[0]
 		}
 		ret.JoinMapTag = arg.JoinMapTag
+		// inner join can work on the build side spilled to disk.
+		ret.CanSpill = true
 
 	case vm.Left:
 		arg := op.(*left.LeftJoin)
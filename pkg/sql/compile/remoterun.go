@@ -556,6 +556,7 @@ func convertToPipelineInstruction(op vm.Operator, ctx *scopeContext, ctxId int32
 			Exprs:        t.Exprs,
 			Types:        convertToPlanTypes(t.Types),
 			Aggs:         convertToPipelineAggregates(t.Aggs),
+			GroupingIds:  t.GroupingIds,
 		}
 		in.ProjectList = t.ProjectList
 	case *sample.Sample:
@@ -1022,6 +1023,7 @@ func convertToVmOperator(opr *pipeline.Instruction, ctx *scopeContext, eng engin
 		arg.Exprs = t.Exprs
 		arg.Types = convertToTypes(t.Types)
 		arg.Aggs = convertToAggregates(t.Aggs)
+		arg.GroupingIds = t.GroupingIds
 		arg.ProjectList = opr.ProjectList
 		op = arg
 	case vm.Sample:
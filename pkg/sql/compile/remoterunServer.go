@@ -202,6 +202,11 @@ func handlePipelineMessage(receiver *messageReceiverOnServer) error {
 		}()
 
 		err = s.ParallelRun(runCompile)
+		if e := runCompile.proc.GetQueryContextError(); moerr.IsMoErrCode(e, moerr.ErrQueryTimeout) {
+			err = e
+		} else if runCompile.proc.Mp().QuotaExceeded() {
+			err = moerr.NewQueryMemoryLimit(runCompile.proc.Ctx, runCompile.proc.Mp().Quota())
+		}
 		if err == nil {
 			// record the number of s3 requests
 			runCompile.proc.Base.AnalInfos[runCompile.anal.curNodeIdx].S3IOInputCount += runCompile.counterSet.FileService.S3.Put.Load()
@@ -274,6 +279,8 @@ type processHelper struct {
 	analysisNodeList []int32
 	StmtId           uuid.UUID
 	prepareParams    *vector.Vector
+	// timeout is the remaining time of the query, 0 means no limit.
+	timeout time.Duration
 }
 
 // messageReceiverOnServer supported a series methods to write back results.
@@ -396,6 +403,10 @@ func (receiver *messageReceiverOnServer) newCompile() (*Compile, error) {
 	proc.Base.SessionInfo.StorageEngine = cnInfo.storeEngine
 	proc.Base.AnalInfos = make([]*process.AnalyzeInfo, len(pHelper.analysisNodeList))
 	proc.SetPrepareParams(pHelper.prepareParams)
+	if pHelper.timeout > 0 {
+		proc.Base.GetContextBase().SetDeadline(time.Now().Add(pHelper.timeout))
+	}
+	mp.SetQuota(pHelper.lim.MemoryQuota)
 	for i := range proc.Base.AnalInfos {
 		proc.Base.AnalInfos[i] = reuse.Alloc[process.AnalyzeInfo](nil)
 		proc.Base.AnalInfos[i].NodeId = pHelper.analysisNodeList[i]
@@ -528,6 +539,7 @@ func generateProcessHelper(data []byte, cli client.TxnClient) (processHelper, er
 		accountId:        procInfo.AccountId,
 		txnClient:        cli,
 		analysisNodeList: procInfo.GetAnalysisNodeList(),
+		timeout:          time.Duration(procInfo.Timeout),
 	}
 	if procInfo.PrepareParams.Length > 0 {
 		result.prepareParams = vector.NewVecWithData(
@@ -348,10 +348,12 @@ func Test_mergeAnalyseInfo(t *testing.T) {
 
 func Test_convertToProcessLimitation(t *testing.T) {
 	lim := pipeline.ProcessLimitation{
-		Size: 100,
+		Size:        100,
+		MemoryQuota: 1024,
 	}
 	limitation := process.ConvertToProcessLimitation(lim)
 	require.Equal(t, limitation.Size, int64(100))
+	require.Equal(t, limitation.MemoryQuota, int64(1024))
 }
 
 func Test_convertToProcessSessionInfo(t *testing.T) {
@@ -549,7 +549,7 @@ func buildScanParallelRun(s *Scope, c *Compile) (*Scope, error) {
 	if s.IsRemote && len(s.DataSource.OrderBy) > 0 {
 		return nil, moerr.NewInternalError(c.proc.Ctx, "ordered scan cannot run in remote.")
 	}
-	maxProvidedCpuNumber := ncpu
+	maxProvidedCpuNumber := c.cpuNum()
 	if s.NodeInfo.Mcpu == 1 {
 		maxProvidedCpuNumber = 1
 	}
@@ -817,7 +817,8 @@ func newParallelScope(c *Compile, s *Scope, ss []*Scope) (*Scope, error) {
 				groupOp := group.NewArgument().
 					WithExprs(arg.Exprs).
 					WithTypes(arg.Types).
-					WithAggsNew(arg.Aggs)
+					WithAggsNew(arg.Aggs).
+					WithGroupingIds(arg.GroupingIds)
 				groupOp.SetInfo(&vm.OperatorInfo{
 					Idx:         arg.Idx,
 					IsFirst:     arg.IsFirst,
@@ -915,6 +916,12 @@ func newParallelScope(c *Compile, s *Scope, ss []*Scope) (*Scope, error) {
 			}
 			arg.Release()
 		case vm.Output:
+			// the parallel output is sent by every pipeline
+			if op.(*output.Output).Parallel {
+				for j := range ss {
+					ss[j].setRootOperator(dupOperator(op, nil, j))
+				}
+			}
 		default:
 			if op != s.RootOp {
 				for j := range ss {
@@ -62,6 +62,8 @@ func (c *compilerContext) GetViews() []string {
 
 func (c *compilerContext) SetViews(views []string) {}
 
+func (c *compilerContext) AddWarning(warn error) {}
+
 func (c *compilerContext) GetSnapshot() *plan.Snapshot {
 	return nil
 }
@@ -311,6 +311,13 @@ type Compile struct {
 	lastAllocID int32
 
 	isPrepare bool
+	// parallelOutput is true if the result can be sent by the parallel
+	// pipelines in any order, such as exporting the result into the files.
+	parallelOutput bool
+	// maxExecutionTime is the time limit of the query, 0 means no limit.
+	maxExecutionTime time.Duration
+	// maxDop is the degree of parallelism given by the DOP hint, 0 means no limit.
+	maxDop int
 }
 
 type RemoteReceivRegInfo struct {
@@ -0,0 +1,90 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// parseOptimizerHints parses the text of the /*+ ... */ comment, such as
+// `MAX_EXECUTION_TIME(1000) LEADING(t1, t2)`. Like MySQL, a malformed hint
+// is not an error of the statement, the parsing stops there and the hints
+// before it are kept.
+func parseOptimizerHints(text string) tree.OptimizerHints {
+	var hints tree.OptimizerHints
+	i := 0
+	skipBlank := func() {
+		for i < len(text) && isHintBlank(text[i]) {
+			i++
+		}
+	}
+	for {
+		skipBlank()
+		start := i
+		for i < len(text) && isHintNameChar(text[i]) {
+			i++
+		}
+		if start == i {
+			return hints
+		}
+		hint := &tree.OptimizerHint{Name: strings.ToUpper(text[start:i])}
+		skipBlank()
+		if i < len(text) && text[i] == '(' {
+			i++
+			closed := false
+			for !closed {
+				for i < len(text) && (isHintBlank(text[i]) || text[i] == ',') {
+					i++
+				}
+				if i == len(text) {
+					return hints
+				}
+				if text[i] == ')' {
+					i++
+					closed = true
+					continue
+				}
+				start = i
+				if text[i] == '`' {
+					end := strings.IndexByte(text[i+1:], '`')
+					if end < 0 {
+						return hints
+					}
+					i += end + 2
+					hint.Args = append(hint.Args, text[start+1:i-1])
+					continue
+				}
+				for i < len(text) && !isHintBlank(text[i]) && text[i] != ',' && text[i] != '(' && text[i] != ')' {
+					i++
+				}
+				if start == i {
+					return hints
+				}
+				hint.Args = append(hint.Args, text[start:i])
+			}
+		}
+		hints = append(hints, hint)
+	}
+}
+
+func isHintBlank(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isHintNameChar(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
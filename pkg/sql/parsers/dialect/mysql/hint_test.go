@@ -0,0 +1,89 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func TestOptimizerHints(t *testing.T) {
+	testcases := []struct {
+		sql   string
+		hints string
+	}{
+		{"select /*+ max_execution_time(1000) */ a from t", "/*+ MAX_EXECUTION_TIME(1000) */"},
+		{"SELECT /*+ MAX_EXECUTION_TIME(10) leading(t1 `t 2`,t3) */ distinct a from t1", "/*+ MAX_EXECUTION_TIME(10) LEADING(t1, `t 2`, t3) */"},
+		{"select /*+ NO_RUNTIME_FILTER HASH_JOIN() */ a from t", "/*+ NO_RUNTIME_FILTER HASH_JOIN */"},
+		{"select /*+ MAX_EXECUTION_TIME(5) BAD( */ a from t", "/*+ MAX_EXECUTION_TIME(5) */"},
+		{"select /*+ MAX_EXECUTION_TIME(5 */ a from t", ""},
+		{"select /*+ */ a from t", ""},
+		{"select /* comment */ /*+ MAX_EXECUTION_TIME(5) */ a from t", "/*+ MAX_EXECUTION_TIME(5) */"},
+		// the hints must follow the SELECT keyword
+		{"select a /*+ MAX_EXECUTION_TIME(5) */ from t", ""},
+	}
+	for _, tc := range testcases {
+		stmt, err := ParseOne(context.TODO(), tc.sql, 1)
+		require.NoError(t, err, tc.sql)
+		clause := stmt.(*tree.Select).Select.(*tree.SelectClause)
+		if tc.hints == "" {
+			require.Empty(t, clause.Hints, tc.sql)
+			continue
+		}
+		require.Equal(t, tc.hints, tree.String(clause.Hints, dialect.MYSQL), tc.sql)
+
+		// the hints are kept in the statement text.
+		stmt, err = ParseOne(context.TODO(), tree.String(stmt, dialect.MYSQL), 1)
+		require.NoError(t, err, tc.sql)
+		clause = stmt.(*tree.Select).Select.(*tree.SelectClause)
+		require.Equal(t, tc.hints, tree.String(clause.Hints, dialect.MYSQL), tc.sql)
+	}
+
+	// the hints of the subquery belong to its own query block
+	stmt, err := ParseOne(context.TODO(), "select * from (select /*+ MAX_EXECUTION_TIME(5) */ a from t) as x", 1)
+	require.NoError(t, err)
+	require.Empty(t, stmt.(*tree.Select).Select.(*tree.SelectClause).Hints)
+
+	_, err = ParseOne(context.TODO(), "select /*+ MAX_EXECUTION_TIME(5) a from t", 1)
+	require.Error(t, err)
+}
+
+func TestOptimizerHintsOfDML(t *testing.T) {
+	stmt, err := ParseOne(context.TODO(), "update /*+ NO_RUNTIME_FILTER DOP(4) */ t set a = 1 where b = 2", 1)
+	require.NoError(t, err)
+	require.Equal(t, "/*+ NO_RUNTIME_FILTER DOP(4) */", tree.String(stmt.(*tree.Update).Hints, dialect.MYSQL))
+	require.Equal(t, "update /*+ NO_RUNTIME_FILTER DOP(4) */ t set a = 1 where b = 2", tree.String(stmt, dialect.MYSQL))
+
+	stmt, err = ParseOne(context.TODO(), "delete /*+ LEADING(t1 t2) */ t1 from t1, t2 where t1.a = t2.a", 1)
+	require.NoError(t, err)
+	require.Equal(t, "/*+ LEADING(t1, t2) */", tree.String(stmt.(*tree.Delete).Hints, dialect.MYSQL))
+	require.Equal(t, "delete /*+ LEADING(t1, t2) */ from t1 using t1 cross join t2 where t1.a = t2.a", tree.String(stmt, dialect.MYSQL))
+
+	stmt, err = ParseOne(context.TODO(), "with c as (select 1 as a) delete /*+ DOP(2) */ from t where a in (select a from c)", 1)
+	require.NoError(t, err)
+	require.Equal(t, "/*+ DOP(2) */", tree.String(stmt.(*tree.Delete).Hints, dialect.MYSQL))
+	require.Equal(t, "with c as (select 1 as a) delete /*+ DOP(2) */ from t where a in (select a from c)", tree.String(stmt, dialect.MYSQL))
+
+	// only the UPDATE and DELETE which start a statement take the hints
+	_, err = ParseOne(context.TODO(), "insert into t values (1) on duplicate key update /*+ DOP(2) */ a = 2", 1)
+	require.NoError(t, err)
+	_, err = ParseOne(context.TODO(), "select a from t for update /*+ DOP(2) */", 1)
+	require.NoError(t, err)
+}
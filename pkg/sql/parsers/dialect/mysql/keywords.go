@@ -623,6 +623,39 @@ func init() {
 		"handler":                    HANDLER,
 		"sample":                     SAMPLE,
 		"percent":                    PERCENT,
+		"histogram":                  HISTOGRAM,
+		"buckets":                    BUCKETS,
+		"rollup":                     ROLLUP,
+		"lag":                        LAG,
+		"lead":                       LEAD,
+		"first_value":                FIRST_VALUE,
+		"last_value":                 LAST_VALUE,
+		"nth_value":                  NTH_VALUE,
+		"ntile":                      NTILE,
+		"percent_rank":               PERCENT_RANK,
+		"cume_dist":                  CUME_DIST,
+		"covar_pop":                  COVAR_POP,
+		"covar_samp":                 COVAR_SAMP,
+		"corr":                       CORR,
+		"regr_slope":                 REGR_SLOPE,
+		"regr_intercept":             REGR_INTERCEPT,
+		"regr_r2":                    REGR_R2,
+		"regr_count":                 REGR_COUNT,
+		"percentile_cont":            PERCENTILE_CONT,
+		"percentile_disc":            PERCENTILE_DISC,
+		"within":                     WITHIN,
+		"json_arrayagg":              JSON_ARRAYAGG,
+		"json_objectagg":             JSON_OBJECTAGG,
+		"json_table":                 JSON_TABLE,
+		"nested":                     NESTED,
+		"ordinality":                 ORDINALITY,
+		"path":                       PATH,
+		"error":                      ERROR,
+		"empty":                      EMPTY,
+		"respect":                    RESPECT,
+		"cube":                       CUBE,
+		"grouping":                   GROUPING,
+		"sets":                       SETS,
 		"master":                     MASTER,
 		"parallelism":                PARALLELISM,
 		"bitmap_bit_position":        BITMAP_BIT_POSITION,
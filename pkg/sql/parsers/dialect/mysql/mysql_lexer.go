@@ -114,6 +114,8 @@ type Lexer struct {
 	stmts      []tree.Statement
 	paramIndex int
 	lower      int64
+	// lastTyp is the type of the last token.
+	lastTyp int
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string, lower int64) *Lexer {
@@ -129,6 +131,7 @@ func (l *Lexer) setScanner(s *Scanner, lower int64) {
 	l.stmts = nil
 	l.paramIndex = 0
 	l.lower = lower
+	l.lastTyp = 0
 }
 
 func (l *Lexer) GetParamIndex() int {
@@ -139,6 +142,11 @@ func (l *Lexer) GetParamIndex() int {
 func (l *Lexer) Lex(lval *yySymType) int {
 	typ, str := l.scanner.Scan()
 	l.scanner.LastToken = str
+	// the optimizer hints must follow the SELECT keyword, or the UPDATE and
+	// DELETE keywords which start a statement, unlike ON DELETE or FOR UPDATE.
+	l.scanner.HintFlag = typ == SELECT ||
+		(typ == UPDATE || typ == DELETE) && (l.lastTyp == 0 || l.lastTyp == ';' || l.lastTyp == ')')
+	l.lastTyp = typ
 
 	switch typ {
 	case INTEGRAL:
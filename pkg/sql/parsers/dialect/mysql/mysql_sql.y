@@ -91,6 +91,9 @@ import (
     selectLockInfo *tree.SelectLockInfo
 
     columnType *tree.T
+    jsonTableColumn *tree.JsonTableColumn
+    jsonTableColumns tree.JsonTableColumns
+    jsonTableOnResponse *tree.JsonTableOnResponse
     unresolvedName *tree.UnresolvedName
     lengthScaleOpt tree.LengthScaleOpt
     tuple *tree.Tuple
@@ -259,10 +262,12 @@ import (
     snapshotObject tree.ObjectInfo
     allCDCOption   *tree.AllOrNotCDC
 
+    optimizerHints tree.OptimizerHints
 }
 
 %token LEX_ERROR
-%nonassoc EMPTY
+%token <str> OPTIMIZER_HINT
+%nonassoc <str> EMPTY
 %left <str> UNION EXCEPT INTERSECT MINUS
 %nonassoc LOWER_THAN_ORDER
 %nonassoc ORDER
@@ -433,6 +438,11 @@ import (
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG RANK ROW_NUMBER
 %token <str> DENSE_RANK BIT_CAST
+%token <str> LAG LEAD FIRST_VALUE LAST_VALUE NTH_VALUE NTILE PERCENT_RANK CUME_DIST RESPECT
+%token <str> COVAR_POP COVAR_SAMP CORR REGR_SLOPE REGR_INTERCEPT REGR_R2 REGR_COUNT
+%token <str> PERCENTILE_CONT PERCENTILE_DISC WITHIN
+%token <str> JSON_ARRAYAGG JSON_OBJECTAGG
+%token <str> JSON_TABLE NESTED ORDINALITY PATH ERROR
 %token <str> BITMAP_BIT_POSITION BITMAP_BUCKET_NUMBER BITMAP_COUNT BITMAP_CONSTRUCT_AGG BITMAP_OR_AGG
 
 // Sequence function
@@ -472,6 +482,12 @@ import (
 // Sample Related.
 %token <str> PERCENT SAMPLE
 
+// Histogram Related.
+%token <str> HISTOGRAM BUCKETS
+
+// Grouping Sets Related.
+%token <str> ROLLUP CUBE GROUPING SETS WITH_ROLLUP
+
 // Snapshot READ
 %token <str> MO_TS
 
@@ -495,7 +511,7 @@ import (
 %type <statement> show_procedure_status_stmt show_function_status_stmt show_node_list_stmt show_locks_stmt
 %type <statement> show_table_num_stmt show_column_num_stmt show_table_values_stmt show_table_size_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
-%type <statement> show_servers_stmt show_connectors_stmt
+%type <statement> show_servers_stmt show_connectors_stmt show_master_status_stmt
 %type <statement> alter_account_stmt alter_user_stmt alter_view_stmt update_stmt use_stmt update_no_with_stmt alter_database_config_stmt alter_table_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
@@ -520,7 +536,7 @@ import (
 %type <exportParm> export_data_param_opt
 %type <loadParam> load_param_opt load_param_opt_2
 %type <tailParam> tail_param_opt
-%type <str> json_type_opt
+%type <str> json_type_opt export_compression_opt
 
 // case statement
 %type <statement> case_stmt
@@ -547,11 +563,15 @@ import (
 
 %type <select> select_stmt select_no_parens
 %type <selectStatement> simple_select select_with_parens simple_select_clause
+%type <optimizerHints> optimizer_hint_opt
 %type <selectExprs> select_expression_list
 %type <selectExpr> select_expression
 %type <tableExprs> table_name_wild_list
 %type <joinTableExpr>  table_references join_table
 %type <tableExpr> into_table_name table_function table_factor table_reference escaped_table_reference
+%type <jsonTableColumns> json_table_columns_clause json_table_column_list
+%type <jsonTableColumn> json_table_column json_table_on_opt
+%type <jsonTableOnResponse> json_table_on_response
 %type <direction> asc_desc_opt
 %type <nullsPosition> nulls_first_last_opt
 %type <order> order
@@ -611,6 +631,8 @@ import (
 %type <from> from_clause from_opt
 %type <where> where_expression_opt having_opt
 %type <groupBy> group_by_opt
+%type <exprs> group_by_list grouping_element_list
+%type <expr> group_by_item grouping_element
 %type <aliasedTableExpr> aliased_table_name
 %type <unionTypeRecord> union_op
 %type <parenTableExpr> table_subquery
@@ -624,7 +646,7 @@ import (
 %type <expr> sample_function_expr
 
 %type <unresolvedName> column_name column_name_unresolved normal_ident
-%type <strs> enum_values force_quote_opt force_quote_list infile_or_s3_param infile_or_s3_params credentialsparams credentialsparam create_cdc_opt create_cdc_opts
+%type <strs> enum_values force_quote_opt force_quote_list export_format_opt export_partition_opt infile_or_s3_param infile_or_s3_params credentialsparams credentialsparam create_cdc_opt create_cdc_opts
 %type <str> charset_keyword db_name db_name_opt
 %type <str> backup_type_opt backup_timestamp_opt
 %type <str> not_keyword func_not_keyword
@@ -702,6 +724,7 @@ import (
 %type <str> fields_or_columns
 %type <int64Val> algorithm_opt partition_num_opt sub_partition_num_opt opt_retry pitr_value
 %type <boolVal> linear_opt
+%type <boolVal> null_treatment_opt from_first_last_opt
 %type <partition> partition
 %type <partitions> partition_list_opt partition_list
 %type <values> values_opt
@@ -727,7 +750,7 @@ import (
 %type <replace> replace_data
 %type <rowsExprs> values_list
 %type <str> name_datetime_scale braces_opt name_braces
-%type <str> std_dev_pop extended_opt
+%type <str> std_dev_pop two_args_stat_func percentile_func extended_opt
 %type <expr> expr_or_default
 %type <exprs> data_values data_opt row_value
 
@@ -737,7 +760,7 @@ import (
 %type <fieldsList> field_item_list
 %type <str> field_terminator starting_opt lines_terminated_opt starting lines_terminated
 %type <lines> load_lines export_lines_opt
-%type <int64Val> ignore_lines
+%type <int64Val> ignore_lines histogram_buckets_opt
 %type <varExpr> user_variable variable system_variable
 %type <varExprs> variable_list
 %type <loadColumn> columns_or_variable
@@ -763,7 +786,7 @@ import (
 %type <epxlainOption> utility_option_elem
 %type <str> utility_option_name utility_option_arg
 %type <str> explain_option_key select_option_opt
-%type <str> explain_foramt_value trim_direction
+%type <str> explain_format_value trim_direction
 %type <str> priority_opt priority quick_opt ignore_opt wild_opt
 
 %type <str> account_name account_role_name
@@ -2839,24 +2862,26 @@ update_stmt:
     }
 
 update_no_with_stmt:
-    UPDATE priority_opt ignore_opt table_reference SET update_list where_expression_opt order_by_opt limit_opt
+    UPDATE optimizer_hint_opt priority_opt ignore_opt table_reference SET update_list where_expression_opt order_by_opt limit_opt
     {
         // Single-table syntax
         $$ = &tree.Update{
-            Tables: tree.TableExprs{$4},
-            Exprs: $6,
-            Where: $7,
-            OrderBy: $8,
-            Limit: $9,
+            Tables: tree.TableExprs{$5},
+            Exprs: $7,
+            Where: $8,
+            OrderBy: $9,
+            Limit: $10,
+            Hints: $2,
         }
     }
-|    UPDATE priority_opt ignore_opt table_references SET update_list where_expression_opt
+|    UPDATE optimizer_hint_opt priority_opt ignore_opt table_references SET update_list where_expression_opt
     {
         // Multiple-table syntax
         $$ = &tree.Update{
-            Tables: tree.TableExprs{$4},
-            Exprs: $6,
-            Where: $7,
+            Tables: tree.TableExprs{$5},
+            Exprs: $7,
+            Where: $8,
+            Hints: $2,
         }
     }
 
@@ -3003,6 +3028,35 @@ explain_stmt:
     {
         $$ = tree.NewExplainStmt($2, "text")
     }
+|   explain_sym FORMAT '=' explain_format_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($5, "text")
+        optionElem := tree.MakeOptionElem("format", $4)
+        options := tree.MakeOptions(optionElem)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
+|   explain_sym ANALYZE FORMAT '=' explain_format_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($6, "text")
+        optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+        optionElem2 := tree.MakeOptionElem("format", $5)
+        options := tree.MakeOptions(optionElem1)
+        options = append(options, optionElem2)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
+|   explain_sym ANALYZE VERBOSE FORMAT '=' explain_format_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($7, "text")
+        optionElem1 := tree.MakeOptionElem("analyze", "NULL")
+        optionElem2 := tree.MakeOptionElem("verbose", "NULL")
+        optionElem3 := tree.MakeOptionElem("format", $6)
+        options := tree.MakeOptions(optionElem1)
+        options = append(options, optionElem2, optionElem3)
+        explainStmt.Options = options
+        $$ = explainStmt
+    }
 |   explain_sym VERBOSE explainable_stmt
     {
         explainStmt := tree.NewExplainStmt($3, "text")
@@ -3077,9 +3131,15 @@ explain_option_key:
 |   VERBOSE
 |   FORMAT
 
-explain_foramt_value:
-    JSON
-|   TEXT
+explain_format_value:
+    ident
+    {
+        $$ = $1.Compare()
+    }
+|   STRING
+    {
+        $$ = strings.ToLower($1)
+    }
 
 
 prepare_sym:
@@ -3124,7 +3184,7 @@ utility_option_name:
 utility_option_arg:
     TRUE                    { $$ = "true" }
 |   FALSE                        { $$ = "false" }
-|   explain_foramt_value                    { $$ = $1 }
+|   explain_format_value                    { $$ = $1 }
 
 
 analyze_stmt:
@@ -3132,6 +3192,27 @@ analyze_stmt:
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
+|   ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeHistogramStmt($3, tree.HistogramUpdate, nil, 0)
+    }
+|   ANALYZE TABLE table_name UPDATE HISTOGRAM ON column_list histogram_buckets_opt
+    {
+        $$ = tree.NewAnalyzeHistogramStmt($3, tree.HistogramUpdate, $7, $8)
+    }
+|   ANALYZE TABLE table_name DROP HISTOGRAM ON column_list
+    {
+        $$ = tree.NewAnalyzeHistogramStmt($3, tree.HistogramDrop, $7, 0)
+    }
+
+histogram_buckets_opt:
+    {
+        $$ = 0
+    }
+|   WITH INTEGRAL BUCKETS
+    {
+        $$ = $2.(int64)
+    }
 
 upgrade_stmt:
     UPGRADE ACCOUNT target opt_retry
@@ -3753,7 +3834,7 @@ alter_account_auth_option:
 }
 
 alter_user_stmt:
-    ALTER USER exists_opt user_spec_list_of_create_user default_role_opt pwd_or_lck_opt user_comment_or_attribute_opt
+    ALTER USER exists_opt user_spec_list default_role_opt pwd_or_lck_opt user_comment_or_attribute_opt
     {
         // Create temporary variables with meaningful names
         ifExists := $3
@@ -3815,6 +3896,14 @@ pwd_or_lck:
     {
         $$ = tree.NewUserMiscOptionAccountLock()
     }
+|   ACCOUNT UNLOCK
+    {
+        $$ = tree.NewUserMiscOptionAccountUnlock()
+    }
+|   ACCOUNT LOCK
+    {
+        $$ = tree.NewUserMiscOptionAccountLock()
+    }
 |   pwd_expire
     {
         $$ = tree.NewUserMiscOptionPasswordExpireNone()
@@ -3931,6 +4020,7 @@ show_stmt:
 |   show_publications_stmt
 |   show_subscriptions_stmt
 |   show_servers_stmt
+|   show_master_status_stmt
 |   show_stages_stmt
 |   show_connectors_stmt
 |   show_snapshots_stmt
@@ -4374,6 +4464,12 @@ show_servers_stmt:
         $$ = &tree.ShowBackendServers{}
     }
 
+show_master_status_stmt:
+    SHOW MASTER STATUS
+    {
+        $$ = &tree.ShowMasterStatus{}
+    }
+
 table_name_unresolved:
     ident
     {
@@ -4593,42 +4689,45 @@ delete_stmt:
     }
 
 delete_without_using_stmt:
-    DELETE priority_opt quick_opt ignore_opt FROM table_name partition_clause_opt as_opt_id where_expression_opt order_by_opt limit_opt
+    DELETE optimizer_hint_opt priority_opt quick_opt ignore_opt FROM table_name partition_clause_opt as_opt_id where_expression_opt order_by_opt limit_opt
     {
         // Single-Table Syntax
         t := &tree.AliasedTableExpr {
-            Expr: $6,
+            Expr: $7,
             As: tree.AliasClause{
-                Alias: tree.Identifier($8),
+                Alias: tree.Identifier($9),
             },
         }
         $$ = &tree.Delete{
             Tables: tree.TableExprs{t},
-            Where: $9,
-            OrderBy: $10,
-            Limit: $11,
+            Where: $10,
+            OrderBy: $11,
+            Limit: $12,
+            Hints: $2,
         }
     }
-|    DELETE priority_opt quick_opt ignore_opt table_name_wild_list FROM table_references where_expression_opt
+|    DELETE optimizer_hint_opt priority_opt quick_opt ignore_opt table_name_wild_list FROM table_references where_expression_opt
     {
         // Multiple-Table Syntax
         $$ = &tree.Delete{
-            Tables: $5,
-            Where: $8,
-            TableRefs: tree.TableExprs{$7},
+            Tables: $6,
+            Where: $9,
+            TableRefs: tree.TableExprs{$8},
+            Hints: $2,
         }
     }
 
 
 
 delete_with_using_stmt:
-    DELETE priority_opt quick_opt ignore_opt FROM table_name_wild_list USING table_references where_expression_opt
+    DELETE optimizer_hint_opt priority_opt quick_opt ignore_opt FROM table_name_wild_list USING table_references where_expression_opt
     {
         // Multiple-Table Syntax
         $$ = &tree.Delete{
-            Tables: $6,
-            Where: $9,
-            TableRefs: tree.TableExprs{$8},
+            Tables: $7,
+            Where: $10,
+            TableRefs: tree.TableExprs{$9},
+            Hints: $2,
         }
     }
 
@@ -4958,7 +5057,7 @@ export_data_param_opt:
     {
         $$ = nil
     }
-|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt
+|   INTO OUTFILE STRING export_fields export_lines_opt header_opt max_file_size_opt force_quote_opt export_format_opt export_compression_opt export_partition_opt
     {
         $$ = &tree.ExportParam{
             Outfile:    true,
@@ -4968,6 +5067,12 @@ export_data_param_opt:
             Header:     $6,
             MaxFileSize:uint64($7)*1024,
             ForceQuote: $8,
+            Compression: $10,
+            PartitionBy: $11,
+        }
+        if len($9) > 0 {
+            $$.FileFormat = $9[0]
+            $$.FormatOption = $9[1:]
         }
     }
 
@@ -5091,6 +5196,37 @@ force_quote_opt:
     }
 
 
+export_format_opt:
+    {
+        $$ = nil
+    }
+|   FORMAT STRING
+    {
+        $$ = []string{strings.ToLower($2)}
+    }
+|   FORMAT STRING '{' infile_or_s3_params '}'
+    {
+        $$ = append([]string{strings.ToLower($2)}, $4...)
+    }
+
+export_compression_opt:
+    {
+        $$ = ""
+    }
+|   COMPRESSION STRING
+    {
+        $$ = strings.ToLower($2)
+    }
+
+export_partition_opt:
+    {
+        $$ = nil
+    }
+|   PARTITION BY force_quote_list
+    {
+        $$ = $3
+    }
+
 force_quote_list:
     ident
     {
@@ -5531,30 +5667,41 @@ union_op:
     }
 
 simple_select_clause:
-    SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+    SELECT optimizer_hint_opt distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
-            Distinct: $2,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
+            Distinct: $3,
+            Exprs: $4,
+            From: $5,
+            Where: $6,
+            GroupBy: $7,
+            Having: $8,
+            Hints: $2,
         }
     }
-|    SELECT select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+|    SELECT optimizer_hint_opt select_option_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
     {
         $$ = &tree.SelectClause{
             Distinct: false,
-            Exprs: $3,
-            From: $4,
-            Where: $5,
-            GroupBy: $6,
-            Having: $7,
-            Option: $2,
+            Exprs: $4,
+            From: $5,
+            Where: $6,
+            GroupBy: $7,
+            Having: $8,
+            Option: $3,
+            Hints: $2,
         }
     }
 
+optimizer_hint_opt:
+    {
+        $$ = nil
+    }
+|   OPTIMIZER_HINT
+    {
+        $$ = parseOptimizerHints($1)
+    }
+
 select_option_opt:
     SQL_SMALL_RESULT
     {
@@ -5599,10 +5746,61 @@ group_by_opt:
     {
         $$ = nil
     }
-|   GROUP BY expression_list
+|   GROUP BY group_by_list
     {
         $$ = tree.GroupBy($3)
     }
+|   GROUP BY group_by_list WITH_ROLLUP
+    {
+        groupBy := tree.GroupBy($3)
+        if groupBy.HasGroupingSets() {
+            yylex.Error("WITH ROLLUP cannot be used with ROLLUP, CUBE or GROUPING SETS")
+            goto ret1
+        }
+        $$ = tree.GroupBy{tree.NewGroupingSets(tree.GroupingSetsRollup, $3)}
+    }
+
+group_by_list:
+    group_by_item
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   group_by_list ',' group_by_item
+    {
+        $$ = append($1, $3)
+    }
+
+group_by_item:
+    expression
+|   ROLLUP '(' expression_list ')'
+    {
+        $$ = tree.NewGroupingSets(tree.GroupingSetsRollup, $3)
+    }
+|   CUBE '(' expression_list ')'
+    {
+        $$ = tree.NewGroupingSets(tree.GroupingSetsCube, $3)
+    }
+|   GROUPING SETS '(' grouping_element_list ')'
+    {
+        $$ = tree.NewGroupingSets(tree.GroupingSetsList, $4)
+    }
+
+grouping_element_list:
+    grouping_element
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   grouping_element_list ',' grouping_element
+    {
+        $$ = append($1, $3)
+    }
+
+grouping_element:
+    expression
+|   '(' ')'
+    {
+        $$ = nil
+    }
 
 where_expression_opt:
     {
@@ -5897,6 +6095,108 @@ table_function:
                 },
         }
     }
+|   JSON_TABLE '(' expression ',' STRING json_table_columns_clause ')'
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.TableFunction{
+            Func: &tree.FuncExpr{
+                Func: tree.FuncName2ResolvableFunctionReference(name),
+                FuncName: tree.NewCStr($1, 1),
+                Exprs: tree.Exprs{$3, tree.NewNumVal($5, $5, false, tree.P_char)},
+                Type: tree.FUNC_TYPE_TABLE,
+            },
+            JsonColumns: $6,
+        }
+    }
+
+json_table_columns_clause:
+    COLUMNS '(' json_table_column_list ')'
+    {
+        $$ = $3
+    }
+
+json_table_column_list:
+    json_table_column
+    {
+        $$ = tree.JsonTableColumns{$1}
+    }
+|   json_table_column_list ',' json_table_column
+    {
+        $$ = append($1, $3)
+    }
+
+json_table_column:
+    ident FOR ORDINALITY
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JsonTableColumnOrdinality,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+|   ident column_type PATH STRING json_table_on_opt
+    {
+        $$ = $5
+        $$.Kind = tree.JsonTableColumnPath
+        $$.Name = tree.Identifier($1.Compare())
+        $$.Type = $2
+        $$.Path = $4
+    }
+|   ident column_type EXISTS PATH STRING
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JsonTableColumnExists,
+            Name: tree.Identifier($1.Compare()),
+            Type: $2,
+            Path: $5,
+        }
+    }
+|   NESTED STRING json_table_columns_clause
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JsonTableColumnNested,
+            Path: $2,
+            Columns: $3,
+        }
+    }
+|   NESTED PATH STRING json_table_columns_clause
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JsonTableColumnNested,
+            Path: $3,
+            Columns: $4,
+        }
+    }
+
+json_table_on_opt:
+    {
+        $$ = &tree.JsonTableColumn{}
+    }
+|   json_table_on_response ON EMPTY
+    {
+        $$ = &tree.JsonTableColumn{OnEmpty: $1}
+    }
+|   json_table_on_response ON ERROR
+    {
+        $$ = &tree.JsonTableColumn{OnError: $1}
+    }
+|   json_table_on_response ON EMPTY json_table_on_response ON ERROR
+    {
+        $$ = &tree.JsonTableColumn{OnEmpty: $1, OnError: $4}
+    }
+
+json_table_on_response:
+    NULL
+    {
+        $$ = &tree.JsonTableOnResponse{Type: tree.JsonTableOnResponseNull}
+    }
+|   ERROR
+    {
+        $$ = &tree.JsonTableOnResponse{Type: tree.JsonTableOnResponseError}
+    }
+|   DEFAULT STRING
+    {
+        $$ = &tree.JsonTableOnResponse{Type: tree.JsonTableOnResponseDefault, Default: $2}
+    }
 
 aliased_table_name:
     table_name as_opt_id index_hint_list_opt
@@ -7026,6 +7326,14 @@ user_identified:
         Str: tree.NewNumVal($3, $3, false, tree.P_char),
     }
     }
+|   IDENTIFIED WITH ident BY STRING
+    {
+    $$ = &tree.AccountIdentified{
+        Typ: tree.AccountIdentifiedByPassword,
+        Str: tree.NewNumVal($5, $5, false, tree.P_char),
+        AuthPlugin: $3.Compare(),
+    }
+    }
 
 name_string:
     ident
@@ -9440,6 +9748,116 @@ function_call_window:
             WindowSpec: $4,
         }
     }
+|	PERCENT_RANK '(' ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            WindowSpec: $4,
+        }
+    }
+|	CUME_DIST '(' ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            WindowSpec: $4,
+        }
+    }
+|	NTILE '(' expression ')' window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            WindowSpec: $5,
+        }
+    }
+|	LAG '(' expression_list ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $6.IgnoreNulls = $5
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $6,
+        }
+    }
+|	LEAD '(' expression_list ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $6.IgnoreNulls = $5
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+            WindowSpec: $6,
+        }
+    }
+|	FIRST_VALUE '(' expression ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $6.IgnoreNulls = $5
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            WindowSpec: $6,
+        }
+    }
+|	LAST_VALUE '(' expression ')' null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $6.IgnoreNulls = $5
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            WindowSpec: $6,
+        }
+    }
+|	NTH_VALUE '(' expression ',' expression ')' from_first_last_opt null_treatment_opt window_spec
+    {
+        name := tree.NewUnresolvedColName($1)
+        $9.FromLast = $7
+        $9.IgnoreNulls = $8
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3, $5},
+            WindowSpec: $9,
+        }
+    }
+
+null_treatment_opt:
+    {
+        $$ = false
+    }
+|	RESPECT NULLS
+    {
+        $$ = false
+    }
+|	IGNORE NULLS
+    {
+        $$ = true
+    }
+
+from_first_last_opt:
+    {
+        $$ = false
+    }
+|	FROM FIRST
+    {
+        $$ = false
+    }
+|	FROM LAST
+    {
+        $$ = true
+    }
 
 sample_function_expr:
     SAMPLE '(' '*' ',' INTEGRAL ROWS ')'
@@ -10075,6 +10493,50 @@ function_call_aggregate:
             WindowSpec: $6,
         }
     }
+|   JSON_ARRAYAGG '(' expression order_by_opt ')' window_spec_opt
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            OrderBy: $4,
+            WindowSpec: $6,
+        }
+    }
+|   JSON_OBJECTAGG '(' expression ',' expression ')' window_spec_opt
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3, $5},
+            WindowSpec: $7,
+        }
+    }
+|   percentile_func '(' expression ')' WITHIN GROUP '(' ORDER BY order ')' window_spec_opt
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$3},
+            OrderBy: tree.OrderBy{$10},
+            WithinGroup: true,
+            WindowSpec: $12,
+        }
+    }
+|   two_args_stat_func '(' func_type_opt expression ',' expression ')' window_spec_opt
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: tree.Exprs{$4, $6},
+            Type: $3,
+            WindowSpec: $8,
+        }
+    }
 |   MEDIAN '(' func_type_opt expression ')' window_spec_opt
     {
 	name := tree.NewUnresolvedColName($1)
@@ -10114,6 +10576,19 @@ std_dev_pop:
 |   STDDEV
 |   STDDEV_POP
 
+percentile_func:
+    PERCENTILE_CONT
+|   PERCENTILE_DISC
+
+two_args_stat_func:
+    COVAR_POP
+|   COVAR_SAMP
+|   CORR
+|   REGR_SLOPE
+|   REGR_INTERCEPT
+|   REGR_R2
+|   REGR_COUNT
+
 function_call_generic:
     ID '(' expression_list_opt ')'
     {
@@ -10400,6 +10875,15 @@ function_call_keyword:
             Exprs: es,
         }
     }
+|   GROUPING '(' expression_list ')'
+    {
+        name := tree.NewUnresolvedColName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            FuncName: tree.NewCStr($1, 1),
+            Exprs: $3,
+        }
+    }
 |   BINARY '(' expression_list ')'
     {
         name := tree.NewUnresolvedColName($1)
@@ -12372,6 +12856,36 @@ non_reserved_keyword:
 |	HANDLER
 |	SAMPLE
 |	PERCENT
+|	HISTOGRAM
+|	BUCKETS
+|	ROLLUP
+|	SETS
+|	LAG
+|	LEAD
+|	FIRST_VALUE
+|	LAST_VALUE
+|	NTH_VALUE
+|	NTILE
+|	PERCENT_RANK
+|	CUME_DIST
+|	RESPECT
+|	COVAR_POP
+|	COVAR_SAMP
+|	CORR
+|	REGR_SLOPE
+|	REGR_INTERCEPT
+|	REGR_R2
+|	REGR_COUNT
+|	PERCENTILE_CONT
+|	PERCENTILE_DISC
+|	WITHIN
+|	JSON_ARRAYAGG
+|	JSON_OBJECTAGG
+|	NESTED
+|	ORDINALITY
+|	PATH
+|	ERROR
+|	EMPTY
 |	OWNERSHIP
 |   MO_TS
 
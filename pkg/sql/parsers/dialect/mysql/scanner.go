@@ -48,6 +48,9 @@ type Scanner struct {
 	buf         string
 
 	strBuilder bytes.Buffer
+
+	// HintFlag is true if a /*+ ... */ comment is scanned as the optimizer hints.
+	HintFlag bool
 }
 
 func (s *Scanner) setSql(sql string) {
@@ -56,6 +59,7 @@ func (s *Scanner) setSql(sql string) {
 	s.LastToken = ""
 	s.LastError = nil
 	s.posVarIndex = 0
+	s.HintFlag = false
 	s.MysqlSpecialComment = nil
 	s.Pos = 0
 	s.Line = 0
@@ -190,6 +194,12 @@ func (s *Scanner) Scan() (int, string) {
 					return LEX_ERROR, ""
 				}
 				return s.Scan()
+			case '+':
+				if s.HintFlag {
+					s.inc()
+					return s.scanOptimizerHint()
+				}
+				fallthrough
 			default:
 				id, str := s.scanCommentTypeBlock()
 				if id == LEX_ERROR {
@@ -565,6 +575,22 @@ func (s *Scanner) scanCommentTypeBlock() (int, string) {
 	return COMMENT, s.buf[start:s.Pos]
 }
 
+// scanOptimizerHint scans the hints of the /*+ ... */ comment, the '/*+' has been consumed.
+func (s *Scanner) scanOptimizerHint() (int, string) {
+	start := s.Pos
+	for {
+		if s.cur() == '*' && s.peek(1) == '/' {
+			end := s.Pos
+			s.incN(2)
+			return OPTIMIZER_HINT, s.buf[start:end]
+		}
+		if s.cur() == eofChar {
+			return LEX_ERROR, s.buf[start:s.Pos]
+		}
+		s.inc()
+	}
+}
+
 // scanMySQLSpecificComment scans a MySQL comment pragma, which always starts with '//*`
 /*func (s *Scanner) scanMySQLSpecificComment() (int, string) {
 	start := s.Pos - 3
@@ -767,6 +793,28 @@ func (s *Scanner) scanIdentifier(isVariable bool) (int, string) {
 			}
 			s.Pos = cur
 			return SPBEGIN, keywordName
+		} else if lower == "with" {
+			// "group by ... with rollup" would conflict with "with check option" of a view,
+			// unlike "with rollup as (...)" or "with rollup(a) as (...)" of a cte.
+			cur, line, col := s.Pos, s.Line, s.Col
+			s.skipBlank()
+			if isLetter(s.cur()) {
+				if typ, _ := s.scanIdentifier(false); typ == ROLLUP {
+					end, endLine, endCol := s.Pos, s.Line, s.Col
+					s.skipBlank()
+					isCte := s.cur() == '('
+					if !isCte && isLetter(s.cur()) {
+						typ, _ = s.scanIdentifier(false)
+						isCte = typ == AS
+					}
+					if !isCte {
+						s.Pos, s.Line, s.Col = end, endLine, endCol
+						return WITH_ROLLUP, keywordName
+					}
+				}
+			}
+			s.Pos, s.Line, s.Col = cur, line, col
+			return keywordID, keywordName
 		} else {
 			return keywordID, keywordName
 		}
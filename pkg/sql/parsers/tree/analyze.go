@@ -14,7 +14,11 @@
 
 package tree
 
-import "github.com/matrixorigin/matrixone/pkg/common/reuse"
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/reuse"
+)
 
 func init() {
 	reuse.CreatePool[AnalyzeStmt](
@@ -24,19 +28,51 @@ func init() {
 	) //WithEnableChecker()
 }
 
+// HistogramOp is the histogram operation of ANALYZE TABLE.
+type HistogramOp int
+
+const (
+	// HistogramNone is ANALYZE TABLE t(c1, c2), which only counts the distinct values.
+	HistogramNone HistogramOp = iota
+	// HistogramUpdate builds and persists the histograms of the columns.
+	HistogramUpdate
+	// HistogramDrop removes the persisted histograms of the columns.
+	HistogramDrop
+)
+
 // Use statement
 type AnalyzeStmt struct {
 	statementImpl
 	Table *TableName
 	Cols  IdentifierList
+	// Histogram is the histogram operation, without Cols it applies to all
+	// columns of the table.
+	Histogram HistogramOp
+	// Buckets is the number of histogram buckets, 0 means the default.
+	Buckets int64
 }
 
 func (node *AnalyzeStmt) Format(ctx *FmtCtx) {
 	ctx.WriteString("analyze table ")
 	node.Table.Format(ctx)
-	ctx.WriteString("(")
-	node.Cols.Format(ctx)
-	ctx.WriteString(")")
+	switch node.Histogram {
+	case HistogramNone:
+		ctx.WriteString("(")
+		node.Cols.Format(ctx)
+		ctx.WriteString(")")
+	case HistogramUpdate:
+		if len(node.Cols) == 0 {
+			return
+		}
+		ctx.WriteString(" update histogram on ")
+		node.Cols.Format(ctx)
+		if node.Buckets > 0 {
+			ctx.WriteString(fmt.Sprintf(" with %d buckets", node.Buckets))
+		}
+	case HistogramDrop:
+		ctx.WriteString(" drop histogram on ")
+		node.Cols.Format(ctx)
+	}
 }
 
 func (node *AnalyzeStmt) GetStatementType() string { return "Analyze Table" }
@@ -61,3 +97,10 @@ func NewAnalyzeStmt(tbl *TableName, cols IdentifierList) *AnalyzeStmt {
 	analyzestmt.Cols = cols
 	return analyzestmt
 }
+
+func NewAnalyzeHistogramStmt(tbl *TableName, op HistogramOp, cols IdentifierList, buckets int64) *AnalyzeStmt {
+	analyzestmt := NewAnalyzeStmt(tbl, cols)
+	analyzestmt.Histogram = op
+	analyzestmt.Buckets = buckets
+	return analyzestmt
+}
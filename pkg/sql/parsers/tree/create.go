@@ -5154,6 +5154,8 @@ const (
 type AccountIdentified struct {
 	Typ AccountIdentifiedOption
 	Str Expr
+	// AuthPlugin is the authentication plugin in IDENTIFIED WITH plugin BY 'auth_string'.
+	AuthPlugin string
 }
 
 func NewAccountIdentified(t AccountIdentifiedOption, s Expr) *AccountIdentified {
@@ -5169,6 +5171,12 @@ func (node *AccountIdentified) Format(ctx *FmtCtx) {
 
 	switch node.Typ {
 	case AccountIdentifiedByPassword:
+		if len(node.AuthPlugin) != 0 {
+			ctx.WriteString(" identified with ")
+			ctx.WriteString(node.AuthPlugin)
+			ctx.WriteString(" by '******'")
+			break
+		}
 		if isParam {
 			ctx.WriteString(" identified by ?")
 		} else {
@@ -24,6 +24,7 @@ type Delete struct {
 	OrderBy        OrderBy
 	Limit          *Limit
 	With           *With
+	Hints          OptimizerHints
 }
 
 func (node *Delete) Format(ctx *FmtCtx) {
@@ -31,7 +32,12 @@ func (node *Delete) Format(ctx *FmtCtx) {
 		node.With.Format(ctx)
 		ctx.WriteByte(' ')
 	}
-	ctx.WriteString("delete from ")
+	ctx.WriteString("delete ")
+	if len(node.Hints) > 0 {
+		node.Hints.Format(ctx)
+		ctx.WriteByte(' ')
+	}
+	ctx.WriteString("from ")
 
 	prefix := ""
 	for _, a := range node.Tables {
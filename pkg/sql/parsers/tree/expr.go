@@ -951,6 +951,10 @@ type FuncExpr struct {
 	WindowSpec *WindowSpec
 
 	OrderBy OrderBy
+
+	// WithinGroup indicates that OrderBy is the WITHIN GROUP (ORDER BY ...)
+	// clause of an ordered-set aggregation, e.g. PERCENTILE_CONT.
+	WithinGroup bool
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -971,12 +975,22 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 		node.Exprs.Format(ctx)
 	}
 
-	if node.OrderBy != nil {
+	if node.OrderBy != nil && !node.WithinGroup {
+		// the ORDER BY of GROUP_CONCAT follows its separator without a space
+		if node.Func.FunctionReference.(*UnresolvedName).ColName() == "json_arrayagg" {
+			ctx.WriteByte(' ')
+		}
 		node.OrderBy.Format(ctx)
 	}
 
 	ctx.WriteByte(')')
 
+	if node.WithinGroup {
+		ctx.WriteString(" within group (")
+		node.OrderBy.Format(ctx)
+		ctx.WriteByte(')')
+	}
+
 	if node.WindowSpec != nil {
 		ctx.WriteString(" ")
 		node.WindowSpec.Format(ctx)
@@ -1029,9 +1043,19 @@ type WindowSpec struct {
 	OrderBy     OrderBy
 	HasFrame    bool
 	Frame       *FrameClause
+	// FromLast is FROM LAST of NTH_VALUE.
+	FromLast bool
+	// IgnoreNulls is IGNORE NULLS of the value functions.
+	IgnoreNulls bool
 }
 
 func (node *WindowSpec) Format(ctx *FmtCtx) {
+	if node.FromLast {
+		ctx.WriteString("from last ")
+	}
+	if node.IgnoreNulls {
+		ctx.WriteString("ignore nulls ")
+	}
 	ctx.WriteString("over (")
 	flag := false
 	if len(node.PartitionBy) > 0 {
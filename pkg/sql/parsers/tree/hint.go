@@ -0,0 +1,71 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+// OptimizerHint is one hint of the /*+ ... */ comment which follows the
+// SELECT, UPDATE or DELETE keyword, such as MAX_EXECUTION_TIME(1000).
+type OptimizerHint struct {
+	// Name is in upper case.
+	Name string
+	Args []string
+}
+
+func (node *OptimizerHint) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Name)
+	if len(node.Args) == 0 {
+		return
+	}
+	ctx.WriteByte('(')
+	for i, arg := range node.Args {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		// quote the names which would not be parsed back as one argument.
+		if arg == "" || strings.ContainsAny(arg, " \t\n\r,()") {
+			ctx.WriteByte('`')
+			ctx.WriteString(arg)
+			ctx.WriteByte('`')
+			continue
+		}
+		ctx.WriteString(arg)
+	}
+	ctx.WriteByte(')')
+}
+
+type OptimizerHints []*OptimizerHint
+
+func (node OptimizerHints) Format(ctx *FmtCtx) {
+	ctx.WriteString("/*+ ")
+	for i, hint := range node {
+		if i > 0 {
+			ctx.WriteByte(' ')
+		}
+		hint.Format(ctx)
+	}
+	ctx.WriteString(" */")
+}
+
+// Find returns the first hint of the name, the later ones conflict with it
+// and are ignored like MySQL does.
+func (node OptimizerHints) Find(name string) *OptimizerHint {
+	for _, hint := range node {
+		if strings.EqualFold(hint.Name, name) {
+			return hint
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+type JsonTableColumnKind int
+
+const (
+	// JsonTableColumnPath is `name type PATH 'path' [on_empty] [on_error]`.
+	JsonTableColumnPath JsonTableColumnKind = iota
+	// JsonTableColumnExists is `name type EXISTS PATH 'path'`.
+	JsonTableColumnExists
+	// JsonTableColumnOrdinality is `name FOR ORDINALITY`.
+	JsonTableColumnOrdinality
+	// JsonTableColumnNested is `NESTED [PATH] 'path' COLUMNS (...)`.
+	JsonTableColumnNested
+)
+
+type JsonTableOnResponseType int
+
+const (
+	JsonTableOnResponseNull JsonTableOnResponseType = iota
+	JsonTableOnResponseError
+	JsonTableOnResponseDefault
+)
+
+// JsonTableOnResponse is the `{NULL | ERROR | DEFAULT 'value'}` part of
+// an ON EMPTY or ON ERROR clause.
+type JsonTableOnResponse struct {
+	Type    JsonTableOnResponseType
+	Default string
+}
+
+func (node *JsonTableOnResponse) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case JsonTableOnResponseNull:
+		ctx.WriteString("null")
+	case JsonTableOnResponseError:
+		ctx.WriteString("error")
+	case JsonTableOnResponseDefault:
+		ctx.WriteString("default ")
+		ctx.WriteValue(P_char, FormatString(node.Default))
+	}
+}
+
+type JsonTableColumn struct {
+	Kind JsonTableColumnKind
+	Name Identifier
+	Type ResolvableTypeReference
+	Path string
+	// OnEmpty and OnError are nil when the clause is omitted.
+	OnEmpty *JsonTableOnResponse
+	OnError *JsonTableOnResponse
+	// Columns are the columns of a NESTED PATH.
+	Columns JsonTableColumns
+}
+
+func (node *JsonTableColumn) Format(ctx *FmtCtx) {
+	if node.Kind == JsonTableColumnNested {
+		ctx.WriteString("nested path ")
+		ctx.WriteValue(P_char, FormatString(node.Path))
+		ctx.WriteByte(' ')
+		node.Columns.Format(ctx)
+		return
+	}
+
+	node.Name.Format(ctx)
+	if node.Kind == JsonTableColumnOrdinality {
+		ctx.WriteString(" for ordinality")
+		return
+	}
+	ctx.WriteByte(' ')
+	node.Type.(*T).InternalType.Format(ctx)
+	if node.Kind == JsonTableColumnExists {
+		ctx.WriteString(" exists")
+	}
+	ctx.WriteString(" path ")
+	ctx.WriteValue(P_char, FormatString(node.Path))
+	if node.OnEmpty != nil {
+		ctx.WriteByte(' ')
+		node.OnEmpty.Format(ctx)
+		ctx.WriteString(" on empty")
+	}
+	if node.OnError != nil {
+		ctx.WriteByte(' ')
+		node.OnError.Format(ctx)
+		ctx.WriteString(" on error")
+	}
+}
+
+type JsonTableColumns []*JsonTableColumn
+
+func (node JsonTableColumns) Format(ctx *FmtCtx) {
+	ctx.WriteString("columns (")
+	for i, col := range node {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
@@ -303,10 +303,15 @@ type SelectClause struct {
 	GroupBy  GroupBy
 	Having   *Where
 	Option   string
+	Hints    OptimizerHints
 }
 
 func (node *SelectClause) Format(ctx *FmtCtx) {
 	ctx.WriteString("select ")
+	if len(node.Hints) > 0 {
+		node.Hints.Format(ctx)
+		ctx.WriteByte(' ')
+	}
 	if node.Distinct {
 		ctx.WriteString("distinct ")
 	}
@@ -408,6 +413,92 @@ func (node *GroupBy) Format(ctx *FmtCtx) {
 	}
 }
 
+// HasGroupingSets tells whether the GROUP BY clause has ROLLUP, CUBE or
+// GROUPING SETS.
+func (node GroupBy) HasGroupingSets() bool {
+	for _, n := range node {
+		if _, ok := n.(*GroupingSets); ok {
+			return true
+		}
+	}
+	return false
+}
+
+type GroupingSetsType int
+
+const (
+	GroupingSetsRollup GroupingSetsType = iota
+	GroupingSetsCube
+	GroupingSetsList
+)
+
+// GroupingSets is a ROLLUP(...), CUBE(...) or GROUPING SETS(...) item of a
+// GROUP BY clause. Each set is a list of expressions, the empty set groups
+// all the rows together.
+type GroupingSets struct {
+	exprImpl
+	Type GroupingSetsType
+	Sets []Exprs
+}
+
+func NewGroupingSets(typ GroupingSetsType, elems Exprs) *GroupingSets {
+	sets := make([]Exprs, len(elems))
+	for i, elem := range elems {
+		switch e := elem.(type) {
+		case nil:
+			sets[i] = Exprs{}
+		case *Tuple:
+			sets[i] = e.Exprs
+		default:
+			sets[i] = Exprs{e}
+		}
+	}
+	return &GroupingSets{Type: typ, Sets: sets}
+}
+
+func (node *GroupingSets) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case GroupingSetsRollup:
+		ctx.WriteString("rollup(")
+	case GroupingSetsCube:
+		ctx.WriteString("cube(")
+	default:
+		ctx.WriteString("grouping sets(")
+	}
+	for i, set := range node.Sets {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		if len(set) == 1 {
+			set[0].Format(ctx)
+			continue
+		}
+		ctx.WriteByte('(')
+		set.Format(ctx)
+		ctx.WriteByte(')')
+	}
+	ctx.WriteByte(')')
+}
+
+// Accept implements NodeChecker interface
+func (node *GroupingSets) Accept(v Visitor) (Expr, bool) {
+	newNode, skipChildren := v.Enter(node)
+	if skipChildren {
+		return v.Exit(newNode)
+	}
+	node = newNode.(*GroupingSets)
+	for _, set := range node.Sets {
+		for i, e := range set {
+			tmpNode, ok := e.Accept(v)
+			if !ok {
+				return node, false
+			}
+			set[i] = tmpNode
+		}
+	}
+	return v.Exit(node)
+}
+
 const (
 	JOIN_TYPE_FULL          = "FULL"
 	JOIN_TYPE_LEFT          = "LEFT"
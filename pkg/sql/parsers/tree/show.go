@@ -849,6 +849,18 @@ func (node *ShowBackendServers) Format(ctx *FmtCtx) {
 func (node *ShowBackendServers) GetStatementType() string { return "Show Backend Servers" }
 func (node *ShowBackendServers) GetQueryType() string     { return QueryTypeOth }
 
+// ShowMasterStatus indicates SHOW MASTER STATUS statement.
+type ShowMasterStatus struct {
+	showImpl
+}
+
+func (node *ShowMasterStatus) Format(ctx *FmtCtx) {
+	ctx.WriteString("show master status")
+}
+
+func (node *ShowMasterStatus) GetStatementType() string { return "Show Master Status" }
+func (node *ShowMasterStatus) GetQueryType() string     { return QueryTypeOth }
+
 type ShowConnectors struct {
 	showImpl
 }
@@ -131,6 +131,7 @@ var (
 
 	//like statements: they composite the result set themselves
 	//    ShowConnectors
+	//    ShowCDC
 	//    ExplainStmt
 	//    ShowTableStatus
 	//    ShowErrors
@@ -139,6 +140,7 @@ var (
 	//    ShowCollation
 	//    ShowSubscriptions
 	//    ShowBackendServers
+	//    ShowMasterStatus
 	compositeResRowType = MakeStmtKind(OUTPUT_RESULT_ROW, RESP_PREBUILD_RESULT_ROW, EXEC_IN_FRONTEND)
 )
 
@@ -269,6 +271,10 @@ func (node *ShowBackendServers) StmtKind() StmtKind {
 	return compositeResRowType
 }
 
+func (node *ShowMasterStatus) StmtKind() StmtKind {
+	return compositeResRowType
+}
+
 func (node *SetTransaction) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
@@ -638,7 +644,7 @@ func (node *DropPitr) StmtKind() StmtKind {
 func (node *CreateCDC) StmtKind() StmtKind { return frontendStatusTyp }
 
 func (node *ShowCDC) StmtKind() StmtKind {
-	return defaultResRowTyp
+	return compositeResRowType
 }
 
 func (node *PauseCDC) StmtKind() StmtKind {
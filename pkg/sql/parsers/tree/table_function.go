@@ -18,12 +18,24 @@ type TableFunction struct {
 	statementImpl
 	Func       *FuncExpr
 	SelectStmt *Select
+	// JsonColumns is the COLUMNS clause of JSON_TABLE.
+	JsonColumns JsonTableColumns
 }
 
 func (t *TableFunction) Format(ctx *FmtCtx) {
-	if t.Func != nil {
+	if t.Func == nil {
+		return
+	}
+	if t.JsonColumns == nil {
 		t.Func.Format(ctx)
+		return
 	}
+	ctx.WriteString(t.Func.FuncName.Origin())
+	ctx.WriteByte('(')
+	t.Func.Exprs.Format(ctx)
+	ctx.WriteByte(' ')
+	t.JsonColumns.Format(ctx)
+	ctx.WriteByte(')')
 }
 
 func (t TableFunction) Id() string {
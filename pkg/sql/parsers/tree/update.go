@@ -31,6 +31,7 @@ type Update struct {
 	OrderBy OrderBy
 	Limit   *Limit
 	With    *With
+	Hints   OptimizerHints
 }
 
 func (node *Update) Format(ctx *FmtCtx) {
@@ -39,6 +40,10 @@ func (node *Update) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 	}
 	ctx.WriteString("update")
+	if len(node.Hints) > 0 {
+		ctx.WriteByte(' ')
+		node.Hints.Format(ctx)
+	}
 	if node.Tables != nil {
 		ctx.WriteByte(' ')
 		node.Tables.Format(ctx)
@@ -497,6 +502,15 @@ type ExportParam struct {
 	ForceQuote []string
 	// stage filename path
 	StageFilePath string
+	// FileFormat is csv, jsonline or parquet, it is csv if it is empty
+	FileFormat string
+	// FormatOption are the key-value pairs of the options of the format
+	FormatOption []string
+	// Compression is gzip or zstd, the files are not compressed if it is empty
+	Compression string
+	// PartitionBy are the result columns which split the rows into the
+	// hive style sub-directories, such as "col=value/"
+	PartitionBy []string
 }
 
 func (ep *ExportParam) Format(ctx *FmtCtx) {
@@ -540,6 +554,30 @@ func (ep *ExportParam) format(ctx *FmtCtx, withOutfile bool) {
 			prefix = ", "
 		}
 	}
+	if ep.FileFormat != "" {
+		ctx.WriteString(" format '")
+		ctx.WriteString(ep.FileFormat)
+		ctx.WriteByte('\'')
+		if len(ep.FormatOption) > 0 {
+			ctx.WriteString(" {")
+			for i := 0; i+1 < len(ep.FormatOption); i += 2 {
+				if i > 0 {
+					ctx.WriteString(", ")
+				}
+				ctx.WriteString("'" + ep.FormatOption[i] + "'='" + ep.FormatOption[i+1] + "'")
+			}
+			ctx.WriteByte('}')
+		}
+	}
+	if ep.Compression != "" {
+		ctx.WriteString(" compression '")
+		ctx.WriteString(ep.Compression)
+		ctx.WriteByte('\'')
+	}
+	if len(ep.PartitionBy) > 0 {
+		ctx.WriteString(" partition by ")
+		ctx.WriteString(strings.Join(ep.PartitionBy, ", "))
+	}
 }
 
 var _ LoadColumn = &UnresolvedName{}
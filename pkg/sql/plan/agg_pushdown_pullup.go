@@ -359,7 +359,7 @@ func (builder *QueryBuilder) aggPullup(rootID, nodeID int32) int32 {
 	}
 
 	agg := builder.qry.Nodes[join.Children[0]]
-	if agg.NodeType != plan.Node_AGG {
+	if agg.NodeType != plan.Node_AGG || len(agg.GroupingIds) > 0 {
 		return nodeID
 	}
 	leftScan := builder.qry.Nodes[agg.Children[0]]
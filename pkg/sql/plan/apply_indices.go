@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 func containsDynamicParam(expr *plan.Expr) bool {
@@ -77,10 +78,23 @@ func (builder *QueryBuilder) applyIndices(nodeID int32, colRefCnt map[[2]int32]i
 
 	switch node.NodeType {
 	case plan.Node_TABLE_SCAN:
-		return builder.applyIndicesForFilters(nodeID, node, colRefCnt, idxColMap)
+		builder.markPrimaryKeyUsed(node)
+		newNodeID := builder.applyIndicesForFilters(nodeID, node, colRefCnt, idxColMap)
+		if newNodeID != nodeID {
+			builder.markIndexHintUsed(node, tree.HintForJoin)
+		}
+		return newNodeID
 
 	case plan.Node_JOIN:
-		return builder.applyIndicesForJoins(nodeID, node, colRefCnt, idxColMap)
+		leftChildID := node.Children[0]
+		newNodeID := builder.applyIndicesForJoins(nodeID, node, colRefCnt, idxColMap)
+		if node.Children[0] != leftChildID {
+			builder.markIndexHintUsed(builder.qry.Nodes[leftChildID], tree.HintForJoin)
+		}
+		return newNodeID
+
+	case plan.Node_AGG:
+		return builder.applyIndicesForGroupBy(nodeID, node, colRefCnt, idxColMap)
 
 	case plan.Node_PROJECT:
 		//NOTE: This is the entry point for vector index rule on SORT NODE.
@@ -98,8 +112,9 @@ func (builder *QueryBuilder) applyIndicesForFilters(nodeID int32, node *plan.Nod
 	}
 	// 1. Master Index Check
 	{
+		indexes, _ := builder.hintedIndexes(node, tree.HintForJoin)
 		masterIndexes := make([]*plan.IndexDef, 0)
-		for _, indexDef := range node.TableDef.Indexes {
+		for _, indexDef := range indexes {
 			if !indexDef.Unique && catalog.IsMasterIndexAlgo(indexDef.IndexAlgo) {
 				masterIndexes = append(masterIndexes, indexDef)
 			}
@@ -182,7 +197,8 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 
 		// 1.a if there are no table scans with multi-table indexes, skip
 		multiTableIndexes := make(map[string]*MultiTableIndex)
-		for _, indexDef := range scanNode.TableDef.Indexes {
+		indexes, _ := builder.hintedIndexes(scanNode, tree.HintForOrderBy)
+		for _, indexDef := range indexes {
 			if catalog.IsIvfIndexAlgo(indexDef.IndexAlgo) {
 				if _, ok := multiTableIndexes[indexDef.IndexName]; !ok {
 					multiTableIndexes[indexDef.IndexName] = &MultiTableIndex{
@@ -273,6 +289,8 @@ func (builder *QueryBuilder) applyIndicesForProject(nodeID int32, projNode *plan
 		newSortNode := builder.applyIndicesForSortUsingVectorIndex(nodeID, projNode, sortNode, scanNode,
 			colRefCnt, idxColMap, multiTableIndexWithSortDistFn, colPosOrderBy)
 
+		builder.markIndexHintUsed(scanNode, tree.HintForOrderBy)
+
 		// TODO: consult with nitao and aungr
 		projNode.Children[0] = newSortNode
 		replaceColumnsForNode(projNode, idxColMap)
@@ -291,7 +309,8 @@ END0:
 func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, node *plan.Node, colRefCnt map[[2]int32]int, idxColMap map[[2]int32]*plan.Expr) int32 {
 	sid := builder.compCtx.GetProcess().GetService()
 
-	if len(node.FilterList) == 0 || len(node.TableDef.Indexes) == 0 {
+	indexes, forced := builder.hintedIndexes(node, tree.HintForJoin)
+	if len(node.FilterList) == 0 || len(indexes) == 0 {
 		return nodeID
 	}
 
@@ -309,7 +328,6 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 		pkPos = node.TableDef.Name2ColIndex[node.TableDef.Pkey.Names[0]]
 	}
 
-	indexes := node.TableDef.Indexes
 	sort.Slice(indexes, func(i, j int) bool {
 		return (indexes[i].Unique && !indexes[j].Unique) || (indexes[i].Unique == indexes[j].Unique && len(indexes[i].Parts) > len(indexes[j].Parts))
 	})
@@ -584,7 +602,8 @@ func (builder *QueryBuilder) applyIndicesForFiltersRegularIndex(nodeID int32, no
 	}
 
 END0:
-	if node.Stats.Selectivity > InFilterSelectivityLimit || node.Stats.Outcnt > float64(GetInFilterCardLimitOnPK(sid, node.Stats.TableCnt)) {
+	// a forced index is used even if a table scan looks cheaper
+	if !forced && (node.Stats.Selectivity > InFilterSelectivityLimit || node.Stats.Outcnt > float64(GetInFilterCardLimitOnPK(sid, node.Stats.TableCnt))) {
 		return nodeID
 	}
 
@@ -655,7 +674,7 @@ END0:
 			filterIdx = append(filterIdx, idx)
 
 			filter := node.FilterList[idx]
-			if filter.Selectivity <= InFilterSelectivityLimit && node.Stats.TableCnt*filter.Selectivity <= float64(GetInFilterCardLimitOnPK(sid, node.Stats.TableCnt)) {
+			if forced || (filter.Selectivity <= InFilterSelectivityLimit && node.Stats.TableCnt*filter.Selectivity <= float64(GetInFilterCardLimitOnPK(sid, node.Stats.TableCnt))) {
 				usePartialIndex = true
 			}
 		}
@@ -812,7 +831,7 @@ END0:
 		}
 
 		idxTag := builder.genNewTag()
-		idxDef := node.TableDef.Indexes[idxPos]
+		idxDef := indexes[idxPos]
 		//idxObjRef, idxTableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, idxDef.IndexTableName, *ts)
 		idxObjRef, idxTableDef := builder.compCtx.Resolve(node.ObjRef.SchemaName, idxDef.IndexTableName, scanSnapshot)
 		builder.addNameByColRef(idxTag, idxTableDef)
@@ -918,12 +937,30 @@ func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node,
 
 	rightChild := builder.qry.Nodes[node.Children[1]]
 
-	if rightChild.Stats.Selectivity > 0.5 {
+	indexes, forced := builder.hintedIndexes(leftChild, tree.HintForJoin)
+	if len(indexes) == 0 {
 		return nodeID
 	}
 
-	if rightChild.Stats.Outcnt > float64(GetInFilterCardLimitOnPK(sid, leftChild.Stats.TableCnt)) || rightChild.Stats.Outcnt > leftChild.Stats.Cost*0.1 {
-		return nodeID
+	// HASH_JOIN keeps the hash join, NO_HASH_JOIN turns it into an index join
+	// whenever an index can serve it.
+	if qh := builder.queryHints; qh != nil {
+		table := builder.hintedTable(leftChild)
+		if qh.hashJoin.match(table) {
+			return nodeID
+		}
+		forced = forced || qh.noHashJoin.match(table)
+	}
+
+	// a forced index is used even if a hash join looks cheaper
+	if !forced {
+		if rightChild.Stats.Selectivity > 0.5 {
+			return nodeID
+		}
+
+		if rightChild.Stats.Outcnt > float64(GetInFilterCardLimitOnPK(sid, leftChild.Stats.TableCnt)) || rightChild.Stats.Outcnt > leftChild.Stats.Cost*0.1 {
+			return nodeID
+		}
 	}
 
 	leftTags := make(map[int32]bool)
@@ -964,7 +1001,6 @@ func (builder *QueryBuilder) applyIndicesForJoins(nodeID int32, node *plan.Node,
 		return nodeID
 	}
 
-	indexes := leftChild.TableDef.Indexes
 	condIdx := make([]int, 0, len(col2Cond))
 	for _, idxDef := range indexes {
 		if !idxDef.TableExist {
@@ -0,0 +1,174 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// applyIndicesForGroupBy groups on a secondary index instead of the table:
+// if all the columns of the table scan under the AGG node are parts of the
+// index, and the GROUP BY columns are among its key parts, the scan is
+// replaced with a scan of the index table.
+//
+// The index table is not narrower than the columns read from the table, so
+// the rule is only applied when a USE or FORCE INDEX hint asks for an index
+// in the GROUP BY scope.
+func (builder *QueryBuilder) applyIndicesForGroupBy(nodeID int32, node *plan.Node, colRefCnt map[[2]int32]int, idxColMap map[[2]int32]*plan.Expr) int32 {
+	if len(node.GroupBy) == 0 || len(node.Children) != 1 {
+		return nodeID
+	}
+	scanID := node.Children[0]
+	scanNode := builder.qry.Nodes[scanID]
+	if scanNode.NodeType != plan.Node_TABLE_SCAN || len(scanNode.BindingTags) == 0 ||
+		len(scanNode.FilterList) > 0 || len(scanNode.BlockFilterList) > 0 || scanNode.Limit != nil ||
+		!builder.indexHintedFor(scanNode, tree.HintForGroupBy) {
+		return nodeID
+	}
+
+	tableDef := scanNode.TableDef
+	scanTag := scanNode.BindingTags[0]
+	for _, expr := range node.GroupBy {
+		col := expr.GetCol()
+		if col == nil || col.RelPos != scanTag {
+			return nodeID
+		}
+	}
+
+	var pkPos int32 = -1
+	if len(tableDef.Pkey.Names) == 1 {
+		pkPos = tableDef.Name2ColIndex[tableDef.Pkey.Names[0]]
+	}
+
+	// the index with the fewest parts covering the query is chosen. the rows
+	// of a unique index with a null key are not in its table, so only the
+	// secondary indexes can be grouped on.
+	indexes, _ := builder.hintedIndexes(scanNode, tree.HintForGroupBy)
+	var idxDef *plan.IndexDef
+	var idxParts map[int32]int
+	for _, indexDef := range indexes {
+		if indexDef.Unique || !indexDef.TableExist || !catalog.IsRegularIndexAlgo(indexDef.IndexAlgo) {
+			continue
+		}
+		if idxDef != nil && len(indexDef.Parts) >= len(idxDef.Parts) {
+			continue
+		}
+
+		parts := make(map[int32]int, len(indexDef.Parts))
+		for i, part := range indexDef.Parts {
+			if catalog.IsAlias(part) {
+				continue
+			}
+			if colIdx, ok := tableDef.Name2ColIndex[part]; ok {
+				parts[colIdx] = i
+			}
+		}
+		covered := true
+		for _, expr := range node.GroupBy {
+			if _, ok := parts[expr.GetCol().ColPos]; !ok {
+				covered = false
+				break
+			}
+		}
+		for i := range tableDef.Cols {
+			if !covered {
+				break
+			}
+			if _, ok := parts[int32(i)]; !ok && int32(i) != pkPos && colRefCnt[[2]int32{scanTag, int32(i)}] > 0 {
+				covered = false
+			}
+		}
+		if covered {
+			idxDef, idxParts = indexDef, parts
+		}
+	}
+	if idxDef == nil {
+		return nodeID
+	}
+
+	scanSnapshot := scanNode.ScanSnapshot
+	if scanSnapshot == nil {
+		scanSnapshot = &Snapshot{}
+	}
+	idxObjRef, idxTableDef := builder.compCtx.Resolve(scanNode.ObjRef.SchemaName, idxDef.IndexTableName, scanSnapshot)
+	if idxTableDef == nil {
+		return nodeID
+	}
+	idxTag := builder.genNewTag()
+	builder.addNameByColRef(idxTag, idxTableDef)
+
+	idxColExpr := &plan.Expr{
+		Typ: idxTableDef.Cols[0].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: idxTag,
+				ColPos: 0,
+			},
+		},
+	}
+	for colIdx, i := range idxParts {
+		mappedExpr, err := BindFuncExprImplByPlanExpr(builder.GetContext(), "serial_extract", []*plan.Expr{
+			DeepCopyExpr(idxColExpr),
+			{
+				Typ: plan.Type{
+					Id: int32(types.T_int64),
+				},
+				Expr: &plan.Expr_Lit{
+					Lit: &plan.Literal{
+						Value: &plan.Literal_I64Val{I64Val: int64(i)},
+					},
+				},
+			},
+			{
+				Typ: tableDef.Cols[colIdx].Typ,
+				Expr: &plan.Expr_T{
+					T: &plan.TargetType{},
+				},
+			},
+		})
+		if err != nil {
+			return nodeID
+		}
+		idxColMap[[2]int32{scanTag, colIdx}] = mappedExpr
+	}
+	if pkPos != -1 {
+		pkColPos := idxTableDef.Name2ColIndex[catalog.IndexTablePrimaryColName]
+		idxColMap[[2]int32{scanTag, pkPos}] = &plan.Expr{
+			Typ: idxTableDef.Cols[pkColPos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: idxTag,
+					ColPos: pkColPos,
+				},
+			},
+		}
+	}
+
+	node.Children[0] = builder.appendNode(&plan.Node{
+		NodeType:     plan.Node_TABLE_SCAN,
+		TableDef:     idxTableDef,
+		ObjRef:       idxObjRef,
+		ParentObjRef: scanNode.ObjRef,
+		BindingTags:  []int32{idxTag},
+		ScanSnapshot: scanNode.ScanSnapshot,
+	}, builder.ctxByNode[scanID])
+	replaceColumnsForNode(node, idxColMap)
+	builder.markIndexHintUsed(scanNode, tree.HintForGroupBy)
+
+	return nodeID
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// indexHints holds the USE/FORCE/IGNORE INDEX hints of a table scan.
+type indexHints struct {
+	table string
+	hints []*tree.IndexHint
+	// used records the scopes in which an index of the table was applied,
+	// HintForJoin stands for both row lookups and joins.
+	used map[tree.IndexHintScope]bool
+	// primaryUsed records that a filter of the scan reads the table by its
+	// primary key.
+	primaryUsed bool
+}
+
+// addIndexHints checks the index names of the hints against the table and
+// keeps them for the index rules.
+func (builder *QueryBuilder) addIndexHints(node *plan.Node, hints []*tree.IndexHint) error {
+	if len(node.BindingTags) == 0 {
+		return nil
+	}
+
+	tableDef := node.TableDef
+	for _, hint := range hints {
+		for _, name := range hint.IndexNames {
+			if strings.EqualFold(name, "primary") {
+				continue
+			}
+			found := false
+			for _, indexDef := range tableDef.Indexes {
+				if strings.EqualFold(indexDef.IndexName, name) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return moerr.NewErrKeyDoesNotExist(builder.GetContext(), name, tableDef.Name)
+			}
+		}
+	}
+
+	if builder.indexHints == nil {
+		builder.indexHints = make(map[int32]*indexHints)
+	}
+	builder.indexHints[node.BindingTags[0]] = &indexHints{
+		table: tableDef.Name,
+		hints: hints,
+		used:  make(map[tree.IndexHintScope]bool),
+	}
+	return nil
+}
+
+// hintedIndexes returns the indexes of the table scan that the hints allow in
+// the scope, and whether the hints force an index. Without hints it returns
+// all indexes of the table.
+func (builder *QueryBuilder) hintedIndexes(node *plan.Node, scope tree.IndexHintScope) ([]*plan.IndexDef, bool) {
+	ih := builder.getIndexHints(node)
+	if ih == nil {
+		return node.TableDef.Indexes, false
+	}
+
+	hasUse, forced := false, false
+	useNames := make(map[string]bool)
+	ignoreNames := make(map[string]bool)
+	for _, hint := range ih.hints {
+		if hint.HintScope != tree.HintForScan && hint.HintScope != scope {
+			continue
+		}
+		switch hint.HintType {
+		case tree.HintUse, tree.HintForce:
+			hasUse = true
+			forced = forced || hint.HintType == tree.HintForce
+			for _, name := range hint.IndexNames {
+				useNames[strings.ToLower(name)] = true
+			}
+		case tree.HintIgnore:
+			for _, name := range hint.IndexNames {
+				ignoreNames[strings.ToLower(name)] = true
+			}
+		}
+	}
+
+	if !hasUse && len(ignoreNames) == 0 {
+		return node.TableDef.Indexes, false
+	}
+
+	indexes := make([]*plan.IndexDef, 0, len(node.TableDef.Indexes))
+	for _, indexDef := range node.TableDef.Indexes {
+		name := strings.ToLower(indexDef.IndexName)
+		if (hasUse && !useNames[name]) || ignoreNames[name] {
+			continue
+		}
+		indexes = append(indexes, indexDef)
+	}
+	return indexes, forced
+}
+
+// indexHintedFor returns true if a USE or FORCE INDEX hint of the table scan
+// names indexes for the scope.
+func (builder *QueryBuilder) indexHintedFor(node *plan.Node, scope tree.IndexHintScope) bool {
+	ih := builder.getIndexHints(node)
+	if ih == nil {
+		return false
+	}
+	for _, hint := range ih.hints {
+		if hint.HintType == tree.HintIgnore || len(hint.IndexNames) == 0 {
+			continue
+		}
+		if hint.HintScope == tree.HintForScan || hint.HintScope == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (builder *QueryBuilder) getIndexHints(node *plan.Node) *indexHints {
+	if builder.indexHints == nil || len(node.BindingTags) == 0 {
+		return nil
+	}
+	return builder.indexHints[node.BindingTags[0]]
+}
+
+// markIndexHintUsed records that an index of the table scan was applied in the scope.
+func (builder *QueryBuilder) markIndexHintUsed(node *plan.Node, scope tree.IndexHintScope) {
+	if ih := builder.getIndexHints(node); ih != nil {
+		ih.used[scope] = true
+	}
+}
+
+// markPrimaryKeyUsed records that the table scan reads the table by its primary
+// key if a filter compares the primary key, or its first part, with constants.
+func (builder *QueryBuilder) markPrimaryKeyUsed(node *plan.Node) {
+	ih := builder.getIndexHints(node)
+	if ih == nil || node.TableDef.Pkey == nil || node.TableDef.Pkey.PkeyColName == catalog.FakePrimaryKeyColName {
+		return
+	}
+
+	pkPos := node.TableDef.Name2ColIndex[node.TableDef.Pkey.Names[0]]
+	for _, expr := range node.FilterList {
+		fn := expr.GetF()
+		if fn == nil {
+			continue
+		}
+		switch fn.Func.ObjName {
+		case "=", "<", "<=", ">", ">=", "between", "in":
+		default:
+			continue
+		}
+
+		onPk := false
+		for _, arg := range fn.Args {
+			if col := arg.GetCol(); col != nil && col.RelPos == node.BindingTags[0] && col.ColPos == pkPos {
+				onPk = true
+			} else if !isRuntimeConstExpr(arg) {
+				onPk = false
+				break
+			}
+		}
+		if onPk {
+			ih.primaryUsed = true
+			return
+		}
+	}
+}
+
+// checkForcedIndexes raises a warning for every FORCE INDEX hint that the plan
+// could not follow.
+func (builder *QueryBuilder) checkForcedIndexes() {
+	if len(builder.indexHints) == 0 {
+		return
+	}
+
+	tags := make([]int32, 0, len(builder.indexHints))
+	for tag := range builder.indexHints {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i] < tags[j] })
+
+	for _, tag := range tags {
+		ih := builder.indexHints[tag]
+		for _, hint := range ih.hints {
+			if hint.HintType != tree.HintForce {
+				continue
+			}
+
+			var used bool
+			switch hint.HintScope {
+			case tree.HintForScan:
+				used = len(ih.used) > 0
+			default:
+				used = ih.used[hint.HintScope]
+			}
+			if !used && ih.primaryUsed && hint.HintScope != tree.HintForOrderBy && hint.HintScope != tree.HintForGroupBy {
+				for _, name := range hint.IndexNames {
+					used = used || strings.EqualFold(name, PrimaryKeyName)
+				}
+			}
+			if used {
+				continue
+			}
+
+			msg := fmt.Sprintf("index hint '%s' on table '%s' cannot be used, the query runs without it",
+				tree.String(hint, dialect.MYSQL), ih.table)
+			builder.compCtx.AddWarning(moerr.NewWarn(builder.GetContext(), msg))
+		}
+	}
+}
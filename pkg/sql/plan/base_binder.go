@@ -949,6 +949,14 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 	}
 	funcName := funcRef.ColName()
 
+	if funcName == "grouping" {
+		return b.bindGroupingFunc(astExpr)
+	}
+
+	if astExpr.WithinGroup {
+		astExpr = rewriteWithinGroup(astExpr)
+	}
+
 	if function.GetFunctionIsAggregateByName(funcName) && astExpr.WindowSpec == nil {
 		if b.ctx.timeTag > 0 {
 			return b.impl.BindTimeWindowFunc(funcName, astExpr, depth, isRoot)
@@ -961,6 +969,23 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 	return b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
 }
 
+// rewriteWithinGroup rewrites the ordered-set aggregation
+// 'percentile_cont(p) within group (order by x [desc])' to
+// 'percentile_cont(x, p, desc)'.
+func rewriteWithinGroup(astExpr *tree.FuncExpr) *tree.FuncExpr {
+	order := astExpr.OrderBy[0]
+	desc := tree.NewNumVal(false, "false", false, tree.P_bool)
+	if order.Direction == tree.Descending {
+		desc = tree.NewNumVal(true, "true", false, tree.P_bool)
+	}
+
+	newExpr := *astExpr
+	newExpr.Exprs = tree.Exprs{order.Expr, astExpr.Exprs[0], desc}
+	newExpr.OrderBy = nil
+	newExpr.WithinGroup = false
+	return &newExpr
+}
+
 func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr, depth int32) (*plan.Expr, error) {
 	// rewrite some ast Exprs before binding
 	switch name {
@@ -23,7 +23,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -515,6 +518,451 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestIndexHints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+
+	sqls := []string{
+		"select * from test_idx use index(idx1) where n_nationkey = 1",
+		"select * from test_idx ignore index for join (idx1) where n_nationkey = 1",
+		"select * from test_idx use index() where n_nationkey = 1",
+		"select * from test_idx force index for order by (idx1) order by n_nationkey",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select * from test_idx use index(idx2)",
+		"select * from test_idx ignore index for group by (idx1, idx2)",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	ctx.warnings = nil
+	_, err := runOneStmt(mock, t, "select * from test_idx force index(idx1)")
+	assert.NoError(t, err)
+	assert.Len(t, ctx.warnings, 1)
+	assert.Contains(t, ctx.warnings[0].Error(), "force index(idx1)")
+
+	ctx.warnings = nil
+	_, err = runOneStmt(mock, t, "select * from test_idx force index(primary)")
+	assert.NoError(t, err)
+	assert.Len(t, ctx.warnings, 1)
+
+	// the filter on the primary key reads the table by it.
+	ctx.warnings = nil
+	_, err = runOneStmt(mock, t, "select * from test_idx force index(primary) where n_nationkey = 1")
+	assert.NoError(t, err)
+	assert.Empty(t, ctx.warnings)
+
+	ctx.warnings = nil
+	_, err = runOneStmt(mock, t, "select * from test_idx force index(primary) where n_nationkey in (1, 2)")
+	assert.NoError(t, err)
+	assert.Empty(t, ctx.warnings)
+
+	ctx.warnings = nil
+	_, err = runOneStmt(mock, t, "select * from test_idx force index(primary) where n_name = 'a'")
+	assert.NoError(t, err)
+	assert.Len(t, ctx.warnings, 1)
+}
+
+func TestGroupByIndexHints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+
+	scanned := func(sql string) []string {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		// the scans reachable from the root, the replaced scan is left in
+		// the node list.
+		qry := logicPlan.GetQuery()
+		var tables []string
+		var walk func(nodeID int32)
+		walk = func(nodeID int32) {
+			node := qry.Nodes[nodeID]
+			if node.NodeType == plan.Node_TABLE_SCAN {
+				tables = append(tables, node.TableDef.Name)
+			}
+			for _, child := range node.Children {
+				walk(child)
+			}
+		}
+		walk(qry.Steps[0])
+		return tables
+	}
+	nameIdx := catalog.SecondaryIndexTableNamePrefix + "test_idx_name"
+	nameKeyIdx := catalog.SecondaryIndexTableNamePrefix + "test_idx_name_key"
+
+	// the table is scanned for grouping without a hint.
+	require.Equal(t, []string{"test_idx"}, scanned("select n_name, count(n_nationkey) from test_idx group by n_name"))
+
+	require.Equal(t, []string{nameIdx}, scanned("select n_name, count(n_nationkey) from test_idx use index for group by (idx_name, idx_name_key) group by n_name"))
+	require.Equal(t, []string{nameKeyIdx}, scanned("select n_name, count(n_nationkey) from test_idx use index (idx_name_key) group by n_name"))
+	// the hint of another scope does not pick an index for grouping.
+	require.Equal(t, []string{"test_idx"}, scanned("select n_name, count(n_nationkey) from test_idx use index for join (idx_name) group by n_name"))
+
+	require.Equal(t, []string{nameKeyIdx}, scanned("select n_name from test_idx use index (idx_name, idx_name_key) ignore index for group by (idx_name) group by n_name"))
+	require.Equal(t, []string{"test_idx"}, scanned("select n_name from test_idx use index (idx_name) ignore index for group by (idx_name) group by n_name"))
+
+	ctx.warnings = nil
+	require.Equal(t, []string{nameIdx}, scanned("select n_name, count(*) from test_idx force index for group by (idx_name) group by n_name"))
+	require.Empty(t, ctx.warnings)
+
+	// the index does not cover the grouping column.
+	ctx.warnings = nil
+	require.Equal(t, []string{"test_idx"}, scanned("select n_nationkey from test_idx force index for group by (idx_name) group by n_nationkey"))
+	require.Len(t, ctx.warnings, 1)
+	require.Contains(t, ctx.warnings[0].Error(), "force index for group by(idx_name)")
+}
+
+func TestOptimizerHints(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+
+	sqls := []string{
+		"select /*+ HASH_JOIN(o) NO_RUNTIME_FILTER NO_PUSHDOWN */ * from customer c, orders o where c.c_custkey = o.o_custkey limit 10",
+		"select /*+ NO_HASH_JOIN SHUFFLE(l) BROADCAST(c) */ count(*) from customer c, orders o, lineitem l where c.c_custkey = o.o_custkey and l.l_orderkey = o.o_orderkey",
+		"update /*+ DOP(2) */ nation set n_name = 'a' where n_nationkey = 1",
+		"delete /*+ NO_RUNTIME_FILTER */ from nation where n_nationkey = 1",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// the tables of the LEADING hint are joined first
+	ctx.warnings = nil
+	pl, err := runOneStmt(mock, t, "select /*+ LEADING(l, c) DOP(4) */ count(*) from customer c, orders o, lineitem l "+
+		"where c.c_custkey = o.o_custkey and l.l_orderkey = o.o_orderkey")
+	require.NoError(t, err)
+	require.Empty(t, ctx.warnings)
+	qry := pl.GetQuery()
+	require.Equal(t, []string{"LEADING(l, c)", "DOP(4)"}, qry.Hints)
+	require.Equal(t, int32(4), qry.Dop)
+
+	var firstJoin *plan.Node
+	var findFirstJoin func(nodeID int32)
+	findFirstJoin = func(nodeID int32) {
+		node := qry.Nodes[nodeID]
+		if node.NodeType == plan.Node_JOIN &&
+			qry.Nodes[node.Children[0]].NodeType == plan.Node_TABLE_SCAN &&
+			qry.Nodes[node.Children[1]].NodeType == plan.Node_TABLE_SCAN {
+			firstJoin = node
+			return
+		}
+		for _, child := range node.Children {
+			findFirstJoin(child)
+		}
+	}
+	findFirstJoin(qry.Steps[0])
+	require.NotNil(t, firstJoin)
+	joined := []string{
+		qry.Nodes[firstJoin.Children[0]].TableDef.Name,
+		qry.Nodes[firstJoin.Children[1]].TableDef.Name,
+	}
+	require.ElementsMatch(t, []string{"lineitem", "customer"}, joined)
+
+	// the unknown hints and a LEADING hint no join order follows are warned about
+	ctx.warnings = nil
+	pl, err = runOneStmt(mock, t, "select /*+ NO_SUCH_HINT(c) DOP(0) LEADING(c, x) */ * from customer c, orders o where c.c_custkey = o.o_custkey")
+	require.NoError(t, err)
+	require.Len(t, ctx.warnings, 3)
+	require.Equal(t, []string{"LEADING(c, x)"}, pl.GetQuery().Hints)
+
+	// the hints of a subquery or a derived table do not apply to the outer block
+	ctx.warnings = nil
+	pl, err = runOneStmt(mock, t, "select /*+ DOP(2) */ * from (select /*+ DOP(4) NO_PUSHDOWN */ * from customer) c "+
+		"where c.c_custkey in (select /*+ HASH_JOIN(o) */ o_custkey from orders o)")
+	require.NoError(t, err)
+	require.Len(t, ctx.warnings, 3)
+	require.Contains(t, ctx.warnings[0].Error(), "outermost query block")
+	require.Equal(t, []string{"DOP(2)"}, pl.GetQuery().Hints)
+	require.Equal(t, int32(2), pl.GetQuery().Dop)
+
+	ctx.warnings = nil
+	pl, err = runOneStmt(mock, t, "select * from customer where c_custkey in (select /*+ DOP(4) */ o_custkey from orders)")
+	require.NoError(t, err)
+	require.Len(t, ctx.warnings, 1)
+	require.Empty(t, pl.GetQuery().Hints)
+	require.Equal(t, int32(0), pl.GetQuery().Dop)
+}
+
+func TestGroupingSets(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"select n_regionkey, n_name, count(*), grouping(n_regionkey, n_name) from nation group by rollup(n_regionkey, n_name)",
+		"select n_regionkey, n_name, count(*) from nation group by n_regionkey, n_name with rollup having grouping(n_name) = 0",
+		"select n_regionkey, n_name, sum(n_nationkey) from nation group by cube(n_regionkey, n_name) order by grouping(n_regionkey)",
+		"select n_regionkey, n_name, count(*) from nation group by grouping sets((n_regionkey, n_name), n_regionkey, ())",
+		"select n_regionkey, n_name, count(*) from nation group by n_regionkey, rollup(n_name) having n_regionkey > 1",
+		"select n_regionkey, count(*) from nation group by rollup(1)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select n_regionkey, grouping(n_regionkey) from nation group by n_regionkey",
+		"select n_regionkey, grouping(n_name) from nation group by rollup(n_regionkey)",
+		"select n_regionkey from nation where grouping(n_regionkey) = 0 group by rollup(n_regionkey)",
+		"select sum(grouping(n_regionkey)) from nation group by rollup(n_regionkey)",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	pl, err := runOneStmt(mock, t, "select n_regionkey, n_name, count(*) from nation group by rollup(n_regionkey, n_name) having n_regionkey > 1")
+	require.NoError(t, err)
+	qry := pl.GetQuery()
+	var agg *plan.Node
+	for _, node := range qry.Nodes {
+		if node.NodeType == plan.Node_AGG {
+			agg = node
+		}
+	}
+	require.NotNil(t, agg)
+	// (n_regionkey, n_name), (n_regionkey), () and the grouping id column
+	require.Equal(t, []uint64{0, 2, 3}, agg.GroupingIds)
+	require.Len(t, agg.GroupBy, 3)
+	for _, expr := range agg.GroupBy[:2] {
+		require.False(t, expr.Typ.NotNullable)
+	}
+	// the filter on a group by column stays above the grouping
+	require.Len(t, agg.FilterList, 1)
+}
+
+func TestWindowValueFunctions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"select n_name, lag(n_nationkey) over (partition by n_regionkey order by n_nationkey) from nation",
+		"select lead(n_nationkey, 2, 0) ignore nulls over (order by n_nationkey) from nation",
+		"select first_value(n_name) over (order by n_nationkey rows between 1 preceding and 1 following) from nation",
+		"select last_value(n_name) ignore nulls over (partition by n_regionkey order by n_nationkey range between current row and unbounded following) from nation",
+		"select nth_value(n_name, 2) from last over (partition by n_regionkey) from nation",
+		"select ntile(4) over (order by n_nationkey), percent_rank() over (order by n_nationkey), cume_dist() over (partition by n_regionkey order by n_name) from nation",
+		"select rank() over (order by n_name), lag(n_name) over (order by n_name) from nation",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select lag(n_nationkey, -1) over (order by n_nationkey) from nation",
+		"select lag(n_nationkey, n_regionkey) over (order by n_nationkey) from nation",
+		"select lead(n_nationkey, 'a') over (order by n_nationkey) from nation",
+		"select nth_value(n_name, 0) over (order by n_nationkey) from nation",
+		"select ntile(0) over (order by n_nationkey) from nation",
+		"select ntile(null) over (order by n_nationkey) from nation",
+		"select first_value(n_nationkey) over (order by n_name) from nation",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	pl, err := runOneStmt(mock, t, "select nth_value(n_name, 2) from last ignore nulls over (order by n_nationkey) from nation")
+	require.NoError(t, err)
+	var win *plan.WindowSpec
+	for _, node := range pl.GetQuery().Nodes {
+		if node.NodeType == plan.Node_WINDOW {
+			win = node.WinSpecList[0].GetW()
+		}
+	}
+	require.NotNil(t, win)
+	require.True(t, win.FromLast)
+	require.True(t, win.IgnoreNulls)
+	require.Equal(t, int32(types.T_varchar), win.WindowFunc.Typ.Id)
+}
+
+func TestStatisticsAggregates(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"select var_samp(n_nationkey), stddev_samp(n_regionkey) from nation",
+		"select n_regionkey, covar_pop(n_nationkey, n_regionkey), covar_samp(n_nationkey, 1.5), corr(n_nationkey, n_regionkey) from nation group by n_regionkey",
+		"select regr_slope(n_nationkey, n_regionkey), regr_intercept(n_nationkey, n_regionkey), regr_r2(n_nationkey, n_regionkey), regr_count(n_nationkey, null) from nation",
+		"select corr(n_nationkey, n_regionkey) over (partition by n_regionkey), regr_count(n_nationkey, n_regionkey) over (order by n_nationkey) from nation",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select covar_pop(n_nationkey, n_name) from nation",
+		"select var_samp(n_name) from nation",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	pl, err := runOneStmt(mock, t, "select regr_count(n_nationkey, n_regionkey), corr(n_nationkey, n_regionkey) from nation")
+	require.NoError(t, err)
+	var agg *plan.Node
+	for _, node := range pl.GetQuery().Nodes {
+		if node.NodeType == plan.Node_AGG {
+			agg = node
+		}
+	}
+	require.NotNil(t, agg)
+	require.Equal(t, int32(types.T_int64), agg.AggList[0].Typ.Id)
+	require.True(t, agg.AggList[0].Typ.NotNullable)
+	require.Equal(t, int32(types.T_float64), agg.AggList[1].Typ.Id)
+	for _, arg := range agg.AggList[1].GetF().Args {
+		require.Equal(t, int32(types.T_float64), arg.Typ.Id)
+	}
+}
+
+func TestPercentileAggregates(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"select percentile_cont(0.5) within group (order by n_nationkey) from nation",
+		"select n_regionkey, percentile_disc(0.95) within group (order by n_nationkey desc) from nation group by n_regionkey having percentile_cont(0.5) within group (order by n_nationkey) > 1",
+		"select percentile_cont(0.5) within group (order by n_nationkey) over (partition by n_regionkey) from nation",
+		"select approx_percentile(n_nationkey, 0.99), approx_percentile(n_nationkey, 0.5, 200) from nation",
+		"select percentile_disc(0.5) within group (order by n_nationkey) from nation order by percentile_disc(0.5) within group (order by n_nationkey)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select percentile_cont(0.5) within group (order by n_name) from nation",
+		"select percentile_disc(0.5) within group (order by n_name) from nation",
+		"select percentile_cont('a') within group (order by n_nationkey) from nation",
+		"select approx_percentile(n_name, 0.5) from nation",
+		"select approx_percentile(n_nationkey) from nation",
+		"select approx_percentile(n_nationkey, 0.5, 1.5) from nation",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	pl, err := runOneStmt(mock, t, "select percentile_disc(0.5) within group (order by n_nationkey desc), percentile_cont(0.5) within group (order by n_nationkey) from nation")
+	require.NoError(t, err)
+	var agg *plan.Node
+	for _, node := range pl.GetQuery().Nodes {
+		if node.NodeType == plan.Node_AGG {
+			agg = node
+		}
+	}
+	require.NotNil(t, agg)
+	disc := agg.AggList[0].GetF()
+	require.Equal(t, "percentile_disc", disc.Func.ObjName)
+	require.Equal(t, 3, len(disc.Args))
+	require.Equal(t, int32(types.T_int32), agg.AggList[0].Typ.Id)
+	require.True(t, disc.Args[2].GetLit().GetBval())
+	require.Equal(t, int32(types.T_float64), agg.AggList[1].Typ.Id)
+	require.False(t, agg.AggList[1].GetF().Args[2].GetLit().GetBval())
+}
+
+func TestJsonAggregates(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"select n_regionkey, json_arrayagg(n_name), json_objectagg(n_name, n_nationkey) from nation group by n_regionkey",
+		"select json_arrayagg(null), json_objectagg(n_nationkey, null) from nation",
+		"select n_regionkey, json_arrayagg(n_name order by n_nationkey desc) from nation group by n_regionkey",
+		"select json_arrayagg(n_nationkey order by 1) from nation",
+		"select json_objectagg(n_name, n_comment) over (partition by n_regionkey) from nation",
+		"select json_arrayagg(json_arrayagg(n_name)) over () from nation",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select json_arrayagg(n_nationkey order by 2) from nation",
+		"select json_objectagg(n_regionkey, n_nationkey) from nation where json_arrayagg(n_name) is null",
+		"select json_arrayagg(json_arrayagg(n_name)) from nation",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	pl, err := runOneStmt(mock, t, "select json_objectagg(n_nationkey, n_name) from nation")
+	require.NoError(t, err)
+	var agg *plan.Node
+	for _, node := range pl.GetQuery().Nodes {
+		if node.NodeType == plan.Node_AGG {
+			agg = node
+		}
+	}
+	require.NotNil(t, agg)
+	f := agg.AggList[0].GetF()
+	require.Equal(t, "json_objectagg", f.Func.ObjName)
+	require.Equal(t, int32(types.T_varchar), f.Args[0].Typ.Id)
+	require.Equal(t, int32(types.T_json), agg.AggList[0].Typ.Id)
+}
+
+func TestJsonFunctions(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"select json_object(), json_array(), json_object('id', n_nationkey, 'name', n_name), json_array(n_nationkey, n_name, null) from nation",
+		"select json_object(n_nationkey, n_regionkey), json_array(json_object('a', 1.5), true, date '2024-01-01') from nation",
+		"select json_set('{\"a\": 1}', '$.b', n_name), json_insert(json_object('a', 1), '$.a', 2, '$.b', n_nationkey), json_replace('[1]', '$[0]', null) from nation",
+		"select json_remove(json_array(n_nationkey, n_name), '$[0]'), json_merge_patch('{}', json_object('a', n_name)), json_merge_preserve('[1]', '2', json_array(3)) from nation",
+		"select n_name from nation where json_contains(json_array(n_regionkey, n_nationkey), '1') and json_contains_path(json_object('a', n_name), 'one', '$.a', '$.b')",
+		"select json_keys(json_object('a', n_name)), json_keys('{\"a\": {\"b\": 1}}', '$.a'), json_length(json_array(n_nationkey)), json_length('[1, [2, 3]]', '$[1]') from nation",
+		"select json_type(json_object('a', n_name)), json_valid(n_comment), json_search(json_array(n_name), 'all', 'A%'), json_search('[\"a\"]', 'one', 'a', null, '$[0]') from nation",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select json_object('a') from nation",
+		"select json_set('{}', '$.a') from nation",
+		"select json_remove('{}') from nation",
+		"select json_merge_patch('{}') from nation",
+		"select json_contains('[1]') from nation",
+		"select json_contains_path('{}', 'one') from nation",
+		"select json_keys('{}', '$', '$') from nation",
+		"select json_type('1', '1') from nation",
+		"select json_search('[]', 'one') from nation",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	pl, err := runOneStmt(mock, t, "select json_length(n_comment), json_valid(n_name), json_type(n_comment) from nation")
+	require.NoError(t, err)
+	var proj *plan.Node
+	for _, node := range pl.GetQuery().Nodes {
+		if node.NodeType == plan.Node_PROJECT {
+			proj = node
+		}
+	}
+	require.NotNil(t, proj)
+	require.Equal(t, int32(types.T_int64), proj.ProjectList[0].Typ.Id)
+	require.Equal(t, int32(types.T_bool), proj.ProjectList[1].Typ.Id)
+	require.Equal(t, int32(types.T_varchar), proj.ProjectList[2].Typ.Id)
+}
+
+func TestJsonTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"select * from json_table('[{\"a\": 1}, {\"a\": 2}]', '$[*]' columns (id for ordinality, a int path '$.a')) as jt",
+		"select n.n_name, jt.a from nation n, json_table(n.n_comment, '$[*]' columns (a int path '$.a' default '0' on empty null on error, b varchar(10) exists path '$.b')) jt where jt.a > n.n_nationkey and n.n_regionkey = 1",
+		"select jt.* from nation n join json_table(n.n_comment, '$' columns (nested path '$.b[*]' columns (b json path '$'), c decimal(10, 2) path '$.c')) as jt on jt.c > n.n_nationkey",
+		"select n_name, count(*), max(jt.a) from nation, json_table(n_comment, '$[*]' columns (a int path '$' error on error)) jt group by n_name",
+		"select * from nation n, json_table(n.n_comment, '$' columns (k int path '$.k')) jt, region r where r.r_regionkey = jt.k",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select * from nation left join json_table(n_comment, '$' columns (a int path '$')) jt on true",
+		"select * from nation, json_table(n_comment, '$' columns (a int path '$', a int path '$.a')) jt",
+		"select * from nation, json_table(n_comment, '$[' columns (a int path '$')) jt",
+		"select * from nation, json_table(n_comment, '$' columns (a int path 'a')) jt",
+		"select * from nation, json_table(n_nationkey, '$' columns (a int path '$')) jt",
+		"select * from nation, json_table(n_comment, '$' columns (a blob path '$')) jt",
+		"select * from json_table(n_comment, '$' columns (a int path '$')) jt",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	// the rows of the left table without a match cannot be kept.
+	for _, sql := range []string{
+		"select * from nation left join json_table(n_comment, '$' columns (a int path '$')) jt on true",
+		"select * from nation n left join json_table(n.n_comment, '$[*]' columns (a int path '$')) jt on jt.a = n.n_nationkey",
+	} {
+		_, err := runOneStmt(mock, t, sql)
+		require.Error(t, err, sql)
+		require.True(t, moerr.IsMoErrCode(err, moerr.ErrNYI), sql)
+		require.Contains(t, err.Error(), "outer join with json_table", sql)
+	}
+
+	// json_table takes the place of the join, and passes n_name through.
+	pl, err := runOneStmt(mock, t, "select n_name, jt.a from nation, json_table(n_comment, '$[*]' columns (a int path '$')) jt where jt.a > n_nationkey")
+	require.NoError(t, err)
+	var scan *plan.Node
+	for _, node := range pl.GetQuery().Nodes {
+		require.NotEqual(t, plan.Node_JOIN, node.NodeType)
+		if node.NodeType == plan.Node_FUNCTION_SCAN {
+			scan = node
+		}
+	}
+	require.NotNil(t, scan)
+	require.Len(t, scan.TableDef.Cols, 1)
+	require.Equal(t, int32(types.T_int32), scan.TableDef.Cols[0].Typ.Id)
+	require.Len(t, scan.ProjectList, 3)
+	require.Equal(t, plan.Node_TABLE_SCAN, pl.GetQuery().Nodes[scan.Children[0]].NodeType)
+}
+
 // test join table plan building
 func TestJoinTableSqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer(false)
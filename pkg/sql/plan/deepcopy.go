@@ -214,6 +214,7 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		OnUpdateExprs:   make([]*plan.Expr, len(node.OnUpdateExprs)),
 	}
 	newNode.Uuid = append(newNode.Uuid, node.Uuid...)
+	newNode.GroupingIds = append(newNode.GroupingIds, node.GroupingIds...)
 
 	copy(newNode.Children, node.Children)
 	copy(newNode.BindingTags, node.BindingTags)
@@ -978,6 +979,8 @@ func DeepCopyExpr(expr *Expr) *Expr {
 				PartitionBy: ps,
 				OrderBy:     os,
 				Name:        item.W.Name,
+				FromLast:    item.W.FromLast,
+				IgnoreNulls: item.W.IgnoreNulls,
 				Frame: &plan.FrameClause{
 					Type: f.Type,
 					Start: &plan.FrameBound{
@@ -27,7 +27,7 @@ func (builder *QueryBuilder) optimizeDistinctAgg(nodeID int32) {
 	}
 
 	if node.NodeType == plan.Node_AGG {
-		if len(node.AggList) != 1 {
+		if len(node.AggList) != 1 || len(node.GroupingIds) > 0 {
 			return
 		}
 
@@ -109,6 +109,12 @@ func describeExpr(ctx context.Context, expr *plan.Expr, options *ExplainOptions,
 		if err != nil {
 			return err
 		}
+		if w.FromLast {
+			buf.WriteString(" from last")
+		}
+		if w.IgnoreNulls {
+			buf.WriteString(" ignore nulls")
+		}
 
 		if len(w.PartitionBy) > 0 {
 			buf.WriteString("; Partition By: ")
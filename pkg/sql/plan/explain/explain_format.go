@@ -0,0 +1,226 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// JsonPlan is the output of EXPLAIN FORMAT=JSON, every step of the query is
+// a tree of the plan nodes.
+type JsonPlan struct {
+	Steps []JsonStep `json:"steps"`
+}
+
+type JsonStep struct {
+	Step int           `json:"step"`
+	Plan *JsonPlanNode `json:"plan"`
+}
+
+// JsonPlanNode is built from the Node of the json visualizer. The output
+// columns are only set by the verbose option, and the statistics are only set
+// by the analyze option.
+type JsonPlanNode struct {
+	NodeId      string          `json:"id"`
+	Name        string          `json:"name"`
+	Title       string          `json:"title"`
+	Labels      []Label         `json:"labels"`
+	Stats       Stats           `json:"stats"`
+	Output      []string        `json:"output,omitempty"`
+	Statistics  *Statistics     `json:"statistics,omitempty"`
+	TotalStats  *TotalStats     `json:"totalStats,omitempty"`
+	SourceSteps []int32         `json:"sourceSteps,omitempty"`
+	Children    []*JsonPlanNode `json:"children,omitempty"`
+}
+
+// textOptions returns the options to describe the expressions of the nodes,
+// they are always described as text.
+func textOptions(options *ExplainOptions) *ExplainOptions {
+	opts := *options
+	opts.Format = EXPLAIN_FORMAT_TEXT
+	return &opts
+}
+
+func explainJson(ctx context.Context, query *plan.Query, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	options = textOptions(options)
+	jsonPlan := JsonPlan{Steps: make([]JsonStep, 0, len(query.Steps))}
+	for index, rootNodeID := range query.Steps {
+		root, err := buildJsonPlanNode(ctx, query.Nodes[rootNodeID], query.Nodes, options)
+		if err != nil {
+			return err
+		}
+		jsonPlan.Steps = append(jsonPlan.Steps, JsonStep{Step: index, Plan: root})
+	}
+	// the expressions are kept as they are, such as "a > 0"
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(jsonPlan); err != nil {
+		return err
+	}
+	buffer.PushDocument(strings.TrimSuffix(buf.String(), "\n"))
+	return nil
+}
+
+func buildJsonPlanNode(ctx context.Context, node *plan.Node, nodes []*plan.Node, options *ExplainOptions) (*JsonPlanNode, error) {
+	newNode, err := ConvertNode(ctx, node, options)
+	if err != nil {
+		return nil, err
+	}
+	jsonNode := &JsonPlanNode{
+		NodeId:      newNode.NodeId,
+		Name:        newNode.Name,
+		Title:       newNode.Title,
+		Labels:      newNode.Labels,
+		Stats:       newNode.Stats,
+		SourceSteps: node.SourceStep,
+	}
+	if options.Verbose && len(node.ProjectList) > 0 {
+		if jsonNode.Output, err = GetExprsLabelValue(ctx, node.ProjectList, options); err != nil {
+			return nil, err
+		}
+	}
+	if options.Analyze && node.AnalyzeInfo != nil {
+		jsonNode.Statistics = &newNode.Statistics
+		jsonNode.TotalStats = &newNode.TotalStats
+	}
+	for _, childNodeID := range node.Children {
+		index, err := serachNodeIndex(ctx, childNodeID, nodes)
+		if err != nil {
+			return nil, err
+		}
+		child, err := buildJsonPlanNode(ctx, nodes[index], nodes, options)
+		if err != nil {
+			return nil, err
+		}
+		jsonNode.Children = append(jsonNode.Children, child)
+	}
+	return jsonNode, nil
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\l`)
+
+// explainDot describes the query as a graphviz digraph. The edges go from
+// the children to their parents, they are labeled with the output rows of
+// the children if the query is analyzed.
+func explainDot(ctx context.Context, query *plan.Query, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	options = textOptions(options)
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	buf.WriteString("digraph plan {\n")
+	buf.WriteString("  node [shape=box, fontname=\"monospace\"];\n")
+	buf.WriteString("  rankdir=BT;\n")
+	isForest := len(query.Steps) > 1
+	for index, rootNodeID := range query.Steps {
+		indent := "  "
+		if isForest {
+			fmt.Fprintf(buf, "  subgraph cluster_%d {\n", index)
+			fmt.Fprintf(buf, "    label=\"Plan %d\";\n", index)
+			indent = "    "
+		}
+		if err := explainDotNode(ctx, query.Nodes[rootNodeID], query.Nodes, buf, indent, options); err != nil {
+			return err
+		}
+		if isForest {
+			buf.WriteString("  }\n")
+		}
+	}
+	// the sink scans read the results of the other steps
+	for _, node := range query.Nodes {
+		for _, step := range node.SourceStep {
+			if step >= 0 && int(step) < len(query.Steps) {
+				rootNodeID := query.Nodes[query.Steps[step]].NodeId
+				fmt.Fprintf(buf, "  n%d -> n%d [style=dashed];\n", rootNodeID, node.NodeId)
+			}
+		}
+	}
+	buf.WriteString("}")
+	buffer.PushDocument(buf.String())
+	return nil
+}
+
+func explainDotNode(ctx context.Context, node *plan.Node, nodes []*plan.Node, buf *bytes.Buffer, indent string, options *ExplainOptions) error {
+	newNode, err := ConvertNode(ctx, node, options)
+	if err != nil {
+		return err
+	}
+	lines := make([]string, 0, 8)
+	lines = append(lines, newNode.Name)
+	if newNode.Title != "" {
+		lines = append(lines, newNode.Title)
+	}
+	stats := newNode.Stats
+	lines = append(lines, fmt.Sprintf("cost=%.2f outcnt=%.2f blocknum=%d rowsize=%.2f hashmapsize=%.2f",
+		stats.Cost, stats.Outcnt, stats.BlockNum, stats.Rowsize, stats.HashmapSize))
+	for _, label := range newNode.Labels {
+		if values, ok := label.Value.([]string); ok && len(values) == 0 {
+			continue
+		}
+		lines = append(lines, label.Name+": "+dotLabelValue(label.Value))
+	}
+	if options.Verbose && len(node.ProjectList) > 0 {
+		output, err := GetExprsLabelValue(ctx, node.ProjectList, options)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, "Output: "+strings.Join(output, ", "))
+	}
+	if options.Analyze && node.AnalyzeInfo != nil {
+		for _, values := range [][]StatisticValue{
+			newNode.Statistics.Time,
+			newNode.Statistics.Throughput,
+			newNode.Statistics.Memory,
+			newNode.Statistics.IO,
+			newNode.Statistics.Network,
+		} {
+			for _, v := range values {
+				lines = append(lines, fmt.Sprintf("%s: %d %s", v.Name, v.Value, v.Unit))
+			}
+		}
+	}
+	fmt.Fprintf(buf, "%sn%d [label=\"%s\\l\"];\n", indent, node.NodeId, dotEscaper.Replace(strings.Join(lines, "\n")))
+
+	for _, childNodeID := range node.Children {
+		index, err := serachNodeIndex(ctx, childNodeID, nodes)
+		if err != nil {
+			return err
+		}
+		child := nodes[index]
+		if err = explainDotNode(ctx, child, nodes, buf, indent, options); err != nil {
+			return err
+		}
+		if options.Analyze && child.AnalyzeInfo != nil {
+			fmt.Fprintf(buf, "%sn%d -> n%d [label=\"%d rows\"];\n", indent, child.NodeId, node.NodeId, child.AnalyzeInfo.OutputRows)
+		} else {
+			fmt.Fprintf(buf, "%sn%d -> n%d;\n", indent, child.NodeId, node.NodeId)
+		}
+	}
+	return nil
+}
+
+func dotLabelValue(value any) string {
+	switch v := value.(type) {
+	case []string:
+		return strings.Join(v, ", ")
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -25,7 +25,6 @@ import (
 
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 
-	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 )
 
@@ -204,10 +203,6 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(ctx context.Context, options *Ex
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -504,10 +499,6 @@ func (ndesc *NodeDescribeImpl) GetPartitionPruneInfo(ctx context.Context, option
 		} else {
 			buf.WriteString("all partitions")
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -527,10 +518,6 @@ func (ndesc *NodeDescribeImpl) GetFilterConditionInfo(ctx context.Context, optio
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -550,10 +537,6 @@ func (ndesc *NodeDescribeImpl) GetBlockFilterConditionInfo(ctx context.Context,
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -579,10 +562,6 @@ func (ndesc *NodeDescribeImpl) GetRuntimeFilteProbeInfo(ctx context.Context, opt
 				buf.WriteString(" Match Prefix")
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -605,10 +584,6 @@ func (ndesc *NodeDescribeImpl) GetRuntimeFilterBuildInfo(ctx context.Context, op
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -629,10 +604,6 @@ func (ndesc *NodeDescribeImpl) GetSendMessageInfo(ctx context.Context, options *
 			first = false
 			describeMessage(v, buf)
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -653,10 +624,6 @@ func (ndesc *NodeDescribeImpl) GetRecvMessageInfo(ctx context.Context, options *
 			first = false
 			describeMessage(v, buf)
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -665,8 +632,14 @@ func (ndesc *NodeDescribeImpl) GetGroupByInfo(ctx context.Context, options *Expl
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Group Key: ")
 	if options.Format == EXPLAIN_FORMAT_TEXT {
+		groupBy := ndesc.Node.GetGroupBy()
+		groupingIds := ndesc.Node.GetGroupingIds()
+		if len(groupingIds) > 0 {
+			// the last one is the grouping id
+			groupBy = groupBy[:len(groupBy)-1]
+		}
 		first := true
-		for _, v := range ndesc.Node.GetGroupBy() {
+		for _, v := range groupBy {
 			if !first {
 				buf.WriteString(", ")
 			}
@@ -676,10 +649,30 @@ func (ndesc *NodeDescribeImpl) GetGroupByInfo(ctx context.Context, options *Expl
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
+		if len(groupingIds) > 0 {
+			buf.WriteString(" Grouping Sets: ")
+			for i, gid := range groupingIds {
+				if i > 0 {
+					buf.WriteString(", ")
+				}
+				buf.WriteString("(")
+				first = true
+				for j, v := range groupBy {
+					if gid&(1<<j) != 0 {
+						continue
+					}
+					if !first {
+						buf.WriteString(", ")
+					}
+					first = false
+					err := describeExpr(ctx, v, options, buf)
+					if err != nil {
+						return "", err
+					}
+				}
+				buf.WriteString(")")
+			}
+		}
 	}
 
 	if ndesc.Node.Stats.HashmapStats != nil && ndesc.Node.Stats.HashmapStats.Shuffle {
@@ -725,10 +718,6 @@ func (ndesc *NodeDescribeImpl) GetAggregationInfo(ctx context.Context, options *
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -753,10 +742,6 @@ func (ndesc *NodeDescribeImpl) GetSampleFuncInfo(ctx context.Context, options *E
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -776,10 +761,6 @@ func (ndesc *NodeDescribeImpl) GetFillColsInfo(ctx context.Context, options *Exp
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -816,10 +797,6 @@ func (ndesc *NodeDescribeImpl) GetFillModeInfo(ctx context.Context, options *Exp
 				}
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -839,10 +816,6 @@ func (ndesc *NodeDescribeImpl) GetWindowSpectListInfo(ctx context.Context, optio
 				return "", err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -856,10 +829,6 @@ func (ndesc *NodeDescribeImpl) GetOrderByInfo(ctx context.Context, options *Expl
 		if err != nil {
 			return "", err
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return "", moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return "", moerr.NewNYI(ctx, "explain format dot")
 	}
 	return buf.String(), nil
 }
@@ -1049,10 +1018,6 @@ func (e *ExprListDescribeImpl) GetDescription(ctx context.Context, options *Expl
 				//return result, err
 			}
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return moerr.NewNYI(ctx, "explain format dot")
 	}
 	return nil
 }
@@ -1086,8 +1051,6 @@ func (o *OrderByDescribeImpl) GetDescription(ctx context.Context, options *Expla
 			orderbyFlag := plan.OrderBySpec_OrderByFlag_name[flagKey]
 			buf.WriteString(" " + orderbyFlag)
 		}
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return moerr.NewNYI(ctx, "explain format dot")
 	}
 	return nil
 }
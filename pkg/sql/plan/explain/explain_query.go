@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -39,8 +40,18 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	switch options.Format {
+	case EXPLAIN_FORMAT_JSON:
+		return explainJson(ctx, e.QueryPlan, buffer, options)
+	case EXPLAIN_FORMAT_DOT:
+		return explainDot(ctx, e.QueryPlan, buffer, options)
+	}
 	nodes := e.QueryPlan.Nodes
 
+	if len(e.QueryPlan.Hints) > 0 {
+		buffer.PushPlanTitle("Optimizer Hints: " + strings.Join(e.QueryPlan.Hints, " "))
+	}
+
 	isForest := false
 	if len(e.QueryPlan.Steps) > 1 {
 		isForest = true
@@ -264,10 +275,6 @@ func explainStep(ctx context.Context, step *plan.Node, nodes []*plan.Node, setti
 		for _, line := range extraInfo {
 			settings.buffer.PushNewLine(line, false, settings.level)
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return moerr.NewNYI(ctx, "explain format dot")
 	}
 	return nil
 }
@@ -16,10 +16,14 @@ package explain
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	planpb "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -57,6 +61,8 @@ func TestBasicSqlExplain(t *testing.T) {
 		"explain verbose SELECT N_NAME, N_REGIONKEY a FROM NATION WHERE N_NATIONKEY > 0 AND N_NATIONKEY < 10 ORDER BY N_NAME, N_REGIONKEY DESC",
 		"explain verbose SELECT count(*) FROM NATION group by N_NAME",
 		"explain verbose SELECT N_NAME, MAX(N_REGIONKEY) FROM NATION GROUP BY N_NAME HAVING MAX(N_REGIONKEY) > 10",
+		"explain verbose SELECT N_REGIONKEY, N_NAME, COUNT(*), GROUPING(N_REGIONKEY, N_NAME) FROM NATION GROUP BY ROLLUP(N_REGIONKEY, N_NAME)",
+		"explain verbose SELECT N_NAME, LAG(N_NAME, 2) IGNORE NULLS OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY), NTH_VALUE(N_NAME, 2) FROM LAST OVER (ORDER BY N_NATIONKEY) FROM NATION",
 		"explain SELECT N_NAME, N_REGIONKEY FROM NATION WHERE N_REGIONKEY > 0 AND N_NAME LIKE '%AA' ORDER BY N_NAME DESC, N_REGIONKEY limit 10",
 		"explain SELECT N_NAME, N_REGIONKEY FROM NATION WHERE N_REGIONKEY > 0 AND N_NAME LIKE '%AA' ORDER BY N_NAME DESC, N_REGIONKEY LIMIT 10 offset 20",
 		"explain verbose select case when p_type like 'PROMO%' then l_extendedprice * (1 - l_discount) when p_type like 'PRX%' then l_extendedprice * (2 - l_discount) else 0 end from lineitem,part where l_shipdate < date '1996-04-01' + interval '1' month",
@@ -128,6 +134,8 @@ func TestJoinQuery(t *testing.T) {
 		"explain SELECT a.* FROM NATION a join REGION b on a.N_REGIONKEY = b.R_REGIONKEY WHERE a.N_REGIONKEY > 0",
 		"explain verbose SELECT * FROM NATION a join REGION b on a.N_REGIONKEY = b.R_REGIONKEY WHERE a.N_REGIONKEY > 0",
 		"explain SELECT * FROM NATION a join REGION b on a.N_REGIONKEY = b.R_REGIONKEY WHERE a.N_REGIONKEY > 0",
+		"explain SELECT /*+ LEADING(l, c) HASH_JOIN(o) NO_RUNTIME_FILTER DOP(4) */ l.L_ORDERKEY FROM CUSTOMER c, ORDERS o, LINEITEM l WHERE c.C_CUSTKEY = o.O_CUSTKEY and l.L_ORDERKEY = o.O_ORDERKEY", //optimizer hints
+		"explain verbose SELECT /*+ BROADCAST(b) NO_PUSHDOWN */ * FROM NATION a join REGION b on a.N_REGIONKEY = b.R_REGIONKEY WHERE a.N_REGIONKEY > 0",                                                //optimizer hints
 	}
 	mockOptimizer := plan.NewMockOptimizer(false)
 	runTestShouldPass(mockOptimizer, t, sqls)
@@ -311,6 +319,66 @@ func TestMultiTableDeleteSQL(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestExplainFormat(t *testing.T) {
+	sqls := []string{
+		"explain format = json SELECT N_NAME, N_REGIONKEY a FROM NATION WHERE N_REGIONKEY > 0 ORDER BY a DESC LIMIT 10",
+		"explain (verbose true, format json) SELECT NATION.N_NAME, REGION.R_NAME FROM NATION join REGION on NATION.N_REGIONKEY = REGION.R_REGIONKEY WHERE NATION.N_REGIONKEY > 10",
+		"explain format = dot SELECT N_NAME, MAX(N_REGIONKEY) FROM NATION GROUP BY N_NAME HAVING MAX(N_REGIONKEY) > 10",
+		"explain (verbose true, format dot) SELECT l.L_ORDERKEY a FROM CUSTOMER c, ORDERS o, LINEITEM l WHERE c.C_CUSTKEY = o.O_CUSTKEY and l.L_ORDERKEY = o.O_ORDERKEY",
+		"explain format = dot with recursive c as (select R_REGIONKEY from REGION union all select R_REGIONKEY + 1 from c where R_REGIONKEY < 5) select * from c",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
+func TestExplainFormatAnalyze(t *testing.T) {
+	mock := plan.NewMockOptimizer(false)
+	sql := "SELECT N_NAME FROM NATION WHERE N_REGIONKEY > 0"
+	stmts, err := mysql.Parse(mock.CurrentContext().GetContext(), sql, 1)
+	require.NoError(t, err)
+	logicPlan, err := plan.BuildPlan(mock.CurrentContext(), stmts[0], false)
+	require.NoError(t, err)
+	query := logicPlan.GetQuery()
+	for _, node := range query.Nodes {
+		node.AnalyzeInfo = &planpb.AnalyzeInfo{InputRows: 25, OutputRows: 5, TimeConsumed: 1000}
+		if node.NodeType == planpb.Node_TABLE_SCAN {
+			node.RuntimeFilterProbeList = []*planpb.RuntimeFilterSpec{{Tag: 1, Expr: node.FilterList[0]}}
+		}
+	}
+
+	ctx := context.TODO()
+	options := &ExplainOptions{Analyze: true, Verbose: true, Format: EXPLAIN_FORMAT_JSON}
+	buffer := NewExplainDataBuffer()
+	require.NoError(t, NewExplainQueryImpl(query).ExplainPlan(ctx, buffer, options))
+	require.Len(t, buffer.Lines, 1)
+	var jsonPlan JsonPlan
+	require.NoError(t, json.Unmarshal([]byte(buffer.Lines[0]), &jsonPlan))
+	require.Len(t, jsonPlan.Steps, 1)
+	root := jsonPlan.Steps[0].Plan
+	require.NotNil(t, root.Statistics)
+	require.NotEmpty(t, root.Output)
+	scan := root
+	for len(scan.Children) > 0 {
+		scan = scan.Children[0]
+	}
+	require.Equal(t, TableScan, scan.Name)
+	var names []string
+	for _, label := range scan.Labels {
+		names = append(names, label.Name)
+	}
+	require.Contains(t, names, Label_Filter_Conditions)
+	require.Contains(t, names, Label_Runtime_Filter_Probe)
+
+	options.Format = EXPLAIN_FORMAT_DOT
+	buffer = NewExplainDataBuffer()
+	require.NoError(t, NewExplainQueryImpl(query).ExplainPlan(ctx, buffer, options))
+	require.Len(t, buffer.Lines, 1)
+	require.True(t, strings.HasPrefix(buffer.Lines[0], "digraph plan {"))
+	require.Contains(t, buffer.Lines[0], "[label=\"5 rows\"]")
+	require.Contains(t, buffer.Lines[0], Label_Runtime_Filter_Probe)
+	require.Contains(t, buffer.Lines[0], OutputRows+": 5 count")
+}
+
 func runTestShouldPass(opt plan.Optimizer, t *testing.T, sqls []string) {
 	for _, sql := range sqls {
 		err := runOneStmt(opt, t, sql)
@@ -354,6 +422,8 @@ func runOneStmt(opt plan.Optimizer, t *testing.T, sql string) error {
 					es.Format = EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
 					es.Format = EXPLAIN_FORMAT_JSON
+				} else if strings.EqualFold(v.Value, "DOT") {
+					es.Format = EXPLAIN_FORMAT_DOT
 				} else {
 					return moerr.NewInvalidInput(ctx, "explain format %v", v.Value)
 				}
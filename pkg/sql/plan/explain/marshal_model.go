@@ -258,6 +258,8 @@ const (
 	Label_Agg_Functions             = "Aggregate functions"
 	Label_Filter_Conditions         = "Filter conditions"
 	Label_Block_Filter_Conditions   = "Block Filter conditions"
+	Label_Runtime_Filter_Build      = "Runtime filter build"
+	Label_Runtime_Filter_Probe      = "Runtime filter probe"
 	Label_Join_Type                 = "Join type"
 	Label_Join_Conditions           = "Join conditions"
 	Label_Left_NodeId               = "Left node id"
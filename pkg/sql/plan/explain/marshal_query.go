@@ -649,6 +649,30 @@ func (m MarshalNodeImpl) GetNodeLabels(ctx context.Context, options *ExplainOpti
 		})
 	}
 
+	// runtime filters are not used by the shuffle join
+	if m.node.NodeType != plan.Node_JOIN || m.node.Stats == nil || m.node.Stats.HashmapStats == nil || !m.node.Stats.HashmapStats.Shuffle {
+		if len(m.node.RuntimeFilterBuildList) > 0 {
+			value, err := GetRuntimeFilterLabelValue(ctx, m.node.RuntimeFilterBuildList, options)
+			if err != nil {
+				return nil, err
+			}
+			labels = append(labels, Label{
+				Name:  Label_Runtime_Filter_Build, // "Runtime filter build",
+				Value: value,
+			})
+		}
+		if len(m.node.RuntimeFilterProbeList) > 0 {
+			value, err := GetRuntimeFilterLabelValue(ctx, m.node.RuntimeFilterProbeList, options)
+			if err != nil {
+				return nil, err
+			}
+			labels = append(labels, Label{
+				Name:  Label_Runtime_Filter_Probe, // "Runtime filter probe",
+				Value: value,
+			})
+		}
+	}
+
 	// 3. handle `Limit` and `Offset` label information for all nodes
 	if m.node.Limit != nil {
 		buf := bytes.NewBuffer(make([]byte, 0, 80))
@@ -847,6 +871,25 @@ func GetExprsLabelValue(ctx context.Context, exprList []*plan.Expr, options *Exp
 	return result, nil
 }
 
+func GetRuntimeFilterLabelValue(ctx context.Context, filterList []*plan.RuntimeFilterSpec, options *ExplainOptions) ([]string, error) {
+	result := make([]string, 0, len(filterList))
+	buf := bytes.NewBuffer(make([]byte, 0, 200))
+	for _, v := range filterList {
+		buf.Reset()
+		if v.Expr != nil {
+			err := describeExpr(ctx, v.Expr, options, buf)
+			if err != nil {
+				return result, err
+			}
+		}
+		if v.MatchPrefix {
+			buf.WriteString(" Match Prefix")
+		}
+		result = append(result, buf.String())
+	}
+	return result, nil
+}
+
 func GetOrderByLabelValue(ctx context.Context, orderbyList []*plan.OrderBySpec, options *ExplainOptions) ([]string, error) {
 	if orderbyList == nil {
 		return make([]string, 0), nil
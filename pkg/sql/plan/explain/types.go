@@ -120,6 +120,17 @@ func (buf *ExplainDataBuffer) PushPlanTitle(title string) {
 	buf.End++
 }
 
+// PushDocument pushes the whole output of the json and dot formats as one
+// line, so it is returned as a single row.
+func (buf *ExplainDataBuffer) PushDocument(doc string) {
+	if buf.Start == -1 {
+		buf.Start++
+	}
+	buf.CurrentLine++
+	buf.Lines = append(buf.Lines, doc)
+	buf.End++
+}
+
 func (buf *ExplainDataBuffer) ToString() string {
 	strBuffer := bytes.NewBufferString("")
 	rs := buf.Lines
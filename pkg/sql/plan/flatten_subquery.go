@@ -458,6 +458,9 @@ func (builder *QueryBuilder) pullupCorrelatedPredicates(nodeID int32, ctx *BindC
 
 	switch node.NodeType {
 	case plan.Node_AGG:
+		if len(node.GroupingIds) > 0 && len(preds) > 0 {
+			return 0, nil, moerr.NewNYI(builder.GetContext(), "correlated columns in subquery with grouping sets")
+		}
 		groupTag := node.BindingTags[0]
 		for _, pred := range preds {
 			builder.pullupThroughAgg(ctx, node, groupTag, pred)
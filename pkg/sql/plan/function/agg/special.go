@@ -36,6 +36,62 @@ func RegisterMedian(id int64) {
 	aggexec.RegisterMedian(id)
 }
 
+func RegisterPercentileCont(id int64) {
+	aggexec.RegisterPercentileCont(id)
+}
+
+func RegisterPercentileDisc(id int64) {
+	aggexec.RegisterPercentileDisc(id)
+}
+
+func RegisterApproxPercentile(id int64) {
+	aggexec.RegisterApproxPercentile(id)
+}
+
+func RegisterJsonArrayAgg(id int64) {
+	aggexec.RegisterJsonArrayAgg(id)
+}
+
+func RegisterJsonObjectAgg(id int64) {
+	aggexec.RegisterJsonObjectAgg(id)
+}
+
 func RegisterClusterCenters(id int64) {
 	aggexec.RegisterClusterCenters(id)
 }
+
+func RegisterVarSamp(id int64) {
+	aggexec.RegisterVarSampAgg(id)
+}
+
+func RegisterStdDevSamp(id int64) {
+	aggexec.RegisterStdDevSampAgg(id)
+}
+
+func RegisterCovarPop(id int64) {
+	aggexec.RegisterCovarPopAgg(id)
+}
+
+func RegisterCovarSamp(id int64) {
+	aggexec.RegisterCovarSampAgg(id)
+}
+
+func RegisterCorr(id int64) {
+	aggexec.RegisterCorrAgg(id)
+}
+
+func RegisterRegrSlope(id int64) {
+	aggexec.RegisterRegrSlopeAgg(id)
+}
+
+func RegisterRegrIntercept(id int64) {
+	aggexec.RegisterRegrInterceptAgg(id)
+}
+
+func RegisterRegrR2(id int64) {
+	aggexec.RegisterRegrR2Agg(id)
+}
+
+func RegisterRegrCount(id int64) {
+	aggexec.RegisterRegrCountAgg(id)
+}
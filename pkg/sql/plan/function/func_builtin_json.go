@@ -15,10 +15,17 @@
 package function
 
 import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggexec"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -392,3 +399,614 @@ func (op *opBuiltInJsonExtract) jsonExtractFloat64(parameters []*vector.Vector,
 	}
 	return nil
 }
+
+// jsonArgsCheckFn returns the type check of the json functions whose arguments
+// are json documents or strings, an argument of other type is cast to varchar.
+func jsonArgsCheckFn(minArgs, maxArgs int) func(overloads []overload, inputs []types.Type) checkResult {
+	return func(overloads []overload, inputs []types.Type) checkResult {
+		if len(inputs) < minArgs || (maxArgs > 0 && len(inputs) > maxArgs) {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+		return jsonCastArgs(inputs, func(int) bool { return false })
+	}
+}
+
+// jsonValuesCheckFn returns the type check of the json functions that take the
+// values to build a json document, the values are at the positions isValue
+// returns true, and other arguments are the documents, paths or keys.
+func jsonValuesCheckFn(check func(n int) bool, isValue func(i int) bool) func(overloads []overload, inputs []types.Type) checkResult {
+	return func(overloads []overload, inputs []types.Type) checkResult {
+		if !check(len(inputs)) {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+		return jsonCastArgs(inputs, isValue)
+	}
+}
+
+var (
+	// JSON_OBJECT([key, val[, key, val] ...])
+	jsonObjectCheckFn = jsonValuesCheckFn(
+		func(n int) bool { return n%2 == 0 },
+		func(i int) bool { return i%2 == 1 })
+	// JSON_ARRAY([val[, val] ...])
+	jsonArrayCheckFn = jsonValuesCheckFn(
+		func(n int) bool { return true },
+		func(i int) bool { return true })
+	// JSON_SET, JSON_INSERT and JSON_REPLACE(json_doc, path, val[, path, val] ...)
+	jsonModifyCheckFn = jsonValuesCheckFn(
+		func(n int) bool { return n >= 3 && n%2 == 1 },
+		func(i int) bool { return i > 0 && i%2 == 0 })
+)
+
+func jsonCastArgs(inputs []types.Type, isValue func(i int) bool) checkResult {
+	ts := make([]types.Type, len(inputs))
+	allMatch := true
+	for i, input := range inputs {
+		ts[i] = input
+		if input.Oid == types.T_json || input.Oid.IsMySQLString() {
+			continue
+		}
+		if isValue(i) && input.Oid != types.T_any && slices.Contains(aggexec.JsonAggSupportedTypes, input.Oid) {
+			continue
+		}
+		if canCast, _ := fixedImplicitTypeCast(input, types.T_varchar); !canCast {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+		ts[i] = types.T_varchar.ToType()
+		allMatch = false
+	}
+	if allMatch {
+		return newCheckResultWithSuccess(0)
+	}
+	return newCheckResultWithCast(0, ts)
+}
+
+// jsonDocAt returns the json document of the row, a string is parsed as a json
+// text, and true if the row is null.
+func jsonDocAt(vec *vector.Vector, w vector.FunctionParameterWrapper[types.Varlena], i uint64) (bytejson.ByteJson, bool, error) {
+	v, null := w.GetStrValue(i)
+	if null {
+		return bytejson.ByteJson{}, true, nil
+	}
+	if vec.GetType().Oid == types.T_json {
+		return types.DecodeJson(v), false, nil
+	}
+	bj, err := types.ParseSliceToByteJson(v)
+	return bj, false, err
+}
+
+// opBuiltInJson is the operator of the json functions that take paths, the
+// paths of the constant arguments are parsed once.
+type opBuiltInJson struct {
+	modifyType bytejson.ModifyType
+	regexp     *opBuiltInRegexp
+
+	constPathStrs map[int]string
+	constPaths    map[int]*bytejson.Path
+}
+
+func newOpBuiltInJson() *opBuiltInJson {
+	return &opBuiltInJson{
+		constPathStrs: make(map[int]string),
+		constPaths:    make(map[int]*bytejson.Path),
+	}
+}
+
+func newOpBuiltInJsonModify(tp bytejson.ModifyType) *opBuiltInJson {
+	op := newOpBuiltInJson()
+	op.modifyType = tp
+	return op
+}
+
+// pathAt returns the path of the row at the argument idx, and true if the row
+// is null.
+func (op *opBuiltInJson) pathAt(params []*vector.Vector, w vector.FunctionParameterWrapper[types.Varlena], idx int, i uint64) (*bytejson.Path, bool, error) {
+	v, null := w.GetStrValue(i)
+	if null {
+		return nil, true, nil
+	}
+	isConst := params[idx].IsConst()
+	if isConst && op.constPathStrs[idx] == string(v) {
+		if p, ok := op.constPaths[idx]; ok {
+			return p, false, nil
+		}
+	}
+	p, err := types.ParseStringToPath(string(v))
+	if err != nil {
+		return nil, false, err
+	}
+	if isConst {
+		op.constPathStrs[idx] = string(v)
+		op.constPaths[idx] = &p
+	}
+	return &p, false, nil
+}
+
+// pathsAt returns the paths of the row at the arguments from idx to the end,
+// and true if any of them is null.
+func (op *opBuiltInJson) pathsAt(params []*vector.Vector, ws []vector.FunctionParameterWrapper[types.Varlena], idx int, i uint64, paths []*bytejson.Path) (bool, error) {
+	for j := range paths {
+		p, null, err := op.pathAt(params, ws[idx+j], idx+j, i)
+		if err != nil || null {
+			return null, err
+		}
+		paths[j] = p
+	}
+	return false, nil
+}
+
+func generateStrParameters(params []*vector.Vector) []vector.FunctionParameterWrapper[types.Varlena] {
+	ws := make([]vector.FunctionParameterWrapper[types.Varlena], len(params))
+	for i, param := range params {
+		if param.GetType().IsVarlen() {
+			ws[i] = vector.GenerateFunctionStrParameter(param)
+		}
+	}
+	return ws
+}
+
+// JSON_OBJECT([key, val[, key, val] ...])
+func jsonObject(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	ws := generateStrParameters(params)
+	n := len(params) / 2
+	for i := uint64(0); i < uint64(length); i++ {
+		keys, vals := make([]string, n), make([]bytejson.ByteJson, n)
+		for j := 0; j < n; j++ {
+			key, null := ws[2*j].GetStrValue(i)
+			if null {
+				return moerr.NewInvalidInput(proc.Ctx, "JSON documents may not contain NULL member names")
+			}
+			val, err := aggexec.JsonValueOf(params[2*j+1], int(i))
+			if err != nil {
+				return err
+			}
+			keys[j], vals[j] = string(key), val
+		}
+		bj, err := bytejson.BuildObject(keys, vals)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendByteJson(bj, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_ARRAY([val[, val] ...])
+func jsonArray(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		elems := make([]bytejson.ByteJson, len(params))
+		for j, param := range params {
+			val, err := aggexec.JsonValueOf(param, int(i))
+			if err != nil {
+				return err
+			}
+			elems[j] = val
+		}
+		if err := rs.AppendByteJson(bytejson.BuildArray(elems), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_SET, JSON_INSERT and JSON_REPLACE(json_doc, path, val[, path, val] ...)
+func (op *opBuiltInJson) jsonModify(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	ws := generateStrParameters(params)
+	n := len(params) / 2
+	paths, vals := make([]*bytejson.Path, n), make([]bytejson.ByteJson, n)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, null, err := jsonDocAt(params[0], ws[0], i)
+		if err != nil {
+			return err
+		}
+		for j := 0; j < n && !null; j++ {
+			if paths[j], null, err = op.pathAt(params, ws[2*j+1], 2*j+1, i); err != nil {
+				return err
+			}
+			if vals[j], err = aggexec.JsonValueOf(params[2*j+2], int(i)); err != nil {
+				return err
+			}
+		}
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		out, err := doc.Modify(paths, vals, op.modifyType)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendByteJson(out, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_REMOVE(json_doc, path[, path] ...)
+func (op *opBuiltInJson) jsonRemove(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	ws := generateStrParameters(params)
+	paths := make([]*bytejson.Path, len(params)-1)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, null, err := jsonDocAt(params[0], ws[0], i)
+		if err != nil {
+			return err
+		}
+		if !null {
+			if null, err = op.pathsAt(params, ws, 1, i, paths); err != nil {
+				return err
+			}
+		}
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		out, err := doc.Remove(paths)
+		if err != nil {
+			return err
+		}
+		if err = rs.AppendByteJson(out, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_MERGE_PATCH and JSON_MERGE_PRESERVE(json_doc, json_doc[, json_doc] ...)
+func jsonMerge(merge func([]bytejson.ByteJson) (bytejson.ByteJson, error)) executeLogicOfOverload {
+	return func(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		ws := generateStrParameters(params)
+		docs := make([]bytejson.ByteJson, len(params))
+		for i := uint64(0); i < uint64(length); i++ {
+			var null bool
+			var err error
+			for j := 0; j < len(params) && !null; j++ {
+				if docs[j], null, err = jsonDocAt(params[j], ws[j], i); err != nil {
+					return err
+				}
+			}
+			if null {
+				if err = rs.AppendBytes(nil, true); err != nil {
+					return err
+				}
+				continue
+			}
+
+			out, err := merge(docs)
+			if err != nil {
+				return err
+			}
+			if err = rs.AppendByteJson(out, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// JSON_CONTAINS(target, candidate[, path])
+func (op *opBuiltInJson) jsonContains(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[bool](result)
+	ws := generateStrParameters(params)
+	for i := uint64(0); i < uint64(length); i++ {
+		target, null, err := jsonDocAt(params[0], ws[0], i)
+		if err != nil {
+			return err
+		}
+		var candidate bytejson.ByteJson
+		if !null {
+			if candidate, null, err = jsonDocAt(params[1], ws[1], i); err != nil {
+				return err
+			}
+		}
+		if !null && len(params) == 3 {
+			var path *bytejson.Path
+			if path, null, err = op.pathAt(params, ws[2], 2, i); err != nil {
+				return err
+			}
+			if !null {
+				var found bool
+				if target, found, err = target.Lookup(path); err != nil {
+					return err
+				}
+				null = !found
+			}
+		}
+		if null {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err = rs.Append(target.Contains(candidate), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// oneOrAllAt returns true if the one_or_all argument of the row is 'one', and
+// true if the row is null.
+func oneOrAllAt(ctx context.Context, fn string, w vector.FunctionParameterWrapper[types.Varlena], i uint64) (bool, bool, error) {
+	v, null := w.GetStrValue(i)
+	if null {
+		return false, true, nil
+	}
+	switch strings.ToLower(string(v)) {
+	case "one":
+		return true, false, nil
+	case "all":
+		return false, false, nil
+	}
+	return false, false, moerr.NewInvalidInput(ctx, "the oneOrAll argument to %s may take these values: 'one' or 'all'", fn)
+}
+
+// JSON_CONTAINS_PATH(json_doc, one_or_all, path[, path] ...)
+func (op *opBuiltInJson) jsonContainsPath(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[bool](result)
+	ws := generateStrParameters(params)
+	paths := make([]*bytejson.Path, len(params)-2)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, null, err := jsonDocAt(params[0], ws[0], i)
+		if err != nil {
+			return err
+		}
+		var one bool
+		if !null {
+			if one, null, err = oneOrAllAt(proc.Ctx, "json_contains_path", ws[1], i); err != nil {
+				return err
+			}
+		}
+		if !null {
+			if null, err = op.pathsAt(params, ws, 2, i, paths); err != nil {
+				return err
+			}
+		}
+		if null {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// one requires any of the paths exists, and all requires each of them.
+		ret := !one
+		for _, path := range paths {
+			if doc.Exists(path) == one {
+				ret = one
+				break
+			}
+		}
+		if err = rs.Append(ret, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// valueAtPath returns the value of the row at the optional path argument, and
+// true if the row is null or there is no value at the path.
+func (op *opBuiltInJson) valueAtPath(params []*vector.Vector, ws []vector.FunctionParameterWrapper[types.Varlena], i uint64) (bytejson.ByteJson, bool, error) {
+	doc, null, err := jsonDocAt(params[0], ws[0], i)
+	if err != nil || null || len(params) == 1 {
+		return doc, null, err
+	}
+	path, null, err := op.pathAt(params, ws[1], 1, i)
+	if err != nil || null {
+		return doc, null, err
+	}
+	v, found, err := doc.Lookup(path)
+	return v, !found, err
+}
+
+// JSON_KEYS(json_doc[, path])
+func (op *opBuiltInJson) jsonKeys(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	ws := generateStrParameters(params)
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null, err := op.valueAtPath(params, ws, i)
+		if err != nil {
+			return err
+		}
+		var keys bytejson.ByteJson
+		if !null {
+			var ok bool
+			keys, ok = v.Keys()
+			null = !ok
+		}
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.AppendByteJson(keys, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_LENGTH(json_doc[, path])
+func (op *opBuiltInJson) jsonLength(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[int64](result)
+	ws := generateStrParameters(params)
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null, err := op.valueAtPath(params, ws, i)
+		if err != nil {
+			return err
+		}
+		if null {
+			if err = rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.Append(int64(v.Length()), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_TYPE(json_val)
+func jsonType(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	w := vector.GenerateFunctionStrParameter(params[0])
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null, err := jsonDocAt(params[0], w, i)
+		if err != nil {
+			return err
+		}
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		tp := v.TYPE()
+		if v.Type == bytejson.TpCodeLiteral && v.Data[0] != bytejson.LiteralNull {
+			tp = "BOOLEAN"
+		}
+		if err = rs.AppendBytes([]byte(tp), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// JSON_VALID(val)
+func jsonValid(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[bool](result)
+	w := vector.GenerateFunctionStrParameter(params[0])
+	isJson := params[0].GetType().Oid == types.T_json
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := w.GetStrValue(i)
+		if null {
+			if err := rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		valid := isJson
+		if !valid {
+			_, err := types.ParseSliceToByteJson(v)
+			valid = err == nil
+		}
+		if err := rs.Append(valid, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// likePatternToRegexp converts the LIKE pattern of JSON_SEARCH to a regular
+// expression. The escape character makes the character after it a literal one,
+// any other character but the wildcards is literal as well.
+func likePatternToRegexp(pat []byte, escape rune) string {
+	var sb strings.Builder
+	sb.WriteString("^(?s:")
+	for len(pat) > 0 {
+		r, n := utf8.DecodeRune(pat)
+		pat = pat[n:]
+		if r == escape && len(pat) > 0 {
+			r, n = utf8.DecodeRune(pat)
+			pat = pat[n:]
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			continue
+		}
+		switch r {
+		case '%':
+			sb.WriteString(".*")
+		case '_':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString(")$")
+	return sb.String()
+}
+
+// JSON_SEARCH(json_doc, one_or_all, search_str[, escape_char[, path] ...])
+func (op *opBuiltInJson) jsonSearch(params []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, selectList *FunctionSelectList) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	ws := generateStrParameters(params)
+	if op.regexp == nil {
+		op.regexp = newOpBuiltInRegexp()
+	}
+	var paths []*bytejson.Path
+	if len(params) > 4 {
+		paths = make([]*bytejson.Path, len(params)-4)
+	}
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, null, err := jsonDocAt(params[0], ws[0], i)
+		if err != nil {
+			return err
+		}
+		var one bool
+		if !null {
+			if one, null, err = oneOrAllAt(proc.Ctx, "json_search", ws[1], i); err != nil {
+				return err
+			}
+		}
+		var pat []byte
+		if !null {
+			pat, null = ws[2].GetStrValue(i)
+		}
+		escapeChar := rune(DefaultEscapeChar)
+		if !null && len(params) > 3 {
+			// a null or empty escape character is the default one.
+			if escape, escapeNull := ws[3].GetStrValue(i); !escapeNull && len(escape) > 0 {
+				if utf8.RuneCount(escape) != 1 {
+					return moerr.NewInvalidInput(proc.Ctx, "incorrect arguments to ESCAPE")
+				}
+				escapeChar, _ = utf8.DecodeRune(escape)
+			}
+			if null, err = op.pathsAt(params, ws, 4, i, paths); err != nil {
+				return err
+			}
+		}
+		if null {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+
+		reg, err := op.regexp.regMap.getRegularMatcher(likePatternToRegexp(pat, escapeChar))
+		if err != nil {
+			return err
+		}
+		out, found, err := doc.Search(paths, one, func(s []byte) (bool, error) {
+			return reg.Match(s), nil
+		})
+		if err != nil {
+			return err
+		}
+		if !found {
+			if err = rs.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.AppendByteJson(out, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
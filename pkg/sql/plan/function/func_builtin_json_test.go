@@ -0,0 +1,366 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonBinaries encodes the json texts as the binary json values of a vector,
+// an empty text is left as it is for a null.
+func jsonBinaries(t *testing.T, texts ...string) []string {
+	ret := make([]string, len(texts))
+	for i, text := range texts {
+		if text == "" {
+			continue
+		}
+		bj, err := bytejson.ParseFromString(text)
+		require.NoError(t, err)
+		data, err := bj.Marshal()
+		require.NoError(t, err)
+		ret[i] = string(data)
+	}
+	return ret
+}
+
+type jsonFnTestCase struct {
+	tcTemp
+	fn fEvalFn
+}
+
+func runJsonFnTestCases(t *testing.T, testCases []jsonFnTestCase) {
+	proc := testutil.NewProcess()
+	for _, tc := range testCases {
+		fcTC := NewFunctionTestCase(proc, tc.inputs, tc.expect, tc.fn)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
+
+func TestJsonConstruct(t *testing.T) {
+	runJsonFnTestCases(t, []jsonFnTestCase{
+		{
+			tcTemp: tcTemp{
+				info: "test json_object",
+				inputs: []FunctionTestInput{
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"id"}, []bool{false}),
+					NewFunctionTestInput(types.T_int64.ToType(), []int64{1, 2, 3}, []bool{false, false, true}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"name", "id", "name"}, []bool{false, false, false}),
+					NewFunctionTestInput(types.T_json.ToType(), jsonBinaries(t, `[1, "a"]`, `{"x": 1}`, `null`), []bool{false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `{"id": 1, "name": [1, "a"]}`, `{"id": {"x": 1}}`, `{"id": null, "name": null}`),
+					[]bool{false, false, false}),
+			},
+			fn: jsonObject,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_object with a null key",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{""}, []bool{true}),
+					NewFunctionTestInput(types.T_int64.ToType(), []int64{1}, []bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), true, []string{""}, []bool{false}),
+			},
+			fn: jsonObject,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_array",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_float64.ToType(), []float64{1.5, 0}, []bool{false, true}),
+					NewFunctionTestInput(types.T_bool.ToType(), []bool{true, false}, []bool{false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`, "x"}, []bool{false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `[1.5, true, "{\"a\": 1}"]`, `[null, false, "x"]`),
+					[]bool{false, false}),
+			},
+			fn: jsonArray,
+		},
+	})
+}
+
+func TestJsonModify(t *testing.T) {
+	doc := NewFunctionTestInput(types.T_varchar.ToType(),
+		[]string{`{"a": 1, "b": [2, 3]}`, `{"a": 1, "b": [2, 3]}`, ""},
+		[]bool{false, false, true})
+	inputs := []FunctionTestInput{
+		doc,
+		NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a", "$.c", "$.a"}, []bool{false, false, false}),
+		NewFunctionTestConstInput(types.T_int64.ToType(), []int64{10}, []bool{false}),
+		NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$.b[5]"}, []bool{false}),
+		NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"x"}, []bool{false}),
+	}
+
+	runJsonFnTestCases(t, []jsonFnTestCase{
+		{
+			tcTemp: tcTemp{
+				info:   "test json_set",
+				inputs: inputs,
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `{"a": 10, "b": [2, 3, "x"]}`, `{"a": 1, "b": [2, 3, "x"], "c": 10}`, ""),
+					[]bool{false, false, true}),
+			},
+			fn: newOpBuiltInJsonModify(bytejson.ModifySet).jsonModify,
+		},
+		{
+			tcTemp: tcTemp{
+				info:   "test json_insert",
+				inputs: inputs,
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `{"a": 1, "b": [2, 3, "x"]}`, `{"a": 1, "b": [2, 3, "x"], "c": 10}`, ""),
+					[]bool{false, false, true}),
+			},
+			fn: newOpBuiltInJsonModify(bytejson.ModifyInsert).jsonModify,
+		},
+		{
+			tcTemp: tcTemp{
+				info:   "test json_replace",
+				inputs: inputs,
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `{"a": 10, "b": [2, 3]}`, `{"a": 1, "b": [2, 3]}`, ""),
+					[]bool{false, false, true}),
+			},
+			fn: newOpBuiltInJsonModify(bytejson.ModifyReplace).jsonModify,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_remove",
+				inputs: []FunctionTestInput{
+					doc,
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$.b[0]"}, []bool{false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a", "", "$.a"}, []bool{false, true, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `{"b": [3]}`, "", ""),
+					[]bool{false, true, true}),
+			},
+			fn: newOpBuiltInJson().jsonRemove,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_remove with a wildcard path",
+				inputs: []FunctionTestInput{
+					doc,
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$.b[*]"}, []bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), true, []string{"", "", ""}, []bool{false, false, true}),
+			},
+			fn: newOpBuiltInJson().jsonRemove,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_merge_patch",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_json.ToType(), jsonBinaries(t, `{"a": 1, "b": 2}`, `[1]`), []bool{false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 3, "b": null}`, `{"c": 1}`}, []bool{false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `{"a": 3}`, `{"c": 1}`),
+					[]bool{false, false}),
+			},
+			fn: fEvalFn(jsonMerge(bytejson.MergePatch)),
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_merge_preserve",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_json.ToType(), jsonBinaries(t, `{"a": 1, "b": 2}`, `[1]`, ""), []bool{false, false, true}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 3, "b": null}`, `{"c": 1}`, `1`}, []bool{false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `{"a": [1, 3], "b": [2, null]}`, `[1, {"c": 1}]`, ""),
+					[]bool{false, false, true}),
+			},
+			fn: fEvalFn(jsonMerge(bytejson.MergePreserve)),
+		},
+	})
+}
+
+func TestJsonQueryFunctions(t *testing.T) {
+	doc := NewFunctionTestInput(types.T_varchar.ToType(),
+		[]string{`{"a": 1, "b": [2, "x"], "c": {"d": "xyz"}}`, `[true, null, "abc"]`, ""},
+		[]bool{false, false, true})
+
+	runJsonFnTestCases(t, []jsonFnTestCase{
+		{
+			tcTemp: tcTemp{
+				info: "test json_contains",
+				inputs: []FunctionTestInput{
+					doc,
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{`2`}, []bool{false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.b", "$[5]", "$"}, []bool{false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, false, false}, []bool{false, true, true}),
+			},
+			fn: newOpBuiltInJson().jsonContains,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_contains_path",
+				inputs: []FunctionTestInput{
+					doc,
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"all", "ONE", "one"}, []bool{false, false, false}),
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$[0]"}, []bool{false}),
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$**.d"}, []bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, true, false}, []bool{false, false, true}),
+			},
+			fn: newOpBuiltInJson().jsonContainsPath,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_contains_path with a wrong one_or_all",
+				inputs: []FunctionTestInput{
+					doc,
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"some"}, []bool{false}),
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$.a"}, []bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), true,
+					[]bool{false, false, false}, []bool{false, false, true}),
+			},
+			fn: newOpBuiltInJson().jsonContainsPath,
+		},
+		{
+			tcTemp: tcTemp{
+				info:   "test json_keys",
+				inputs: []FunctionTestInput{doc},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `["a", "b", "c"]`, "", ""), []bool{false, true, true}),
+			},
+			fn: newOpBuiltInJson().jsonKeys,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_length with a path",
+				inputs: []FunctionTestInput{
+					doc,
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$[1]"}, []bool{false}),
+				},
+				expect: NewFunctionTestResult(types.T_int64.ToType(), false,
+					[]int64{0, 1, 0}, []bool{true, false, true}),
+			},
+			fn: newOpBuiltInJson().jsonLength,
+		},
+		{
+			tcTemp: tcTemp{
+				info:   "test json_length",
+				inputs: []FunctionTestInput{doc},
+				expect: NewFunctionTestResult(types.T_int64.ToType(), false,
+					[]int64{3, 3, 0}, []bool{false, false, true}),
+			},
+			fn: newOpBuiltInJson().jsonLength,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_type",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_json.ToType(),
+						jsonBinaries(t, `{}`, `[]`, `1`, `18446744073709551615`, `1.5`, `"a"`, `true`, `null`, ""),
+						[]bool{false, false, false, false, false, false, false, false, true}),
+				},
+				expect: NewFunctionTestResult(types.T_varchar.ToType(), false,
+					[]string{"OBJECT", "ARRAY", "INTEGER", "UNSIGNED INTEGER", "DOUBLE", "STRING", "BOOLEAN", "NULL", ""},
+					[]bool{false, false, false, false, false, false, false, false, true}),
+			},
+			fn: jsonType,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_valid",
+				inputs: []FunctionTestInput{
+					NewFunctionTestInput(types.T_varchar.ToType(),
+						[]string{`{"a": 1}`, `{"a": 1`, `hello`, `"hello"`, ""},
+						[]bool{false, false, false, false, true}),
+				},
+				expect: NewFunctionTestResult(types.T_bool.ToType(), false,
+					[]bool{true, false, false, true, false},
+					[]bool{false, false, false, false, true}),
+			},
+			fn: jsonValid,
+		},
+	})
+}
+
+func TestJsonSearch(t *testing.T) {
+	// the first input sets the number of rows, so it is not a constant.
+	docs := func(text string, n int) FunctionTestInput {
+		texts := make([]string, n)
+		for i := range texts {
+			texts[i] = text
+		}
+		return NewFunctionTestInput(types.T_varchar.ToType(), texts, make([]bool, n))
+	}
+	doc := `["abc", [{"k": "10"}, "def"], {"x": "abc"}, {"y": "bcd"}, {"a b": "a%c"}]`
+
+	runJsonFnTestCases(t, []jsonFnTestCase{
+		{
+			tcTemp: tcTemp{
+				info: "test json_search",
+				inputs: []FunctionTestInput{
+					docs(doc, 5),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"one", "all", "all", "all", "all"}, []bool{false, false, false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"abc", "abc", "%b%", "ghi", ""}, []bool{false, false, false, false, true}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `"$[0]"`, `["$[0]", "$[2].x"]`, `["$[0]", "$[2].x", "$[3].y"]`, "", ""),
+					[]bool{false, false, false, true, true}),
+			},
+			fn: newOpBuiltInJson().jsonSearch,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_search with an escape character and paths",
+				inputs: []FunctionTestInput{
+					docs(doc, 3),
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"all"}, []bool{false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"a|%c", "a%c", "1%"}, []bool{false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"|", "", ""}, []bool{false, true, false}),
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$[4]"}, []bool{false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"$[0]", "$[0]", "$[1]"}, []bool{false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `"$[4].\"a b\""`, `["$[4].\"a b\"", "$[0]"]`, `"$[1][0].k"`),
+					[]bool{false, false, false}),
+			},
+			fn: newOpBuiltInJson().jsonSearch,
+		},
+		{
+			tcTemp: tcTemp{
+				info: "test json_search with literal backslashes and wildcards",
+				inputs: []FunctionTestInput{
+					docs(`["a\\b", "a.b", "a|b", "a%b"]`, 5),
+					NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"all"}, []bool{false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{`a\b`, "a.b", "a||b", "a|%b", `a\%b`}, []bool{false, false, false, false, false}),
+					NewFunctionTestInput(types.T_varchar.ToType(), []string{"|", "|", "|", "|", ""}, []bool{false, false, false, false, false}),
+				},
+				expect: NewFunctionTestResult(types.T_json.ToType(), false,
+					jsonBinaries(t, `"$[0]"`, `"$[1]"`, `"$[2]"`, `"$[3]"`, `"$[3]"`),
+					[]bool{false, false, false, false, false}),
+			},
+			fn: newOpBuiltInJson().jsonSearch,
+		},
+	})
+}
@@ -53,7 +53,10 @@ func initAllSupportedFunctions() {
 
 	for _, fn := range supportedWindowInNewFramework {
 		for _, ov := range fn.Overloads {
-			ov.aggFramework.aggRegister(encodeOverloadID(int32(fn.functionId), int32(ov.overloadId)))
+			// the value functions are evaluated by the window operator itself.
+			if ov.aggFramework.aggRegister != nil {
+				ov.aggFramework.aggRegister(encodeOverloadID(int32(fn.functionId), int32(ov.overloadId)))
+			}
 		}
 		allSupportedFunctions[fn.functionId] = fn
 	}
@@ -92,6 +95,15 @@ func GetFunctionIsWinOrderFunByName(name string) bool {
 	return f.isWindowOrder()
 }
 
+func GetFunctionIsWinValueFunByName(name string) bool {
+	fid, exists := getFunctionIdByNameWithoutErr(name)
+	if !exists {
+		return false
+	}
+	f := allSupportedFunctions[fid]
+	return f.isWindowValue()
+}
+
 func GetFunctionIsWinOrderFunById(overloadID int64) bool {
 	fid, _ := DecodeOverloadID(overloadID)
 	return allSupportedFunctions[fid].isWindowOrder()
@@ -255,6 +267,9 @@ func DeduceNotNullable(overloadID int64, args []*plan.Expr) bool {
 	if allSupportedFunctions[fid].testFlag(plan.Function_PRODUCE_NO_NULL) {
 		return true
 	}
+	if allSupportedFunctions[fid].testFlag(plan.Function_PRODUCE_NULL) {
+		return false
+	}
 
 	for _, arg := range args {
 		if !arg.Typ.NotNullable {
@@ -452,6 +467,10 @@ func (fn *FuncNew) isWindowOrder() bool {
 	return fn.testFlag(plan.Function_WIN_ORDER)
 }
 
+func (fn *FuncNew) isWindowValue() bool {
+	return fn.testFlag(plan.Function_WIN_VALUE)
+}
+
 func (fn *FuncNew) testFlag(funcFlag plan.Function_FuncFlag) bool {
 	return fn.class&funcFlag != 0
 }
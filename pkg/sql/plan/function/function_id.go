@@ -386,6 +386,38 @@ const (
 	BITMAP_CONSTRUCT_AGG
 	BITMAP_OR_AGG
 
+	// regression aggregate function
+	REGR_SLOPE
+	REGR_INTERCEPT
+	REGR_R2
+	REGR_COUNT
+
+	// percentile aggregate function
+	PERCENTILE_CONT
+	PERCENTILE_DISC
+	APPROX_PERCENTILE
+
+	// json aggregate function
+	JSON_ARRAYAGG
+	JSON_OBJECTAGG
+
+	// json construction, mutation and query function
+	JSON_OBJECT
+	JSON_ARRAY
+	JSON_SET
+	JSON_INSERT
+	JSON_REPLACE
+	JSON_REMOVE
+	JSON_MERGE_PATCH
+	JSON_MERGE_PRESERVE
+	JSON_CONTAINS
+	JSON_CONTAINS_PATH
+	JSON_KEYS
+	JSON_LENGTH
+	JSON_TYPE
+	JSON_VALID
+	JSON_SEARCH
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -465,6 +497,20 @@ var functionIdRegister = map[string]int32{
 	"stddev_pop":            STDDEV_POP,
 	"variance":              VAR_POP,
 	"var_pop":               VAR_POP,
+	"stddev_samp":           STDDEV_SAMPLE,
+	"var_samp":              VAR_SAMPLE,
+	"covar_pop":             COVAR_POP,
+	"covar_samp":            COVAR_SAMPLE,
+	"corr":                  CORR,
+	"regr_slope":            REGR_SLOPE,
+	"regr_intercept":        REGR_INTERCEPT,
+	"regr_r2":               REGR_R2,
+	"regr_count":            REGR_COUNT,
+	"percentile_cont":       PERCENTILE_CONT,
+	"percentile_disc":       PERCENTILE_DISC,
+	"approx_percentile":     APPROX_PERCENTILE,
+	"json_arrayagg":         JSON_ARRAYAGG,
+	"json_objectagg":        JSON_OBJECTAGG,
 	"approx_count":          APPROX_COUNT,
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
 	"any_value":             ANY_VALUE,
@@ -588,11 +634,34 @@ var functionIdRegister = map[string]int32{
 	"json_row":                       JSON_ROW,
 	"jq":                             JQ,
 	"try_jq":                         TRY_JQ,
+	"json_object":                    JSON_OBJECT,
+	"json_array":                     JSON_ARRAY,
+	"json_set":                       JSON_SET,
+	"json_insert":                    JSON_INSERT,
+	"json_replace":                   JSON_REPLACE,
+	"json_remove":                    JSON_REMOVE,
+	"json_merge_patch":               JSON_MERGE_PATCH,
+	"json_merge_preserve":            JSON_MERGE_PRESERVE,
+	"json_contains":                  JSON_CONTAINS,
+	"json_contains_path":             JSON_CONTAINS_PATH,
+	"json_keys":                      JSON_KEYS,
+	"json_length":                    JSON_LENGTH,
+	"json_type":                      JSON_TYPE,
+	"json_valid":                     JSON_VALID,
+	"json_search":                    JSON_SEARCH,
 	"wasm":                           WASM,
 	"try_wasm":                       TRY_WASM,
 	"enable_fault_injection":         ENABLE_FAULT_INJECTION,
 	"disable_fault_injection":        DISABLE_FAULT_INJECTION,
 	"dense_rank":                     DENSE_RANK,
+	"percent_rank":                   PERCENT_RANK,
+	"cume_dist":                      CUME_DIST,
+	"ntile":                          NTILE,
+	"lag":                            LAG,
+	"lead":                           LEAD,
+	"first_value":                    FIRST_VALUE,
+	"last_value":                     LAST_VALUE,
+	"nth_value":                      NTH_VALUE,
 	"add_fault_point":                ADD_FAULT_POINT,
 	"remove_fault_point":             REMOVE_FAULT_POINT,
 	"trigger_fault_point":            TRIGGER_FAULT_POINT,
@@ -382,6 +382,195 @@ var supportedAggInNewFramework = []FuncNew{
 		},
 	},
 
+	{
+		functionId: VAR_SAMPLE,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return statisticsAggTypeCheck(inputs, 1, aggexec.StatisticsSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.StatisticsReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "var_samp",
+					aggRegister: agg.RegisterVarSamp,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: STDDEV_SAMPLE,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return statisticsAggTypeCheck(inputs, 1, aggexec.StatisticsSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.StatisticsReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "stddev_samp",
+					aggRegister: agg.RegisterStdDevSamp,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: COVAR_POP,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return statisticsAggTypeCheck(inputs, 2, aggexec.StatisticsSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.StatisticsReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "covar_pop",
+					aggRegister: agg.RegisterCovarPop,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: COVAR_SAMPLE,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return statisticsAggTypeCheck(inputs, 2, aggexec.StatisticsSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.StatisticsReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "covar_samp",
+					aggRegister: agg.RegisterCovarSamp,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: CORR,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return statisticsAggTypeCheck(inputs, 2, aggexec.StatisticsSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.StatisticsReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "corr",
+					aggRegister: agg.RegisterCorr,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: REGR_SLOPE,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return statisticsAggTypeCheck(inputs, 2, aggexec.StatisticsSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.StatisticsReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "regr_slope",
+					aggRegister: agg.RegisterRegrSlope,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: REGR_INTERCEPT,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return statisticsAggTypeCheck(inputs, 2, aggexec.StatisticsSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.StatisticsReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "regr_intercept",
+					aggRegister: agg.RegisterRegrIntercept,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: REGR_R2,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return statisticsAggTypeCheck(inputs, 2, aggexec.StatisticsSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.StatisticsReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "regr_r2",
+					aggRegister: agg.RegisterRegrR2,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: REGR_COUNT,
+		class:      plan.Function_AGG | plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return statisticsAggTypeCheck(inputs, 2, aggexec.StatisticsSupportedTypes)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.RegrCountReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "regr_count",
+					aggRegister: agg.RegisterRegrCount,
+				},
+			},
+		},
+	},
+
 	{
 		functionId: MEDIAN,
 		class:      plan.Function_AGG,
@@ -403,6 +592,111 @@ var supportedAggInNewFramework = []FuncNew{
 		},
 	},
 
+	{
+		functionId: PERCENTILE_CONT,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return percentileAggTypeCheck(inputs, aggexec.StatisticsSupportedTypes, true, false)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.PercentileContReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "percentile_cont",
+					aggRegister: agg.RegisterPercentileCont,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: PERCENTILE_DISC,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return percentileAggTypeCheck(inputs, aggexec.PercentileDiscSupportedTypes, false, false)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.PercentileDiscReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "percentile_disc",
+					aggRegister: agg.RegisterPercentileDisc,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: APPROX_PERCENTILE,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return percentileAggTypeCheck(inputs, aggexec.StatisticsSupportedTypes, true, true)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.ApproxPercentileReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "approx_percentile",
+					aggRegister: agg.RegisterApproxPercentile,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: JSON_ARRAYAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return jsonAggTypeCheck(inputs, aggexec.JsonAggSupportedTypes, false)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.JsonAggReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "json_arrayagg",
+					aggRegister: agg.RegisterJsonArrayAgg,
+				},
+			},
+		},
+	},
+
+	{
+		functionId: JSON_OBJECTAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return jsonAggTypeCheck(inputs, aggexec.JsonAggSupportedTypes, true)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    aggexec.JsonAggReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str:         "json_objectagg",
+					aggRegister: agg.RegisterJsonObjectAgg,
+				},
+			},
+		},
+	},
+
 	{
 		functionId: CLUSTER_CENTERS,
 		class:      plan.Function_AGG,
@@ -88,4 +88,202 @@ var supportedWindowInNewFramework = []FuncNew{
 			},
 		},
 	},
+	{
+		functionId: PERCENT_RANK,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str: "percent_rank",
+				},
+			},
+		},
+	},
+	{
+		functionId: CUME_DIST,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str: "cume_dist",
+				},
+			},
+		},
+	},
+	{
+		functionId: NTILE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 1 {
+				if inputs[0].Oid == types.T_int64 {
+					return newCheckResultWithSuccess(0)
+				}
+				if inputs[0].Oid.IsInteger() || inputs[0].Oid == types.T_any {
+					return newCheckResultWithCast(0, []types.Type{types.T_int64.ToType()})
+				}
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_int64.ToType()
+				},
+				aggFramework: aggregationLogicOfOverload{
+					str: "ntile",
+				},
+			},
+		},
+	},
+	{
+		functionId: LAG,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowValueTypeCheck(inputs, 1, 3)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    windowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str: "lag",
+				},
+			},
+		},
+	},
+	{
+		functionId: LEAD,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowValueTypeCheck(inputs, 1, 3)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    windowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str: "lead",
+				},
+			},
+		},
+	},
+	{
+		functionId: FIRST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowValueTypeCheck(inputs, 1, 1)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    windowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str: "first_value",
+				},
+			},
+		},
+	},
+	{
+		functionId: LAST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowValueTypeCheck(inputs, 1, 1)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    windowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str: "last_value",
+				},
+			},
+		},
+	},
+	{
+		functionId: NTH_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			return windowValueTypeCheck(inputs, 2, 2)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType:    windowValueReturnType,
+				aggFramework: aggregationLogicOfOverload{
+					str: "nth_value",
+				},
+			},
+		},
+	},
+}
+
+// windowValueTypeCheck checks the arguments of LAG, LEAD, FIRST_VALUE,
+// LAST_VALUE and NTH_VALUE. The first one is the value, the second one is an
+// integer offset cast to int64, and the third one is the default value cast to
+// the type of the value.
+func windowValueTypeCheck(inputs []types.Type, minArgs, maxArgs int) checkResult {
+	if len(inputs) < minArgs || len(inputs) > maxArgs {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+
+	needCast := false
+	castTypes := make([]types.Type, len(inputs))
+	copy(castTypes, inputs)
+	if len(inputs) > 1 && inputs[1].Oid != types.T_int64 {
+		if !inputs[1].Oid.IsInteger() && inputs[1].Oid != types.T_any {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+		castTypes[1] = types.T_int64.ToType()
+		needCast = true
+	}
+	if len(inputs) > 2 && !inputs[2].Eq(inputs[0]) {
+		castTypes[2] = inputs[0]
+		needCast = true
+	}
+	if needCast {
+		return newCheckResultWithCast(0, castTypes)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
+// windowValueReturnType is the type of the value, the first argument.
+func windowValueReturnType(parameters []types.Type) types.Type {
+	return parameters[0]
 }
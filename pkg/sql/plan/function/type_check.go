@@ -16,6 +16,7 @@ package function
 
 import (
 	"math"
+	"slices"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
@@ -267,6 +268,121 @@ func fixedUnaryAggTypeCheck(inputs []types.Type, supported []types.T) checkResul
 	return newCheckResultWithFailure(failedAggParametersWrong)
 }
 
+// statisticsAggTypeCheck checks the n arguments of the aggregations computed
+// from the moments of their arguments, which are all cast to float64.
+func statisticsAggTypeCheck(inputs []types.Type, n int, supported []types.T) checkResult {
+	if len(inputs) != n {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+	needCast := false
+	for _, t := range inputs {
+		if t.Oid == types.T_float64 {
+			continue
+		}
+		if t.Oid != types.T_any && !slices.Contains(supported, t.Oid) {
+			return newCheckResultWithFailure(failedAggParametersWrong)
+		}
+		needCast = true
+	}
+	if needCast {
+		castType := make([]types.Type, n)
+		for i := range castType {
+			castType[i] = types.T_float64.ToType()
+		}
+		return newCheckResultWithCast(0, castType)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
+// percentileAggTypeCheck checks the arguments (x, fraction, desc) of the
+// PERCENTILE_CONT and PERCENTILE_DISC, and (x, fraction[, accuracy]) of the
+// APPROX_PERCENTILE. x is cast to float64 if castX, the fraction is always
+// cast to float64 and the accuracy to int64.
+// jsonAggTypeCheck checks the arguments of the JSON_ARRAYAGG(value) and the
+// JSON_OBJECTAGG(key, value), the key is cast to varchar.
+func jsonAggTypeCheck(inputs []types.Type, supported []types.T, object bool) checkResult {
+	if object && len(inputs) != 2 || !object && len(inputs) != 1 {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+
+	castType := make([]types.Type, len(inputs))
+	needCast := false
+	for i, t := range inputs {
+		castType[i] = t
+		switch {
+		case object && i == 0:
+			if t.Oid != types.T_any && (t.Oid == types.T_json || !slices.Contains(supported, t.Oid)) {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			if t.Oid != types.T_char && t.Oid != types.T_varchar && t.Oid != types.T_text {
+				castType[i] = types.T_varchar.ToType()
+				needCast = true
+			}
+		case t.Oid == types.T_any:
+			castType[i] = types.T_varchar.ToType()
+			needCast = true
+		case !slices.Contains(supported, t.Oid):
+			return newCheckResultWithFailure(failedAggParametersWrong)
+		}
+	}
+
+	if needCast {
+		return newCheckResultWithCast(0, castType)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
+func percentileAggTypeCheck(inputs []types.Type, supported []types.T, castX bool, approx bool) checkResult {
+	if approx && (len(inputs) < 2 || len(inputs) > 3) || !approx && len(inputs) != 3 {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+
+	castType := make([]types.Type, len(inputs))
+	needCast := false
+	cast := func(i int, target types.Type) {
+		castType[i] = target
+		if inputs[i].Oid != target.Oid {
+			needCast = true
+		}
+	}
+
+	x := inputs[0]
+	switch {
+	case x.Oid == types.T_any:
+		cast(0, supported[0].ToType())
+	case !slices.Contains(supported, x.Oid):
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	case castX:
+		cast(0, types.T_float64.ToType())
+	default:
+		castType[0] = x
+	}
+
+	if p := inputs[1]; p.Oid != types.T_any && !p.IsNumeric() {
+		return newCheckResultWithFailure(failedAggParametersWrong)
+	}
+	cast(1, types.T_float64.ToType())
+
+	if len(inputs) > 2 {
+		if approx {
+			if a := inputs[2]; a.Oid != types.T_any && !a.IsInt() && !a.IsUInt() {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			cast(2, types.T_int64.ToType())
+		} else {
+			if inputs[2].Oid != types.T_bool {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			castType[2] = inputs[2]
+		}
+	}
+
+	if needCast {
+		return newCheckResultWithCast(0, castType)
+	}
+	return newCheckResultWithSuccess(0)
+}
+
 var fixedBinaryCastRule1 [300][300]tarTypes
 var fixedBinaryCastRule2 [300][300]tarTypes
 var fixedCanImplicitCastRule [300]implicitTypeCastRule
@@ -32,21 +32,31 @@ func NewGroupBinder(builder *QueryBuilder, ctx *BindContext, selectList tree.Sel
 	return b
 }
 
+// resolvePosition replaces a GROUP BY position by the expression of the
+// select list.
+func (b *GroupBinder) resolvePosition(astExpr tree.Expr) (tree.Expr, error) {
+	if numVal, ok := astExpr.(*tree.NumVal); ok {
+		switch numVal.Kind() {
+		case tree.Int:
+			colPos, _ := numVal.Int64()
+			if colPos < 1 || int(colPos) > len(b.selectList) {
+				return nil, moerr.NewSyntaxError(b.GetContext(), "GROUP BY position %v is not in select list", colPos)
+			}
+
+			astExpr = b.selectList[colPos-1].Expr
+
+		default:
+			return nil, moerr.NewSyntaxError(b.GetContext(), "non-integer constant in GROUP BY")
+		}
+	}
+	return astExpr, nil
+}
+
 func (b *GroupBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*plan.Expr, error) {
 	if isRoot {
-		if numVal, ok := astExpr.(*tree.NumVal); ok {
-			switch numVal.Kind() {
-			case tree.Int:
-				colPos, _ := numVal.Int64()
-				if colPos < 1 || int(colPos) > len(b.selectList) {
-					return nil, moerr.NewSyntaxError(b.GetContext(), "GROUP BY position %v is not in select list", colPos)
-				}
-
-				astExpr = b.selectList[colPos-1].Expr
-
-			default:
-				return nil, moerr.NewSyntaxError(b.GetContext(), "non-integer constant in GROUP BY")
-			}
+		var err error
+		if astExpr, err = b.resolvePosition(astExpr); err != nil {
+			return nil, err
 		}
 	}
 
@@ -72,6 +82,17 @@ func (b *GroupBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*pl
 	return expr, err
 }
 
+// bindGroupingColumn binds a column of a grouping set and returns its
+// position in the group by list, the same columns of different sets share
+// one position.
+func (b *GroupBinder) bindGroupingColumn(astExpr tree.Expr) (int32, error) {
+	if _, err := b.BindExpr(astExpr, 0, true); err != nil {
+		return 0, err
+	}
+	astExpr, _ = b.resolvePosition(astExpr)
+	return b.ctx.groupByAst[tree.String(astExpr, dialect.MYSQL)], nil
+}
+
 func (b *GroupBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32, isRoot bool) (*plan.Expr, error) {
 	expr, err := b.baseBindColRef(astExpr, depth, isRoot)
 	if err != nil {
@@ -0,0 +1,199 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	// maxGroupingSets is the maximal number of grouping sets of a query block.
+	maxGroupingSets = 4096
+	// maxGroupingColumns is the maximal number of group by columns with
+	// grouping sets, the grouping id has a bit per column.
+	maxGroupingColumns = 63
+)
+
+// bindGroupingSets binds a GROUP BY clause with ROLLUP, CUBE or GROUPING SETS.
+// The group by list is the union of the columns of all the sets followed by
+// the grouping id column. The AGG node groups the rows once per set, the
+// grouping id of a set has the bit i set if the column i is absent from the
+// set, that column is null in the groups of the set. A set given more than
+// once is grouped once.
+func (builder *QueryBuilder) bindGroupingSets(ctx *BindContext, groupBy tree.GroupBy, groupBinder *GroupBinder) error {
+	bindColumns := func(exprs tree.Exprs) (uint64, error) {
+		var mask uint64
+		for _, astExpr := range exprs {
+			astExpr, err := ctx.qualifyColumnNames(astExpr, AliasAfterColumn)
+			if err != nil {
+				return 0, err
+			}
+			pos, err := groupBinder.bindGroupingColumn(astExpr)
+			if err != nil {
+				return 0, err
+			}
+			if pos >= maxGroupingColumns {
+				return 0, moerr.NewNotSupported(builder.GetContext(), "more than %d columns in GROUP BY with grouping sets", maxGroupingColumns)
+			}
+			mask |= 1 << pos
+		}
+		return mask, nil
+	}
+
+	// each set is the mask of its columns
+	sets := []uint64{0}
+	for _, item := range groupBy {
+		gs, ok := item.(*tree.GroupingSets)
+		if !ok {
+			mask, err := bindColumns(tree.Exprs{item})
+			if err != nil {
+				return err
+			}
+			for i := range sets {
+				sets[i] |= mask
+			}
+			continue
+		}
+
+		elems := make([]uint64, len(gs.Sets))
+		for i, set := range gs.Sets {
+			mask, err := bindColumns(set)
+			if err != nil {
+				return err
+			}
+			elems[i] = mask
+		}
+
+		var itemSets []uint64
+		switch gs.Type {
+		case tree.GroupingSetsRollup:
+			// rollup(a, b) is (a, b), (a), ()
+			for i := len(elems); i >= 0; i-- {
+				var mask uint64
+				for _, elem := range elems[:i] {
+					mask |= elem
+				}
+				itemSets = append(itemSets, mask)
+			}
+		case tree.GroupingSetsCube:
+			// cube(a, b) is (a, b), (a), (b), ()
+			if 1<<len(elems) > maxGroupingSets {
+				return moerr.NewNotSupported(builder.GetContext(), "more than %d grouping sets", maxGroupingSets)
+			}
+			for i := 0; i < 1<<len(elems); i++ {
+				var mask uint64
+				for j, elem := range elems {
+					if i&(1<<j) == 0 {
+						mask |= elem
+					}
+				}
+				itemSets = append(itemSets, mask)
+			}
+		default:
+			itemSets = elems
+		}
+
+		if len(sets)*len(itemSets) > maxGroupingSets {
+			return moerr.NewNotSupported(builder.GetContext(), "more than %d grouping sets", maxGroupingSets)
+		}
+		product := make([]uint64, 0, len(sets)*len(itemSets))
+		for _, set := range sets {
+			for _, itemSet := range itemSets {
+				product = append(product, set|itemSet)
+			}
+		}
+		sets = product
+	}
+
+	all := uint64(1)<<len(ctx.groups) - 1
+	seen := make(map[uint64]bool, len(sets))
+	for _, set := range sets {
+		gid := all &^ set
+		if !seen[gid] {
+			seen[gid] = true
+			ctx.groupingIds = append(ctx.groupingIds, gid)
+		}
+	}
+
+	for _, expr := range ctx.groups {
+		expr.Typ.NotNullable = false
+	}
+	builder.nameByColRef[[2]int32{ctx.groupTag, int32(len(ctx.groups))}] = "grouping_id"
+	ctx.groups = append(ctx.groups, makePlan2Int64ConstExprWithType(0))
+	return nil
+}
+
+// bindGroupingFunc binds GROUPING(a, b, ...), the bit n-1-i of the result is
+// set if the i-th argument is null because it is absent from the grouping
+// set of the row.
+func (b *baseBinder) bindGroupingFunc(astExpr *tree.FuncExpr) (*plan.Expr, error) {
+	switch impl := b.impl.(type) {
+	case *ProjectionBinder:
+	case *HavingBinder:
+		if impl.insideAgg {
+			return nil, moerr.NewInvalidInput(b.GetContext(), "GROUPING function in aggregate function")
+		}
+	default:
+		return nil, moerr.NewInvalidInput(b.GetContext(), "GROUPING function is only allowed in the select list, HAVING and ORDER BY")
+	}
+	if b.ctx == nil || b.ctx.groupingIds == nil {
+		return nil, moerr.NewInvalidInput(b.GetContext(), "GROUPING function without ROLLUP, CUBE or GROUPING SETS")
+	}
+	if len(astExpr.Exprs) == 0 || len(astExpr.Exprs) > maxGroupingColumns {
+		return nil, moerr.NewInvalidInput(b.GetContext(), "GROUPING function takes 1 to %d arguments", maxGroupingColumns)
+	}
+
+	gidPos := int32(len(b.ctx.groups) - 1)
+	gid := &plan.Expr{
+		Typ: plan.Type{Id: int32(types.T_int64), NotNullable: true},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: b.ctx.groupTag,
+				ColPos: gidPos,
+			},
+		},
+	}
+
+	var ret *plan.Expr
+	for i, arg := range astExpr.Exprs {
+		pos, ok := b.ctx.groupByAst[tree.String(arg, dialect.MYSQL)]
+		if !ok {
+			return nil, moerr.NewInvalidInput(b.GetContext(), "argument #%d of GROUPING function is not in GROUP BY", i+1)
+		}
+
+		bit, err := BindFuncExprImplByPlanExpr(b.GetContext(), ">>", []*plan.Expr{DeepCopyExpr(gid), makePlan2Int64ConstExprWithType(int64(pos))})
+		if err != nil {
+			return nil, err
+		}
+		if bit, err = BindFuncExprImplByPlanExpr(b.GetContext(), "&", []*plan.Expr{bit, makePlan2Int64ConstExprWithType(1)}); err != nil {
+			return nil, err
+		}
+		if shift := len(astExpr.Exprs) - 1 - i; shift > 0 {
+			if bit, err = BindFuncExprImplByPlanExpr(b.GetContext(), "<<", []*plan.Expr{bit, makePlan2Int64ConstExprWithType(int64(shift))}); err != nil {
+				return nil, err
+			}
+		}
+		if ret == nil {
+			ret = bit
+		} else if ret, err = BindFuncExprImplByPlanExpr(b.GetContext(), "|", []*plan.Expr{ret, bit}); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
@@ -126,7 +126,7 @@ func (b *HavingBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, dept
 		return nil, moerr.NewSyntaxError(b.GetContext(), "aggregate function %s calls cannot be nested", funcName)
 	}
 
-	if funcName == NameGroupConcat {
+	if isForceWindowAgg(funcName) {
 		err := b.processForceWindows(funcName, astExpr, depth, isRoot)
 		if err != nil {
 			return nil, err
@@ -181,6 +181,12 @@ func (b *HavingBinder) processForceWindows(funcName string, astExpr *tree.FuncEx
 	//order by
 	w.OrderBy = make([]*plan.OrderBySpec, 0, len(astExpr.OrderBy))
 
+	// the last argument of the group_concat is the separator.
+	argCount := len(astExpr.Exprs)
+	if funcName == NameGroupConcat {
+		argCount--
+	}
+
 	for _, order := range astExpr.OrderBy {
 		orderExpr := order.Expr
 		if numVal, ok := order.Expr.(*tree.NumVal); ok {
@@ -190,8 +196,8 @@ func (b *HavingBinder) processForceWindows(funcName string, astExpr *tree.FuncEx
 				if numVal.Negative() {
 					moerr.NewSyntaxError(b.GetContext(), "ORDER BY position %v is negative", colPos)
 				}
-				if colPos < 1 || int(colPos) > len(astExpr.Exprs)-1 {
-					return moerr.NewSyntaxError(b.GetContext(), "ORDER BY position %v is not in group_concat arguments", colPos)
+				if colPos < 1 || int(colPos) > argCount {
+					return moerr.NewSyntaxError(b.GetContext(), "ORDER BY position %v is not in %s arguments", colPos, funcName)
 				}
 				orderExpr = astExpr.Exprs[colPos-1]
 			default:
@@ -201,7 +207,7 @@ func (b *HavingBinder) processForceWindows(funcName string, astExpr *tree.FuncEx
 		}
 
 		if _, ok := order.Expr.(*tree.Subquery); ok {
-			return moerr.NewNotSupported(b.GetContext(), "subquery in group_concat ORDER BY")
+			return moerr.NewNotSupported(b.GetContext(), "subquery in %s ORDER BY", funcName)
 		}
 
 		b.insideAgg = true
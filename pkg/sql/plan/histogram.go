@@ -0,0 +1,292 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
+)
+
+const (
+	// DefaultHistogramBuckets is the number of buckets of ANALYZE TABLE
+	// without the WITH n BUCKETS clause.
+	DefaultHistogramBuckets = 100
+	// MaxHistogramBuckets is the maximal number of buckets of a histogram.
+	MaxHistogramBuckets = 1024
+	// HistogramSampleRows is the number of rows sampled to build a histogram.
+	HistogramSampleRows = 30000
+)
+
+// IsHistogramType tells whether ANALYZE TABLE builds histograms for the
+// columns of the type, their values must have a float64 form.
+func IsHistogramType(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime:
+		return true
+	}
+	return false
+}
+
+// BuildHistogram builds the histogram of the sampled non-null values of a
+// column. A value which would fill a whole bucket goes to the most common
+// values, the others are split into equi-depth buckets, the values equal to
+// each other are never split into two buckets.
+func BuildHistogram(values []float64, buckets int) *pb.Histogram {
+	if buckets <= 0 {
+		buckets = DefaultHistogramBuckets
+	}
+	if buckets > MaxHistogramBuckets {
+		buckets = MaxHistogramBuckets
+	}
+	h := &pb.Histogram{SampleRows: int64(len(values))}
+	if len(values) == 0 {
+		return h
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	total := float64(len(sorted))
+
+	type run struct {
+		val float64
+		cnt int
+	}
+	var runs []run
+	for i, v := range sorted {
+		if i > 0 && v == sorted[i-1] {
+			runs[len(runs)-1].cnt++
+			continue
+		}
+		runs = append(runs, run{val: v, cnt: 1})
+	}
+
+	// the most common values, at most one per bucket
+	mcvLimit := len(sorted) / buckets
+	if mcvLimit < 2 {
+		mcvLimit = 2
+	}
+	mcvs := make([]run, 0)
+	for _, r := range runs {
+		if r.cnt >= mcvLimit {
+			mcvs = append(mcvs, r)
+		}
+	}
+	sort.Slice(mcvs, func(i, j int) bool { return mcvs[i].cnt > mcvs[j].cnt })
+	if len(mcvs) > buckets {
+		mcvs = mcvs[:buckets]
+	}
+	isMcv := make(map[float64]bool, len(mcvs))
+	sort.Slice(mcvs, func(i, j int) bool { return mcvs[i].val < mcvs[j].val })
+	for _, r := range mcvs {
+		isMcv[r.val] = true
+		h.McvValues = append(h.McvValues, r.val)
+		h.McvFreqs = append(h.McvFreqs, float64(r.cnt)/total)
+	}
+
+	rest := 0
+	for _, r := range runs {
+		if !isMcv[r.val] {
+			rest += r.cnt
+		}
+	}
+	if rest == 0 {
+		return h
+	}
+	depth := (rest + buckets - 1) / buckets
+
+	cnt, ndv := 0, 0
+	for _, r := range runs {
+		if isMcv[r.val] {
+			continue
+		}
+		if len(h.Bounds) == 0 {
+			h.Bounds = append(h.Bounds, r.val)
+		}
+		cnt += r.cnt
+		ndv++
+		if cnt >= depth {
+			h.Bounds = append(h.Bounds, r.val)
+			h.Freqs = append(h.Freqs, float64(cnt)/total)
+			h.Ndvs = append(h.Ndvs, float64(ndv))
+			cnt, ndv = 0, 0
+		}
+	}
+	if cnt > 0 {
+		h.Bounds = append(h.Bounds, sorted[len(sorted)-1])
+		for i := len(runs) - 1; i >= 0; i-- {
+			if !isMcv[runs[i].val] {
+				h.Bounds[len(h.Bounds)-1] = runs[i].val
+				break
+			}
+		}
+		h.Freqs = append(h.Freqs, float64(cnt)/total)
+		h.Ndvs = append(h.Ndvs, float64(ndv))
+	}
+	return h
+}
+
+// HistogramToJSON returns the form of the histogram persisted in
+// mo_catalog.mo_column_statistics.
+func HistogramToJSON(h *pb.Histogram) (string, error) {
+	data, err := json.Marshal(h)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// HistogramFromJSON is the reverse of HistogramToJSON.
+func HistogramFromJSON(s string) (*pb.Histogram, error) {
+	h := &pb.Histogram{}
+	if err := json.Unmarshal([]byte(s), h); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// histogramMinFraction returns the fraction of the non-null values assumed
+// for a value the sample missed. Such a value may still be in the table, so
+// its fraction is one value of the sample or one distinct value of the
+// column, whichever is less, rather than 0.
+func histogramMinFraction(h *pb.Histogram, colNdv float64) float64 {
+	ret := 1 / float64(h.SampleRows)
+	if colNdv > 1 && 1/colNdv < ret {
+		ret = 1 / colNdv
+	}
+	return ret
+}
+
+// histogramEqualFraction returns the fraction of the non-null values equal
+// to val. colNdv is the number of distinct values of the column, which
+// scales the distinct values of the buckets found in the sample.
+func histogramEqualFraction(h *pb.Histogram, val, colNdv float64) float64 {
+	for i, v := range h.McvValues {
+		if v == val {
+			return h.McvFreqs[i]
+		}
+	}
+
+	i := histogramBucket(h, val)
+	if i < 0 {
+		return histogramMinFraction(h, colNdv)
+	}
+	sampleNdv := 0.0
+	for _, ndv := range h.Ndvs {
+		sampleNdv += ndv
+	}
+	ndv := h.Ndvs[i]
+	if restNdv := colNdv - float64(len(h.McvValues)); restNdv > sampleNdv && sampleNdv > 0 {
+		ndv = ndv * restNdv / sampleNdv
+	}
+	if ndv < 1 {
+		ndv = 1
+	}
+	return h.Freqs[i] / ndv
+}
+
+// histogramLessFraction returns the fraction of the non-null values less
+// than val, or not greater than val if inclusive is set. The values in a
+// bucket are assumed to be uniformly distributed.
+func histogramLessFraction(h *pb.Histogram, val float64, inclusive bool) float64 {
+	ret := 0.0
+	for i, v := range h.McvValues {
+		if v < val || (inclusive && v == val) {
+			ret += h.McvFreqs[i]
+		}
+	}
+
+	for i := range h.Freqs {
+		lo, hi := h.Bounds[i], h.Bounds[i+1]
+		switch {
+		case hi < val || (inclusive && hi == val):
+			ret += h.Freqs[i]
+		case val < lo || (i > 0 && val == lo) || (!inclusive && val == lo):
+		case hi > lo:
+			ret += h.Freqs[i] * (val - lo) / (hi - lo)
+		}
+	}
+	if ret > 1 {
+		ret = 1
+	}
+	return ret
+}
+
+// histogramBucket returns the bucket which may contain val, or -1.
+func histogramBucket(h *pb.Histogram, val float64) int {
+	if len(h.Freqs) == 0 || val < h.Bounds[0] || val > h.Bounds[len(h.Bounds)-1] {
+		return -1
+	}
+	i := sort.SearchFloat64s(h.Bounds[1:], val)
+	if i >= len(h.Freqs) {
+		return len(h.Freqs) - 1
+	}
+	return i
+}
+
+// estimateSelectivityByHistogram estimates the selectivity of the filter
+// on the column by its histogram, ok is false if the column has none.
+func estimateSelectivityByHistogram(s *pb.StatsInfo, colName, funcName string, typ types.T, literals []*Const) (ret float64, ok bool) {
+	h := s.HistogramMap[colName]
+	if h == nil || h.SampleRows == 0 {
+		return 0, false
+	}
+	vals := make([]float64, len(literals))
+	for i, lit := range literals {
+		if lit == nil {
+			return 0, false
+		}
+		if vals[i], ok = getFloat64Value(typ, lit); !ok {
+			return 0, false
+		}
+	}
+
+	switch funcName {
+	case "=":
+		ret = histogramEqualFraction(h, vals[0], s.NdvMap[colName])
+	case "<":
+		ret = histogramLessFraction(h, vals[0], false)
+	case "<=":
+		ret = histogramLessFraction(h, vals[0], true)
+	case ">":
+		ret = 1 - histogramLessFraction(h, vals[0], true)
+	case ">=":
+		ret = 1 - histogramLessFraction(h, vals[0], false)
+	case "between":
+		ret = histogramLessFraction(h, vals[1], true) - histogramLessFraction(h, vals[0], false)
+	default:
+		return 0, false
+	}
+	if minRet := histogramMinFraction(h, s.NdvMap[colName]); ret < minRet {
+		ret = minRet
+	}
+
+	// the histogram covers the non-null values only
+	if s.TableCnt > 0 {
+		ret *= 1 - float64(s.NullCntMap[colName])/s.TableCnt
+	}
+	if ret < 0 {
+		ret = 0
+	}
+	if ret > 1 {
+		ret = 1
+	}
+	return ret, true
+}
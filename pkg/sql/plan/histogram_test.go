@@ -0,0 +1,119 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
+	"github.com/stretchr/testify/require"
+)
+
+// skewedValues returns 0..999 once each and 7 another 500 times.
+func skewedValues() []float64 {
+	values := make([]float64, 0, 1500)
+	for i := 0; i < 1000; i++ {
+		values = append(values, float64(i))
+	}
+	for i := 0; i < 500; i++ {
+		values = append(values, 7)
+	}
+	return values
+}
+
+func TestBuildHistogram(t *testing.T) {
+	h := BuildHistogram(skewedValues(), 10)
+	require.Equal(t, int64(1500), h.SampleRows)
+	require.Equal(t, []float64{7}, h.McvValues)
+	require.InDelta(t, 501.0/1500, h.McvFreqs[0], 1e-9)
+	require.Equal(t, len(h.Freqs)+1, len(h.Bounds))
+	require.Equal(t, len(h.Freqs), len(h.Ndvs))
+	require.Equal(t, 0.0, h.Bounds[0])
+	require.Equal(t, 999.0, h.Bounds[len(h.Bounds)-1])
+
+	total := h.McvFreqs[0]
+	for i, f := range h.Freqs {
+		require.LessOrEqual(t, h.Bounds[i], h.Bounds[i+1])
+		total += f
+	}
+	require.InDelta(t, 1.0, total, 1e-9)
+
+	require.Equal(t, int64(0), BuildHistogram(nil, 10).SampleRows)
+	one := BuildHistogram([]float64{3, 3, 3}, 10)
+	require.Equal(t, []float64{3}, one.McvValues)
+	require.Empty(t, one.Freqs)
+
+	data, err := HistogramToJSON(h)
+	require.NoError(t, err)
+	h2, err := HistogramFromJSON(data)
+	require.NoError(t, err)
+	require.Equal(t, h, h2)
+}
+
+func TestHistogramFractions(t *testing.T) {
+	h := BuildHistogram(skewedValues(), 10)
+	require.InDelta(t, 501.0/1500, histogramEqualFraction(h, 7, 1000), 1e-9)
+	require.InDelta(t, 1.0/1500, histogramEqualFraction(h, 500, 1000), 1e-4)
+	// a value out of the bounds may be missed by the sample.
+	require.Equal(t, 1.0/1500, histogramEqualFraction(h, 5000, 1000))
+	require.Equal(t, 1.0/2000, histogramEqualFraction(h, 5000, 2000))
+
+	require.InDelta(t, 1000.0/1500, histogramLessFraction(h, 500, false), 0.01)
+	require.Equal(t, 0.0, histogramLessFraction(h, -1, true))
+	require.InDelta(t, 1.0, histogramLessFraction(h, 999, true), 1e-9)
+}
+
+func TestEstimateSelectivityByHistogram(t *testing.T) {
+	s := &pb.StatsInfo{
+		NdvMap:       map[string]float64{"a": 1000},
+		NullCntMap:   map[string]uint64{"a": 500},
+		TableCnt:     2000,
+		HistogramMap: map[string]*pb.Histogram{"a": BuildHistogram(skewedValues(), 10)},
+	}
+	lit := func(v int64) *Const {
+		return &plan.Literal{Value: &plan.Literal_I64Val{I64Val: v}}
+	}
+
+	sel, ok := estimateSelectivityByHistogram(s, "a", "=", types.T_int64, []*Const{lit(7)})
+	require.True(t, ok)
+	require.InDelta(t, 0.75*501/1500, sel, 1e-9)
+
+	sel, ok = estimateSelectivityByHistogram(s, "a", "<", types.T_int64, []*Const{lit(500)})
+	require.True(t, ok)
+	require.InDelta(t, 0.75*1000/1500, sel, 0.01)
+
+	sel, ok = estimateSelectivityByHistogram(s, "a", "between", types.T_int64, []*Const{lit(100), lit(199)})
+	require.True(t, ok)
+	require.InDelta(t, 0.75*100/1500, sel, 0.01)
+
+	sel, ok = estimateSelectivityByHistogram(s, "a", "=", types.T_int64, []*Const{lit(5000)})
+	require.True(t, ok)
+	require.InDelta(t, 0.75/1500, sel, 1e-9)
+
+	sel, ok = estimateSelectivityByHistogram(s, "a", ">", types.T_int64, []*Const{lit(5000)})
+	require.True(t, ok)
+	require.InDelta(t, 0.75/1500, sel, 1e-9)
+
+	sel, ok = estimateSelectivityByHistogram(s, "a", "between", types.T_int64, []*Const{lit(-10), lit(-1)})
+	require.True(t, ok)
+	require.InDelta(t, 0.75/1500, sel, 1e-9)
+
+	_, ok = estimateSelectivityByHistogram(s, "b", "=", types.T_int64, []*Const{lit(7)})
+	require.False(t, ok)
+	_, ok = estimateSelectivityByHistogram(s, "a", "like", types.T_int64, []*Const{lit(7)})
+	require.False(t, ok)
+}
@@ -223,6 +223,11 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 	leaves, conds := builder.gatherJoinLeavesAndConds(node, nil, nil)
 	newConds := deduceNewOnList(conds)
 	conds = append(conds, newConds...)
+
+	if leadingID := builder.leadingJoinTree(leaves, conds); leadingID != -1 {
+		return builder.pushdownJoinConds(leadingID, conds)
+	}
+
 	vertices := builder.getJoinGraph(leaves, conds)
 
 	subTrees := make([]*plan.Node, 0, len(leaves))
@@ -327,6 +332,12 @@ func (builder *QueryBuilder) determineJoinOrder(nodeID int32) int32 {
 		}
 	}
 
+	return builder.pushdownJoinConds(nodeID, conds)
+}
+
+// pushdownJoinConds pushes the conditions into the reordered join tree, the
+// ones which cannot be pushed down are kept in a filter on top of it.
+func (builder *QueryBuilder) pushdownJoinConds(nodeID int32, conds []*plan.Expr) int32 {
 	nodeID, conds = builder.pushdownFilters(nodeID, conds, true)
 	if len(conds) > 0 {
 		nodeID = builder.appendNode(&plan.Node{
@@ -557,7 +568,7 @@ func (builder *QueryBuilder) enumerateTags(nodeID int32) []int32 {
 	node := builder.qry.Nodes[nodeID]
 	if len(node.BindingTags) > 0 {
 		tags = append(tags, node.BindingTags...)
-		if node.NodeType != plan.Node_JOIN {
+		if node.NodeType != plan.Node_JOIN && !isJsonTableScan(node) {
 			return tags
 		}
 	}
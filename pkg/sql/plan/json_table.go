@@ -0,0 +1,191 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// JsonTableColumn is the column definition of json_table passed to the
+// executor as the parameter of the table function. The parameter itself is
+// a NESTED PATH column of the row path.
+type JsonTableColumn struct {
+	Kind    tree.JsonTableColumnKind  `json:"kind"`
+	Name    string                    `json:"name,omitempty"`
+	Path    string                    `json:"path,omitempty"`
+	OnEmpty *tree.JsonTableOnResponse `json:"onEmpty,omitempty"`
+	OnError *tree.JsonTableOnResponse `json:"onError,omitempty"`
+	Columns []*JsonTableColumn        `json:"columns,omitempty"`
+}
+
+var jsonTableColumnTypes = map[types.T]bool{
+	types.T_bool:       true,
+	types.T_int8:       true,
+	types.T_int16:      true,
+	types.T_int32:      true,
+	types.T_int64:      true,
+	types.T_uint8:      true,
+	types.T_uint16:     true,
+	types.T_uint32:     true,
+	types.T_uint64:     true,
+	types.T_float32:    true,
+	types.T_float64:    true,
+	types.T_decimal64:  true,
+	types.T_decimal128: true,
+	types.T_char:       true,
+	types.T_varchar:    true,
+	types.T_text:       true,
+	types.T_json:       true,
+	types.T_date:       true,
+	types.T_datetime:   true,
+	types.T_timestamp:  true,
+	types.T_time:       true,
+}
+
+// isJsonTableScan returns true if the node is a json_table scan, which
+// passes the columns of its child through after its own columns, so that
+// the child is the outer row of the lateral correlation.
+func isJsonTableScan(node *plan.Node) bool {
+	return node.NodeType == plan.Node_FUNCTION_SCAN && node.TableDef.GetTblFunc().GetName() == "json_table"
+}
+
+func (builder *QueryBuilder) buildJsonTable(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	docTyp := types.T(exprs[0].Typ.Id)
+	if docTyp != types.T_json && !docTyp.IsMySQLString() && docTyp != types.T_any {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table: the document must be json or string, but got %s", docTyp.String())
+	}
+	rowPath := exprs[1].GetLit().GetSval()
+	if _, err := types.ParseStringToPath(rowPath); err != nil {
+		return 0, err
+	}
+
+	var colDefs []*plan.ColDef
+	columns, err := builder.buildJsonTableColumns(tbl.JsonColumns, &colDefs, make(map[string]bool))
+	if err != nil {
+		return 0, err
+	}
+	param, err := json.Marshal(&JsonTableColumn{
+		Kind:    tree.JsonTableColumnNested,
+		Path:    rowPath,
+		Columns: columns,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  "json_table",
+				Param: param,
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: exprs[:1],
+		Children:        []int32{childId},
+	}
+	return builder.appendNode(node, ctx), nil
+}
+
+// buildJsonTableColumns flattens the columns of all the NESTED PATH into
+// colDefs in the order of definition.
+func (builder *QueryBuilder) buildJsonTableColumns(cols tree.JsonTableColumns, colDefs *[]*plan.ColDef, names map[string]bool) ([]*JsonTableColumn, error) {
+	ret := make([]*JsonTableColumn, 0, len(cols))
+	for _, col := range cols {
+		if _, err := types.ParseStringToPath(col.Path); col.Kind != tree.JsonTableColumnOrdinality && err != nil {
+			return nil, err
+		}
+		column := &JsonTableColumn{
+			Kind:    col.Kind,
+			Name:    string(col.Name),
+			Path:    col.Path,
+			OnEmpty: col.OnEmpty,
+			OnError: col.OnError,
+		}
+		ret = append(ret, column)
+
+		if col.Kind == tree.JsonTableColumnNested {
+			var err error
+			if column.Columns, err = builder.buildJsonTableColumns(col.Columns, colDefs, names); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if names[column.Name] {
+			return nil, moerr.NewInvalidInput(builder.GetContext(), "json_table: duplicate column name '%s'", column.Name)
+		}
+		names[column.Name] = true
+
+		var typ plan.Type
+		if col.Kind == tree.JsonTableColumnOrdinality {
+			ordTyp := types.T_uint32.ToType()
+			typ = makePlan2Type(&ordTyp)
+		} else {
+			var err error
+			if typ, err = getTypeFromAst(builder.GetContext(), col.Type); err != nil {
+				return nil, err
+			}
+			if !jsonTableColumnTypes[types.T(typ.Id)] {
+				return nil, moerr.NewNotSupported(builder.GetContext(), "json_table: column '%s' of type %s", column.Name, types.T(typ.Id).String())
+			}
+		}
+		*colDefs = append(*colDefs, &plan.ColDef{
+			Name: column.Name,
+			Typ:  typ,
+		})
+	}
+	return ret, nil
+}
+
+// buildJsonTableJoinCond turns the join of the left table and json_table into
+// a filter on the json_table scan, which already joins each row of the left
+// table with its rows. Only inner and cross joins get here: a left table row
+// with a NULL document or no match has no rows, and ON only filters.
+func (builder *QueryBuilder) buildJsonTableJoinCond(tbl *tree.JoinTableExpr, nodeID int32, ctx *BindContext) (int32, error) {
+	if tbl.JoinType == tree.JOIN_TYPE_NATURAL {
+		return 0, moerr.NewNotSupported(builder.GetContext(), "natural join with json_table")
+	}
+
+	var conds []*plan.Expr
+	switch cond := tbl.Cond.(type) {
+	case *tree.OnJoinCond:
+		var err error
+		ctx.binder = NewTableBinder(builder, ctx)
+		if conds, err = splitAndBindCondition(cond.Expr, NoAlias, ctx); err != nil {
+			return 0, err
+		}
+	case *tree.UsingJoinCond:
+		return 0, moerr.NewNotSupported(builder.GetContext(), "join with json_table using columns")
+	}
+
+	if len(conds) == 0 {
+		return nodeID, nil
+	}
+	return builder.appendNode(&plan.Node{
+		NodeType:   plan.Node_FILTER,
+		Children:   []int32{nodeID},
+		FilterList: conds,
+	}, ctx), nil
+}
@@ -39,6 +39,7 @@ type MockCompilerContext struct {
 	id2name         map[uint64]string
 	isDml           bool
 	mysqlCompatible bool
+	warnings        []error
 
 	// ctx default: nil
 	ctx context.Context
@@ -55,6 +56,10 @@ func (m *MockCompilerContext) GetViews() []string {
 func (m *MockCompilerContext) SetViews(views []string) {
 }
 
+func (m *MockCompilerContext) AddWarning(warn error) {
+	m.warnings = append(m.warnings, warn)
+}
+
 func (m *MockCompilerContext) GetSnapshot() *Snapshot {
 	return nil
 }
@@ -188,6 +193,17 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 		pks:    []int{0},
 		outcnt: 25,
 	}
+	for _, name := range []string{"test_idx_name", "test_idx_name_key"} {
+		tpchSchema[catalog.SecondaryIndexTableNamePrefix+name] = &Schema{
+			cols: []col{
+				{catalog.IndexTableIndexColName, types.T_varchar, false, 65535, 0},
+				{catalog.IndexTablePrimaryColName, types.T_int32, false, 0, 0},
+				{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+			},
+			pks:    []int{0},
+			outcnt: 25,
+		}
+	}
 	tpchSchema["region"] = &Schema{
 		cols: []col{
 			{"r_regionkey", types.T_int32, false, 0, 0},
@@ -364,6 +380,13 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 			{"creator", types.T_int32, false, 50, 0},
 			{"owner", types.T_int32, false, 50, 0},
 			{"default_role", types.T_int32, false, 50, 0},
+			{"pg_auth_string", types.T_varchar, false, 300, 0},
+			{"auth_plugin", types.T_varchar, false, 64, 0},
+			{"sha2_auth_string", types.T_varchar, false, 128, 0},
+			{"password_last_changed", types.T_timestamp, false, 0, 0},
+			{"password_history", types.T_text, false, 0, 0},
+			{"failed_logins", types.T_uint32, false, 0, 0},
+			{"locked_time", types.T_timestamp, false, 0, 0},
 			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
 		},
 		pks: []int{0},
@@ -826,7 +849,20 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 					IndexTableName: "nation",
 					TableExist:     true,
 				}
-				tableDef.Indexes = []*plan.IndexDef{p}
+				// secondary indexes on n_name, the primary key is the last part.
+				nameIdx := &plan.IndexDef{
+					IndexName:      "idx_name",
+					Parts:          []string{"n_name", catalog.CreateAlias("n_nationkey")},
+					IndexTableName: catalog.SecondaryIndexTableNamePrefix + "test_idx_name",
+					TableExist:     true,
+				}
+				nameKeyIdx := &plan.IndexDef{
+					IndexName:      "idx_name_key",
+					Parts:          []string{"n_name", "n_nationkey"},
+					IndexTableName: catalog.SecondaryIndexTableNamePrefix + "test_idx_name_key",
+					TableExist:     true,
+				}
+				tableDef.Indexes = []*plan.IndexDef{p, nameIdx, nameKeyIdx}
 			}
 
 			if tableName == "v1" {
@@ -580,7 +580,7 @@ func (builder *QueryBuilder) rewriteEffectlessAggToProject(nodeID int32) {
 	if node.NodeType != plan.Node_AGG {
 		return
 	}
-	if node.AggList != nil || node.ProjectList != nil || node.FilterList != nil {
+	if node.AggList != nil || node.ProjectList != nil || node.FilterList != nil || node.GroupingIds != nil {
 		return
 	}
 	scan := builder.qry.Nodes[node.Children[0]]
@@ -18,10 +18,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
 )
 
@@ -132,6 +134,11 @@ func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr,
 		return nil, err
 	}
 	w.Name = funcName
+	w.FromLast = ws.FromLast
+	w.IgnoreNulls = ws.IgnoreNulls
+	if err = b.checkWinFuncOffset(funcName, w.WindowFunc.GetF()); err != nil {
+		return nil, err
+	}
 	// partition by
 	for _, group := range ws.PartitionBy {
 		expr, err := b.BindExpr(group, depth, isRoot)
@@ -210,7 +217,7 @@ func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr,
 		if len(w.OrderBy) != 1 && isNRange(ws.Frame) {
 			return nil, moerr.NewParseError(b.GetContext(), "Window '<unnamed window>' with RANGE N PRECEDING/FOLLOWING frame requires exactly one ORDER BY expression, of numeric or temporal type")
 		}
-		if len(w.OrderBy) == 0 {
+		if len(w.OrderBy) == 0 || (!isNRange(ws.Frame) && ignoresFrame(funcName)) {
 			// not N range
 			break
 		}
@@ -252,6 +259,47 @@ func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr,
 	}, nil
 }
 
+// checkWinFuncOffset checks that the offset of LAG, LEAD and NTH_VALUE and the
+// number of buckets of NTILE are integer constants in range.
+func (b *ProjectionBinder) checkWinFuncOffset(funcName string, f *plan.Function) error {
+	pos, minVal, kind := 1, int64(0), "non-negative"
+	switch funcName {
+	case "lag", "lead":
+	case "nth_value":
+		minVal, kind = 1, "positive"
+	case "ntile":
+		pos, minVal, kind = 0, 1, "positive"
+	default:
+		return nil
+	}
+	if f == nil || len(f.Args) <= pos {
+		return nil
+	}
+
+	if rule.IsConstant(f.Args[pos], false) {
+		proc := b.builder.compCtx.GetProcess()
+		vec, err := colexec.EvalExpressionOnce(proc, f.Args[pos], []*batch.Batch{batch.EmptyForConstFoldBatch})
+		if err != nil {
+			return err
+		}
+		defer vec.Free(proc.Mp())
+		if !vec.IsConstNull() && vector.MustFixedCol[int64](vec)[0] >= minVal {
+			return nil
+		}
+	}
+	return moerr.NewInvalidInput(b.GetContext(), "the argument #%d of %s must be a %s integer constant", pos+1, funcName, kind)
+}
+
+// ignoresFrame reports whether the window function works on the whole
+// partition whatever the frame is.
+func ignoresFrame(funcName string) bool {
+	switch funcName {
+	case "lag", "lead", "ntile", "percent_rank", "cume_dist":
+		return true
+	}
+	return function.GetFunctionIsWinOrderFunByName(funcName)
+}
+
 func isNRange(f *tree.FrameClause) bool {
 	if f.Start.Expr == nil && f.End.Expr == nil {
 		return false
@@ -33,7 +33,8 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 		aggregateTag := node.BindingTags[1]
 
 		for _, filter := range filters {
-			if !containsTag(filter, aggregateTag) {
+			// with grouping sets, a group by column is null in the sets without it
+			if !containsTag(filter, aggregateTag) && (len(node.GroupingIds) == 0 || !containsTag(filter, groupTag)) {
 				canPushdown = append(canPushdown, replaceColRefs(filter, groupTag, node.GroupBy))
 			} else {
 				node.FilterList = append(node.FilterList, filter)
@@ -455,16 +456,27 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr,
 	case plan.Node_FUNCTION_SCAN:
 		downFilters := make([]*plan.Expr, 0)
 		selfFilters := make([]*plan.Expr, 0)
+		jsonTable := isJsonTableScan(node)
 		for _, filter := range filters {
 			if onlyContainsTag(filter, node.BindingTags[0]) {
 				selfFilters = append(selfFilters, DeepCopyExpr(filter))
+			} else if jsonTable && containsTag(filter, node.BindingTags[0]) {
+				// the filter also refers to the outer row passed through json_table
+				cantPushdown = append(cantPushdown, filter)
 			} else {
 				downFilters = append(downFilters, DeepCopyExpr(filter))
 			}
 		}
 		node.FilterList = append(node.FilterList, selfFilters...)
 		childId := node.Children[0]
-		childId, _ = builder.pushdownFilters(childId, downFilters, separateNonEquiConds)
+		childId, cantPushdownChild := builder.pushdownFilters(childId, downFilters, separateNonEquiConds)
+		if jsonTable && len(cantPushdownChild) > 0 {
+			childId = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{childId},
+				FilterList: cantPushdownChild,
+			}, nil)
+		}
 		node.Children[0] = childId
 	default:
 		if len(node.Children) > 0 {
@@ -62,6 +62,7 @@ func NewQueryBuilder(queryType plan.Query_StatementType, ctx CompilerContext, is
 		nextTag:            0,
 		mysqlCompatible:    mysqlCompatible,
 		tag2Table:          make(map[int32]*TableDef),
+		aliasByTag:         make(map[int32]string),
 		isPrepareStatement: isPrepareStatement,
 		deleteNode:         make(map[uint64]int32),
 		skipStats:          skipStats,
@@ -283,6 +284,28 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, step int32, colRefCnt
 			}
 		}
 
+		if isJsonTableScan(node) {
+			// the columns of the outer row follow the columns of json_table
+			colCnt := int32(len(node.TableDef.Cols))
+			for i, globalRef := range childMap.localToGlobal {
+				if colRefCnt[globalRef] == 0 {
+					continue
+				}
+
+				remapping.addColRef(globalRef)
+
+				node.ProjectList = append(node.ProjectList, &plan.Expr{
+					Typ: childNode.ProjectList[i].Typ,
+					Expr: &plan.Expr_Col{
+						Col: &plan.ColRef{
+							RelPos: 0,
+							ColPos: colCnt + int32(i),
+						},
+					},
+				})
+			}
+		}
+
 	case plan.Node_TABLE_SCAN, plan.Node_MATERIAL_SCAN, plan.Node_EXTERNAL_SCAN, plan.Node_SOURCE_SCAN:
 		for _, expr := range node.FilterList {
 			increaseRefCnt(expr, 1, colRefCnt)
@@ -1497,7 +1520,10 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		builder.rewriteDistinctToAGG(rootID)
 		builder.rewriteEffectlessAggToProject(rootID)
 		rootID = builder.optimizeFilters(rootID)
-		builder.pushdownLimitToTableScan(rootID)
+		noPushdown := builder.noPushdownHint()
+		if !noPushdown {
+			builder.pushdownLimitToTableScan(rootID)
+		}
 
 		colRefCnt := make(map[[2]int32]int)
 		builder.countColRefs(rootID, colRefCnt)
@@ -1508,21 +1534,28 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		tagCnt := make(map[int32]int)
 		rootID = builder.removeEffectlessLeftJoins(rootID, tagCnt)
 		ReCalcNodeStats(rootID, builder, true, false, true)
-		builder.pushdownTopThroughLeftJoin(rootID)
-		ReCalcNodeStats(rootID, builder, true, false, true)
+		if !noPushdown {
+			builder.pushdownTopThroughLeftJoin(rootID)
+			ReCalcNodeStats(rootID, builder, true, false, true)
 
-		rootID = builder.aggPushDown(rootID)
-		ReCalcNodeStats(rootID, builder, true, false, true)
+			rootID = builder.aggPushDown(rootID)
+			ReCalcNodeStats(rootID, builder, true, false, true)
+		}
 		rootID = builder.determineJoinOrder(rootID)
 		colMap := make(map[[2]int32]int)
 		colGroup := make([]int, 0)
 		builder.removeRedundantJoinCond(rootID, colMap, colGroup)
 		ReCalcNodeStats(rootID, builder, true, false, true)
-		rootID = builder.applyAssociativeLaw(rootID)
+		// the associative law would reorder the joins given by the LEADING hint
+		if builder.queryHints == nil || !builder.queryHints.leadingApplied {
+			rootID = builder.applyAssociativeLaw(rootID)
+		}
 		builder.determineBuildAndProbeSide(rootID, true)
 		rootID = builder.aggPullup(rootID, rootID)
 		ReCalcNodeStats(rootID, builder, true, false, true)
-		rootID = builder.pushdownSemiAntiJoins(rootID)
+		if !noPushdown {
+			rootID = builder.pushdownSemiAntiJoins(rootID)
+		}
 		builder.optimizeDistinctAgg(rootID)
 		ReCalcNodeStats(rootID, builder, true, false, true)
 		builder.determineBuildAndProbeSide(rootID, true)
@@ -1547,7 +1580,9 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		// after determine shuffle, be careful when calling ReCalcNodeStats again.
 		// needResetHashMapStats should always be false from here
 
-		builder.generateRuntimeFilters(rootID)
+		if !builder.noRuntimeFilterHint() {
+			builder.generateRuntimeFilters(rootID)
+		}
 		ReCalcNodeStats(rootID, builder, true, false, false)
 		builder.forceJoinOnOneCN(rootID, false)
 		// after this ,never call ReCalcNodeStats again !!!
@@ -1592,6 +1627,8 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 	//for i := 1; i < len(builder.qry.Steps); i++ {
 	//	builder.remapSinkScanColRefs(builder.qry.Steps[i], int32(i), sinkColRef)
 	//}
+	builder.checkForcedIndexes()
+	builder.checkQueryHints()
 	builder.hintQueryType()
 	return builder.qry, nil
 }
@@ -1943,8 +1980,15 @@ func (builder *QueryBuilder) buildUnion(stmt *tree.UnionClause, astOrderBy tree.
 }
 
 const NameGroupConcat = "group_concat"
+const NameJsonArrayAgg = "json_arrayagg"
 const NameClusterCenters = "cluster_centers"
 
+// isForceWindowAgg returns true if the ORDER BY of the aggregate function is
+// done by forcing it to be a window function.
+func isForceWindowAgg(name string) bool {
+	return name == NameGroupConcat || name == NameJsonArrayAgg
+}
+
 func (bc *BindContext) generateForceWinSpecList() ([]*plan.Expr, error) {
 	windowsSpecList := make([]*plan.Expr, 0, len(bc.aggregates))
 	j := 0
@@ -1963,7 +2007,7 @@ func (bc *BindContext) generateForceWinSpecList() ([]*plan.Expr, error) {
 		windowExpr.Typ = bc.aggregates[i].Typ
 		windowSpec.Name = bc.aggregates[i].GetF().Func.ObjName
 
-		if windowSpec.Name == NameGroupConcat {
+		if isForceWindowAgg(windowSpec.Name) {
 			if j < len(bc.windows)-1 {
 				j++
 			}
@@ -1978,6 +2022,11 @@ func (bc *BindContext) generateForceWinSpecList() ([]*plan.Expr, error) {
 }
 
 func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, isRoot bool) (int32, error) {
+	// the outermost query block is built first, the hints of the other blocks
+	// would apply to the whole query, so they are ignored.
+	outermost := !builder.queryBlockBuilt
+	builder.queryBlockBuilt = true
+
 	// preprocess CTEs
 	if stmt.With != nil {
 		ctx.cteByName = make(map[string]*CTERef)
@@ -2138,6 +2187,11 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	switch selectClause := stmt.Select.(type) {
 	case *tree.SelectClause:
 		clause = selectClause
+		if outermost {
+			builder.addQueryHints(selectClause.Hints)
+		} else {
+			builder.ignoreQueryHints(selectClause.Hints)
+		}
 	case *tree.UnionClause:
 		if builder.isForUpdate {
 			return 0, moerr.NewInternalError(builder.GetContext(), "not support select union for update")
@@ -2381,15 +2435,21 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 				return 0, moerr.NewParseError(builder.GetContext(), "not support group by in recursive cte: '%v'", tree.String(&clause.GroupBy, dialect.MYSQL))
 			}
 			groupBinder := NewGroupBinder(builder, ctx, selectList)
-			for _, group := range clause.GroupBy {
-				group, err = ctx.qualifyColumnNames(group, AliasAfterColumn)
-				if err != nil {
+			if clause.GroupBy.HasGroupingSets() {
+				if err = builder.bindGroupingSets(ctx, clause.GroupBy, groupBinder); err != nil {
 					return 0, err
 				}
+			} else {
+				for _, group := range clause.GroupBy {
+					group, err = ctx.qualifyColumnNames(group, AliasAfterColumn)
+					if err != nil {
+						return 0, err
+					}
 
-				_, err = groupBinder.BindExpr(group, 0, true)
-				if err != nil {
-					return 0, err
+					_, err = groupBinder.BindExpr(group, 0, true)
+					if err != nil {
+						return 0, err
+					}
 				}
 			}
 		}
@@ -2646,6 +2706,10 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}
 	}
 
+	if ctx.groupingIds != nil && (ctx.forceWindows || ctx.sampleFunc.hasSampleFunc) {
+		return 0, moerr.NewNYI(builder.GetContext(), "grouping sets with sample or group_concat ... order by")
+	}
+
 	if ctx.forceWindows {
 		ctx.tmpGroups = ctx.groups
 		ctx.windows, _ = ctx.generateForceWinSpecList()
@@ -2716,6 +2780,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 					NodeType:    plan.Node_AGG,
 					Children:    []int32{nodeID},
 					GroupBy:     ctx.groups,
+					GroupingIds: ctx.groupingIds,
 					AggList:     ctx.aggregates,
 					BindingTags: []int32{ctx.groupTag, ctx.aggregateTag},
 				}, ctx)
@@ -3703,6 +3768,12 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 		//can only read its own data.
 
 		if midNode.NodeType == plan.Node_TABLE_SCAN {
+			if len(tbl.IndexHints) > 0 {
+				if err := builder.addIndexHints(midNode, tbl.IndexHints); err != nil {
+					return 0, err
+				}
+			}
+
 			dbName := midNode.ObjRef.SchemaName
 			tableName := midNode.TableDef.Name
 			currentAccountID, err := builder.compCtx.GetAccountId()
@@ -3917,6 +3988,7 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 
 	ctx.bindings = append(ctx.bindings, binding)
 	ctx.bindingByTag[binding.tag] = binding
+	builder.aliasByTag[binding.tag] = binding.table
 	ctx.bindingByTable[binding.table] = binding
 
 	if node.NodeType != plan.Node_RECURSIVE_SCAN && node.NodeType != plan.Node_SINK_SCAN {
@@ -3972,6 +4044,11 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 
 	if builder.qry.Nodes[rightChildID].NodeType == plan.Node_FUNCTION_SCAN {
 		if joinType != plan.Node_INNER {
+			// json_table joins the rows of the left table in its scan, the
+			// rows without a match cannot be padded with nulls there.
+			if isJsonTableScan(builder.qry.Nodes[rightChildID]) {
+				return 0, moerr.NewNYI(builder.GetContext(), "outer join with json_table")
+			}
 			return 0, moerr.NewSyntaxError(builder.GetContext(), "table function can only be used in a inner join")
 		}
 	}
@@ -3981,6 +4058,10 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		return 0, err
 	}
 
+	if rightNode := builder.qry.Nodes[rightChildID]; isJsonTableScan(rightNode) && rightNode.Children[0] == leftChildID {
+		return builder.buildJsonTableJoinCond(tbl, rightChildID, ctx)
+	}
+
 	nodeID := builder.appendNode(&plan.Node{
 		NodeType: plan.Node_JOIN,
 		Children: []int32{leftChildID, rightChildID},
@@ -4066,7 +4147,13 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		ctx.binder = NewTableBinder(builder, ctx)
 	} else {
 		ctx.binder = NewTableBinder(builder, leftCtx)
-		childId = builder.copyNode(ctx, preNodeId)
+		if tbl.Id() == "json_table" {
+			// json_table is correlated to the outer row, it is built on the left
+			// table and takes the place of the join.
+			childId = preNodeId
+		} else {
+			childId = builder.copyNode(ctx, preNodeId)
+		}
 	}
 
 	exprs := make([]*plan.Expr, 0, len(tbl.Func.Exprs))
@@ -4099,6 +4186,10 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		nodeId, err = builder.buildMoTransactions(tbl, ctx, exprs, childId)
 	case "mo_cache":
 		nodeId, err = builder.buildMoCache(tbl, ctx, exprs, childId)
+	case "mo_query_memory":
+		nodeId, err = builder.buildMoQueryMemory(tbl, ctx, exprs, childId)
+	case "json_table":
+		nodeId, err = builder.buildJsonTable(tbl, ctx, exprs, childId)
 	default:
 		err = moerr.NewNotSupported(builder.GetContext(), "table function '%s' not supported", id)
 	}
@@ -0,0 +1,298 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// queryHints holds the optimizer hints of the /*+ ... */ comments of the
+// statement. The table names of the hints are in lower case, a hint without
+// table names applies to all tables.
+type queryHints struct {
+	leading         []string
+	hashJoin        tableHint
+	noHashJoin      tableHint
+	broadcast       tableHint
+	shuffle         tableHint
+	noRuntimeFilter bool
+	noPushdown      bool
+	dop             int32
+
+	// leadingApplied is set once a join order follows the LEADING hint.
+	leadingApplied bool
+	// texts are the accepted hints, which are shown by EXPLAIN.
+	texts []string
+}
+
+// tableHint is a hint which names the tables it applies to.
+type tableHint struct {
+	set    bool
+	tables map[string]bool
+}
+
+func (h *tableHint) add(args []string) {
+	h.set = true
+	if h.tables == nil {
+		h.tables = make(map[string]bool)
+	}
+	for _, arg := range args {
+		h.tables[strings.ToLower(arg)] = true
+	}
+}
+
+// match tells whether the hint applies to the table.
+func (h *tableHint) match(table string) bool {
+	return h.set && (len(h.tables) == 0 || h.tables[table])
+}
+
+// addQueryHints accepts the hints of a statement or a query block. A hint
+// given more than once keeps its first occurrence, the unknown and malformed
+// hints are ignored with a warning.
+func (builder *QueryBuilder) addQueryHints(hints tree.OptimizerHints) {
+	if len(hints) == 0 {
+		return
+	}
+	if builder.queryHints == nil {
+		builder.queryHints = &queryHints{}
+	}
+	qh := builder.queryHints
+
+	for _, hint := range hints {
+		accepted := true
+		switch hint.Name {
+		case "MAX_EXECUTION_TIME":
+			// handled by the frontend, before the statement runs.
+			continue
+		case "LEADING":
+			if len(hint.Args) < 2 || qh.leading != nil {
+				accepted = false
+				break
+			}
+			seen := make(map[string]bool)
+			for _, arg := range hint.Args {
+				name := strings.ToLower(arg)
+				if seen[name] {
+					accepted = false
+					break
+				}
+				seen[name] = true
+			}
+			if accepted {
+				qh.leading = make([]string, len(hint.Args))
+				for i, arg := range hint.Args {
+					qh.leading[i] = strings.ToLower(arg)
+				}
+			}
+		case "HASH_JOIN":
+			qh.hashJoin.add(hint.Args)
+		case "NO_HASH_JOIN":
+			qh.noHashJoin.add(hint.Args)
+		case "BROADCAST":
+			accepted = len(hint.Args) > 0
+			if accepted {
+				qh.broadcast.add(hint.Args)
+			}
+		case "SHUFFLE":
+			accepted = len(hint.Args) > 0
+			if accepted {
+				qh.shuffle.add(hint.Args)
+			}
+		case "NO_RUNTIME_FILTER":
+			accepted = len(hint.Args) == 0
+			qh.noRuntimeFilter = qh.noRuntimeFilter || accepted
+		case "NO_PUSHDOWN":
+			accepted = len(hint.Args) == 0
+			qh.noPushdown = qh.noPushdown || accepted
+		case "DOP":
+			accepted = len(hint.Args) == 1 && qh.dop == 0
+			if accepted {
+				dop, err := strconv.ParseInt(hint.Args[0], 10, 32)
+				accepted = err == nil && dop > 0
+				if accepted {
+					qh.dop = int32(dop)
+				}
+			}
+		default:
+			accepted = false
+		}
+
+		text := tree.String(hint, dialect.MYSQL)
+		if !accepted {
+			msg := fmt.Sprintf("optimizer hint '%s' is unknown or invalid, it is ignored", text)
+			builder.compCtx.AddWarning(moerr.NewWarn(builder.GetContext(), msg))
+			continue
+		}
+		qh.texts = append(qh.texts, text)
+	}
+}
+
+// ignoreQueryHints warns about the hints of a query block other than the
+// outermost one, a subquery, a derived table or a branch of a UNION.
+func (builder *QueryBuilder) ignoreQueryHints(hints tree.OptimizerHints) {
+	for _, hint := range hints {
+		msg := fmt.Sprintf("optimizer hint '%s' is only supported in the outermost query block, it is ignored",
+			tree.String(hint, dialect.MYSQL))
+		builder.compCtx.AddWarning(moerr.NewWarn(builder.GetContext(), msg))
+	}
+}
+
+// hintedTable returns the name by which the hints refer to the table scan.
+func (builder *QueryBuilder) hintedTable(node *plan.Node) string {
+	if len(node.BindingTags) == 0 {
+		return ""
+	}
+	return builder.aliasByTag[node.BindingTags[0]]
+}
+
+// hintsMatchSubtree tells whether the table hint applies to any table in the subtree.
+func (builder *QueryBuilder) hintsMatchSubtree(h *tableHint, nodeID int32) bool {
+	if !h.set {
+		return false
+	}
+	for _, tag := range builder.enumerateTags(nodeID) {
+		if name, ok := builder.aliasByTag[tag]; ok && h.match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// shuffleHinted tells whether the SHUFFLE hint applies to the join.
+func (builder *QueryBuilder) shuffleHinted(join *plan.Node) bool {
+	if builder.queryHints == nil {
+		return false
+	}
+	for _, child := range join.Children {
+		if builder.hintsMatchSubtree(&builder.queryHints.shuffle, child) {
+			return true
+		}
+	}
+	return false
+}
+
+func (builder *QueryBuilder) noPushdownHint() bool {
+	return builder.queryHints != nil && builder.queryHints.noPushdown
+}
+
+func (builder *QueryBuilder) noRuntimeFilterHint() bool {
+	return builder.queryHints != nil && builder.queryHints.noRuntimeFilter
+}
+
+// leadingJoinTree joins the leaves in the order of the LEADING hint, then the
+// remaining leaves, the ones connected by a join condition first. It returns
+// -1 if the hint does not name a distinct leaf for each of its tables.
+func (builder *QueryBuilder) leadingJoinTree(leaves []*plan.Node, conds []*plan.Expr) int32 {
+	if builder.queryHints == nil || len(builder.queryHints.leading) == 0 {
+		return -1
+	}
+
+	leafByTag := make(map[int32]int32)
+	leafByName := make(map[string]int32)
+	for i, leaf := range leaves {
+		for _, tag := range builder.enumerateTags(leaf.NodeId) {
+			leafByTag[tag] = int32(i)
+			if name, ok := builder.aliasByTag[tag]; ok {
+				leafByName[name] = int32(i)
+			}
+		}
+	}
+
+	nLeaf := int32(len(leaves))
+	visited := make([]bool, nLeaf)
+	order := make([]int32, 0, nLeaf)
+	for _, name := range builder.queryHints.leading {
+		i, ok := leafByName[name]
+		if !ok || visited[i] {
+			return -1
+		}
+		visited[i] = true
+		order = append(order, i)
+	}
+
+	adjMat := make([]bool, nLeaf*nLeaf)
+	for _, cond := range conds {
+		hyperEdge := make(map[int32]bool)
+		getHyperEdgeFromExpr(cond, leafByTag, hyperEdge)
+		for i := range hyperEdge {
+			for j := range hyperEdge {
+				adjMat[nLeaf*i+j] = true
+			}
+		}
+	}
+
+	eligible := make([]bool, nLeaf)
+	for _, i := range order {
+		for j, adj := range adjMat[i*nLeaf : (i+1)*nLeaf] {
+			eligible[j] = eligible[j] || adj
+		}
+	}
+	for int32(len(order)) < nLeaf {
+		next := int32(-1)
+		for i := range visited {
+			if !visited[i] && eligible[i] {
+				next = int32(i)
+				break
+			}
+		}
+		if next == -1 {
+			for i := range visited {
+				if !visited[i] {
+					next = int32(i)
+					break
+				}
+			}
+		}
+		visited[next] = true
+		order = append(order, next)
+		for j, adj := range adjMat[next*nLeaf : (next+1)*nLeaf] {
+			eligible[j] = eligible[j] || adj
+		}
+	}
+
+	nodeID := leaves[order[0]].NodeId
+	for _, i := range order[1:] {
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType: plan.Node_JOIN,
+			Children: []int32{nodeID, leaves[i].NodeId},
+			JoinType: plan.Node_INNER,
+		}, nil)
+	}
+	builder.queryHints.leadingApplied = true
+	return nodeID
+}
+
+// checkQueryHints warns about a LEADING hint which no join order followed,
+// and records the hints in the query for EXPLAIN and the compile.
+func (builder *QueryBuilder) checkQueryHints() {
+	qh := builder.queryHints
+	if qh == nil {
+		return
+	}
+	if len(qh.leading) > 0 && !qh.leadingApplied {
+		msg := fmt.Sprintf("optimizer hint 'LEADING(%s)' cannot be used, the query runs without it",
+			strings.Join(qh.leading, ", "))
+		builder.compCtx.AddWarning(moerr.NewWarn(builder.GetContext(), msg))
+	}
+	builder.qry.Hints = qh.texts
+	builder.qry.Dop = qh.dop
+}
@@ -310,13 +310,25 @@ func determinShuffleForJoin(n *plan.Node, builder *QueryBuilder) {
 		return
 	}
 
-	if n.BuildOnLeft {
-		if n.Stats.HashmapStats.HashmapSize < threshHoldForRightJoinShuffle {
+	// the BROADCAST hint keeps the join of the table a broadcast join, the
+	// SHUFFLE hint shuffles it whatever the size of the hashmap.
+	forced := false
+	if qh := builder.queryHints; qh != nil {
+		if builder.hintsMatchSubtree(&qh.broadcast, n.Children[0]) || builder.hintsMatchSubtree(&qh.broadcast, n.Children[1]) {
 			return
 		}
-	} else {
-		if n.Stats.HashmapStats.HashmapSize < threshHoldForRangeShuffle {
-			return
+		forced = builder.shuffleHinted(n)
+	}
+
+	if !forced {
+		if n.BuildOnLeft {
+			if n.Stats.HashmapStats.HashmapSize < threshHoldForRightJoinShuffle {
+				return
+			}
+		} else {
+			if n.Stats.HashmapStats.HashmapSize < threshHoldForRangeShuffle {
+				return
+			}
 		}
 	}
 
@@ -342,7 +354,7 @@ func determinShuffleForJoin(n *plan.Node, builder *QueryBuilder) {
 
 	//find the highest ndv
 	highestNDV := n.OnList[idx].Ndv
-	if highestNDV < ShuffleThreshHoldOfNDV {
+	if highestNDV < ShuffleThreshHoldOfNDV && !forced {
 		return
 	}
 
@@ -369,7 +381,7 @@ func determinShuffleForJoin(n *plan.Node, builder *QueryBuilder) {
 		n.Stats.HashmapStats.ShuffleColIdx = int32(idx)
 		n.Stats.HashmapStats.Shuffle = true
 		determinShuffleType(hashCol0, n, builder)
-		if n.Stats.HashmapStats.ShuffleType == plan.ShuffleType_Hash && n.Stats.HashmapStats.HashmapSize < threshHoldForHashShuffle {
+		if n.Stats.HashmapStats.ShuffleType == plan.ShuffleType_Hash && n.Stats.HashmapStats.HashmapSize < threshHoldForHashShuffle && !forced {
 			n.Stats.HashmapStats.Shuffle = false
 		}
 	}
@@ -395,7 +407,7 @@ func determinShuffleForGroupBy(n *plan.Node, builder *QueryBuilder) {
 	if n.NodeType != plan.Node_AGG {
 		return
 	}
-	if len(n.GroupBy) == 0 {
+	if len(n.GroupBy) == 0 || len(n.GroupingIds) > 0 {
 		return
 	}
 
@@ -548,7 +560,7 @@ func determineShuffleMethod2(nodeID, parentID int32, builder *QueryBuilder) {
 		if parent.NodeType == plan.Node_AGG && parent.Stats.HashmapStats.ShuffleMethod == plan.ShuffleMethod_Reuse {
 			return
 		}
-		if node.Stats.HashmapStats.HashmapSize <= threshHoldForHybirdShuffle {
+		if node.Stats.HashmapStats.HashmapSize <= threshHoldForHybirdShuffle && !builder.shuffleHinted(node) {
 			node.Stats.HashmapStats.Shuffle = false
 			if parent.NodeType == plan.Node_AGG {
 				parent.Stats.HashmapStats.ShuffleMethod = plan.ShuffleMethod_Normal
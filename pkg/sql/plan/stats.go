@@ -357,6 +357,14 @@ func estimateEqualitySelectivity(expr *plan.Expr, builder *QueryBuilder) float64
 	if col == nil {
 		return 0.01
 	}
+	if s := builder.getStatsInfoByCol(col); s != nil && len(s.HistogramMap) > 0 {
+		col, _, literals, colFnName, hasDynamicParam := extractColRefAndLiteralsInFilter(expr)
+		if col != nil && colFnName == "" && !hasDynamicParam && len(literals) == 1 {
+			if sel, ok := estimateSelectivityByHistogram(s, col.Name, "=", types.T(s.DataTypeMap[col.Name]), literals); ok {
+				return sel
+			}
+		}
+	}
 	ndv := getExprNdv(expr, builder)
 	if ndv > 0 {
 		return 1 / ndv
@@ -471,6 +479,11 @@ func estimateNonEqualitySelectivity(expr *plan.Expr, funcName string, builder *Q
 	}
 	if col != nil && len(literals) > 0 {
 		typ := types.T(s.DataTypeMap[col.Name])
+		if colFnName == "" {
+			if sel, ok := estimateSelectivityByHistogram(s, col.Name, funcName, typ, literals); ok {
+				return sel
+			}
+		}
 		if !(typ.IsInteger() || typ.IsDateRelate()) {
 			return 0.1
 		}
@@ -772,6 +785,9 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 			if outcnt > incnt {
 				outcnt = math.Min(incnt, outcnt*math.Pow(childStats.Selectivity, 0.8))
 			}
+			if len(node.GroupingIds) > 0 {
+				outcnt *= float64(len(node.GroupingIds))
+			}
 			node.Stats.Outcnt = outcnt
 			node.Stats.Cost = incnt + outcnt
 			node.Stats.HashmapStats.HashmapSize = outcnt
@@ -345,3 +345,93 @@ func (builder *QueryBuilder) buildMoCache(tbl *tree.TableFunction, ctx *BindCont
 	}
 	return builder.appendNode(node, ctx), err
 }
+
+var MoQueryMemoryColNames = []string{
+	"node_id",
+	"conn_id",
+	"session_id",
+	"account",
+	"user",
+	"statement_id",
+	"query_start",
+	"info",
+	"memory_used",
+	"memory_peak",
+	"memory_quota",
+}
+
+var MoQueryMemoryColTypes = []types.Type{
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_uint32, 0, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_text, 0, 0),
+	types.New(types.T_int64, 0, 0),
+	types.New(types.T_int64, 0, 0),
+	types.New(types.T_int64, 0, 0),
+}
+
+var MoQueryMemoryColName2Index = map[string]int32{
+	"node_id":      0,
+	"conn_id":      1,
+	"session_id":   2,
+	"account":      3,
+	"user":         4,
+	"statement_id": 5,
+	"query_start":  6,
+	"info":         7,
+	"memory_used":  8,
+	"memory_peak":  9,
+	"memory_quota": 10,
+}
+
+type MoQueryMemoryColType int32
+
+const (
+	MoQueryMemoryColTypeNodeId = iota
+	MoQueryMemoryColTypeConnId
+	MoQueryMemoryColTypeSessionId
+	MoQueryMemoryColTypeAccount
+	MoQueryMemoryColTypeUser
+	MoQueryMemoryColTypeStatementId
+	MoQueryMemoryColTypeQueryStart
+	MoQueryMemoryColTypeInfo
+	MoQueryMemoryColTypeMemoryUsed
+	MoQueryMemoryColTypeMemoryPeak
+	MoQueryMemoryColTypeMemoryQuota
+)
+
+func (builder *QueryBuilder) buildMoQueryMemory(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	var err error
+
+	colDefs := make([]*plan.ColDef, 0, len(MoQueryMemoryColNames))
+
+	for i, name := range MoQueryMemoryColNames {
+		colDefs = append(colDefs, &plan.ColDef{
+			Name: name,
+			Typ: plan.Type{
+				Id:    int32(MoQueryMemoryColTypes[i].Oid),
+				Width: MoQueryMemoryColTypes[i].Width,
+			},
+		})
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name: "mo_query_memory",
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		Children:        []int32{childId},
+		TblFuncExprList: exprs,
+	}
+	return builder.appendNode(node, ctx), err
+}
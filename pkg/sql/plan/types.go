@@ -136,6 +136,9 @@ type CompilerContext interface {
 	SetViews(views []string)
 
 	GetLowerCaseTableNames() int64
+	// AddWarning records a warning raised while building the plan,
+	// it is reported by SHOW WARNINGS.
+	AddWarning(warn error)
 }
 
 type Optimizer interface {
@@ -181,6 +184,11 @@ type QueryBuilder struct {
 	deleteNode     map[uint64]int32 //delete node in this query. key is tableId, value is the nodeId of sinkScan node in the delete plan
 	skipStats      bool
 	optimizerHints *OptimizerHints
+
+	indexHints      map[int32]*indexHints // binding tag of table scan -> USE/FORCE/IGNORE INDEX hints
+	queryHints      *queryHints           // hints of the /*+ ... */ comments of the outermost query block
+	queryBlockBuilt bool                  // the outermost query block is being built, the later ones are nested
+	aliasByTag      map[int32]string      // binding tag -> table name or alias, to match the hints
 }
 
 type OptimizerHints struct {
@@ -246,10 +254,12 @@ type BindContext struct {
 
 	groups     []*plan.Expr
 	aggregates []*plan.Expr
-	projects   []*plan.Expr
-	results    []*plan.Expr
-	windows    []*plan.Expr
-	times      []*plan.Expr
+	// groupingIds are the grouping sets of GROUP BY, see bindGroupingSets.
+	groupingIds []uint64
+	projects    []*plan.Expr
+	results     []*plan.Expr
+	windows     []*plan.Expr
+	times       []*plan.Expr
 
 	groupByAst     map[string]int32
 	aggregateByAst map[string]int32
@@ -53,6 +53,18 @@ func (m *MockCompilerContext2) EXPECT() *MockCompilerContext2MockRecorder {
 	return m.recorder
 }
 
+// AddWarning mocks base method.
+func (m *MockCompilerContext2) AddWarning(warn error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddWarning", warn)
+}
+
+// AddWarning indicates an expected call of AddWarning.
+func (mr *MockCompilerContext2MockRecorder) AddWarning(warn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWarning", reflect.TypeOf((*MockCompilerContext2)(nil).AddWarning), warn)
+}
+
 // CheckSubscriptionValid mocks base method.
 func (m *MockCompilerContext2) CheckSubscriptionValid(subName, accName, pubName string) error {
 	m.ctrl.T.Helper()
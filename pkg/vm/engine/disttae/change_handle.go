@@ -0,0 +1,442 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+)
+
+// ChangesCommitTSAttr is the name of the commit timestamp column appended to
+// the batches returned by a ChangesHandle.
+const ChangesCommitTSAttr = "__mo_commit_ts"
+
+const changesBatchRows = 8192
+
+// rowVersion identifies a single committed version of a row, it is used
+// to avoid emitting the same change twice when it is both in memory and
+// already persisted.
+type rowVersion struct {
+	rowID   types.Rowid
+	ts      types.TS
+	deleted bool
+}
+
+type changedBlock struct {
+	info       objectio.BlockInfo
+	appendable bool
+	// createTS is the commit ts of every row of a non-appendable block
+	createTS types.TS
+}
+
+type changedDelta struct {
+	loc objectio.Location
+	cts types.TS
+}
+
+// changesHandle collects the committed changes of a table from its partition
+// state. Changes come from three places:
+//  1. rows still in the logtail memory of the partition state,
+//  2. appendable blocks and delta locations that were flushed, which carry
+//     the commit ts of every row,
+//  3. non-appendable objects written by CN for bulk inserts, whose rows are
+//     all committed at the create time of the object.
+//
+// Objects produced by merges are skipped since they only rewrite existing rows.
+type changesHandle struct {
+	from, to types.TS
+	fs       fileservice.FileService
+	state    *logtailreplay.PartitionState
+
+	attrs    []string
+	colTypes []types.Type
+	seqnums  []uint16
+	pkPos    int
+
+	memIter logtailreplay.RowsIter
+	memSeen map[rowVersion]struct{}
+
+	blocks []changedBlock
+	deltas []changedDelta
+}
+
+var _ engine.ChangesHandle = new(changesHandle)
+
+func (tbl *txnTable) CollectChanges(
+	ctx context.Context,
+	from, to types.TS,
+	_ *mpool.MPool,
+) (engine.ChangesHandle, error) {
+	snapshot := types.TimestampToTS(tbl.getTxn().op.SnapshotTS())
+	if snapshot.Less(&to) {
+		return nil, moerr.NewInternalError(ctx,
+			"collect changes to %s beyond the txn snapshot %s", to.ToString(), snapshot.ToString())
+	}
+	state, err := tbl.getPartitionState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	def := tbl.GetTableDef(ctx)
+	if def.Pkey == nil || def.Pkey.PkeyColName == "" {
+		return nil, moerr.NewInternalError(ctx, "table %s has no primary key", def.Name)
+	}
+	h := &changesHandle{
+		from:    from,
+		to:      to,
+		fs:      tbl.getTxn().engine.fs,
+		state:   state,
+		pkPos:   -1,
+		memSeen: make(map[rowVersion]struct{}),
+	}
+	for _, col := range def.Cols {
+		if col.Name == catalog.Row_ID {
+			continue
+		}
+		if col.Name == def.Pkey.PkeyColName {
+			h.pkPos = len(h.attrs)
+		}
+		h.attrs = append(h.attrs, col.Name)
+		h.colTypes = append(h.colTypes, types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale))
+		h.seqnums = append(h.seqnums, uint16(col.Seqnum))
+	}
+	if h.pkPos == -1 {
+		return nil, moerr.NewInternalError(ctx, "primary key %s not found in %s", def.Pkey.PkeyColName, def.Name)
+	}
+
+	if err = h.collectPersisted(); err != nil {
+		return nil, err
+	}
+	h.memIter = state.NewChangesIter(from, to)
+	return h, nil
+}
+
+// collectPersisted finds the persisted blocks and deltas which may contain
+// changes in (from, to]. It fails with a stale error if the partition state
+// has been truncated after `from`, the caller should then start over from a
+// full snapshot.
+func (h *changesHandle) collectPersisted() error {
+	iter, err := h.state.NewObjectsIter(h.from, false)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var (
+		objects    []logtailreplay.ObjectEntry
+		mergedTSes = make(map[types.TS]struct{})
+	)
+	for iter.Next() {
+		obj := iter.Entry()
+		if obj.CreateTime.Greater(&h.to) {
+			continue
+		}
+		if !obj.DeleteTime.IsEmpty() {
+			// objects are soft deleted at the commit ts of the merge
+			mergedTSes[obj.DeleteTime] = struct{}{}
+			if obj.DeleteTime.LessEq(&h.from) {
+				continue
+			}
+		}
+		objects = append(objects, obj)
+	}
+
+	for _, obj := range objects {
+		if !obj.Appendable {
+			if obj.CreateTime.LessEq(&h.from) {
+				continue
+			}
+			if _, ok := mergedTSes[obj.CreateTime]; ok {
+				continue
+			}
+		}
+		ForeachBlkInObjStatsList(false, nil, func(blk objectio.BlockInfo, _ objectio.BlockObject) bool {
+			h.blocks = append(h.blocks, changedBlock{
+				info:       blk,
+				appendable: obj.Appendable,
+				createTS:   obj.CreateTime,
+			})
+			return true
+		}, obj.ObjectStats)
+	}
+
+	deltas := make(map[types.Blockid]logtailreplay.BlockDeltaInfo)
+	if err = h.state.GetTombstoneDeltaLocs(deltas); err != nil {
+		return err
+	}
+	for _, delta := range deltas {
+		if delta.Cts.LessEq(&h.from) {
+			continue
+		}
+		h.deltas = append(h.deltas, changedDelta{
+			loc: delta.Loc,
+			cts: delta.Cts,
+		})
+	}
+	return nil
+}
+
+func (h *changesHandle) newDataBatch() *batch.Batch {
+	bat := batch.NewWithSize(len(h.attrs) + 1)
+	bat.Attrs = append(append(bat.Attrs[:0], h.attrs...), ChangesCommitTSAttr)
+	for i, typ := range h.colTypes {
+		bat.Vecs[i] = vector.NewVec(typ)
+	}
+	bat.Vecs[len(h.attrs)] = vector.NewVec(types.T_TS.ToType())
+	return bat
+}
+
+func (h *changesHandle) newTombstoneBatch() *batch.Batch {
+	bat := batch.NewWithSize(2)
+	bat.Attrs = append(bat.Attrs[:0], h.attrs[h.pkPos], ChangesCommitTSAttr)
+	bat.Vecs[0] = vector.NewVec(h.colTypes[h.pkPos])
+	bat.Vecs[1] = vector.NewVec(types.T_TS.ToType())
+	return bat
+}
+
+func (h *changesHandle) Next(
+	ctx context.Context,
+	mp *mpool.MPool,
+) (data *batch.Batch, tombstone *batch.Batch, err error) {
+	// the memory rows go first, so that the persisted changes can be
+	// deduplicated against them.
+	if h.memIter != nil {
+		if data, tombstone, err = h.nextInMemory(mp); err != nil || data != nil || tombstone != nil {
+			return
+		}
+		_ = h.memIter.Close()
+		h.memIter = nil
+	}
+	for len(h.blocks) > 0 {
+		blk := h.blocks[0]
+		h.blocks = h.blocks[1:]
+		if data, err = h.readBlock(ctx, blk, mp); err != nil || data != nil {
+			return
+		}
+	}
+	for len(h.deltas) > 0 {
+		delta := h.deltas[0]
+		h.deltas = h.deltas[1:]
+		if tombstone, err = h.readDelta(ctx, delta, mp); err != nil || tombstone != nil {
+			return
+		}
+	}
+	return nil, nil, nil
+}
+
+func (h *changesHandle) nextInMemory(mp *mpool.MPool) (data *batch.Batch, tombstone *batch.Batch, err error) {
+	defer func() {
+		if err != nil {
+			if data != nil {
+				data.Clean(mp)
+			}
+			if tombstone != nil {
+				tombstone.Clean(mp)
+			}
+			data, tombstone = nil, nil
+		}
+	}()
+
+	rows := 0
+	for rows < changesBatchRows && h.memIter.Next() {
+		entry := h.memIter.Entry()
+		h.memSeen[rowVersion{rowID: entry.RowID, ts: entry.Time, deleted: entry.Deleted}] = struct{}{}
+		if entry.Batch == nil {
+			continue
+		}
+		if entry.Deleted {
+			// deletes carry rowid, commit ts and the primary key
+			if len(entry.Batch.Vecs) < 3 {
+				continue
+			}
+			if tombstone == nil {
+				tombstone = h.newTombstoneBatch()
+			}
+			if err = tombstone.Vecs[0].UnionOne(entry.Batch.Vecs[2], entry.Offset, mp); err != nil {
+				return
+			}
+			if err = vector.AppendFixed(tombstone.Vecs[1], entry.Time, false, mp); err != nil {
+				return
+			}
+			rows++
+			continue
+		}
+		if data == nil {
+			data = h.newDataBatch()
+		}
+		for i, seqnum := range h.seqnums {
+			idx := 2 /*rowid and commits*/ + int(seqnum)
+			if idx >= len(entry.Batch.Vecs) /*add column*/ ||
+				entry.Batch.Attrs[idx] == "" /*drop column*/ {
+				err = vector.AppendAny(data.Vecs[i], nil, true, mp)
+			} else {
+				err = data.Vecs[i].UnionOne(entry.Batch.Vecs[idx], entry.Offset, mp)
+			}
+			if err != nil {
+				return
+			}
+		}
+		if err = vector.AppendFixed(data.Vecs[len(h.seqnums)], entry.Time, false, mp); err != nil {
+			return
+		}
+		rows++
+	}
+	if data != nil {
+		data.SetRowCount(data.Vecs[0].Length())
+	}
+	if tombstone != nil {
+		tombstone.SetRowCount(tombstone.Vecs[0].Length())
+	}
+	return
+}
+
+func (h *changesHandle) readBlock(
+	ctx context.Context,
+	blk changedBlock,
+	mp *mpool.MPool,
+) (data *batch.Batch, err error) {
+	seqnums := h.seqnums
+	if blk.appendable {
+		seqnums = append(append([]uint16{}, h.seqnums...), objectio.SEQNUM_COMMITTS, objectio.SEQNUM_ABORT)
+	}
+	loaded, release, err := blockio.LoadColumns(ctx, seqnums, h.colTypes, h.fs, blk.info.MetaLocation(), nil, fileservice.Policy(0))
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	var (
+		commits []types.TS
+		aborts  []bool
+		rows    = loaded.Vecs[0].Length()
+	)
+	if blk.appendable {
+		commits = vector.MustFixedCol[types.TS](loaded.Vecs[len(h.seqnums)])
+		aborts = vector.MustFixedCol[bool](loaded.Vecs[len(h.seqnums)+1])
+	}
+
+	sels := make([]int64, 0, rows)
+	tss := make([]types.TS, 0, rows)
+	for i := 0; i < rows; i++ {
+		ts := blk.createTS
+		if blk.appendable {
+			if aborts[i] {
+				continue
+			}
+			ts = commits[i]
+		}
+		if ts.LessEq(&h.from) || ts.Greater(&h.to) {
+			continue
+		}
+		rowID := *types.NewRowid(&blk.info.BlockID, uint32(i))
+		if _, ok := h.memSeen[rowVersion{rowID: rowID, ts: ts}]; ok {
+			continue
+		}
+		sels = append(sels, int64(i))
+		tss = append(tss, ts)
+	}
+	if len(sels) == 0 {
+		return nil, nil
+	}
+
+	data = h.newDataBatch()
+	for i := range h.seqnums {
+		if err = data.Vecs[i].Union(loaded.Vecs[i], sels, mp); err != nil {
+			data.Clean(mp)
+			return nil, err
+		}
+	}
+	if err = vector.AppendFixedList(data.Vecs[len(h.seqnums)], tss, nil, mp); err != nil {
+		data.Clean(mp)
+		return nil, err
+	}
+	data.SetRowCount(len(sels))
+	return data, nil
+}
+
+func (h *changesHandle) readDelta(
+	ctx context.Context,
+	delta changedDelta,
+	mp *mpool.MPool,
+) (tombstone *batch.Batch, err error) {
+	deletes, persistedByCN, release, err := blockio.ReadBlockDelete(ctx, delta.loc, h.fs)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// deletes persisted by CN only have rowid and primary key, they
+	// are all committed at the commit ts of the location.
+	pkIdx := 2
+	if persistedByCN {
+		pkIdx = 1
+	}
+	rowIDs := vector.MustFixedCol[types.Rowid](deletes.Vecs[0])
+	sels := make([]int64, 0, len(rowIDs))
+	tss := make([]types.TS, 0, len(rowIDs))
+	for i, rowID := range rowIDs {
+		ts := delta.cts
+		if !persistedByCN {
+			if vector.GetFixedAt[bool](deletes.Vecs[3], i) {
+				continue
+			}
+			ts = vector.GetFixedAt[types.TS](deletes.Vecs[1], i)
+		}
+		if ts.LessEq(&h.from) || ts.Greater(&h.to) {
+			continue
+		}
+		if _, ok := h.memSeen[rowVersion{rowID: rowID, ts: ts, deleted: true}]; ok {
+			continue
+		}
+		sels = append(sels, int64(i))
+		tss = append(tss, ts)
+	}
+	if len(sels) == 0 {
+		return nil, nil
+	}
+
+	tombstone = h.newTombstoneBatch()
+	if err = tombstone.Vecs[0].Union(deletes.Vecs[pkIdx], sels, mp); err != nil {
+		tombstone.Clean(mp)
+		return nil, err
+	}
+	if err = vector.AppendFixedList(tombstone.Vecs[1], tss, nil, mp); err != nil {
+		tombstone.Clean(mp)
+		return nil, err
+	}
+	tombstone.SetRowCount(len(sels))
+	return tombstone, nil
+}
+
+func (h *changesHandle) Close() error {
+	if h.memIter != nil {
+		_ = h.memIter.Close()
+		h.memIter = nil
+	}
+	h.blocks = nil
+	h.deltas = nil
+	return nil
+}
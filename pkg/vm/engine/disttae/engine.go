@@ -765,6 +765,10 @@ func (e *Engine) Stats(ctx context.Context, key pb.StatsInfoKey, sync bool) *pb.
 	return e.globalStats.Get(ctx, key, sync)
 }
 
+func (e *Engine) SetHistograms(key pb.StatsInfoKey, histograms map[string]*pb.Histogram) {
+	e.globalStats.SetHistograms(key, histograms)
+}
+
 func (e *Engine) GetMessageCenter() any {
 	return e.messageCenter
 }
@@ -550,3 +550,40 @@ func (p *primaryKeyDelIter) Next() bool {
 		return true
 	}
 }
+
+type changesIter struct {
+	from, to types.TS
+	iter     btree.IterG[RowEntry]
+}
+
+// NewChangesIter returns an iterator over every row version, insert or delete,
+// whose commit timestamp falls in (from, to].
+func (p *PartitionState) NewChangesIter(from, to types.TS) RowsIter {
+	return &changesIter{
+		from: from,
+		to:   to,
+		iter: p.rows.Copy().Iter(),
+	}
+}
+
+var _ RowsIter = new(changesIter)
+
+func (p *changesIter) Next() bool {
+	for p.iter.Next() {
+		entry := p.iter.Item()
+		if entry.Time.LessEq(&p.from) || entry.Time.Greater(&p.to) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func (p *changesIter) Entry() RowEntry {
+	return p.iter.Item()
+}
+
+func (p *changesIter) Close() error {
+	p.iter.Release()
+	return nil
+}
@@ -492,3 +492,90 @@ func TestPrimaryKeyModifiedWithDeleteOnly(t *testing.T) {
 	}
 
 }
+
+func TestPartitionStateChangesIter(t *testing.T) {
+	state := NewPartitionState("", false, 42)
+	ctx := context.Background()
+	pool := mpool.MustNewZero()
+	packer := types.NewPacker()
+	defer packer.Close()
+
+	const num = 128
+
+	sid := objectio.NewSegmentid()
+	buildRowID := func(i int) types.Rowid {
+		blk := objectio.NewBlockid(sid, uint16(i), 0)
+		return *objectio.NewRowid(blk, uint32(0))
+	}
+
+	{
+		// insert number i at time i with (i+1) row id
+		rowIDVec := vector.NewVec(types.T_Rowid.ToType())
+		tsVec := vector.NewVec(types.T_TS.ToType())
+		vec1 := vector.NewVec(types.T_int64.ToType())
+		for i := 0; i < num; i++ {
+			vector.AppendFixed(rowIDVec, buildRowID(i+1), false, pool)
+			vector.AppendFixed(tsVec, types.BuildTS(int64(i), 0), false, pool)
+			vector.AppendFixed(vec1, int64(i), false, pool)
+		}
+		state.HandleRowsInsert(ctx, &api.Batch{
+			Attrs: []string{"rowid", "time", "a"},
+			Vecs: []api.Vector{
+				mustVectorToProto(rowIDVec),
+				mustVectorToProto(tsVec),
+				mustVectorToProto(vec1),
+			},
+		}, 0, packer, pool)
+	}
+
+	{
+		// delete number i at time i+1
+		rowIDVec := vector.NewVec(types.T_Rowid.ToType())
+		tsVec := vector.NewVec(types.T_TS.ToType())
+		primaryKeyVec := vector.NewVec(types.T_int64.ToType())
+		for i := 0; i < num; i++ {
+			vector.AppendFixed(rowIDVec, buildRowID(i+1), false, pool)
+			vector.AppendFixed(tsVec, types.BuildTS(int64(i+1), 0), false, pool)
+			vector.AppendFixed(primaryKeyVec, int64(i), false, pool)
+		}
+		state.HandleRowsDelete(ctx, &api.Batch{
+			Attrs: []string{"rowid", "time", "i"},
+			Vecs: []api.Vector{
+				mustVectorToProto(rowIDVec),
+				mustVectorToProto(tsVec),
+				mustVectorToProto(primaryKeyVec),
+			},
+		}, packer, pool)
+	}
+
+	// (i, i+1] holds the insert of i+1 and the delete of i
+	for i := 0; i < num-1; i++ {
+		iter := state.NewChangesIter(types.BuildTS(int64(i), 0), types.BuildTS(int64(i+1), 0))
+		inserts, deletes := 0, 0
+		for iter.Next() {
+			entry := iter.Entry()
+			if entry.Deleted {
+				deletes++
+				require.Equal(t, buildRowID(i+1), entry.RowID)
+			} else {
+				inserts++
+				require.Equal(t, buildRowID(i+2), entry.RowID)
+			}
+		}
+		require.Equal(t, 1, inserts)
+		require.Equal(t, 1, deletes)
+		require.Nil(t, iter.Close())
+	}
+
+	{
+		// the whole range
+		iter := state.NewChangesIter(types.TS{}, types.BuildTS(num, 0))
+		n := 0
+		for iter.Next() {
+			n++
+		}
+		// the insert at time 0 is excluded
+		require.Equal(t, num*2-1, n)
+		require.Nil(t, iter.Close())
+	}
+}
@@ -16,17 +16,21 @@ package disttae
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"runtime"
 	"sync"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/gossip"
 	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
 	"github.com/matrixorigin/matrixone/pkg/pb/query"
@@ -34,11 +38,19 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/queryservice/client"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 )
 
+const getHistogramsSQL = "select column_name, histogram from %s.%s where account_id = %d and table_id = %d"
+
+// histogramReloadInterval is the interval after which the histograms of a
+// table are loaded again, in case the changes of mo_column_statistics were
+// missed because the table was not subscribed.
+var histogramReloadInterval = time.Minute * 30
+
 var (
 	// MinUpdateInterval is the minimal interval to update stats info as it
 	// is necessary to update stats every time.
@@ -159,6 +171,19 @@ type GlobalStats struct {
 	KeyRouter client.KeyRouter[pb.StatsInfoKey]
 
 	concurrentExecutor ConcurrentExecutor
+
+	// histMu protects the column histograms built by ANALYZE TABLE, which
+	// are loaded from mo_catalog.mo_column_statistics and attached to the
+	// stats info of the tables.
+	histMu struct {
+		sync.Mutex
+		histograms map[pb.StatsInfoKey]map[string]*pb.Histogram
+		// loaded is the time the histograms of the keys were loaded, a table
+		// without histograms is recorded too so that it is not loaded again.
+		loaded map[pb.StatsInfoKey]time.Time
+		// loading is set for the keys whose histograms are being loaded.
+		loading map[pb.StatsInfoKey]bool
+	}
 }
 
 func NewGlobalStats(
@@ -176,6 +201,9 @@ func NewGlobalStats(
 	s.updatingMu.updating = make(map[pb.StatsInfoKey]*updateRecord)
 	s.mu.statsInfoMap = make(map[pb.StatsInfoKey]*pb.StatsInfo)
 	s.mu.cond = sync.NewCond(&s.mu)
+	s.histMu.histograms = make(map[pb.StatsInfoKey]map[string]*pb.Histogram)
+	s.histMu.loaded = make(map[pb.StatsInfoKey]time.Time)
+	s.histMu.loading = make(map[pb.StatsInfoKey]bool)
 	for _, opt := range opts {
 		opt(s)
 	}
@@ -322,6 +350,11 @@ func (gs *GlobalStats) triggerUpdate(key pb.StatsInfoKey, force bool) {
 }
 
 func (gs *GlobalStats) consumeLogtail(tail *logtail.TableLogtail) {
+	if tail.Table != nil && tail.Table.DbId == catalog.MO_CATALOG_ID &&
+		tail.Table.TbName == catalog.MO_COLUMN_STATISTICS {
+		gs.histogramsChanged(tail)
+	}
+
 	key := pb.StatsInfoKey{
 		AccId:      tail.Table.AccId,
 		DatabaseID: tail.Table.DbId,
@@ -563,9 +596,157 @@ func (gs *GlobalStats) updateTableStats(key pb.StatsInfoKey) {
 		return
 	}
 	v2.StatsUpdateBlockCounter.Add(float64(stats.BlockNumber))
+	stats.HistogramMap = gs.getHistograms(key)
 	updated = true
 }
 
+// SetHistograms replaces the column histograms of the table, the stats info
+// already built is copied with the new histograms.
+func (gs *GlobalStats) SetHistograms(key pb.StatsInfoKey, histograms map[string]*pb.Histogram) {
+	gs.histMu.Lock()
+	gs.histMu.histograms[key] = histograms
+	gs.histMu.loaded[key] = time.Now()
+	gs.histMu.Unlock()
+
+	gs.mu.Lock()
+	defer gs.mu.Unlock()
+	if info := gs.mu.statsInfoMap[key]; info != nil {
+		c := *info
+		c.HistogramMap = histograms
+		gs.mu.statsInfoMap[key] = &c
+	}
+}
+
+// getHistograms returns the cached column histograms of the table. They are
+// loaded in the background the first time, and again once they are changed
+// by ANALYZE TABLE on any node, see histogramsChanged.
+func (gs *GlobalStats) getHistograms(key pb.StatsInfoKey) map[string]*pb.Histogram {
+	// the histograms themselves are stored in mo_catalog.
+	if key.DatabaseID == catalog.MO_CATALOG_ID {
+		return nil
+	}
+	gs.histMu.Lock()
+	defer gs.histMu.Unlock()
+	if at, ok := gs.histMu.loaded[key]; !ok || time.Since(at) > histogramReloadInterval {
+		gs.startLoadHistogramsLocked(key)
+	}
+	return gs.histMu.histograms[key]
+}
+
+func (gs *GlobalStats) startLoadHistogramsLocked(key pb.StatsInfoKey) {
+	if !gs.histMu.loading[key] {
+		gs.histMu.loading[key] = true
+		go gs.loadHistograms(key)
+	}
+}
+
+// histogramsChanged reloads the histograms of the tables whose rows of
+// mo_catalog.mo_column_statistics are changed by the logtail. The rows are
+// found by the primary key (account_id, table_id, column_name), and only the
+// tables with histograms loaded are reloaded, the others are loaded with
+// their stats.
+func (gs *GlobalStats) histogramsChanged(tail *logtail.TableLogtail) {
+	type tableID struct {
+		accID   uint32
+		tableID uint64
+	}
+	changed := make(map[tableID]bool)
+	for _, cmd := range tail.Commands {
+		if cmd.Bat == nil || logtailreplay.IsBlkTable(cmd.TableName) ||
+			logtailreplay.IsObjTable(cmd.TableName) || logtailreplay.IsMetaTable(cmd.TableName) {
+			continue
+		}
+		// the rows start with the row id and the commit time.
+		pkIdx := 2
+		if cmd.EntryType == api.Entry_Insert {
+			pkIdx += int(tail.Table.PrimarySeqnum)
+		}
+		if pkIdx >= len(cmd.Bat.Vecs) {
+			continue
+		}
+		vec, err := vector.ProtoVectorToVector(cmd.Bat.Vecs[pkIdx])
+		if err != nil || vec.GetType().Oid != types.T_varchar {
+			continue
+		}
+		for i := 0; i < vec.Length(); i++ {
+			tuple, err := types.Unpack(vec.GetBytesAt(i))
+			if err != nil || len(tuple) < 2 {
+				continue
+			}
+			accID, ok1 := tuple[0].(uint32)
+			tid, ok2 := tuple[1].(uint64)
+			if ok1 && ok2 {
+				changed[tableID{accID, tid}] = true
+			}
+		}
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	gs.histMu.Lock()
+	defer gs.histMu.Unlock()
+	for key := range gs.histMu.loaded {
+		if changed[tableID{key.AccId, key.TableID}] {
+			delete(gs.histMu.loaded, key)
+			gs.startLoadHistogramsLocked(key)
+		}
+	}
+}
+
+// loadHistograms reads the column histograms of the table from
+// mo_catalog.mo_column_statistics.
+func (gs *GlobalStats) loadHistograms(key pb.StatsInfoKey) {
+	defer func() {
+		gs.histMu.Lock()
+		defer gs.histMu.Unlock()
+		delete(gs.histMu.loading, key)
+	}()
+
+	v, ok := moruntime.ServiceRuntime(gs.engine.service).GetGlobalVariables(moruntime.InternalSQLExecutor)
+	if !ok {
+		return
+	}
+	exec := v.(executor.SQLExecutor)
+	ctx, cancel := context.WithTimeout(gs.ctx, time.Minute)
+	defer cancel()
+	sql := fmt.Sprintf(getHistogramsSQL, catalog.MO_CATALOG, catalog.MO_COLUMN_STATISTICS, key.AccId, key.TableID)
+	opts := executor.Options{}.
+		WithAccountID(catalog.System_Account).
+		WithDatabase(catalog.MO_CATALOG).
+		WithDisableTrace()
+	res, err := exec.Exec(ctx, sql, opts)
+	if err != nil {
+		logutil.Warnf("failed to load the histograms of table %v, err: %v", key, err)
+		return
+	}
+	defer res.Close()
+
+	var histograms map[string]*pb.Histogram
+	res.ReadRows(func(rows int, cols []*vector.Vector) bool {
+		for i := 0; i < rows; i++ {
+			h, err := plan2.HistogramFromJSON(cols[1].GetStringAt(i))
+			if err != nil {
+				logutil.Warnf("invalid histogram of table %v, err: %v", key, err)
+				continue
+			}
+			if histograms == nil {
+				histograms = make(map[string]*pb.Histogram)
+			}
+			histograms[cols[0].GetStringAt(i)] = h
+		}
+		return true
+	})
+
+	gs.histMu.Lock()
+	changed := len(histograms) != 0 || len(gs.histMu.histograms[key]) != 0
+	gs.histMu.loaded[key] = time.Now()
+	gs.histMu.Unlock()
+	if changed {
+		gs.SetHistograms(key, histograms)
+	}
+}
+
 func calcNdvUsingZonemap(zm objectio.ZoneMap, t *types.Type) float64 {
 	if !zm.IsInited() {
 		return -1 /*for new added column, its zonemap will be empty and not initialized*/
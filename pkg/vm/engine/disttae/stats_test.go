@@ -22,6 +22,12 @@ import (
 	"time"
 
 	"github.com/lni/goutils/leaktest"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/logtail"
 	"github.com/matrixorigin/matrixone/pkg/pb/statsinfo"
 	"github.com/stretchr/testify/assert"
 )
@@ -74,3 +80,49 @@ func TestGlobalStats_ShouldUpdate(t *testing.T) {
 		assert.Equal(t, 1, int(count.Load()))
 	})
 }
+
+func TestGlobalStats_Histograms(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gs := NewGlobalStats(ctx, nil, nil)
+	k1 := statsinfo.StatsInfoKey{AccId: 1, DatabaseID: 100, TableID: 101}
+	k2 := statsinfo.StatsInfoKey{AccId: 1, DatabaseID: 100, TableID: 102}
+
+	// a table without histograms is cached and not loaded again.
+	gs.SetHistograms(k1, nil)
+	gs.SetHistograms(k2, nil)
+	assert.Nil(t, gs.getHistograms(k1))
+	gs.histMu.Lock()
+	assert.False(t, gs.histMu.loading[k1])
+	// keep the reload below from running without an engine.
+	gs.histMu.loading[k1] = true
+	gs.histMu.Unlock()
+
+	// the histograms of k1 are changed by ANALYZE TABLE.
+	packer := types.NewPacker()
+	defer packer.Close()
+	packer.EncodeUint32(k1.AccId)
+	packer.EncodeUint64(k1.TableID)
+	packer.EncodeStringType([]byte("a"))
+	mp := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_varchar.ToType())
+	defer vec.Free(mp)
+	assert.NoError(t, vector.AppendBytes(vec, packer.GetBuf(), false, mp))
+	pv, err := vector.VectorToProtoVector(vec)
+	assert.NoError(t, err)
+	gs.histogramsChanged(&logtail.TableLogtail{
+		Table: &api.TableID{DbId: catalog.MO_CATALOG_ID, TbName: catalog.MO_COLUMN_STATISTICS},
+		Commands: []api.Entry{{
+			EntryType: api.Entry_Delete,
+			TableName: catalog.MO_COLUMN_STATISTICS,
+			Bat:       &api.Batch{Vecs: []api.Vector{pv, pv, pv}},
+		}},
+	})
+
+	gs.histMu.Lock()
+	defer gs.histMu.Unlock()
+	_, ok := gs.histMu.loaded[k1]
+	assert.False(t, ok)
+	_, ok = gs.histMu.loaded[k2]
+	assert.True(t, ok)
+}
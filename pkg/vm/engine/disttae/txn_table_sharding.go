@@ -18,7 +18,9 @@ import (
 	"context"
 
 	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -447,6 +449,17 @@ func (tbl *txnTableDelegate) GetNonAppendableObjectStats(ctx context.Context) ([
 	return stats, nil
 }
 
+func (tbl *txnTableDelegate) CollectChanges(
+	ctx context.Context,
+	from, to types.TS,
+	mp *mpool.MPool,
+) (engine.ChangesHandle, error) {
+	if tbl.isLocal() {
+		return tbl.origin.CollectChanges(ctx, from, to, mp)
+	}
+	return nil, moerr.NewNYI(ctx, "CollectChanges on remote shard")
+}
+
 func (tbl *txnTableDelegate) TableDefs(
 	ctx context.Context,
 ) ([]engine.TableDef, error) {
@@ -106,6 +106,10 @@ func (e *EntireEngine) Stats(ctx context.Context, key pb.StatsInfoKey, sync bool
 	return e.Engine.Stats(ctx, key, sync)
 }
 
+func (e *EntireEngine) SetHistograms(key pb.StatsInfoKey, histograms map[string]*pb.Histogram) {
+	e.Engine.SetHistograms(key, histograms)
+}
+
 func (e *EntireEngine) GetMessageCenter() any {
 	return e.Engine.GetMessageCenter()
 }
@@ -309,6 +309,9 @@ func (e *testEngine) Stats(ctx context.Context, key pb.StatsInfoKey, sync bool)
 	return nil
 }
 
+func (e *testEngine) SetHistograms(key pb.StatsInfoKey, histograms map[string]*pb.Histogram) {
+}
+
 func (e *testEngine) GetMessageCenter() any {
 	return nil
 }
@@ -109,6 +109,10 @@ func (b *BindedEngine) Stats(ctx context.Context, key pb.StatsInfoKey, sync bool
 	return b.engine.Stats(ctx, key, sync)
 }
 
+func (b *BindedEngine) SetHistograms(key pb.StatsInfoKey, histograms map[string]*pb.Histogram) {
+	b.engine.SetHistograms(key, histograms)
+}
+
 func (b *BindedEngine) GetMessageCenter() any {
 	return nil
 }
@@ -54,6 +54,9 @@ func (c *CompilerContext) GetViews() []string {
 func (c *CompilerContext) SetViews(views []string) {
 }
 
+func (c *CompilerContext) AddWarning(warn error) {
+}
+
 func (c *CompilerContext) GetSnapshot() *plan.Snapshot {
 	return nil
 }
@@ -245,6 +245,9 @@ func (e *Engine) Stats(ctx context.Context, key pb.StatsInfoKey, sync bool) *pb.
 	return nil
 }
 
+func (e *Engine) SetHistograms(key pb.StatsInfoKey, histograms map[string]*pb.Histogram) {
+}
+
 func getTNServices(cluster clusterservice.MOCluster) []metadata.TNService {
 	var values []metadata.TNService
 	cluster.GetTNService(clusterservice.NewSelector(),
@@ -20,6 +20,8 @@ import (
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -557,3 +559,7 @@ func (t *Table) MergeObjects(ctx context.Context, objstats []objectio.ObjectStat
 func (t *Table) GetNonAppendableObjectStats(ctx context.Context) ([]objectio.ObjectStats, error) {
 	return nil, nil
 }
+
+func (t *Table) CollectChanges(_ context.Context, _, _ types.TS, _ *mpool.MPool) (engine.ChangesHandle, error) {
+	return nil, moerr.NewNYINoCtx("CollectChanges in memory engine")
+}
@@ -854,6 +854,21 @@ type Relation interface {
 	ApproxObjectsNum(ctx context.Context) int
 	MergeObjects(ctx context.Context, objstats []objectio.ObjectStats, targetObjSize uint32) (*api.MergeCommitEntry, error)
 	GetNonAppendableObjectStats(ctx context.Context) ([]objectio.ObjectStats, error)
+
+	// CollectChanges returns a handle to iterate the committed inserts and deletes
+	// of the relation whose commit timestamps fall in (from, to].
+	// `to` must not be greater than the snapshot timestamp of the transaction.
+	CollectChanges(ctx context.Context, from, to types.TS, mp *mpool.MPool) (ChangesHandle, error)
+}
+
+// ChangesHandle iterates the committed changes of a relation.
+type ChangesHandle interface {
+	// Next returns the next batch of inserted rows and the next batch of
+	// deleted primary keys. The last column of both batches is the commit
+	// timestamp of the row. Both batches are nil when the changes are drained.
+	// The caller owns the returned batches.
+	Next(ctx context.Context, mp *mpool.MPool) (data *batch.Batch, tombstone *batch.Batch, err error)
+	Close() error
 }
 
 type Reader interface {
@@ -934,6 +949,10 @@ type Engine interface {
 	// just return nil if the current stats info has not been initialized.
 	Stats(ctx context.Context, key pb.StatsInfoKey, sync bool) *pb.StatsInfo
 
+	// SetHistograms replaces the column histograms built by ANALYZE TABLE,
+	// which are attached to the stats info of the key.
+	SetHistograms(key pb.StatsInfoKey, histograms map[string]*pb.Histogram)
+
 	GetMessageCenter() any
 
 	GetService() string
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 
 	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 var _ Message = new(JoinMapMsg)
@@ -37,6 +38,10 @@ type JoinMap struct {
 	mpool            *mpool.MPool
 	multiSels        [][]int32
 	batches          []*batch.Batch
+	// spilled[i] are the files of the i-th partition of the build batches.
+	// a spilled join map has no hash table, the join builds one for every
+	// partition itself.
+	spilled [][]*spill.File
 }
 
 func NewJoinMap(sels [][]int32, ihm *hashmap.IntHashMap, shm *hashmap.StrHashMap, batches []*batch.Batch, m *mpool.MPool) *JoinMap {
@@ -50,6 +55,24 @@ func NewJoinMap(sels [][]int32, ihm *hashmap.IntHashMap, shm *hashmap.StrHashMap
 	}
 }
 
+// NewSpilledJoinMap returns a join map of the build batches spilled to disk
+// as partitions.
+func NewSpilledJoinMap(spilled [][]*spill.File, m *mpool.MPool) *JoinMap {
+	return &JoinMap{
+		spilled: spilled,
+		mpool:   m,
+		valid:   true,
+	}
+}
+
+// Spilled returns the partitions of a spilled join map, or nil.
+func (jm *JoinMap) Spilled() [][]*spill.File {
+	if jm == nil {
+		return nil
+	}
+	return jm.spilled
+}
+
 func (jm *JoinMap) GetBatches() []*batch.Batch {
 	if jm == nil {
 		return nil
@@ -80,6 +103,10 @@ func (jm *JoinMap) Sels() [][]int32 {
 	return jm.multiSels
 }
 
+func (jm *JoinMap) SetSels(sels [][]int32) {
+	jm.multiSels = sels
+}
+
 func (jm *JoinMap) NewIterator() hashmap.Iterator {
 	if jm.shm != nil {
 		return jm.shm.NewIterator()
@@ -106,9 +133,13 @@ func (jm *JoinMap) Free() {
 	jm.multiSels = nil
 	if jm.ihm != nil {
 		jm.ihm.Free()
-	} else {
+	} else if jm.shm != nil {
 		jm.shm.Free()
 	}
+	for _, files := range jm.spilled {
+		spill.Cleanup(files...)
+	}
+	jm.spilled = nil
 	for i := range jm.batches {
 		jm.batches[i].Clean(jm.mpool)
 	}
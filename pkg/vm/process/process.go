@@ -21,10 +21,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/log"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/system"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/lockservice"
@@ -33,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	qclient "github.com/matrixorigin/matrixone/pkg/queryservice/client"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 	"io"
 
 	"go.uber.org/zap"
@@ -100,6 +103,81 @@ func (proc *Process) GetFileService() fileservice.FileService {
 	return proc.Base.FileService
 }
 
+// EnterMemoryQuota starts the memory quota of the query on the mpool of the
+// process, and returns the function to end it. The mpool is shared with the
+// queries run inside this one, such as the sql of nextval() run by the
+// background executor of the session. A query without a quota of its own
+// counts in the quota of the outer query, and the quota of the outer query
+// is put back once the inner one is done.
+func (proc *Process) EnterMemoryQuota() (exit func()) {
+	mp := proc.Mp()
+	quota := proc.Base.Lim.MemoryQuota
+	if quota == 0 && mp.Quota() > 0 {
+		return func() {}
+	}
+	st := mp.SetQuota(quota)
+	return func() {
+		mp.RestoreQuota(st)
+	}
+}
+
+// NeedSpill returns true if an operator holding size bytes of state should write
+// part of it to disk, which happens when the operator is over its memory threshold
+// or the query is about to run out of its memory budget. The budget is the memory
+// quota of the query, or defaultMemoryBudget if it has none.
+func (proc *Process) NeedSpill(size int64) bool {
+	if size <= 0 || !proc.CanSpill() {
+		return false
+	}
+	if lim := proc.Base.Lim.Size; lim > 0 && size >= lim {
+		return true
+	}
+	budget := proc.Mp().Quota()
+	if budget == 0 {
+		budget = defaultMemoryBudget(proc.Mp())
+	}
+	curr, _ := proc.Mp().QuotaUsage()
+	return max(curr, size) >= budget/4*3
+}
+
+// defaultMemoryBudget is the memory a query without a quota can hold before its
+// operators spill, a quarter of the memory of the CN, or the cap of the mpool
+// if it is less.
+func defaultMemoryBudget(mp *mpool.MPool) int64 {
+	budget := int64(system.MemoryTotal() / 4)
+	if c := mp.Cap(); budget == 0 || c < budget {
+		budget = c
+	}
+	return budget
+}
+
+// CanSpill returns true if the process has an ETL file service to write
+// temporary files into.
+func (proc *Process) CanSpill() bool {
+	if proc.Base.FileService == nil {
+		return false
+	}
+	_, err := fileservice.Get[fileservice.FileService](proc.Base.FileService, defines.ETLFileServiceName)
+	return err == nil
+}
+
+// Spill writes the batches into a temporary file of the query.
+func (proc *Process) Spill(bats []*batch.Batch) (*spill.File, error) {
+	return spill.Write(proc.Ctx, proc.Base.FileService, proc.QueryId(), bats)
+}
+
+// SpillPartitions writes the batches into temporary files of the query as
+// partitions by the hash of their keys, see spill.WritePartitions.
+func (proc *Process) SpillPartitions(
+	bats []*batch.Batch, keys func(*batch.Batch) ([]*vector.Vector, error), skipNull bool) ([]*spill.File, error) {
+	return spill.WritePartitions(proc.Ctx, proc.Base.FileService, proc.QueryId(), proc.Mp(), bats, keys, skipNull)
+}
+
+// NewSpillWriter returns a writer of a new temporary file of the query.
+func (proc *Process) NewSpillWriter() *spill.Writer {
+	return spill.NewWriter(proc.Base.FileService, proc.QueryId())
+}
+
 func (proc *Process) GetUnixTime() int64 {
 	return proc.Base.UnixTime
 }
@@ -19,6 +19,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -224,7 +225,8 @@ func (proc *Process) GetQueryContextError() error {
 		return base.outerContext.Err()
 	}
 	if base.queryContext != nil && base.queryContext.Err() != nil {
-		return base.queryContext.Err()
+		// the cause tells the query was cancelled or timed out.
+		return context.Cause(base.queryContext)
 	}
 	return nil
 }
@@ -262,6 +264,10 @@ type QueryBaseContext struct {
 	// Once query was began to run, the query context and query cancel will be refreshed by calling BuildQueryCtx() method.
 	queryContext context.Context
 	queryCancel  context.CancelFunc
+
+	// deadline is the time by which the query must finish, zero means no limit.
+	// The query context is cancelled with the ErrQueryTimeout cause once it passes.
+	deadline time.Time
 }
 
 func (bp *BaseProcess) GetContextBase() *QueryBaseContext {
@@ -270,10 +276,25 @@ func (bp *BaseProcess) GetContextBase() *QueryBaseContext {
 
 // BuildQueryCtx refreshes the query context and cancellation method after the outer context was ready to run the query.
 func (qbCtx *QueryBaseContext) BuildQueryCtx() context.Context {
-	qbCtx.queryContext, qbCtx.queryCancel = context.WithCancel(qbCtx.outerContext)
+	if qbCtx.deadline.IsZero() {
+		qbCtx.queryContext, qbCtx.queryCancel = context.WithCancel(qbCtx.outerContext)
+	} else {
+		qbCtx.queryContext, qbCtx.queryCancel = context.WithDeadlineCause(
+			qbCtx.outerContext, qbCtx.deadline, moerr.NewQueryTimeoutNoCtx())
+	}
 	return qbCtx.queryContext
 }
 
+// SetDeadline sets the time by which the query must finish, it takes effect at the next BuildQueryCtx().
+func (qbCtx *QueryBaseContext) SetDeadline(deadline time.Time) {
+	qbCtx.deadline = deadline
+}
+
+// GetDeadline returns the time by which the query must finish, zero means no limit.
+func (qbCtx *QueryBaseContext) GetDeadline() time.Time {
+	return qbCtx.deadline
+}
+
 // SaveToQueryContext saves the key-value pair to the query context.
 // Every pipeline context can access the key-value pair by calling its own context.Value() method.
 // But should be careful to avoid adding key-value pairs after the pipeline context has been created.
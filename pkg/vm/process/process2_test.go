@@ -0,0 +1,132 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package process
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryDeadline(t *testing.T) {
+	proc := &Process{Base: &BaseProcess{}}
+	proc.ReplaceTopCtx(context.Background())
+	base := proc.Base.GetContextBase()
+
+	// no limit.
+	ctx := base.BuildQueryCtx()
+	_, ok := ctx.Deadline()
+	require.False(t, ok)
+	base.queryCancel()
+	require.Equal(t, context.Canceled, proc.GetQueryContextError())
+
+	// the query context is cancelled with ErrQueryTimeout once the deadline passes.
+	base.SetDeadline(time.Now().Add(time.Millisecond))
+	ctx = base.BuildQueryCtx()
+	<-ctx.Done()
+	require.True(t, moerr.IsMoErrCode(proc.GetQueryContextError(), moerr.ErrQueryTimeout))
+
+	// the cancelled query is not a timeout.
+	base.SetDeadline(time.Now().Add(time.Hour))
+	base.BuildQueryCtx()
+	base.queryCancel()
+	require.Equal(t, context.Canceled, proc.GetQueryContextError())
+	base.SetDeadline(time.Time{})
+	require.True(t, base.GetDeadline().IsZero())
+}
+
+func TestMemoryQuotaOfNestedQuery(t *testing.T) {
+	mp := mpool.MustNewZeroNoFixed()
+	outer := &Process{Base: &BaseProcess{mp: mp}}
+	outer.Base.Lim.MemoryQuota = 4096
+	// the query run inside the outer one on the same mpool, such as the sql
+	// of nextval() run by the background executor, has no quota of its own.
+	inner := &Process{Base: &BaseProcess{mp: mp}}
+
+	exitOuter := outer.EnterMemoryQuota()
+	held, err := mp.Alloc(2048)
+	require.NoError(t, err)
+
+	// the inner query counts in the quota of the outer one.
+	exitInner := inner.EnterMemoryQuota()
+	_, err = mp.Alloc(4096)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryLimit))
+	exitInner()
+
+	// and the outer limit still applies once it is done.
+	require.Equal(t, int64(4096), mp.Quota())
+	_, err = mp.Alloc(4096)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryLimit))
+
+	// an inner query with a quota of its own gives the outer quota back.
+	inner.Base.Lim.MemoryQuota = 8192
+	exitInner = inner.EnterMemoryQuota()
+	d, err := mp.Alloc(4096)
+	require.NoError(t, err)
+	mp.Free(d)
+	exitInner()
+	require.Equal(t, int64(4096), mp.Quota())
+	_, err = mp.Alloc(4096)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrQueryMemoryLimit))
+
+	mp.Free(held)
+	exitOuter()
+	require.Equal(t, int64(0), mp.Quota())
+	d, err = mp.Alloc(8192)
+	require.NoError(t, err)
+	mp.Free(d)
+}
+
+func TestNeedSpill(t *testing.T) {
+	mp, err := mpool.NewMPool("test", 1<<20, mpool.NoFixed)
+	require.NoError(t, err)
+	proc := &Process{Base: &BaseProcess{mp: mp}}
+
+	// nowhere to spill.
+	require.False(t, proc.NeedSpill(1<<20))
+
+	fs, err := fileservice.NewMemoryFS(defines.ETLFileServiceName, fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	proc.Base.FileService = fs
+
+	// without a quota, the budget is the cap of the mpool here.
+	require.False(t, proc.NeedSpill(1<<19))
+	require.True(t, proc.NeedSpill(800<<10))
+
+	// the memory held by the query counts too.
+	exit := proc.EnterMemoryQuota()
+	d, err := mp.Alloc(780 << 10)
+	require.NoError(t, err)
+	require.True(t, proc.NeedSpill(100<<10))
+	mp.Free(d)
+	exit()
+
+	// the quota takes the place of the default budget.
+	proc.Base.Lim.MemoryQuota = 4 << 10
+	exit = proc.EnterMemoryQuota()
+	require.True(t, proc.NeedSpill(3<<10))
+	exit()
+
+	// so does the threshold of the operator.
+	proc.Base.Lim.MemoryQuota = 0
+	proc.Base.Lim.Size = 1
+	require.True(t, proc.NeedSpill(1))
+}
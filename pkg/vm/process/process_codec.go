@@ -47,6 +47,10 @@ func (proc *Process) BuildProcessInfo(
 		procInfo.Sql = sql
 		procInfo.Lim = convertToPipelineLimitation(proc.GetLim())
 		procInfo.UnixTime = proc.Base.UnixTime
+		if deadline := proc.Base.sqlContext.deadline; !deadline.IsZero() {
+			// send the remaining time rather than the deadline, the clocks of the CNs may differ.
+			procInfo.Timeout = max(int64(time.Until(deadline)), 1)
+		}
 		accountId, err := defines.GetAccountId(proc.Ctx)
 		if err != nil {
 			return procInfo, err
@@ -227,6 +231,7 @@ func convertToPipelineLimitation(lim Limitation) pipeline.ProcessLimitation {
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		MemoryQuota:   lim.MemoryQuota,
 	}
 }
 
@@ -273,6 +278,7 @@ func ConvertToProcessLimitation(
 		BatchSize:     lim.BatchSize,
 		PartitionRows: lim.PartitionRows,
 		ReaderSize:    lim.ReaderSize,
+		MemoryQuota:   lim.MemoryQuota,
 	}
 }
 
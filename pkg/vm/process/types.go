@@ -136,6 +136,8 @@ type Limitation struct {
 	ReaderSize int64
 	// MaxMessageSize max size for read messages from dn
 	MaxMsgSize uint64
+	// MemoryQuota, max bytes the query can hold in the mpool, 0 means no limit.
+	MemoryQuota int64
 }
 
 // SessionInfo session information
@@ -0,0 +1,354 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spill writes temporary batches through the ETL file service, so that
+// operators like group, order and join can work on more data than their memory
+// budget allows.
+package spill
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/google/uuid"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+// Partitions is the number of partitions used by the grace hash spilling of
+// group and join.
+const Partitions = 32
+
+// WriteBufferSize is the bytes of marshaled batches a Writer holds at most,
+// they are written out as a segment of the file once they reach it.
+const WriteBufferSize = 1 << 20
+
+// File is a temporary file holding a list of batches.
+// it is written once by a Writer and read back batch by batch. The batches
+// are stored in one or more segments, every segment is a file of the file
+// service.
+type File struct {
+	fs   fileservice.FileService
+	path string
+	// segments is the number of the segments written.
+	segments int
+	// the i-th batch is at offsets[i] of the segment segs[i].
+	segs    []int
+	offsets []int64
+	sizes   []int64
+	rows    int
+}
+
+// Writer builds a temporary file batch by batch, the batches are marshaled as
+// soon as they are appended, so the caller can free them right away. The
+// marshaled batches are held in memory until they reach WriteBufferSize.
+type Writer struct {
+	file     *File
+	entries  []fileservice.IOEntry
+	offset   int64
+	buffered int64
+	size     int64
+}
+
+// NewWriter returns a writer of a new temporary file of the query qid.
+func NewWriter(fs fileservice.FileService, qid string) *Writer {
+	return &Writer{
+		file: &File{
+			fs:   fs,
+			path: fileservice.JoinPath(defines.ETLFileServiceName, fmt.Sprintf("/spill/%s/%s", qid, uuid.NewString())),
+		},
+	}
+}
+
+// Append marshals the batch into the file, nil and empty batches are skipped.
+// The batches held are written out if they reach WriteBufferSize.
+func (w *Writer) Append(ctx context.Context, bat *batch.Batch) error {
+	if bat == nil || bat.RowCount() == 0 {
+		return nil
+	}
+	data, err := bat.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	f := w.file
+	w.entries = append(w.entries, fileservice.IOEntry{
+		Offset: w.offset,
+		Size:   int64(len(data)),
+		Data:   data,
+	})
+	f.segs = append(f.segs, f.segments)
+	f.offsets = append(f.offsets, w.offset)
+	f.sizes = append(f.sizes, int64(len(data)))
+	f.rows += bat.RowCount()
+	w.offset += int64(len(data))
+	w.buffered += int64(len(data))
+	w.size += int64(len(data))
+	if w.buffered >= WriteBufferSize {
+		return w.writeSegment(ctx)
+	}
+	return nil
+}
+
+// Size returns the bytes appended so far.
+func (w *Writer) Size() int64 {
+	return w.size
+}
+
+// writeSegment writes the batches held as a new segment of the file.
+func (w *Writer) writeSegment(ctx context.Context) error {
+	if len(w.entries) == 0 {
+		return nil
+	}
+	f := w.file
+	vec := fileservice.IOVector{
+		FilePath: f.segmentPath(f.segments),
+		Entries:  w.entries,
+		Policy:   fileservice.SkipAllCache,
+	}
+	// the segment is counted before writing, so that it is deleted with the
+	// file even if the write fails halfway.
+	f.segments++
+	if err := f.fs.Write(ctx, vec); err != nil {
+		return err
+	}
+	w.entries = nil
+	w.offset = 0
+	w.buffered = 0
+	return nil
+}
+
+// Flush writes the batches held and returns the file.
+func (w *Writer) Flush(ctx context.Context) (*File, error) {
+	if err := w.writeSegment(ctx); err != nil {
+		return nil, err
+	}
+	return w.file, nil
+}
+
+// Abort removes what has been written, the file is not usable afterwards.
+func (w *Writer) Abort(ctx context.Context) error {
+	w.entries = nil
+	w.buffered = 0
+	return w.file.Delete(ctx)
+}
+
+// Write marshals the batches into a new temporary file of the query qid.
+// nil and empty batches are skipped, the batches are not freed.
+func Write(ctx context.Context, fs fileservice.FileService, qid string, bats []*batch.Batch) (*File, error) {
+	w := NewWriter(fs, qid)
+	for _, bat := range bats {
+		if err := w.Append(ctx, bat); err != nil {
+			_ = w.Abort(ctx)
+			return nil, err
+		}
+	}
+	f, err := w.Flush(ctx)
+	if err != nil {
+		_ = w.Abort(ctx)
+	}
+	return f, err
+}
+
+func (f *File) segmentPath(i int) string {
+	return fmt.Sprintf("%s.%d", f.path, i)
+}
+
+// Len returns the number of batches in the file.
+func (f *File) Len() int {
+	return len(f.offsets)
+}
+
+// Rows returns the number of rows in the file.
+func (f *File) Rows() int {
+	return f.rows
+}
+
+// Read reads the i-th batch of the file back, the memory of the batch is
+// allocated from mp.
+func (f *File) Read(ctx context.Context, i int, mp *mpool.MPool) (*batch.Batch, error) {
+	vec := fileservice.IOVector{
+		FilePath: f.segmentPath(f.segs[i]),
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: f.offsets[i],
+				Size:   f.sizes[i],
+			},
+		},
+		Policy: fileservice.SkipAllCache,
+	}
+	if err := f.fs.Read(ctx, &vec); err != nil {
+		return nil, err
+	}
+	defer vec.Release()
+	bat := batch.NewWithSize(0)
+	if err := bat.UnmarshalBinaryWithCopy(vec.Entries[0].Data, mp); err != nil {
+		bat.Clean(mp)
+		return nil, err
+	}
+	return bat, nil
+}
+
+// Delete removes the file. it is safe to call it more than once.
+func (f *File) Delete(ctx context.Context) error {
+	if f == nil || f.segments == 0 {
+		return nil
+	}
+	paths := make([]string, f.segments)
+	for i := range paths {
+		paths[i] = f.segmentPath(i)
+	}
+	f.segments = 0
+	f.segs, f.offsets, f.sizes = nil, nil, nil
+	return f.fs.Delete(ctx, paths...)
+}
+
+// DeleteAll removes all the files and returns the first error met.
+func DeleteAll(ctx context.Context, files []*File) (err error) {
+	for _, f := range files {
+		if e := f.Delete(ctx); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// Cleanup removes the files of an operator being reset or freed. The query
+// context may be canceled already, so the files are removed with a context
+// of their own, and an error only leaves the temporary files behind.
+func Cleanup(files ...*File) {
+	_ = DeleteAll(context.Background(), files)
+}
+
+// PartitionSels splits the rows of a batch into n partitions by the hash of
+// the key vectors, the row numbers of partition i are appended to sels[i].
+// rows with a null key are dropped if skipNull, otherwise they are hashed as
+// a special value. Every level takes other bits of the hash, so the rows of
+// a partition at a level are split again at the next level.
+func PartitionSels(keys []*vector.Vector, rows int, sels [][]int32, level int, skipNull bool) [][]int32 {
+	n := uint64(len(sels))
+	var nullHash = xxhash.Sum64String("null")
+	for row := 0; row < rows; row++ {
+		var h uint64
+		hasNull := false
+		for _, key := range keys {
+			var v uint64
+			if key.IsNull(uint64(row)) {
+				hasNull = true
+				v = nullHash
+			} else {
+				v = xxhash.Sum64(key.GetRawBytesAt(row))
+			}
+			h = h*31 + v
+		}
+		if hasNull && skipNull {
+			continue
+		}
+		for i := 0; i < level; i++ {
+			h /= n
+		}
+		sels[h%n] = append(sels[h%n], int32(row))
+	}
+	return sels
+}
+
+// SplitBatch copies the rows of a batch at sels[i] into the i-th returned
+// batch, which is nil if sels[i] is empty.
+func SplitBatch(bat *batch.Batch, sels [][]int32, mp *mpool.MPool) ([]*batch.Batch, error) {
+	bats := make([]*batch.Batch, len(sels))
+	for i, sel := range sels {
+		if len(sel) == 0 {
+			continue
+		}
+		bats[i] = batch.NewWithSize(len(bat.Vecs))
+		for j, vec := range bat.Vecs {
+			bats[i].Vecs[j] = vector.NewVec(*vec.GetType())
+			if err := bats[i].Vecs[j].UnionInt32(vec, sel, mp); err != nil {
+				for _, b := range bats[:i+1] {
+					if b != nil {
+						b.Clean(mp)
+					}
+				}
+				return nil, err
+			}
+		}
+		bats[i].SetRowCount(len(sel))
+	}
+	return bats, nil
+}
+
+// WritePartitions splits the batches into Partitions partitions by the hash of
+// the key vectors returned by keys, and writes every partition into a new file
+// of the query qid. the rows with a null key are dropped if skipNull.
+func WritePartitions(
+	ctx context.Context, fs fileservice.FileService, qid string, mp *mpool.MPool,
+	bats []*batch.Batch, keys func(*batch.Batch) ([]*vector.Vector, error), skipNull bool) ([]*File, error) {
+	writers := make([]*Writer, Partitions)
+	for i := range writers {
+		writers[i] = NewWriter(fs, qid)
+	}
+	abort := func() {
+		for _, w := range writers {
+			_ = w.Abort(ctx)
+		}
+	}
+	sels := make([][]int32, Partitions)
+	for _, bat := range bats {
+		if bat == nil || bat.RowCount() == 0 {
+			continue
+		}
+		vecs, err := keys(bat)
+		if err != nil {
+			abort()
+			return nil, err
+		}
+		for i := range sels {
+			sels[i] = sels[i][:0]
+		}
+		PartitionSels(vecs, bat.RowCount(), sels, 0, skipNull)
+		parts, err := SplitBatch(bat, sels, mp)
+		if err != nil {
+			abort()
+			return nil, err
+		}
+		for i, part := range parts {
+			if part == nil {
+				continue
+			}
+			if err == nil {
+				err = writers[i].Append(ctx, part)
+			}
+			part.Clean(mp)
+		}
+		if err != nil {
+			abort()
+			return nil, err
+		}
+	}
+
+	files := make([]*File, Partitions)
+	for i, w := range writers {
+		f, err := w.Flush(ctx)
+		if err != nil {
+			abort()
+			return nil, err
+		}
+		files[i] = f
+	}
+	return files, nil
+}
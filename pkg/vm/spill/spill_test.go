@@ -0,0 +1,105 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+func newTestBatch(t *testing.T, mp *mpool.MPool, start, rows int) *batch.Batch {
+	vals := make([]int64, rows)
+	for i := range vals {
+		vals[i] = int64(start + i)
+	}
+	vec := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(vec, vals, nil, mp))
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vec
+	bat.SetRowCount(rows)
+	return bat
+}
+
+func TestWriterSegments(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	fs, err := fileservice.NewMemoryFS(defines.ETLFileServiceName, fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+
+	// every batch is half of the write buffer, so a segment is written for
+	// every two of them.
+	const rows = WriteBufferSize / 2 / 8
+	w := NewWriter(fs, "query")
+	for i := 0; i < 5; i++ {
+		bat := newTestBatch(t, mp, i*rows, rows)
+		require.NoError(t, w.Append(ctx, bat))
+		bat.Clean(mp)
+	}
+	require.LessOrEqual(t, len(w.entries), 2)
+	f, err := w.Flush(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, f.segments)
+	require.Equal(t, 5, f.Len())
+	require.Equal(t, 5*rows, f.Rows())
+
+	for i := 0; i < f.Len(); i++ {
+		bat, err := f.Read(ctx, i, mp)
+		require.NoError(t, err)
+		vals := vector.MustFixedCol[int64](bat.Vecs[0])
+		require.Equal(t, rows, len(vals))
+		require.Equal(t, int64(i*rows), vals[0])
+		bat.Clean(mp)
+	}
+
+	path := f.segmentPath(2)
+	require.NoError(t, f.Delete(ctx))
+	_, err = fs.StatFile(ctx, path)
+	require.Error(t, err)
+	require.NoError(t, f.Delete(ctx))
+	require.Equal(t, int64(0), mp.CurrNB())
+}
+
+func TestPartitionSelsLevel(t *testing.T) {
+	mp := mpool.MustNewZero()
+	bat := newTestBatch(t, mp, 0, 10000)
+	defer bat.Clean(mp)
+
+	sels := PartitionSels(bat.Vecs, bat.RowCount(), make([][]int32, Partitions), 0, false)
+	// the rows of a partition are spread over the partitions of the next
+	// level.
+	sub := PartitionSels(bat.Vecs, bat.RowCount(), make([][]int32, Partitions), 1, false)
+	part := make([]int, bat.RowCount())
+	for i, s := range sub {
+		for _, row := range s {
+			part[row] = i
+		}
+	}
+	for _, sel := range sels {
+		seen := make(map[int]bool)
+		for _, row := range sel {
+			seen[part[row]] = true
+		}
+		require.Greater(t, len(seen), 1)
+	}
+}
@@ -311,7 +311,8 @@ func (info OperatorInfo) GetAddress() message.MessageAddress {
 
 func CannotRemote(op Operator) bool {
 	// todo: I think we should add more operators here.
-	return op.OpType() == LockOp
+	// the output sends the result by the function of the current CN.
+	return op.OpType() == LockOp || op.OpType() == Output
 }
 
 type ModificationArgument interface {
@@ -18,6 +18,14 @@ commit;
 
 select count(*) > 0 from mo_cache() c;
 
+-- test mo_query_memory
+
+select count(*) >= 0 from mo_query_memory() q;
+set query_memory_limit = 1048576;
+select count(*) from (select distinct result from generate_series(1, 10000000) g) t;
+set query_memory_limit = 0;
+select count(*) from (select distinct result from generate_series(1, 100000) g) t;
+
 -- test mo_configurations
 
 select count(*) >0 from mo_configurations() t;